@@ -19,6 +19,8 @@ const (
 	QueryKiraFunctions     = "/api/kira/metadata"
 	QueryKiraStatus        = "/api/kira/status"
 
+	QueryKiraDistributorRewards = "/api/kira/distributor/rewards/{address}"
+
 	QueryInterxFunctions = "/api/metadata"
 
 	FaucetRequestURL         = "/api/faucet"
@@ -32,6 +34,7 @@ const (
 	QueryStatus              = "/api/status"
 	QueryValidators          = "/api/valopers"
 	QueryValidatorInfos      = "/api/valoperinfos"
+	QueryValidatorHistory    = "/api/valopers/{val_addr}/history"
 	QueryGenesis             = "/api/genesis"
 	QueryGenesisSum          = "/api/gensum"
 
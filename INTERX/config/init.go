@@ -19,6 +19,7 @@ func getGetMethods() []string {
 		QueryStatus,
 		QueryValidators,
 		QueryValidatorInfos,
+		QueryValidatorHistory,
 		QueryBlocks,
 		QueryBlockByHeightOrHash,
 		QueryBlockTransactions,
@@ -31,6 +32,7 @@ func getGetMethods() []string {
 		QueryVotes,
 		QueryKiraTokensAliases,
 		QueryKiraTokensRates,
+		QueryKiraDistributorRewards,
 
 		QueryRosettaNetworkList,
 		QueryRosettaNetworkOptions,
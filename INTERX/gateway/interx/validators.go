@@ -19,9 +19,11 @@ import (
 func RegisterValidatorsQueryRoutes(r *mux.Router, gwCosmosmux *runtime.ServeMux, rpcAddr string) {
 	r.HandleFunc(config.QueryValidators, QueryValidators(gwCosmosmux, rpcAddr)).Methods("GET")
 	r.HandleFunc(config.QueryValidatorInfos, QueryValidatorInfos(gwCosmosmux, rpcAddr)).Methods("GET")
+	r.HandleFunc(config.QueryValidatorHistory, QueryValidatorHistory(gwCosmosmux, rpcAddr)).Methods("GET")
 
 	common.AddRPCMethod("GET", config.QueryValidators, "This is an API to query validators.", true)
 	common.AddRPCMethod("GET", config.QueryValidatorInfos, "This is an API to query validator infos.", true)
+	common.AddRPCMethod("GET", config.QueryValidatorHistory, "This is an API to query the uptime and rank history of a validator.", true)
 }
 
 const (
@@ -52,8 +54,10 @@ func queryValidatorsHandle(r *http.Request, gwCosmosmux *runtime.ServeMux) (inte
 	proposer := queries["proposer"]
 	countTotal := queries["count_total"]
 	all := queries["all"]
+	history := queries["history"]
 
 	isQueryAll := false
+	isQueryHistory := len(history) == 1 && history[0] == "true"
 
 	var events = make([]string, 0, 9)
 	if len(key) == 1 {
@@ -145,6 +149,34 @@ func queryValidatorsHandle(r *http.Request, gwCosmosmux *runtime.ServeMux) (inte
 				result.Validators[index].Tombstoned = signInfoResponse.ValSigningInfo.Tombstoned
 				result.Validators[index].MissedBlocksCounter = signInfoResponse.ValSigningInfo.MissedBlocksCounter
 			}
+
+			if isQueryHistory {
+				newReq := tempRequest.Clone(tempRequest.Context())
+				newReq.URL.Path = strings.Replace(config.QueryValidatorHistory, "{val_addr}", validator.Valkey, 1)
+				newReq.URL.RawQuery = ""
+
+				historyRes, _, _ := common.ServeGRPC(newReq, gwCosmosmux)
+
+				if historyRes != nil {
+					historyResponse := struct {
+						Epochs []types.ValidatorEpoch `json:"epochs,omitempty"`
+					}{}
+
+					byteData, err := json.Marshal(historyRes)
+					if err != nil {
+						common.GetLogger().Error("[query-validator-history] Invalid response format: ", err)
+						return common.ServeError(0, "", err.Error(), http.StatusInternalServerError)
+					}
+
+					err = json.Unmarshal(byteData, &historyResponse)
+					if err != nil {
+						common.GetLogger().Error("[query-validator-history] Invalid response format: ", err)
+						return common.ServeError(0, "", err.Error(), http.StatusInternalServerError)
+					}
+
+					result.Validators[index].History = historyResponse.Epochs
+				}
+			}
 		}
 
 		sort.Sort(types.QueryValidators(result.Validators))
@@ -290,3 +322,59 @@ func QueryValidatorInfos(gwCosmosmux *runtime.ServeMux, rpcAddr string) http.Han
 		common.WrapResponse(w, request, *response, statusCode, true)
 	}
 }
+
+func queryValidatorHistoryHandle(r *http.Request, gwCosmosmux *runtime.ServeMux) (interface{}, interface{}, int) {
+	queries := r.URL.Query()
+	key := queries["key"]
+	offset := queries["offset"]
+	limit := queries["limit"]
+	countTotal := queries["count_total"]
+
+	var events = make([]string, 0, 4)
+	if len(key) == 1 {
+		events = append(events, fmt.Sprintf("pagination.key=%s", key[0]))
+	}
+	if len(offset) == 1 {
+		events = append(events, fmt.Sprintf("pagination.offset=%s", offset[0]))
+	}
+	if len(limit) == 1 {
+		events = append(events, fmt.Sprintf("pagination.limit=%s", limit[0]))
+	}
+	if len(countTotal) == 1 {
+		events = append(events, fmt.Sprintf("pagination.count_total=%s", countTotal[0]))
+	}
+
+	r.URL.RawQuery = strings.Join(events, "&")
+
+	return common.ServeGRPC(r, gwCosmosmux)
+}
+
+// QueryValidatorHistory is a function to query the blocks signed and missed, the rank and the status changes of a validator by epoch.
+func QueryValidatorHistory(gwCosmosmux *runtime.ServeMux, rpcAddr string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		request := common.GetInterxRequest(r)
+		response := common.GetResponseFormat(request, rpcAddr)
+		statusCode := http.StatusOK
+
+		common.GetLogger().Info("[query-validator-history] Entering validator history query")
+
+		if !common.RPCMethods["GET"][config.QueryValidatorHistory].Enabled {
+			response.Response, response.Error, statusCode = common.ServeError(0, "", "API disabled", http.StatusForbidden)
+		} else {
+			if common.RPCMethods["GET"][config.QueryValidatorHistory].CachingEnabled {
+				found, cacheResponse, cacheError, cacheStatus := common.SearchCache(request, response)
+				if found {
+					response.Response, response.Error, statusCode = cacheResponse, cacheError, cacheStatus
+					common.WrapResponse(w, request, *response, statusCode, false)
+
+					common.GetLogger().Info("[query-validator-history] Returning from the cache")
+					return
+				}
+			}
+
+			response.Response, response.Error, statusCode = queryValidatorHistoryHandle(r, gwCosmosmux)
+		}
+
+		common.WrapResponse(w, request, *response, statusCode, common.RPCMethods["GET"][config.QueryValidatorHistory].CachingEnabled)
+	}
+}
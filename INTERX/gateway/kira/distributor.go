@@ -0,0 +1,51 @@
+package kira
+
+import (
+	"net/http"
+
+	"github.com/KiraCore/sekai/INTERX/common"
+	"github.com/KiraCore/sekai/INTERX/config"
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+)
+
+// RegisterKiraDistributorRoutes registers kira distributor query routers.
+func RegisterKiraDistributorRoutes(r *mux.Router, gwCosmosmux *runtime.ServeMux, rpcAddr string) {
+	r.HandleFunc(config.QueryKiraDistributorRewards, QueryKiraDistributorRewardsRequest(gwCosmosmux, rpcAddr)).Methods("GET")
+
+	common.AddRPCMethod("GET", config.QueryKiraDistributorRewards, "This is an API to query block rewards of an address.", true)
+}
+
+func queryKiraDistributorRewardsHandler(r *http.Request, gwCosmosmux *runtime.ServeMux) (interface{}, interface{}, int) {
+	return common.ServeGRPC(r, gwCosmosmux)
+}
+
+// QueryKiraDistributorRewardsRequest is a function to query block rewards of an address.
+func QueryKiraDistributorRewardsRequest(gwCosmosmux *runtime.ServeMux, rpcAddr string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		request := common.GetInterxRequest(r)
+		response := common.GetResponseFormat(request, rpcAddr)
+		statusCode := http.StatusOK
+
+		common.GetLogger().Info("[query-distributor-rewards] Entering block rewards query")
+
+		if !common.RPCMethods["GET"][config.QueryKiraDistributorRewards].Enabled {
+			response.Response, response.Error, statusCode = common.ServeError(0, "", "API disabled", http.StatusForbidden)
+		} else {
+			if common.RPCMethods["GET"][config.QueryKiraDistributorRewards].CachingEnabled {
+				found, cacheResponse, cacheError, cacheStatus := common.SearchCache(request, response)
+				if found {
+					response.Response, response.Error, statusCode = cacheResponse, cacheError, cacheStatus
+					common.WrapResponse(w, request, *response, statusCode, false)
+
+					common.GetLogger().Info("[query-distributor-rewards] Returning from the cache")
+					return
+				}
+			}
+
+			response.Response, response.Error, statusCode = queryKiraDistributorRewardsHandler(r, gwCosmosmux)
+		}
+
+		common.WrapResponse(w, request, *response, statusCode, common.RPCMethods["GET"][config.QueryKiraDistributorRewards].CachingEnabled)
+	}
+}
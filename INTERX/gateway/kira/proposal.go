@@ -199,10 +199,13 @@ func queryVotesHandler(r *http.Request, gwCosmosmux *runtime.ServeMux) (interfac
 	if success != nil {
 		result := struct {
 			Votes []struct {
-				ProposalID uint64 `json:"proposal_id,omitempty"`
-				Voter      []byte `json:"voter,omitempty"`
-				Option     string `json:"option,omitempty"`
-			} `json:"voters,omitempty"`
+				ProposalID uint64                        `json:"proposal_id,omitempty"`
+				Voter      []byte                        `json:"voter,omitempty"`
+				Option     string                        `json:"option,omitempty"`
+				Options    []govTypes.WeightedVoteOption `json:"options,omitempty"`
+				Reason     string                        `json:"reason,omitempty"`
+				SubmitTime string                        `json:"submit_time,omitempty"`
+			} `json:"votes,omitempty"`
 		}{}
 
 		byteData, err := json.Marshal(success)
@@ -225,6 +228,14 @@ func queryVotesHandler(r *http.Request, gwCosmosmux *runtime.ServeMux) (interfac
 			newVote.ProposalID = vote.ProposalID
 			newVote.Voter = sdk.MustBech32ifyAddressBytes(sdk.GetConfig().GetBech32AccountAddrPrefix(), vote.Voter)
 			newVote.Option = vote.Option
+			newVote.Options = vote.Options
+			newVote.Reason = vote.Reason
+			newVote.SubmitTime = vote.SubmitTime
+
+			// votes cast before weighted votes carry only the option
+			if len(newVote.Options) == 0 {
+				newVote.Options = []govTypes.WeightedVoteOption{{Option: vote.Option, Weight: "1.000000000000000000"}}
+			}
 
 			votes = append(votes, newVote)
 		}
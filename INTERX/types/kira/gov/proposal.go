@@ -35,7 +35,16 @@ var (
 )
 
 type Vote struct {
-	ProposalID uint64 `json:"proposal_id"`
-	Voter      string `json:"voter"`
-	Option     string `json:"option"`
+	ProposalID uint64               `json:"proposal_id"`
+	Voter      string               `json:"voter"`
+	Option     string               `json:"option"`
+	Options    []WeightedVoteOption `json:"options"`
+	Reason     string               `json:"reason"`
+	SubmitTime string               `json:"submit_time"`
+}
+
+// WeightedVoteOption is the share of a vote cast on an option.
+type WeightedVoteOption struct {
+	Option string `json:"option"`
+	Weight string `json:"weight"`
 }
@@ -169,6 +169,29 @@ type QueryValidator struct {
 	InactiveUntil       string `json:"inactive_until"`
 	Tombstoned          bool   `json:"tombstoned,string"`
 	MissedBlocksCounter int64  `json:"missed_blocks_counter,string"`
+
+	// Uptime and rank history, queried with history=true
+	History []ValidatorEpoch `json:"history,omitempty"`
+}
+
+// ValidatorStatusChange is a struct to be used for a status change of a validator during an uptime epoch
+type ValidatorStatusChange struct {
+	Height int64  `json:"height,string"`
+	Time   string `json:"time"`
+	Status string `json:"status"`
+}
+
+// ValidatorEpoch is a struct to be used for the uptime and rank of a validator over an epoch
+type ValidatorEpoch struct {
+	Epoch         uint64                  `json:"epoch,string"`
+	StartHeight   int64                   `json:"start_height,string"`
+	EndHeight     int64                   `json:"end_height,string"`
+	SignedBlocks  uint64                  `json:"signed_blocks,string"`
+	MissedBlocks  uint64                  `json:"missed_blocks,string"`
+	Rank          int64                   `json:"rank,string"`
+	Streak        int64                   `json:"streak,string"`
+	Status        string                  `json:"status"`
+	StatusChanges []ValidatorStatusChange `json:"status_changes,omitempty"`
 }
 
 type QueryValidators []QueryValidator
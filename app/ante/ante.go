@@ -106,7 +106,7 @@ func (svd ValidateFeeRangeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 
 	// execution fee should be prepaid
 	executionMaxFee := uint64(0)
-	for _, msg := range feeTx.GetMsgs() {
+	for _, msg := range unwrapExecutedMsgs(feeTx.GetMsgs()) {
 		fee := svd.cgk.GetExecutionFee(ctx, msg.Type())
 		if fee != nil { // execution fee exist
 			maxFee := fee.FailureFee
@@ -152,10 +152,10 @@ func (sgcd ExecutionFeeRegistrationDecorator) AnteHandle(ctx sdk.Context, tx sdk
 	}
 
 	// execution fee consume gas
-	for _, msg := range sigTx.GetMsgs() {
+	for i, msg := range unwrapExecutedMsgs(sigTx.GetMsgs()) {
 		fee := sgcd.cgk.GetExecutionFee(ctx, msg.Type())
 		if fee != nil { // execution fee exist
-			sgcd.fk.AddExecutionStart(ctx, msg)
+			sgcd.fk.AddExecutionStart(ctx, uint32(i), msg, sigTx.FeePayer())
 		}
 	}
 
@@ -178,6 +178,40 @@ func NewPoorNetworkManagementDecorator(ak keeper.AccountKeeper, cgk customgovkee
 	}
 }
 
+// unwrapMsgs returns the messages of a tx, the ones executed by a MsgExec or proposed to a group
+// following it so that they go through the same restrictions as when they are sent directly
+func unwrapMsgs(msgs []sdk.Msg) []sdk.Msg {
+	unwrapped := make([]sdk.Msg, 0, len(msgs))
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *customgovtypes.MsgExec:
+			unwrapped = append(unwrapped, unwrapMsgs(msg.UnpackedMsgs())...)
+			continue
+		case *customgovtypes.MsgSubmitGroupProposal:
+			unwrapped = append(unwrapped, msg)
+			unwrapped = append(unwrapped, unwrapMsgs(msg.UnpackedMsgs())...)
+			continue
+		}
+		unwrapped = append(unwrapped, msg)
+	}
+
+	return unwrapped
+}
+
+// unwrapExecutedMsgs returns the messages executed by a tx, each MsgExec followed by the messages it executes.
+// Unlike unwrapMsgs it leaves out the messages proposed to a group, which are executed once the group proposal passes.
+func unwrapExecutedMsgs(msgs []sdk.Msg) []sdk.Msg {
+	unwrapped := make([]sdk.Msg, 0, len(msgs))
+	for _, msg := range msgs {
+		unwrapped = append(unwrapped, msg)
+		if exec, ok := msg.(*customgovtypes.MsgExec); ok {
+			unwrapped = append(unwrapped, unwrapExecutedMsgs(exec.UnpackedMsgs())...)
+		}
+	}
+
+	return unwrapped
+}
+
 func findString(a []string, x string) int {
 	for i, n := range a {
 		if x == n {
@@ -203,7 +237,7 @@ func (pnmd PoorNetworkManagementDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx
 	if !found {
 		poorNetworkMsgs = &customgovtypes.AllowedMessages{}
 	}
-	for _, msg := range sigTx.GetMsgs() {
+	for _, msg := range unwrapMsgs(sigTx.GetMsgs()) {
 		if msg.Type() == bank.TypeMsgSend {
 			// on poor network, we introduce POOR_NETWORK_MAX_BANK_TX_SEND network property to limit transaction send amount
 			msg := msg.(*bank.MsgSend)
@@ -214,12 +248,11 @@ func (pnmd PoorNetworkManagementDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx
 				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "only restricted amount send is allowed on poor network")
 			}
 			// TODO: we could do restriction to send only when target account does not exist on chain yet for more restriction
-			return next(ctx, tx, simulate)
+			continue
 		}
-		if findString(poorNetworkMsgs.Messages, msg.Type()) >= 0 {
-			return next(ctx, tx, simulate)
+		if findString(poorNetworkMsgs.Messages, msg.Type()) < 0 {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid transaction type on poor network")
 		}
-		return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid transaction type on poor network")
 	}
 
 	return next(ctx, tx, simulate)
@@ -251,7 +284,7 @@ func (pnmd BlackWhiteTokensCheckDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx
 	bondDenom := pnmd.csk.BondDenom(ctx)
 	tokensBlackWhite := pnmd.tk.GetTokenBlackWhites(ctx)
 	properties := pnmd.cgk.GetNetworkProperties(ctx)
-	for _, msg := range sigTx.GetMsgs() {
+	for _, msg := range unwrapMsgs(sigTx.GetMsgs()) {
 		if msg.Type() == bank.TypeMsgSend {
 			msg := msg.(*bank.MsgSend)
 			for _, amt := range msg.Amount {
@@ -63,6 +63,23 @@ func (suite *AnteTestSuite) TestCustomAnteHandlerExecutionFee() {
 			false,
 			errors.New("fee 100ukex(100) is less than max execution fee 10000ukex: invalid request"),
 		},
+		{
+			"insufficient max execution fee set for a message executed with exec",
+			func() ([]sdk.Msg, []cryptotypes.PrivKey, []uint64, []uint64, sdk.Coins) {
+				execMsg, err := customgovtypes.NewMsgExec(accounts[0].acc.GetAddress(), []sdk.Msg{
+					customgovtypes.NewMsgSetNetworkProperties(accounts[1].acc.GetAddress(), &customgovtypes.NetworkProperties{
+						MinTxFee:                 2,
+						MaxTxFee:                 10000,
+						EnableForeignFeePayments: true,
+					}),
+				})
+				suite.Require().NoError(err)
+				return []sdk.Msg{execMsg}, privs[0:1], accNums[0:1], []uint64{0}, defaultFee
+			},
+			true,
+			false,
+			errors.New("fee 100ukex(100) is less than max execution fee 10000ukex: invalid request"),
+		},
 		{
 			"execution failure fee deduction",
 			func() ([]sdk.Msg, []cryptotypes.PrivKey, []uint64, []uint64, sdk.Coins) {
@@ -309,6 +326,133 @@ func (suite *AnteTestSuite) TestCustomAnteHandlerExecutionFee() {
 			false,
 			errors.New("invalid transaction type on poor network: invalid request"),
 		},
+		{
+			"try sending more bond denom than restricted amount with exec on poor network",
+			func() ([]sdk.Msg, []cryptotypes.PrivKey, []uint64, []uint64, sdk.Coins) {
+				suite.app.CustomGovKeeper.SetNetworkProperties(suite.ctx, &customgovtypes.NetworkProperties{
+					MinTxFee:                 2,
+					MaxTxFee:                 10000,
+					EnableForeignFeePayments: true,
+					MinValidators:            100,
+					PoorNetworkMaxBankSend:   1000,
+				})
+				execMsg, err := customgovtypes.NewMsgExec(accounts[4].acc.GetAddress(), []sdk.Msg{
+					bank.NewMsgSend(
+						accounts[3].acc.GetAddress(),
+						accounts[4].acc.GetAddress(),
+						sdk.NewCoins(sdk.NewInt64Coin("ukex", 10000000)),
+					),
+				})
+				suite.Require().NoError(err)
+				return []sdk.Msg{execMsg}, privs[4:5], accNums[4:5], []uint64{0}, sdk.NewCoins(sdk.NewInt64Coin("ubtc", 10))
+			},
+			false,
+			false,
+			errors.New("only restricted amount send is allowed on poor network: invalid request"),
+		},
+		{
+			"try sending more bond denom than restricted amount with a group proposal on poor network",
+			func() ([]sdk.Msg, []cryptotypes.PrivKey, []uint64, []uint64, sdk.Coins) {
+				suite.app.CustomGovKeeper.SetNetworkProperties(suite.ctx, &customgovtypes.NetworkProperties{
+					MinTxFee:                 2,
+					MaxTxFee:                 10000,
+					EnableForeignFeePayments: true,
+					MinValidators:            100,
+					PoorNetworkMaxBankSend:   1000,
+				})
+				proposalMsg, err := customgovtypes.NewMsgSubmitGroupProposal(accounts[4].acc.GetAddress(), 1, []sdk.Msg{
+					bank.NewMsgSend(
+						customgovtypes.GroupAddress(1),
+						accounts[4].acc.GetAddress(),
+						sdk.NewCoins(sdk.NewInt64Coin("ukex", 10000000)),
+					),
+				})
+				suite.Require().NoError(err)
+				return []sdk.Msg{proposalMsg}, privs[4:5], accNums[4:5], []uint64{0}, sdk.NewCoins(sdk.NewInt64Coin("ubtc", 10))
+			},
+			false,
+			false,
+			errors.New("only restricted amount send is allowed on poor network: invalid request"),
+		},
+		{
+			"try executing not enabled message with exec on poor network",
+			func() ([]sdk.Msg, []cryptotypes.PrivKey, []uint64, []uint64, sdk.Coins) {
+				suite.app.CustomGovKeeper.SetNetworkProperties(suite.ctx, &customgovtypes.NetworkProperties{
+					MinTxFee:                 2,
+					MaxTxFee:                 10000,
+					EnableForeignFeePayments: true,
+					MinValidators:            100,
+				})
+				// MsgExec is enabled on poor network, the messages it executes are checked as if they were sent directly
+				execMsg, err := customgovtypes.NewMsgExec(accounts[4].acc.GetAddress(), []sdk.Msg{
+					customgovtypes.NewMsgSetExecutionFee(
+						types.MsgTypeSetNetworkProperties,
+						types.MsgTypeSetNetworkProperties,
+						10000,
+						1000,
+						0,
+						0,
+						accounts[3].acc.GetAddress(),
+					),
+				})
+				suite.Require().NoError(err)
+				return []sdk.Msg{execMsg}, privs[4:5], accNums[4:5], []uint64{0}, sdk.NewCoins(sdk.NewInt64Coin("ubtc", 10))
+			},
+			false,
+			false,
+			errors.New("invalid transaction type on poor network: invalid request"),
+		},
+		{
+			"try proposing not enabled message to a group on poor network",
+			func() ([]sdk.Msg, []cryptotypes.PrivKey, []uint64, []uint64, sdk.Coins) {
+				suite.app.CustomGovKeeper.SetNetworkProperties(suite.ctx, &customgovtypes.NetworkProperties{
+					MinTxFee:                 2,
+					MaxTxFee:                 10000,
+					EnableForeignFeePayments: true,
+					MinValidators:            100,
+				})
+				proposalMsg, err := customgovtypes.NewMsgSubmitGroupProposal(accounts[4].acc.GetAddress(), 1, []sdk.Msg{
+					customgovtypes.NewMsgSetExecutionFee(
+						types.MsgTypeSetNetworkProperties,
+						types.MsgTypeSetNetworkProperties,
+						10000,
+						1000,
+						0,
+						0,
+						customgovtypes.GroupAddress(1),
+					),
+				})
+				suite.Require().NoError(err)
+				return []sdk.Msg{proposalMsg}, privs[4:5], accNums[4:5], []uint64{0}, sdk.NewCoins(sdk.NewInt64Coin("ubtc", 10))
+			},
+			false,
+			false,
+			errors.New("invalid transaction type on poor network: invalid request"),
+		},
+		{
+			"try sending frozen token with exec",
+			func() ([]sdk.Msg, []cryptotypes.PrivKey, []uint64, []uint64, sdk.Coins) {
+				suite.app.CustomGovKeeper.SetNetworkProperties(suite.ctx, &customgovtypes.NetworkProperties{
+					MinTxFee:                 2,
+					MaxTxFee:                 10000,
+					EnableForeignFeePayments: true,
+					EnableTokenBlacklist:     true,
+				})
+				suite.app.TokensKeeper.AddTokensToBlacklist(suite.ctx, []string{"frozen"})
+				execMsg, err := customgovtypes.NewMsgExec(accounts[4].acc.GetAddress(), []sdk.Msg{
+					bank.NewMsgSend(
+						accounts[3].acc.GetAddress(),
+						accounts[4].acc.GetAddress(),
+						sdk.NewCoins(sdk.NewInt64Coin("frozen", 10)),
+					),
+				})
+				suite.Require().NoError(err)
+				return []sdk.Msg{execMsg}, privs[4:5], accNums[4:5], []uint64{0}, sdk.NewCoins(sdk.NewInt64Coin("ubtc", 10))
+			},
+			false,
+			false,
+			errors.New("token is frozen: invalid request"),
+		},
 	}
 
 	for _, tc := range testCases {
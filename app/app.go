@@ -8,6 +8,10 @@ import (
 
 	customante "github.com/KiraCore/sekai/app/ante"
 	"github.com/KiraCore/sekai/middleware"
+	kiratypes "github.com/KiraCore/sekai/types"
+	"github.com/KiraCore/sekai/x/distributor"
+	distributorkeeper "github.com/KiraCore/sekai/x/distributor/keeper"
+	distributortypes "github.com/KiraCore/sekai/x/distributor/types"
 	"github.com/KiraCore/sekai/x/evidence"
 	evidencekeeper "github.com/KiraCore/sekai/x/evidence/keeper"
 	evidencetypes "github.com/KiraCore/sekai/x/evidence/types"
@@ -38,6 +42,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -87,11 +92,16 @@ var (
 		evidence.AppModuleBasic{},
 		tokens.AppModuleBasic{},
 		feeprocessing.AppModuleBasic{},
+		distributor.AppModuleBasic{},
 	)
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName: nil,
+		authtypes.FeeCollectorName:           nil,
+		customstakingtypes.BondedPoolName:    {authtypes.Burner},
+		customstakingtypes.NotBondedPoolName: {authtypes.Burner},
+		distributortypes.ModuleName:          {authtypes.Minter},
+		customgovtypes.ModuleName:            {authtypes.Burner},
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -123,6 +133,7 @@ type SekaiApp struct {
 	customGovKeeper      customgovkeeper.Keeper
 	tokensKeeper         tokenskeeper.Keeper
 	feeprocessingKeeper  feeprocessingkeeper.Keeper
+	distributorKeeper    distributorkeeper.Keeper
 	evidenceKeeper       evidencekeeper.Keeper
 
 	// Module Manager
@@ -168,6 +179,7 @@ func NewInitApp(
 		customgovtypes.ModuleName,
 		tokenstypes.ModuleName,
 		feeprocessingtypes.ModuleName,
+		distributortypes.ModuleName,
 		evidencetypes.StoreKey,
 	)
 	tKeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
@@ -197,16 +209,20 @@ func NewInitApp(
 	)
 	app.upgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath)
 
-	app.customGovKeeper = customgovkeeper.NewKeeper(keys[customgovtypes.ModuleName], appCodec)
-	customStakingKeeper := customstakingkeeper.NewKeeper(keys[customstakingtypes.ModuleName], cdc, app.customGovKeeper)
+	app.customGovKeeper = customgovkeeper.NewKeeper(keys[customgovtypes.ModuleName], appCodec, app.bankKeeper, app.paramsKeeper)
+	customStakingKeeper := customstakingkeeper.NewKeeper(keys[customstakingtypes.ModuleName], cdc, app.bankKeeper, app.customGovKeeper)
 	app.customSlashingKeeper = customslashingkeeper.NewKeeper(
 		appCodec, keys[customslashingtypes.StoreKey], &customStakingKeeper, app.GetSubspace(customslashingtypes.ModuleName),
 	)
 	app.tokensKeeper = tokenskeeper.NewKeeper(keys[tokenstypes.ModuleName], appCodec)
+	app.distributorKeeper = distributorkeeper.NewKeeper(keys[distributortypes.ModuleName], appCodec, app.bankKeeper, &customStakingKeeper)
 	// NOTE: customStakingKeeper above is passed by reference, so that it will contain these hooks
 	app.customStakingKeeper = *customStakingKeeper.SetHooks(
-		customstakingtypes.NewMultiStakingHooks(app.customSlashingKeeper.Hooks()),
+		customstakingtypes.NewMultiStakingHooks(app.customSlashingKeeper.Hooks(), app.distributorKeeper.Hooks()),
 	)
+	app.customGovKeeper = *app.customGovKeeper.SetStakingKeeper(app.customStakingKeeper)
+	// the routes are registered on the router by the module manager below
+	app.customGovKeeper = *app.customGovKeeper.SetMsgRouter(app.Router())
 
 	app.feeprocessingKeeper = feeprocessingkeeper.NewKeeper(keys[feeprocessingtypes.ModuleName], appCodec, app.bankKeeper, app.tokensKeeper, app.customGovKeeper)
 
@@ -243,10 +259,16 @@ func NewInitApp(
 				tokens.NewApplyWhiteBlackChangeProposalHandler(app.tokensKeeper),
 				customstaking.NewApplyUnjailValidatorProposalHandler(app.customStakingKeeper),
 				customgov.NewApplyCreateRoleProposalHandler(app.customGovKeeper),
+				customgov.NewApplySetRoleVoteWeightProposalHandler(app.customGovKeeper),
+				customgov.NewApplyParameterChangeProposalHandler(app.customGovKeeper),
+				customgov.NewApplySoftwareUpgradeProposalHandler(app.upgradeKeeper),
+				customgov.NewApplyCancelSoftwareUpgradeProposalHandler(app.upgradeKeeper),
+				customgov.NewApplyRemoveCouncilorProposalHandler(app.customGovKeeper),
 			},
 		)),
 		tokens.NewAppModule(app.tokensKeeper, app.customGovKeeper),
 		feeprocessing.NewAppModule(app.feeprocessingKeeper),
+		distributor.NewAppModule(app.distributorKeeper),
 		evidence.NewAppModule(app.evidenceKeeper),
 	)
 
@@ -254,7 +276,7 @@ func NewInitApp(
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
 	app.mm.SetOrderBeginBlockers(
-		upgradetypes.ModuleName, customslashingtypes.ModuleName,
+		upgradetypes.ModuleName, distributortypes.ModuleName, customslashingtypes.ModuleName,
 		evidencetypes.ModuleName, customstakingtypes.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
@@ -276,12 +298,14 @@ func NewInitApp(
 		customgovtypes.ModuleName,
 		tokenstypes.ModuleName,
 		feeprocessingtypes.ModuleName,
+		distributortypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 	)
 
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 	app.mm.RegisterServices(module.NewConfigurator(app.MsgServiceRouter(), app.GRPCQueryRouter()))
+	kiratypes.RegisterUpgradeHandlers(app.mm, app.upgradeKeeper)
 
 	// add test gRPC service for testing gRPC queries in isolation
 	testdata.RegisterQueryServer(app.GRPCQueryRouter(), testdata.QueryImpl{})
@@ -304,6 +328,13 @@ func NewInitApp(
 	app.MountKVStores(keys)
 	app.MountTransientStores(tKeys)
 
+	// the stores added by the upgrades are created at the upgrade height
+	if err := kiratypes.SetUpgradeStoreLoader(app, app.upgradeKeeper, map[string]*storetypes.StoreUpgrades{
+		kiratypes.UpgradeV0119: {Added: []string{distributortypes.ModuleName}},
+	}); err != nil {
+		tmos.Exit(err.Error())
+	}
+
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
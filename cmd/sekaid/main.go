@@ -182,6 +182,13 @@ func queryCommand() *cobra.Command {
 	)
 
 	app.ModuleBasics.AddQueryCommands(cmd)
+	cmd.AddCommand(
+		customstaking.GetCmdQueryDelegations(),
+		customstaking.GetCmdQueryValidatorDelegations(),
+		customstaking.GetCmdQueryUnbondingDelegations(),
+		customstaking.GetCmdQueryStandbyValidators(),
+		customstaking.GetCmdQueryValidatorHistory(),
+	)
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
 
 	return cmd
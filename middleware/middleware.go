@@ -2,8 +2,10 @@ package middleware
 
 import (
 	feeprocessingkeeper "github.com/KiraCore/sekai/x/feeprocessing/keeper"
+	feeprocessingtypes "github.com/KiraCore/sekai/x/feeprocessing/types"
 	customgovkeeper "github.com/KiraCore/sekai/x/gov/keeper"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var (
@@ -20,6 +22,7 @@ func SetKeepers(cgk customgovkeeper.Keeper, fk feeprocessingkeeper.Keeper) {
 // NewRoute returns an instance of Route.
 func NewRoute(p string, h sdk.Handler) sdk.Route {
 	newHandler := func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		gasBefore := ctx.GasMeter().GasConsumed()
 		hResult, hErr := h(ctx, msg)
 		if hErr != nil {
 			return hResult, hErr
@@ -31,6 +34,12 @@ func NewRoute(p string, h sdk.Handler) sdk.Route {
 			return hResult, hErr
 		}
 
+		// the timeout is the gas the handler can consume, an execution timing out fails and is charged the failure fee
+		gasUsed := ctx.GasMeter().GasConsumed() - gasBefore
+		if fee.Timeout != 0 && gasUsed > fee.Timeout {
+			return nil, errors.Wrapf(feeprocessingtypes.ErrExecutionTimeout, "%s consumed %d gas, more than its timeout %d", msg.Type(), gasUsed, fee.Timeout)
+		}
+
 		feeprocessingKeeper.SetExecutionStatusSuccess(ctx, msg)
 		return hResult, hErr
 	}
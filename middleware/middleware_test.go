@@ -32,6 +32,7 @@ func TestNewHandler_SetNetworkProperties(t *testing.T) {
 	tests := []struct {
 		name       string
 		msg        sdk.Msg
+		timeout    uint64
 		desiredErr string
 	}{
 		{
@@ -43,6 +44,7 @@ func TestNewHandler_SetNetworkProperties(t *testing.T) {
 				},
 				Proposer: changeFeeAddr,
 			},
+			timeout:    200000,
 			desiredErr: "",
 		},
 		{
@@ -54,8 +56,21 @@ func TestNewHandler_SetNetworkProperties(t *testing.T) {
 				},
 				Proposer: sudoAddr,
 			},
+			timeout:    200000,
 			desiredErr: "not enough permissions",
 		},
+		{
+			name: "Failure run consuming more gas than the timeout",
+			msg: &customgovtypes.MsgSetNetworkProperties{
+				NetworkProperties: &customgovtypes.NetworkProperties{
+					MinTxFee: 100,
+					MaxTxFee: 1000,
+				},
+				Proposer: changeFeeAddr,
+			},
+			timeout:    1,
+			desiredErr: "execution timed out",
+		},
 	}
 
 	for _, tt := range tests {
@@ -76,7 +91,7 @@ func TestNewHandler_SetNetworkProperties(t *testing.T) {
 			handler := gov.NewHandler(app.CustomGovKeeper)
 
 			// set change fee permission to addr
-			_, err = handler(ctx, &customgovtypes.MsgWhitelistPermissions{
+			_, err := handler(ctx, &customgovtypes.MsgWhitelistPermissions{
 				Proposer:   sudoAddr,
 				Address:    changeFeeAddr,
 				Permission: uint32(customgovtypes.PermChangeTxFee),
@@ -90,12 +105,12 @@ func TestNewHandler_SetNetworkProperties(t *testing.T) {
 				TransactionType:   types.MsgTypeSetNetworkProperties,
 				ExecutionFee:      10000,
 				FailureFee:        1000,
-				Timeout:           1,
+				Timeout:           tt.timeout,
 				DefaultParameters: 2,
 			})
 			require.NoError(t, err)
 
-			app.FeeProcessingKeeper.AddExecutionStart(ctx, tt.msg)
+			app.FeeProcessingKeeper.AddExecutionStart(ctx, 0, tt.msg, tt.msg.GetSigners()[0])
 
 			// test message with new middleware handler
 			newHandler := middleware.NewRoute(customgovtypes.ModuleName, gov.NewHandler(app.CustomGovKeeper)).Handler()
@@ -21,6 +21,7 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -51,6 +52,10 @@ import (
 
 	customante "github.com/KiraCore/sekai/app/ante"
 	"github.com/KiraCore/sekai/middleware"
+	kiratypes "github.com/KiraCore/sekai/types"
+	"github.com/KiraCore/sekai/x/distributor"
+	distributorkeeper "github.com/KiraCore/sekai/x/distributor/keeper"
+	distributortypes "github.com/KiraCore/sekai/x/distributor/types"
 	"github.com/KiraCore/sekai/x/evidence"
 	evidencekeeper "github.com/KiraCore/sekai/x/evidence/keeper"
 	evidencetypes "github.com/KiraCore/sekai/x/evidence/types"
@@ -92,12 +97,17 @@ var (
 		customgov.AppModuleBasic{},
 		tokens.AppModuleBasic{},
 		feeprocessing.AppModuleBasic{},
+		distributor.AppModuleBasic{},
 		evidence.AppModuleBasic{},
 	)
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName: nil,
+		authtypes.FeeCollectorName:           nil,
+		customstakingtypes.BondedPoolName:    {authtypes.Burner},
+		customstakingtypes.NotBondedPoolName: {authtypes.Burner},
+		distributortypes.ModuleName:          {authtypes.Minter},
+		customgovtypes.ModuleName:            {authtypes.Burner},
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -136,6 +146,7 @@ type SimApp struct {
 	CustomGovKeeper      customgovkeeper.Keeper
 	TokensKeeper         tokenskeeper.Keeper
 	FeeProcessingKeeper  feeprocessingkeeper.Keeper
+	DistributorKeeper    distributorkeeper.Keeper
 	EvidenceKeeper       evidencekeeper.Keeper
 
 	ProposalRouter customgov.ProposalRouter
@@ -174,10 +185,10 @@ func NewSimApp(
 	bApp.SetInterfaceRegistry(interfaceRegistry)
 
 	keys := sdk.NewKVStoreKeys(
-		authtypes.StoreKey, banktypes.StoreKey, paramstypes.StoreKey,
+		authtypes.StoreKey, banktypes.StoreKey, paramstypes.StoreKey, upgradetypes.StoreKey,
 		customstakingtypes.ModuleName, customslashingtypes.ModuleName, customgovtypes.ModuleName,
 		customgovtypes.ModuleName, tokenstypes.ModuleName, feeprocessingtypes.ModuleName,
-		evidencetypes.StoreKey,
+		distributortypes.ModuleName, evidencetypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 
@@ -196,14 +207,6 @@ func NewSimApp(
 	// set the BaseApp's parameter store
 	bApp.SetParamStore(app.ParamsKeeper.Subspace(baseapp.Paramspace).WithKeyTable(paramskeeper.ConsensusParamsKeyTable()))
 
-	app.CustomGovKeeper = customgovkeeper.NewKeeper(keys[customgovtypes.ModuleName], appCodec)
-	customStakingKeeper := keeper.NewKeeper(keys[customstakingtypes.ModuleName], legacyAmino, app.CustomGovKeeper)
-	app.CustomSlashingKeeper = customslashingkeeper.NewKeeper(appCodec, keys[customslashingtypes.ModuleName], &customStakingKeeper, app.GetSubspace(customslashingtypes.ModuleName))
-	app.TokensKeeper = tokenskeeper.NewKeeper(keys[tokenstypes.ModuleName], appCodec)
-	app.CustomStakingKeeper = *customStakingKeeper.SetHooks(
-		customstakingtypes.NewMultiStakingHooks(app.CustomSlashingKeeper.Hooks()),
-	)
-
 	// add keepers
 	app.AccountKeeper = authkeeper.NewAccountKeeper(
 		appCodec, keys[authtypes.StoreKey], app.GetSubspace(authtypes.ModuleName), authtypes.ProtoBaseAccount, maccPerms,
@@ -211,6 +214,18 @@ func NewSimApp(
 	app.BankKeeper = bankkeeper.NewBaseKeeper(
 		appCodec, keys[banktypes.StoreKey], app.AccountKeeper, app.GetSubspace(banktypes.ModuleName), app.BlockedAddrs(),
 	)
+	app.CustomGovKeeper = customgovkeeper.NewKeeper(keys[customgovtypes.ModuleName], appCodec, app.BankKeeper, app.ParamsKeeper)
+	customStakingKeeper := keeper.NewKeeper(keys[customstakingtypes.ModuleName], legacyAmino, app.BankKeeper, app.CustomGovKeeper)
+	app.CustomSlashingKeeper = customslashingkeeper.NewKeeper(appCodec, keys[customslashingtypes.ModuleName], &customStakingKeeper, app.GetSubspace(customslashingtypes.ModuleName))
+	app.TokensKeeper = tokenskeeper.NewKeeper(keys[tokenstypes.ModuleName], appCodec)
+	app.DistributorKeeper = distributorkeeper.NewKeeper(keys[distributortypes.ModuleName], appCodec, app.BankKeeper, &customStakingKeeper)
+	app.CustomStakingKeeper = *customStakingKeeper.SetHooks(
+		customstakingtypes.NewMultiStakingHooks(app.CustomSlashingKeeper.Hooks(), app.DistributorKeeper.Hooks()),
+	)
+	app.CustomGovKeeper = *app.CustomGovKeeper.SetStakingKeeper(app.CustomStakingKeeper)
+	// the routes are registered on the router by the module manager below
+	app.CustomGovKeeper = *app.CustomGovKeeper.SetMsgRouter(app.Router())
+
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath)
 	app.FeeProcessingKeeper = feeprocessingkeeper.NewKeeper(keys[feeprocessingtypes.ModuleName], appCodec, app.BankKeeper, app.TokensKeeper, app.CustomGovKeeper)
 
@@ -235,6 +250,11 @@ func NewSimApp(
 			tokens.NewApplyUpsertTokenRatesProposalHandler(app.TokensKeeper),
 			customstaking.NewApplyUnjailValidatorProposalHandler(app.CustomStakingKeeper),
 			customgov.NewApplyCreateRoleProposalHandler(app.CustomGovKeeper),
+			customgov.NewApplySetRoleVoteWeightProposalHandler(app.CustomGovKeeper),
+			customgov.NewApplyParameterChangeProposalHandler(app.CustomGovKeeper),
+			customgov.NewApplySoftwareUpgradeProposalHandler(app.UpgradeKeeper),
+			customgov.NewApplyCancelSoftwareUpgradeProposalHandler(app.UpgradeKeeper),
+			customgov.NewApplyRemoveCouncilorProposalHandler(app.CustomGovKeeper),
 		},
 	)
 	app.mm = module.NewManager(
@@ -246,6 +266,7 @@ func NewSimApp(
 		customgov.NewAppModule(app.CustomGovKeeper, app.ProposalRouter),
 		tokens.NewAppModule(app.TokensKeeper, app.CustomGovKeeper),
 		feeprocessing.NewAppModule(app.FeeProcessingKeeper),
+		distributor.NewAppModule(app.DistributorKeeper),
 		evidence.NewAppModule(app.EvidenceKeeper),
 	)
 
@@ -254,7 +275,7 @@ func NewSimApp(
 	// CanWithdrawInvariant invariant.
 	// NOTE: staking module is required if HistoricalEntries param > 0
 	app.mm.SetOrderBeginBlockers(
-		upgradetypes.ModuleName, customslashingtypes.ModuleName, evidencetypes.ModuleName,
+		upgradetypes.ModuleName, distributortypes.ModuleName, customslashingtypes.ModuleName, evidencetypes.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
 		customgovtypes.ModuleName,
@@ -270,11 +291,12 @@ func NewSimApp(
 	app.mm.SetOrderInitGenesis(
 		authtypes.ModuleName, banktypes.ModuleName, banktypes.ModuleName,
 		customgovtypes.ModuleName, customslashingtypes.ModuleName,
-		tokenstypes.ModuleName, feeprocessingtypes.ModuleName, evidencetypes.ModuleName,
+		tokenstypes.ModuleName, feeprocessingtypes.ModuleName, distributortypes.ModuleName, evidencetypes.ModuleName,
 	)
 
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 	app.mm.RegisterServices(module.NewConfigurator(app.MsgServiceRouter(), app.GRPCQueryRouter()))
+	kiratypes.RegisterUpgradeHandlers(app.mm, app.UpgradeKeeper)
 
 	// add test gRPC service for testing gRPC queries in isolation
 	testdata.RegisterQueryServer(app.GRPCQueryRouter(), testdata.QueryImpl{})
@@ -296,6 +318,13 @@ func NewSimApp(
 	app.MountKVStores(keys)
 	app.MountTransientStores(tkeys)
 
+	// the stores added by the upgrades are created at the upgrade height
+	if err := kiratypes.SetUpgradeStoreLoader(app, app.UpgradeKeeper, map[string]*storetypes.StoreUpgrades{
+		kiratypes.UpgradeV0119: {Added: []string{distributortypes.ModuleName}},
+	}); err != nil {
+		tmos.Exit(err.Error())
+	}
+
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
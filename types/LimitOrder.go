@@ -13,7 +13,7 @@ type LimitOrder struct {
 	OrderType   uint8  `json:"order_type"`
 	Amount      int64  `json:"amount"`
 	LimitPrice  int64  `json:"limit_price"`
-	ExpiryTime  int64  `json:"curator"`
+	ExpiryTime  int64  `json:"expiry_time"`
 	IsCancelled bool   `json:"is_cancelled"`
 	Curator 	sdk.AccAddress `json:"curator"`
 }
@@ -7,16 +7,34 @@ import (
 // custom msg types
 const (
 	// governance
-	MsgTypeProposalSetNetworkProperty = "proposal-set-network-property"
-	MsgTypeProposalAssignPermission   = "proposal-assign-permission"
-	MsgTypeProposalUpsertDataRegistry = "proposal-upsert-data-registry"
-	MsgTypeProposalUpsertTokenAlias   = "proposal-upsert-token-alias"
-	MsgTypeProposalSetPoorNetworkMsgs = "proposal-set-poor-network-messages"
-	MsgTypeProposalCreateRole         = "proposal-create-role"
-	MsgTypeVoteProposal               = "vote-proposal"
+	MsgTypeProposalSetNetworkProperty    = "proposal-set-network-property"
+	MsgTypeProposalAssignPermission      = "proposal-assign-permission"
+	MsgTypeProposalUpsertDataRegistry    = "proposal-upsert-data-registry"
+	MsgTypeProposalUpsertTokenAlias      = "proposal-upsert-token-alias"
+	MsgTypeProposalSetPoorNetworkMsgs    = "proposal-set-poor-network-messages"
+	MsgTypeProposalCreateRole            = "proposal-create-role"
+	MsgTypeProposalSetRoleVoteWeight     = "proposal-set-role-vote-weight"
+	MsgTypeProposalParameterChange       = "proposal-parameter-change"
+	MsgTypeProposalMultiContent          = "proposal-multi-content"
+	MsgTypeProposalSoftwareUpgrade       = "proposal-software-upgrade"
+	MsgTypeProposalCancelSoftwareUpgrade = "proposal-cancel-software-upgrade"
+	MsgTypeProposalRemoveCouncilor       = "proposal-remove-councilor"
+	MsgTypeVoteProposal                  = "vote-proposal"
+	MsgTypeCancelProposal                = "cancel-proposal"
 
 	MsgTypeWhitelistPermissions = "whitelist-permissions"
 	MsgTypeBlacklistPermissions = "blacklist-permissions"
+	MsgTypeDelegatePermission   = "delegate-permission"
+	MsgTypeRevokePermission     = "revoke-permission"
+
+	MsgTypeGrantAuthorization  = "grant-authorization"
+	MsgTypeRevokeAuthorization = "revoke-authorization"
+	MsgTypeExec                = "exec"
+
+	MsgTypeCreateGroup          = "create-group"
+	MsgTypeUpdateGroup          = "update-group"
+	MsgTypeSubmitGroupProposal  = "submit-group-proposal"
+	MsgTypeApproveGroupProposal = "approve-group-proposal"
 
 	MsgTypeClaimCouncilor       = "claim-councilor"
 	MsgTypeSetNetworkProperties = "set-network-properties"
@@ -34,6 +52,13 @@ const (
 	// staking module
 	MsgTypeClaimValidator          = "claim-validator"
 	MsgTypeProposalUnjailValidator = "proposal-unjail-validator"
+	MsgTypeDelegate                = "delegate"
+	MsgTypeUndelegate              = "undelegate"
+	MsgTypeRedelegate              = "redelegate"
+	MsgTypeUnjail                  = "unjail"
+	MsgTypeEditValidator           = "edit-validator"
+	MsgTypeRotateConsensusKey      = "rotate-consensus-key"
+	MsgTypeExitValidator           = "exit-validator"
 
 	// tokens module
 	MsgTypeUpsertTokenAlias               = "upsert-token-alias"
@@ -46,6 +71,9 @@ const (
 	MsgTypeActivate = "activate"
 	MsgTypePause    = "pause"
 	MsgTypeUnpause  = "unpause"
+
+	// distributor module
+	MsgTypeWithdrawRewards = "withdraw-rewards"
 )
 
 // MsgFuncIDMapping defines function_id mapping
@@ -79,4 +107,28 @@ var MsgFuncIDMapping = map[string]int64{
 	MsgTypePause:                          27,
 	MsgTypeUnpause:                        28,
 	MsgTypeProposalUnjailValidator:        29,
+	MsgTypeDelegate:                       30,
+	MsgTypeUndelegate:                     31,
+	MsgTypeRedelegate:                     32,
+	MsgTypeWithdrawRewards:                33,
+	MsgTypeProposalSetRoleVoteWeight:      34,
+	MsgTypeCancelProposal:                 35,
+	MsgTypeProposalParameterChange:        36,
+	MsgTypeProposalMultiContent:           37,
+	MsgTypeProposalSoftwareUpgrade:        38,
+	MsgTypeProposalCancelSoftwareUpgrade:  39,
+	MsgTypeProposalRemoveCouncilor:        40,
+	MsgTypeDelegatePermission:             41,
+	MsgTypeRevokePermission:               42,
+	MsgTypeGrantAuthorization:             43,
+	MsgTypeRevokeAuthorization:            44,
+	MsgTypeExec:                           45,
+	MsgTypeCreateGroup:                    46,
+	MsgTypeUpdateGroup:                    47,
+	MsgTypeSubmitGroupProposal:            48,
+	MsgTypeApproveGroupProposal:           49,
+	MsgTypeUnjail:                         50,
+	MsgTypeEditValidator:                  51,
+	MsgTypeRotateConsensusKey:             52,
+	MsgTypeExitValidator:                  53,
 }
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrationHandler rewrites the store of a module when the upgrade it is registered for is applied.
+type MigrationHandler func(ctx sdk.Context) error
+
+// HasMigrations is implemented by the app modules rewriting their store layout at upgrade heights.
+type HasMigrations interface {
+	RegisterMigrations(registry *MigrationRegistry)
+}
+
+type migration struct {
+	module  string
+	handler MigrationHandler
+}
+
+// MigrationRegistry holds the store migrations of the modules by upgrade name.
+type MigrationRegistry struct {
+	upgrades   []string
+	migrations map[string][]migration
+}
+
+// NewMigrationRegistry returns an empty migration registry.
+func NewMigrationRegistry() *MigrationRegistry {
+	return &MigrationRegistry{
+		migrations: make(map[string][]migration),
+	}
+}
+
+// AddUpgrade adds an upgrade handled by the software, an upgrade without migrations only swaps the binary.
+func (r *MigrationRegistry) AddUpgrade(upgradeName string) {
+	if _, ok := r.migrations[upgradeName]; ok {
+		return
+	}
+
+	r.upgrades = append(r.upgrades, upgradeName)
+	r.migrations[upgradeName] = []migration{}
+}
+
+// Register adds the migration of the module to the upgrade, the migrations of an upgrade run in registration order.
+func (r *MigrationRegistry) Register(upgradeName string, module string, handler MigrationHandler) {
+	r.AddUpgrade(upgradeName)
+	r.migrations[upgradeName] = append(r.migrations[upgradeName], migration{module: module, handler: handler})
+}
+
+// Upgrades returns the names of the upgrades in the order they were added.
+func (r *MigrationRegistry) Upgrades() []string {
+	return r.upgrades
+}
+
+// Migrate runs the migrations of the upgrade and stops at the first one failing.
+func (r *MigrationRegistry) Migrate(ctx sdk.Context, upgradeName string) error {
+	migrations, ok := r.migrations[upgradeName]
+	if !ok {
+		return fmt.Errorf("unknown upgrade %s", upgradeName)
+	}
+
+	for _, m := range migrations {
+		if err := m.handler(ctx); err != nil {
+			return fmt.Errorf("error migrating %s store for upgrade %s: %w", m.module, upgradeName, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrationRegistry_Upgrades(t *testing.T) {
+	registry := NewMigrationRegistry()
+	registry.AddUpgrade("v2")
+	registry.Register("v1", "gov", func(ctx sdk.Context) error { return nil })
+	registry.Register("v2", "tokens", func(ctx sdk.Context) error { return nil })
+	registry.AddUpgrade("v1")
+
+	require.Equal(t, []string{"v2", "v1"}, registry.Upgrades())
+}
+
+func TestMigrationRegistry_Migrate(t *testing.T) {
+	var migrated []string
+	migrate := func(module string, err error) MigrationHandler {
+		return func(ctx sdk.Context) error {
+			migrated = append(migrated, module)
+			return err
+		}
+	}
+
+	registry := NewMigrationRegistry()
+	registry.AddUpgrade("v1")
+	registry.Register("v2", "gov", migrate("gov", nil))
+	registry.Register("v2", "tokens", migrate("tokens", nil))
+	registry.Register("v3", "gov", migrate("gov", errors.New("invalid store")))
+	registry.Register("v3", "tokens", migrate("tokens", nil))
+
+	// an upgrade without migrations only swaps the binary
+	require.NoError(t, registry.Migrate(sdk.Context{}, "v1"))
+	require.Empty(t, migrated)
+
+	// migrations run in registration order
+	require.NoError(t, registry.Migrate(sdk.Context{}, "v2"))
+	require.Equal(t, []string{"gov", "tokens"}, migrated)
+
+	// the first failing migration stops the upgrade
+	migrated = nil
+	err := registry.Migrate(sdk.Context{}, "v3")
+	require.EqualError(t, err, "error migrating gov store for upgrade v3: invalid store")
+	require.Equal(t, []string{"gov"}, migrated)
+
+	err = registry.Migrate(sdk.Context{}, "v4")
+	require.EqualError(t, err, "unknown upgrade v4")
+}
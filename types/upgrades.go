@@ -0,0 +1,79 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// UpgradeV0119 is the upgrade migrating the stores of the chains running v0.1.18.
+const UpgradeV0119 = "v0.1.19"
+
+// upgrades lists the upgrades handled by this version of the software which have no store migration,
+// the upgrades the modules register migrations for are handled as well.
+var upgrades = []string{}
+
+// UpgradeHandlerSetter is implemented by the upgrade keeper.
+type UpgradeHandlerSetter interface {
+	SetUpgradeHandler(name string, upgradeHandler upgradetypes.UpgradeHandler)
+}
+
+// UpgradeInfoReader is implemented by the upgrade keeper.
+type UpgradeInfoReader interface {
+	ReadUpgradeInfoFromDisk() (storetypes.UpgradeInfo, error)
+	IsSkipHeight(height int64) bool
+}
+
+// StoreLoaderSetter is implemented by the base app.
+type StoreLoaderSetter interface {
+	SetStoreLoader(loader baseapp.StoreLoader)
+}
+
+// SetUpgradeStoreLoader sets the loader adding, renaming and deleting the stores changed by the upgrade the node
+// halted for, storeUpgrades holds those changes by upgrade name. A store added by an upgrade has no version before
+// the upgrade height, the node can not load it when it restarts with the new software unless it is declared here.
+// It must be called before the stores are loaded.
+func SetUpgradeStoreLoader(app StoreLoaderSetter, upgradeKeeper UpgradeInfoReader, storeUpgrades map[string]*storetypes.StoreUpgrades) error {
+	upgradeInfo, err := upgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		return err
+	}
+
+	if upgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return nil
+	}
+
+	if upgrade, ok := storeUpgrades[upgradeInfo.Name]; ok {
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, upgrade))
+	}
+
+	return nil
+}
+
+// RegisterUpgradeHandlers sets the handler of every upgrade handled by the software. When the height of a scheduled
+// upgrade is reached, the upgrade module halts the chain unless the running software has a handler for it.
+func RegisterUpgradeHandlers(mm *module.Manager, upgradeKeeper UpgradeHandlerSetter) *MigrationRegistry {
+	registry := NewMigrationRegistry()
+	for _, name := range upgrades {
+		registry.AddUpgrade(name)
+	}
+
+	// modules register their migrations in genesis order so that the migrations run in a deterministic order
+	for _, moduleName := range mm.OrderInitGenesis {
+		if m, ok := mm.Modules[moduleName].(HasMigrations); ok {
+			m.RegisterMigrations(registry)
+		}
+	}
+
+	for _, name := range registry.Upgrades() {
+		upgradeKeeper.SetUpgradeHandler(name, func(ctx sdk.Context, plan upgradetypes.Plan) {
+			if err := registry.Migrate(ctx, plan.Name); err != nil {
+				panic(err)
+			}
+		})
+	}
+
+	return registry
+}
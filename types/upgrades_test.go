@@ -0,0 +1,94 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+type upgradeInfoReader struct {
+	info        storetypes.UpgradeInfo
+	skipHeights map[int64]bool
+}
+
+func (r upgradeInfoReader) ReadUpgradeInfoFromDisk() (storetypes.UpgradeInfo, error) {
+	return r.info, nil
+}
+
+func (r upgradeInfoReader) IsSkipHeight(height int64) bool {
+	return r.skipHeights[height]
+}
+
+type storeLoaderSetter struct {
+	loader baseapp.StoreLoader
+}
+
+func (s *storeLoaderSetter) SetStoreLoader(loader baseapp.StoreLoader) {
+	s.loader = loader
+}
+
+func newMultiStore(db dbm.DB, keys ...*sdk.KVStoreKey) *rootmulti.Store {
+	ms := rootmulti.NewStore(db)
+	for _, key := range keys {
+		ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	}
+
+	return ms
+}
+
+func TestSetUpgradeStoreLoader(t *testing.T) {
+	govKey := sdk.NewKVStoreKey("customgov")
+	distributorKey := sdk.NewKVStoreKey("distributor")
+	storeUpgrades := map[string]*storetypes.StoreUpgrades{
+		UpgradeV0119: {Added: []string{distributorKey.Name()}},
+	}
+
+	// the chain halts for the upgrade at height 2, once height 1 is committed
+	db := dbm.NewMemDB()
+	ms := newMultiStore(db, govKey)
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.GetKVStore(govKey).Set([]byte("key"), []byte("value"))
+	ms.Commit()
+
+	// without the upgrade, the added store is committed at its first version instead of the height, out of
+	// step with the other stores
+	withoutUpgrade := dbm.NewMemDB()
+	ms = newMultiStore(withoutUpgrade, govKey)
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.Commit()
+	ms = newMultiStore(withoutUpgrade, govKey, distributorKey)
+	require.NoError(t, baseapp.DefaultStoreLoader(ms))
+	ms.GetKVStore(distributorKey).Set([]byte("key"), []byte("value"))
+	ms.Commit()
+	require.Equal(t, int64(1), ms.GetCommitKVStore(distributorKey).LastCommitID().Version)
+
+	// an upgrade without store changes or skipped by the node keeps the default loader
+	setter := &storeLoaderSetter{}
+	require.NoError(t, SetUpgradeStoreLoader(setter, upgradeInfoReader{info: storetypes.UpgradeInfo{Name: "v0.1.18", Height: 2}}, storeUpgrades))
+	require.Nil(t, setter.loader)
+
+	skipped := upgradeInfoReader{info: storetypes.UpgradeInfo{Name: UpgradeV0119, Height: 2}, skipHeights: map[int64]bool{2: true}}
+	require.NoError(t, SetUpgradeStoreLoader(setter, skipped, storeUpgrades))
+	require.Nil(t, setter.loader)
+
+	require.NoError(t, SetUpgradeStoreLoader(setter, upgradeInfoReader{info: storetypes.UpgradeInfo{Name: UpgradeV0119, Height: 2}}, storeUpgrades))
+	require.NotNil(t, setter.loader)
+
+	ms = newMultiStore(db, govKey, distributorKey)
+	require.NoError(t, setter.loader(ms))
+	require.Equal(t, []byte("value"), ms.GetKVStore(govKey).Get([]byte("key")))
+	ms.GetKVStore(distributorKey).Set([]byte("key"), []byte("value"))
+	ms.Commit()
+
+	// the node restarting after the upgrade height loads the added store
+	ms = newMultiStore(db, govKey, distributorKey)
+	require.NoError(t, setter.loader(ms))
+	require.Equal(t, int64(2), ms.LastCommitID().Version)
+	require.Equal(t, int64(2), ms.GetCommitKVStore(distributorKey).LastCommitID().Version)
+	require.Equal(t, []byte("value"), ms.GetKVStore(distributorKey).Get([]byte("key")))
+}
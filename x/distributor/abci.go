@@ -0,0 +1,21 @@
+package distributor
+
+import (
+	"github.com/KiraCore/sekai/x/distributor/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// BeginBlocker splits the fees and the inflation between the validators that signed the previous block
+func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
+	var signers []sdk.ConsAddress
+	for _, voteInfo := range req.LastCommitInfo.GetVotes() {
+		if voteInfo.SignedLastBlock {
+			signers = append(signers, voteInfo.Validator.Address)
+		}
+	}
+
+	if err := k.AllocateTokens(ctx, signers); err != nil {
+		panic(err)
+	}
+}
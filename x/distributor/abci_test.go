@@ -0,0 +1,52 @@
+package distributor_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/KiraCore/sekai/app"
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/distributor"
+	stakingtypes "github.com/KiraCore/sekai/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestMain(m *testing.M) {
+	app.SetConfig()
+	os.Exit(m.Run())
+}
+
+func TestBeginBlocker(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(1000))
+	pubKeys := simapp.CreateTestPubKeys(2)
+
+	var votes []abci.VoteInfo
+	for i, addr := range addrs {
+		validator, err := stakingtypes.NewValidator("validator", "some-web.com", "A Social", "My Identity", sdk.ZeroDec(), sdk.ValAddress(addr), pubKeys[i])
+		require.NoError(t, err)
+		app.CustomStakingKeeper.AddValidator(ctx, validator)
+
+		votes = append(votes, abci.VoteInfo{
+			Validator:       abci.Validator{Address: validator.GetConsAddr(), Power: 1},
+			SignedLastBlock: i == 0,
+		})
+	}
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin("ukex", 100))
+	require.NoError(t, app.BankKeeper.SendCoinsFromAccountToModule(ctx, addrs[0], authtypes.FeeCollectorName, fees))
+
+	distributor.BeginBlocker(ctx, abci.RequestBeginBlock{
+		LastCommitInfo: abci.LastCommitInfo{Votes: votes},
+	}, app.DistributorKeeper)
+
+	// only the validator that signed the last block is rewarded
+	require.Equal(t, sdk.NewDecCoinsFromCoins(fees...), app.DistributorKeeper.GetRewards(ctx, addrs[0]))
+	require.True(t, app.DistributorKeeper.GetRewards(ctx, addrs[1]).IsZero())
+}
@@ -0,0 +1,27 @@
+package distributor
+
+import (
+	"github.com/KiraCore/sekai/x/distributor/keeper"
+	"github.com/KiraCore/sekai/x/distributor/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis sets the params and the rewards accrued before the genesis
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+
+	outstanding := sdk.DecCoins{}
+	for _, rewards := range data.Rewards {
+		keeper.SetRewards(ctx, rewards.Address, rewards.Rewards)
+		outstanding = outstanding.Add(rewards.Rewards...)
+	}
+	keeper.SetOutstandingRewards(ctx, outstanding)
+}
+
+// ExportGenesis returns the params and the accrued rewards, the rewards of the delegations are exported
+// as credited so that the delegations start from a zero reward ratio
+func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
+	rewards := keeper.GetAllAccruedRewards(ctx)
+
+	return types.NewGenesisState(keeper.GetParams(ctx), rewards)
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"github.com/KiraCore/sekai/x/distributor/types"
+	stakingtypes "github.com/KiraCore/sekai/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AllocateTokens mints the block inflation, collects the fees and splits the rewards that are not
+// accrued yet between the validators that signed the previous block, weighted by their rank.
+// Rewards that cannot be split without rounding stay in the pool for the next block.
+func (k Keeper) AllocateTokens(ctx sdk.Context, signers []sdk.ConsAddress) error {
+	var validators []stakingtypes.Validator
+	totalWeight := int64(0)
+	for _, consAddr := range signers {
+		validator, err := k.sk.GetValidatorByConsAddr(ctx, consAddr)
+		if err != nil {
+			continue
+		}
+
+		validators = append(validators, validator)
+		totalWeight += RewardWeight(validator)
+	}
+
+	// nobody to reward, the fees stay in the fee collector
+	if len(validators) == 0 {
+		return nil
+	}
+
+	if err := k.mintInflation(ctx); err != nil {
+		return err
+	}
+
+	fees := k.bk.GetAllBalances(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+	if !fees.IsZero() {
+		if err := k.bk.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, fees); err != nil {
+			return err
+		}
+	}
+
+	balance := sdk.NewDecCoinsFromCoins(k.bk.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))...)
+	pool, hasNeg := balance.SafeSub(k.GetOutstandingRewards(ctx))
+	if hasNeg || pool.IsZero() {
+		return nil
+	}
+
+	for _, validator := range validators {
+		weight := sdk.NewDec(RewardWeight(validator)).QuoInt64(totalWeight)
+		k.allocateValidatorRewards(ctx, validator, pool.MulDecTruncate(weight))
+	}
+
+	return nil
+}
+
+// RewardWeight returns the share of the block rewards of a validator, it grows linearly with its rank.
+func RewardWeight(validator stakingtypes.Validator) int64 {
+	if validator.Rank < 0 {
+		return 1
+	}
+
+	return validator.Rank + 1
+}
+
+// allocateValidatorRewards takes the validator commission out of the rewards and adds the rest to the
+// reward ratio of the validator, the delegators are paid their shares of it once their delegations change
+// or they withdraw their rewards.
+func (k Keeper) allocateValidatorRewards(ctx sdk.Context, validator stakingtypes.Validator, rewards sdk.DecCoins) {
+	commissionRate := validator.Commission
+	if commissionRate.IsNil() || commissionRate.IsNegative() {
+		commissionRate = sdk.ZeroDec()
+	}
+	if commissionRate.GT(sdk.OneDec()) {
+		commissionRate = sdk.OneDec()
+	}
+
+	commission := rewards.MulDecTruncate(commissionRate)
+	shared := rewards.Sub(commission)
+
+	bond := k.sk.GetValidatorBond(ctx, validator.ValKey)
+	if bond.DelegatorShares.IsNil() || bond.DelegatorShares.IsZero() {
+		// without delegators the validator keeps the whole rewards
+		commission = rewards
+	} else {
+		ratio := shared.QuoDecTruncate(bond.DelegatorShares)
+		k.SetRewardRatio(ctx, validator.ValKey, k.GetRewardRatio(ctx, validator.ValKey).Add(ratio...))
+		k.SetOutstandingRewards(ctx, k.GetOutstandingRewards(ctx).Add(ratio.MulDecTruncate(bond.DelegatorShares)...))
+	}
+
+	k.addRewards(ctx, sdk.AccAddress(validator.ValKey), commission)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewards,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.ValKey.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, rewards.String()),
+			sdk.NewAttribute(types.AttributeKeyCommission, commission.String()),
+		),
+	)
+}
+
+// mintInflation mints the block share of the yearly inflation in the distributor module account.
+func (k Keeper) mintInflation(ctx sdk.Context) error {
+	denom := k.sk.BondDenom(ctx)
+	amount := k.GetParams(ctx).BlockInflation(k.bk.GetSupply(ctx).GetTotal().AmountOf(denom))
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	return k.bk.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, amount)))
+}
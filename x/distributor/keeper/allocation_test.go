@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/distributor/keeper"
+	"github.com/KiraCore/sekai/x/distributor/types"
+	stakingtypes "github.com/KiraCore/sekai/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func collectFees(t *testing.T, app *simapp.SimApp, ctx sdk.Context, amount int64) {
+	payer := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(amount))[0]
+	fees := sdk.NewCoins(sdk.NewInt64Coin("ukex", amount))
+	require.NoError(t, app.BankKeeper.SendCoinsFromAccountToModule(ctx, payer, authtypes.FeeCollectorName, fees))
+}
+
+func TestAllocateTokens(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	validators := createValidators(t, app, ctx, []int64{0, 2}, []sdk.Dec{sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1)})
+
+	// the first validator is backed by a self-bond and a delegator with the same amount
+	delegator := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000))[0]
+	require.NoError(t, app.CustomStakingKeeper.Delegate(ctx, sdk.AccAddress(validators[0].ValKey), validators[0].ValKey, sdk.NewInt(1000)))
+	require.NoError(t, app.CustomStakingKeeper.Delegate(ctx, delegator, validators[0].ValKey, sdk.NewInt(1000)))
+
+	collectFees(t, app, ctx, 4000)
+
+	err := app.DistributorKeeper.AllocateTokens(ctx, []sdk.ConsAddress{validators[0].GetConsAddr(), validators[1].GetConsAddr()})
+	require.NoError(t, err)
+
+	// rank 0 weights 1 and rank 2 weights 3: 1000 and 3000 rewards,
+	// the first validator takes 10% commission and splits the rest with its delegator.
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("ukex", 550)), app.DistributorKeeper.GetAccruedRewards(ctx, sdk.AccAddress(validators[0].ValKey)))
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("ukex", 450)), app.DistributorKeeper.GetAccruedRewards(ctx, delegator))
+	// only the commission is credited, the delegations are credited once they change
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("ukex", 100)), app.DistributorKeeper.GetRewards(ctx, sdk.AccAddress(validators[0].ValKey)))
+	require.True(t, app.DistributorKeeper.GetRewards(ctx, delegator).IsZero())
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("ukex", sdk.NewDecWithPrec(45, 2))), app.DistributorKeeper.GetRewardRatio(ctx, validators[0].ValKey))
+	// without delegators the validator keeps the whole rewards
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("ukex", 3000)), app.DistributorKeeper.GetRewards(ctx, sdk.AccAddress(validators[1].ValKey)))
+
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("ukex", 4000)), app.DistributorKeeper.GetOutstandingRewards(ctx))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName)).IsZero())
+	require.Equal(t, sdk.NewInt(4000), app.BankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), "ukex").Amount)
+
+	// accrued rewards are not split again
+	err = app.DistributorKeeper.AllocateTokens(ctx, []sdk.ConsAddress{validators[0].GetConsAddr(), validators[1].GetConsAddr()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("ukex", 4000)), app.DistributorKeeper.GetOutstandingRewards(ctx))
+}
+
+func TestAllocateTokens_SettleDelegations(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	validators := createValidators(t, app, ctx, []int64{0, 0}, []sdk.Dec{sdk.ZeroDec(), sdk.ZeroDec()})
+	valAddr := validators[0].ValKey
+	signers := []sdk.ConsAddress{validators[0].GetConsAddr()}
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(2000))
+	delegator1, delegator2 := addrs[0], addrs[1]
+	require.NoError(t, app.CustomStakingKeeper.Delegate(ctx, delegator1, valAddr, sdk.NewInt(1000)))
+
+	collectFees(t, app, ctx, 100)
+	require.NoError(t, app.DistributorKeeper.AllocateTokens(ctx, signers))
+
+	// the rewards are credited when the shares of the delegation change
+	require.NoError(t, app.CustomStakingKeeper.Delegate(ctx, delegator1, valAddr, sdk.NewInt(1000)))
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("ukex", 100)), app.DistributorKeeper.GetRewards(ctx, delegator1))
+	require.Equal(t, app.DistributorKeeper.GetRewardRatio(ctx, valAddr), app.DistributorKeeper.GetStartRatio(ctx, delegator1, valAddr))
+
+	// a new delegation does not accrue the rewards allocated before it
+	require.NoError(t, app.CustomStakingKeeper.Delegate(ctx, delegator2, valAddr, sdk.NewInt(2000)))
+	require.True(t, app.DistributorKeeper.GetAccruedRewards(ctx, delegator2).IsZero())
+
+	collectFees(t, app, ctx, 100)
+	require.NoError(t, app.DistributorKeeper.AllocateTokens(ctx, signers))
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("ukex", 150)), app.DistributorKeeper.GetAccruedRewards(ctx, delegator1))
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("ukex", 50)), app.DistributorKeeper.GetAccruedRewards(ctx, delegator2))
+
+	// undelegating and redelegating credit the rewards of the delegation they change
+	_, err := app.CustomStakingKeeper.Undelegate(ctx, delegator1, valAddr, sdk.NewInt(2000))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("ukex", 150)), app.DistributorKeeper.GetRewards(ctx, delegator1))
+	require.True(t, app.DistributorKeeper.GetStartRatio(ctx, delegator1, valAddr).IsZero())
+
+	require.NoError(t, app.CustomStakingKeeper.Redelegate(ctx, delegator2, valAddr, validators[1].ValKey, sdk.NewInt(1000)))
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("ukex", 50)), app.DistributorKeeper.GetRewards(ctx, delegator2))
+
+	// withdrawing credits the rewards of all the delegations first
+	collectFees(t, app, ctx, 100)
+	require.NoError(t, app.DistributorKeeper.AllocateTokens(ctx, signers))
+	withdrawn, err := app.DistributorKeeper.WithdrawRewards(ctx, delegator2)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ukex", 150)), withdrawn)
+	require.True(t, app.DistributorKeeper.GetAccruedRewards(ctx, delegator2).IsZero())
+
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("ukex", 150)), app.DistributorKeeper.GetOutstandingRewards(ctx))
+}
+
+func TestAllocateTokens_NoSigners(t *testing.T) {
+	tests := []struct {
+		name    string
+		signers func(validators []stakingtypes.Validator) []sdk.ConsAddress
+	}{
+		{
+			name: "no signers",
+			signers: func(validators []stakingtypes.Validator) []sdk.ConsAddress {
+				return nil
+			},
+		},
+		{
+			name: "unknown signers",
+			signers: func(validators []stakingtypes.Validator) []sdk.ConsAddress {
+				return []sdk.ConsAddress{sdk.ConsAddress("unknown")}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			ctx := app.NewContext(false, tmproto.Header{})
+
+			validators := createValidators(t, app, ctx, []int64{0}, []sdk.Dec{sdk.ZeroDec()})
+			collectFees(t, app, ctx, 100)
+
+			err := app.DistributorKeeper.AllocateTokens(ctx, tt.signers(validators))
+			require.NoError(t, err)
+
+			require.Equal(t, sdk.NewInt(100), app.BankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName), "ukex").Amount)
+			require.True(t, app.DistributorKeeper.GetOutstandingRewards(ctx).IsZero())
+		})
+	}
+}
+
+func TestAllocateTokens_Inflation(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	validators := createValidators(t, app, ctx, []int64{0}, []sdk.Dec{sdk.ZeroDec()})
+	app.DistributorKeeper.SetParams(ctx, types.NewParams(sdk.NewDecWithPrec(1, 1), 10))
+
+	supply := app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf("ukex")
+	minted := supply.QuoRaw(100)
+
+	err := app.DistributorKeeper.AllocateTokens(ctx, []sdk.ConsAddress{validators[0].GetConsAddr()})
+	require.NoError(t, err)
+
+	require.Equal(t, supply.Add(minted), app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf("ukex"))
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin("ukex", minted)), app.DistributorKeeper.GetRewards(ctx, sdk.AccAddress(validators[0].ValKey)))
+}
+
+func TestRewardWeight(t *testing.T) {
+	require.Equal(t, int64(1), keeper.RewardWeight(stakingtypes.Validator{Rank: 0}))
+	require.Equal(t, int64(6), keeper.RewardWeight(stakingtypes.Validator{Rank: 5}))
+	require.Equal(t, int64(1), keeper.RewardWeight(stakingtypes.Validator{Rank: -1}))
+}
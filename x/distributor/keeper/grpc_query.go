@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KiraCore/sekai/x/distributor/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Querier struct {
+	keeper Keeper
+}
+
+func NewQuerier(keeper Keeper) types.QueryServer {
+	return &Querier{keeper: keeper}
+}
+
+var _ types.QueryServer = Querier{}
+
+// Rewards returns the block rewards accrued by an account
+func (q Querier) Rewards(ctx context.Context, request *types.QueryRewardsRequest) (*types.QueryRewardsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(request.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	rewards := q.keeper.GetAccruedRewards(sdk.UnwrapSDKContext(ctx), addr)
+
+	return &types.QueryRewardsResponse{Rewards: rewards}, nil
+}
+
+// Params returns the block reward inflation schedule
+func (q Querier) Params(ctx context.Context, request *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params := q.keeper.GetParams(sdk.UnwrapSDKContext(ctx))
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
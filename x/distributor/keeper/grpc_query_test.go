@@ -0,0 +1,34 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/distributor/keeper"
+	"github.com/KiraCore/sekai/x/distributor/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestQuerier_Rewards(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.ZeroInt())[0]
+	rewards := sdk.NewDecCoins(sdk.NewInt64DecCoin("ukex", 10))
+	app.DistributorKeeper.SetRewards(ctx, addr, rewards)
+
+	querier := keeper.NewQuerier(app.DistributorKeeper)
+
+	res, err := querier.Rewards(sdk.WrapSDKContext(ctx), &types.QueryRewardsRequest{Address: addr.String()})
+	require.NoError(t, err)
+	require.Equal(t, rewards, res.Rewards)
+
+	_, err = querier.Rewards(sdk.WrapSDKContext(ctx), &types.QueryRewardsRequest{Address: "invalid"})
+	require.Error(t, err)
+
+	params, err := querier.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), params.Params)
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	stakingtypes "github.com/KiraCore/sekai/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Hooks settles the rewards of the delegations when they change
+type Hooks struct {
+	k Keeper
+}
+
+var _ stakingtypes.StakingHooks = Hooks{}
+
+// Hooks returns the staking hooks of the distributor
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// BeforeDelegationSharesModified credits the rewards accrued by the delegation with its current shares
+func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	if delegation, found := h.k.sk.GetDelegation(ctx, delAddr, valAddr); found {
+		h.k.settleRewards(ctx, delegation)
+	}
+}
+
+// AfterDelegationModified starts accruing the rewards of the delegation from the current reward ratio
+func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	if _, found := h.k.sk.GetDelegation(ctx, delAddr, valAddr); found {
+		h.k.SetStartRatio(ctx, delAddr, valAddr, h.k.GetRewardRatio(ctx, valAddr))
+	} else {
+		h.k.DeleteStartRatio(ctx, delAddr, valAddr)
+	}
+}
+
+func (h Hooks) AfterValidatorJoined(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) {}
+
+func (h Hooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress) {}
+
+func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) {}
+
+func (h Hooks) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) {}
+
+func (h Hooks) AfterConsensusKeyRotated(_ sdk.Context, _, _ sdk.ConsAddress, _ sdk.ValAddress) {}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"github.com/KiraCore/sekai/x/distributor/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper manages the block rewards of the validators and their delegators
+type Keeper struct {
+	cdc      codec.BinaryMarshaler
+	storeKey sdk.StoreKey
+	bk       types.BankKeeper
+	sk       types.StakingKeeper
+}
+
+// NewKeeper returns new instance of a keeper
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, bk types.BankKeeper, sk types.StakingKeeper) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+		bk:       bk,
+		sk:       sk,
+	}
+}
+
+// GetParams returns the block reward inflation schedule
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshalBinaryBare(bz, &params)
+
+	return params
+}
+
+// SetParams sets the block reward inflation schedule
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsKey, k.cdc.MustMarshalBinaryBare(&params))
+}
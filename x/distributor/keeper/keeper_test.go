@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/KiraCore/sekai/app"
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/distributor/types"
+	stakingtypes "github.com/KiraCore/sekai/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestMain(m *testing.M) {
+	app.SetConfig()
+	os.Exit(m.Run())
+}
+
+func TestKeeper_Params(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	require.Equal(t, types.DefaultParams(), app.DistributorKeeper.GetParams(ctx))
+
+	params := types.NewParams(sdk.NewDecWithPrec(7, 2), 1000)
+	app.DistributorKeeper.SetParams(ctx, params)
+	require.Equal(t, params, app.DistributorKeeper.GetParams(ctx))
+}
+
+// createValidators adds active validators with the given ranks and commissions.
+func createValidators(t *testing.T, app *simapp.SimApp, ctx sdk.Context, ranks []int64, commissions []sdk.Dec) []stakingtypes.Validator {
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, len(ranks), sdk.TokensFromConsensusPower(10))
+	pubKeys := simapp.CreateTestPubKeys(len(ranks))
+
+	var validators []stakingtypes.Validator
+	for i, addr := range addrs {
+		validator, err := stakingtypes.NewValidator(
+			"validator",
+			"some-web.com",
+			"A Social",
+			"My Identity",
+			commissions[i],
+			sdk.ValAddress(addr),
+			pubKeys[i],
+		)
+		require.NoError(t, err)
+
+		validator.Rank = ranks[i]
+		app.CustomStakingKeeper.AddValidator(ctx, validator)
+		validators = append(validators, validator)
+	}
+
+	return validators
+}
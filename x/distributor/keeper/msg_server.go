@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KiraCore/sekai/x/distributor/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type msgServer struct {
+	keeper Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the distributor MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) WithdrawRewards(goCtx context.Context, msg *types.MsgWithdrawRewards) (*types.MsgWithdrawRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	amount, err := k.keeper.WithdrawRewards(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawRewardsResponse{Amount: amount}, nil
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"github.com/KiraCore/sekai/x/distributor/types"
+	stakingtypes "github.com/KiraCore/sekai/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetRewardRatio returns the rewards accrued by a delegation share of the validator since it was first rewarded.
+func (k Keeper) GetRewardRatio(ctx sdk.Context, valAddress sdk.ValAddress) sdk.DecCoins {
+	return k.getRatio(ctx, rewardRatioKey(valAddress))
+}
+
+// SetRewardRatio sets the rewards accrued by a delegation share of the validator since it was first rewarded.
+func (k Keeper) SetRewardRatio(ctx sdk.Context, valAddress sdk.ValAddress, ratio sdk.DecCoins) {
+	k.setRatio(ctx, rewardRatioKey(valAddress), ratio)
+}
+
+// GetStartRatio returns the reward ratio of the validator when the delegation last changed, the delegations
+// made before the first rewards of the validator start from zero.
+func (k Keeper) GetStartRatio(ctx sdk.Context, delegator sdk.AccAddress, valAddress sdk.ValAddress) sdk.DecCoins {
+	return k.getRatio(ctx, startRatioKey(delegator, valAddress))
+}
+
+// SetStartRatio sets the reward ratio of the validator when the delegation last changed.
+func (k Keeper) SetStartRatio(ctx sdk.Context, delegator sdk.AccAddress, valAddress sdk.ValAddress, ratio sdk.DecCoins) {
+	k.setRatio(ctx, startRatioKey(delegator, valAddress), ratio)
+}
+
+// DeleteStartRatio removes the start ratio of a delegation that no longer exists.
+func (k Keeper) DeleteStartRatio(ctx sdk.Context, delegator sdk.AccAddress, valAddress sdk.ValAddress) {
+	ctx.KVStore(k.storeKey).Delete(startRatioKey(delegator, valAddress))
+}
+
+func (k Keeper) getRatio(ctx sdk.Context, key []byte) sdk.DecCoins {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return sdk.DecCoins{}
+	}
+
+	var ratio types.RewardRatio
+	k.cdc.MustUnmarshalBinaryBare(bz, &ratio)
+
+	return ratio.Ratio
+}
+
+func (k Keeper) setRatio(ctx sdk.Context, key []byte, ratio sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+
+	if ratio.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, k.cdc.MustMarshalBinaryBare(&types.RewardRatio{Ratio: ratio}))
+}
+
+// pendingRewards returns the rewards accrued by the delegation since it last changed, the growth of the
+// reward ratio of its validator times its shares.
+func (k Keeper) pendingRewards(ctx sdk.Context, delegation stakingtypes.Delegation) sdk.DecCoins {
+	growth, hasNeg := k.GetRewardRatio(ctx, delegation.ValKey).SafeSub(k.GetStartRatio(ctx, delegation.Delegator, delegation.ValKey))
+	if hasNeg {
+		return sdk.DecCoins{}
+	}
+
+	return growth.MulDecTruncate(delegation.Shares)
+}
+
+// settleRewards credits the rewards accrued by the delegation since it last changed to the delegator, the
+// delegation accrues rewards from the current reward ratio of the validator afterwards.
+func (k Keeper) settleRewards(ctx sdk.Context, delegation stakingtypes.Delegation) {
+	if rewards := k.pendingRewards(ctx, delegation); !rewards.IsZero() {
+		k.SetRewards(ctx, delegation.Delegator, k.GetRewards(ctx, delegation.Delegator).Add(rewards...))
+	}
+
+	k.SetStartRatio(ctx, delegation.Delegator, delegation.ValKey, k.GetRewardRatio(ctx, delegation.ValKey))
+}
+
+// rewardRatioKey returns the key in format <0x04 + val_address_bytes>
+func rewardRatioKey(valAddress sdk.ValAddress) []byte {
+	return append(append([]byte{}, types.RewardRatioKey...), valAddress...)
+}
+
+// startRatioKey returns the key in format <0x05 + val_address_bytes + delegator_bytes>
+func startRatioKey(delegator sdk.AccAddress, valAddress sdk.ValAddress) []byte {
+	return append(append(append([]byte{}, types.StartRatioKey...), valAddress...), delegator...)
+}
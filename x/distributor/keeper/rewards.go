@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/KiraCore/sekai/x/distributor/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetRewards returns the rewards credited to an address and not withdrawn yet, the rewards of its delegations
+// are credited once they change or the address withdraws its rewards.
+func (k Keeper) GetRewards(ctx sdk.Context, addr sdk.AccAddress) sdk.DecCoins {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(append(types.RewardsKey, addr...))
+	if bz == nil {
+		return sdk.DecCoins{}
+	}
+
+	var rewards types.Rewards
+	k.cdc.MustUnmarshalBinaryBare(bz, &rewards)
+
+	return rewards.Rewards
+}
+
+// SetRewards sets the rewards accrued by an address, the record is removed when there is nothing left.
+func (k Keeper) SetRewards(ctx sdk.Context, addr sdk.AccAddress, rewards sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+
+	if rewards.IsZero() {
+		store.Delete(append(types.RewardsKey, addr...))
+		return
+	}
+
+	store.Set(append(types.RewardsKey, addr...), k.cdc.MustMarshalBinaryBare(&types.Rewards{
+		Address: addr,
+		Rewards: rewards,
+	}))
+}
+
+// GetAllRewards returns the rewards accrued by all the addresses.
+func (k Keeper) GetAllRewards(ctx sdk.Context) []types.Rewards {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.RewardsKey)
+	defer iter.Close()
+
+	var allRewards []types.Rewards
+	for ; iter.Valid(); iter.Next() {
+		var rewards types.Rewards
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &rewards)
+		allRewards = append(allRewards, rewards)
+	}
+
+	return allRewards
+}
+
+// GetAccruedRewards returns the rewards accrued by an address and not withdrawn yet, including the rewards
+// of its delegations which are not credited yet.
+func (k Keeper) GetAccruedRewards(ctx sdk.Context, addr sdk.AccAddress) sdk.DecCoins {
+	rewards := k.GetRewards(ctx, addr)
+	for _, delegation := range k.sk.GetDelegatorDelegations(ctx, addr) {
+		rewards = rewards.Add(k.pendingRewards(ctx, delegation)...)
+	}
+
+	return rewards
+}
+
+// GetAllAccruedRewards returns the rewards accrued by all the addresses, including the rewards of their
+// delegations which are not credited yet, ordered by address.
+func (k Keeper) GetAllAccruedRewards(ctx sdk.Context) []types.Rewards {
+	accrued := map[string]sdk.DecCoins{}
+	for _, rewards := range k.GetAllRewards(ctx) {
+		accrued[string(rewards.Address)] = rewards.Rewards
+	}
+
+	for _, delegation := range k.sk.GetAllDelegations(ctx) {
+		if pending := k.pendingRewards(ctx, delegation); !pending.IsZero() {
+			accrued[string(delegation.Delegator)] = accrued[string(delegation.Delegator)].Add(pending...)
+		}
+	}
+
+	addrs := make([]string, 0, len(accrued))
+	for addr := range accrued {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+
+	allRewards := make([]types.Rewards, 0, len(addrs))
+	for _, addr := range addrs {
+		allRewards = append(allRewards, types.Rewards{Address: sdk.AccAddress(addr), Rewards: accrued[addr]})
+	}
+
+	return allRewards
+}
+
+// GetOutstandingRewards returns the sum of the rewards accrued by all the addresses.
+func (k Keeper) GetOutstandingRewards(ctx sdk.Context) sdk.DecCoins {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.OutstandingRewardsKey)
+	if bz == nil {
+		return sdk.DecCoins{}
+	}
+
+	var outstanding types.OutstandingRewards
+	k.cdc.MustUnmarshalBinaryBare(bz, &outstanding)
+
+	return outstanding.Rewards
+}
+
+// SetOutstandingRewards sets the sum of the rewards accrued by all the addresses.
+func (k Keeper) SetOutstandingRewards(ctx sdk.Context, rewards sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.OutstandingRewardsKey, k.cdc.MustMarshalBinaryBare(&types.OutstandingRewards{Rewards: rewards}))
+}
+
+// addRewards credits rewards to an address.
+func (k Keeper) addRewards(ctx sdk.Context, addr sdk.AccAddress, rewards sdk.DecCoins) {
+	if rewards.IsZero() {
+		return
+	}
+
+	k.SetRewards(ctx, addr, k.GetRewards(ctx, addr).Add(rewards...))
+	k.SetOutstandingRewards(ctx, k.GetOutstandingRewards(ctx).Add(rewards...))
+}
+
+// WithdrawRewards credits the rewards of the delegations of the address and pays the whole part of the
+// accrued rewards to it, the decimal remainder stays accrued.
+func (k Keeper) WithdrawRewards(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coins, error) {
+	for _, delegation := range k.sk.GetDelegatorDelegations(ctx, addr) {
+		k.settleRewards(ctx, delegation)
+	}
+
+	rewards := k.GetRewards(ctx, addr)
+
+	coins, remainder := rewards.TruncateDecimal()
+	if coins.IsZero() {
+		return nil, types.ErrNoRewards
+	}
+
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
+		return nil, err
+	}
+
+	withdrawn := sdk.NewDecCoinsFromCoins(coins...)
+	k.SetRewards(ctx, addr, remainder)
+	k.SetOutstandingRewards(ctx, k.GetOutstandingRewards(ctx).Sub(withdrawn))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawRewards,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+		),
+	)
+
+	return coins, nil
+}
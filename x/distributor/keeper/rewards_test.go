@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/distributor/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestWithdrawRewards(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	validators := createValidators(t, app, ctx, []int64{0, 0, 0}, []sdk.Dec{sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()})
+	collectFees(t, app, ctx, 100)
+
+	var signers []sdk.ConsAddress
+	for _, validator := range validators {
+		signers = append(signers, validator.GetConsAddr())
+	}
+	require.NoError(t, app.DistributorKeeper.AllocateTokens(ctx, signers))
+
+	addr := sdk.AccAddress(validators[0].ValKey)
+	balance := app.BankKeeper.GetBalance(ctx, addr, "ukex").Amount
+
+	withdrawn, err := app.DistributorKeeper.WithdrawRewards(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ukex", 33)), withdrawn)
+	require.Equal(t, balance.AddRaw(33), app.BankKeeper.GetBalance(ctx, addr, "ukex").Amount)
+
+	// the decimal remainder stays accrued
+	remainder := app.DistributorKeeper.GetRewards(ctx, addr)
+	require.True(t, remainder.AmountOf("ukex").LT(sdk.OneDec()))
+	require.True(t, remainder.AmountOf("ukex").IsPositive())
+
+	_, err = app.DistributorKeeper.WithdrawRewards(ctx, addr)
+	require.Equal(t, types.ErrNoRewards, err)
+
+	outstanding := remainder.
+		Add(app.DistributorKeeper.GetRewards(ctx, sdk.AccAddress(validators[1].ValKey))...).
+		Add(app.DistributorKeeper.GetRewards(ctx, sdk.AccAddress(validators[2].ValKey))...)
+	require.Equal(t, outstanding, app.DistributorKeeper.GetOutstandingRewards(ctx))
+}
+
+func TestGetAllRewards(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.ZeroInt())
+	app.DistributorKeeper.SetRewards(ctx, addrs[0], sdk.NewDecCoins(sdk.NewInt64DecCoin("ukex", 10)))
+	app.DistributorKeeper.SetRewards(ctx, addrs[1], sdk.NewDecCoins(sdk.NewInt64DecCoin("ukex", 20)))
+
+	require.Len(t, app.DistributorKeeper.GetAllRewards(ctx), 2)
+
+	// empty rewards are removed
+	app.DistributorKeeper.SetRewards(ctx, addrs[0], sdk.DecCoins{})
+	require.Len(t, app.DistributorKeeper.GetAllRewards(ctx), 1)
+}
+
+func TestGetAllAccruedRewards(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	validators := createValidators(t, app, ctx, []int64{0}, []sdk.Dec{sdk.NewDecWithPrec(1, 1)})
+	valAddr := validators[0].ValKey
+	delegator := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000))[0]
+	require.NoError(t, app.CustomStakingKeeper.Delegate(ctx, delegator, valAddr, sdk.NewInt(1000)))
+
+	collectFees(t, app, ctx, 100)
+	require.NoError(t, app.DistributorKeeper.AllocateTokens(ctx, []sdk.ConsAddress{validators[0].GetConsAddr()}))
+
+	// the rewards of the delegation are included though they are not credited yet
+	require.Len(t, app.DistributorKeeper.GetAllRewards(ctx), 1)
+	require.ElementsMatch(t, []types.Rewards{
+		{Address: sdk.AccAddress(valAddr), Rewards: sdk.NewDecCoins(sdk.NewInt64DecCoin("ukex", 10))},
+		{Address: delegator, Rewards: sdk.NewDecCoins(sdk.NewInt64DecCoin("ukex", 90))},
+	}, app.DistributorKeeper.GetAllAccruedRewards(ctx))
+}
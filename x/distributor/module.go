@@ -0,0 +1,145 @@
+package distributor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+
+	"github.com/KiraCore/sekai/middleware"
+	distributorcli "github.com/KiraCore/sekai/x/distributor/client/cli"
+	distributorkeeper "github.com/KiraCore/sekai/x/distributor/keeper"
+	distributortypes "github.com/KiraCore/sekai/x/distributor/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+var (
+	_ module.AppModule      = AppModule{}
+	_ module.AppModuleBasic = AppModuleBasic{}
+)
+
+type AppModuleBasic struct{}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the distributor module.
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+}
+
+func (b AppModuleBasic) Name() string {
+	return distributortypes.ModuleName
+}
+
+func (b AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	distributortypes.RegisterInterfaces(registry)
+}
+
+func (b AppModuleBasic) DefaultGenesis(cdc codec.JSONMarshaler) json.RawMessage {
+	return cdc.MustMarshalJSON(distributortypes.DefaultGenesis())
+}
+
+func (b AppModuleBasic) ValidateGenesis(cdc codec.JSONMarshaler, config client.TxEncodingConfig, bz json.RawMessage) error {
+	var data distributortypes.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", distributortypes.ModuleName, err)
+	}
+
+	return distributortypes.ValidateGenesis(data)
+}
+
+func (b AppModuleBasic) RegisterRESTRoutes(clientCtx client.Context, router *mux.Router) {
+}
+
+func (b AppModuleBasic) RegisterGRPCRoutes(clientCtx client.Context, serveMux *runtime.ServeMux) {
+	distributortypes.RegisterQueryHandlerClient(context.Background(), serveMux, distributortypes.NewQueryClient(clientCtx))
+}
+
+func (b AppModuleBasic) RegisterLegacyAminoCodec(amino *codec.LegacyAmino) {
+	distributortypes.RegisterCodec(amino)
+}
+
+func (b AppModuleBasic) GetTxCmd() *cobra.Command {
+	return distributorcli.NewTxCmd()
+}
+
+// GetQueryCmd implement query commands for this module
+func (b AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return distributorcli.NewQueryCmd()
+}
+
+// AppModule for block rewards distribution
+type AppModule struct {
+	AppModuleBasic
+	keeper distributorkeeper.Keeper
+}
+
+// RegisterServices registers the msg and the gRPC query services of the module.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	distributortypes.RegisterMsgServer(cfg.MsgServer(), distributorkeeper.NewMsgServerImpl(am.keeper))
+	distributortypes.RegisterQueryServer(cfg.QueryServer(), distributorkeeper.NewQuerier(am.keeper))
+}
+
+func (am AppModule) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	distributortypes.RegisterInterfaces(registry)
+}
+
+func (am AppModule) InitGenesis(
+	ctx sdk.Context,
+	cdc codec.JSONMarshaler,
+	data json.RawMessage,
+) []abci.ValidatorUpdate {
+	var genesisState distributortypes.GenesisState
+	cdc.MustUnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, am.keeper, &genesisState)
+	return nil
+}
+
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) json.RawMessage {
+	gs := ExportGenesis(ctx, am.keeper)
+	return cdc.MustMarshalJSON(gs)
+}
+
+func (am AppModule) RegisterInvariants(registry sdk.InvariantRegistry) {}
+
+func (am AppModule) QuerierRoute() string {
+	return distributortypes.QuerierRoute
+}
+
+// LegacyQuerierHandler returns the distributor module sdk.Querier.
+func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
+	return nil
+}
+
+// BeginBlock splits the block rewards between the validators that signed the previous block.
+func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
+	BeginBlocker(ctx, req, am.keeper)
+}
+
+func (am AppModule) EndBlock(ctx sdk.Context, block abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return nil
+}
+
+func (am AppModule) Name() string {
+	return distributortypes.ModuleName
+}
+
+// Route returns the message routing key for the distributor module.
+func (am AppModule) Route() sdk.Route {
+	return middleware.NewRoute(distributortypes.ModuleName, NewHandler(am.keeper))
+}
+
+// NewAppModule returns a new distributor module.
+func NewAppModule(
+	keeper distributorkeeper.Keeper,
+) AppModule {
+	return AppModule{
+		keeper: keeper,
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	functionmeta "github.com/KiraCore/sekai/function_meta"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterCodec register codec and metadata
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgWithdrawRewards{}, "kiraHub/MsgWithdrawRewards", nil)
+	functionmeta.AddNewFunction((&MsgWithdrawRewards{}).Type(), `{
+		"description": "MsgWithdrawRewards defines a message to withdraw the block rewards accrued by the sender.",
+		"parameters": {
+			"sender": {
+				"type":        "address",
+				"description": "account withdrawing its rewards"
+			}
+		}
+	}`)
+}
+
+// RegisterInterfaces register Msg and structs
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgWithdrawRewards{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global x/distributor module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding as Amino is
+	// still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/distributor and
+	// defined at the application level.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterCodec(amino)
+	amino.Seal()
+}
@@ -0,0 +1,8 @@
+package types
+
+import "github.com/cosmos/cosmos-sdk/types/errors"
+
+// distributor module errors
+var (
+	ErrNoRewards = errors.Register(ModuleName, 2, "no rewards to withdraw")
+)
@@ -0,0 +1,12 @@
+package types
+
+// distributor module event types
+const (
+	EventTypeRewards         = "rewards"
+	EventTypeWithdrawRewards = "withdraw_rewards"
+
+	AttributeKeyValidator  = "validator"
+	AttributeKeyAddress    = "address"
+	AttributeKeyAmount     = "amount"
+	AttributeKeyCommission = "commission"
+)
@@ -0,0 +1,26 @@
+package types
+
+import (
+	stakingtypes "github.com/KiraCore/sekai/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankexported "github.com/cosmos/cosmos-sdk/x/bank/exported"
+)
+
+// BankKeeper defines the expected bank keeper used to collect, mint and pay the block rewards
+type BankKeeper interface {
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetSupply(ctx sdk.Context) bankexported.SupplyI
+	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// StakingKeeper defines the expected staking keeper used to find the validators and their delegators
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+	GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) (stakingtypes.Validator, error)
+	GetValidatorBond(ctx sdk.Context, valAddress sdk.ValAddress) stakingtypes.ValidatorBond
+	GetDelegation(ctx sdk.Context, delegator sdk.AccAddress, valAddress sdk.ValAddress) (stakingtypes.Delegation, bool)
+	GetDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress) []stakingtypes.Delegation
+	GetAllDelegations(ctx sdk.Context) []stakingtypes.Delegation
+}
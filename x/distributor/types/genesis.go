@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, rewards []Rewards) *GenesisState {
+	return &GenesisState{
+		Params:  params,
+		Rewards: rewards,
+	}
+}
+
+// DefaultGenesis returns the default distributor genesis state
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(DefaultParams(), []Rewards{})
+}
+
+// ValidateGenesis validates the distributor genesis parameters
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, rewards := range data.Rewards {
+		if rewards.Address.Empty() {
+			return fmt.Errorf("rewards address not set")
+		}
+
+		if err := rewards.Rewards.Validate(); err != nil {
+			return fmt.Errorf("invalid rewards of %s: %w", rewards.Address, err)
+		}
+	}
+
+	return nil
+}
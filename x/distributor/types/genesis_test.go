@@ -0,0 +1,76 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	tests := []struct {
+		name        string
+		genesis     GenesisState
+		expectedErr bool
+	}{
+		{
+			name:    "default genesis",
+			genesis: *DefaultGenesis(),
+		},
+		{
+			name: "negative inflation rate",
+			genesis: GenesisState{
+				Params: NewParams(sdk.NewDec(-1), DefaultBlocksPerYear),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "inflation rate above one",
+			genesis: GenesisState{
+				Params: NewParams(sdk.NewDec(2), DefaultBlocksPerYear),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "zero blocks per year",
+			genesis: GenesisState{
+				Params: NewParams(sdk.ZeroDec(), 0),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "rewards without address",
+			genesis: GenesisState{
+				Params:  DefaultParams(),
+				Rewards: []Rewards{{Rewards: sdk.NewDecCoins(sdk.NewInt64DecCoin("ukex", 1))}},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "valid rewards",
+			genesis: GenesisState{
+				Params:  DefaultParams(),
+				Rewards: []Rewards{{Address: sdk.AccAddress("addr"), Rewards: sdk.NewDecCoins(sdk.NewInt64DecCoin("ukex", 1))}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateGenesis(tt.genesis)
+			if tt.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParams_BlockInflation(t *testing.T) {
+	params := NewParams(sdk.NewDecWithPrec(1, 1), 10)
+	require.Equal(t, sdk.NewInt(100), params.BlockInflation(sdk.NewInt(10000)))
+
+	require.True(t, DefaultParams().BlockInflation(sdk.NewInt(10000)).IsZero())
+}
@@ -0,0 +1,18 @@
+package types
+
+// constants
+const (
+	ModuleName = "distributor"
+	// RouterKey to be used for routing msgs
+	RouterKey    = ModuleName
+	QuerierRoute = ModuleName
+)
+
+// store prefixes
+var (
+	ParamsKey             = []byte{0x01}
+	RewardsKey            = []byte{0x02}
+	OutstandingRewardsKey = []byte{0x03}
+	RewardRatioKey        = []byte{0x04}
+	StartRatioKey         = []byte{0x05}
+)
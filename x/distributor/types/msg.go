@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/KiraCore/sekai/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgWithdrawRewards{}
+
+func NewMsgWithdrawRewards(sender sdk.AccAddress) *MsgWithdrawRewards {
+	return &MsgWithdrawRewards{
+		Sender: sender,
+	}
+}
+
+func (m *MsgWithdrawRewards) Route() string {
+	return ModuleName
+}
+
+func (m *MsgWithdrawRewards) Type() string {
+	return types.MsgTypeWithdrawRewards
+}
+
+func (m *MsgWithdrawRewards) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return fmt.Errorf("sender not set")
+	}
+
+	return nil
+}
+
+func (m *MsgWithdrawRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgWithdrawRewards) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultBlocksPerYear assumes a block every 5 seconds
+const DefaultBlocksPerYear = 60 * 60 * 24 * 365 / 5
+
+// NewParams creates a new Params instance
+func NewParams(inflationRate sdk.Dec, blocksPerYear uint64) Params {
+	return Params{
+		InflationRate: inflationRate,
+		BlocksPerYear: blocksPerYear,
+	}
+}
+
+// DefaultParams returns the default params, block rewards only come from fees
+func DefaultParams() Params {
+	return NewParams(sdk.ZeroDec(), DefaultBlocksPerYear)
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if p.InflationRate.IsNil() || p.InflationRate.IsNegative() || p.InflationRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("inflation rate should be between zero and one, is %s", p.InflationRate)
+	}
+
+	if p.BlocksPerYear == 0 {
+		return fmt.Errorf("blocks per year should be positive")
+	}
+
+	return nil
+}
+
+// BlockInflation returns the amount of tokens minted each block out of the supply
+func (p Params) BlockInflation(supply sdk.Int) sdk.Int {
+	if p.InflationRate.IsZero() || p.BlocksPerYear == 0 {
+		return sdk.ZeroInt()
+	}
+
+	return supply.ToDec().Mul(p.InflationRate).QuoInt64(int64(p.BlocksPerYear)).TruncateInt()
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/KiraCore/sekai/x/staking"
 	"github.com/KiraCore/sekai/x/staking/teststaking"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func (suite *KeeperTestSuite) TestHandleDoubleSign() {
@@ -22,6 +23,13 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign() {
 	// execute end-blocker and verify validator attributes
 	staking.EndBlocker(ctx, suite.app.CustomStakingKeeper)
 
+	// self-bond some tokens to the validator
+	selfBond := sdk.NewCoins(sdk.NewInt64Coin("ukex", 1000))
+	supply := suite.app.BankKeeper.GetSupply(ctx)
+	suite.app.BankKeeper.SetSupply(ctx, banktypes.NewSupply(supply.GetTotal().Add(selfBond...)))
+	suite.NoError(suite.app.BankKeeper.AddCoins(ctx, sdk.AccAddress(operatorAddr), selfBond))
+	suite.NoError(suite.app.CustomStakingKeeper.Delegate(ctx, sdk.AccAddress(operatorAddr), operatorAddr, sdk.NewInt(1000)))
+
 	// double sign less than max age
 	evidence := &types.Equivocation{
 		Height:           0,
@@ -36,6 +44,11 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign() {
 	suite.True(validator.IsJailed())
 	suite.True(suite.app.CustomSlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
 
+	// the bonded tokens should be slashed
+	slashFraction := suite.app.CustomSlashingKeeper.SlashFractionDoubleSign(ctx)
+	expectedTokens := sdk.NewInt(1000).Sub(slashFraction.MulInt64(1000).TruncateInt())
+	suite.Equal(expectedTokens, suite.app.CustomStakingKeeper.GetValidatorBond(ctx, operatorAddr).Tokens)
+
 	// submit duplicate evidence
 	suite.app.EvidenceKeeper.HandleEquivocationEvidence(ctx, evidence)
 
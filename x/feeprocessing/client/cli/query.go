@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/KiraCore/sekai/x/feeprocessing/types"
+)
+
+// NewQueryCmd returns a root CLI command handler for all x/feeprocessing query commands.
+func NewQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:   types.RouterKey,
+		Short: "query commands for the feeprocessing module",
+	}
+	queryCmd.AddCommand(
+		GetCmdQueryRefundableFees(),
+	)
+
+	queryCmd.PersistentFlags().String("node", "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain")
+	return queryCmd
+}
+
+// GetCmdQueryRefundableFees the query refundable fees command.
+func GetCmdQueryRefundableFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refundable-fees [addr]",
+		Short: "Query the fees paid by an account which can still be refunded",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			params := &types.QueryRefundableFeesRequest{Address: args[0]}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RefundableFees(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -21,8 +21,9 @@ func NewHandler(fk feeprocessingkeeper.Keeper) sdk.Handler {
 	}
 }
 
-// EndBlocker handles return of unused fee back to user in the currency he/she paid
+// EndBlocker handles return of unused fee back to user in the currency he/she paid, and prunes the fee ledger
 func EndBlocker(ctx sdk.Context, keeper feeprocessingkeeper.Keeper) []abci.ValidatorUpdate {
 	keeper.ProcessExecutionFeeReturn(ctx)
+	keeper.PruneFeeLedger(ctx)
 	return []abci.ValidatorUpdate{}
 }
@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"github.com/KiraCore/sekai/x/feeprocessing/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddFeeLedgerEntries records the fees paid by an address at the current height
+func (k Keeper) AddFeeLedgerEntries(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) {
+	for _, coin := range coins {
+		entry, found := k.GetFeeLedgerEntry(ctx, addr, ctx.BlockHeight(), coin.Denom)
+		if !found {
+			entry = types.FeeLedgerEntry{
+				Address: addr,
+				Denom:   coin.Denom,
+				Height:  ctx.BlockHeight(),
+				Amount:  sdk.ZeroInt(),
+			}
+		}
+		entry.Amount = entry.Amount.Add(coin.Amount)
+		k.SetFeeLedgerEntry(ctx, entry)
+	}
+}
+
+// SetFeeLedgerEntry saves a fee ledger entry, an entry without amount left is removed
+func (k Keeper) SetFeeLedgerEntry(ctx sdk.Context, entry types.FeeLedgerEntry) {
+	if !entry.Amount.IsPositive() {
+		k.DeleteFeeLedgerEntry(ctx, entry)
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := feeLedgerKey(entry.Address, entry.Height, entry.Denom)
+	bz := k.cdc.MustMarshalBinaryBare(&entry)
+	store.Set(key, bz)
+	store.Set(feeLedgerHeightKey(entry.Height, key), bz)
+}
+
+// DeleteFeeLedgerEntry removes a fee ledger entry
+func (k Keeper) DeleteFeeLedgerEntry(ctx sdk.Context, entry types.FeeLedgerEntry) {
+	store := ctx.KVStore(k.storeKey)
+	key := feeLedgerKey(entry.Address, entry.Height, entry.Denom)
+	store.Delete(key)
+	store.Delete(feeLedgerHeightKey(entry.Height, key))
+}
+
+// GetFeeLedgerEntry returns the fees paid by an address in a denom at a height
+func (k Keeper) GetFeeLedgerEntry(ctx sdk.Context, addr sdk.AccAddress, height int64, denom string) (types.FeeLedgerEntry, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(feeLedgerKey(addr, height, denom))
+	if bz == nil {
+		return types.FeeLedgerEntry{}, false
+	}
+
+	var entry types.FeeLedgerEntry
+	k.cdc.MustUnmarshalBinaryBare(bz, &entry)
+
+	return entry, true
+}
+
+// GetFeeLedger returns the fee ledger entries of an address, ordered by height
+func (k Keeper) GetFeeLedger(ctx sdk.Context, addr sdk.AccAddress) []types.FeeLedgerEntry {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), feeLedgerAddressKey(addr))
+	defer iterator.Close()
+
+	entries := []types.FeeLedgerEntry{}
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.FeeLedgerEntry
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &entry)
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// GetRefundableFees returns the fees of an address which can still be refunded
+func (k Keeper) GetRefundableFees(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	fees := sdk.Coins{}
+	for _, entry := range k.GetFeeLedger(ctx, addr) {
+		fees = fees.Add(sdk.NewCoin(entry.Denom, entry.Amount))
+	}
+
+	return fees
+}
+
+// GetFeeRemainder returns the value in bond denom of the refunds of an address not repaid because of rounding
+func (k Keeper) GetFeeRemainder(ctx sdk.Context, addr sdk.AccAddress) sdk.Dec {
+	bz := ctx.KVStore(k.storeKey).Get(feeRemainderKey(addr))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var remainder sdk.DecProto
+	k.cdc.MustUnmarshalBinaryBare(bz, &remainder)
+
+	return remainder.Dec
+}
+
+// SetFeeRemainder saves the value in bond denom of the refunds of an address not repaid because of rounding
+func (k Keeper) SetFeeRemainder(ctx sdk.Context, addr sdk.AccAddress, remainder sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	if !remainder.IsPositive() {
+		store.Delete(feeRemainderKey(addr))
+		return
+	}
+
+	store.Set(feeRemainderKey(addr), k.cdc.MustMarshalBinaryBare(&sdk.DecProto{Dec: remainder}))
+}
+
+// PruneFeeLedger removes the fee ledger entries older than the FEE_LEDGER_WINDOW network property, with the
+// remainder of the addresses left without entries
+func (k Keeper) PruneFeeLedger(ctx sdk.Context) {
+	window := k.cgk.GetNetworkProperties(ctx).FeeLedgerWindow
+	if window == 0 || ctx.BlockHeight() < int64(window) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyFeeLedgerHeight, feeLedgerHeightPrefix(ctx.BlockHeight()-int64(window)+1))
+	defer iterator.Close()
+
+	pruned := []types.FeeLedgerEntry{}
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.FeeLedgerEntry
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &entry)
+		pruned = append(pruned, entry)
+	}
+
+	for _, entry := range pruned {
+		k.DeleteFeeLedgerEntry(ctx, entry)
+	}
+
+	// the remainder is dropped with the last entry of an address
+	checked := map[string]bool{}
+	for _, entry := range pruned {
+		if checked[entry.Address.String()] {
+			continue
+		}
+		checked[entry.Address.String()] = true
+
+		if !k.hasFeeLedgerEntries(ctx, entry.Address) {
+			k.SetFeeRemainder(ctx, entry.Address, sdk.ZeroDec())
+		}
+	}
+}
+
+func (k Keeper) hasFeeLedgerEntries(ctx sdk.Context, addr sdk.AccAddress) bool {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), feeLedgerAddressKey(addr))
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+// feeLedgerAddressKey returns the key in format <KeyFeeLedger + address_bytes>
+func feeLedgerAddressKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, types.KeyFeeLedger...), addr.Bytes()...)
+}
+
+// feeLedgerKey returns the key in format <KeyFeeLedger + address_bytes + height_bytes + denom_bytes>
+func feeLedgerKey(addr sdk.AccAddress, height int64, denom string) []byte {
+	key := append(feeLedgerAddressKey(addr), sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, []byte(denom)...)
+}
+
+// feeLedgerHeightPrefix returns the key in format <KeyFeeLedgerHeight + height_bytes>
+func feeLedgerHeightPrefix(height int64) []byte {
+	return append(append([]byte{}, types.KeyFeeLedgerHeight...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// feeLedgerHeightKey returns the key in format <KeyFeeLedgerHeight + height_bytes + entry_key>, holding a copy
+// of the entry, this is used to prune the entries by height
+func feeLedgerHeightKey(height int64, entryKey []byte) []byte {
+	return append(feeLedgerHeightPrefix(height), entryKey...)
+}
+
+// feeRemainderKey returns the key in format <KeyFeeRemainder + address_bytes>
+func feeRemainderKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, types.KeyFeeRemainder...), addr.Bytes()...)
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KiraCore/sekai/x/feeprocessing/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Querier struct {
+	keeper Keeper
+}
+
+func NewQuerier(keeper Keeper) types.QueryServer {
+	return &Querier{keeper: keeper}
+}
+
+var _ types.QueryServer = Querier{}
+
+// RefundableFees returns the fees paid by an account which can still be refunded
+func (q Querier) RefundableFees(ctx context.Context, request *types.QueryRefundableFeesRequest) (*types.QueryRefundableFeesResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(request.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryRefundableFeesResponse{
+		Fees:      q.keeper.GetRefundableFees(sdkCtx, addr),
+		Entries:   q.keeper.GetFeeLedger(sdkCtx, addr),
+		Remainder: q.keeper.GetFeeRemainder(sdkCtx, addr),
+	}, nil
+}
@@ -0,0 +1,33 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/feeprocessing/keeper"
+	"github.com/KiraCore/sekai/x/feeprocessing/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestQuerier_RefundableFees(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 1})
+
+	addr := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.ZeroInt())[0]
+	fees := sdk.Coins{sdk.NewInt64Coin("ubtc", 5), sdk.NewInt64Coin("ukex", 100)}
+	app.FeeProcessingKeeper.AddFeeLedgerEntries(ctx, addr, fees)
+	app.FeeProcessingKeeper.SetFeeRemainder(ctx, addr, sdk.NewDec(3))
+
+	querier := keeper.NewQuerier(app.FeeProcessingKeeper)
+
+	res, err := querier.RefundableFees(sdk.WrapSDKContext(ctx), &types.QueryRefundableFeesRequest{Address: addr.String()})
+	require.NoError(t, err)
+	require.True(t, fees.IsEqual(res.Fees))
+	require.Len(t, res.Entries, 2)
+	require.Equal(t, sdk.NewDec(3), res.Remainder)
+
+	_, err = querier.RefundableFees(sdk.WrapSDKContext(ctx), &types.QueryRefundableFeesRequest{Address: "invalid"})
+	require.Error(t, err)
+}
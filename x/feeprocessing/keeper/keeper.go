@@ -2,13 +2,16 @@ package keeper
 
 import (
 	"bytes"
-	"encoding/json"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
 
 	"github.com/KiraCore/sekai/x/feeprocessing/types"
 	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 )
 
 // Keeper manages module's storage
@@ -36,130 +39,164 @@ func (k Keeper) BondDenom(ctx sdk.Context) string {
 	return "ukex"
 }
 
-// GetSenderCoinsHistory returns fee payment history of an address
-func (k Keeper) GetSenderCoinsHistory(ctx sdk.Context, senderAddr sdk.AccAddress) sdk.Coins {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyFeePaymentHistory)
-	bz := prefixStore.Get([]byte(senderAddr))
-	coins := []sdk.Coin{}
-	json.Unmarshal(bz, &coins)
-	return coins
-}
-
-// SetSenderCoinsHistory set fee payment history of an address
-func (k Keeper) SetSenderCoinsHistory(ctx sdk.Context, senderAddr sdk.AccAddress, coins sdk.Coins) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyFeePaymentHistory)
-	bz, _ := json.Marshal(coins)
-	prefixStore.Set([]byte(senderAddr), bz)
-}
-
-// SendCoinsFromModuleToAccount is a wrapper of bank keeper's SendCoinsFromModuleToAccount
+// SendCoinsFromModuleToAccount is a wrapper of bank keeper's SendCoinsFromModuleToAccount, the value of the
+// coins is repaid in the denoms of the fees the recipient paid, the latest fees being repaid first.
 func (k Keeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
-	recipientSentCoins := k.GetSenderCoinsHistory(ctx, recipientAddr)
-	paybackCoins := sdk.Coins{}
-	totalAmount := sdk.NewDec(0)
-	filledAmount := sdk.NewDec(0)
-
+	// the value left by the rounding of the previous refunds is repaid with this one
+	toFillAmount := k.GetFeeRemainder(ctx, recipientAddr)
 	for _, coin := range amt {
 		rate := k.tk.GetTokenRate(ctx, coin.Denom)
 		if rate != nil {
-			totalAmount = totalAmount.Add(rate.Rate.Mul(coin.Amount.ToDec()))
+			toFillAmount = toFillAmount.Add(rate.Rate.Mul(coin.Amount.ToDec()))
 		}
 	}
 
-	for _, coin := range recipientSentCoins {
-		rate := k.tk.GetTokenRate(ctx, coin.Denom)
-		if rate == nil {
+	paybackCoins := sdk.Coins{}
+	entries := k.GetFeeLedger(ctx, recipientAddr)
+	for i := len(entries) - 1; i >= 0 && toFillAmount.IsPositive(); i-- {
+		entry := entries[i]
+		rate := k.tk.GetTokenRate(ctx, entry.Denom)
+		if rate == nil || !rate.Rate.IsPositive() {
 			continue
 		}
-		toFillAmt := totalAmount.Sub(filledAmount)
-		fillAmt := rate.Rate.Mul(coin.Amount.ToDec())
-		if fillAmt.GT(toFillAmt) {
-			// we don't pay back full amount if there's remainder in div operation
-			coinAmt := toFillAmt.BigInt().Div(toFillAmt.BigInt(), rate.Rate.BigInt())
-			if coinAmt.Int64() > 0 {
-				paybackCoins = paybackCoins.Add(sdk.NewInt64Coin(coin.Denom, coinAmt.Int64()))
-				filledAmount = filledAmount.Add(rate.Rate.Mul(sdk.NewDec(coinAmt.Int64())))
-			}
-		} else {
-			filledAmount = filledAmount.Add(fillAmt)
-			paybackCoins = paybackCoins.Add(coin)
-		}
-		if totalAmount.Equal(filledAmount) {
-			break
+
+		// we don't pay back the remainder of the division, it is kept for the next refunds
+		coinAmt := sdk.MinInt(toFillAmount.QuoTruncate(rate.Rate).TruncateInt(), entry.Amount)
+		if !coinAmt.IsPositive() {
+			continue
 		}
+
+		paybackCoins = paybackCoins.Add(sdk.NewCoin(entry.Denom, coinAmt))
+		toFillAmount = toFillAmount.Sub(rate.Rate.MulInt(coinAmt))
+		entry.Amount = entry.Amount.Sub(coinAmt)
+		k.SetFeeLedgerEntry(ctx, entry)
 	}
 
-	k.SetSenderCoinsHistory(ctx, recipientAddr, recipientSentCoins.Sub(paybackCoins))
+	// there is nothing left to repay the remainder from once the fees of the recipient are repaid
+	if len(k.GetFeeLedger(ctx, recipientAddr)) == 0 {
+		toFillAmount = sdk.ZeroDec()
+	}
+	k.SetFeeRemainder(ctx, recipientAddr, toFillAmount)
+
 	return k.bk.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, paybackCoins)
 }
 
-// SendCoinsFromAccountToModule is a wrapper of bank keeper's SendCoinsFromAccountToModule
+// SendCoinsFromAccountToModule is a wrapper of bank keeper's SendCoinsFromAccountToModule, the coins are
+// recorded in the fee ledger of the sender
 func (k Keeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
-	coins := k.GetSenderCoinsHistory(ctx, senderAddr)
-	k.SetSenderCoinsHistory(ctx, senderAddr, coins.Add(amt...))
+	k.AddFeeLedgerEntries(ctx, senderAddr, amt)
 	return k.bk.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
 }
 
-// GetExecutionsStatus returns array of executions status registered on that block
+// GetExecutionsStatus returns the executions status registered on that block, ordered by tx hash and message index
 func (k Keeper) GetExecutionsStatus(ctx sdk.Context) []types.ExecutionStatus {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyExecutionStatus)
+	defer iterator.Close()
+
 	executions := []types.ExecutionStatus{}
-	store := ctx.KVStore(k.storeKey)
-	if store.Has(types.KeyExecutionStatus) {
-		bz := store.Get(types.KeyExecutionStatus)
-		json.Unmarshal(bz, &executions)
+	for ; iterator.Valid(); iterator.Next() {
+		var exec types.ExecutionStatus
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &exec)
+		executions = append(executions, exec)
 	}
 	return executions
 }
 
-// AddExecutionStart add execution on executions status array
-func (k Keeper) AddExecutionStart(ctx sdk.Context, msg sdk.Msg) {
-	executions := k.GetExecutionsStatus(ctx)
-	executions = append(executions, types.ExecutionStatus{
+// SetExecutionStatus saves the execution status of a message
+func (k Keeper) SetExecutionStatus(ctx sdk.Context, exec types.ExecutionStatus) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(executionStatusKey(exec.TxHash, exec.MsgIndex), k.cdc.MustMarshalBinaryBare(&exec))
+}
+
+// AddExecutionStart registers the execution of the message at msgIndex of the current tx, the fee payer of the tx
+// prepaid its execution fee
+func (k Keeper) AddExecutionStart(ctx sdk.Context, msgIndex uint32, msg sdk.Msg, feePayer sdk.AccAddress) {
+	k.SetExecutionStatus(ctx, types.ExecutionStatus{
+		TxHash:   txHash(ctx),
+		MsgIndex: msgIndex,
 		MsgType:  msg.Type(),
-		FeePayer: msg.GetSigners()[0],
+		FeePayer: feePayer,
 		Success:  false,
+		Signer:   msg.GetSigners()[0],
 	})
-	bz, _ := json.Marshal(executions)
-	store := ctx.KVStore(k.storeKey)
-	store.Set(types.KeyExecutionStatus, bz)
 }
 
-// SetExecutionStatusSuccess set statatus of particular message to true
+// SetExecutionStatusSuccess set status of the first pending execution of the message in the current tx to success
 func (k Keeper) SetExecutionStatusSuccess(ctx sdk.Context, msg sdk.Msg) {
-	executions := k.GetExecutionsStatus(ctx)
-	for i, exec := range executions {
-		// when execution message is same as param and success is false, just set success flag to be true and break
-		if exec.MsgType == msg.Type() && bytes.Equal(exec.FeePayer, msg.GetSigners()[0]) && exec.Success == false {
-			executions[i].Success = true
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), executionStatusTxKey(txHash(ctx)))
+	defer iterator.Close()
+
+	// the messages of a tx are executed in order and a failure reverts the tx, the first pending
+	// execution with the same type and signer is the message being executed
+	for ; iterator.Valid(); iterator.Next() {
+		var exec types.ExecutionStatus
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &exec)
+		if exec.MsgType == msg.Type() && bytes.Equal(exec.Signer, msg.GetSigners()[0]) && !exec.Success {
+			exec.Success = true
+			k.SetExecutionStatus(ctx, exec)
 			break
 		}
 	}
-	bz, _ := json.Marshal(executions)
-	store := ctx.KVStore(k.storeKey)
-	store.Set(types.KeyExecutionStatus, bz)
 }
 
 // ProcessExecutionFeeReturn process the executions fee return and clear it up
 func (k Keeper) ProcessExecutionFeeReturn(ctx sdk.Context) {
-	executions := k.GetExecutionsStatus(ctx)
-	for _, exec := range executions {
+	store := ctx.KVStore(k.storeKey)
+	bondDenom := k.BondDenom(ctx)
+	for _, exec := range k.GetExecutionsStatus(ctx) {
 		fee := k.cgk.GetExecutionFee(ctx, exec.MsgType)
 		if fee != nil {
-			amount := int64(0)
+			// the max of the execution and failure fees is prepaid, the fee of the result is charged
+			charged, amount := fee.FailureFee, uint64(0)
+			if exec.Success {
+				charged = fee.ExecutionFee
+			}
 			if exec.Success && fee.ExecutionFee < fee.FailureFee {
-				amount = int64(fee.FailureFee - fee.ExecutionFee)
+				amount = fee.FailureFee - fee.ExecutionFee
 			}
 			if !exec.Success && fee.FailureFee < fee.ExecutionFee {
-				amount = int64(fee.ExecutionFee - fee.FailureFee)
+				amount = fee.ExecutionFee - fee.FailureFee
 			}
+
+			ctx.EventManager().EmitEvent(newExecutionFeeEvent(types.EventTypeExecutionFeeCharge, exec, sdk.NewCoin(bondDenom, sdk.NewIntFromUint64(charged))))
 			if amount > 0 {
 				// handle extra fee based on handler result
-				bondDenom := k.BondDenom(ctx)
-				fees := sdk.Coins{sdk.NewInt64Coin(bondDenom, amount)}
-				k.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, exec.FeePayer, fees)
+				refund := sdk.NewCoin(bondDenom, sdk.NewIntFromUint64(amount))
+				if err := k.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, exec.FeePayer, sdk.Coins{refund}); err == nil {
+					ctx.EventManager().EmitEvent(newExecutionFeeEvent(types.EventTypeExecutionFeeRefund, exec, refund))
+				}
 			}
 		}
+		store.Delete(executionStatusKey(exec.TxHash, exec.MsgIndex))
 	}
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.KeyExecutionStatus)
+}
+
+// newExecutionFeeEvent returns an event describing the fee charged or refunded for an execution
+func newExecutionFeeEvent(eventType string, exec types.ExecutionStatus, amount sdk.Coin) sdk.Event {
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(types.AttributeKeyTxHash, strings.ToUpper(hex.EncodeToString(exec.TxHash))),
+		sdk.NewAttribute(types.AttributeKeyMsgIndex, fmt.Sprint(exec.MsgIndex)),
+		sdk.NewAttribute(types.AttributeKeyMsgType, exec.MsgType),
+		sdk.NewAttribute(types.AttributeKeyFeePayer, exec.FeePayer.String()),
+		sdk.NewAttribute(types.AttributeKeySuccess, fmt.Sprint(exec.Success)),
+		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+	)
+}
+
+// txHash returns the hash of the tx being processed
+func txHash(ctx sdk.Context) []byte {
+	return tmhash.Sum(ctx.TxBytes())
+}
+
+// executionStatusTxKey returns the key in format <KeyExecutionStatus + tx_hash>
+func executionStatusTxKey(txHash []byte) []byte {
+	return append(append([]byte{}, types.KeyExecutionStatus...), txHash...)
+}
+
+// executionStatusKey returns the key in format <KeyExecutionStatus + tx_hash + msg_index_bytes>
+func executionStatusKey(txHash []byte, msgIndex uint32) []byte {
+	indexBz := make([]byte, 4)
+	binary.BigEndian.PutUint32(indexBz, msgIndex)
+	return append(executionStatusTxKey(txHash), indexBz...)
 }
@@ -2,33 +2,63 @@ package keeper_test
 
 import (
 	"bytes"
+	"fmt"
 	"testing"
 
 	"github.com/KiraCore/sekai/simapp"
 	kiratypes "github.com/KiraCore/sekai/types"
+	"github.com/KiraCore/sekai/x/feeprocessing/types"
 	customgovtypes "github.com/KiraCore/sekai/x/gov/types"
 	tokenstypes "github.com/KiraCore/sekai/x/tokens/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
-func TestNewKeeper_SenderCoinsHistory(t *testing.T) {
+func TestNewKeeper_FeeLedger(t *testing.T) {
 	app := simapp.Setup(false)
-	ctx := app.NewContext(false, tmproto.Header{})
+	ctx := app.NewContext(false, tmproto.Header{Height: 1})
 
 	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(10))
 	addr := addrs[0]
 
-	savedFees := app.FeeProcessingKeeper.GetSenderCoinsHistory(ctx, addr)
-	require.True(t, savedFees.IsEqual(sdk.Coins{}))
-
-	fees := sdk.Coins{sdk.NewInt64Coin("ukex", 100)}
-	app.FeeProcessingKeeper.SetSenderCoinsHistory(ctx, addr, fees)
-
-	savedFees = app.FeeProcessingKeeper.GetSenderCoinsHistory(ctx, addr)
-	require.True(t, savedFees.IsEqual(fees))
+	require.Len(t, app.FeeProcessingKeeper.GetFeeLedger(ctx, addr), 0)
+	require.True(t, app.FeeProcessingKeeper.GetRefundableFees(ctx, addr).IsEqual(sdk.Coins{}))
+
+	// fees paid at the same height are added to the same entry
+	app.FeeProcessingKeeper.AddFeeLedgerEntries(ctx, addr, sdk.Coins{sdk.NewInt64Coin("ukex", 100)})
+	app.FeeProcessingKeeper.AddFeeLedgerEntries(ctx, addr, sdk.Coins{sdk.NewInt64Coin("ubtc", 5), sdk.NewInt64Coin("ukex", 50)})
+	require.Equal(t, []types.FeeLedgerEntry{
+		{Address: addr, Denom: "ubtc", Height: 1, Amount: sdk.NewInt(5)},
+		{Address: addr, Denom: "ukex", Height: 1, Amount: sdk.NewInt(150)},
+	}, app.FeeProcessingKeeper.GetFeeLedger(ctx, addr))
+
+	ctx = ctx.WithBlockHeight(3)
+	app.FeeProcessingKeeper.AddFeeLedgerEntries(ctx, addr, sdk.Coins{sdk.NewInt64Coin("ukex", 10)})
+	require.Len(t, app.FeeProcessingKeeper.GetFeeLedger(ctx, addr), 3)
+	require.True(t, app.FeeProcessingKeeper.GetRefundableFees(ctx, addr).IsEqual(sdk.Coins{
+		sdk.NewInt64Coin("ubtc", 5), sdk.NewInt64Coin("ukex", 160),
+	}))
+
+	// entries older than the window are pruned
+	properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	properties.FeeLedgerWindow = 2
+	app.CustomGovKeeper.SetNetworkProperties(ctx, properties)
+	app.FeeProcessingKeeper.SetFeeRemainder(ctx, addr, sdk.NewDecWithPrec(5, 1))
+
+	app.FeeProcessingKeeper.PruneFeeLedger(ctx)
+	require.Equal(t, []types.FeeLedgerEntry{
+		{Address: addr, Denom: "ukex", Height: 3, Amount: sdk.NewInt(10)},
+	}, app.FeeProcessingKeeper.GetFeeLedger(ctx, addr))
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), app.FeeProcessingKeeper.GetFeeRemainder(ctx, addr))
+
+	// the remainder is dropped with the last entry of the address
+	ctx = ctx.WithBlockHeight(5)
+	app.FeeProcessingKeeper.PruneFeeLedger(ctx)
+	require.Len(t, app.FeeProcessingKeeper.GetFeeLedger(ctx, addr), 0)
+	require.Equal(t, sdk.ZeroDec(), app.FeeProcessingKeeper.GetFeeRemainder(ctx, addr))
 }
 
 func TestNewKeeper_Executions(t *testing.T) {
@@ -43,17 +73,17 @@ func TestNewKeeper_Executions(t *testing.T) {
 	require.True(t, len(executions) == 0)
 
 	msg1 := tokenstypes.NewMsgUpsertTokenRate(addr, "ukex", sdk.NewDec(1), true)
-	app.FeeProcessingKeeper.AddExecutionStart(ctx, msg1)
+	app.FeeProcessingKeeper.AddExecutionStart(ctx, 0, msg1, msg1.GetSigners()[0])
 	executions = app.FeeProcessingKeeper.GetExecutionsStatus(ctx)
 	require.True(t, len(executions) == 1)
 
 	msg2 := tokenstypes.NewMsgUpsertTokenAlias(addr, "KEX", "Kira", "", 10, []string{"ukex"})
-	app.FeeProcessingKeeper.AddExecutionStart(ctx, msg2)
+	app.FeeProcessingKeeper.AddExecutionStart(ctx, 1, msg2, msg2.GetSigners()[0])
 	executions = app.FeeProcessingKeeper.GetExecutionsStatus(ctx)
 	require.True(t, len(executions) == 2)
 
 	msg3 := tokenstypes.NewMsgUpsertTokenRate(addr, "ukex", sdk.NewDec(1), true)
-	app.FeeProcessingKeeper.AddExecutionStart(ctx, msg3)
+	app.FeeProcessingKeeper.AddExecutionStart(ctx, 2, msg3, msg3.GetSigners()[0])
 	executions = app.FeeProcessingKeeper.GetExecutionsStatus(ctx)
 	require.True(t, len(executions) == 3)
 
@@ -95,7 +125,7 @@ func TestNewKeeper_SendCoinsFromAccountToModule(t *testing.T) {
 	balance = app.BankKeeper.GetBalance(ctx, feeCollectorAcc.GetAddress(), "ukex")
 	require.True(t, balance.Amount.Int64() == 100)
 
-	savedFees := app.FeeProcessingKeeper.GetSenderCoinsHistory(ctx, addr)
+	savedFees := app.FeeProcessingKeeper.GetRefundableFees(ctx, addr)
 	require.True(t, savedFees.IsEqual(fees))
 }
 
@@ -119,10 +149,36 @@ func TestNewKeeper_SendCoinsFromModuleToAccount(t *testing.T) {
 	balance = app.BankKeeper.GetBalance(ctx, feeCollectorAcc.GetAddress(), "ukex")
 	require.True(t, balance.Amount.Int64() == 100-10)
 
-	savedFees := app.FeeProcessingKeeper.GetSenderCoinsHistory(ctx, addr)
+	savedFees := app.FeeProcessingKeeper.GetRefundableFees(ctx, addr)
 	require.True(t, savedFees.IsEqual(fees.Sub(returnFees)))
 }
 
+func TestNewKeeper_SendCoinsFromModuleToAccount_PaidDenoms(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 1})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(10))
+	addr := addrs[0]
+	app.BankKeeper.SetBalances(ctx, addr, sdk.Coins{sdk.NewInt64Coin("ubtc", 100), sdk.NewInt64Coin("ukex", 10000)})
+
+	app.FeeProcessingKeeper.SendCoinsFromAccountToModule(ctx, addr, authtypes.FeeCollectorName, sdk.Coins{sdk.NewInt64Coin("ubtc", 5)})
+	ctx = ctx.WithBlockHeight(2)
+	app.FeeProcessingKeeper.SendCoinsFromAccountToModule(ctx, addr, authtypes.FeeCollectorName, sdk.Coins{sdk.NewInt64Coin("ukex", 10)})
+
+	// the latest fees are repaid first, the rest in ubtc at the rate of 10 ukex, keeping the remainder
+	app.FeeProcessingKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, addr, sdk.Coins{sdk.NewInt64Coin("ukex", 25)})
+	require.Equal(t, int64(10000), app.BankKeeper.GetBalance(ctx, addr, "ukex").Amount.Int64())
+	require.Equal(t, int64(96), app.BankKeeper.GetBalance(ctx, addr, "ubtc").Amount.Int64())
+	require.True(t, app.FeeProcessingKeeper.GetRefundableFees(ctx, addr).IsEqual(sdk.Coins{sdk.NewInt64Coin("ubtc", 4)}))
+	require.Equal(t, sdk.NewDec(5), app.FeeProcessingKeeper.GetFeeRemainder(ctx, addr))
+
+	// the remainder is repaid with the next refund
+	app.FeeProcessingKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, addr, sdk.Coins{sdk.NewInt64Coin("ukex", 5)})
+	require.Equal(t, int64(97), app.BankKeeper.GetBalance(ctx, addr, "ubtc").Amount.Int64())
+	require.True(t, app.FeeProcessingKeeper.GetRefundableFees(ctx, addr).IsEqual(sdk.Coins{sdk.NewInt64Coin("ubtc", 3)}))
+	require.Equal(t, sdk.ZeroDec(), app.FeeProcessingKeeper.GetFeeRemainder(ctx, addr))
+}
+
 func TestNewKeeper_ProcessExecutionFeeReturn(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.NewContext(false, tmproto.Header{})
@@ -148,7 +204,7 @@ func TestNewKeeper_ProcessExecutionFeeReturn(t *testing.T) {
 	fees := sdk.Coins{sdk.NewInt64Coin("ukex", 1000)}
 	app.FeeProcessingKeeper.SendCoinsFromAccountToModule(ctx, addr, authtypes.FeeCollectorName, fees)
 	msg := tokenstypes.NewMsgUpsertTokenRate(addr, "ukex", sdk.NewDec(1), true)
-	app.FeeProcessingKeeper.AddExecutionStart(ctx, msg)
+	app.FeeProcessingKeeper.AddExecutionStart(ctx, 0, msg, msg.GetSigners()[0])
 	app.FeeProcessingKeeper.ProcessExecutionFeeReturn(ctx)
 
 	feeCollectorAcc := app.AccountKeeper.GetModuleAccount(ctx, authtypes.FeeCollectorName)
@@ -160,7 +216,7 @@ func TestNewKeeper_ProcessExecutionFeeReturn(t *testing.T) {
 
 	// check success fee
 	app.FeeProcessingKeeper.SendCoinsFromAccountToModule(ctx, addr, authtypes.FeeCollectorName, fees)
-	app.FeeProcessingKeeper.AddExecutionStart(ctx, msg)
+	app.FeeProcessingKeeper.AddExecutionStart(ctx, 0, msg, msg.GetSigners()[0])
 	app.FeeProcessingKeeper.SetExecutionStatusSuccess(ctx, msg)
 	app.FeeProcessingKeeper.ProcessExecutionFeeReturn(ctx)
 
@@ -175,8 +231,8 @@ func TestNewKeeper_ProcessExecutionFeeReturn(t *testing.T) {
 	app.FeeProcessingKeeper.SendCoinsFromAccountToModule(ctx, addr3, authtypes.FeeCollectorName, fees)
 	msg2 := tokenstypes.NewMsgUpsertTokenRate(addr2, "ukex", sdk.NewDec(1), true)
 	msg3 := tokenstypes.NewMsgUpsertTokenRate(addr3, "ukex", sdk.NewDec(1), true)
-	app.FeeProcessingKeeper.AddExecutionStart(ctx, msg3)
-	app.FeeProcessingKeeper.AddExecutionStart(ctx, msg2)
+	app.FeeProcessingKeeper.AddExecutionStart(ctx, 0, msg3, msg3.GetSigners()[0])
+	app.FeeProcessingKeeper.AddExecutionStart(ctx, 1, msg2, msg2.GetSigners()[0])
 	app.FeeProcessingKeeper.SetExecutionStatusSuccess(ctx, msg2)
 	app.FeeProcessingKeeper.ProcessExecutionFeeReturn(ctx)
 
@@ -187,3 +243,80 @@ func TestNewKeeper_ProcessExecutionFeeReturn(t *testing.T) {
 	t.Log("BBB", balance)
 	require.True(t, balance.Amount.Int64() == 10000-100) // failure fee
 }
+
+func TestNewKeeper_ExecutionsPerTx(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(10))
+	addr := addrs[0]
+
+	// the same message in two txs is recorded and flagged separately
+	msg := tokenstypes.NewMsgUpsertTokenRate(addr, "ukex", sdk.NewDec(1), true)
+	tx1Ctx := ctx.WithTxBytes([]byte("tx1"))
+	tx2Ctx := ctx.WithTxBytes([]byte("tx2"))
+	app.FeeProcessingKeeper.AddExecutionStart(tx1Ctx, 0, msg, msg.GetSigners()[0])
+	app.FeeProcessingKeeper.AddExecutionStart(tx1Ctx, 1, msg, msg.GetSigners()[0])
+	app.FeeProcessingKeeper.AddExecutionStart(tx2Ctx, 0, msg, msg.GetSigners()[0])
+
+	app.FeeProcessingKeeper.SetExecutionStatusSuccess(tx2Ctx, msg)
+	app.FeeProcessingKeeper.SetExecutionStatusSuccess(tx1Ctx, msg)
+
+	executions := app.FeeProcessingKeeper.GetExecutionsStatus(ctx)
+	require.Len(t, executions, 3)
+	for _, exec := range executions {
+		switch {
+		case bytes.Equal(exec.TxHash, tmhash.Sum([]byte("tx1"))):
+			require.Equal(t, exec.MsgIndex == 0, exec.Success)
+		case bytes.Equal(exec.TxHash, tmhash.Sum([]byte("tx2"))):
+			require.True(t, exec.Success)
+		default:
+			t.Fatalf("unexpected tx hash %X", exec.TxHash)
+		}
+	}
+}
+
+func TestNewKeeper_ProcessExecutionFeeReturn_Events(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{}).WithTxBytes([]byte("tx"))
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(10))
+	addr := addrs[0]
+	app.BankKeeper.SetBalance(ctx, addr, sdk.NewInt64Coin("ukex", 10000))
+
+	app.CustomGovKeeper.SetExecutionFee(ctx, &customgovtypes.ExecutionFee{
+		Name:            kiratypes.MsgTypeUpsertTokenRate,
+		TransactionType: kiratypes.MsgTypeUpsertTokenRate,
+		ExecutionFee:    1000,
+		FailureFee:      100,
+	})
+
+	app.FeeProcessingKeeper.SendCoinsFromAccountToModule(ctx, addr, authtypes.FeeCollectorName, sdk.Coins{sdk.NewInt64Coin("ukex", 1000)})
+	msg := tokenstypes.NewMsgUpsertTokenRate(addr, "ukex", sdk.NewDec(1), true)
+	app.FeeProcessingKeeper.AddExecutionStart(ctx, 0, msg, msg.GetSigners()[0])
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.FeeProcessingKeeper.ProcessExecutionFeeReturn(ctx)
+
+	// the failed execution is charged the failure fee and refunded the rest
+	events := sdk.Events{}
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeExecutionFeeCharge || event.Type == types.EventTypeExecutionFeeRefund {
+			events = append(events, event)
+		}
+	}
+	require.Len(t, events, 2)
+	require.Equal(t, types.EventTypeExecutionFeeCharge, events[0].Type)
+	require.Equal(t, types.EventTypeExecutionFeeRefund, events[1].Type)
+
+	attributes := map[string]string{}
+	for _, attr := range events[1].Attributes {
+		attributes[string(attr.Key)] = string(attr.Value)
+	}
+	require.Equal(t, fmt.Sprintf("%X", tmhash.Sum([]byte("tx"))), attributes[types.AttributeKeyTxHash])
+	require.Equal(t, "0", attributes[types.AttributeKeyMsgIndex])
+	require.Equal(t, kiratypes.MsgTypeUpsertTokenRate, attributes[types.AttributeKeyMsgType])
+	require.Equal(t, addr.String(), attributes[types.AttributeKeyFeePayer])
+	require.Equal(t, "false", attributes[types.AttributeKeySuccess])
+	require.Equal(t, "900ukex", attributes[types.AttributeKeyAmount])
+}
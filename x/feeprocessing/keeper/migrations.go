@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"github.com/KiraCore/sekai/x/feeprocessing/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateV0119 migrates the store of the chains running v0.1.18, the fee payment history replaced by the fee
+// ledger is removed.
+func (k Keeper) MigrateV0119(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyFeePaymentHistory)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return nil
+}
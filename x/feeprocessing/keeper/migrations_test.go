@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KiraCore/sekai/simapp"
+	kiratypes "github.com/KiraCore/sekai/types"
+	"github.com/KiraCore/sekai/x/feeprocessing/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestKeeper_MigrateV0119(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 10})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.TokensFromConsensusPower(10))
+
+	// fee payment history of a chain running v0.1.18
+	store := ctx.KVStore(app.GetKey(types.ModuleName))
+	store.Set(append(append([]byte{}, types.KeyFeePaymentHistory...), addrs[0]...), []byte(`[{"denom":"ukex","amount":"100"}]`))
+	store.Set(append(append([]byte{}, types.KeyFeePaymentHistory...), addrs[1]...), []byte(`[{"denom":"ukex","amount":"10"}]`))
+
+	app.FeeProcessingKeeper.AddFeeLedgerEntries(ctx, addrs[0], sdk.Coins{sdk.NewInt64Coin("ukex", 100)})
+
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: kiratypes.UpgradeV0119, Height: 10})
+
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyFeePaymentHistory)
+	defer iterator.Close()
+	require.False(t, iterator.Valid())
+
+	// the fee ledger is kept
+	require.Len(t, app.FeeProcessingKeeper.GetFeeLedger(ctx, addrs[0]), 1)
+}
@@ -1,10 +1,13 @@
 package feeprocessing
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
+	kiratypes "github.com/KiraCore/sekai/types"
+	feeprocessingcli "github.com/KiraCore/sekai/x/feeprocessing/client/cli"
 	feeprocessingkeeper "github.com/KiraCore/sekai/x/feeprocessing/keeper"
 	feeprocessingtypes "github.com/KiraCore/sekai/x/feeprocessing/types"
 
@@ -22,6 +25,8 @@ import (
 var (
 	_ module.AppModule      = AppModule{}
 	_ module.AppModuleBasic = AppModuleBasic{}
+
+	_ kiratypes.HasMigrations = AppModule{}
 )
 
 type AppModuleBasic struct{}
@@ -47,7 +52,8 @@ func (b AppModuleBasic) ValidateGenesis(marshaler codec.JSONMarshaler, config cl
 func (b AppModuleBasic) RegisterRESTRoutes(context client.Context, router *mux.Router) {
 }
 
-func (b AppModuleBasic) RegisterGRPCRoutes(context client.Context, serveMux *runtime.ServeMux) {
+func (b AppModuleBasic) RegisterGRPCRoutes(clientCtx client.Context, serveMux *runtime.ServeMux) {
+	feeprocessingtypes.RegisterQueryHandlerClient(context.Background(), serveMux, feeprocessingtypes.NewQueryClient(clientCtx))
 }
 
 func (b AppModuleBasic) RegisterLegacyAminoCodec(amino *codec.LegacyAmino) {
@@ -59,7 +65,7 @@ func (b AppModuleBasic) GetTxCmd() *cobra.Command {
 
 // GetQueryCmd implement query commands for this module
 func (b AppModuleBasic) GetQueryCmd() *cobra.Command {
-	return nil
+	return feeprocessingcli.NewQueryCmd()
 }
 
 // AppModule extends the cosmos SDK gov.
@@ -68,12 +74,19 @@ type AppModule struct {
 	keeper feeprocessingkeeper.Keeper
 }
 
-func (am AppModule) RegisterServices(configurator module.Configurator) {
+// RegisterServices registers the gRPC query service of the module.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	feeprocessingtypes.RegisterQueryServer(cfg.QueryServer(), feeprocessingkeeper.NewQuerier(am.keeper))
 }
 
 func (am AppModule) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 }
 
+// RegisterMigrations registers the store migrations of the module by upgrade.
+func (am AppModule) RegisterMigrations(registry *kiratypes.MigrationRegistry) {
+	registry.Register(kiratypes.UpgradeV0119, feeprocessingtypes.ModuleName, am.keeper.MigrateV0119)
+}
+
 func (am AppModule) InitGenesis(
 	ctx sdk.Context,
 	cdc codec.JSONMarshaler,
@@ -0,0 +1,8 @@
+package types
+
+import "github.com/cosmos/cosmos-sdk/types/errors"
+
+// feeprocessing module errors
+var (
+	ErrExecutionTimeout = errors.Register(ModuleName, 2, "execution timed out")
+)
@@ -0,0 +1,14 @@
+package types
+
+// feeprocessing module event types
+const (
+	EventTypeExecutionFeeCharge = "execution_fee_charge"
+	EventTypeExecutionFeeRefund = "execution_fee_refund"
+
+	AttributeKeyTxHash   = "tx_hash"
+	AttributeKeyMsgIndex = "msg_index"
+	AttributeKeyMsgType  = "msg_type"
+	AttributeKeyFeePayer = "fee_payer"
+	AttributeKeySuccess  = "success"
+	AttributeKeyAmount   = "amount"
+)
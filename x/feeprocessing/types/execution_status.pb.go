@@ -0,0 +1,569 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: execution_status.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ExecutionStatus records the execution of a message charged an execution fee, the fee is settled at the end of the block.
+type ExecutionStatus struct {
+	// hash of the tx including the message
+	TxHash []byte `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// index of the message in the tx, the messages executed by a MsgExec follow it
+	MsgIndex uint32                                        `protobuf:"varint,2,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
+	MsgType  string                                        `protobuf:"bytes,3,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
+	FeePayer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=fee_payer,json=feePayer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"fee_payer,omitempty"`
+	// success is set once the handler of the message succeeded within its timeout
+	Success bool `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// signer of the message, the granter of the messages executed by a MsgExec
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,6,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *ExecutionStatus) Reset()         { *m = ExecutionStatus{} }
+func (m *ExecutionStatus) String() string { return proto.CompactTextString(m) }
+func (*ExecutionStatus) ProtoMessage()    {}
+func (*ExecutionStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3a155a4ecc08e53, []int{0}
+}
+func (m *ExecutionStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionStatus.Merge(m, src)
+}
+func (m *ExecutionStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionStatus proto.InternalMessageInfo
+
+func (m *ExecutionStatus) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *ExecutionStatus) GetMsgIndex() uint32 {
+	if m != nil {
+		return m.MsgIndex
+	}
+	return 0
+}
+
+func (m *ExecutionStatus) GetMsgType() string {
+	if m != nil {
+		return m.MsgType
+	}
+	return ""
+}
+
+func (m *ExecutionStatus) GetFeePayer() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.FeePayer
+	}
+	return nil
+}
+
+func (m *ExecutionStatus) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *ExecutionStatus) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ExecutionStatus)(nil), "kira.feeprocessing.ExecutionStatus")
+}
+
+func init() { proto.RegisterFile("execution_status.proto", fileDescriptor_f3a155a4ecc08e53) }
+
+var fileDescriptor_f3a155a4ecc08e53 = []byte{
+	// 315 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0xd0, 0x4f, 0x4b, 0xc3, 0x30,
+	0x18, 0x06, 0xf0, 0x65, 0x6a, 0xb7, 0x05, 0x45, 0x08, 0xa2, 0x55, 0xa1, 0x16, 0x4f, 0xbd, 0xac,
+	0x45, 0xfc, 0x04, 0x9b, 0x08, 0x0e, 0x41, 0xa4, 0x7a, 0xf2, 0x52, 0xba, 0xf4, 0x5d, 0x1a, 0x46,
+	0x9b, 0x92, 0x37, 0x85, 0xee, 0x5b, 0x78, 0xf3, 0x2b, 0x79, 0xdc, 0xd1, 0x93, 0xc8, 0xf6, 0x2d,
+	0x3c, 0x49, 0xf6, 0x07, 0xf4, 0xea, 0x29, 0xef, 0x43, 0xc2, 0x2f, 0xbc, 0x0f, 0x3d, 0x86, 0x06,
+	0x78, 0x6d, 0xa4, 0x2a, 0x13, 0x34, 0xa9, 0xa9, 0x31, 0xac, 0xb4, 0x32, 0x8a, 0xb1, 0xa9, 0xd4,
+	0x69, 0x38, 0x01, 0xa8, 0xb4, 0xe2, 0x80, 0x28, 0x4b, 0x71, 0x76, 0x24, 0x94, 0x50, 0xab, 0xeb,
+	0xc8, 0x4e, 0xeb, 0x97, 0x97, 0x6f, 0x6d, 0x7a, 0x78, 0xbb, 0x45, 0x9e, 0x56, 0x06, 0x3b, 0xa1,
+	0x1d, 0xd3, 0x24, 0x79, 0x8a, 0xb9, 0x4b, 0x7c, 0x12, 0xec, 0xc7, 0x8e, 0x69, 0xee, 0x52, 0xcc,
+	0xd9, 0x39, 0xed, 0x15, 0x28, 0x12, 0x59, 0x66, 0xd0, 0xb8, 0x6d, 0x9f, 0x04, 0x07, 0x71, 0xb7,
+	0x40, 0x31, 0xb2, 0x99, 0x9d, 0x52, 0x3b, 0x27, 0x66, 0x56, 0x81, 0xbb, 0xe3, 0x93, 0xa0, 0x17,
+	0x77, 0x0a, 0x14, 0xcf, 0xb3, 0x0a, 0xd8, 0x03, 0xed, 0x4d, 0x00, 0x92, 0x2a, 0x9d, 0x81, 0x76,
+	0x77, 0x2d, 0x39, 0xbc, 0xfa, 0xfe, 0xbc, 0xe8, 0x0b, 0x69, 0xf2, 0x7a, 0x1c, 0x72, 0x55, 0x44,
+	0x5c, 0x61, 0xa1, 0x70, 0x73, 0xf4, 0x31, 0x9b, 0x46, 0x16, 0xc2, 0x70, 0xc0, 0xf9, 0x20, 0xcb,
+	0x34, 0x20, 0xc6, 0xdd, 0x09, 0xc0, 0xa3, 0x25, 0x98, 0x4b, 0x3b, 0x58, 0x73, 0xbb, 0x98, 0xbb,
+	0xe7, 0x93, 0xa0, 0x1b, 0x6f, 0x23, 0x1b, 0x51, 0x07, 0xa5, 0x28, 0x41, 0xbb, 0xce, 0x7f, 0xbf,
+	0xd9, 0x00, 0xc3, 0xd1, 0xfb, 0xc2, 0x23, 0xf3, 0x85, 0x47, 0xbe, 0x16, 0x1e, 0x79, 0x5d, 0x7a,
+	0xad, 0xf9, 0xd2, 0x6b, 0x7d, 0x2c, 0xbd, 0xd6, 0x4b, 0xf4, 0x0b, 0xbc, 0x97, 0x3a, 0xbd, 0x51,
+	0x1a, 0x22, 0x84, 0x69, 0x2a, 0xa3, 0x26, 0xfa, 0x53, 0xfa, 0x5a, 0x1f, 0x3b, 0xab, 0xae, 0xaf,
+	0x7f, 0x06, 0x00, 0x21, 0x64, 0xd9, 0xa2, 0xaf, 0x01, 0x00, 0x00,
+}
+
+func (m *ExecutionStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintExecutionStatus(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintExecutionStatus(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintExecutionStatus(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MsgIndex != 0 {
+		i = encodeVarintExecutionStatus(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintExecutionStatus(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintExecutionStatus(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExecutionStatus(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExecutionStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovExecutionStatus(uint64(l))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovExecutionStatus(uint64(m.MsgIndex))
+	}
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovExecutionStatus(uint64(l))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovExecutionStatus(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovExecutionStatus(uint64(l))
+	}
+	return n
+}
+
+func sovExecutionStatus(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozExecutionStatus(x uint64) (n int) {
+	return sovExecutionStatus(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ExecutionStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutionStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExecutionStatus
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutionStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutionStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutionStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExecutionStatus
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutionStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = append(m.FeePayer[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeePayer == nil {
+				m.FeePayer = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExecutionStatus
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutionStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutionStatus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecutionStatus
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExecutionStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipExecutionStatus(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowExecutionStatus
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExecutionStatus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExecutionStatus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthExecutionStatus
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupExecutionStatus
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthExecutionStatus
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthExecutionStatus        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowExecutionStatus          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupExecutionStatus = fmt.Errorf("proto: unexpected end of group")
+)
@@ -20,4 +20,5 @@ type TokensKeeper interface {
 // CustomGovKeeper defines the expected interface contract the tokens module requires
 type CustomGovKeeper interface {
 	GetExecutionFee(ctx sdk.Context, txType string) *customgovtypes.ExecutionFee
+	GetNetworkProperties(ctx sdk.Context) *customgovtypes.NetworkProperties
 }
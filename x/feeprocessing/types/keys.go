@@ -8,6 +8,14 @@ const RouterKey = ModuleName
 
 // constants
 var (
+	// KeyFeePaymentHistory prefixed the fee payment history of v0.1.18, removed by the v0.1.19 upgrade
 	KeyFeePaymentHistory = []byte("fee_payment_history")
-	KeyExecutionStatus   = []byte("execution_status")
+	// KeyFeeLedger prefixes the fees paid per address, keyed by <address + height + denom>
+	KeyFeeLedger = []byte("fee_ledger_entry")
+	// KeyFeeLedgerHeight indexes a copy of the fee ledger entries by <height + entry_key>
+	KeyFeeLedgerHeight = []byte("fee_ledger_height")
+	// KeyFeeRemainder prefixes the refund rounding remainders per address
+	KeyFeeRemainder = []byte("fee_ledger_remainder")
+	// KeyExecutionStatus prefixes the execution status records, keyed by <tx_hash + msg_index>
+	KeyExecutionStatus = []byte("execution_status")
 )
@@ -0,0 +1,721 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryRefundableFeesRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryRefundableFeesRequest) Reset()         { *m = QueryRefundableFeesRequest{} }
+func (m *QueryRefundableFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRefundableFeesRequest) ProtoMessage()    {}
+func (*QueryRefundableFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{0}
+}
+func (m *QueryRefundableFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRefundableFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRefundableFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRefundableFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRefundableFeesRequest.Merge(m, src)
+}
+func (m *QueryRefundableFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRefundableFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRefundableFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRefundableFeesRequest proto.InternalMessageInfo
+
+func (m *QueryRefundableFeesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryRefundableFeesResponse struct {
+	// total of the ledger entries per denom
+	Fees    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	Entries []FeeLedgerEntry                         `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+	// value in bond denom of the refunds not repaid yet because of rounding
+	Remainder github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=remainder,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"remainder"`
+}
+
+func (m *QueryRefundableFeesResponse) Reset()         { *m = QueryRefundableFeesResponse{} }
+func (m *QueryRefundableFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRefundableFeesResponse) ProtoMessage()    {}
+func (*QueryRefundableFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{1}
+}
+func (m *QueryRefundableFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRefundableFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRefundableFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRefundableFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRefundableFeesResponse.Merge(m, src)
+}
+func (m *QueryRefundableFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRefundableFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRefundableFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRefundableFeesResponse proto.InternalMessageInfo
+
+func (m *QueryRefundableFeesResponse) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func (m *QueryRefundableFeesResponse) GetEntries() []FeeLedgerEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryRefundableFeesRequest)(nil), "kira.feeprocessing.QueryRefundableFeesRequest")
+	proto.RegisterType((*QueryRefundableFeesResponse)(nil), "kira.feeprocessing.QueryRefundableFeesResponse")
+}
+
+func init() { proto.RegisterFile("query.proto", fileDescriptor_5c6ac9b241082464) }
+
+var fileDescriptor_5c6ac9b241082464 = []byte{
+	// 433 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x6e, 0x13, 0x31,
+	0x10, 0xc6, 0xb3, 0x69, 0xa1, 0xaa, 0x2b, 0x21, 0x64, 0x71, 0x08, 0x01, 0x6d, 0x50, 0x0e, 0x28,
+	0x97, 0x7a, 0x68, 0x11, 0x3c, 0xc0, 0x16, 0x2a, 0x21, 0x7a, 0x61, 0x8f, 0x5c, 0x22, 0xef, 0xee,
+	0x64, 0xb1, 0x92, 0xd8, 0x5b, 0x8f, 0x17, 0x11, 0x21, 0x2e, 0x3c, 0x41, 0x25, 0x5e, 0x02, 0x71,
+	0xe4, 0x29, 0x7a, 0xac, 0xc4, 0x05, 0x71, 0x28, 0x28, 0xe1, 0x41, 0x90, 0xbd, 0x5b, 0xa0, 0xd0,
+	0x4a, 0x3d, 0xf9, 0xcf, 0xcc, 0x37, 0xf3, 0xcd, 0xcf, 0x66, 0x5b, 0x87, 0x35, 0xda, 0x85, 0xa8,
+	0xac, 0x71, 0x86, 0xf3, 0xa9, 0xb2, 0x52, 0x4c, 0x10, 0x2b, 0x6b, 0x72, 0x24, 0x52, 0xba, 0xec,
+	0xdf, 0x2a, 0x4d, 0x69, 0x42, 0x18, 0xfc, 0xae, 0xc9, 0xec, 0xdf, 0x2d, 0x8d, 0x29, 0x67, 0x08,
+	0xb2, 0x52, 0x20, 0xb5, 0x36, 0x4e, 0x3a, 0x65, 0x34, 0xb5, 0xd1, 0x38, 0x37, 0x34, 0x37, 0x04,
+	0x99, 0x24, 0x84, 0xd7, 0x3b, 0x19, 0x3a, 0xb9, 0x03, 0xb9, 0x51, 0xba, 0x8d, 0xdf, 0x9c, 0x20,
+	0x8e, 0x67, 0x58, 0x94, 0x68, 0x9b, 0x9b, 0xe1, 0x63, 0xd6, 0x7f, 0xe1, 0x8d, 0xa4, 0x38, 0xa9,
+	0x75, 0x21, 0xb3, 0x19, 0xee, 0x23, 0x52, 0x8a, 0x87, 0x35, 0x92, 0xe3, 0x3d, 0xb6, 0x21, 0x8b,
+	0xc2, 0x22, 0x51, 0x2f, 0xba, 0x17, 0x8d, 0x36, 0xd3, 0xb3, 0xe3, 0xf0, 0xa8, 0xcb, 0xee, 0x5c,
+	0x28, 0xa4, 0xca, 0x68, 0x42, 0x3e, 0x66, 0xeb, 0x13, 0x44, 0x2f, 0x5b, 0x1b, 0x6d, 0xed, 0xde,
+	0x16, 0x8d, 0x31, 0xe1, 0x8d, 0x89, 0xd6, 0x98, 0xd8, 0x33, 0x4a, 0x27, 0x0f, 0x8e, 0x4f, 0x07,
+	0x9d, 0x4f, 0xdf, 0x07, 0xa3, 0x52, 0xb9, 0x57, 0x75, 0x26, 0x72, 0x33, 0x87, 0x76, 0x8a, 0x66,
+	0xd9, 0xa6, 0x62, 0x0a, 0x6e, 0x51, 0x21, 0x05, 0x01, 0xa5, 0xa1, 0x30, 0x4f, 0xd8, 0x06, 0x6a,
+	0x67, 0x15, 0x52, 0xaf, 0x1b, 0x7a, 0x0c, 0xc5, 0xff, 0x10, 0xc5, 0x3e, 0xe2, 0x41, 0x18, 0xf7,
+	0xa9, 0x76, 0x76, 0x91, 0xac, 0xfb, 0x66, 0xe9, 0x99, 0x90, 0x1f, 0xb0, 0x4d, 0x8b, 0x73, 0xa9,
+	0x74, 0x81, 0xb6, 0xb7, 0xe6, 0x07, 0x4c, 0x84, 0xcf, 0xf8, 0x76, 0x3a, 0xb8, 0x7f, 0x05, 0x3b,
+	0x4f, 0x30, 0x4f, 0xff, 0x14, 0xd8, 0xfd, 0x1c, 0xb1, 0x6b, 0x01, 0x09, 0xff, 0x18, 0xb1, 0x1b,
+	0xe7, 0xb9, 0x70, 0x71, 0x91, 0xbb, 0xcb, 0xc9, 0xf7, 0xe1, 0xca, 0xf9, 0x0d, 0xf0, 0xe1, 0xa3,
+	0xf7, 0x5f, 0x7e, 0x7e, 0xe8, 0x02, 0xdf, 0x06, 0x2f, 0x84, 0x73, 0x42, 0xb0, 0xbf, 0x35, 0x63,
+	0x0f, 0x0f, 0xde, 0xb6, 0xcf, 0xf8, 0x2e, 0x79, 0x76, 0xbc, 0x8c, 0xa3, 0x93, 0x65, 0x1c, 0xfd,
+	0x58, 0xc6, 0xd1, 0xd1, 0x2a, 0xee, 0x9c, 0xac, 0xe2, 0xce, 0xd7, 0x55, 0xdc, 0x79, 0x09, 0x7f,
+	0x11, 0x78, 0xae, 0xac, 0xdc, 0x33, 0x16, 0x81, 0x70, 0x2a, 0x15, 0xbc, 0xf9, 0xa7, 0x7c, 0xc0,
+	0x91, 0x5d, 0x0f, 0x3f, 0xea, 0xe1, 0xaf, 0x01, 0x00, 0x86, 0x49, 0x4f, 0x5d, 0xda, 0x02, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// RefundableFees queries the fees of an account which can still be refunded
+	RefundableFees(ctx context.Context, in *QueryRefundableFeesRequest, opts ...grpc.CallOption) (*QueryRefundableFeesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) RefundableFees(ctx context.Context, in *QueryRefundableFeesRequest, opts ...grpc.CallOption) (*QueryRefundableFeesResponse, error) {
+	out := new(QueryRefundableFeesResponse)
+	err := c.cc.Invoke(ctx, "/kira.feeprocessing.Query/RefundableFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// RefundableFees queries the fees of an account which can still be refunded
+	RefundableFees(context.Context, *QueryRefundableFeesRequest) (*QueryRefundableFeesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) RefundableFees(ctx context.Context, req *QueryRefundableFeesRequest) (*QueryRefundableFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundableFees not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_RefundableFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRefundableFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RefundableFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.feeprocessing.Query/RefundableFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RefundableFees(ctx, req.(*QueryRefundableFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kira.feeprocessing.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RefundableFees",
+			Handler:    _Query_RefundableFees_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "query.proto",
+}
+
+func (m *QueryRefundableFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRefundableFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRefundableFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRefundableFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRefundableFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRefundableFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Remainder.Size()
+		i -= size
+		if _, err := m.Remainder.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryRefundableFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRefundableFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Remainder.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryRefundableFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRefundableFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRefundableFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRefundableFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRefundableFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRefundableFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, FeeLedgerEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remainder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
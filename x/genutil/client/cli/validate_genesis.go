@@ -7,8 +7,13 @@ import (
 	"github.com/spf13/cobra"
 	tmtypes "github.com/tendermint/tendermint/types"
 
+	customgovtypes "github.com/KiraCore/sekai/x/gov/types"
+	customstakingtypes "github.com/KiraCore/sekai/x/staking/types"
+	tokenstypes "github.com/KiraCore/sekai/x/tokens/types"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
@@ -48,12 +53,48 @@ func ValidateGenesisCmd(mbm module.BasicManager) *cobra.Command {
 				return fmt.Errorf("error validating genesis file %s: %s", genesis, err.Error())
 			}
 
+			if err = ValidateCrossModuleGenesis(cdc, genState); err != nil {
+				return fmt.Errorf("error validating genesis file %s: %s", genesis, err.Error())
+			}
+
 			fmt.Printf("File at %s is a valid genesis file\n", genesis)
 			return nil
 		},
 	}
 }
 
+// ValidateCrossModuleGenesis checks the genesis states of the modules agree with each other, every
+// validator account must be granted PermClaimValidator and the bond denom must be accepted as fee payment.
+func ValidateCrossModuleGenesis(cdc codec.JSONMarshaler, genState map[string]json.RawMessage) error {
+	var stakingGenesis customstakingtypes.GenesisState
+	if err := cdc.UnmarshalJSON(genState[customstakingtypes.ModuleName], &stakingGenesis); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", customstakingtypes.ModuleName, err)
+	}
+
+	var govGenesis customgovtypes.GenesisState
+	if err := cdc.UnmarshalJSON(genState[customgovtypes.ModuleName], &govGenesis); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", customgovtypes.ModuleName, err)
+	}
+
+	var tokensGenesis tokenstypes.GenesisState
+	if err := cdc.UnmarshalJSON(genState[tokenstypes.ModuleName], &tokensGenesis); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", tokenstypes.ModuleName, err)
+	}
+
+	for _, val := range append(append([]customstakingtypes.Validator{}, stakingGenesis.Validators...), stakingGenesis.PendingValidators...) {
+		if !govGenesis.IsAllowed(sdk.AccAddress(val.ValKey), customgovtypes.PermClaimValidator) {
+			return fmt.Errorf("account of validator %s is not granted %s", val.ValKey, customgovtypes.PermClaimValidator)
+		}
+	}
+
+	rate, ok := tokensGenesis.Rates[customstakingtypes.BondDenom]
+	if !ok || rate == nil || !rate.FeePayments {
+		return fmt.Errorf("fee payment denom %s has no token rate accepting fee payments", customstakingtypes.BondDenom)
+	}
+
+	return nil
+}
+
 // validateGenDoc reads a genesis file and validates that it is a correct
 // Tendermint GenesisDoc. This function does not do any cosmos-related
 // validation.
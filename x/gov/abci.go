@@ -16,6 +16,10 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper, router ProposalRouter) {
 	for ; iterator.Valid(); iterator.Next() {
 		processProposal(ctx, k, keeper.BytesToProposalID(iterator.Value()))
 	}
+
+	k.RemoveExpiredPermissions(ctx)
+	k.RemoveExpiredAuthorizations(ctx)
+	k.EndCouncilorTerms(ctx)
 }
 
 func processProposal(ctx sdk.Context, k keeper.Keeper, proposalID uint64) {
@@ -24,23 +28,24 @@ func processProposal(ctx sdk.Context, k keeper.Keeper, proposalID uint64) {
 		panic("proposal was expected to exist")
 	}
 
-	votes := k.GetProposalVotes(ctx, proposalID)
-
-	availableVoters := k.GetNetworkActorsByAbsoluteWhitelistPermission(ctx, proposal.GetContent().VotePermission())
+	availableVoters := k.GetNetworkActorsByAbsoluteWhitelistPermissions(ctx, types.VotePermissions(proposal.GetContent()))
 	totalVoters := len(availableVoters)
-	numVotes := len(votes)
 
 	quorum := k.GetNetworkProperties(ctx).VoteQuorum
 
-	isQuorum, err := types.IsQuorum(quorum, uint64(numVotes), uint64(totalVoters))
+	k.UpdateCouncilorsActivity(ctx, proposalID, availableVoters)
+
+	calculatedVote := k.TallyVotes(ctx, proposalID, availableVoters)
+	proposal.WeightedTally = calculatedVote.WeightedTally()
+	proposal.TallyResult = calculatedVote.TallyResult(uint64(totalVoters), quorum)
+
+	// the quorum weighs the votes and the voters the same way as the tally
+	isQuorum, err := types.IsWeightedQuorum(quorum, calculatedVote.TotalWeight(), k.GetVotersWeight(ctx, availableVoters))
 	if err != nil {
 		panic(err)
 	}
 
 	if isQuorum {
-		numActorsWithVeto := len(types.GetActorsWithVoteWithVeto(availableVoters))
-		calculatedVote := types.CalculateVotes(votes, uint64(numActorsWithVeto))
-
 		proposal.Result = calculatedVote.ProcessResult()
 		if proposal.Result == types.Passed { // This is done in order to show that proposal is in enactment, but after enactment passes it will be passed.
 			proposal.Result = types.Enactment
@@ -49,6 +54,15 @@ func processProposal(ctx sdk.Context, k keeper.Keeper, proposalID uint64) {
 		proposal.Result = types.QuorumNotReached
 	}
 
+	if proposal.Result == types.RejectedWithVeto || proposal.Result == types.QuorumNotReached {
+		err = k.BurnDeposit(ctx, proposalID)
+	} else {
+		err = k.RefundDeposit(ctx, proposalID)
+	}
+	if err != nil {
+		panic(err)
+	}
+
 	k.SaveProposal(ctx, proposal)
 	k.RemoveActiveProposal(ctx, proposal)
 	k.AddToEnactmentProposals(ctx, proposal)
@@ -61,8 +75,12 @@ func processEnactmentProposal(ctx sdk.Context, k keeper.Keeper, router ProposalR
 	}
 
 	if proposal.Result == types.Enactment {
-		router.ApplyProposal(ctx, proposal.GetContent())
-		proposal.Result = types.Passed
+		err := router.ApplyProposal(ctx, proposal.GetContent())
+		if err != nil {
+			proposal.Result = types.EnactmentFailed
+		} else {
+			proposal.Result = types.Passed
+		}
 		k.SaveProposal(ctx, proposal)
 	}
 
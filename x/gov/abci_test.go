@@ -9,6 +9,7 @@ import (
 	tokenstypes "github.com/KiraCore/sekai/x/tokens/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
@@ -41,6 +42,11 @@ func TestEndBlocker_ActiveProposal(t *testing.T) {
 				)
 				require.NoError(t, err)
 
+				err = app.CustomGovKeeper.SetNetworkProperty(ctx, types.MinProposalDeposit, 50)
+				require.NoError(t, err)
+				err = app.CustomGovKeeper.CollectProposalDeposit(ctx, proposalID, addrs[0], proposal.GetContent())
+				require.NoError(t, err)
+
 				app.CustomGovKeeper.SaveProposal(ctx, proposal)
 				app.CustomGovKeeper.AddToActiveProposals(ctx, proposal)
 
@@ -75,6 +81,11 @@ func TestEndBlocker_ActiveProposal(t *testing.T) {
 				proposal, found := app.CustomGovKeeper.GetProposal(ctx, 1234)
 				require.True(t, found)
 				require.Equal(t, types.QuorumNotReached, proposal.Result)
+
+				// The deposit is burned
+				require.Equal(t, sdk.NewInt(50), app.BankKeeper.GetBalance(ctx, addrs[0], "ukex").Amount)
+				moduleAddr := app.AccountKeeper.GetModuleAddress(types.ModuleName)
+				require.True(t, app.BankKeeper.GetBalance(ctx, moduleAddr, "ukex").IsZero())
 			},
 		},
 		{
@@ -95,6 +106,11 @@ func TestEndBlocker_ActiveProposal(t *testing.T) {
 				)
 				require.NoError(t, err)
 
+				err = app.CustomGovKeeper.SetNetworkProperty(ctx, types.MinProposalDeposit, 50)
+				require.NoError(t, err)
+				err = app.CustomGovKeeper.CollectProposalDeposit(ctx, proposalID, addrs[0], proposal.GetContent())
+				require.NoError(t, err)
+
 				app.CustomGovKeeper.SaveProposal(ctx, proposal)
 				require.NoError(t, err)
 				app.CustomGovKeeper.AddToActiveProposals(ctx, proposal)
@@ -136,6 +152,119 @@ func TestEndBlocker_ActiveProposal(t *testing.T) {
 				proposal, found := app.CustomGovKeeper.GetProposal(ctx, 1234)
 				require.True(t, found)
 				require.Equal(t, types.Enactment, proposal.Result)
+
+				// The deposit is refunded
+				require.Equal(t, sdk.NewInt(100), app.BankKeeper.GetBalance(ctx, addrs[0], "ukex").Amount)
+			},
+		},
+		{
+			name: "proposal with role weighted votes is rejected",
+			prepareScenario: func(app *simapp.SimApp, ctx sdk.Context) []sdk.AccAddress {
+				addrs := simapp.AddTestAddrsIncremental(app, ctx, 10, sdk.NewInt(100))
+
+				err := app.CustomGovKeeper.SetNetworkProperty(ctx, types.VoteWeightMode, types.VoteWeightModeRole)
+				require.NoError(t, err)
+				app.CustomGovKeeper.SetRoleVoteWeight(ctx, types.RoleValidator, sdk.NewDec(10))
+
+				proposalID := uint64(1234)
+				proposal, err := types.NewProposal(
+					proposalID,
+					types.NewAssignPermissionProposal(
+						addrs[0],
+						types.PermSetPermissions,
+					),
+					time.Now(),
+					time.Now().Add(10*time.Second),
+					time.Now().Add(20*time.Second),
+				)
+				require.NoError(t, err)
+
+				app.CustomGovKeeper.SaveProposal(ctx, proposal)
+				app.CustomGovKeeper.AddToActiveProposals(ctx, proposal)
+
+				for i, addr := range addrs {
+					actor := types.NewDefaultActor(addr)
+					err := app.CustomGovKeeper.AddWhitelistPermission(ctx, actor, types.PermVoteSetPermissionProposal)
+					require.NoError(t, err)
+
+					// 4 actors vote yes, a validator weighting 10 votes no.
+					if i < 4 {
+						app.CustomGovKeeper.SaveVote(ctx, types.NewVote(proposalID, addr, types.OptionYes))
+					}
+					if i == 4 {
+						actor, _ = app.CustomGovKeeper.GetNetworkActorByAddress(ctx, addr)
+						app.CustomGovKeeper.AssignRoleToActor(ctx, actor, types.RoleValidator)
+						app.CustomGovKeeper.SaveVote(ctx, types.NewVote(proposalID, addr, types.OptionNo))
+					}
+				}
+
+				return addrs
+			},
+			validateScenario: func(t *testing.T, app *simapp.SimApp, ctx sdk.Context, addrs []sdk.AccAddress) {
+				proposal, found := app.CustomGovKeeper.GetProposal(ctx, 1234)
+				require.True(t, found)
+				require.Equal(t, types.Rejected, proposal.Result)
+				require.Equal(t, types.WeightedTally{
+					Yes:        sdk.NewDec(4),
+					Abstain:    sdk.ZeroDec(),
+					No:         sdk.NewDec(10),
+					NoWithVeto: sdk.ZeroDec(),
+				}, proposal.WeightedTally)
+				require.Equal(t, types.TallyResult{
+					Yes:         4,
+					No:          1,
+					TotalVoters: 10,
+					Quorum:      app.CustomGovKeeper.GetNetworkProperties(ctx).VoteQuorum,
+				}, proposal.TallyResult)
+			},
+		},
+		{
+			name: "proposal with role weighted votes does not reach the weighted quorum",
+			prepareScenario: func(app *simapp.SimApp, ctx sdk.Context) []sdk.AccAddress {
+				addrs := simapp.AddTestAddrsIncremental(app, ctx, 10, sdk.NewInt(100))
+
+				err := app.CustomGovKeeper.SetNetworkProperty(ctx, types.VoteWeightMode, types.VoteWeightModeRole)
+				require.NoError(t, err)
+				app.CustomGovKeeper.SetRoleVoteWeight(ctx, types.RoleValidator, sdk.NewDec(20))
+
+				proposalID := uint64(1234)
+				proposal, err := types.NewProposal(
+					proposalID,
+					types.NewAssignPermissionProposal(
+						addrs[0],
+						types.PermSetPermissions,
+					),
+					time.Now(),
+					time.Now().Add(10*time.Second),
+					time.Now().Add(20*time.Second),
+				)
+				require.NoError(t, err)
+
+				app.CustomGovKeeper.SaveProposal(ctx, proposal)
+				app.CustomGovKeeper.AddToActiveProposals(ctx, proposal)
+
+				for i, addr := range addrs {
+					actor := types.NewDefaultActor(addr)
+					err := app.CustomGovKeeper.AddWhitelistPermission(ctx, actor, types.PermVoteSetPermissionProposal)
+					require.NoError(t, err)
+
+					// 4 of the 10 actors vote yes, the validator weighting 20 does not vote.
+					if i < 4 {
+						app.CustomGovKeeper.SaveVote(ctx, types.NewVote(proposalID, addr, types.OptionYes))
+					}
+					if i == 9 {
+						actor, _ = app.CustomGovKeeper.GetNetworkActorByAddress(ctx, addr)
+						app.CustomGovKeeper.AssignRoleToActor(ctx, actor, types.RoleValidator)
+					}
+				}
+
+				return addrs
+			},
+			validateScenario: func(t *testing.T, app *simapp.SimApp, ctx sdk.Context, addrs []sdk.AccAddress) {
+				// 4 votes out of 10 voters, but only 4 out of 29 vote weight
+				proposal, found := app.CustomGovKeeper.GetProposal(ctx, 1234)
+				require.True(t, found)
+				require.Equal(t, types.QuorumNotReached, proposal.Result)
 			},
 		},
 		{
@@ -456,6 +585,8 @@ func TestEndBlocker_ActiveProposal(t *testing.T) {
 					proposalID,
 					types.NewCreateRoleProposal(
 						types.Role(1000),
+						"role_1000",
+						"",
 						[]types.PermValue{
 							types.PermClaimValidator,
 						},
@@ -490,6 +621,321 @@ func TestEndBlocker_ActiveProposal(t *testing.T) {
 				require.True(t, found)
 				require.True(t, perms.IsWhitelisted(types.PermClaimValidator))
 				require.True(t, perms.IsBlacklisted(types.PermChangeTxFee))
+
+				info, found := app.CustomGovKeeper.GetRoleInfoBySid(ctx, "role_1000")
+				require.True(t, found)
+				require.Equal(t, uint32(1000), info.Id)
+			},
+		},
+		{
+			name: "Passed proposal in enactment is applied and removed from enactment list: Set Role Vote Weight",
+			prepareScenario: func(app *simapp.SimApp, ctx sdk.Context) []sdk.AccAddress {
+				addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(100))
+
+				proposalID := uint64(1234)
+				proposal, err := types.NewProposal(
+					proposalID,
+					types.NewSetRoleVoteWeightProposal(
+						types.RoleValidator,
+						sdk.NewDec(3),
+					),
+					time.Now(),
+					time.Now().Add(10*time.Second),
+					time.Now().Add(20*time.Second),
+				)
+				require.NoError(t, err)
+
+				proposal.Result = types.Enactment
+				app.CustomGovKeeper.SaveProposal(ctx, proposal)
+
+				app.CustomGovKeeper.AddToEnactmentProposals(ctx, proposal)
+
+				return addrs
+			},
+			validateScenario: func(t *testing.T, app *simapp.SimApp, ctx sdk.Context, addrs []sdk.AccAddress) {
+				iterator := app.CustomGovKeeper.GetEnactmentProposalsWithFinishedEnactmentEndTimeIterator(ctx, time.Now().Add(25*time.Second))
+				requireIteratorCount(t, iterator, 0)
+
+				require.Equal(t, sdk.NewDec(3), app.CustomGovKeeper.GetRoleVoteWeight(ctx, types.RoleValidator))
+			},
+		},
+		{
+			name: "Passed proposal in enactment is applied and removed from enactment list: Parameter Change",
+			prepareScenario: func(app *simapp.SimApp, ctx sdk.Context) []sdk.AccAddress {
+				addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(100))
+
+				proposalID := uint64(1234)
+				proposal, err := types.NewProposal(
+					proposalID,
+					types.NewParameterChangeProposal(
+						[]types.ParamChange{
+							types.NewParamChange("customslashing", "SignedBlocksWindow", types.ParamValueTypeUint, "200"),
+							types.NewParamChange("customslashing", "DowntimeInactiveDuration", types.ParamValueTypeDuration, "1h"),
+						},
+					),
+					time.Now(),
+					time.Now().Add(10*time.Second),
+					time.Now().Add(20*time.Second),
+				)
+				require.NoError(t, err)
+
+				proposal.Result = types.Enactment
+				app.CustomGovKeeper.SaveProposal(ctx, proposal)
+
+				app.CustomGovKeeper.AddToEnactmentProposals(ctx, proposal)
+
+				return addrs
+			},
+			validateScenario: func(t *testing.T, app *simapp.SimApp, ctx sdk.Context, addrs []sdk.AccAddress) {
+				iterator := app.CustomGovKeeper.GetEnactmentProposalsWithFinishedEnactmentEndTimeIterator(ctx, time.Now().Add(25*time.Second))
+				requireIteratorCount(t, iterator, 0)
+
+				require.Equal(t, int64(200), app.CustomSlashingKeeper.SignedBlocksWindow(ctx))
+				require.Equal(t, time.Hour, app.CustomSlashingKeeper.DowntimeInactiveDuration(ctx))
+			},
+		},
+		{
+			name: "Passed proposal in enactment is applied and removed from enactment list: Multi Content",
+			prepareScenario: func(app *simapp.SimApp, ctx sdk.Context) []sdk.AccAddress {
+				addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(100))
+
+				content, err := types.NewMultiContentProposal([]types.Content{
+					types.NewSetRoleVoteWeightProposal(types.RoleValidator, sdk.NewDec(3)),
+					types.NewAssignPermissionProposal(addrs[0], types.PermClaimValidator),
+				})
+				require.NoError(t, err)
+
+				proposal, err := types.NewProposal(
+					1234,
+					content,
+					time.Now(),
+					time.Now().Add(10*time.Second),
+					time.Now().Add(20*time.Second),
+				)
+				require.NoError(t, err)
+
+				proposal.Result = types.Enactment
+				app.CustomGovKeeper.SaveProposal(ctx, proposal)
+
+				app.CustomGovKeeper.AddToEnactmentProposals(ctx, proposal)
+
+				return addrs
+			},
+			validateScenario: func(t *testing.T, app *simapp.SimApp, ctx sdk.Context, addrs []sdk.AccAddress) {
+				iterator := app.CustomGovKeeper.GetEnactmentProposalsWithFinishedEnactmentEndTimeIterator(ctx, time.Now().Add(25*time.Second))
+				requireIteratorCount(t, iterator, 0)
+
+				require.Equal(t, sdk.NewDec(3), app.CustomGovKeeper.GetRoleVoteWeight(ctx, types.RoleValidator))
+
+				actor, found := app.CustomGovKeeper.GetNetworkActorByAddress(ctx, addrs[0])
+				require.True(t, found)
+				require.True(t, actor.Permissions.IsWhitelisted(types.PermClaimValidator))
+
+				proposal, found := app.CustomGovKeeper.GetProposal(ctx, 1234)
+				require.True(t, found)
+				require.Equal(t, types.Passed, proposal.Result)
+			},
+		},
+		{
+			name: "Multi content proposal in enactment is reverted when one of its contents fails",
+			prepareScenario: func(app *simapp.SimApp, ctx sdk.Context) []sdk.AccAddress {
+				addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(100))
+
+				// the slashing params validator rejects an empty signed blocks window
+				content, err := types.NewMultiContentProposal([]types.Content{
+					types.NewSetRoleVoteWeightProposal(types.RoleValidator, sdk.NewDec(3)),
+					types.NewParameterChangeProposal([]types.ParamChange{
+						types.NewParamChange("customslashing", "SignedBlocksWindow", types.ParamValueTypeUint, "0"),
+					}),
+				})
+				require.NoError(t, err)
+
+				proposal, err := types.NewProposal(
+					1234,
+					content,
+					time.Now(),
+					time.Now().Add(10*time.Second),
+					time.Now().Add(20*time.Second),
+				)
+				require.NoError(t, err)
+
+				proposal.Result = types.Enactment
+				app.CustomGovKeeper.SaveProposal(ctx, proposal)
+
+				app.CustomGovKeeper.AddToEnactmentProposals(ctx, proposal)
+
+				return addrs
+			},
+			validateScenario: func(t *testing.T, app *simapp.SimApp, ctx sdk.Context, addrs []sdk.AccAddress) {
+				iterator := app.CustomGovKeeper.GetEnactmentProposalsWithFinishedEnactmentEndTimeIterator(ctx, time.Now().Add(25*time.Second))
+				requireIteratorCount(t, iterator, 0)
+
+				// the role vote weight set by the first content is reverted
+				require.Equal(t, sdk.OneDec(), app.CustomGovKeeper.GetRoleVoteWeight(ctx, types.RoleValidator))
+
+				proposal, found := app.CustomGovKeeper.GetProposal(ctx, 1234)
+				require.True(t, found)
+				require.Equal(t, types.EnactmentFailed, proposal.Result)
+			},
+		},
+		{
+			name: "Passed proposal in enactment is applied and removed from enactment list: Software Upgrade",
+			prepareScenario: func(app *simapp.SimApp, ctx sdk.Context) []sdk.AccAddress {
+				addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(100))
+
+				proposal, err := types.NewProposal(
+					1234,
+					types.NewSoftwareUpgradeProposal(upgradetypes.Plan{Name: "v0.2.0", Height: 100}),
+					time.Now(),
+					time.Now().Add(10*time.Second),
+					time.Now().Add(20*time.Second),
+				)
+				require.NoError(t, err)
+
+				proposal.Result = types.Enactment
+				app.CustomGovKeeper.SaveProposal(ctx, proposal)
+
+				app.CustomGovKeeper.AddToEnactmentProposals(ctx, proposal)
+
+				return addrs
+			},
+			validateScenario: func(t *testing.T, app *simapp.SimApp, ctx sdk.Context, addrs []sdk.AccAddress) {
+				iterator := app.CustomGovKeeper.GetEnactmentProposalsWithFinishedEnactmentEndTimeIterator(ctx, time.Now().Add(25*time.Second))
+				requireIteratorCount(t, iterator, 0)
+
+				plan, found := app.UpgradeKeeper.GetUpgradePlan(ctx)
+				require.True(t, found)
+				require.Equal(t, "v0.2.0", plan.Name)
+				require.Equal(t, int64(100), plan.Height)
+
+				proposal, found := app.CustomGovKeeper.GetProposal(ctx, 1234)
+				require.True(t, found)
+				require.Equal(t, types.Passed, proposal.Result)
+			},
+		},
+		{
+			name: "Passed proposal in enactment is applied and removed from enactment list: Cancel Software Upgrade",
+			prepareScenario: func(app *simapp.SimApp, ctx sdk.Context) []sdk.AccAddress {
+				addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(100))
+
+				err := app.UpgradeKeeper.ScheduleUpgrade(ctx, upgradetypes.Plan{Name: "v0.2.0", Height: 100})
+				require.NoError(t, err)
+
+				proposal, err := types.NewProposal(
+					1234,
+					types.NewCancelSoftwareUpgradeProposal(),
+					time.Now(),
+					time.Now().Add(10*time.Second),
+					time.Now().Add(20*time.Second),
+				)
+				require.NoError(t, err)
+
+				proposal.Result = types.Enactment
+				app.CustomGovKeeper.SaveProposal(ctx, proposal)
+
+				app.CustomGovKeeper.AddToEnactmentProposals(ctx, proposal)
+
+				return addrs
+			},
+			validateScenario: func(t *testing.T, app *simapp.SimApp, ctx sdk.Context, addrs []sdk.AccAddress) {
+				iterator := app.CustomGovKeeper.GetEnactmentProposalsWithFinishedEnactmentEndTimeIterator(ctx, time.Now().Add(25*time.Second))
+				requireIteratorCount(t, iterator, 0)
+
+				_, found := app.UpgradeKeeper.GetUpgradePlan(ctx)
+				require.False(t, found)
+			},
+		},
+		{
+			name: "Active councilor missing the proposals it can vote on is suspended",
+			prepareScenario: func(app *simapp.SimApp, ctx sdk.Context) []sdk.AccAddress {
+				addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(100))
+
+				err := app.CustomGovKeeper.SetNetworkProperty(ctx, types.MaxCouncilorMissedProposals, 2)
+				require.NoError(t, err)
+
+				for _, addr := range addrs {
+					app.CustomGovKeeper.SaveCouncilor(ctx, types.NewCouncilor(addr.String(), "website", "social", "identity", addr, types.CouncilorActive, ctx.BlockTime()))
+
+					actor := types.NewDefaultActor(addr)
+					err := app.CustomGovKeeper.AddWhitelistPermission(ctx, actor, types.PermVoteSetPermissionProposal)
+					require.NoError(t, err)
+				}
+
+				// only the first councilor votes on the two proposals
+				for _, proposalID := range []uint64{1234, 1235} {
+					proposal, err := types.NewProposal(
+						proposalID,
+						types.NewAssignPermissionProposal(addrs[0], types.PermSetPermissions),
+						time.Now(),
+						time.Now().Add(10*time.Second),
+						time.Now().Add(20*time.Second),
+					)
+					require.NoError(t, err)
+
+					app.CustomGovKeeper.SaveProposal(ctx, proposal)
+					app.CustomGovKeeper.AddToActiveProposals(ctx, proposal)
+					app.CustomGovKeeper.SaveVote(ctx, types.NewVote(proposalID, addrs[0], types.OptionYes))
+				}
+
+				return addrs
+			},
+			validateScenario: func(t *testing.T, app *simapp.SimApp, ctx sdk.Context, addrs []sdk.AccAddress) {
+				councilor, found := app.CustomGovKeeper.GetCouncilor(ctx, addrs[0])
+				require.True(t, found)
+				require.Equal(t, types.CouncilorActive, councilor.Status)
+				require.Equal(t, uint64(0), councilor.MissedProposals)
+
+				councilor, found = app.CustomGovKeeper.GetCouncilor(ctx, addrs[1])
+				require.True(t, found)
+				require.Equal(t, types.CouncilorSuspended, councilor.Status)
+				require.Equal(t, uint64(2), councilor.MissedProposals)
+			},
+		},
+		{
+			name: "Passed proposal in enactment is applied and removed from enactment list: Remove Councilor",
+			prepareScenario: func(app *simapp.SimApp, ctx sdk.Context) []sdk.AccAddress {
+				addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(100))
+
+				app.CustomGovKeeper.SaveCouncilor(ctx, types.NewCouncilor("moniker", "website", "social", "identity", addrs[0], types.CouncilorActive, ctx.BlockTime()))
+				err := app.CustomGovKeeper.AddWhitelistPermission(ctx, types.NewDefaultActor(addrs[0]), types.PermClaimCouncilor)
+				require.NoError(t, err)
+
+				proposal, err := types.NewProposal(
+					1234,
+					types.NewRemoveCouncilorProposal(addrs[0]),
+					time.Now(),
+					time.Now().Add(10*time.Second),
+					time.Now().Add(20*time.Second),
+				)
+				require.NoError(t, err)
+
+				proposal.Result = types.Enactment
+				app.CustomGovKeeper.SaveProposal(ctx, proposal)
+
+				app.CustomGovKeeper.AddToEnactmentProposals(ctx, proposal)
+
+				return addrs
+			},
+			validateScenario: func(t *testing.T, app *simapp.SimApp, ctx sdk.Context, addrs []sdk.AccAddress) {
+				iterator := app.CustomGovKeeper.GetEnactmentProposalsWithFinishedEnactmentEndTimeIterator(ctx, time.Now().Add(25*time.Second))
+				requireIteratorCount(t, iterator, 0)
+
+				_, found := app.CustomGovKeeper.GetCouncilor(ctx, addrs[0])
+				require.False(t, found)
+
+				// the removed councilor can not claim its seat back
+				actor, found := app.CustomGovKeeper.GetNetworkActorByAddress(ctx, addrs[0])
+				require.True(t, found)
+				require.False(t, actor.Permissions.IsWhitelisted(types.PermClaimCouncilor))
+				require.True(t, actor.Permissions.IsBlacklisted(types.PermClaimCouncilor))
+
+				handler := gov.NewHandler(app.CustomGovKeeper)
+				_, err := handler(ctx, &types.MsgClaimCouncilor{Moniker: "moniker", Address: addrs[0]})
+				require.EqualError(t, err, "PermClaimCouncilor: not enough permissions")
+
+				proposal, found := app.CustomGovKeeper.GetProposal(ctx, 1234)
+				require.True(t, found)
+				require.Equal(t, types.Passed, proposal.Result)
 			},
 		},
 	}
@@ -519,3 +965,71 @@ func requireIteratorCount(t *testing.T, iterator sdk.Iterator, expectedCount int
 
 	require.Equal(t, expectedCount, c)
 }
+
+func TestEndBlocker_SoftwareUpgradeHeightReached(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 150})
+
+	// the upgrade height was reached while the proposal was in enactment
+	proposal, err := types.NewProposal(
+		1234,
+		types.NewSoftwareUpgradeProposal(upgradetypes.Plan{Name: "v0.2.0", Height: 100}),
+		time.Now(),
+		time.Now().Add(10*time.Second),
+		time.Now().Add(20*time.Second),
+	)
+	require.NoError(t, err)
+
+	proposal.Result = types.Enactment
+	app.CustomGovKeeper.SaveProposal(ctx, proposal)
+	app.CustomGovKeeper.AddToEnactmentProposals(ctx, proposal)
+
+	ctx = ctx.WithBlockTime(time.Now().Add(time.Second * 25))
+	gov.EndBlocker(ctx, app.CustomGovKeeper, app.ProposalRouter)
+
+	_, found := app.UpgradeKeeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+
+	proposal, found = app.CustomGovKeeper.GetProposal(ctx, 1234)
+	require.True(t, found)
+	require.Equal(t, types.EnactmentFailed, proposal.Result)
+}
+
+func TestEndBlocker_RemovesExpiredPermissions(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 10})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(100))
+
+	err := app.CustomGovKeeper.AddWhitelistPermission(ctx, types.NewDefaultActor(addrs[0]), types.PermUpsertTokenRate)
+	require.NoError(t, err)
+	app.CustomGovKeeper.SetPermissionExpiry(ctx, types.NewPermissionExpiry(addrs[0], types.PermUpsertTokenRate, 11, nil))
+	app.CustomGovKeeper.SetPermissionGrant(ctx, types.NewPermissionGrant(addrs[0], addrs[1], types.PermUpsertTokenRate, 0, nil))
+
+	gov.EndBlocker(ctx, app.CustomGovKeeper, app.ProposalRouter)
+	require.True(t, app.CustomGovKeeper.CheckIfAllowedPermission(ctx, addrs[0], types.PermUpsertTokenRate))
+	require.True(t, app.CustomGovKeeper.CheckIfAllowedPermission(ctx, addrs[1], types.PermUpsertTokenRate))
+
+	// once the permission expires the grants delegated from it are not honored anymore
+	ctx = ctx.WithBlockHeight(11)
+	gov.EndBlocker(ctx, app.CustomGovKeeper, app.ProposalRouter)
+	require.False(t, app.CustomGovKeeper.CheckIfAllowedPermission(ctx, addrs[0], types.PermUpsertTokenRate))
+	require.False(t, app.CustomGovKeeper.CheckIfAllowedPermission(ctx, addrs[1], types.PermUpsertTokenRate))
+	require.Empty(t, app.CustomGovKeeper.GetPermissionExpiries(ctx))
+}
+
+func TestEndBlocker_RemovesExpiredAuthorizations(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 10})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(100))
+	typeURL := types.MsgTypeURL(&types.MsgVoteProposal{})
+
+	app.CustomGovKeeper.SetAuthorization(ctx, types.NewAuthorization(addrs[0], addrs[1], typeURL, nil, 0, 11, nil))
+	gov.EndBlocker(ctx, app.CustomGovKeeper, app.ProposalRouter)
+	require.Len(t, app.CustomGovKeeper.GetAuthorizations(ctx), 1)
+
+	ctx = ctx.WithBlockHeight(11)
+	gov.EndBlocker(ctx, app.CustomGovKeeper, app.ProposalRouter)
+	require.Empty(t, app.CustomGovKeeper.GetAuthorizations(ctx))
+}
@@ -0,0 +1,56 @@
+package cli_test
+
+import (
+	"fmt"
+
+	"github.com/KiraCore/sekai/x/gov/client/cli"
+	customgovtypes "github.com/KiraCore/sekai/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s IntegrationTestSuite) TestGrantAuthorization_AndQueryAuthorizations() {
+	val := s.network.Validators[0]
+
+	grantee, err := sdk.AccAddressFromBech32("kira1alzyfq40zjsveat87jlg8jxetwqmr0a29sgd0f")
+	s.Require().NoError(err)
+
+	typeURL := customgovtypes.MsgTypeURL(&customgovtypes.MsgVoteProposal{})
+
+	clientCtx := val.ClientCtx.WithOutputFormat("json")
+	_, err = clitestutil.ExecTestCLICmd(
+		clientCtx,
+		cli.GetTxGrantAuthorization(),
+		[]string{
+			grantee.String(),
+			typeURL,
+			fmt.Sprintf("--%s=%d", cli.FlagMaxUses, 5),
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))).String()),
+		},
+	)
+	s.Require().NoError(err)
+
+	err = s.network.WaitForNextBlock()
+	s.Require().NoError(err)
+
+	out, err := clitestutil.ExecTestCLICmd(
+		clientCtx,
+		cli.GetCmdQueryAuthorizations(),
+		[]string{
+			val.Address.String(),
+			grantee.String(),
+		},
+	)
+	s.Require().NoError(err)
+
+	var res customgovtypes.AuthorizationsResponse
+	clientCtx.JSONMarshaler.MustUnmarshalJSON(out.Bytes(), &res)
+
+	s.Require().Len(res.Authorizations, 1)
+	s.Require().Equal(typeURL, res.Authorizations[0].MsgTypeUrl)
+	s.Require().Equal(uint64(5), res.Authorizations[0].MaxUses)
+}
@@ -0,0 +1,95 @@
+package cli_test
+
+import (
+	"fmt"
+
+	"github.com/KiraCore/sekai/x/gov/client/cli"
+	customgovtypes "github.com/KiraCore/sekai/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s IntegrationTestSuite) TestCreateGroup_AndUpdateGroupByProposal() {
+	val := s.network.Validators[0]
+
+	member, err := sdk.AccAddressFromBech32("kira1alzyfq40zjsveat87jlg8jxetwqmr0a29sgd0f")
+	s.Require().NoError(err)
+
+	clientCtx := val.ClientCtx.WithOutputFormat("json")
+	_, err = clitestutil.ExecTestCLICmd(
+		clientCtx,
+		cli.GetTxCreateGroup(),
+		[]string{
+			fmt.Sprintf("--%s=%s:1,%s:1", cli.FlagMembers, val.Address.String(), member.String()),
+			fmt.Sprintf("--%s=%d", cli.FlagThreshold, 1),
+			fmt.Sprintf("--%s=%s", cli.FlagDescription, "validators"),
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))).String()),
+		},
+	)
+	s.Require().NoError(err)
+
+	err = s.network.WaitForNextBlock()
+	s.Require().NoError(err)
+
+	groupAddr := customgovtypes.GroupAddress(1)
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryGroup(), []string{groupAddr.String()})
+	s.Require().NoError(err)
+
+	var groupRes customgovtypes.GroupResponse
+	clientCtx.JSONMarshaler.MustUnmarshalJSON(out.Bytes(), &groupRes)
+	s.Require().Equal(uint64(1), groupRes.Group.Id)
+	s.Require().Equal("validators", groupRes.Group.Description)
+	s.Require().Len(groupRes.Group.Members, 2)
+
+	// the update is signed by the group, it is generated and then proposed to the group
+	out, err = clitestutil.ExecTestCLICmd(
+		clientCtx,
+		cli.GetTxUpdateGroup(),
+		[]string{
+			groupAddr.String(),
+			fmt.Sprintf("--%s=%s:1", cli.FlagMembers, val.Address.String()),
+			fmt.Sprintf("--%s=%d", cli.FlagThreshold, 1),
+			fmt.Sprintf("--%s=%s", cli.FlagDescription, "validator"),
+			fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+		},
+	)
+	s.Require().NoError(err)
+	txFile := testutil.WriteToNewTempFile(s.T(), out.String())
+
+	_, err = clitestutil.ExecTestCLICmd(
+		clientCtx,
+		cli.GetTxSubmitGroupProposal(),
+		[]string{
+			"1",
+			txFile.Name(),
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))).String()),
+		},
+	)
+	s.Require().NoError(err)
+
+	err = s.network.WaitForNextBlock()
+	s.Require().NoError(err)
+
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryGroupProposals(), []string{"1"})
+	s.Require().NoError(err)
+
+	var proposalsRes customgovtypes.GroupProposalsResponse
+	clientCtx.JSONMarshaler.MustUnmarshalJSON(out.Bytes(), &proposalsRes)
+	s.Require().Len(proposalsRes.Proposals, 1)
+	s.Require().Equal(customgovtypes.GroupProposalExecuted, proposalsRes.Proposals[0].Status)
+
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryGroup(), []string{"1"})
+	s.Require().NoError(err)
+
+	clientCtx.JSONMarshaler.MustUnmarshalJSON(out.Bytes(), &groupRes)
+	s.Require().Equal("validator", groupRes.Group.Description)
+	s.Require().Len(groupRes.Group.Members, 1)
+}
@@ -128,3 +128,46 @@ func (s IntegrationTestSuite) TestGetTxSetWhitelistPermissions_WithUserThatDoesN
 	s.Require().NoError(err)
 	strings.Contains(out.String(), "SetPermissions: not enough permissions")
 }
+
+func (s IntegrationTestSuite) TestGetTxDelegatePermission_AndQueryPermissionGrants() {
+	val := s.network.Validators[0]
+
+	addr, err := sdk.AccAddressFromBech32("kira1alzyfq40zjsveat87jlg8jxetwqmr0a29sgd0f")
+	s.Require().NoError(err)
+
+	clientCtx := val.ClientCtx.WithOutputFormat("json")
+	_, err = clitestutil.ExecTestCLICmd(
+		clientCtx,
+		cli.GetTxDelegatePermission(),
+		[]string{
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+			fmt.Sprintf("--%s=%s", stakingcli.FlagAddr, addr.String()),
+			fmt.Sprintf("--%s=%d", cli.FlagPermission, customgovtypes.PermCreateSetNetworkPropertyProposal),
+			fmt.Sprintf("--%s=%d", cli.FlagExpiryHeight, 10000),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))).String()),
+		},
+	)
+	s.Require().NoError(err)
+
+	err = s.network.WaitForNextBlock()
+	s.Require().NoError(err)
+
+	out, err := clitestutil.ExecTestCLICmd(
+		clientCtx,
+		cli.GetCmdQueryPermissionGrants(),
+		[]string{
+			addr.String(),
+		},
+	)
+	s.Require().NoError(err)
+
+	var res customgovtypes.PermissionGrantsResponse
+	clientCtx.JSONMarshaler.MustUnmarshalJSON(out.Bytes(), &res)
+
+	s.Require().Len(res.Grants, 1)
+	s.Require().Equal(val.Address, res.Grants[0].Delegator)
+	s.Require().Equal(uint32(customgovtypes.PermCreateSetNetworkPropertyProposal), res.Grants[0].Permission)
+	s.Require().Equal(uint64(10000), res.Grants[0].ExpiryHeight)
+}
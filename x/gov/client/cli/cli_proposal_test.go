@@ -5,6 +5,7 @@ import (
 
 	stakingcli "github.com/KiraCore/sekai/x/staking/client/cli"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/KiraCore/sekai/x/gov/client/cli"
@@ -42,6 +43,19 @@ func (s IntegrationTestSuite) TestCreateProposalAssignPermission() {
 		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))).String()),
 	})
 	s.Require().NoError(err)
+
+	// Change the vote to a split vote
+	cmd = cli.GetTxWeightedVoteProposal()
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{
+		fmt.Sprintf("%d", 1), // Proposal ID
+		fmt.Sprintf("%d=0.6,%d=0.4", customgovtypes.OptionYes, customgovtypes.OptionAbstain),
+		fmt.Sprintf("--%s=%s", cli.FlagReason, "needs more discussion"),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))).String()),
+	})
+	s.Require().NoError(err)
 }
 
 func (s IntegrationTestSuite) TestCreateProposalUpsertDataRegistry() {
@@ -121,6 +135,7 @@ func (s IntegrationTestSuite) TestCreateProposalCreateRole() {
 	clientCtx := val.ClientCtx
 	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{
 		fmt.Sprintf("%d", 12345),
+		"role_12345",
 		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
 		fmt.Sprintf("--%s=%s", cli.FlagWhitelistPerms, "1,2,3"),
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
@@ -143,3 +158,98 @@ func (s IntegrationTestSuite) TestCreateProposalCreateRole() {
 	s.Require().NoError(err)
 	fmt.Printf("%s", out.String())
 }
+
+func (s IntegrationTestSuite) TestCreateProposalSetRoleVoteWeight() {
+	val := s.network.Validators[0]
+
+	cmd := cli.GetTxProposalSetRoleVoteWeight()
+	clientCtx := val.ClientCtx
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{
+		fmt.Sprintf("%d", customgovtypes.RoleValidator),
+		"2.5",
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))).String()),
+	})
+	s.Require().NoError(err)
+	s.Require().Contains(out.String(), "\"code\":0")
+}
+
+func (s IntegrationTestSuite) TestCreateProposalParameterChange() {
+	val := s.network.Validators[0]
+
+	cmd := cli.GetTxProposalParameterChange()
+	clientCtx := val.ClientCtx
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{
+		"customslashing",
+		"DowntimeInactiveDuration",
+		"duration",
+		"20m",
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))).String()),
+	})
+	s.Require().NoError(err)
+	s.Require().Contains(out.String(), "\"code\":0")
+}
+
+func (s IntegrationTestSuite) TestCreateProposalMultiContent() {
+	val := s.network.Validators[0]
+
+	contentsFile := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(`{
+		"contents": [
+			{"@type": "/kira.gov.SetRoleVoteWeightProposal", "role": %d, "weight": "3"},
+			{"@type": "/kira.gov.SetNetworkPropertyProposal", "network_property": "MIN_TX_FEE", "value": "200"}
+		]
+	}`, customgovtypes.RoleValidator))
+
+	cmd := cli.GetTxProposalMultiContent()
+	clientCtx := val.ClientCtx
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{
+		contentsFile.Name(),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))).String()),
+	})
+	s.Require().NoError(err)
+	s.Require().Contains(out.String(), "\"code\":0")
+}
+
+func (s IntegrationTestSuite) TestCreateProposalSoftwareUpgrade() {
+	val := s.network.Validators[0]
+
+	cmd := cli.GetTxProposalSoftwareUpgrade()
+	clientCtx := val.ClientCtx
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{
+		"v0.2.0",
+		"100000",
+		fmt.Sprintf("--%s=%s", cli.FlagUpgradeInfo, "https://github.com/KiraCore/sekai/releases/tag/v0.2.0"),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))).String()),
+	})
+	s.Require().NoError(err)
+	s.Require().Contains(out.String(), "\"code\":0")
+}
+
+func (s IntegrationTestSuite) TestCreateProposalRemoveCouncilor() {
+	val := s.network.Validators[0]
+
+	s.SetCouncilor(val.Address)
+
+	cmd := cli.GetTxProposalRemoveCouncilor()
+	clientCtx := val.ClientCtx
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{
+		val.Address.String(),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))).String()),
+	})
+	s.Require().NoError(err)
+	s.Require().Contains(out.String(), "\"code\":0")
+}
@@ -195,7 +195,9 @@ func (s IntegrationTestSuite) TestCreateRole() {
 	// Add role
 	cmd = cli.GetTxCreateRole()
 	_, err = clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{
-		"1234", // RoleValidator
+		"1234", // RoleInTest
+		"role_in_test",
+		fmt.Sprintf("--%s=%s", cli.FlagDescription, "Role created in test"),
 		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
@@ -212,6 +214,63 @@ func (s IntegrationTestSuite) TestCreateRole() {
 		"1234", // RoleInTest
 	})
 	s.Require().NoError(err)
+
+	// Query the role from its sid
+	cmd = cli.GetCmdQueryRole()
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{
+		"role_in_test",
+	})
+	s.Require().NoError(err)
+
+	var role customgovtypes.RoleDetails
+	val.ClientCtx.JSONMarshaler.MustUnmarshalJSON(out.Bytes(), &role)
+	s.Require().Equal(customgovtypes.NewRoleInfo(1234, "role_in_test", "Role created in test", false), role.Info)
+}
+
+func (s IntegrationTestSuite) TestQueryRoles() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	cmd := cli.GetCmdQueryAllRoles()
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{})
+	s.Require().NoError(err)
+
+	var roles customgovtypes.AllRolesResponse
+	val.ClientCtx.JSONMarshaler.MustUnmarshalJSON(out.Bytes(), &roles)
+
+	sids := make(map[string]uint32)
+	for _, role := range roles.Roles {
+		sids[role.Info.Sid] = role.Info.Id
+	}
+	s.Require().Equal(uint32(customgovtypes.RoleSudo), sids["sudo"])
+	s.Require().Equal(uint32(customgovtypes.RoleValidator), sids["validator"])
+
+	// the role is resolved from its sid
+	cmd = cli.GetCmdQueryRolePermissions()
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{
+		"validator",
+	})
+	s.Require().NoError(err)
+
+	var perms customgovtypes.Permissions
+	val.ClientCtx.JSONMarshaler.MustUnmarshalJSON(out.Bytes(), &perms)
+	s.Require().True(perms.IsWhitelisted(customgovtypes.PermClaimValidator))
+
+	cmd = cli.GetCmdQueryRoleActors()
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{
+		"sudo",
+	})
+	s.Require().NoError(err)
+
+	var actors customgovtypes.RoleActorsResponse
+	val.ClientCtx.JSONMarshaler.MustUnmarshalJSON(out.Bytes(), &actors)
+	s.Require().Contains(actors.Actors, val.Address)
+
+	cmd = cli.GetCmdQueryRolePermissions()
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{
+		"unknown_role",
+	})
+	s.Require().Error(err)
 }
 
 func (s IntegrationTestSuite) TestAssignRoles_AndRemoveRoles() {
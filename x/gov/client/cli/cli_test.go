@@ -89,10 +89,34 @@ func (s IntegrationTestSuite) TestClaimCouncilor_HappyPath() {
 	s.Require().NoError(err)
 
 	// Query command
-	// Mandatory flags
+	// Without flags, all the councilors are listed
 	cmd := cli.GetCmdQueryCouncilRegistry()
 
-	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{""})
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{})
+	s.Require().NoError(err)
+
+	var councilors customgovtypes.CouncilorsResponse
+	err = val.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &councilors)
+	s.Require().NoError(err)
+	s.Require().Len(councilors.Councilors, 1)
+	s.Require().Equal(customgovtypes.CouncilorActive, councilors.Councilors[0].Status)
+
+	// Filtered by status
+	cmd = cli.GetCmdQueryCouncilRegistry()
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{
+		fmt.Sprintf("--%s=%s", cli.FlagStatus, "suspended"),
+	})
+	s.Require().NoError(err)
+
+	councilors = customgovtypes.CouncilorsResponse{}
+	err = val.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &councilors)
+	s.Require().NoError(err)
+	s.Require().Len(councilors.Councilors, 0)
+
+	cmd = cli.GetCmdQueryCouncilRegistry()
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{
+		fmt.Sprintf("--%s=%s", cli.FlagStatus, "unknown"),
+	})
 	s.Require().Error(err)
 
 	// From address
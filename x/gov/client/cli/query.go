@@ -52,6 +52,135 @@ func GetCmdQueryPermissions() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryPermissionGrants is the command to get the permissions delegated to an address and the expiries of its permissions.
+func GetCmdQueryPermissionGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "permission-grants addr",
+		Short: "Get the permissions delegated to an address and the expiries of its whitelisted permissions",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			accAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return errors.Wrap(err, "invalid account address")
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PermissionGrants(context.Background(), &types.PermissionGrantsRequest{Address: accAddr})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAuthorizations is the command to query the authorizations given by a granter.
+func GetCmdQueryAuthorizations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorizations granter [grantee]",
+		Short: "Get the authorizations given by a granter, to a grantee when it is given",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return errors.Wrap(err, "invalid granter address")
+			}
+
+			var grantee sdk.AccAddress
+			if len(args) == 2 {
+				grantee, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return errors.Wrap(err, "invalid grantee address")
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Authorizations(context.Background(), &types.AuthorizationsRequest{Granter: granter, Grantee: grantee})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryGroup is the command to query a group by its id or its address.
+func GetCmdQueryGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group [id|address]",
+		Short: "Get a group by its id or its address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			request := &types.GroupRequest{}
+			if groupID, err := strconv.ParseUint(args[0], 10, 64); err == nil {
+				request.GroupId = groupID
+			} else {
+				request.Address, err = sdk.AccAddressFromBech32(args[0])
+				if err != nil {
+					return errors.Wrap(err, "invalid group id or address")
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Group(context.Background(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryGroupProposals is the command to query the proposals of a group.
+func GetCmdQueryGroupProposals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-proposals group-id",
+		Short: "Get the proposals of a group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid group id")
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GroupProposals(context.Background(), &types.GroupProposalsRequest{GroupId: groupID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryRolesByAddress the query delegation command.
 func GetCmdQueryRolesByAddress() *cobra.Command {
 	cmd := &cobra.Command{
@@ -84,19 +213,19 @@ func GetCmdQueryRolesByAddress() *cobra.Command {
 
 func GetCmdQueryRolePermissions() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "role-permissions arg-num",
-		Short: "Get the permissions of all the roles",
+		Use:   "role-permissions role",
+		Short: "Get the permissions of a role from its number or its sid",
 		Args:  cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
-			roleNum, err := strconv.ParseUint(args[0], 10, 64)
+			role, err := parseRole(clientCtx, args[0])
 			if err != nil {
-				return fmt.Errorf("invalid role number")
+				return err
 			}
 
 			params := &types.RolePermissionsRequest{
-				Role: roleNum,
+				Role: uint64(role),
 			}
 
 			queryClient := types.NewQueryClient(clientCtx)
@@ -114,6 +243,93 @@ func GetCmdQueryRolePermissions() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryAllRoles is the command to list every role with its permissions.
+func GetCmdQueryAllRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-roles",
+		Short: "Get every role of the registry with its sid, description and permissions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AllRoles(context.Background(), &types.AllRolesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRole is the command to get a role from its number or its sid.
+func GetCmdQueryRole() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "role role",
+		Short: "Get a role with its sid, description and permissions from its number or its sid",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Role(context.Background(), &types.RoleRequest{Identifier: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Role)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRoleActors is the command to list the actors holding a role.
+func GetCmdQueryRoleActors() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "role-actors role",
+		Short: "Get the addresses of the actors holding a role from its number or its sid",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RoleActors(context.Background(), &types.RoleActorsRequest{Identifier: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseRole returns the role from its number, or queries the role registry to resolve it from its sid.
+func parseRole(clientCtx client.Context, identifier string) (types.Role, error) {
+	if role, err := strconv.ParseUint(identifier, 10, 32); err == nil {
+		return types.Role(role), nil
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.Role(context.Background(), &types.RoleRequest{Identifier: identifier})
+	if err != nil {
+		return types.RoleUndefined, fmt.Errorf("invalid role %s: %w", identifier, err)
+	}
+
+	return types.Role(res.Role.Info.Id), nil
+}
+
 // GetCmdQueryNetworkProperties implement query network properties
 func GetCmdQueryNetworkProperties() *cobra.Command {
 	cmd := &cobra.Command{
@@ -190,8 +406,10 @@ func GetCmdQueryExecutionFee() *cobra.Command {
 
 func GetCmdQueryCouncilRegistry() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "council-registry [--addr || --flagMoniker]",
+		Use:   "council-registry [--address || --moniker || --status]",
 		Short: "Query the governance registry.",
+		Long: `Query a councilor by address or moniker, or the councilors of the registry optionally filtered by status, like:
+council-registry --status=suspended`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
@@ -205,7 +423,18 @@ func GetCmdQueryCouncilRegistry() *cobra.Command {
 				return err
 			}
 			if addr == "" && moniker == "" {
-				return fmt.Errorf("at least one flag (--flag or --moniker) is mandatory")
+				status, err := cmd.Flags().GetString(FlagStatus)
+				if err != nil {
+					return err
+				}
+
+				queryClient := types.NewQueryClient(clientCtx)
+				res, err := queryClient.Councilors(context.Background(), &types.CouncilorsRequest{Status: status})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
 			}
 
 			var res *types.CouncilorResponse
@@ -240,6 +469,7 @@ func GetCmdQueryCouncilRegistry() *cobra.Command {
 
 	cmd.Flags().String(FlagAddress, "", "the address you want to query information")
 	cmd.Flags().String(FlagMoniker, "", "the moniker you want to query information")
+	cmd.Flags().String(FlagStatus, "", "the status of the councilors to list (active or suspended), all the councilors are listed when empty")
 
 	return cmd
 }
@@ -488,3 +718,136 @@ $ %[1]s query gov voters 1
 
 	return cmd
 }
+
+// GetCmdQueryTally implements the command to query for the tally of a proposal.
+func GetCmdQueryTally() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tally [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the tally of a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tally of a single proposal by its identifier, proposals still in voting are tallied with the current votes.
+
+Example:
+$ %[1]s query gov tally 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.Tally(
+				context.Background(),
+				&types.QueryTallyRequest{ProposalId: proposalID},
+			)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDeposit implements the command to query for the deposit of a proposal.
+func GetCmdQueryDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the deposit of a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the deposit escrowed for a single proposal by its identifier.
+
+Example:
+$ %[1]s query gov deposit 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.Deposit(
+				context.Background(),
+				&types.QueryDepositRequest{ProposalId: proposalID},
+			)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryVoteHistory implements the command to query for the votes cast by a voter on a proposal.
+func GetCmdQueryVoteHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-history [proposal-id] [voter-addr]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the votes cast by a voter on a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the votes cast by a voter on a proposal, including the ones it changed, oldest first.
+
+Example:
+$ %s query gov vote-history 1 kira1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			voterAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VoteHistory(
+				context.Background(),
+				&types.QueryVoteHistoryRequest{ProposalId: proposalID, Voter: voterAddr},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
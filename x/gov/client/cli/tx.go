@@ -2,13 +2,17 @@ package cli
 
 import (
 	"fmt"
+	"io/ioutil"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	"github.com/spf13/cobra"
 
 	"github.com/KiraCore/sekai/x/gov/types"
@@ -34,6 +38,16 @@ const (
 	FlagAddress           = "address"
 	FlagWhitelistPerms    = "whitelist"
 	FlagBlacklistPerms    = "blacklist"
+	FlagReason            = "reason"
+	FlagUpgradeInfo       = "upgrade-info"
+	FlagStatus            = "status"
+	FlagDescription       = "description"
+	FlagExpiryHeight      = "expiry-height"
+	FlagExpiryTime        = "expiry-time"
+	FlagSpendLimit        = "spend-limit"
+	FlagMaxUses           = "max-uses"
+	FlagMembers           = "members"
+	FlagThreshold         = "threshold"
 )
 
 // NewTxCmd returns a root CLI command handler for all x/bank transaction commands.
@@ -51,6 +65,8 @@ func NewTxCmd() *cobra.Command {
 		NewTxProposalCmds(),
 		NewTxRoleCmds(),
 		NewTxPermissionCmds(),
+		NewTxAuthorizationCmds(),
+		NewTxGroupCmds(),
 		NewTxSetNetworkProperties(),
 		NewTxSetExecutionFee(),
 	)
@@ -70,9 +86,17 @@ func NewTxProposalCmds() *cobra.Command {
 
 	proposalCmd.AddCommand(GetTxProposalAssignPermission())
 	proposalCmd.AddCommand(GetTxVoteProposal())
+	proposalCmd.AddCommand(GetTxWeightedVoteProposal())
+	proposalCmd.AddCommand(GetTxCancelProposal())
 	proposalCmd.AddCommand(GetTxProposalSetNetworkProperty())
 	proposalCmd.AddCommand(GetTxProposalSetPoorNetworkMsgs())
 	proposalCmd.AddCommand(GetTxProposalCreateRole())
+	proposalCmd.AddCommand(GetTxProposalSetRoleVoteWeight())
+	proposalCmd.AddCommand(GetTxProposalParameterChange())
+	proposalCmd.AddCommand(GetTxProposalMultiContent())
+	proposalCmd.AddCommand(GetTxProposalSoftwareUpgrade())
+	proposalCmd.AddCommand(GetTxProposalCancelSoftwareUpgrade())
+	proposalCmd.AddCommand(GetTxProposalRemoveCouncilor())
 	proposalCmd.AddCommand(GetTxProposalUpsertDataRegistry())
 
 	return proposalCmd
@@ -111,10 +135,47 @@ func NewTxPermissionCmds() *cobra.Command {
 
 	permCmd.AddCommand(GetTxSetWhitelistPermissions())
 	permCmd.AddCommand(GetTxSetBlacklistPermissions())
+	permCmd.AddCommand(GetTxDelegatePermission())
+	permCmd.AddCommand(GetTxRevokePermission())
 
 	return permCmd
 }
 
+// NewTxAuthorizationCmds returns the subcommands of authorization related commands.
+func NewTxAuthorizationCmds() *cobra.Command {
+	authCmd := &cobra.Command{
+		Use:                        "authorization",
+		Short:                      "Authorization subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	authCmd.AddCommand(GetTxGrantAuthorization())
+	authCmd.AddCommand(GetTxRevokeAuthorization())
+	authCmd.AddCommand(GetTxExec())
+
+	return authCmd
+}
+
+// NewTxGroupCmds returns the subcommands of group related commands.
+func NewTxGroupCmds() *cobra.Command {
+	groupCmd := &cobra.Command{
+		Use:                        "group",
+		Short:                      "Group subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	groupCmd.AddCommand(GetTxCreateGroup())
+	groupCmd.AddCommand(GetTxUpdateGroup())
+	groupCmd.AddCommand(GetTxSubmitGroupProposal())
+	groupCmd.AddCommand(GetTxApproveGroupProposal())
+
+	return groupCmd
+}
+
 func NewTxCouncilorCmds() *cobra.Command {
 	councilor := &cobra.Command{
 		Use:                        "councilor",
@@ -146,10 +207,17 @@ func GetTxSetWhitelistPermissions() *cobra.Command {
 				return fmt.Errorf("error getting address: %w", err)
 			}
 
+			expiryHeight, expiryTime, err := getExpiryFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgWhitelistPermissions(
 				clientCtx.FromAddress,
 				addr,
 				perm,
+				expiryHeight,
+				expiryTime,
 			)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -157,11 +225,399 @@ func GetTxSetWhitelistPermissions() *cobra.Command {
 	}
 
 	setPermissionFlags(cmd)
+	setExpiryFlags(cmd)
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func GetTxDelegatePermission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate",
+		Short: "Delegates a permission held by the sender to an address, until revoked or expired",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			perm, err := cmd.Flags().GetUint32(FlagPermission)
+			if err != nil {
+				return fmt.Errorf("invalid permissions")
+			}
+
+			addr, err := getAddressFromFlag(cmd)
+			if err != nil {
+				return fmt.Errorf("error getting address: %w", err)
+			}
+
+			expiryHeight, expiryTime, err := getExpiryFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegatePermission(
+				clientCtx.FromAddress,
+				addr,
+				perm,
+				expiryHeight,
+				expiryTime,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	setPermissionFlags(cmd)
+	setExpiryFlags(cmd)
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func GetTxRevokePermission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "Revokes a permission delegated by the sender to an address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			perm, err := cmd.Flags().GetUint32(FlagPermission)
+			if err != nil {
+				return fmt.Errorf("invalid permissions")
+			}
+
+			addr, err := getAddressFromFlag(cmd)
+			if err != nil {
+				return fmt.Errorf("error getting address: %w", err)
+			}
+
+			msg := types.NewMsgRevokePermission(
+				clientCtx.FromAddress,
+				addr,
+				perm,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	setPermissionFlags(cmd)
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func GetTxGrantAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant grantee msg-type-url",
+		Short: "Authorizes a grantee to execute the messages of a type on behalf of the sender, like /kira.gov.MsgVoteProposal",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid grantee address: %w", err)
+			}
+
+			spendLimitStr, err := cmd.Flags().GetString(FlagSpendLimit)
+			if err != nil {
+				return fmt.Errorf("invalid spend limit: %w", err)
+			}
+
+			spendLimit, err := sdk.ParseCoinsNormalized(spendLimitStr)
+			if err != nil {
+				return fmt.Errorf("invalid spend limit: %w", err)
+			}
+
+			maxUses, err := cmd.Flags().GetUint64(FlagMaxUses)
+			if err != nil {
+				return fmt.Errorf("invalid max uses: %w", err)
+			}
+
+			expiryHeight, expiryTime, err := getExpiryFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantAuthorization(
+				clientCtx.FromAddress,
+				grantee,
+				args[1],
+				spendLimit,
+				maxUses,
+				expiryHeight,
+				expiryTime,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagSpendLimit, "", "the amount the grantee can send, only for /cosmos.bank.v1beta1.MsgSend")
+	cmd.Flags().Uint64(FlagMaxUses, 0, "the number of executions authorized, 0 for no limit")
+	setExpiryFlags(cmd)
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func GetTxRevokeAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke grantee msg-type-url",
+		Short: "Revokes an authorization given by the sender to a grantee",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid grantee address: %w", err)
+			}
+
+			msg := types.NewMsgRevokeAuthorization(clientCtx.FromAddress, grantee, args[1])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func GetTxExec() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec tx-json-file",
+		Short: "Executes the messages of a tx generated with --generate-only on behalf of their signers",
+		Long: `Executes the messages of a tx generated with --generate-only on behalf of their signers, with the
+authorizations given to the sender. The messages are checked against the permissions of their signers.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			stdTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgExec(clientCtx.FromAddress, stdTx.GetMsgs())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
 
 	flags.AddTxFlagsToCmd(cmd)
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
 
-	return cmd
+	return cmd
+}
+
+func GetTxCreateGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Creates a group account governed by its members, like --members=kira1...:1,kira1...:2 --threshold=2",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			members, threshold, description, err := getGroupFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateGroup(clientCtx.FromAddress, members, threshold, description)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addGroupFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func GetTxUpdateGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update group-address",
+		Short: "Changes the members and the threshold of a group",
+		Long: `Changes the members and the threshold of a group. The message is signed by the group, generate it
+with --generate-only and propose it to the group with the propose command.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			group, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid group address: %w", err)
+			}
+
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			members, threshold, description, err := getGroupFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroup(group, members, threshold, description)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addGroupFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetTxSubmitGroupProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose group-id tx-json-file",
+		Short: "Proposes the messages of a tx generated with --generate-only to a group, the sender approves them",
+		Long: `Proposes the messages of a tx generated with --generate-only to a group, the sender approves them.
+The messages must be signed by the group and are executed once the approvals reach its threshold.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid group id: %w", err)
+			}
+
+			stdTx, err := authclient.ReadTxFromFile(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSubmitGroupProposal(clientCtx.FromAddress, groupID, stdTx.GetMsgs())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func GetTxApproveGroupProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve proposal-id",
+		Short: "Approves a group proposal, its messages are executed once the approvals reach the threshold of the group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid proposal id: %w", err)
+			}
+
+			msg := types.NewMsgApproveGroupProposal(clientCtx.FromAddress, proposalID)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+// addGroupFlags adds the flags of the members and the threshold of a group.
+func addGroupFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagMembers, "", "the members of the group with their weight, like kira1...:1,kira1...:2")
+	cmd.Flags().Uint64(FlagThreshold, 0, "the weight of the approvals required to execute a group proposal")
+	cmd.Flags().String(FlagDescription, "", "the description of the group")
+	_ = cmd.MarkFlagRequired(FlagMembers)
+	_ = cmd.MarkFlagRequired(FlagThreshold)
+}
+
+// getGroupFromFlags parses the members, the threshold and the description of a group.
+func getGroupFromFlags(cmd *cobra.Command) ([]types.GroupMember, uint64, string, error) {
+	membersStr, err := cmd.Flags().GetString(FlagMembers)
+	if err != nil {
+		return nil, 0, "", fmt.Errorf("invalid members: %w", err)
+	}
+
+	var members []types.GroupMember
+	for _, memberStr := range strings.Split(membersStr, ",") {
+		parts := strings.Split(memberStr, ":")
+		if len(parts) != 2 {
+			return nil, 0, "", fmt.Errorf("invalid member %s, expected address:weight", memberStr)
+		}
+
+		address, err := sdk.AccAddressFromBech32(parts[0])
+		if err != nil {
+			return nil, 0, "", fmt.Errorf("invalid member address: %w", err)
+		}
+
+		weight, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return nil, 0, "", fmt.Errorf("invalid member weight: %w", err)
+		}
+
+		members = append(members, types.NewGroupMember(address, weight))
+	}
+
+	threshold, err := cmd.Flags().GetUint64(FlagThreshold)
+	if err != nil {
+		return nil, 0, "", fmt.Errorf("invalid threshold: %w", err)
+	}
+
+	description, err := cmd.Flags().GetString(FlagDescription)
+	if err != nil {
+		return nil, 0, "", fmt.Errorf("invalid description: %w", err)
+	}
+
+	return members, threshold, description, nil
 }
 
 func GetTxSetBlacklistPermissions() *cobra.Command {
@@ -266,9 +722,9 @@ func GetTxWhitelistRolePermission() *cobra.Command {
 				return err
 			}
 
-			role, err := strconv.Atoi(args[0])
+			role, err := parseRole(clientCtx, args[0])
 			if err != nil {
-				return fmt.Errorf("invalid role: %w", err)
+				return err
 			}
 
 			permission, err := strconv.Atoi(args[1])
@@ -346,7 +802,7 @@ func NewTxSetExecutionFee() *cobra.Command {
 	cmd.Flags().String(FlagTxType, "", "execution type")
 	cmd.Flags().Uint64(FlagExecutionFee, 10, "execution fee")
 	cmd.Flags().Uint64(FlagFailureFee, 1, "failure fee")
-	cmd.Flags().Uint64(FlagTimeout, 0, "timeout")
+	cmd.Flags().Uint64(FlagTimeout, 0, "gas the execution can consume before it fails, 0 for no limit")
 	cmd.Flags().Uint64(FlagDefaultParameters, 0, "default parameters")
 	flags.AddTxFlagsToCmd(cmd)
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
@@ -365,9 +821,9 @@ func GetTxBlacklistRolePermission() *cobra.Command {
 				return err
 			}
 
-			role, err := strconv.Atoi(args[0])
+			role, err := parseRole(clientCtx, args[0])
 			if err != nil {
-				return fmt.Errorf("invalid role: %w", err)
+				return err
 			}
 
 			permission, err := strconv.Atoi(args[1])
@@ -402,9 +858,9 @@ func GetTxRemoveWhitelistRolePermission() *cobra.Command {
 				return err
 			}
 
-			role, err := strconv.Atoi(args[0])
+			role, err := parseRole(clientCtx, args[0])
 			if err != nil {
-				return fmt.Errorf("invalid role: %w", err)
+				return err
 			}
 
 			permission, err := strconv.Atoi(args[1])
@@ -439,9 +895,9 @@ func GetTxRemoveBlacklistRolePermission() *cobra.Command {
 				return err
 			}
 
-			role, err := strconv.Atoi(args[0])
+			role, err := parseRole(clientCtx, args[0])
 			if err != nil {
-				return fmt.Errorf("invalid role: %w", err)
+				return err
 			}
 
 			permission, err := strconv.Atoi(args[1])
@@ -467,9 +923,9 @@ func GetTxRemoveBlacklistRolePermission() *cobra.Command {
 
 func GetTxCreateRole() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "create role",
-		Short: "Create new role",
-		Args:  cobra.ExactArgs(1),
+		Use:   "create role sid",
+		Short: "Create new role with a unique sid, like 1234 my_role --description=\"...\"",
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -481,9 +937,16 @@ func GetTxCreateRole() *cobra.Command {
 				return fmt.Errorf("invalid role: %w", err)
 			}
 
+			description, err := cmd.Flags().GetString(FlagDescription)
+			if err != nil {
+				return fmt.Errorf("invalid description: %w", err)
+			}
+
 			msg := types.NewMsgCreateRole(
 				clientCtx.FromAddress,
 				uint32(role),
+				args[1],
+				description,
 			)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -491,6 +954,7 @@ func GetTxCreateRole() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagDescription, "", "the description of the role")
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
 
 	return cmd
@@ -507,9 +971,9 @@ func GetTxAssignRole() *cobra.Command {
 				return err
 			}
 
-			role, err := strconv.Atoi(args[0])
+			role, err := parseRole(clientCtx, args[0])
 			if err != nil {
-				return fmt.Errorf("invalid role: %w", err)
+				return err
 			}
 
 			addr, err := getAddressFromFlag(cmd)
@@ -547,9 +1011,9 @@ func GetTxRemoveRole() *cobra.Command {
 				return err
 			}
 
-			role, err := strconv.Atoi(args[0])
+			role, err := parseRole(clientCtx, args[0])
 			if err != nil {
-				return fmt.Errorf("invalid role: %w", err)
+				return err
 			}
 
 			addr, err := getAddressFromFlag(cmd)
@@ -768,11 +1232,119 @@ func GetTxVoteProposal() *cobra.Command {
 				return fmt.Errorf("invalid vote option: %w", err)
 			}
 
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return fmt.Errorf("invalid reason: %w", err)
+			}
+
 			msg := types.NewMsgVoteProposal(
 				uint64(proposalID),
 				clientCtx.FromAddress,
 				types.VoteOption(voteOption),
 			)
+			msg.Reason = reason
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReason, "", "Short justification of the vote.")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func GetTxWeightedVoteProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "weighted-vote proposal-id weighted-options",
+		Short: "Vote a proposal splitting the vote across options, e.g. weighted-vote 1 1=0.6,3=0.4",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid proposal ID: %w", err)
+			}
+
+			options, err := parseWeightedVoteOptions(args[1])
+			if err != nil {
+				return err
+			}
+
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return fmt.Errorf("invalid reason: %w", err)
+			}
+
+			msg := types.NewMsgVoteProposal(
+				uint64(proposalID),
+				clientCtx.FromAddress,
+				options.MainOption(),
+			)
+			msg.Options = options
+			msg.Reason = reason
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReason, "", "Short justification of the vote.")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+// parseWeightedVoteOptions parses options formatted as option=weight separated by commas.
+func parseWeightedVoteOptions(s string) (types.WeightedVoteOptions, error) {
+	var options types.WeightedVoteOptions
+	for _, part := range strings.Split(s, ",") {
+		fields := strings.Split(part, "=")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid weighted vote option %s, expected option=weight", part)
+		}
+
+		option, err := strconv.Atoi(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid vote option: %w", err)
+		}
+
+		weight, err := sdk.NewDecFromStr(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid vote weight: %w", err)
+		}
+
+		options = append(options, types.WeightedVoteOption{Option: types.VoteOption(option), Weight: weight})
+	}
+
+	return options, nil
+}
+
+func GetTxCancelProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel proposal-id",
+		Short: "Cancel a proposal, by the proposer while in voting or by a councilor while in enactment.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid proposal ID: %w", err)
+			}
+
+			msg := types.NewMsgCancelProposal(
+				uint64(proposalID),
+				clientCtx.FromAddress,
+			)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -791,6 +1363,31 @@ func setPermissionFlags(cmd *cobra.Command) {
 	cmd.Flags().Uint32(FlagPermission, 0, "the permission")
 }
 
+func setExpiryFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(FlagExpiryHeight, 0, "the block height from which the permission is removed, 0 never expires")
+	cmd.Flags().String(FlagExpiryTime, "", "the block time from which the permission is removed in RFC3339 format, like 2021-04-01T12:00:00Z")
+}
+
+// getExpiryFromFlags returns the expiry height and time from FlagExpiryHeight and FlagExpiryTime in Command.
+func getExpiryFromFlags(cmd *cobra.Command) (uint64, *time.Time, error) {
+	expiryHeight, err := cmd.Flags().GetUint64(FlagExpiryHeight)
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid expiry height: %w", err)
+	}
+
+	timeStr, err := cmd.Flags().GetString(FlagExpiryTime)
+	if err != nil || timeStr == "" {
+		return expiryHeight, nil, err
+	}
+
+	expiryTime, err := time.Parse(time.RFC3339, timeStr)
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid expiry time: %w", err)
+	}
+
+	return expiryHeight, &expiryTime, nil
+}
+
 // getAddressFromFlag returns the AccAddress from FlagAddr in Command.
 func getAddressFromFlag(cmd *cobra.Command) (sdk.AccAddress, error) {
 	addr, err := cmd.Flags().GetString(cli.FlagAddr)
@@ -854,9 +1451,9 @@ func GetTxClaimCouncilorSeatCmd() *cobra.Command {
 
 func GetTxProposalCreateRole() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "create-role role",
-		Short: "Create a proposal to add a new role.",
-		Args:  cobra.ExactArgs(1),
+		Use:   "create-role role sid",
+		Short: "Create a proposal to add a new role with a unique sid.",
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -880,9 +1477,16 @@ func GetTxProposalCreateRole() *cobra.Command {
 			}
 			blacklistPerms := convertAsPermValues(bAsInts)
 
+			description, err := cmd.Flags().GetString(FlagDescription)
+			if err != nil {
+				return fmt.Errorf("invalid description: %w", err)
+			}
+
 			msg := types.NewMsgProposalCreateRole(
 				clientCtx.FromAddress,
 				types.Role(role),
+				args[1],
+				description,
 				whitelistPerms,
 				blacklistPerms,
 			)
@@ -895,6 +1499,121 @@ func GetTxProposalCreateRole() *cobra.Command {
 
 	cmd.Flags().Int32Slice(FlagWhitelistPerms, []int32{}, "the whitelist value in format 1,2,3")
 	cmd.Flags().Int32Slice(FlagBlacklistPerms, []int32{}, "the blacklist values in format 1,2,3")
+	cmd.Flags().String(FlagDescription, "", "the description of the role")
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func GetTxProposalSetRoleVoteWeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-role-vote-weight role weight",
+		Short: "Create a proposal to set the vote weight of a role.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			role, err := parseRole(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			weight, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid weight: %w", err)
+			}
+
+			msg := types.NewMsgProposalSetRoleVoteWeight(
+				clientCtx.FromAddress,
+				types.Role(role),
+				weight,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func GetTxProposalParameterChange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "param-change subspace key type value",
+		Short: "Create a proposal to change a param of a module param subspace.",
+		Long: `Create a proposal to change a param of a module param subspace.
+The type of the value is one of bool, uint, dec, duration or string, like:
+param-change customslashing DowntimeInactiveDuration duration 20m`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valueType, ok := types.ParamValueType_value["PARAM_VALUE_TYPE_"+strings.ToUpper(args[2])]
+			if !ok {
+				return fmt.Errorf("invalid value type %s", args[2])
+			}
+
+			msg := types.NewMsgProposalParameterChange(
+				clientCtx.FromAddress,
+				[]types.ParamChange{
+					types.NewParamChange(args[0], args[1], types.ParamValueType(valueType), args[3]),
+				},
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func GetTxProposalMultiContent() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-content contents-file",
+		Short: "Create a proposal batching several contents applied atomically.",
+		Long: `Create a proposal batching several contents applied atomically.
+The contents are read from a JSON file, like:
+{
+	"contents": [
+		{"@type": "/kira.gov.SetRoleVoteWeightProposal", "role": 2, "weight": "3"},
+		{"@type": "/kira.gov.SetNetworkPropertyProposal", "network_property": "MIN_TX_FEE", "value": "200"}
+	]
+}`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgProposalMultiContent{}
+			err = clientCtx.JSONMarshaler.UnmarshalJSON(bz, msg)
+			if err != nil {
+				return fmt.Errorf("invalid contents file: %w", err)
+			}
+			msg.Proposer = clientCtx.FromAddress
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
 
 	return cmd
@@ -909,3 +1628,98 @@ func convertAsPermValues(values []int32) []types.PermValue {
 
 	return v
 }
+
+func GetTxProposalSoftwareUpgrade() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "software-upgrade name height",
+		Short: "Create a proposal to schedule a software upgrade.",
+		Long: `Create a proposal to schedule a software upgrade.
+The chain halts at the upgrade height until the software handling the upgrade name is started, like:
+software-upgrade v0.2.0 150000 --upgrade-info="https://github.com/KiraCore/sekai/releases/tag/v0.2.0"`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid upgrade height: %w", err)
+			}
+
+			info, err := cmd.Flags().GetString(FlagUpgradeInfo)
+			if err != nil {
+				return fmt.Errorf("invalid upgrade info: %w", err)
+			}
+
+			msg := types.NewMsgProposalSoftwareUpgrade(
+				clientCtx.FromAddress,
+				upgradetypes.Plan{
+					Name:   args[0],
+					Height: height,
+					Info:   info,
+				},
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagUpgradeInfo, "", "Info of the upgrade, like the release of the software to run.")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func GetTxProposalCancelSoftwareUpgrade() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-software-upgrade",
+		Short: "Create a proposal to cancel the scheduled software upgrade.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgProposalCancelSoftwareUpgrade(clientCtx.FromAddress)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func GetTxProposalRemoveCouncilor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-councilor address",
+		Short: "Create a proposal to remove a councilor from the council registry.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid address: %w", err)
+			}
+
+			msg := types.NewMsgProposalRemoveCouncilor(clientCtx.FromAddress, addr)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
@@ -0,0 +1,180 @@
+package gov
+
+import (
+	"fmt"
+
+	"github.com/KiraCore/sekai/x/gov/keeper"
+	"github.com/KiraCore/sekai/x/gov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis sets the roles, actors, network properties and the proposals of the genesis
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genesisState types.GenesisState) {
+	for _, actor := range genesisState.NetworkActors {
+		k.SaveNetworkActor(ctx, *actor)
+		for _, role := range actor.Roles {
+			k.AssignRoleToActor(ctx, *actor, types.Role(role))
+		}
+		for _, perm := range actor.Permissions.Whitelist {
+			err := k.AddWhitelistPermission(ctx, *actor, types.PermValue(perm))
+			if err != nil {
+				panic(err)
+			}
+		}
+		// TODO when we add keeper function for managing blacklist mapping, we can just enable this
+		// for _, perm := range actor.Permissions.Blacklist {
+		// 	k.RemoveWhitelistPermission(ctx, *actor, types.PermValue(perm))
+		// }
+	}
+
+	for index, perm := range genesisState.Permissions {
+		role := types.Role(index)
+		k.CreateRole(ctx, role)
+		for _, white := range perm.Whitelist {
+			err := k.WhitelistRolePermission(ctx, role, types.PermValue(white))
+			if err != nil {
+				panic(err)
+			}
+		}
+		for _, black := range perm.Blacklist {
+			err := k.BlacklistRolePermission(ctx, role, types.PermValue(black))
+			if err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	for _, info := range genesisState.Roles {
+		if err := k.SetRoleInfo(ctx, info); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, expiry := range genesisState.PermissionExpiries {
+		k.SetPermissionExpiry(ctx, expiry)
+	}
+
+	for _, grant := range genesisState.PermissionGrants {
+		k.SetPermissionGrant(ctx, grant)
+	}
+
+	for _, auth := range genesisState.Authorizations {
+		k.SetAuthorization(ctx, auth)
+	}
+
+	for _, group := range genesisState.Groups {
+		k.SetGroup(ctx, group)
+	}
+
+	for _, proposal := range genesisState.GroupProposals {
+		k.SetGroupProposal(ctx, proposal)
+	}
+
+	for _, weight := range genesisState.RoleVoteWeights {
+		k.SetRoleVoteWeight(ctx, types.Role(weight.Role), weight.Weight)
+	}
+
+	for _, councilor := range genesisState.Councilors {
+		k.SaveCouncilor(ctx, councilor)
+	}
+
+	for key, entry := range genesisState.DataRegistryEntries {
+		k.UpsertDataRegistryEntry(ctx, key, *entry)
+	}
+
+	for _, proposal := range genesisState.Proposals {
+		k.SaveProposal(ctx, proposal)
+	}
+
+	for _, proposalID := range genesisState.ActiveProposals {
+		k.AddToActiveProposals(ctx, mustGetProposal(ctx, k, proposalID))
+	}
+
+	for _, proposalID := range genesisState.EnactmentProposals {
+		k.AddToEnactmentProposals(ctx, mustGetProposal(ctx, k, proposalID))
+	}
+
+	for _, vote := range genesisState.Votes {
+		k.SetVote(ctx, vote)
+	}
+
+	for _, vote := range genesisState.VoteHistory {
+		k.SetVoteHistoryEntry(ctx, vote)
+	}
+
+	for _, deposit := range genesisState.Deposits {
+		k.SaveDeposit(ctx, deposit)
+	}
+
+	k.SaveProposalID(ctx, genesisState.StartingProposalId)
+
+	k.SetNetworkProperties(ctx, genesisState.NetworkProperties)
+
+	for _, fee := range genesisState.ExecutionFees {
+		k.SetExecutionFee(ctx, fee)
+	}
+
+	k.SavePoorNetworkMsgs(ctx, genesisState.PoorNetworkMessages)
+}
+
+// ExportGenesis returns the content of every store of the module
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	nextProposalID, err := k.GetNextProposalID(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	actors := []*types.NetworkActor{}
+	for _, actor := range k.GetNetworkActors(ctx) {
+		actor := actor
+		actors = append(actors, &actor)
+	}
+
+	dataRegistryEntries := make(map[string]*types.DataRegistryEntry)
+	for _, key := range k.ListDataRegistryEntry(ctx) {
+		entry, _ := k.GetDataRegistryEntry(ctx, key)
+		dataRegistryEntries[key] = &entry
+	}
+
+	proposals, err := k.GetProposals(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	properties := k.GetNetworkProperties(ctx)
+	poorNetworkMessages, _ := k.GetPoorNetworkMsgs(ctx)
+
+	return &types.GenesisState{
+		StartingProposalId:     nextProposalID,
+		Permissions:            k.GetRolesPermissions(ctx),
+		Roles:                  k.GetRoleInfos(ctx),
+		PermissionExpiries:     k.GetPermissionExpiries(ctx),
+		PermissionGrants:       k.GetPermissionGrants(ctx),
+		Authorizations:         k.GetAuthorizations(ctx),
+		Groups:                 k.GetGroups(ctx),
+		GroupProposals:         k.GetGroupProposals(ctx),
+		NetworkActors:          actors,
+		NetworkProperties:      properties,
+		ExecutionFees:          k.GetExecutionFees(ctx),
+		PoorNetworkMessages:    poorNetworkMessages,
+		PoorNetworkMaxBankSend: properties.PoorNetworkMaxBankSend,
+		RoleVoteWeights:        k.GetRoleVoteWeights(ctx),
+		Councilors:             k.GetCouncilors(ctx),
+		DataRegistryEntries:    dataRegistryEntries,
+		Proposals:              proposals,
+		ActiveProposals:        k.GetActiveProposalIDs(ctx),
+		EnactmentProposals:     k.GetEnactmentProposalIDs(ctx),
+		Votes:                  k.GetVotes(ctx),
+		VoteHistory:            k.GetAllVoteHistory(ctx),
+		Deposits:               k.GetDeposits(ctx),
+	}
+}
+
+func mustGetProposal(ctx sdk.Context, k keeper.Keeper, proposalID uint64) types.Proposal {
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		panic(fmt.Sprintf("proposal %d does not exist", proposalID))
+	}
+
+	return proposal
+}
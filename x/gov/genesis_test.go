@@ -0,0 +1,107 @@
+package gov_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/gov"
+	"github.com/KiraCore/sekai/x/gov/types"
+)
+
+func TestExportInitGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(100))
+	now := time.Unix(1600000000, 0).UTC()
+
+	actor := types.NewNetworkActor(addrs[0], nil, types.Active, nil, types.NewPermissions(nil, nil), 1)
+	app.CustomGovKeeper.SaveNetworkActor(ctx, actor)
+	app.CustomGovKeeper.AssignRoleToActor(ctx, actor, types.RoleValidator)
+	actor, _ = app.CustomGovKeeper.GetNetworkActorByAddress(ctx, addrs[0])
+	require.NoError(t, app.CustomGovKeeper.AddWhitelistPermission(ctx, actor, types.PermClaimCouncilor))
+	actor, _ = app.CustomGovKeeper.GetNetworkActorByAddress(ctx, addrs[0])
+	require.NoError(t, app.CustomGovKeeper.AddWhitelistPermission(ctx, actor, types.PermUpsertTokenRate))
+	app.CustomGovKeeper.SetPermissionExpiry(ctx, types.NewPermissionExpiry(addrs[0], types.PermUpsertTokenRate, 100, nil))
+	app.CustomGovKeeper.SetPermissionGrant(ctx, types.NewPermissionGrant(addrs[0], addrs[1], types.PermUpsertTokenRate, 0, &now))
+	app.CustomGovKeeper.SetAuthorization(ctx, types.NewAuthorization(addrs[0], addrs[1], types.MsgTypeURL(&types.MsgVoteProposal{}), nil, 3, 0, &now))
+	group := app.CustomGovKeeper.CreateGroup(ctx, []types.GroupMember{types.NewGroupMember(addrs[0], 1), types.NewGroupMember(addrs[1], 1)}, 2, "group")
+	groupProposalMsg, err := types.NewMsgSubmitGroupProposal(addrs[0], group.Id, []sdk.Msg{types.NewMsgVoteProposal(1, group.Address, types.OptionYes)})
+	require.NoError(t, err)
+	app.CustomGovKeeper.SetGroupProposal(ctx, types.NewGroupProposal(1, group.Id, addrs[0], groupProposalMsg.Msgs))
+
+	app.CustomGovKeeper.CreateRole(ctx, types.Role(3))
+	require.NoError(t, app.CustomGovKeeper.WhitelistRolePermission(ctx, types.Role(3), types.PermClaimValidator))
+	require.NoError(t, app.CustomGovKeeper.BlacklistRolePermission(ctx, types.Role(3), types.PermSetPermissions))
+	require.NoError(t, app.CustomGovKeeper.SetRoleInfo(ctx, types.NewRoleInfo(types.Role(3), "auditor", "Audits the network", false)))
+	app.CustomGovKeeper.SetRoleVoteWeight(ctx, types.Role(3), sdk.NewDecWithPrec(15, 1))
+
+	app.CustomGovKeeper.SaveCouncilor(ctx, types.NewCouncilor("moniker", "website", "social", "identity", addrs[1], types.CouncilorActive, now))
+	app.CustomGovKeeper.UpsertDataRegistryEntry(ctx, "code", types.NewDataRegistryEntry("hash", "reference", "encoding", 1234))
+
+	activeProposal, err := types.NewProposal(1, types.NewAssignPermissionProposal(addrs[1], types.PermClaimValidator), now, now.Add(time.Minute), now.Add(2*time.Minute))
+	require.NoError(t, err)
+	app.CustomGovKeeper.SaveProposal(ctx, activeProposal)
+	app.CustomGovKeeper.AddToActiveProposals(ctx, activeProposal)
+
+	enactmentProposal, err := types.NewProposal(2, types.NewAssignPermissionProposal(addrs[0], types.PermClaimValidator), now, now, now.Add(time.Hour))
+	require.NoError(t, err)
+	enactmentProposal.Result = types.Passed
+	app.CustomGovKeeper.SaveProposal(ctx, enactmentProposal)
+	app.CustomGovKeeper.AddToEnactmentProposals(ctx, enactmentProposal)
+
+	vote := types.NewVote(1, addrs[0], types.OptionNo)
+	vote.SubmitTime = now
+	app.CustomGovKeeper.SaveVote(ctx, vote)
+	vote = types.NewVote(1, addrs[0], types.OptionYes)
+	vote.SubmitTime = now.Add(time.Second)
+	app.CustomGovKeeper.SaveVote(ctx, vote)
+
+	app.CustomGovKeeper.SaveDeposit(ctx, types.Deposit{
+		ProposalId: 1,
+		Depositor:  addrs[1],
+		Amount:     sdk.NewCoins(sdk.NewInt64Coin("ukex", 10)),
+	})
+
+	cdc := app.AppCodec()
+	exported := cdc.MustMarshalJSON(gov.ExportGenesis(ctx, app.CustomGovKeeper))
+
+	var genesisState types.GenesisState
+	cdc.MustUnmarshalJSON(exported, &genesisState)
+	require.Equal(t, uint64(3), genesisState.StartingProposalId)
+	require.Len(t, genesisState.Proposals, 2)
+	require.Equal(t, []uint64{1}, genesisState.ActiveProposals)
+	require.Equal(t, []uint64{2}, genesisState.EnactmentProposals)
+	require.Len(t, genesisState.Votes, 1)
+	require.Len(t, genesisState.VoteHistory, 2)
+	require.Len(t, genesisState.Deposits, 1)
+	require.Len(t, genesisState.Councilors, 1)
+	require.Len(t, genesisState.RoleVoteWeights, 1)
+	require.Contains(t, genesisState.DataRegistryEntries, "code")
+	require.Contains(t, genesisState.Permissions, uint64(3))
+	require.Len(t, genesisState.Roles, 3)
+	require.Equal(t, types.NewRoleInfo(types.Role(3), "auditor", "Audits the network", false), genesisState.Roles[2])
+	require.Equal(t, []types.PermissionExpiry{types.NewPermissionExpiry(addrs[0], types.PermUpsertTokenRate, 100, nil)}, genesisState.PermissionExpiries)
+	require.Equal(t, []types.PermissionGrant{types.NewPermissionGrant(addrs[0], addrs[1], types.PermUpsertTokenRate, 0, &now)}, genesisState.PermissionGrants)
+	require.Len(t, genesisState.Authorizations, 1)
+	require.Equal(t, types.MsgTypeURL(&types.MsgVoteProposal{}), genesisState.Authorizations[0].MsgTypeUrl)
+	require.Equal(t, uint64(3), genesisState.Authorizations[0].MaxUses)
+	require.Equal(t, []types.Group{group}, genesisState.Groups)
+	require.Len(t, genesisState.GroupProposals, 1)
+	require.Len(t, genesisState.GroupProposals[0].UnpackedMsgs(), 1)
+
+	// a fresh chain started from the exported genesis exports the same genesis
+	newApp := simapp.Setup(false)
+	newCtx := newApp.NewContext(false, tmproto.Header{})
+	gov.InitGenesis(newCtx, newApp.CustomGovKeeper, genesisState)
+	require.Equal(t, uint64(2), newApp.CustomGovKeeper.GetNextGroupID(newCtx))
+	require.Equal(t, uint64(2), newApp.CustomGovKeeper.GetNextGroupProposalID(newCtx))
+
+	reexported := cdc.MustMarshalJSON(gov.ExportGenesis(newCtx, newApp.CustomGovKeeper))
+	require.Equal(t, string(exported), string(reexported))
+}
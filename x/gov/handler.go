@@ -29,6 +29,37 @@ func NewHandler(ck keeper.Keeper) sdk.Handler {
 		case *customgovtypes.MsgBlacklistPermissions:
 			res, err := msgServer.BlacklistPermissions(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *customgovtypes.MsgDelegatePermission:
+			res, err := msgServer.DelegatePermission(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *customgovtypes.MsgRevokePermission:
+			res, err := msgServer.RevokePermission(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		// Authorization Related
+		case *customgovtypes.MsgGrantAuthorization:
+			res, err := msgServer.GrantAuthorization(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *customgovtypes.MsgRevokeAuthorization:
+			res, err := msgServer.RevokeAuthorization(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *customgovtypes.MsgExec:
+			res, err := msgServer.Exec(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		// Group Related
+		case *customgovtypes.MsgCreateGroup:
+			res, err := msgServer.CreateGroup(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *customgovtypes.MsgUpdateGroup:
+			res, err := msgServer.UpdateGroup(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *customgovtypes.MsgSubmitGroupProposal:
+			res, err := msgServer.SubmitGroupProposal(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *customgovtypes.MsgApproveGroupProposal:
+			res, err := msgServer.ApproveGroupProposal(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 
 		// Councilor Related
 		case *customgovtypes.MsgClaimCouncilor:
@@ -74,9 +105,30 @@ func NewHandler(ck keeper.Keeper) sdk.Handler {
 		case *customgovtypes.MsgVoteProposal:
 			res, err := msgServer.VoteProposal(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *customgovtypes.MsgCancelProposal:
+			res, err := msgServer.CancelProposal(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *customgovtypes.MsgProposalCreateRole:
 			res, err := msgServer.ProposalCreateRole(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *customgovtypes.MsgProposalSetRoleVoteWeight:
+			res, err := msgServer.ProposalSetRoleVoteWeight(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *customgovtypes.MsgProposalParameterChange:
+			res, err := msgServer.ProposalParameterChange(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *customgovtypes.MsgProposalMultiContent:
+			res, err := msgServer.ProposalMultiContent(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *customgovtypes.MsgProposalSoftwareUpgrade:
+			res, err := msgServer.ProposalSoftwareUpgrade(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *customgovtypes.MsgProposalCancelSoftwareUpgrade:
+			res, err := msgServer.ProposalCancelSoftwareUpgrade(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *customgovtypes.MsgProposalRemoveCouncilor:
+			res, err := msgServer.ProposalRemoveCouncilor(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, errors.Wrapf(errors.ErrUnknownRequest, "unrecognized %s message type: %T", customgovtypes.ModuleName, msg)
 		}
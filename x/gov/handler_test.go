@@ -11,11 +11,15 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/KiraCore/sekai/app"
 	"github.com/KiraCore/sekai/simapp"
+	kiratypes "github.com/KiraCore/sekai/types"
 	"github.com/KiraCore/sekai/x/gov"
 	"github.com/KiraCore/sekai/x/gov/types"
 )
@@ -258,6 +262,436 @@ func TestNewHandler_SetPermissions_ProposerHasRoleSudo(t *testing.T) {
 	}
 }
 
+func TestHandler_WhitelistPermissions_WithExpiry(t *testing.T) {
+	proposerAddr, err := sdk.AccAddressFromBech32("kira1alzyfq40zjsveat87jlg8jxetwqmr0a29sgd0f")
+	require.NoError(t, err)
+
+	addr, err := sdk.AccAddressFromBech32("kira15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqzp4f3d")
+	require.NoError(t, err)
+
+	now := time.Unix(1600000000, 0).UTC()
+	past := now.Add(-time.Minute)
+	later := now.Add(time.Hour)
+
+	tests := []struct {
+		name         string
+		expiryHeight uint64
+		expiryTime   *time.Time
+		expectedErr  error
+	}{
+		{
+			name:         "expiry height not after the current height",
+			expiryHeight: 10,
+			expectedErr:  errors.Wrap(types.ErrInvalidPermissionExpiry, "expiry height 10 is not after the current height 10"),
+		},
+		{
+			name:        "expiry time not after the current time",
+			expiryTime:  &past,
+			expectedErr: errors.Wrapf(types.ErrInvalidPermissionExpiry, "expiry time %s is not after the current time %s", &past, now),
+		},
+		{
+			name:         "expiry height and time",
+			expiryHeight: 20,
+			expiryTime:   &later,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			ctx := app.NewContext(false, tmproto.Header{Height: 10, Time: now})
+
+			err := setPermissionToAddr(t, app, ctx, proposerAddr, types.PermSetPermissions)
+			require.NoError(t, err)
+
+			handler := gov.NewHandler(app.CustomGovKeeper)
+			_, err = handler(ctx, types.NewMsgWhitelistPermissions(proposerAddr, addr, uint32(types.PermUpsertTokenRate), tt.expiryHeight, tt.expiryTime))
+			if tt.expectedErr != nil {
+				require.EqualError(t, err, tt.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+
+			actor, found := app.CustomGovKeeper.GetNetworkActorByAddress(ctx, addr)
+			require.True(t, found)
+			require.True(t, actor.Permissions.IsWhitelisted(types.PermUpsertTokenRate))
+			require.Equal(t, []types.PermissionExpiry{
+				types.NewPermissionExpiry(addr, types.PermUpsertTokenRate, tt.expiryHeight, tt.expiryTime),
+			}, app.CustomGovKeeper.GetPermissionExpiries(ctx))
+		})
+	}
+}
+
+func TestHandler_DelegatePermission_Errors(t *testing.T) {
+	delegator, err := sdk.AccAddressFromBech32("kira1alzyfq40zjsveat87jlg8jxetwqmr0a29sgd0f")
+	require.NoError(t, err)
+
+	delegatee, err := sdk.AccAddressFromBech32("kira15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqzp4f3d")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		msg          sdk.Msg
+		preparePerms func(t *testing.T, app *simapp.SimApp, ctx sdk.Context)
+		expectedErr  error
+	}{
+		{
+			"delegator is not an actor",
+			types.NewMsgDelegatePermission(delegator, delegatee, uint32(types.PermUpsertTokenRate), 0, nil),
+			func(t *testing.T, app *simapp.SimApp, ctx sdk.Context) {},
+			errors.Wrap(types.ErrNotEnoughPermissions, types.PermUpsertTokenRate.String()),
+		},
+		{
+			"delegator only holds the permission through a grant",
+			types.NewMsgDelegatePermission(delegator, delegatee, uint32(types.PermUpsertTokenRate), 0, nil),
+			func(t *testing.T, app *simapp.SimApp, ctx sdk.Context) {
+				owner := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(10))[0]
+				err := setPermissionToAddr(t, app, ctx, owner, types.PermUpsertTokenRate)
+				require.NoError(t, err)
+				app.CustomGovKeeper.SaveNetworkActor(ctx, types.NewDefaultActor(delegator))
+				app.CustomGovKeeper.SetPermissionGrant(ctx, types.NewPermissionGrant(owner, delegator, types.PermUpsertTokenRate, 0, nil))
+			},
+			errors.Wrap(types.ErrNotEnoughPermissions, types.PermUpsertTokenRate.String()),
+		},
+		{
+			"expiry height not after the current height",
+			types.NewMsgDelegatePermission(delegator, delegatee, uint32(types.PermUpsertTokenRate), 1, nil),
+			func(t *testing.T, app *simapp.SimApp, ctx sdk.Context) {
+				err := setPermissionToAddr(t, app, ctx, delegator, types.PermUpsertTokenRate)
+				require.NoError(t, err)
+			},
+			errors.Wrap(types.ErrInvalidPermissionExpiry, "expiry height 1 is not after the current height 10"),
+		},
+		{
+			"revoke a grant which does not exist",
+			types.NewMsgRevokePermission(delegator, delegatee, uint32(types.PermUpsertTokenRate)),
+			func(t *testing.T, app *simapp.SimApp, ctx sdk.Context) {},
+			types.ErrPermissionGrantNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			ctx := app.NewContext(false, tmproto.Header{Height: 10})
+
+			tt.preparePerms(t, app, ctx)
+
+			handler := gov.NewHandler(app.CustomGovKeeper)
+			_, err := handler(ctx, tt.msg)
+			require.EqualError(t, err, tt.expectedErr.Error())
+		})
+	}
+}
+
+func TestHandler_DelegatePermission_AndRevoke(t *testing.T) {
+	delegator, err := sdk.AccAddressFromBech32("kira1alzyfq40zjsveat87jlg8jxetwqmr0a29sgd0f")
+	require.NoError(t, err)
+
+	delegatee, err := sdk.AccAddressFromBech32("kira15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqzp4f3d")
+	require.NoError(t, err)
+
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 10})
+
+	err = setPermissionToAddr(t, app, ctx, delegator, types.PermUpsertTokenRate)
+	require.NoError(t, err)
+	require.False(t, app.CustomGovKeeper.CheckIfAllowedPermission(ctx, delegatee, types.PermUpsertTokenRate))
+
+	handler := gov.NewHandler(app.CustomGovKeeper)
+	_, err = handler(ctx, types.NewMsgDelegatePermission(delegator, delegatee, uint32(types.PermUpsertTokenRate), 100, nil))
+	require.NoError(t, err)
+
+	// the delegatee does not need to be a network actor
+	require.True(t, app.CustomGovKeeper.CheckIfAllowedPermission(ctx, delegatee, types.PermUpsertTokenRate))
+	require.Equal(t, []types.PermissionGrant{
+		types.NewPermissionGrant(delegator, delegatee, types.PermUpsertTokenRate, 100, nil),
+	}, app.CustomGovKeeper.GetPermissionGrantsByDelegatee(ctx, delegatee))
+
+	_, err = handler(ctx, types.NewMsgRevokePermission(delegator, delegatee, uint32(types.PermUpsertTokenRate)))
+	require.NoError(t, err)
+
+	require.False(t, app.CustomGovKeeper.CheckIfAllowedPermission(ctx, delegatee, types.PermUpsertTokenRate))
+	require.Empty(t, app.CustomGovKeeper.GetPermissionGrants(ctx))
+}
+
+func TestHandler_GrantAuthorization_AndExec(t *testing.T) {
+	granter, err := sdk.AccAddressFromBech32("kira1alzyfq40zjsveat87jlg8jxetwqmr0a29sgd0f")
+	require.NoError(t, err)
+
+	grantee, err := sdk.AccAddressFromBech32("kira15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqzp4f3d")
+	require.NoError(t, err)
+
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 10})
+	addr := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(100))[0]
+
+	err = setPermissionToAddr(t, app, ctx, granter, types.PermSetPermissions)
+	require.NoError(t, err)
+
+	whitelistMsg := types.NewMsgWhitelistPermissions(granter, addr, uint32(types.PermUpsertTokenRate), 0, nil)
+	execMsg, err := types.NewMsgExec(grantee, []sdk.Msg{whitelistMsg})
+	require.NoError(t, err)
+
+	handler := gov.NewHandler(app.CustomGovKeeper)
+	_, err = handler(ctx, execMsg)
+	require.True(t, types.ErrAuthorizationNotFound.Is(err))
+
+	_, err = handler(ctx, types.NewMsgGrantAuthorization(granter, grantee, types.MsgTypeURL(whitelistMsg), nil, 1, 100, nil))
+	require.NoError(t, err)
+
+	// the whitelist permission message is checked against the permissions of the granter
+	_, err = handler(ctx, execMsg)
+	require.NoError(t, err)
+	require.True(t, app.CustomGovKeeper.CheckIfAllowedPermission(ctx, addr, types.PermUpsertTokenRate))
+	require.False(t, app.CustomGovKeeper.CheckIfAllowedPermission(ctx, grantee, types.PermSetPermissions))
+
+	// the authorization was given for a single use
+	require.Empty(t, app.CustomGovKeeper.GetAuthorizations(ctx))
+	_, err = handler(ctx, execMsg)
+	require.True(t, types.ErrAuthorizationNotFound.Is(err))
+}
+
+func TestHandler_Exec_Errors(t *testing.T) {
+	granter, err := sdk.AccAddressFromBech32("kira1alzyfq40zjsveat87jlg8jxetwqmr0a29sgd0f")
+	require.NoError(t, err)
+
+	grantee, err := sdk.AccAddressFromBech32("kira15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqzp4f3d")
+	require.NoError(t, err)
+
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 10})
+	addr := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(100))[0]
+
+	whitelistMsg := types.NewMsgWhitelistPermissions(granter, addr, uint32(types.PermUpsertTokenRate), 0, nil)
+	execMsg, err := types.NewMsgExec(grantee, []sdk.Msg{whitelistMsg})
+	require.NoError(t, err)
+
+	handler := gov.NewHandler(app.CustomGovKeeper)
+
+	// the granter does not hold the permission itself
+	_, err = handler(ctx, types.NewMsgGrantAuthorization(granter, grantee, types.MsgTypeURL(whitelistMsg), nil, 0, 0, nil))
+	require.NoError(t, err)
+	_, err = handler(ctx, execMsg)
+	require.EqualError(t, err, errors.Wrap(types.ErrNotEnoughPermissions, "PermSetPermissions").Error())
+
+	// the authorization is expired
+	_, err = handler(ctx, types.NewMsgGrantAuthorization(granter, grantee, types.MsgTypeURL(whitelistMsg), nil, 0, 11, nil))
+	require.NoError(t, err)
+	_, err = handler(ctx.WithBlockHeight(11), execMsg)
+	require.True(t, types.ErrAuthorizationExpired.Is(err))
+
+	// the authorization is revoked
+	_, err = handler(ctx, types.NewMsgRevokeAuthorization(granter, grantee, types.MsgTypeURL(whitelistMsg)))
+	require.NoError(t, err)
+	_, err = handler(ctx, execMsg)
+	require.True(t, types.ErrAuthorizationNotFound.Is(err))
+
+	_, err = handler(ctx, types.NewMsgRevokeAuthorization(granter, grantee, types.MsgTypeURL(whitelistMsg)))
+	require.True(t, types.ErrAuthorizationNotFound.Is(err))
+
+	// the expiry is already reached
+	_, err = handler(ctx, types.NewMsgGrantAuthorization(granter, grantee, types.MsgTypeURL(whitelistMsg), nil, 0, 10, nil))
+	require.True(t, types.ErrInvalidPermissionExpiry.Is(err))
+}
+
+func TestHandler_Exec_SpendLimit(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 10})
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(100))
+	granter, grantee, recipient := addrs[0], addrs[1], addrs[2]
+
+	sendMsg := banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewInt64Coin("ukex", 6)))
+	execMsg, err := types.NewMsgExec(grantee, []sdk.Msg{sendMsg})
+	require.NoError(t, err)
+
+	handler := gov.NewHandler(app.CustomGovKeeper)
+	_, err = handler(ctx, types.NewMsgGrantAuthorization(granter, grantee, types.MsgTypeURL(sendMsg), sdk.NewCoins(sdk.NewInt64Coin("ukex", 10)), 0, 0, nil))
+	require.NoError(t, err)
+
+	_, err = handler(ctx, execMsg)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("ukex", 94), app.BankKeeper.GetBalance(ctx, granter, "ukex"))
+	require.Equal(t, sdk.NewInt64Coin("ukex", 106), app.BankKeeper.GetBalance(ctx, recipient, "ukex"))
+
+	auth, found := app.CustomGovKeeper.GetAuthorization(ctx, granter, grantee, types.MsgTypeURL(sendMsg))
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ukex", 4)), auth.SpendLimit)
+	require.Equal(t, uint64(1), auth.Uses)
+
+	_, err = handler(ctx, execMsg)
+	require.True(t, types.ErrAuthorizationSpendLimit.Is(err))
+}
+
+func TestHandler_GroupProposal_Executed(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 10})
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 5, sdk.NewInt(100))
+	member1, member2 := addrs[3], addrs[4]
+
+	handler := gov.NewHandler(app.CustomGovKeeper)
+	res, err := handler(ctx, types.NewMsgCreateGroup(member1, []types.GroupMember{
+		types.NewGroupMember(member1, 1),
+		types.NewGroupMember(member2, 1),
+		types.NewGroupMember(addrs[0], 1),
+	}, 2, "2 of 3"))
+	require.NoError(t, err)
+
+	var createRes types.MsgCreateGroupResponse
+	require.NoError(t, createRes.Unmarshal(res.Data))
+	require.Equal(t, uint64(1), createRes.GroupId)
+	require.Equal(t, types.GroupAddress(1), createRes.Address)
+
+	err = setPermissionToAddr(t, app, ctx, createRes.Address, types.PermSetPermissions)
+	require.NoError(t, err)
+
+	whitelistMsg := types.NewMsgWhitelistPermissions(createRes.Address, addrs[1], uint32(types.PermUpsertTokenRate), 0, nil)
+	proposalMsg, err := types.NewMsgSubmitGroupProposal(member1, createRes.GroupId, []sdk.Msg{whitelistMsg})
+	require.NoError(t, err)
+	_, err = handler(ctx, proposalMsg)
+	require.NoError(t, err)
+
+	// the approval of the proposer alone does not reach the threshold
+	proposal, found := app.CustomGovKeeper.GetGroupProposal(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, types.GroupProposalPending, proposal.Status)
+	require.Equal(t, []sdk.AccAddress{member1}, proposal.Approvals)
+	require.False(t, app.CustomGovKeeper.CheckIfAllowedPermission(ctx, addrs[1], types.PermUpsertTokenRate))
+
+	_, err = handler(ctx, types.NewMsgApproveGroupProposal(member1, 1))
+	require.True(t, types.ErrInvalidGroupProposal.Is(err))
+
+	_, err = handler(ctx, types.NewMsgApproveGroupProposal(member2, 1))
+	require.NoError(t, err)
+
+	proposal, found = app.CustomGovKeeper.GetGroupProposal(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, types.GroupProposalExecuted, proposal.Status)
+	require.True(t, app.CustomGovKeeper.CheckIfAllowedPermission(ctx, addrs[1], types.PermUpsertTokenRate))
+
+	_, err = handler(ctx, types.NewMsgApproveGroupProposal(addrs[0], 1))
+	require.True(t, types.ErrGroupProposalNotPending.Is(err))
+}
+
+func TestHandler_GroupProposal_FailedAndUpdateGroup(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 10})
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(100))
+	addr, member1, member2 := addrs[0], addrs[1], addrs[2]
+
+	handler := gov.NewHandler(app.CustomGovKeeper)
+	_, err := handler(ctx, types.NewMsgCreateGroup(member1, []types.GroupMember{
+		types.NewGroupMember(member1, 2),
+		types.NewGroupMember(member2, 1),
+	}, 2, ""))
+	require.NoError(t, err)
+	groupAddr := types.GroupAddress(1)
+
+	// only the members of the group can propose and approve
+	whitelistMsg := types.NewMsgWhitelistPermissions(groupAddr, addr, uint32(types.PermUpsertTokenRate), 0, nil)
+	proposalMsg, err := types.NewMsgSubmitGroupProposal(addr, 1, []sdk.Msg{whitelistMsg})
+	require.NoError(t, err)
+	_, err = handler(ctx, proposalMsg)
+	require.True(t, types.ErrNotGroupMember.Is(err), err)
+
+	proposalMsg, err = types.NewMsgSubmitGroupProposal(member1, 2, []sdk.Msg{whitelistMsg})
+	require.NoError(t, err)
+	_, err = handler(ctx, proposalMsg)
+	require.True(t, types.ErrGroupNotFound.Is(err))
+
+	// the group does not hold the permission, the proposal fails without changing the state
+	proposalMsg, err = types.NewMsgSubmitGroupProposal(member1, 1, []sdk.Msg{whitelistMsg})
+	require.NoError(t, err)
+	_, err = handler(ctx, proposalMsg)
+	require.NoError(t, err)
+
+	proposal, found := app.CustomGovKeeper.GetGroupProposal(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, types.GroupProposalFailed, proposal.Status)
+	require.Equal(t, errors.Wrap(types.ErrNotEnoughPermissions, "PermSetPermissions").Error(), proposal.FailureReason)
+	require.False(t, app.CustomGovKeeper.CheckIfAllowedPermission(ctx, addr, types.PermUpsertTokenRate))
+
+	// the group replaces its members through a proposal
+	updateMsg := types.NewMsgUpdateGroup(groupAddr, []types.GroupMember{
+		types.NewGroupMember(member2, 1),
+		types.NewGroupMember(addr, 1),
+	}, 1, "updated")
+	proposalMsg, err = types.NewMsgSubmitGroupProposal(member1, 1, []sdk.Msg{updateMsg})
+	require.NoError(t, err)
+	_, err = handler(ctx, proposalMsg)
+	require.NoError(t, err)
+
+	group, found := app.CustomGovKeeper.GetGroupByAddress(ctx, groupAddr)
+	require.True(t, found)
+	require.Equal(t, uint64(1), group.Threshold)
+	require.Equal(t, "updated", group.Description)
+	_, isMember := group.MemberWeight(member1)
+	require.False(t, isMember)
+
+	_, err = handler(ctx, types.NewMsgApproveGroupProposal(member1, 3))
+	require.True(t, types.ErrGroupProposalNotFound.Is(err))
+}
+
+func TestHandler_GroupProposal_ExecutionFees(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 10})
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(100))
+	addr, member := addrs[0], addrs[1]
+
+	handler := gov.NewHandler(app.CustomGovKeeper)
+	_, err := handler(ctx, types.NewMsgCreateGroup(member, []types.GroupMember{types.NewGroupMember(member, 1)}, 1, ""))
+	require.NoError(t, err)
+	groupAddr := types.GroupAddress(1)
+	app.BankKeeper.SetBalance(ctx, groupAddr, sdk.NewInt64Coin("ukex", 1000))
+
+	app.CustomGovKeeper.SetExecutionFee(ctx, &types.ExecutionFee{
+		Name:            kiratypes.MsgTypeWhitelistPermissions,
+		TransactionType: kiratypes.MsgTypeWhitelistPermissions,
+		ExecutionFee:    300,
+		FailureFee:      50,
+	})
+
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	collected := app.BankKeeper.GetBalance(ctx, feeCollector, "ukex")
+	submit := func(msg sdk.Msg) types.GroupProposal {
+		proposalMsg, err := types.NewMsgSubmitGroupProposal(member, 1, []sdk.Msg{msg})
+		require.NoError(t, err)
+		res, err := handler(ctx, proposalMsg)
+		require.NoError(t, err)
+
+		var submitRes types.MsgSubmitGroupProposalResponse
+		require.NoError(t, submitRes.Unmarshal(res.Data))
+		proposal, found := app.CustomGovKeeper.GetGroupProposal(ctx, submitRes.ProposalId)
+		require.True(t, found)
+		return proposal
+	}
+
+	// the group pays the failure fee of a failed proposal
+	whitelistMsg := types.NewMsgWhitelistPermissions(groupAddr, addr, uint32(types.PermUpsertTokenRate), 0, nil)
+	require.Equal(t, types.GroupProposalFailed, submit(whitelistMsg).Status)
+	require.Equal(t, collected.Add(sdk.NewInt64Coin("ukex", 50)), app.BankKeeper.GetBalance(ctx, feeCollector, "ukex"))
+	require.Equal(t, sdk.NewInt64Coin("ukex", 950), app.BankKeeper.GetBalance(ctx, groupAddr, "ukex"))
+
+	// and the execution fee of an executed one
+	err = setPermissionToAddr(t, app, ctx, groupAddr, types.PermSetPermissions)
+	require.NoError(t, err)
+	require.Equal(t, types.GroupProposalExecuted, submit(whitelistMsg).Status)
+	require.Equal(t, collected.Add(sdk.NewInt64Coin("ukex", 350)), app.BankKeeper.GetBalance(ctx, feeCollector, "ukex"))
+	require.Equal(t, sdk.NewInt64Coin("ukex", 650), app.BankKeeper.GetBalance(ctx, groupAddr, "ukex"))
+
+	// the messages are not executed when the group can not pay their execution fees
+	app.BankKeeper.SetBalance(ctx, groupAddr, sdk.NewInt64Coin("ukex", 200))
+	whitelistMsg = types.NewMsgWhitelistPermissions(groupAddr, addr, uint32(types.PermCreateSetPermissionsProposal), 0, nil)
+	proposal := submit(whitelistMsg)
+	require.Equal(t, types.GroupProposalFailed, proposal.Status)
+	require.Contains(t, proposal.FailureReason, "insufficient funds")
+	require.False(t, app.CustomGovKeeper.CheckIfAllowedPermission(ctx, addr, types.PermCreateSetPermissionsProposal))
+	require.Equal(t, collected.Add(sdk.NewInt64Coin("ukex", 400)), app.BankKeeper.GetBalance(ctx, feeCollector, "ukex"))
+	require.Equal(t, sdk.NewInt64Coin("ukex", 150), app.BankKeeper.GetBalance(ctx, groupAddr, "ukex"))
+}
+
 func TestNewHandler_SetNetworkProperties(t *testing.T) {
 	changeFeeAddr, err := sdk.AccAddressFromBech32("kira15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqzp4f3d")
 	require.NoError(t, err)
@@ -479,7 +913,10 @@ func TestHandler_ClaimCouncilor_HappyPath(t *testing.T) {
 				tt.msg.Social,
 				tt.msg.Identity,
 				tt.msg.Address,
+				types.CouncilorActive,
+				ctx.BlockTime(),
 			)
+			expectedCouncilor.TermEnd = ctx.BlockTime().Add(365 * 24 * time.Hour)
 
 			councilor, found := app.CustomGovKeeper.GetCouncilor(ctx, addr)
 			require.True(t, found)
@@ -489,6 +926,95 @@ func TestHandler_ClaimCouncilor_HappyPath(t *testing.T) {
 	}
 }
 
+func TestHandler_ClaimCouncilor_KeepsStatusDuringTerm(t *testing.T) {
+	addr, err := sdk.AccAddressFromBech32("kira15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqzp4f3d")
+	require.NoError(t, err)
+
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Time: time.Unix(1600000000, 0).UTC()})
+
+	err = setPermissionToAddr(t, app, ctx, addr, types.PermClaimCouncilor)
+	require.NoError(t, err)
+
+	active := types.NewCouncilor("OldMoniker", "website", "social", "identity", addr, types.CouncilorActive, ctx.BlockTime())
+	active.MissedProposals = 3
+	active.TermEnd = ctx.BlockTime().Add(24 * time.Hour)
+	app.CustomGovKeeper.SaveCouncilor(ctx, active)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+
+	// claiming the seat again during the term only updates the councilor details
+	handler := gov.NewHandler(app.CustomGovKeeper)
+	_, err = handler(ctx, &types.MsgClaimCouncilor{Moniker: "TheMoniker", Address: addr})
+	require.NoError(t, err)
+
+	expected := active
+	expected.Moniker = "TheMoniker"
+	expected.Website = ""
+	expected.Social = ""
+	expected.Identity = ""
+
+	councilor, found := app.CustomGovKeeper.GetCouncilor(ctx, addr)
+	require.True(t, found)
+	require.Equal(t, expected, councilor)
+
+	_, found = app.CustomGovKeeper.GetCouncilorByMoniker(ctx, "OldMoniker")
+	require.False(t, found)
+
+	// once the term ended, claiming the seat starts a new term
+	ctx = ctx.WithBlockTime(active.TermEnd)
+	gov.EndBlocker(ctx, app.CustomGovKeeper, app.ProposalRouter)
+
+	councilor, found = app.CustomGovKeeper.GetCouncilor(ctx, addr)
+	require.True(t, found)
+	require.Equal(t, types.CouncilorInactive, councilor.Status)
+
+	_, err = handler(ctx, &types.MsgClaimCouncilor{Moniker: "TheMoniker", Address: addr})
+	require.NoError(t, err)
+
+	expected = types.NewCouncilor("TheMoniker", "", "", "", addr, types.CouncilorActive, ctx.BlockTime())
+	expected.TermEnd = ctx.BlockTime().Add(365 * 24 * time.Hour)
+
+	councilor, found = app.CustomGovKeeper.GetCouncilor(ctx, addr)
+	require.True(t, found)
+	require.Equal(t, expected, councilor)
+}
+
+func TestHandler_ClaimCouncilor_LiftsSuspension(t *testing.T) {
+	addr, err := sdk.AccAddressFromBech32("kira15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqzp4f3d")
+	require.NoError(t, err)
+
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Time: time.Unix(1600000000, 0).UTC()})
+
+	err = setPermissionToAddr(t, app, ctx, addr, types.PermClaimCouncilor)
+	require.NoError(t, err)
+
+	// the term of the councilor never ends
+	properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	properties.CouncilorTerm = 0
+	app.CustomGovKeeper.SetNetworkProperties(ctx, properties)
+
+	suspended := types.NewCouncilor("OldMoniker", "website", "social", "identity", addr, types.CouncilorSuspended, ctx.BlockTime())
+	suspended.MissedProposals = 10
+	app.CustomGovKeeper.SaveCouncilor(ctx, suspended)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.False(t, app.CustomGovKeeper.IsActiveCouncilor(ctx, addr))
+
+	// claiming the seat again makes the councilor active with no missed proposals, its term is kept
+	handler := gov.NewHandler(app.CustomGovKeeper)
+	_, err = handler(ctx, &types.MsgClaimCouncilor{Moniker: "TheMoniker", Address: addr})
+	require.NoError(t, err)
+
+	expected := types.NewCouncilor("TheMoniker", "", "", "", addr, types.CouncilorActive, suspended.TermStart)
+
+	councilor, found := app.CustomGovKeeper.GetCouncilor(ctx, addr)
+	require.True(t, found)
+	require.Equal(t, expected, councilor)
+	require.True(t, app.CustomGovKeeper.IsActiveCouncilor(ctx, addr))
+}
+
 func TestHandler_WhitelistRolePermissions_Errors(t *testing.T) {
 	addr, err := sdk.AccAddressFromBech32("kira15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqzp4f3d")
 	require.NoError(t, err)
@@ -868,6 +1394,8 @@ func TestHandler_CreateRole_Errors(t *testing.T) {
 			types.NewMsgCreateRole(
 				addr,
 				10,
+				"role_10",
+				"",
 			),
 			func(t *testing.T, app *simapp.SimApp, ctx sdk.Context) {},
 			fmt.Errorf("PermUpsertRole: not enough permissions"),
@@ -877,6 +1405,8 @@ func TestHandler_CreateRole_Errors(t *testing.T) {
 			types.NewMsgCreateRole(
 				addr,
 				1234,
+				"role_1234",
+				"",
 			),
 			func(t *testing.T, app *simapp.SimApp, ctx sdk.Context) {
 				err2 := setPermissionToAddr(t, app, ctx, addr, types.PermUpsertRole)
@@ -885,6 +1415,20 @@ func TestHandler_CreateRole_Errors(t *testing.T) {
 			},
 			fmt.Errorf("role already exist"),
 		},
+		{
+			"fails when sid already exists",
+			types.NewMsgCreateRole(
+				addr,
+				1234,
+				"validator",
+				"",
+			),
+			func(t *testing.T, app *simapp.SimApp, ctx sdk.Context) {
+				err2 := setPermissionToAddr(t, app, ctx, addr, types.PermUpsertRole)
+				require.NoError(t, err2)
+			},
+			types.ErrRoleSidExist,
+		},
 	}
 
 	for _, tt := range tests {
@@ -916,11 +1460,17 @@ func TestHandler_CreateRole(t *testing.T) {
 	_, err = handler(ctx, types.NewMsgCreateRole(
 		addr,
 		1234,
+		"role_1234",
+		"",
 	))
 	require.NoError(t, err)
 
 	_, found = app.CustomGovKeeper.GetPermissionsForRole(ctx, 1234)
 	require.True(t, found)
+
+	info, found := app.CustomGovKeeper.GetRoleInfoBySid(ctx, "role_1234")
+	require.True(t, found)
+	require.Equal(t, types.NewRoleInfo(1234, "role_1234", "", false), info)
 }
 
 func TestHandler_AssignRole_Errors(t *testing.T) {
@@ -1217,6 +1767,7 @@ func TestHandler_ProposalAssignPermission(t *testing.T) {
 		ctx.BlockTime().Add((time.Second*time.Duration(properties.ProposalEndTime))+(time.Second*time.Duration(properties.ProposalEnactmentTime))),
 	)
 	require.NoError(t, err)
+	expectedSavedProposal.Proposer = proposerAddr
 	require.Equal(t, expectedSavedProposal, savedProposal)
 
 	// Next proposal ID is increased.
@@ -1325,6 +1876,7 @@ func TestHandler_ProposalUpsertDataRegistry(t *testing.T) {
 		ctx.BlockTime().Add(time.Second*time.Duration(properties.ProposalEnactmentTime)+time.Second*time.Duration(properties.ProposalEndTime)),
 	)
 	require.NoError(t, err)
+	expectedSavedProposal.Proposer = proposerAddr
 	require.Equal(t, expectedSavedProposal, savedProposal)
 
 	// Next proposal ID is increased.
@@ -1618,8 +2170,70 @@ func TestHandler_VoteProposal(t *testing.T) {
 	require.Equal(t, types.NewVote(proposal.ProposalId, voterAddr, types.OptionAbstain), vote)
 }
 
-func setPermissionToAddr(t *testing.T, app *simapp.SimApp, ctx sdk.Context, addr sdk.AccAddress, perm types.PermValue) error {
-	proposerActor := types.NewDefaultActor(addr)
+func TestHandler_VoteProposal_ChangeWeightedVote(t *testing.T) {
+	voterAddr, err := sdk.AccAddressFromBech32("kira15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqzp4f3d")
+	require.NoError(t, err)
+
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	actor := types.NewNetworkActor(
+		voterAddr,
+		types.Roles{},
+		types.Active,
+		[]types.VoteOption{},
+		types.NewPermissions(nil, nil),
+		1,
+	)
+	app.CustomGovKeeper.SaveNetworkActor(ctx, actor)
+	err = app.CustomGovKeeper.AddWhitelistPermission(ctx, actor, types.PermVoteSetPermissionProposal)
+	require.NoError(t, err)
+
+	proposal, err := types.NewProposal(
+		1,
+		types.NewAssignPermissionProposal(
+			voterAddr,
+			types.PermClaimCouncilor,
+		),
+		ctx.BlockTime(),
+		ctx.BlockTime().Add(time.Second*10),
+		ctx.BlockTime().Add(time.Second*20),
+	)
+	require.NoError(t, err)
+	app.CustomGovKeeper.SaveProposal(ctx, proposal)
+
+	handler := gov.NewHandler(app.CustomGovKeeper)
+	_, err = handler(ctx, types.NewMsgVoteProposal(proposal.ProposalId, voterAddr, types.OptionYes))
+	require.NoError(t, err)
+
+	// change the vote to a split vote with a reason
+	options := types.WeightedVoteOptions{
+		{Option: types.OptionNo, Weight: sdk.NewDecWithPrec(7, 1)},
+		{Option: types.OptionAbstain, Weight: sdk.NewDecWithPrec(3, 1)},
+	}
+	msg := types.NewMsgVoteProposal(proposal.ProposalId, voterAddr, types.OptionNo)
+	msg.Options = options
+	msg.Reason = "not convinced yet"
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second * 5))
+	_, err = handler(ctx, msg)
+	require.NoError(t, err)
+
+	vote, found := app.CustomGovKeeper.GetVote(ctx, proposal.ProposalId, voterAddr)
+	require.True(t, found)
+	require.Equal(t, types.OptionNo, vote.Option)
+	require.Equal(t, options, types.WeightedVoteOptions(vote.Options))
+	require.Equal(t, "not convinced yet", vote.Reason)
+	require.Equal(t, ctx.BlockTime(), vote.SubmitTime)
+
+	history := app.CustomGovKeeper.GetVoteHistory(ctx, proposal.ProposalId, voterAddr)
+	require.Len(t, history, 2)
+	require.Equal(t, types.OptionYes, history[0].Option)
+	require.Equal(t, vote, history[1])
+}
+
+func setPermissionToAddr(t *testing.T, app *simapp.SimApp, ctx sdk.Context, addr sdk.AccAddress, perm types.PermValue) error {
+	proposerActor := types.NewDefaultActor(addr)
 	err := app.CustomGovKeeper.AddWhitelistPermission(ctx, proposerActor, perm)
 	require.NoError(t, err)
 
@@ -1709,6 +2323,7 @@ func TestHandler_ProposalSetNetworkProperty(t *testing.T) {
 		ctx.BlockTime().Add(time.Second*time.Duration(properties.ProposalEnactmentTime)+time.Second*time.Duration(properties.ProposalEndTime)),
 	)
 	require.NoError(t, err)
+	expectedSavedProposal.Proposer = proposerAddr
 	require.Equal(t, expectedSavedProposal, savedProposal)
 
 	// Next proposal ID is increased.
@@ -1740,6 +2355,8 @@ func TestHandler_CreateProposalCreateRole_Errors(t *testing.T) {
 			types.NewMsgProposalCreateRole(
 				proposerAddr,
 				types.Role(1),
+				"role_1",
+				"",
 				[]types.PermValue{},
 				[]types.PermValue{types.PermClaimValidator},
 			),
@@ -1751,6 +2368,8 @@ func TestHandler_CreateProposalCreateRole_Errors(t *testing.T) {
 			types.NewMsgProposalCreateRole(
 				proposerAddr,
 				types.Role(1),
+				"role_1",
+				"",
 				[]types.PermValue{types.PermClaimCouncilor},
 				[]types.PermValue{},
 			),
@@ -1767,11 +2386,34 @@ func TestHandler_CreateProposalCreateRole_Errors(t *testing.T) {
 			},
 			types.ErrRoleExist,
 		},
+		{
+			"sid already exists",
+			types.NewMsgProposalCreateRole(
+				proposerAddr,
+				types.Role(1000),
+				"sudo",
+				"",
+				[]types.PermValue{types.PermClaimCouncilor},
+				[]types.PermValue{},
+			),
+			func(t *testing.T, app *simapp.SimApp, ctx sdk.Context) {
+				proposerActor := types.NewDefaultActor(proposerAddr)
+				err := app.CustomGovKeeper.AddWhitelistPermission(
+					ctx,
+					proposerActor,
+					types.PermCreateRoleProposal,
+				)
+				require.NoError(t, err)
+			},
+			types.ErrRoleSidExist,
+		},
 		{
 			"permissions are empty",
 			types.NewMsgProposalCreateRole(
 				proposerAddr,
 				types.Role(1000),
+				"role_1000",
+				"",
 				[]types.PermValue{},
 				[]types.PermValue{},
 			),
@@ -1827,6 +2469,8 @@ func TestHandler_ProposalCreateRole(t *testing.T) {
 		types.NewMsgProposalCreateRole(
 			proposerAddr,
 			types.Role(1000),
+			"role_1000",
+			"",
 			[]types.PermValue{
 				types.PermClaimValidator,
 			},
@@ -1847,6 +2491,8 @@ func TestHandler_ProposalCreateRole(t *testing.T) {
 		1,
 		types.NewCreateRoleProposal(
 			types.Role(1000),
+			"role_1000",
+			"",
 			[]types.PermValue{
 				types.PermClaimValidator,
 			},
@@ -1859,6 +2505,7 @@ func TestHandler_ProposalCreateRole(t *testing.T) {
 		ctx.BlockTime().Add(time.Second*time.Duration(properties.ProposalEnactmentTime)+time.Second*time.Duration(properties.ProposalEndTime)),
 	)
 	require.NoError(t, err)
+	expectedSavedProposal.Proposer = proposerAddr
 	require.Equal(t, expectedSavedProposal, savedProposal)
 
 	// Next proposal ID is increased.
@@ -1874,3 +2521,468 @@ func TestHandler_ProposalCreateRole(t *testing.T) {
 	iterator = app.CustomGovKeeper.GetActiveProposalsWithFinishedVotingEndTimeIterator(ctx, ctx.BlockTime())
 	require.True(t, iterator.Valid())
 }
+
+func TestHandler_ProposalSetRoleVoteWeight(t *testing.T) {
+	proposerAddr, err := sdk.AccAddressFromBech32("kira1alzyfq40zjsveat87jlg8jxetwqmr0a29sgd0f")
+	require.NoError(t, err)
+
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{
+		Time: time.Now(),
+	})
+
+	handler := gov.NewHandler(app.CustomGovKeeper)
+	msg := types.NewMsgProposalSetRoleVoteWeight(proposerAddr, types.RoleValidator, sdk.NewDec(3))
+
+	// Proposer without permission
+	_, err = handler(ctx, msg)
+	require.EqualError(t, err, errors.Wrap(types.ErrNotEnoughPermissions, types.PermCreateSetRoleVoteWeightProposal.String()).Error())
+
+	proposerActor := types.NewDefaultActor(proposerAddr)
+	err = app.CustomGovKeeper.AddWhitelistPermission(ctx, proposerActor, types.PermCreateSetRoleVoteWeightProposal)
+	require.NoError(t, err)
+
+	// Role does not exist
+	_, err = handler(ctx, types.NewMsgProposalSetRoleVoteWeight(proposerAddr, types.Role(1000), sdk.NewDec(3)))
+	require.EqualError(t, err, types.ErrRoleDoesNotExist.Error())
+
+	res, err := handler(ctx, msg)
+	require.NoError(t, err)
+
+	expData, _ := proto.Marshal(&types.MsgProposalSetRoleVoteWeightResponse{ProposalID: 1})
+	require.Equal(t, expData, res.Data)
+
+	properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	savedProposal, found := app.CustomGovKeeper.GetProposal(ctx, 1)
+	require.True(t, found)
+
+	expectedSavedProposal, err := types.NewProposal(
+		1,
+		types.NewSetRoleVoteWeightProposal(types.RoleValidator, sdk.NewDec(3)),
+		ctx.BlockTime(),
+		ctx.BlockTime().Add(time.Second*time.Duration(properties.ProposalEndTime)),
+		ctx.BlockTime().Add(time.Second*time.Duration(properties.ProposalEnactmentTime)+time.Second*time.Duration(properties.ProposalEndTime)),
+	)
+	require.NoError(t, err)
+	expectedSavedProposal.Proposer = proposerAddr
+	require.Equal(t, expectedSavedProposal, savedProposal)
+}
+
+func TestHandler_ProposalParameterChange(t *testing.T) {
+	proposerAddr, err := sdk.AccAddressFromBech32("kira1alzyfq40zjsveat87jlg8jxetwqmr0a29sgd0f")
+	require.NoError(t, err)
+
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{
+		Time: time.Now(),
+	})
+
+	changes := []types.ParamChange{
+		types.NewParamChange("customslashing", "DowntimeInactiveDuration", types.ParamValueTypeDuration, "20m"),
+	}
+
+	handler := gov.NewHandler(app.CustomGovKeeper)
+	msg := types.NewMsgProposalParameterChange(proposerAddr, changes)
+
+	// Proposer without permission
+	_, err = handler(ctx, msg)
+	require.EqualError(t, err, errors.Wrap(types.ErrNotEnoughPermissions, types.PermCreateParameterChangeProposal.String()).Error())
+
+	proposerActor := types.NewDefaultActor(proposerAddr)
+	err = app.CustomGovKeeper.AddWhitelistPermission(ctx, proposerActor, types.PermCreateParameterChangeProposal)
+	require.NoError(t, err)
+
+	// Value rejected by the slashing params validator
+	_, err = handler(ctx, types.NewMsgProposalParameterChange(proposerAddr, []types.ParamChange{
+		types.NewParamChange("customslashing", "DowntimeInactiveDuration", types.ParamValueTypeDuration, "-20m"),
+	}))
+	require.Error(t, err)
+	require.True(t, types.ErrInvalidParamChange.Is(err))
+
+	res, err := handler(ctx, msg)
+	require.NoError(t, err)
+
+	expData, _ := proto.Marshal(&types.MsgProposalParameterChangeResponse{ProposalID: 1})
+	require.Equal(t, expData, res.Data)
+
+	properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	savedProposal, found := app.CustomGovKeeper.GetProposal(ctx, 1)
+	require.True(t, found)
+
+	expectedSavedProposal, err := types.NewProposal(
+		1,
+		types.NewParameterChangeProposal(changes),
+		ctx.BlockTime(),
+		ctx.BlockTime().Add(time.Second*time.Duration(properties.ProposalEndTime)),
+		ctx.BlockTime().Add(time.Second*time.Duration(properties.ProposalEnactmentTime)+time.Second*time.Duration(properties.ProposalEndTime)),
+	)
+	require.NoError(t, err)
+	expectedSavedProposal.Proposer = proposerAddr
+	require.Equal(t, expectedSavedProposal, savedProposal)
+}
+
+func TestHandler_ProposalMultiContent(t *testing.T) {
+	proposerAddr, err := sdk.AccAddressFromBech32("kira1alzyfq40zjsveat87jlg8jxetwqmr0a29sgd0f")
+	require.NoError(t, err)
+	voterAddr, err := sdk.AccAddressFromBech32("kira15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqzp4f3d")
+	require.NoError(t, err)
+
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{
+		Time: time.Now(),
+	})
+
+	contents := []types.Content{
+		types.NewSetRoleVoteWeightProposal(types.RoleValidator, sdk.NewDec(3)),
+		types.NewAssignPermissionProposal(voterAddr, types.PermClaimValidator),
+	}
+
+	handler := gov.NewHandler(app.CustomGovKeeper)
+	msg, err := types.NewMsgProposalMultiContent(proposerAddr, contents)
+	require.NoError(t, err)
+
+	// Proposer without permission
+	_, err = handler(ctx, msg)
+	require.EqualError(t, err, errors.Wrap(types.ErrNotEnoughPermissions, types.PermCreateMultiContentProposal.String()).Error())
+
+	proposerActor := types.NewDefaultActor(proposerAddr)
+	err = app.CustomGovKeeper.AddWhitelistPermission(ctx, proposerActor, types.PermCreateMultiContentProposal)
+	require.NoError(t, err)
+
+	// Proposer without the permissions to create the contents
+	_, err = handler(ctx, msg)
+	require.EqualError(t, err, errors.Wrap(types.ErrNotEnoughPermissions, types.PermCreateSetRoleVoteWeightProposal.String()).Error())
+
+	proposerActor, found := app.CustomGovKeeper.GetNetworkActorByAddress(ctx, proposerAddr)
+	require.True(t, found)
+	err = app.CustomGovKeeper.AddWhitelistPermission(ctx, proposerActor, types.PermCreateSetRoleVoteWeightProposal)
+	require.NoError(t, err)
+
+	_, err = handler(ctx, msg)
+	require.EqualError(t, err, errors.Wrap(types.ErrNotEnoughPermissions, types.PermCreateSetPermissionsProposal.String()).Error())
+
+	proposerActor, found = app.CustomGovKeeper.GetNetworkActorByAddress(ctx, proposerAddr)
+	require.True(t, found)
+	err = app.CustomGovKeeper.AddWhitelistPermission(ctx, proposerActor, types.PermCreateSetPermissionsProposal)
+	require.NoError(t, err)
+
+	res, err := handler(ctx, msg)
+	require.NoError(t, err)
+
+	expData, _ := proto.Marshal(&types.MsgProposalMultiContentResponse{ProposalID: 1})
+	require.Equal(t, expData, res.Data)
+
+	savedProposal, found := app.CustomGovKeeper.GetProposal(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, proposerAddr, savedProposal.Proposer)
+
+	content, ok := savedProposal.GetContent().(*types.MultiContentProposal)
+	require.True(t, ok)
+	require.Equal(t, contents, content.UnpackedContents())
+
+	// Voting requires the vote permissions of all the contents
+	voter := types.NewNetworkActor(
+		voterAddr,
+		types.Roles{},
+		types.Active,
+		[]types.VoteOption{},
+		types.NewPermissions(nil, nil),
+		1,
+	)
+	app.CustomGovKeeper.SaveNetworkActor(ctx, voter)
+	err = app.CustomGovKeeper.AddWhitelistPermission(ctx, voter, types.PermVoteSetRoleVoteWeightProposal)
+	require.NoError(t, err)
+
+	_, err = handler(ctx, types.NewMsgVoteProposal(1, voterAddr, types.OptionYes))
+	require.EqualError(t, err, errors.Wrap(types.ErrNotEnoughPermissions, types.PermVoteSetPermissionProposal.String()).Error())
+
+	voter, found = app.CustomGovKeeper.GetNetworkActorByAddress(ctx, voterAddr)
+	require.True(t, found)
+	err = app.CustomGovKeeper.AddWhitelistPermission(ctx, voter, types.PermVoteSetPermissionProposal)
+	require.NoError(t, err)
+
+	_, err = handler(ctx, types.NewMsgVoteProposal(1, voterAddr, types.OptionYes))
+	require.NoError(t, err)
+}
+
+func TestHandler_ProposalSoftwareUpgrade(t *testing.T) {
+	proposerAddr, err := sdk.AccAddressFromBech32("kira1alzyfq40zjsveat87jlg8jxetwqmr0a29sgd0f")
+	require.NoError(t, err)
+
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{
+		Height: 100,
+		Time:   time.Now(),
+	})
+
+	plan := upgradetypes.Plan{Name: "v0.2.0", Height: 200, Info: "https://github.com/KiraCore/sekai/releases/tag/v0.2.0"}
+
+	handler := gov.NewHandler(app.CustomGovKeeper)
+	msg := types.NewMsgProposalSoftwareUpgrade(proposerAddr, plan)
+
+	// Proposer without permission
+	_, err = handler(ctx, msg)
+	require.EqualError(t, err, errors.Wrap(types.ErrNotEnoughPermissions, types.PermCreateSoftwareUpgradeProposal.String()).Error())
+
+	proposerActor := types.NewDefaultActor(proposerAddr)
+	err = app.CustomGovKeeper.AddWhitelistPermission(ctx, proposerActor, types.PermCreateSoftwareUpgradeProposal)
+	require.NoError(t, err)
+
+	// Upgrade height already reached
+	_, err = handler(ctx, types.NewMsgProposalSoftwareUpgrade(proposerAddr, upgradetypes.Plan{Name: "v0.2.0", Height: 100}))
+	require.Error(t, err)
+	require.True(t, types.ErrInvalidUpgradePlan.Is(err))
+
+	res, err := handler(ctx, msg)
+	require.NoError(t, err)
+
+	expData, _ := proto.Marshal(&types.MsgProposalSoftwareUpgradeResponse{ProposalID: 1})
+	require.Equal(t, expData, res.Data)
+
+	savedProposal, found := app.CustomGovKeeper.GetProposal(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, proposerAddr, savedProposal.Proposer)
+	require.Equal(t, types.NewSoftwareUpgradeProposal(plan), savedProposal.GetContent())
+
+	// Cancelling the upgrade requires the same permission
+	res, err = handler(ctx, types.NewMsgProposalCancelSoftwareUpgrade(proposerAddr))
+	require.NoError(t, err)
+
+	expData, _ = proto.Marshal(&types.MsgProposalCancelSoftwareUpgradeResponse{ProposalID: 2})
+	require.Equal(t, expData, res.Data)
+
+	savedProposal, found = app.CustomGovKeeper.GetProposal(ctx, 2)
+	require.True(t, found)
+	require.Equal(t, types.NewCancelSoftwareUpgradeProposal(), savedProposal.GetContent())
+}
+
+func TestHandler_ProposalRemoveCouncilor(t *testing.T) {
+	proposerAddr, err := sdk.AccAddressFromBech32("kira1alzyfq40zjsveat87jlg8jxetwqmr0a29sgd0f")
+	require.NoError(t, err)
+	councilorAddr, err := sdk.AccAddressFromBech32("kira15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqzp4f3d")
+	require.NoError(t, err)
+
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{
+		Time: time.Now(),
+	})
+
+	handler := gov.NewHandler(app.CustomGovKeeper)
+	msg := types.NewMsgProposalRemoveCouncilor(proposerAddr, councilorAddr)
+
+	// Proposer without permission
+	_, err = handler(ctx, msg)
+	require.EqualError(t, err, errors.Wrap(types.ErrNotEnoughPermissions, types.PermCreateRemoveCouncilorProposal.String()).Error())
+
+	proposerActor := types.NewDefaultActor(proposerAddr)
+	err = app.CustomGovKeeper.AddWhitelistPermission(ctx, proposerActor, types.PermCreateRemoveCouncilorProposal)
+	require.NoError(t, err)
+
+	// Councilor does not exist
+	_, err = handler(ctx, msg)
+	require.EqualError(t, err, types.ErrCouncilorNotFound.Error())
+
+	app.CustomGovKeeper.SaveCouncilor(ctx, types.NewCouncilor("moniker", "website", "social", "identity", councilorAddr, types.CouncilorActive, ctx.BlockTime()))
+
+	res, err := handler(ctx, msg)
+	require.NoError(t, err)
+
+	expData, _ := proto.Marshal(&types.MsgProposalRemoveCouncilorResponse{ProposalID: 1})
+	require.Equal(t, expData, res.Data)
+
+	savedProposal, found := app.CustomGovKeeper.GetProposal(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, proposerAddr, savedProposal.Proposer)
+	require.Equal(t, types.NewRemoveCouncilorProposal(councilorAddr), savedProposal.GetContent())
+}
+
+func TestHandler_ProposalDeposit(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{
+		Time: time.Now(),
+	})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000))
+	proposerAddr := addrs[0]
+
+	proposerActor := types.NewDefaultActor(proposerAddr)
+	err := app.CustomGovKeeper.AddWhitelistPermission(ctx, proposerActor, types.PermCreateSetNetworkPropertyProposal)
+	require.NoError(t, err)
+
+	properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	properties.MinProposalDeposits = []types.ProposalTypeDeposit{
+		{ProposalType: types.SetNetworkPropertyProposalType, Amount: 2000},
+	}
+	app.CustomGovKeeper.SetNetworkProperties(ctx, properties)
+
+	handler := gov.NewHandler(app.CustomGovKeeper)
+	msg := types.NewMsgProposalSetNetworkProperty(proposerAddr, types.MinTxFee, 1234)
+
+	// Proposer without enough tokens for the deposit
+	_, err = handler(ctx, msg)
+	require.Error(t, err)
+
+	_, found := app.CustomGovKeeper.GetProposal(ctx, 1)
+	require.False(t, found)
+
+	properties.MinProposalDeposits[0].Amount = 300
+	app.CustomGovKeeper.SetNetworkProperties(ctx, properties)
+
+	_, err = handler(ctx, msg)
+	require.NoError(t, err)
+
+	deposit, found := app.CustomGovKeeper.GetDeposit(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, proposerAddr, deposit.Depositor)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ukex", 300)), deposit.Amount)
+	require.Equal(t, sdk.NewInt(700), app.BankKeeper.GetBalance(ctx, proposerAddr, "ukex").Amount)
+}
+
+func TestHandler_CancelProposal(t *testing.T) {
+	tests := []struct {
+		name          string
+		result        types.VoteResult
+		elapsed       time.Duration
+		prepareSender func(app *simapp.SimApp, ctx sdk.Context, proposer, other sdk.AccAddress) sdk.AccAddress
+		expectedErr   error
+	}{
+		{
+			name:   "proposer cancels proposal in voting",
+			result: types.Pending,
+			prepareSender: func(app *simapp.SimApp, ctx sdk.Context, proposer, other sdk.AccAddress) sdk.AccAddress {
+				return proposer
+			},
+		},
+		{
+			name:   "other actor can not cancel proposal in voting",
+			result: types.Pending,
+			prepareSender: func(app *simapp.SimApp, ctx sdk.Context, proposer, other sdk.AccAddress) sdk.AccAddress {
+				return other
+			},
+			expectedErr: errors.Wrap(types.ErrNotEnoughPermissions, "only the proposer can cancel a proposal in voting"),
+		},
+		{
+			name:    "proposer can not cancel proposal once its voting ended",
+			result:  types.Pending,
+			elapsed: 10 * time.Second,
+			prepareSender: func(app *simapp.SimApp, ctx sdk.Context, proposer, other sdk.AccAddress) sdk.AccAddress {
+				return proposer
+			},
+			expectedErr: types.ErrVotingTimeEnded,
+		},
+		{
+			name:   "councilor with permission cancels proposal in enactment",
+			result: types.Enactment,
+			prepareSender: func(app *simapp.SimApp, ctx sdk.Context, proposer, other sdk.AccAddress) sdk.AccAddress {
+				app.CustomGovKeeper.SaveCouncilor(ctx, types.NewCouncilor("moniker", "website", "social", "identity", other, types.CouncilorActive, ctx.BlockTime()))
+				err := app.CustomGovKeeper.AddWhitelistPermission(ctx, types.NewDefaultActor(other), types.PermCancelProposal)
+				require.NoError(t, err)
+				return other
+			},
+		},
+		{
+			name:   "councilor without permission can not cancel proposal in enactment",
+			result: types.Enactment,
+			prepareSender: func(app *simapp.SimApp, ctx sdk.Context, proposer, other sdk.AccAddress) sdk.AccAddress {
+				app.CustomGovKeeper.SaveCouncilor(ctx, types.NewCouncilor("moniker", "website", "social", "identity", other, types.CouncilorActive, ctx.BlockTime()))
+				return other
+			},
+			expectedErr: errors.Wrap(types.ErrNotEnoughPermissions, types.PermCancelProposal.String()),
+		},
+		{
+			name:   "suspended councilor can not cancel proposal in enactment",
+			result: types.Enactment,
+			prepareSender: func(app *simapp.SimApp, ctx sdk.Context, proposer, other sdk.AccAddress) sdk.AccAddress {
+				app.CustomGovKeeper.SaveCouncilor(ctx, types.NewCouncilor("moniker", "website", "social", "identity", other, types.CouncilorSuspended, ctx.BlockTime()))
+				err := app.CustomGovKeeper.AddWhitelistPermission(ctx, types.NewDefaultActor(other), types.PermCancelProposal)
+				require.NoError(t, err)
+				return other
+			},
+			expectedErr: errors.Wrap(types.ErrCouncilorNotActive, types.CouncilorSuspended.String()),
+		},
+		{
+			name:   "proposer can not cancel proposal in enactment",
+			result: types.Enactment,
+			prepareSender: func(app *simapp.SimApp, ctx sdk.Context, proposer, other sdk.AccAddress) sdk.AccAddress {
+				return proposer
+			},
+			expectedErr: types.ErrCouncilorNotFound,
+		},
+		{
+			name:   "passed proposal can not be cancelled",
+			result: types.Passed,
+			prepareSender: func(app *simapp.SimApp, ctx sdk.Context, proposer, other sdk.AccAddress) sdk.AccAddress {
+				return proposer
+			},
+			expectedErr: errors.Wrap(types.ErrProposalNotCancellable, types.Passed.String()),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			ctx := app.NewContext(false, tmproto.Header{
+				Time: time.Now(),
+			})
+
+			addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000))
+			proposer := addrs[0]
+
+			proposal, err := types.NewProposal(
+				1,
+				types.NewSetNetworkPropertyProposal(types.MinTxFee, 1234),
+				ctx.BlockTime(),
+				ctx.BlockTime().Add(10*time.Second),
+				ctx.BlockTime().Add(20*time.Second),
+			)
+			require.NoError(t, err)
+			proposal.Proposer = proposer
+			proposal.Result = tt.result
+
+			err = app.CustomGovKeeper.SetNetworkProperty(ctx, types.MinProposalDeposit, 100)
+			require.NoError(t, err)
+			err = app.CustomGovKeeper.CollectProposalDeposit(ctx, 1, proposer, proposal.GetContent())
+			require.NoError(t, err)
+
+			app.CustomGovKeeper.SaveProposal(ctx, proposal)
+			if tt.result == types.Pending {
+				app.CustomGovKeeper.AddToActiveProposals(ctx, proposal)
+			} else {
+				app.CustomGovKeeper.AddToEnactmentProposals(ctx, proposal)
+			}
+
+			sender := tt.prepareSender(app, ctx, proposer, addrs[1])
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(tt.elapsed))
+
+			handler := gov.NewHandler(app.CustomGovKeeper)
+			_, err = handler(ctx, types.NewMsgCancelProposal(1, sender))
+			if tt.expectedErr != nil {
+				require.EqualError(t, err, tt.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+
+			savedProposal, found := app.CustomGovKeeper.GetProposal(ctx, 1)
+			require.True(t, found)
+			require.Equal(t, types.Cancelled, savedProposal.Result)
+
+			iterator := app.CustomGovKeeper.GetActiveProposalsWithFinishedVotingEndTimeIterator(ctx, ctx.BlockTime().Add(30*time.Second))
+			requireIteratorCount(t, iterator, 0)
+			iterator = app.CustomGovKeeper.GetEnactmentProposalsWithFinishedEnactmentEndTimeIterator(ctx, ctx.BlockTime().Add(30*time.Second))
+			requireIteratorCount(t, iterator, 0)
+
+			// the deposit of a proposal cancelled in voting is refunded
+			if tt.result == types.Pending {
+				require.Equal(t, sdk.NewInt(1000), app.BankKeeper.GetBalance(ctx, proposer, "ukex").Amount)
+				require.True(t, app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(types.ModuleName), "ukex").IsZero())
+				_, found = app.CustomGovKeeper.GetDeposit(ctx, 1)
+				require.False(t, found)
+			}
+
+			// cancelled proposals can not be voted
+			_, err = handler(ctx, types.NewMsgVoteProposal(1, proposer, types.OptionYes))
+			require.Error(t, err)
+		})
+	}
+}
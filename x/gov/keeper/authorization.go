@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"github.com/KiraCore/sekai/x/gov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// SetAuthorization saves an authorization given by the granter to the grantee, replacing the previous one.
+func (k Keeper) SetAuthorization(ctx sdk.Context, auth types.Authorization) {
+	if previous, found := k.GetAuthorization(ctx, auth.Granter, auth.Grantee, auth.MsgTypeUrl); found {
+		k.DeleteAuthorization(ctx, previous)
+	}
+
+	key := authorizationKey(auth.Granter, auth.Grantee, auth.MsgTypeUrl)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(&auth))
+	k.setExpiryQueue(ctx, AuthorizationByHeightPrefix, AuthorizationByTimePrefix, auth.ExpiryHeight, auth.ExpiryTime, key)
+}
+
+// GetAuthorization returns the authorization given by the granter to the grantee for a message type.
+func (k Keeper) GetAuthorization(ctx sdk.Context, granter, grantee sdk.AccAddress, msgTypeURL string) (types.Authorization, bool) {
+	return k.getAuthorizationByKey(ctx, authorizationKey(granter, grantee, msgTypeURL))
+}
+
+func (k Keeper) getAuthorizationByKey(ctx sdk.Context, key []byte) (types.Authorization, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return types.Authorization{}, false
+	}
+
+	var auth types.Authorization
+	k.cdc.MustUnmarshalBinaryBare(bz, &auth)
+
+	return auth, true
+}
+
+// DeleteAuthorization removes an authorization given by the granter to the grantee.
+func (k Keeper) DeleteAuthorization(ctx sdk.Context, auth types.Authorization) {
+	key := authorizationKey(auth.Granter, auth.Grantee, auth.MsgTypeUrl)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(key)
+	k.deleteExpiryQueue(ctx, AuthorizationByHeightPrefix, AuthorizationByTimePrefix, auth.ExpiryHeight, auth.ExpiryTime, key)
+}
+
+// GetAuthorizationsByGranter returns the authorizations given by a granter.
+func (k Keeper) GetAuthorizationsByGranter(ctx sdk.Context, granter sdk.AccAddress) []types.Authorization {
+	return k.getAuthorizations(ctx, append(AuthorizationPrefix, granter.Bytes()...))
+}
+
+// GetAuthorizationsByGranterAndGrantee returns the authorizations given by a granter to a grantee.
+func (k Keeper) GetAuthorizationsByGranterAndGrantee(ctx sdk.Context, granter, grantee sdk.AccAddress) []types.Authorization {
+	return k.getAuthorizations(ctx, append(append(AuthorizationPrefix, granter.Bytes()...), grantee.Bytes()...))
+}
+
+// GetAuthorizations returns all the authorizations.
+func (k Keeper) GetAuthorizations(ctx sdk.Context) []types.Authorization {
+	return k.getAuthorizations(ctx, AuthorizationPrefix)
+}
+
+func (k Keeper) getAuthorizations(ctx sdk.Context, prefix []byte) []types.Authorization {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	var auths []types.Authorization
+	for ; iterator.Valid(); iterator.Next() {
+		var auth types.Authorization
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &auth)
+		auths = append(auths, auth)
+	}
+
+	return auths
+}
+
+// RemoveExpiredAuthorizations removes the authorizations which expired at the block height or time.
+func (k Keeper) RemoveExpiredAuthorizations(ctx sdk.Context) {
+	for _, key := range k.getExpiredKeys(ctx, AuthorizationByHeightPrefix, AuthorizationByTimePrefix) {
+		if auth, found := k.getAuthorizationByKey(ctx, key); found {
+			k.DeleteAuthorization(ctx, auth)
+		}
+	}
+}
+
+// UseAuthorization consumes the authorization allowing the grantee to execute the message on behalf of the granter.
+// The authorization is removed once its uses or its spend limit are exhausted.
+func (k Keeper) UseAuthorization(ctx sdk.Context, granter, grantee sdk.AccAddress, msg sdk.Msg) error {
+	msgTypeURL := types.MsgTypeURL(msg)
+
+	auth, found := k.GetAuthorization(ctx, granter, grantee, msgTypeURL)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrAuthorizationNotFound, "%s from %s to %s", msgTypeURL, granter, grantee)
+	}
+
+	if auth.IsExpired(ctx.BlockHeight(), ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrAuthorizationExpired, "%s from %s to %s", msgTypeURL, granter, grantee)
+	}
+
+	exhausted := false
+	if !auth.SpendLimit.Empty() {
+		send, ok := msg.(*banktypes.MsgSend)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidExecMsg, "spend limit does not apply to %s", msgTypeURL)
+		}
+
+		left, isNegative := auth.SpendLimit.SafeSub(send.Amount)
+		if isNegative {
+			return sdkerrors.Wrapf(types.ErrAuthorizationSpendLimit, "%s left, %s sent", auth.SpendLimit, send.Amount)
+		}
+
+		auth.SpendLimit = left
+		exhausted = left.IsZero()
+	}
+
+	auth.Uses++
+	if auth.MaxUses != 0 && auth.Uses >= auth.MaxUses {
+		exhausted = true
+	}
+
+	if exhausted {
+		k.DeleteAuthorization(ctx, auth)
+	} else {
+		k.SetAuthorization(ctx, auth)
+	}
+
+	return nil
+}
+
+// ExecMsgs executes the messages on behalf of their signer, checking the authorizations given to the grantee
+// by the signers other than itself. It returns the data returned by each message.
+func (k Keeper) ExecMsgs(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
+	results := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidExecMsg, "%s must have a single signer", msg.Type())
+		}
+
+		granter := signers[0]
+		if !granter.Equals(grantee) {
+			if err := k.UseAuthorization(ctx, granter, grantee, msg); err != nil {
+				return nil, err
+			}
+		}
+
+		res, err := k.dispatchMsg(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = res.Data
+	}
+
+	return results, nil
+}
+
+// dispatchMsg executes a message with the handler of its route, the events of the message are
+// emitted on the event manager of the context.
+func (k Keeper) dispatchMsg(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+	handler := k.router.Route(ctx, msg.Route())
+	if handler == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", msg.Route())
+	}
+
+	return handler(ctx, msg)
+}
+
+// authorizationKey returns the key in format <0x36 + granter_bytes + grantee_bytes + msgTypeUrl_Bytes>
+func authorizationKey(granter, grantee sdk.AccAddress, msgTypeURL string) []byte {
+	key := append(append(AuthorizationPrefix, granter.Bytes()...), grantee.Bytes()...)
+	return append(key, []byte(msgTypeURL)...)
+}
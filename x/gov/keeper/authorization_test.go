@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/gov/types"
+)
+
+func TestKeeper_GetAuthorizations(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.TokensFromConsensusPower(10))
+	voteURL := types.MsgTypeURL(&types.MsgVoteProposal{})
+	claimURL := types.MsgTypeURL(&types.MsgClaimCouncilor{})
+
+	app.CustomGovKeeper.SetAuthorization(ctx, types.NewAuthorization(addrs[0], addrs[1], voteURL, nil, 0, 0, nil))
+	app.CustomGovKeeper.SetAuthorization(ctx, types.NewAuthorization(addrs[0], addrs[1], claimURL, nil, 0, 0, nil))
+	app.CustomGovKeeper.SetAuthorization(ctx, types.NewAuthorization(addrs[0], addrs[2], voteURL, nil, 0, 0, nil))
+	app.CustomGovKeeper.SetAuthorization(ctx, types.NewAuthorization(addrs[1], addrs[2], voteURL, nil, 0, 0, nil))
+
+	require.Len(t, app.CustomGovKeeper.GetAuthorizations(ctx), 4)
+	require.Len(t, app.CustomGovKeeper.GetAuthorizationsByGranter(ctx, addrs[0]), 3)
+	require.Len(t, app.CustomGovKeeper.GetAuthorizationsByGranterAndGrantee(ctx, addrs[0], addrs[1]), 2)
+
+	auth, found := app.CustomGovKeeper.GetAuthorization(ctx, addrs[1], addrs[2], voteURL)
+	require.True(t, found)
+	require.Equal(t, types.NewAuthorization(addrs[1], addrs[2], voteURL, nil, 0, 0, nil), auth)
+
+	app.CustomGovKeeper.DeleteAuthorization(ctx, auth)
+	_, found = app.CustomGovKeeper.GetAuthorization(ctx, addrs[1], addrs[2], voteURL)
+	require.False(t, found)
+}
+
+func TestKeeper_UseAuthorization(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Unix(1600000000, 0).UTC()
+	ctx := app.NewContext(false, tmproto.Header{Height: 10, Time: now})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.TokensFromConsensusPower(10))
+	msg := types.NewMsgVoteProposal(1, addrs[0], types.OptionYes)
+
+	err := app.CustomGovKeeper.UseAuthorization(ctx, addrs[0], addrs[1], msg)
+	require.True(t, types.ErrAuthorizationNotFound.Is(err))
+
+	app.CustomGovKeeper.SetAuthorization(ctx, types.NewAuthorization(addrs[0], addrs[1], types.MsgTypeURL(msg), nil, 2, 0, nil))
+
+	require.NoError(t, app.CustomGovKeeper.UseAuthorization(ctx, addrs[0], addrs[1], msg))
+	auth, found := app.CustomGovKeeper.GetAuthorization(ctx, addrs[0], addrs[1], types.MsgTypeURL(msg))
+	require.True(t, found)
+	require.Equal(t, uint64(1), auth.Uses)
+
+	// the authorization is removed once used up
+	require.NoError(t, app.CustomGovKeeper.UseAuthorization(ctx, addrs[0], addrs[1], msg))
+	_, found = app.CustomGovKeeper.GetAuthorization(ctx, addrs[0], addrs[1], types.MsgTypeURL(msg))
+	require.False(t, found)
+
+	app.CustomGovKeeper.SetAuthorization(ctx, types.NewAuthorization(addrs[0], addrs[1], types.MsgTypeURL(msg), nil, 0, 0, &now))
+	err = app.CustomGovKeeper.UseAuthorization(ctx, addrs[0], addrs[1], msg)
+	require.True(t, types.ErrAuthorizationExpired.Is(err))
+}
+
+func TestKeeper_RemoveExpiredAuthorizations(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Unix(1600000000, 0).UTC()
+	ctx := app.NewContext(false, tmproto.Header{Height: 10, Time: now})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.TokensFromConsensusPower(10))
+	voteURL := types.MsgTypeURL(&types.MsgVoteProposal{})
+	expiryTime := now.Add(time.Hour)
+
+	// replacing an authorization removes the previous expiry from the queue
+	app.CustomGovKeeper.SetAuthorization(ctx, types.NewAuthorization(addrs[0], addrs[1], voteURL, nil, 0, 11, nil))
+	app.CustomGovKeeper.SetAuthorization(ctx, types.NewAuthorization(addrs[0], addrs[1], voteURL, nil, 0, 20, nil))
+	app.CustomGovKeeper.SetAuthorization(ctx, types.NewAuthorization(addrs[0], addrs[2], voteURL, nil, 0, 0, &expiryTime))
+	app.CustomGovKeeper.SetAuthorization(ctx, types.NewAuthorization(addrs[1], addrs[2], voteURL, nil, 0, 0, nil))
+
+	ctx = ctx.WithBlockHeight(11)
+	app.CustomGovKeeper.RemoveExpiredAuthorizations(ctx)
+	require.Len(t, app.CustomGovKeeper.GetAuthorizations(ctx), 3)
+
+	ctx = ctx.WithBlockHeight(25)
+	app.CustomGovKeeper.RemoveExpiredAuthorizations(ctx)
+	_, found := app.CustomGovKeeper.GetAuthorization(ctx, addrs[0], addrs[1], voteURL)
+	require.False(t, found)
+	require.Len(t, app.CustomGovKeeper.GetAuthorizations(ctx), 2)
+
+	ctx = ctx.WithBlockTime(expiryTime)
+	app.CustomGovKeeper.RemoveExpiredAuthorizations(ctx)
+	require.Equal(t, []types.Authorization{types.NewAuthorization(addrs[1], addrs[2], voteURL, nil, 0, 0, nil)}, app.CustomGovKeeper.GetAuthorizations(ctx))
+}
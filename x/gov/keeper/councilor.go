@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -10,6 +12,7 @@ import (
 var (
 	CouncilorsKey          = []byte{0x21} // Councilors key prefix.
 	CouncilorsByMonikerKey = []byte{0x22} // Councilors by moniker prefix.
+	CouncilorsByTermEndKey = []byte{0x23} // Councilors by term end prefix.
 )
 
 func GetCouncilorKey(address sdk.AccAddress) []byte {
@@ -20,6 +23,19 @@ func GetCouncilorByMonikerKey(moniker string) []byte {
 	return append(CouncilorsByMonikerKey, []byte(moniker)...)
 }
 
+func GetCouncilorByTermEndTimeKey(termEnd time.Time) []byte {
+	return append(CouncilorsByTermEndKey, sdk.FormatTimeBytes(termEnd)...)
+}
+
+func GetCouncilorByTermEndKey(termEnd time.Time, address sdk.AccAddress) []byte {
+	return append(GetCouncilorByTermEndTimeKey(termEnd), address.Bytes()...)
+}
+
+// hasTermEnd returns true if the councilor term ends and is not over yet.
+func hasTermEnd(councilor types.Councilor) bool {
+	return !councilor.TermEnd.IsZero() && councilor.Status != types.CouncilorInactive
+}
+
 func (k Keeper) SaveCouncilor(ctx sdk.Context, councilor types.Councilor) {
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), CouncilorIdentityRegistryPrefix)
 
@@ -27,8 +43,35 @@ func (k Keeper) SaveCouncilor(ctx sdk.Context, councilor types.Councilor) {
 
 	councilorKey := GetCouncilorKey(councilor.Address)
 
+	if previous, found := k.getCouncilorByKey(ctx, councilorKey); found {
+		// a councilor claiming its seat again can change its moniker
+		if previous.Moniker != councilor.Moniker {
+			prefixStore.Delete(GetCouncilorByMonikerKey(previous.Moniker))
+		}
+
+		if hasTermEnd(previous) {
+			prefixStore.Delete(GetCouncilorByTermEndKey(previous.TermEnd, previous.Address))
+		}
+	}
+
 	prefixStore.Set(councilorKey, bz)
 	prefixStore.Set(GetCouncilorByMonikerKey(councilor.Moniker), councilorKey)
+
+	if hasTermEnd(councilor) {
+		prefixStore.Set(GetCouncilorByTermEndKey(councilor.TermEnd, councilor.Address), councilorKey)
+	}
+}
+
+// DeleteCouncilor removes the councilor from the council registry.
+func (k Keeper) DeleteCouncilor(ctx sdk.Context, councilor types.Councilor) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), CouncilorIdentityRegistryPrefix)
+
+	prefixStore.Delete(GetCouncilorKey(councilor.Address))
+	prefixStore.Delete(GetCouncilorByMonikerKey(councilor.Moniker))
+
+	if hasTermEnd(councilor) {
+		prefixStore.Delete(GetCouncilorByTermEndKey(councilor.TermEnd, councilor.Address))
+	}
 }
 
 func (k Keeper) GetCouncilor(ctx sdk.Context, address sdk.AccAddress) (types.Councilor, bool) {
@@ -59,3 +102,86 @@ func (k Keeper) getCouncilorByKey(ctx sdk.Context, key []byte) (types.Councilor,
 
 	return councilor, true
 }
+
+// GetCouncilors returns all the councilors.
+func (k Keeper) GetCouncilors(ctx sdk.Context) []types.Councilor {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), CouncilorIdentityRegistryPrefix)
+
+	iterator := sdk.KVStorePrefixIterator(prefixStore, CouncilorsKey)
+	defer iterator.Close()
+
+	councilors := []types.Councilor{}
+	for ; iterator.Valid(); iterator.Next() {
+		var councilor types.Councilor
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &councilor)
+		councilors = append(councilors, councilor)
+	}
+
+	return councilors
+}
+
+// GetCouncilorsByStatus returns the councilors with the given status.
+func (k Keeper) GetCouncilorsByStatus(ctx sdk.Context, status types.CouncilorStatus) []types.Councilor {
+	councilors := []types.Councilor{}
+	for _, councilor := range k.GetCouncilors(ctx) {
+		if councilor.Status == status {
+			councilors = append(councilors, councilor)
+		}
+	}
+
+	return councilors
+}
+
+// IsActiveCouncilor returns true if the address is a councilor holding the councilor rights.
+func (k Keeper) IsActiveCouncilor(ctx sdk.Context, address sdk.AccAddress) bool {
+	councilor, found := k.GetCouncilor(ctx, address)
+	return found && councilor.IsActive()
+}
+
+// UpdateCouncilorsActivity records, once the voting of a proposal ends, which of the active councilors eligible to vote
+// on it voted. Voting resets the missed proposals of a councilor, a councilor missing MAX_COUNCILOR_MISSED_PROPOSALS
+// consecutive proposals is suspended until it claims its seat again or its term ends.
+func (k Keeper) UpdateCouncilorsActivity(ctx sdk.Context, proposalID uint64, availableVoters []types.NetworkActor) {
+	maxMissed := k.GetNetworkProperties(ctx).MaxCouncilorMissedProposals
+
+	for _, voter := range availableVoters {
+		councilor, found := k.GetCouncilor(ctx, voter.Address)
+		if !found || !councilor.IsActive() {
+			continue
+		}
+
+		if _, voted := k.GetVote(ctx, proposalID, voter.Address); voted {
+			councilor.MissedProposals = 0
+		} else {
+			councilor.MissedProposals++
+			if maxMissed > 0 && councilor.MissedProposals >= maxMissed {
+				councilor.Status = types.CouncilorSuspended
+			}
+		}
+
+		k.SaveCouncilor(ctx, councilor)
+	}
+}
+
+// EndCouncilorTerms sets the councilors whose term ended as inactive, they hold the councilor rights again once
+// they claim their seat for a new term.
+// Called in each EndBlock
+func (k Keeper) EndCouncilorTerms(ctx sdk.Context) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), CouncilorIdentityRegistryPrefix)
+
+	iterator := prefixStore.Iterator(CouncilorsByTermEndKey, sdk.PrefixEndBytes(GetCouncilorByTermEndTimeKey(ctx.BlockTime())))
+	defer iterator.Close()
+
+	var councilors []types.Councilor
+	for ; iterator.Valid(); iterator.Next() {
+		councilor, found := k.getCouncilorByKey(ctx, iterator.Value())
+		if found {
+			councilors = append(councilors, councilor)
+		}
+	}
+
+	for _, councilor := range councilors {
+		councilor.Status = types.CouncilorInactive
+		k.SaveCouncilor(ctx, councilor)
+	}
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/KiraCore/sekai/simapp"
 	"github.com/KiraCore/sekai/x/gov/types"
@@ -23,6 +24,8 @@ func TestKeeper_SaveCouncilor(t *testing.T) {
 		"social",
 		"identity",
 		addr,
+		types.CouncilorActive,
+		ctx.BlockTime(),
 	)
 
 	app.CustomGovKeeper.SaveCouncilor(ctx, councilor)
@@ -36,3 +39,118 @@ func TestKeeper_SaveCouncilor(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, councilor, councilorByMoniker)
 }
+
+func TestKeeper_SaveCouncilor_ChangedMoniker(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(10))[0]
+
+	councilor := types.NewCouncilor("moniker", "website", "social", "identity", addr, types.CouncilorActive, ctx.BlockTime())
+	app.CustomGovKeeper.SaveCouncilor(ctx, councilor)
+
+	councilor.Moniker = "new moniker"
+	app.CustomGovKeeper.SaveCouncilor(ctx, councilor)
+
+	_, found := app.CustomGovKeeper.GetCouncilorByMoniker(ctx, "moniker")
+	require.False(t, found)
+
+	councilorByMoniker, found := app.CustomGovKeeper.GetCouncilorByMoniker(ctx, "new moniker")
+	require.True(t, found)
+	require.Equal(t, councilor, councilorByMoniker)
+}
+
+func TestKeeper_DeleteCouncilor(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.TokensFromConsensusPower(10))
+
+	councilor := types.NewCouncilor("moniker", "website", "social", "identity", addrs[0], types.CouncilorActive, ctx.BlockTime())
+	other := types.NewCouncilor("other", "website", "social", "identity", addrs[1], types.CouncilorSuspended, ctx.BlockTime())
+	app.CustomGovKeeper.SaveCouncilor(ctx, councilor)
+	app.CustomGovKeeper.SaveCouncilor(ctx, other)
+
+	require.Equal(t, []types.Councilor{councilor}, app.CustomGovKeeper.GetCouncilorsByStatus(ctx, types.CouncilorActive))
+	require.Equal(t, []types.Councilor{other}, app.CustomGovKeeper.GetCouncilorsByStatus(ctx, types.CouncilorSuspended))
+	require.True(t, app.CustomGovKeeper.IsActiveCouncilor(ctx, addrs[0]))
+	require.False(t, app.CustomGovKeeper.IsActiveCouncilor(ctx, addrs[1]))
+
+	app.CustomGovKeeper.DeleteCouncilor(ctx, councilor)
+
+	_, found := app.CustomGovKeeper.GetCouncilor(ctx, councilor.Address)
+	require.False(t, found)
+	_, found = app.CustomGovKeeper.GetCouncilorByMoniker(ctx, councilor.Moniker)
+	require.False(t, found)
+	require.Equal(t, []types.Councilor{other}, app.CustomGovKeeper.GetCouncilors(ctx))
+}
+
+func TestKeeper_UpdateCouncilorsActivity(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.TokensFromConsensusPower(10))
+	voter, absent, notCouncilor := addrs[0], addrs[1], addrs[2]
+
+	err := app.CustomGovKeeper.SetNetworkProperty(ctx, types.MaxCouncilorMissedProposals, 2)
+	require.NoError(t, err)
+
+	app.CustomGovKeeper.SaveCouncilor(ctx, types.NewCouncilor("voter", "website", "social", "identity", voter, types.CouncilorActive, ctx.BlockTime()))
+	app.CustomGovKeeper.SaveCouncilor(ctx, types.NewCouncilor("absent", "website", "social", "identity", absent, types.CouncilorActive, ctx.BlockTime()))
+
+	availableVoters := []types.NetworkActor{
+		types.NewDefaultActor(voter),
+		types.NewDefaultActor(absent),
+		types.NewDefaultActor(notCouncilor),
+	}
+
+	for proposalID := uint64(1); proposalID <= 2; proposalID++ {
+		app.CustomGovKeeper.SaveVote(ctx, types.NewVote(proposalID, voter, types.OptionYes))
+		app.CustomGovKeeper.UpdateCouncilorsActivity(ctx, proposalID, availableVoters)
+
+		councilor, found := app.CustomGovKeeper.GetCouncilor(ctx, voter)
+		require.True(t, found)
+		require.Equal(t, uint64(0), councilor.MissedProposals)
+		require.True(t, councilor.IsActive())
+	}
+
+	councilor, found := app.CustomGovKeeper.GetCouncilor(ctx, absent)
+	require.True(t, found)
+	require.Equal(t, uint64(2), councilor.MissedProposals)
+	require.Equal(t, types.CouncilorSuspended, councilor.Status)
+
+	_, found = app.CustomGovKeeper.GetCouncilor(ctx, notCouncilor)
+	require.False(t, found)
+}
+
+func TestKeeper_EndCouncilorTerms(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Time: time.Unix(1600000000, 0).UTC()})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.TokensFromConsensusPower(10))
+
+	ending := types.NewCouncilor("ending", "website", "social", "identity", addrs[0], types.CouncilorSuspended, ctx.BlockTime())
+	ending.TermEnd = ctx.BlockTime().Add(time.Hour)
+	later := types.NewCouncilor("later", "website", "social", "identity", addrs[1], types.CouncilorActive, ctx.BlockTime())
+	later.TermEnd = ctx.BlockTime().Add(2 * time.Hour)
+	removed := types.NewCouncilor("removed", "website", "social", "identity", addrs[2], types.CouncilorActive, ctx.BlockTime())
+	removed.TermEnd = ctx.BlockTime().Add(time.Hour)
+
+	app.CustomGovKeeper.SaveCouncilor(ctx, ending)
+	app.CustomGovKeeper.SaveCouncilor(ctx, later)
+	app.CustomGovKeeper.SaveCouncilor(ctx, removed)
+	app.CustomGovKeeper.DeleteCouncilor(ctx, removed)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	app.CustomGovKeeper.EndCouncilorTerms(ctx)
+
+	councilor, found := app.CustomGovKeeper.GetCouncilor(ctx, addrs[0])
+	require.True(t, found)
+	require.Equal(t, types.CouncilorInactive, councilor.Status)
+	require.False(t, app.CustomGovKeeper.IsActiveCouncilor(ctx, addrs[0]))
+
+	require.True(t, app.CustomGovKeeper.IsActiveCouncilor(ctx, addrs[1]))
+
+	_, found = app.CustomGovKeeper.GetCouncilor(ctx, addrs[2])
+	require.False(t, found)
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"github.com/KiraCore/sekai/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetMinProposalDeposit returns the deposit required to submit a proposal of the given type,
+// the MIN_PROPOSAL_DEPOSIT network property applies to the types without a deposit of their own.
+func (k Keeper) GetMinProposalDeposit(ctx sdk.Context, proposalType string) sdk.Coins {
+	properties := k.GetNetworkProperties(ctx)
+
+	amount := properties.MinProposalDeposit
+	for _, deposit := range properties.MinProposalDeposits {
+		if deposit.ProposalType == proposalType {
+			amount = deposit.Amount
+			break
+		}
+	}
+
+	return sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), sdk.NewIntFromUint64(amount)))
+}
+
+// GetProposalDeposit returns the deposit required to submit the proposal content, a multi content
+// proposal requires the highest deposit among its own type and the contents it batches.
+func (k Keeper) GetProposalDeposit(ctx sdk.Context, content types.Content) sdk.Coins {
+	amount := k.GetMinProposalDeposit(ctx, content.ProposalType())
+
+	multiContent, ok := content.(*types.MultiContentProposal)
+	if !ok {
+		return amount
+	}
+
+	for _, c := range multiContent.UnpackedContents() {
+		deposit := k.GetMinProposalDeposit(ctx, c.ProposalType())
+		if deposit.IsAllGT(amount) {
+			amount = deposit
+		}
+	}
+
+	return amount
+}
+
+// CollectProposalDeposit escrows the deposit required by the proposal content from the proposer into the gov module account.
+func (k Keeper) CollectProposalDeposit(ctx sdk.Context, proposalID uint64, proposer sdk.AccAddress, content types.Content) error {
+	amount := k.GetProposalDeposit(ctx, content)
+	if amount.IsZero() {
+		return nil
+	}
+
+	err := k.bk.SendCoinsFromAccountToModule(ctx, proposer, types.ModuleName, amount)
+	if err != nil {
+		return err
+	}
+
+	k.SaveDeposit(ctx, types.Deposit{
+		ProposalId: proposalID,
+		Depositor:  proposer,
+		Amount:     amount,
+	})
+
+	return nil
+}
+
+// SaveDeposit stores the deposit of a proposal.
+func (k Keeper) SaveDeposit(ctx sdk.Context, deposit types.Deposit) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), DepositsPrefix)
+	prefixStore.Set(ProposalIDToBytes(deposit.ProposalId), k.cdc.MustMarshalBinaryBare(&deposit))
+}
+
+// GetDeposit returns the deposit of a proposal, proposals submitted without a deposit have none.
+func (k Keeper) GetDeposit(ctx sdk.Context, proposalID uint64) (types.Deposit, bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), DepositsPrefix)
+	bz := prefixStore.Get(ProposalIDToBytes(proposalID))
+	if bz == nil {
+		return types.Deposit{}, false
+	}
+
+	var deposit types.Deposit
+	k.cdc.MustUnmarshalBinaryBare(bz, &deposit)
+
+	return deposit, true
+}
+
+// GetDeposits returns the deposits of all the proposals.
+func (k Keeper) GetDeposits(ctx sdk.Context) []types.Deposit {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), DepositsPrefix)
+
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	deposits := []types.Deposit{}
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.Deposit
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &deposit)
+		deposits = append(deposits, deposit)
+	}
+
+	return deposits
+}
+
+// RemoveDeposit deletes the deposit of a proposal once it is settled.
+func (k Keeper) RemoveDeposit(ctx sdk.Context, proposalID uint64) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), DepositsPrefix)
+	prefixStore.Delete(ProposalIDToBytes(proposalID))
+}
+
+// RefundDeposit returns the deposit of a proposal to the depositor and deletes it.
+func (k Keeper) RefundDeposit(ctx sdk.Context, proposalID uint64) error {
+	deposit, found := k.GetDeposit(ctx, proposalID)
+	if !found {
+		return nil
+	}
+
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, deposit.Depositor, deposit.Amount); err != nil {
+		return err
+	}
+
+	k.RemoveDeposit(ctx, proposalID)
+	return nil
+}
+
+// BurnDeposit burns the deposit of a proposal and deletes it.
+func (k Keeper) BurnDeposit(ctx sdk.Context, proposalID uint64) error {
+	deposit, found := k.GetDeposit(ctx, proposalID)
+	if !found {
+		return nil
+	}
+
+	if err := k.bk.BurnCoins(ctx, types.ModuleName, deposit.Amount); err != nil {
+		return err
+	}
+
+	k.RemoveDeposit(ctx, proposalID)
+	return nil
+}
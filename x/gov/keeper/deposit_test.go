@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/gov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestKeeper_GetMinProposalDeposit(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	// no deposit by default
+	deposit := app.CustomGovKeeper.GetMinProposalDeposit(ctx, types.AssignPermissionProposalType)
+	require.True(t, deposit.IsZero())
+
+	properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	properties.MinProposalDeposit = 100
+	properties.MinProposalDeposits = []types.ProposalTypeDeposit{
+		{ProposalType: types.SetNetworkPropertyProposalType, Amount: 500},
+	}
+	app.CustomGovKeeper.SetNetworkProperties(ctx, properties)
+
+	deposit = app.CustomGovKeeper.GetMinProposalDeposit(ctx, types.AssignPermissionProposalType)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ukex", 100)), deposit)
+
+	deposit = app.CustomGovKeeper.GetMinProposalDeposit(ctx, types.SetNetworkPropertyProposalType)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ukex", 500)), deposit)
+}
+
+func TestKeeper_GetProposalDeposit(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(10))
+
+	properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	properties.MinProposalDeposit = 100
+	properties.MinProposalDeposits = []types.ProposalTypeDeposit{
+		{ProposalType: types.SetNetworkPropertyProposalType, Amount: 500},
+	}
+	app.CustomGovKeeper.SetNetworkProperties(ctx, properties)
+
+	// a multi content proposal requires the highest deposit of its contents
+	content, err := types.NewMultiContentProposal([]types.Content{
+		types.NewAssignPermissionProposal(addrs[0], types.PermSetPermissions),
+		types.NewSetNetworkPropertyProposal(types.MinTxFee, 100),
+	})
+	require.NoError(t, err)
+
+	deposit := app.CustomGovKeeper.GetProposalDeposit(ctx, content)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ukex", 500)), deposit)
+
+	deposit = app.CustomGovKeeper.GetProposalDeposit(ctx, types.NewAssignPermissionProposal(addrs[0], types.PermSetPermissions))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ukex", 100)), deposit)
+}
+
+func TestKeeper_ProposalDeposit(t *testing.T) {
+	tests := []struct {
+		name            string
+		settle          func(app *simapp.SimApp, ctx sdk.Context, proposalID uint64) error
+		expectedBalance sdk.Int
+		expectedSupply  sdk.Int
+	}{
+		{
+			name: "refunded deposit goes back to the proposer",
+			settle: func(app *simapp.SimApp, ctx sdk.Context, proposalID uint64) error {
+				return app.CustomGovKeeper.RefundDeposit(ctx, proposalID)
+			},
+			expectedBalance: sdk.NewInt(1000),
+			expectedSupply:  sdk.NewInt(1000),
+		},
+		{
+			name: "burned deposit is removed from the supply",
+			settle: func(app *simapp.SimApp, ctx sdk.Context, proposalID uint64) error {
+				return app.CustomGovKeeper.BurnDeposit(ctx, proposalID)
+			},
+			expectedBalance: sdk.NewInt(900),
+			expectedSupply:  sdk.NewInt(900),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			ctx := app.NewContext(false, tmproto.Header{})
+
+			addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000))
+			supply := app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf("ukex")
+
+			properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+			properties.MinProposalDeposit = 100
+			app.CustomGovKeeper.SetNetworkProperties(ctx, properties)
+
+			content := types.NewAssignPermissionProposal(addrs[0], types.PermSetPermissions)
+			err := app.CustomGovKeeper.CollectProposalDeposit(ctx, 1, addrs[0], content)
+			require.NoError(t, err)
+
+			deposit, found := app.CustomGovKeeper.GetDeposit(ctx, 1)
+			require.True(t, found)
+			require.Equal(t, types.Deposit{
+				ProposalId: 1,
+				Depositor:  addrs[0],
+				Amount:     sdk.NewCoins(sdk.NewInt64Coin("ukex", 100)),
+			}, deposit)
+			require.Equal(t, sdk.NewInt(900), app.BankKeeper.GetBalance(ctx, addrs[0], "ukex").Amount)
+
+			err = tt.settle(app, ctx, 1)
+			require.NoError(t, err)
+
+			// the settled deposit is deleted, settling it again does nothing
+			_, found = app.CustomGovKeeper.GetDeposit(ctx, 1)
+			require.False(t, found)
+
+			err = tt.settle(app, ctx, 1)
+			require.NoError(t, err)
+
+			require.Equal(t, tt.expectedBalance, app.BankKeeper.GetBalance(ctx, addrs[0], "ukex").Amount)
+			require.Equal(t, supply.Sub(sdk.NewInt(1000)).Add(tt.expectedSupply), app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf("ukex"))
+		})
+	}
+
+	// deposit larger than the proposer balance
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(10))
+
+	properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	properties.MinProposalDeposit = 100
+	app.CustomGovKeeper.SetNetworkProperties(ctx, properties)
+
+	err := app.CustomGovKeeper.CollectProposalDeposit(ctx, 1, addrs[0], types.NewAssignPermissionProposal(addrs[0], types.PermSetPermissions))
+	require.Error(t, err)
+
+	_, found := app.CustomGovKeeper.GetDeposit(ctx, 1)
+	require.False(t, found)
+}
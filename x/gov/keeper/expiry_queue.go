@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The expiry queues index the records expiring at a height or a time by <prefix + height or time + record key>,
+// so that the expired records are found without iterating over all of them.
+
+func expiryByHeightKey(prefix []byte, expiryHeight uint64, recordKey []byte) []byte {
+	return append(append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(expiryHeight)...), recordKey...)
+}
+
+func expiryByTimeKey(prefix []byte, expiryTime time.Time, recordKey []byte) []byte {
+	return append(append(append([]byte{}, prefix...), sdk.FormatTimeBytes(expiryTime)...), recordKey...)
+}
+
+// setExpiryQueue queues the record at its expiry height and time, a zero height and an unset time never expire.
+func (k Keeper) setExpiryQueue(ctx sdk.Context, heightPrefix, timePrefix []byte, expiryHeight uint64, expiryTime *time.Time, recordKey []byte) {
+	store := ctx.KVStore(k.storeKey)
+	if expiryHeight != 0 {
+		store.Set(expiryByHeightKey(heightPrefix, expiryHeight, recordKey), recordKey)
+	}
+	if expiryTime != nil {
+		store.Set(expiryByTimeKey(timePrefix, *expiryTime, recordKey), recordKey)
+	}
+}
+
+// deleteExpiryQueue removes the record from the expiry queues.
+func (k Keeper) deleteExpiryQueue(ctx sdk.Context, heightPrefix, timePrefix []byte, expiryHeight uint64, expiryTime *time.Time, recordKey []byte) {
+	store := ctx.KVStore(k.storeKey)
+	if expiryHeight != 0 {
+		store.Delete(expiryByHeightKey(heightPrefix, expiryHeight, recordKey))
+	}
+	if expiryTime != nil {
+		store.Delete(expiryByTimeKey(timePrefix, *expiryTime, recordKey))
+	}
+}
+
+// getExpiredKeys returns the keys of the records queued up to the block height or time.
+func (k Keeper) getExpiredKeys(ctx sdk.Context, heightPrefix, timePrefix []byte) [][]byte {
+	store := ctx.KVStore(k.storeKey)
+
+	var keys [][]byte
+	seen := map[string]bool{}
+	collect := func(iterator sdk.Iterator) {
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			if key := iterator.Value(); !seen[string(key)] {
+				seen[string(key)] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	heightEnd := append(append([]byte{}, heightPrefix...), sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()))...)
+	collect(store.Iterator(heightPrefix, sdk.PrefixEndBytes(heightEnd)))
+
+	timeEnd := append(append([]byte{}, timePrefix...), sdk.FormatTimeBytes(ctx.BlockTime())...)
+	collect(store.Iterator(timePrefix, sdk.PrefixEndBytes(timeEnd)))
+
+	return keys
+}
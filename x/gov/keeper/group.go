@@ -0,0 +1,241 @@
+package keeper
+
+import (
+	"github.com/KiraCore/sekai/x/gov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GetNextGroupID returns the id of the next group, ids start at 1.
+func (k Keeper) GetNextGroupID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(NextGroupIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// CreateGroup saves a new group with the next group id.
+func (k Keeper) CreateGroup(ctx sdk.Context, members []types.GroupMember, threshold uint64, description string) types.Group {
+	group := types.NewGroup(k.GetNextGroupID(ctx), members, threshold, description)
+	k.SetGroup(ctx, group)
+
+	return group
+}
+
+// SetGroup saves a group and its address index.
+func (k Keeper) SetGroup(ctx sdk.Context, group types.Group) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(groupKey(group.Id), k.cdc.MustMarshalBinaryBare(&group))
+	store.Set(groupAddressKey(group.Address), sdk.Uint64ToBigEndian(group.Id))
+
+	// saving back an older group must not rewind the next group id
+	if k.GetNextGroupID(ctx) <= group.Id {
+		store.Set(NextGroupIDKey, sdk.Uint64ToBigEndian(group.Id+1))
+	}
+}
+
+// GetGroup returns the group with the given id.
+func (k Keeper) GetGroup(ctx sdk.Context, groupID uint64) (types.Group, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(groupKey(groupID))
+	if bz == nil {
+		return types.Group{}, false
+	}
+
+	var group types.Group
+	k.cdc.MustUnmarshalBinaryBare(bz, &group)
+
+	return group, true
+}
+
+// GetGroupByAddress returns the group with the given address.
+func (k Keeper) GetGroupByAddress(ctx sdk.Context, address sdk.AccAddress) (types.Group, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(groupAddressKey(address))
+	if bz == nil {
+		return types.Group{}, false
+	}
+
+	return k.GetGroup(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// GetGroups returns all the groups ordered by id.
+func (k Keeper) GetGroups(ctx sdk.Context) []types.Group {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), GroupPrefix)
+	defer iterator.Close()
+
+	groups := []types.Group{}
+	for ; iterator.Valid(); iterator.Next() {
+		var group types.Group
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &group)
+		groups = append(groups, group)
+	}
+
+	return groups
+}
+
+// GetNextGroupProposalID returns the id of the next group proposal, ids start at 1.
+func (k Keeper) GetNextGroupProposalID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(NextGroupProposalIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetGroupProposal saves a group proposal.
+func (k Keeper) SetGroupProposal(ctx sdk.Context, proposal types.GroupProposal) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(groupProposalKey(proposal.Id), k.cdc.MustMarshalBinaryBare(&proposal))
+
+	// saving back an older proposal must not rewind the next group proposal id
+	if k.GetNextGroupProposalID(ctx) <= proposal.Id {
+		store.Set(NextGroupProposalIDKey, sdk.Uint64ToBigEndian(proposal.Id+1))
+	}
+}
+
+// GetGroupProposal returns the group proposal with the given id.
+func (k Keeper) GetGroupProposal(ctx sdk.Context, proposalID uint64) (types.GroupProposal, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(groupProposalKey(proposalID))
+	if bz == nil {
+		return types.GroupProposal{}, false
+	}
+
+	var proposal types.GroupProposal
+	k.cdc.MustUnmarshalBinaryBare(bz, &proposal)
+
+	return proposal, true
+}
+
+// GetGroupProposals returns all the group proposals ordered by id.
+func (k Keeper) GetGroupProposals(ctx sdk.Context) []types.GroupProposal {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), GroupProposalPrefix)
+	defer iterator.Close()
+
+	proposals := []types.GroupProposal{}
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.GroupProposal
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &proposal)
+		proposals = append(proposals, proposal)
+	}
+
+	return proposals
+}
+
+// GetGroupProposalsByGroup returns the proposals of a group ordered by id.
+func (k Keeper) GetGroupProposalsByGroup(ctx sdk.Context, groupID uint64) []types.GroupProposal {
+	proposals := []types.GroupProposal{}
+	for _, proposal := range k.GetGroupProposals(ctx) {
+		if proposal.GroupId == groupID {
+			proposals = append(proposals, proposal)
+		}
+	}
+
+	return proposals
+}
+
+// ApproveGroupProposal adds the approval of a member to a pending group proposal. Once the weight of
+// the approvals reaches the threshold of the group the messages are executed, the proposal fails with
+// no change to the state when one of them fails.
+func (k Keeper) ApproveGroupProposal(ctx sdk.Context, proposalID uint64, member sdk.AccAddress) (types.GroupProposal, error) {
+	proposal, found := k.GetGroupProposal(ctx, proposalID)
+	if !found {
+		return types.GroupProposal{}, sdkerrors.Wrapf(types.ErrGroupProposalNotFound, "group proposal %d", proposalID)
+	}
+
+	if proposal.Status != types.GroupProposalPending {
+		return types.GroupProposal{}, sdkerrors.Wrapf(types.ErrGroupProposalNotPending, "group proposal %d is %s", proposalID, proposal.Status)
+	}
+
+	group, found := k.GetGroup(ctx, proposal.GroupId)
+	if !found {
+		return types.GroupProposal{}, sdkerrors.Wrapf(types.ErrGroupNotFound, "group %d", proposal.GroupId)
+	}
+
+	if _, isMember := group.MemberWeight(member); !isMember {
+		return types.GroupProposal{}, sdkerrors.Wrapf(types.ErrNotGroupMember, "%s is not a member of group %d", member, group.Id)
+	}
+
+	if proposal.HasApproved(member) {
+		return types.GroupProposal{}, sdkerrors.Wrapf(types.ErrInvalidGroupProposal, "%s already approved group proposal %d", member, proposalID)
+	}
+
+	proposal.Approvals = append(proposal.Approvals, member)
+	if group.ApprovalWeight(proposal.Approvals) >= group.Threshold {
+		k.executeGroupProposal(ctx, &proposal)
+	}
+
+	k.SetGroupProposal(ctx, proposal)
+
+	return proposal, nil
+}
+
+// executeGroupProposal executes the messages of an approved group proposal in a cached context, the
+// changes and events are only kept when all of them succeed. The group account pays the execution fees of
+// the messages when they succeed and their failure fees otherwise, as the fee payer of a tx does.
+func (k Keeper) executeGroupProposal(ctx sdk.Context, proposal *types.GroupProposal) {
+	msgs := proposal.UnpackedMsgs()
+	groupAddr := types.GroupAddress(proposal.GroupId)
+
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	err := k.chargeExecutionFees(cacheCtx, groupAddr, msgs, true)
+	for i := 0; err == nil && i < len(msgs); i++ {
+		_, err = k.dispatchMsg(cacheCtx, msgs[i])
+	}
+
+	if err != nil {
+		proposal.Status = types.GroupProposalFailed
+		proposal.FailureReason = err.Error()
+		// nothing is charged when the group can not pay the failure fees
+		_ = k.chargeExecutionFees(ctx, groupAddr, msgs, false)
+		return
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	proposal.Status = types.GroupProposalExecuted
+}
+
+// chargeExecutionFees sends the execution fees of the messages, or their failure fees, from the payer to the
+// fee collector
+func (k Keeper) chargeExecutionFees(ctx sdk.Context, payer sdk.AccAddress, msgs []sdk.Msg, success bool) error {
+	amount := uint64(0)
+	for _, msg := range msgs {
+		fee := k.GetExecutionFee(ctx, msg.Type())
+		if fee == nil {
+			continue
+		}
+
+		if success {
+			amount += fee.ExecutionFee
+		} else {
+			amount += fee.FailureFee
+		}
+	}
+
+	if amount == 0 {
+		return nil
+	}
+
+	fees := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), sdk.NewIntFromUint64(amount)))
+	return k.bk.SendCoinsFromAccountToModule(ctx, payer, authtypes.FeeCollectorName, fees)
+}
+
+// groupKey returns the key in format <0x50 + groupID_Bytes>
+func groupKey(groupID uint64) []byte {
+	return append([]byte{GroupPrefix[0]}, sdk.Uint64ToBigEndian(groupID)...)
+}
+
+// groupAddressKey returns the key in format <0x51 + group_address_bytes>
+func groupAddressKey(address sdk.AccAddress) []byte {
+	return append([]byte{GroupAddressPrefix[0]}, address.Bytes()...)
+}
+
+// groupProposalKey returns the key in format <0x52 + groupProposalID_Bytes>
+func groupProposalKey(proposalID uint64) []byte {
+	return append([]byte{GroupProposalPrefix[0]}, sdk.Uint64ToBigEndian(proposalID)...)
+}
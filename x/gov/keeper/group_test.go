@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/gov/types"
+)
+
+func TestKeeper_Groups(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.TokensFromConsensusPower(10))
+	members := []types.GroupMember{types.NewGroupMember(addrs[0], 1), types.NewGroupMember(addrs[1], 1)}
+
+	group1 := app.CustomGovKeeper.CreateGroup(ctx, members, 1, "first")
+	group2 := app.CustomGovKeeper.CreateGroup(ctx, members, 2, "second")
+	require.Equal(t, uint64(1), group1.Id)
+	require.Equal(t, uint64(2), group2.Id)
+	require.Equal(t, []types.Group{group1, group2}, app.CustomGovKeeper.GetGroups(ctx))
+
+	group, found := app.CustomGovKeeper.GetGroupByAddress(ctx, types.GroupAddress(2))
+	require.True(t, found)
+	require.Equal(t, group2, group)
+
+	_, found = app.CustomGovKeeper.GetGroup(ctx, 3)
+	require.False(t, found)
+
+	// saving back an older group does not rewind the next id
+	app.CustomGovKeeper.SetGroup(ctx, group1)
+	require.Equal(t, uint64(3), app.CustomGovKeeper.GetNextGroupID(ctx))
+}
+
+func TestKeeper_GroupProposals(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(10))
+	require.Equal(t, uint64(1), app.CustomGovKeeper.GetNextGroupProposalID(ctx))
+
+	app.CustomGovKeeper.SetGroupProposal(ctx, types.NewGroupProposal(1, 1, addrs[0], nil))
+	app.CustomGovKeeper.SetGroupProposal(ctx, types.NewGroupProposal(2, 2, addrs[0], nil))
+	app.CustomGovKeeper.SetGroupProposal(ctx, types.NewGroupProposal(3, 1, addrs[0], nil))
+	require.Equal(t, uint64(4), app.CustomGovKeeper.GetNextGroupProposalID(ctx))
+
+	require.Len(t, app.CustomGovKeeper.GetGroupProposals(ctx), 3)
+	proposals := app.CustomGovKeeper.GetGroupProposalsByGroup(ctx, 1)
+	require.Len(t, proposals, 2)
+	require.Equal(t, uint64(1), proposals[0].Id)
+	require.Equal(t, uint64(3), proposals[1].Id)
+
+	proposal, found := app.CustomGovKeeper.GetGroupProposal(ctx, 2)
+	require.True(t, found)
+	require.Equal(t, types.GroupProposalPending, proposal.Status)
+}
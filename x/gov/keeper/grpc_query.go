@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -45,6 +46,22 @@ func (q Querier) CouncilorByAddress(ctx context.Context, request *types.Councilo
 	return &types.CouncilorResponse{Councilor: councilor}, nil
 }
 
+// Councilors returns the councilors of the council registry, filtered by status when the status is set
+func (q Querier) Councilors(ctx context.Context, request *types.CouncilorsRequest) (*types.CouncilorsResponse, error) {
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+
+	if request.Status == "" {
+		return &types.CouncilorsResponse{Councilors: q.keeper.GetCouncilors(sdkContext)}, nil
+	}
+
+	status, err := types.ParseCouncilorStatus(request.Status)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.CouncilorsResponse{Councilors: q.keeper.GetCouncilorsByStatus(sdkContext, status)}, nil
+}
+
 // CouncilorByMoniker return councilor object named moniker
 func (q Querier) CouncilorByMoniker(ctx context.Context, request *types.CouncilorByMonikerRequest) (*types.CouncilorResponse, error) {
 	councilor, found := q.keeper.GetCouncilorByMoniker(sdk.UnwrapSDKContext(ctx), request.Moniker)
@@ -67,6 +84,59 @@ func (q Querier) PermissionsByAddress(ctx context.Context, request *types.Permis
 	return &types.PermissionsResponse{Permissions: networkActor.Permissions}, nil
 }
 
+// PermissionGrants returns the permissions delegated to an address and the expiries of its whitelisted permissions
+func (q Querier) PermissionGrants(ctx context.Context, request *types.PermissionGrantsRequest) (*types.PermissionGrantsResponse, error) {
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+
+	return &types.PermissionGrantsResponse{
+		Grants:   q.keeper.GetPermissionGrantsByDelegatee(sdkContext, request.Address),
+		Expiries: q.keeper.GetPermissionExpiriesByAddress(sdkContext, request.Address),
+	}, nil
+}
+
+// Authorizations returns the authorizations given by a granter, to a grantee when it is set
+func (q Querier) Authorizations(ctx context.Context, request *types.AuthorizationsRequest) (*types.AuthorizationsResponse, error) {
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+
+	if request.Grantee.Empty() {
+		return &types.AuthorizationsResponse{
+			Authorizations: q.keeper.GetAuthorizationsByGranter(sdkContext, request.Granter),
+		}, nil
+	}
+
+	return &types.AuthorizationsResponse{
+		Authorizations: q.keeper.GetAuthorizationsByGranterAndGrantee(sdkContext, request.Granter, request.Grantee),
+	}, nil
+}
+
+// Group returns a group by its id, or by its address when it is set
+func (q Querier) Group(ctx context.Context, request *types.GroupRequest) (*types.GroupResponse, error) {
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+
+	var group types.Group
+	var found bool
+	if request.Address.Empty() {
+		group, found = q.keeper.GetGroup(sdkContext, request.GroupId)
+	} else {
+		group, found = q.keeper.GetGroupByAddress(sdkContext, request.Address)
+	}
+
+	if !found {
+		return nil, types.ErrGroupNotFound
+	}
+
+	return &types.GroupResponse{Group: group}, nil
+}
+
+// GroupProposals returns the proposals of a group
+func (q Querier) GroupProposals(ctx context.Context, request *types.GroupProposalsRequest) (*types.GroupProposalsResponse, error) {
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+
+	return &types.GroupProposalsResponse{
+		Proposals: q.keeper.GetGroupProposalsByGroup(sdkContext, request.GroupId),
+	}, nil
+}
+
 // GetNetworkProperties return global network properties
 func (q Querier) GetNetworkProperties(ctx context.Context, request *types.NetworkPropertiesRequest) (*types.NetworkPropertiesResponse, error) {
 	sdkContext := sdk.UnwrapSDKContext(ctx)
@@ -87,6 +157,54 @@ func (q Querier) RolePermissions(ctx context.Context, request *types.RolePermiss
 	return &types.RolePermissionsResponse{Permissions: &perms}, nil
 }
 
+// AllRoles returns every role of the registry with its permissions
+func (q Querier) AllRoles(ctx context.Context, request *types.AllRolesRequest) (*types.AllRolesResponse, error) {
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+
+	var roles []types.RoleDetails
+	for id, perms := range q.keeper.GetRolesPermissions(sdkContext) {
+		roles = append(roles, q.roleDetails(sdkContext, types.Role(id), *perms))
+	}
+	sort.Slice(roles, func(i, j int) bool { return roles[i].Info.Id < roles[j].Info.Id })
+
+	return &types.AllRolesResponse{Roles: roles}, nil
+}
+
+// Role returns a role of the registry from its number or its sid
+func (q Querier) Role(ctx context.Context, request *types.RoleRequest) (*types.RoleResponse, error) {
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+
+	role, found := q.keeper.GetRoleByIdentifier(sdkContext, request.Identifier)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrRoleDoesNotExist, request.Identifier)
+	}
+
+	perms, _ := q.keeper.GetPermissionsForRole(sdkContext, role)
+	return &types.RoleResponse{Role: q.roleDetails(sdkContext, role, perms)}, nil
+}
+
+// RoleActors returns the addresses of the actors holding a role
+func (q Querier) RoleActors(ctx context.Context, request *types.RoleActorsRequest) (*types.RoleActorsResponse, error) {
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+
+	role, found := q.keeper.GetRoleByIdentifier(sdkContext, request.Identifier)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrRoleDoesNotExist, request.Identifier)
+	}
+
+	return &types.RoleActorsResponse{Actors: q.keeper.GetRoleActors(sdkContext, role)}, nil
+}
+
+// roleDetails returns the role with its permissions, roles created without info only have their number set.
+func (q Querier) roleDetails(ctx sdk.Context, role types.Role, perms types.Permissions) types.RoleDetails {
+	info, found := q.keeper.GetRoleInfo(ctx, role)
+	if !found {
+		info = types.RoleInfo{Id: uint32(role)}
+	}
+
+	return types.RoleDetails{Info: info, Permissions: perms}
+}
+
 // GetExecutionFee returns execution fee associated to a specific message type
 func (q Querier) GetExecutionFee(ctx context.Context, request *types.ExecutionFeeRequest) (*types.ExecutionFeeResponse, error) {
 	sdkContext := sdk.UnwrapSDKContext(ctx)
@@ -149,6 +267,13 @@ func (q Querier) Vote(ctx context.Context, request *types.QueryVoteRequest) (*ty
 	return &types.QueryVoteResponse{Vote: vote}, nil
 }
 
+// VoteHistory queries the votes cast by a voter on a proposal, including the ones it changed.
+func (q Querier) VoteHistory(ctx context.Context, request *types.QueryVoteHistoryRequest) (*types.QueryVoteHistoryResponse, error) {
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+	votes := q.keeper.GetVoteHistory(sdkContext, request.ProposalId, request.Voter)
+	return &types.QueryVoteHistoryResponse{Votes: votes}, nil
+}
+
 // Votes queries votes of a given proposal.
 func (q Querier) Votes(ctx context.Context, request *types.QueryVotesRequest) (*types.QueryVotesResponse, error) {
 	sdkContext := sdk.UnwrapSDKContext(ctx)
@@ -156,6 +281,41 @@ func (q Querier) Votes(ctx context.Context, request *types.QueryVotesRequest) (*
 	return &types.QueryVotesResponse{Votes: votes}, nil
 }
 
+// Tally queries the tally of a given proposal, proposals still in voting are tallied with the current votes.
+func (q Querier) Tally(ctx context.Context, request *types.QueryTallyRequest) (*types.QueryTallyResponse, error) {
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+	proposal, found := q.keeper.GetProposal(sdkContext, request.ProposalId)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrGettingProposals, fmt.Sprintf("proposal does not exist for %d", request.ProposalId))
+	}
+
+	if proposal.Result != types.Pending {
+		return &types.QueryTallyResponse{
+			TallyResult:   proposal.TallyResult,
+			WeightedTally: proposal.WeightedTally,
+		}, nil
+	}
+
+	availableVoters := q.keeper.GetNetworkActorsByAbsoluteWhitelistPermissions(sdkContext, types.VotePermissions(proposal.GetContent()))
+	calculatedVote := q.keeper.TallyVotes(sdkContext, request.ProposalId, availableVoters)
+	quorum := q.keeper.GetNetworkProperties(sdkContext).VoteQuorum
+
+	return &types.QueryTallyResponse{
+		TallyResult:   calculatedVote.TallyResult(uint64(len(availableVoters)), quorum),
+		WeightedTally: calculatedVote.WeightedTally(),
+	}, nil
+}
+
+// Deposit queries the deposit escrowed for a given proposal.
+func (q Querier) Deposit(ctx context.Context, request *types.QueryDepositRequest) (*types.QueryDepositResponse, error) {
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+	deposit, found := q.keeper.GetDeposit(sdkContext, request.ProposalId)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrGettingProposals, fmt.Sprintf("deposit does not exist for %d", request.ProposalId))
+	}
+	return &types.QueryDepositResponse{Deposit: deposit}, nil
+}
+
 // GetAllDataReferenceKeys queries all data reference keys with pagination
 func (q Querier) GetAllDataReferenceKeys(ctx context.Context, request *types.QueryDataReferenceKeysRequest) (*types.QueryDataReferenceKeysResponse, error) {
 	sdkContext := sdk.UnwrapSDKContext(ctx)
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -134,6 +135,101 @@ func TestQuerier_Proposal(t *testing.T) {
 	require.Len(t, resp.Votes, 1)
 }
 
+func TestQuerier_Tally(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.TokensFromConsensusPower(10))
+
+	proposalID := uint64(1234)
+	proposal, err := types.NewProposal(
+		proposalID,
+		types.NewAssignPermissionProposal(
+			addrs[0],
+			types.PermSetPermissions,
+		),
+		time.Now(),
+		time.Now().Add(10*time.Second),
+		time.Now().Add(20*time.Second),
+	)
+	require.NoError(t, err)
+
+	app.CustomGovKeeper.SaveProposal(ctx, proposal)
+
+	for _, addr := range addrs {
+		err := app.CustomGovKeeper.AddWhitelistPermission(ctx, types.NewDefaultActor(addr), types.PermVoteSetPermissionProposal)
+		require.NoError(t, err)
+	}
+	app.CustomGovKeeper.SaveVote(ctx, types.NewVote(proposalID, addrs[0], types.OptionYes))
+	app.CustomGovKeeper.SaveVote(ctx, types.NewVote(proposalID, addrs[1], types.OptionNo))
+
+	querier := customgovkeeper.NewQuerier(app.CustomGovKeeper)
+
+	// proposal in voting is tallied with the current votes
+	resp, err := querier.Tally(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryTallyRequest{ProposalId: proposalID},
+	)
+	require.NoError(t, err)
+	require.Equal(t, types.TallyResult{
+		Yes:         1,
+		No:          1,
+		TotalVoters: 3,
+		Quorum:      app.CustomGovKeeper.GetNetworkProperties(ctx).VoteQuorum,
+	}, resp.TallyResult)
+	require.Equal(t, sdk.OneDec(), resp.WeightedTally.Yes)
+	require.Equal(t, sdk.OneDec(), resp.WeightedTally.No)
+
+	// finished proposal returns the stored tally
+	proposal.Result = types.Rejected
+	proposal.TallyResult = types.TallyResult{No: 2, TotalVoters: 2, Quorum: 50}
+	app.CustomGovKeeper.SaveProposal(ctx, proposal)
+
+	resp, err = querier.Tally(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryTallyRequest{ProposalId: proposalID},
+	)
+	require.NoError(t, err)
+	require.Equal(t, proposal.TallyResult, resp.TallyResult)
+
+	// non existing proposal
+	_, err = querier.Tally(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryTallyRequest{ProposalId: proposalID + 1},
+	)
+	require.Error(t, err)
+}
+
+func TestQuerier_Deposit(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(10))
+
+	deposit := types.Deposit{
+		ProposalId: 1234,
+		Depositor:  addrs[0],
+		Amount:     sdk.NewCoins(sdk.NewInt64Coin("ukex", 100)),
+	}
+	app.CustomGovKeeper.SaveDeposit(ctx, deposit)
+
+	querier := customgovkeeper.NewQuerier(app.CustomGovKeeper)
+
+	resp, err := querier.Deposit(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryDepositRequest{ProposalId: 1234},
+	)
+	require.NoError(t, err)
+	require.Equal(t, deposit, resp.Deposit)
+
+	// proposal without deposit
+	_, err = querier.Deposit(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryDepositRequest{ProposalId: 1235},
+	)
+	require.Error(t, err)
+}
+
 func TestQuerier_CouncilorByAddress(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.NewContext(false, tmproto.Header{})
@@ -148,6 +244,8 @@ func TestQuerier_CouncilorByAddress(t *testing.T) {
 		"TheSocial",
 		"TheIdentity",
 		addr1,
+		types.CouncilorActive,
+		ctx.BlockTime(),
 	)
 
 	app.CustomGovKeeper.SaveCouncilor(ctx, councilor)
@@ -178,3 +276,72 @@ func TestQuerier_CouncilorByAddress(t *testing.T) {
 	)
 	require.Error(t, types.ErrCouncilorNotFound)
 }
+
+func TestQuerier_Councilors(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.TokensFromConsensusPower(10))
+
+	active := types.NewCouncilor("active", "website", "social", "identity", addrs[0], types.CouncilorActive, ctx.BlockTime())
+	suspended := types.NewCouncilor("suspended", "website", "social", "identity", addrs[1], types.CouncilorSuspended, ctx.BlockTime())
+	app.CustomGovKeeper.SaveCouncilor(ctx, active)
+	app.CustomGovKeeper.SaveCouncilor(ctx, suspended)
+
+	querier := customgovkeeper.NewQuerier(app.CustomGovKeeper)
+
+	resp, err := querier.Councilors(sdk.WrapSDKContext(ctx), &types.CouncilorsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Councilors, 2)
+
+	resp, err = querier.Councilors(sdk.WrapSDKContext(ctx), &types.CouncilorsRequest{Status: "COUNCILOR_SUSPENDED"})
+	require.NoError(t, err)
+	require.Equal(t, []types.Councilor{suspended}, resp.Councilors)
+
+	resp, err = querier.Councilors(sdk.WrapSDKContext(ctx), &types.CouncilorsRequest{Status: "active"})
+	require.NoError(t, err)
+	require.Equal(t, []types.Councilor{active}, resp.Councilors)
+
+	_, err = querier.Councilors(sdk.WrapSDKContext(ctx), &types.CouncilorsRequest{Status: "unknown"})
+	require.Error(t, err)
+}
+
+func TestQuerier_Roles(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.TokensFromConsensusPower(10))
+
+	// a role created before the registry had names only has its number
+	app.CustomGovKeeper.CreateRole(ctx, types.Role(3))
+
+	for _, addr := range addrs {
+		actor := types.NewDefaultActor(addr)
+		app.CustomGovKeeper.AssignRoleToActor(ctx, actor, types.RoleValidator)
+	}
+
+	querier := customgovkeeper.NewQuerier(app.CustomGovKeeper)
+
+	allRoles, err := querier.AllRoles(sdk.WrapSDKContext(ctx), &types.AllRolesRequest{})
+	require.NoError(t, err)
+	require.Len(t, allRoles.Roles, 3)
+	require.Equal(t, "sudo", allRoles.Roles[0].Info.Sid)
+	require.Equal(t, "validator", allRoles.Roles[1].Info.Sid)
+	require.Equal(t, types.RoleInfo{Id: 3}, allRoles.Roles[2].Info)
+
+	role, err := querier.Role(sdk.WrapSDKContext(ctx), &types.RoleRequest{Identifier: "validator"})
+	require.NoError(t, err)
+	require.Equal(t, uint32(types.RoleValidator), role.Role.Info.Id)
+	require.True(t, role.Role.Permissions.IsWhitelisted(types.PermClaimValidator))
+
+	_, err = querier.Role(sdk.WrapSDKContext(ctx), &types.RoleRequest{Identifier: "unknown"})
+	require.True(t, errors.Is(err, types.ErrRoleDoesNotExist))
+
+	actors, err := querier.RoleActors(sdk.WrapSDKContext(ctx), &types.RoleActorsRequest{Identifier: "2"})
+	require.NoError(t, err)
+	require.ElementsMatch(t, addrs, actors.Actors)
+
+	actors, err = querier.RoleActors(sdk.WrapSDKContext(ctx), &types.RoleActorsRequest{Identifier: "sudo"})
+	require.NoError(t, err)
+	require.Empty(t, actors.Actors)
+}
@@ -15,10 +15,36 @@ import (
 type Keeper struct {
 	cdc      codec.BinaryMarshaler
 	storeKey sdk.StoreKey
+	bk       types.BankKeeper
+	sk       types.StakingKeeper
+	pk       types.ParamsKeeper
+	router   sdk.Router
 }
 
-func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryMarshaler) Keeper {
-	return Keeper{cdc: cdc, storeKey: storeKey}
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, bk types.BankKeeper, pk types.ParamsKeeper) Keeper {
+	return Keeper{cdc: cdc, storeKey: storeKey, bk: bk, pk: pk}
+}
+
+// SetStakingKeeper sets the staking keeper used to weight the votes by bonded tokens
+func (k *Keeper) SetStakingKeeper(sk types.StakingKeeper) *Keeper {
+	if k.sk != nil {
+		panic("cannot set staking keeper twice")
+	}
+
+	k.sk = sk
+
+	return k
+}
+
+// SetMsgRouter sets the router dispatching the messages executed on behalf of their granters
+func (k *Keeper) SetMsgRouter(router sdk.Router) *Keeper {
+	if k.router != nil {
+		panic("cannot set msg router twice")
+	}
+
+	k.router = router
+
+	return k
 }
 
 // BondDenom returns the denom that is basically used for fee payment
@@ -72,6 +98,38 @@ func (k Keeper) GetNetworkProperty(ctx sdk.Context, property types.NetworkProper
 		return BoolToInt(properties.EnableTokenWhitelist), nil
 	case types.EnableTokenBlacklist:
 		return BoolToInt(properties.EnableTokenBlacklist), nil
+	case types.ValidatorPowerMode:
+		return properties.ValidatorPowerMode, nil
+	case types.MaxValidatorPowerPercent:
+		return properties.MaxValidatorPowerPercent, nil
+	case types.UnbondingTime:
+		return properties.UnbondingTime, nil
+	case types.VoteWeightMode:
+		return properties.VoteWeightMode, nil
+	case types.CouncilorVoteWeight:
+		return properties.CouncilorVoteWeight, nil
+	case types.MinProposalDeposit:
+		return properties.MinProposalDeposit, nil
+	case types.MaxCouncilorMissedProposals:
+		return properties.MaxCouncilorMissedProposals, nil
+	case types.FeeLedgerWindow:
+		return properties.FeeLedgerWindow, nil
+	case types.MinJailTime:
+		return properties.MinJailTime, nil
+	case types.CommissionChangeInterval:
+		return properties.CommissionChangeInterval, nil
+	case types.MaxCommissionChange:
+		return properties.MaxCommissionChange, nil
+	case types.ExitNoticePeriod:
+		return properties.ExitNoticePeriod, nil
+	case types.MaxValidators:
+		return properties.MaxValidators, nil
+	case types.UptimeEpochLength:
+		return properties.UptimeEpochLength, nil
+	case types.UptimeEpochRetention:
+		return properties.UptimeEpochRetention, nil
+	case types.CouncilorTerm:
+		return properties.CouncilorTerm, nil
 	default:
 		return 0, errors.New("trying to fetch network property that does not exist")
 	}
@@ -110,6 +168,38 @@ func (k Keeper) SetNetworkProperty(ctx sdk.Context, property types.NetworkProper
 		properties.EnableTokenBlacklist = IntToBool(value)
 	case types.EnableTokenWhitelist:
 		properties.EnableTokenWhitelist = IntToBool(value)
+	case types.ValidatorPowerMode:
+		properties.ValidatorPowerMode = value
+	case types.MaxValidatorPowerPercent:
+		properties.MaxValidatorPowerPercent = value
+	case types.UnbondingTime:
+		properties.UnbondingTime = value
+	case types.VoteWeightMode:
+		properties.VoteWeightMode = value
+	case types.CouncilorVoteWeight:
+		properties.CouncilorVoteWeight = value
+	case types.MinProposalDeposit:
+		properties.MinProposalDeposit = value
+	case types.MaxCouncilorMissedProposals:
+		properties.MaxCouncilorMissedProposals = value
+	case types.FeeLedgerWindow:
+		properties.FeeLedgerWindow = value
+	case types.MinJailTime:
+		properties.MinJailTime = value
+	case types.CommissionChangeInterval:
+		properties.CommissionChangeInterval = value
+	case types.MaxCommissionChange:
+		properties.MaxCommissionChange = value
+	case types.ExitNoticePeriod:
+		properties.ExitNoticePeriod = value
+	case types.MaxValidators:
+		properties.MaxValidators = value
+	case types.UptimeEpochLength:
+		properties.UptimeEpochLength = value
+	case types.UptimeEpochRetention:
+		properties.UptimeEpochRetention = value
+	case types.CouncilorTerm:
+		properties.CouncilorTerm = value
 	default:
 		return errors.New("trying to set network property that does not exist")
 	}
@@ -138,6 +228,23 @@ func (k Keeper) GetExecutionFee(ctx sdk.Context, txType string) *types.Execution
 	return fee
 }
 
+// GetExecutionFees returns the fees of all the execution functions
+func (k Keeper) GetExecutionFees(ctx sdk.Context) []*types.ExecutionFee {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixExecutionFee)
+
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	fees := []*types.ExecutionFee{}
+	for ; iterator.Valid(); iterator.Next() {
+		fee := new(types.ExecutionFee)
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), fee)
+		fees = append(fees, fee)
+	}
+
+	return fees
+}
+
 // GetAllDataReferenceKeys implements the Query all data reference keys gRPC method
 func (k Keeper) GetAllDataReferenceKeys(sdkCtx sdk.Context, req *types.QueryDataReferenceKeysRequest) (*types.QueryDataReferenceKeysResponse, error) {
 	if req == nil {
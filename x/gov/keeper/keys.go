@@ -6,8 +6,13 @@ package keeper
 // 0x02<proposalID_Bytes + voterAddress_Bytes> : The vote
 // 0x03<endTime_Bytes + proposalID_Bytes> : ActiveProposalID
 // 0x04<EnactmentEndTime_Bytes + proposalID_Bytes> : ProposalID Holds all the proposals that are in process of enactment.
+// 0x05<proposalID_Bytes> : The proposal deposit.
+// 0x06<proposalID_Bytes + voterAddress_Bytes + submitTime_Bytes> : The vote history.
 //
 // 0x10<role_uint64_Bytes> : The role permissions.
+// 0x11<role_uint64_Bytes> : The role vote weight.
+// 0x12<role_uint64_Bytes> : The role info.
+// 0x13<sid_Bytes> : The role with the sid.
 //
 // 0x20<councilorAddress_Bytes> : Councilor.
 //
@@ -15,16 +20,36 @@ package keeper
 // 0x31<permissionID_Bytes + actor_address_bytes> : NetworkActorAddress. This is used to get all the actors that have a specific whitelist.
 // 0x32<roleID_Bytes + actor_address_bytes> : NetworkActorAddress. This is used to get all the actors that have a specific role.
 // 0x33<permissionID_Bytes + RoleID_Bytes> : RoleID_Bytes. This is used to get all the actors that have a specific role.
+// 0x34<actor_address_bytes + permissionID_Bytes> : PermissionExpiry. The expiry of a whitelisted permission.
+// 0x35<delegatee_address_bytes + delegator_address_bytes + permissionID_Bytes> : PermissionGrant.
+// 0x36<granter_address_bytes + grantee_address_bytes + msgTypeUrl_Bytes> : Authorization.
+// 0x37<expiryHeight_Bytes + permissionExpiryKey_Bytes> : permissionExpiryKey_Bytes. The permissions expiring at a height.
+// 0x38<expiryTime_Bytes + permissionExpiryKey_Bytes> : permissionExpiryKey_Bytes. The permissions expiring at a time.
+// 0x39<expiryHeight_Bytes + permissionGrantKey_Bytes> : permissionGrantKey_Bytes. The grants expiring at a height.
+// 0x3A<expiryTime_Bytes + permissionGrantKey_Bytes> : permissionGrantKey_Bytes. The grants expiring at a time.
+// 0x3B<expiryHeight_Bytes + authorizationKey_Bytes> : authorizationKey_Bytes. The authorizations expiring at a height.
+// 0x3C<expiryTime_Bytes + authorizationKey_Bytes> : authorizationKey_Bytes. The authorizations expiring at a time.
 //
 // 0x40<key_Bytes> : DataRegistryEntry
+//
+// 0x50<groupID_Bytes> : Group.
+// 0x51<group_address_bytes> : groupID_Bytes. This is used to get a group by its address.
+// 0x52<groupProposalID_Bytes> : GroupProposal.
+// 0x53 : The next groupID.
+// 0x54 : The next groupProposalID.
 var (
 	NextProposalIDPrefix     = []byte{0x00}
 	ProposalsPrefix          = []byte{0x01}
 	VotesPrefix              = []byte{0x02}
 	ActiveProposalsPrefix    = []byte{0x03}
 	EnactmentProposalsPrefix = []byte{0x04}
+	DepositsPrefix           = []byte{0x05}
+	VoteHistoryPrefix        = []byte{0x06}
 
 	RolePermissionRegistry          = []byte{0x10}
+	RoleVoteWeightPrefix            = []byte{0x11}
+	RoleInfoPrefix                  = []byte{0x12}
+	RoleSidPrefix                   = []byte{0x13}
 	CouncilorIdentityRegistryPrefix = []byte{0x20}
 
 	NetworkActorsPrefix  = []byte{0x30}
@@ -32,7 +57,24 @@ var (
 	RoleActorPrefix      = []byte{0x32}
 	WhitelistRolePrefix  = []byte{0x33}
 
+	PermissionExpiryPrefix = []byte{0x34}
+	PermissionGrantPrefix  = []byte{0x35}
+	AuthorizationPrefix    = []byte{0x36}
+
+	PermissionExpiryByHeightPrefix = []byte{0x37}
+	PermissionExpiryByTimePrefix   = []byte{0x38}
+	PermissionGrantByHeightPrefix  = []byte{0x39}
+	PermissionGrantByTimePrefix    = []byte{0x3A}
+	AuthorizationByHeightPrefix    = []byte{0x3B}
+	AuthorizationByTimePrefix      = []byte{0x3C}
+
 	DataRegistryPrefix = []byte{0x40}
 
 	PoorNetworkMsgsPrefix = []byte{0x41}
+
+	GroupPrefix            = []byte{0x50}
+	GroupAddressPrefix     = []byte{0x51}
+	GroupProposalPrefix    = []byte{0x52}
+	NextGroupIDKey         = []byte{0x53}
+	NextGroupProposalIDKey = []byte{0x54}
 )
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"github.com/KiraCore/sekai/x/gov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateV0119 migrates the store of the chains running v0.1.18, the network properties added since then
+// read as zero on those chains and are set to their default. The execution fee timeouts stored by those
+// chains were not a gas limit and are set to the default one.
+func (k Keeper) MigrateV0119(ctx sdk.Context) error {
+	defaults := types.DefaultGenesis().NetworkProperties
+
+	properties := k.GetNetworkProperties(ctx)
+	if properties.UnbondingTime == 0 {
+		properties.UnbondingTime = defaults.UnbondingTime
+	}
+	if properties.CouncilorVoteWeight == 0 {
+		properties.CouncilorVoteWeight = defaults.CouncilorVoteWeight
+	}
+	if properties.MinJailTime == 0 {
+		properties.MinJailTime = defaults.MinJailTime
+	}
+	if properties.CommissionChangeInterval == 0 {
+		properties.CommissionChangeInterval = defaults.CommissionChangeInterval
+	}
+	if properties.MaxCommissionChange == 0 {
+		properties.MaxCommissionChange = defaults.MaxCommissionChange
+	}
+	// the jail max time was not enforced by v0.1.18, the jailed validators are kept until governance sets it
+	properties.JailMaxTime = 0
+	k.SetNetworkProperties(ctx, properties)
+
+	for _, fee := range k.GetExecutionFees(ctx) {
+		if fee.Timeout != 0 && fee.Timeout < types.DefaultExecutionTimeout {
+			fee.Timeout = types.DefaultExecutionTimeout
+			k.SetExecutionFee(ctx, fee)
+		}
+	}
+
+	return nil
+}
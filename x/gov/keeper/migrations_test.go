@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KiraCore/sekai/simapp"
+	kiratypes "github.com/KiraCore/sekai/types"
+	"github.com/KiraCore/sekai/x/gov/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestKeeper_MigrateV0119(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 10})
+
+	// network properties of a chain running v0.1.18
+	properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	properties.UnbondingTime = 0
+	properties.CouncilorVoteWeight = 0
+	properties.MinJailTime = 0
+	properties.CommissionChangeInterval = 0
+	properties.MaxCommissionChange = 0
+	properties.JailMaxTime = 10
+	properties.VoteQuorum = 50
+	app.CustomGovKeeper.SetNetworkProperties(ctx, properties)
+	app.CustomGovKeeper.SetExecutionFee(ctx, &types.ExecutionFee{
+		Name:            "Claim Validator Seat",
+		TransactionType: "claim-validator-seat",
+		ExecutionFee:    10,
+		FailureFee:      1,
+		Timeout:         10,
+	})
+	app.CustomGovKeeper.SetExecutionFee(ctx, &types.ExecutionFee{
+		Name:            "Vote Proposal",
+		TransactionType: "vote-proposal",
+		ExecutionFee:    10,
+		FailureFee:      1,
+		Timeout:         0,
+	})
+	app.CustomGovKeeper.SetExecutionFee(ctx, &types.ExecutionFee{
+		Name:            "Create Role",
+		TransactionType: "create-role",
+		ExecutionFee:    10,
+		FailureFee:      1,
+		Timeout:         500000,
+	})
+
+	require.True(t, app.UpgradeKeeper.HasHandler(kiratypes.UpgradeV0119))
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: kiratypes.UpgradeV0119, Height: 10})
+
+	defaults := types.DefaultGenesis().NetworkProperties
+	properties = app.CustomGovKeeper.GetNetworkProperties(ctx)
+	require.Equal(t, defaults.UnbondingTime, properties.UnbondingTime)
+	require.Equal(t, defaults.CouncilorVoteWeight, properties.CouncilorVoteWeight)
+	require.Equal(t, defaults.MinJailTime, properties.MinJailTime)
+	require.Equal(t, defaults.CommissionChangeInterval, properties.CommissionChangeInterval)
+	require.Equal(t, defaults.MaxCommissionChange, properties.MaxCommissionChange)
+	require.Equal(t, uint64(0), properties.JailMaxTime)
+	require.Equal(t, uint64(50), properties.VoteQuorum)
+
+	// the timeouts stored before they were a gas limit are set to the default one
+	require.Equal(t, uint64(types.DefaultExecutionTimeout), app.CustomGovKeeper.GetExecutionFee(ctx, "claim-validator-seat").Timeout)
+	require.Equal(t, uint64(0), app.CustomGovKeeper.GetExecutionFee(ctx, "vote-proposal").Timeout)
+	require.Equal(t, uint64(500000), app.CustomGovKeeper.GetExecutionFee(ctx, "create-role").Timeout)
+
+	// the properties set on the chain are kept
+	properties.UnbondingTime = 60
+	app.CustomGovKeeper.SetNetworkProperties(ctx, properties)
+
+	require.NoError(t, app.CustomGovKeeper.MigrateV0119(ctx))
+	require.Equal(t, uint64(60), app.CustomGovKeeper.GetNetworkProperties(ctx).UnbondingTime)
+}
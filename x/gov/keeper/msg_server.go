@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	"github.com/KiraCore/sekai/x/gov/types"
 	customgovtypes "github.com/KiraCore/sekai/x/gov/types"
@@ -41,21 +42,77 @@ func (k msgServer) VoteProposal(
 		return nil, customgovtypes.ErrProposalDoesNotExist
 	}
 
-	if proposal.VotingEndTime.Before(ctx.BlockTime()) {
+	if proposal.VotingEndTime.Before(ctx.BlockTime()) || proposal.Result != customgovtypes.Pending {
 		return nil, customgovtypes.ErrVotingTimeEnded
 	}
 
-	isAllowed := CheckIfAllowedPermission(ctx, k.keeper, msg.Voter, proposal.GetContent().VotePermission())
-	if !isAllowed {
-		return nil, errors.Wrap(customgovtypes.ErrNotEnoughPermissions, proposal.GetContent().VotePermission().String())
+	for _, perm := range customgovtypes.VotePermissions(proposal.GetContent()) {
+		isAllowed := CheckIfAllowedPermission(ctx, k.keeper, msg.Voter, perm)
+		if !isAllowed {
+			return nil, errors.Wrap(customgovtypes.ErrNotEnoughPermissions, perm.String())
+		}
 	}
 
-	vote := customgovtypes.NewVote(msg.ProposalId, msg.Voter, msg.Option)
+	vote := customgovtypes.NewWeightedVote(msg.ProposalId, msg.Voter, msg.VoteOptions(), msg.Reason)
+	vote.SubmitTime = ctx.BlockTime()
 	k.keeper.SaveVote(ctx, vote)
 
 	return &customgovtypes.MsgVoteProposalResponse{}, nil
 }
 
+func (k msgServer) CancelProposal(
+	goCtx context.Context,
+	msg *customgovtypes.MsgCancelProposal,
+) (*customgovtypes.MsgCancelProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proposal, found := k.keeper.GetProposal(ctx, msg.ProposalId)
+	if !found {
+		return nil, customgovtypes.ErrProposalDoesNotExist
+	}
+
+	switch proposal.Result {
+	case customgovtypes.Pending:
+		if !proposal.Proposer.Equals(msg.Sender) {
+			return nil, errors.Wrap(customgovtypes.ErrNotEnoughPermissions, "only the proposer can cancel a proposal in voting")
+		}
+
+		// the result of a proposal whose voting ended is only waiting for the end blocker to tally it
+		if !ctx.BlockTime().Before(proposal.VotingEndTime) {
+			return nil, customgovtypes.ErrVotingTimeEnded
+		}
+
+		err := k.keeper.RefundDeposit(ctx, proposal.ProposalId)
+		if err != nil {
+			return nil, err
+		}
+		k.keeper.RemoveActiveProposal(ctx, proposal)
+	case customgovtypes.Enactment:
+		councilor, found := k.keeper.GetCouncilor(ctx, msg.Sender)
+		if !found {
+			return nil, customgovtypes.ErrCouncilorNotFound
+		}
+
+		if !councilor.IsActive() {
+			return nil, errors.Wrap(customgovtypes.ErrCouncilorNotActive, councilor.Status.String())
+		}
+
+		isAllowed := CheckIfAllowedPermission(ctx, k.keeper, msg.Sender, customgovtypes.PermCancelProposal)
+		if !isAllowed {
+			return nil, errors.Wrap(customgovtypes.ErrNotEnoughPermissions, customgovtypes.PermCancelProposal.String())
+		}
+
+		k.keeper.RemoveEnactmentProposal(ctx, proposal)
+	default:
+		return nil, errors.Wrap(customgovtypes.ErrProposalNotCancellable, proposal.Result.String())
+	}
+
+	proposal.Result = customgovtypes.Cancelled
+	k.keeper.SaveProposal(ctx, proposal)
+
+	return &customgovtypes.MsgCancelProposalResponse{}, nil
+}
+
 func (k msgServer) ProposalSetPoorNetworkMsgs(
 	goCtx context.Context,
 	msg *customgovtypes.MsgProposalSetPoorNetworkMessages,
@@ -67,7 +124,7 @@ func (k msgServer) ProposalSetPoorNetworkMsgs(
 		return nil, errors.Wrap(customgovtypes.ErrNotEnoughPermissions, customgovtypes.PermCreateSetNetworkPropertyProposal.String())
 	}
 
-	proposalID, err := k.CreateAndSaveProposalWithContent(ctx, customgovtypes.NewSetPoorNetworkMessagesProposal(msg.Messages))
+	proposalID, err := k.CreateAndSaveProposalWithContent(ctx, msg.Proposer, customgovtypes.NewSetPoorNetworkMessagesProposal(msg.Messages))
 
 	return &types.MsgProposalSetPoorNetworkMessagesResponse{
 		ProposalID: proposalID,
@@ -85,7 +142,7 @@ func (k msgServer) ProposalUpsertDataRegistry(
 		return nil, errors.Wrap(customgovtypes.ErrNotEnoughPermissions, customgovtypes.PermUpsertDataRegistryProposal.String())
 	}
 
-	proposalID, err := k.CreateAndSaveProposalWithContent(ctx,
+	proposalID, err := k.CreateAndSaveProposalWithContent(ctx, msg.Proposer,
 		customgovtypes.NewUpsertDataRegistryProposal(
 			msg.Key,
 			msg.Hash,
@@ -118,7 +175,7 @@ func (k msgServer) ProposalAssignPermission(
 		}
 	}
 
-	proposalID, err := k.CreateAndSaveProposalWithContent(ctx, customgovtypes.NewAssignPermissionProposal(
+	proposalID, err := k.CreateAndSaveProposalWithContent(ctx, msg.Proposer, customgovtypes.NewAssignPermissionProposal(
 		msg.Address,
 		customgovtypes.PermValue(msg.Permission),
 	))
@@ -127,7 +184,7 @@ func (k msgServer) ProposalAssignPermission(
 	}, err
 }
 
-func (k msgServer) CreateAndSaveProposalWithContent(ctx sdk.Context, content customgovtypes.Content) (uint64, error) {
+func (k msgServer) CreateAndSaveProposalWithContent(ctx sdk.Context, proposer sdk.AccAddress, content customgovtypes.Content) (uint64, error) {
 	blockTime := ctx.BlockTime()
 	proposalID, err := k.keeper.GetNextProposalID(ctx)
 	if err != nil {
@@ -145,6 +202,15 @@ func (k msgServer) CreateAndSaveProposalWithContent(ctx sdk.Context, content cus
 			time.Second*time.Duration(properties.ProposalEnactmentTime),
 		),
 	)
+	if err != nil {
+		return 0, err
+	}
+	proposal.Proposer = proposer
+
+	err = k.keeper.CollectProposalDeposit(ctx, proposalID, proposer, content)
+	if err != nil {
+		return 0, err
+	}
 
 	k.keeper.SaveProposal(ctx, proposal)
 	k.keeper.AddToActiveProposals(ctx, proposal)
@@ -171,7 +237,7 @@ func (k msgServer) ProposalSetNetworkProperty(
 		return nil, errors.Wrap(errors.ErrInvalidRequest, "network property already set as proposed value")
 	}
 
-	proposalID, err := k.CreateAndSaveProposalWithContent(ctx, customgovtypes.NewSetNetworkPropertyProposal(
+	proposalID, err := k.CreateAndSaveProposalWithContent(ctx, msg.Proposer, customgovtypes.NewSetNetworkPropertyProposal(
 		msg.NetworkProperty,
 		msg.Value,
 	))
@@ -260,6 +326,11 @@ func (k msgServer) CreateRole(
 		return nil, customgovtypes.ErrRoleExist
 	}
 
+	err := k.keeper.SetRoleInfo(ctx, customgovtypes.NewRoleInfo(customgovtypes.Role(msg.Role), msg.Sid, msg.Description, false))
+	if err != nil {
+		return nil, err
+	}
+
 	k.keeper.CreateRole(ctx, customgovtypes.Role(msg.Role))
 
 	return &customgovtypes.MsgCreateRoleResponse{}, nil
@@ -352,19 +423,157 @@ func (k msgServer) WhitelistPermissions(
 		return nil, errors.Wrap(customgovtypes.ErrNotEnoughPermissions, "PermSetPermissions")
 	}
 
+	err := validatePermissionExpiry(ctx, msg.ExpiryHeight, msg.ExpiryTime)
+	if err != nil {
+		return nil, err
+	}
+
 	actor, found := k.keeper.GetNetworkActorByAddress(ctx, msg.Address)
 	if !found {
 		actor = customgovtypes.NewDefaultActor(msg.Address)
 	}
 
-	err := k.keeper.AddWhitelistPermission(ctx, actor, customgovtypes.PermValue(msg.Permission))
+	err = k.keeper.AddWhitelistPermission(ctx, actor, customgovtypes.PermValue(msg.Permission))
 	if err != nil {
 		return nil, errors.Wrapf(customgovtypes.ErrSetPermissions, "error setting %d to whitelist: %s", msg.Permission, err)
 	}
 
+	if msg.ExpiryHeight != 0 || msg.ExpiryTime != nil {
+		k.keeper.SetPermissionExpiry(ctx, customgovtypes.NewPermissionExpiry(
+			msg.Address,
+			customgovtypes.PermValue(msg.Permission),
+			msg.ExpiryHeight,
+			msg.ExpiryTime,
+		))
+	}
+
 	return &customgovtypes.MsgWhitelistPermissionsResponse{}, nil
 }
 
+func (k msgServer) DelegatePermission(
+	goCtx context.Context,
+	msg *customgovtypes.MsgDelegatePermission,
+) (*customgovtypes.MsgDelegatePermissionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	perm := customgovtypes.PermValue(msg.Permission)
+
+	// the delegator can only delegate the permissions it holds itself, not the ones delegated to it
+	delegator, found := k.keeper.GetNetworkActorByAddress(ctx, msg.Delegator)
+	if !found {
+		return nil, errors.Wrap(customgovtypes.ErrNotEnoughPermissions, perm.String())
+	}
+
+	isAllowed, _ := checkActorPermission(ctx, k.keeper, delegator, perm)
+	if !isAllowed {
+		return nil, errors.Wrap(customgovtypes.ErrNotEnoughPermissions, perm.String())
+	}
+
+	err := validatePermissionExpiry(ctx, msg.ExpiryHeight, msg.ExpiryTime)
+	if err != nil {
+		return nil, err
+	}
+
+	k.keeper.SetPermissionGrant(ctx, customgovtypes.NewPermissionGrant(
+		msg.Delegator,
+		msg.Delegatee,
+		perm,
+		msg.ExpiryHeight,
+		msg.ExpiryTime,
+	))
+
+	return &customgovtypes.MsgDelegatePermissionResponse{}, nil
+}
+
+func (k msgServer) RevokePermission(
+	goCtx context.Context,
+	msg *customgovtypes.MsgRevokePermission,
+) (*customgovtypes.MsgRevokePermissionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grant, found := k.keeper.GetPermissionGrant(ctx, msg.Delegatee, msg.Delegator, customgovtypes.PermValue(msg.Permission))
+	if !found {
+		return nil, customgovtypes.ErrPermissionGrantNotFound
+	}
+
+	k.keeper.DeletePermissionGrant(ctx, grant)
+
+	return &customgovtypes.MsgRevokePermissionResponse{}, nil
+}
+
+func (k msgServer) GrantAuthorization(
+	goCtx context.Context,
+	msg *customgovtypes.MsgGrantAuthorization,
+) (*customgovtypes.MsgGrantAuthorizationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := validatePermissionExpiry(ctx, msg.ExpiryHeight, msg.ExpiryTime)
+	if err != nil {
+		return nil, err
+	}
+
+	k.keeper.SetAuthorization(ctx, customgovtypes.NewAuthorization(
+		msg.Granter,
+		msg.Grantee,
+		msg.MsgTypeUrl,
+		msg.SpendLimit,
+		msg.MaxUses,
+		msg.ExpiryHeight,
+		msg.ExpiryTime,
+	))
+
+	return &customgovtypes.MsgGrantAuthorizationResponse{}, nil
+}
+
+func (k msgServer) RevokeAuthorization(
+	goCtx context.Context,
+	msg *customgovtypes.MsgRevokeAuthorization,
+) (*customgovtypes.MsgRevokeAuthorizationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	auth, found := k.keeper.GetAuthorization(ctx, msg.Granter, msg.Grantee, msg.MsgTypeUrl)
+	if !found {
+		return nil, customgovtypes.ErrAuthorizationNotFound
+	}
+
+	k.keeper.DeleteAuthorization(ctx, auth)
+
+	return &customgovtypes.MsgRevokeAuthorizationResponse{}, nil
+}
+
+// Exec executes the messages on behalf of their granters, the permissions checked by the messages are the ones of the granters.
+func (k msgServer) Exec(
+	goCtx context.Context,
+	msg *customgovtypes.MsgExec,
+) (*customgovtypes.MsgExecResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	msgs := msg.UnpackedMsgs()
+	if len(msgs) != len(msg.Msgs) {
+		return nil, errors.Wrap(customgovtypes.ErrInvalidExecMsg, "invalid message")
+	}
+
+	results, err := k.keeper.ExecMsgs(ctx, msg.Grantee, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &customgovtypes.MsgExecResponse{Results: results}, nil
+}
+
+// validatePermissionExpiry checks the expiry height and time, when set, are after the current block.
+func validatePermissionExpiry(ctx sdk.Context, expiryHeight uint64, expiryTime *time.Time) error {
+	if expiryHeight != 0 && expiryHeight <= uint64(ctx.BlockHeight()) {
+		return errors.Wrapf(customgovtypes.ErrInvalidPermissionExpiry, "expiry height %d is not after the current height %d", expiryHeight, ctx.BlockHeight())
+	}
+
+	if expiryTime != nil && !expiryTime.After(ctx.BlockTime()) {
+		return errors.Wrapf(customgovtypes.ErrInvalidPermissionExpiry, "expiry time %s is not after the current time %s", expiryTime, ctx.BlockTime())
+	}
+
+	return nil
+}
+
 func (k msgServer) BlacklistPermissions(
 	goCtx context.Context,
 	msg *customgovtypes.MsgBlacklistPermissions,
@@ -438,7 +647,33 @@ func (k msgServer) ClaimCouncilor(
 		return nil, errors.Wrap(customgovtypes.ErrNotEnoughPermissions, "PermClaimCouncilor")
 	}
 
-	councilor := customgovtypes.NewCouncilor(msg.Moniker, msg.Website, msg.Social, msg.Identity, msg.Address)
+	councilor, found := k.keeper.GetCouncilor(ctx, msg.Address)
+	if found && councilor.Status != customgovtypes.CouncilorInactive {
+		// claiming the seat again during a term updates the councilor details and lifts a suspension for
+		// missed proposals, the term is kept
+		councilor.Moniker = msg.Moniker
+		councilor.Website = msg.Website
+		councilor.Social = msg.Social
+		councilor.Identity = msg.Identity
+		if councilor.Status == customgovtypes.CouncilorSuspended {
+			councilor.Status = customgovtypes.CouncilorActive
+			councilor.MissedProposals = 0
+		}
+	} else {
+		councilor = customgovtypes.NewCouncilor(
+			msg.Moniker,
+			msg.Website,
+			msg.Social,
+			msg.Identity,
+			msg.Address,
+			customgovtypes.CouncilorActive,
+			ctx.BlockTime(),
+		)
+
+		if term := k.keeper.GetNetworkProperties(ctx).CouncilorTerm; term > 0 {
+			councilor.TermEnd = ctx.BlockTime().Add(time.Duration(term) * time.Second)
+		}
+	}
 
 	k.keeper.SaveCouncilor(ctx, councilor)
 
@@ -462,9 +697,16 @@ func (k msgServer) ProposalCreateRole(goCtx context.Context, msg *customgovtypes
 		return nil, customgovtypes.ErrRoleExist
 	}
 
-	proposalID, err := k.CreateAndSaveProposalWithContent(ctx,
+	_, exists = k.keeper.GetRoleInfoBySid(ctx, msg.Sid)
+	if exists {
+		return nil, customgovtypes.ErrRoleSidExist
+	}
+
+	proposalID, err := k.CreateAndSaveProposalWithContent(ctx, msg.Proposer,
 		customgovtypes.NewCreateRoleProposal(
 			customgovtypes.Role(msg.Role),
+			msg.Sid,
+			msg.Description,
 			msg.WhitelistedPermissions,
 			msg.BlacklistedPermissions,
 		),
@@ -477,3 +719,262 @@ func (k msgServer) ProposalCreateRole(goCtx context.Context, msg *customgovtypes
 		ProposalID: proposalID,
 	}, nil
 }
+
+func (k msgServer) ProposalSetRoleVoteWeight(goCtx context.Context, msg *customgovtypes.MsgProposalSetRoleVoteWeight) (*customgovtypes.MsgProposalSetRoleVoteWeightResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	isAllowed := CheckIfAllowedPermission(ctx, k.keeper, msg.Proposer, customgovtypes.PermCreateSetRoleVoteWeightProposal)
+	if !isAllowed {
+		return nil, errors.Wrap(customgovtypes.ErrNotEnoughPermissions, customgovtypes.PermCreateSetRoleVoteWeightProposal.String())
+	}
+
+	_, exists := k.keeper.GetPermissionsForRole(ctx, customgovtypes.Role(msg.Role))
+	if !exists {
+		return nil, customgovtypes.ErrRoleDoesNotExist
+	}
+
+	proposalID, err := k.CreateAndSaveProposalWithContent(ctx, msg.Proposer,
+		customgovtypes.NewSetRoleVoteWeightProposal(
+			customgovtypes.Role(msg.Role),
+			msg.Weight,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &customgovtypes.MsgProposalSetRoleVoteWeightResponse{
+		ProposalID: proposalID,
+	}, nil
+}
+
+func (k msgServer) ProposalParameterChange(goCtx context.Context, msg *customgovtypes.MsgProposalParameterChange) (*customgovtypes.MsgProposalParameterChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	isAllowed := CheckIfAllowedPermission(ctx, k.keeper, msg.Proposer, customgovtypes.PermCreateParameterChangeProposal)
+	if !isAllowed {
+		return nil, errors.Wrap(customgovtypes.ErrNotEnoughPermissions, customgovtypes.PermCreateParameterChangeProposal.String())
+	}
+
+	for _, change := range msg.Changes {
+		err := k.keeper.ValidateParamChange(ctx, change)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	proposalID, err := k.CreateAndSaveProposalWithContent(ctx, msg.Proposer,
+		customgovtypes.NewParameterChangeProposal(msg.Changes),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &customgovtypes.MsgProposalParameterChangeResponse{
+		ProposalID: proposalID,
+	}, nil
+}
+
+func (k msgServer) ProposalMultiContent(goCtx context.Context, msg *customgovtypes.MsgProposalMultiContent) (*customgovtypes.MsgProposalMultiContentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	isAllowed := CheckIfAllowedPermission(ctx, k.keeper, msg.Proposer, customgovtypes.PermCreateMultiContentProposal)
+	if !isAllowed {
+		return nil, errors.Wrap(customgovtypes.ErrNotEnoughPermissions, customgovtypes.PermCreateMultiContentProposal.String())
+	}
+
+	contents := msg.UnpackedContents()
+	for _, content := range contents {
+		perm := content.CreatePermission()
+		if !CheckIfAllowedPermission(ctx, k.keeper, msg.Proposer, perm) {
+			return nil, errors.Wrap(customgovtypes.ErrNotEnoughPermissions, perm.String())
+		}
+
+		if err := content.ValidateBasic(); err != nil {
+			return nil, err
+		}
+
+		switch p := content.(type) {
+		case *customgovtypes.ParameterChangeProposal:
+			for _, change := range p.Changes {
+				err := k.keeper.ValidateParamChange(ctx, change)
+				if err != nil {
+					return nil, err
+				}
+			}
+		case *customgovtypes.SoftwareUpgradeProposal:
+			err := validateUpgradeHeight(ctx, p.Plan)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	content, err := customgovtypes.NewMultiContentProposal(contents)
+	if err != nil {
+		return nil, err
+	}
+
+	proposalID, err := k.CreateAndSaveProposalWithContent(ctx, msg.Proposer, content)
+	if err != nil {
+		return nil, err
+	}
+
+	return &customgovtypes.MsgProposalMultiContentResponse{
+		ProposalID: proposalID,
+	}, nil
+}
+
+func (k msgServer) ProposalSoftwareUpgrade(goCtx context.Context, msg *customgovtypes.MsgProposalSoftwareUpgrade) (*customgovtypes.MsgProposalSoftwareUpgradeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	isAllowed := CheckIfAllowedPermission(ctx, k.keeper, msg.Proposer, customgovtypes.PermCreateSoftwareUpgradeProposal)
+	if !isAllowed {
+		return nil, errors.Wrap(customgovtypes.ErrNotEnoughPermissions, customgovtypes.PermCreateSoftwareUpgradeProposal.String())
+	}
+
+	err := validateUpgradeHeight(ctx, msg.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	proposalID, err := k.CreateAndSaveProposalWithContent(ctx, msg.Proposer,
+		customgovtypes.NewSoftwareUpgradeProposal(msg.Plan),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &customgovtypes.MsgProposalSoftwareUpgradeResponse{
+		ProposalID: proposalID,
+	}, nil
+}
+
+func (k msgServer) ProposalCancelSoftwareUpgrade(goCtx context.Context, msg *customgovtypes.MsgProposalCancelSoftwareUpgrade) (*customgovtypes.MsgProposalCancelSoftwareUpgradeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	isAllowed := CheckIfAllowedPermission(ctx, k.keeper, msg.Proposer, customgovtypes.PermCreateSoftwareUpgradeProposal)
+	if !isAllowed {
+		return nil, errors.Wrap(customgovtypes.ErrNotEnoughPermissions, customgovtypes.PermCreateSoftwareUpgradeProposal.String())
+	}
+
+	proposalID, err := k.CreateAndSaveProposalWithContent(ctx, msg.Proposer,
+		customgovtypes.NewCancelSoftwareUpgradeProposal(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &customgovtypes.MsgProposalCancelSoftwareUpgradeResponse{
+		ProposalID: proposalID,
+	}, nil
+}
+
+func (k msgServer) ProposalRemoveCouncilor(goCtx context.Context, msg *customgovtypes.MsgProposalRemoveCouncilor) (*customgovtypes.MsgProposalRemoveCouncilorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	isAllowed := CheckIfAllowedPermission(ctx, k.keeper, msg.Proposer, customgovtypes.PermCreateRemoveCouncilorProposal)
+	if !isAllowed {
+		return nil, errors.Wrap(customgovtypes.ErrNotEnoughPermissions, customgovtypes.PermCreateRemoveCouncilorProposal.String())
+	}
+
+	_, found := k.keeper.GetCouncilor(ctx, msg.Address)
+	if !found {
+		return nil, customgovtypes.ErrCouncilorNotFound
+	}
+
+	proposalID, err := k.CreateAndSaveProposalWithContent(ctx, msg.Proposer,
+		customgovtypes.NewRemoveCouncilorProposal(msg.Address),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &customgovtypes.MsgProposalRemoveCouncilorResponse{
+		ProposalID: proposalID,
+	}, nil
+}
+
+// validateUpgradeHeight checks the upgrade is not scheduled at a height the chain already reached,
+// the height is checked again when the proposal is enacted.
+func validateUpgradeHeight(ctx sdk.Context, plan upgradetypes.Plan) error {
+	if plan.Height <= ctx.BlockHeight() {
+		return errors.Wrapf(customgovtypes.ErrInvalidUpgradePlan, "upgrade height %d is not after the current height %d", plan.Height, ctx.BlockHeight())
+	}
+
+	return nil
+}
+
+func (k msgServer) CreateGroup(
+	goCtx context.Context,
+	msg *customgovtypes.MsgCreateGroup,
+) (*customgovtypes.MsgCreateGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	group := k.keeper.CreateGroup(ctx, msg.Members, msg.Threshold, msg.Description)
+
+	return &customgovtypes.MsgCreateGroupResponse{
+		GroupId: group.Id,
+		Address: group.Address,
+	}, nil
+}
+
+func (k msgServer) UpdateGroup(
+	goCtx context.Context,
+	msg *customgovtypes.MsgUpdateGroup,
+) (*customgovtypes.MsgUpdateGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	group, found := k.keeper.GetGroupByAddress(ctx, msg.Group)
+	if !found {
+		return nil, errors.Wrapf(customgovtypes.ErrGroupNotFound, "group %s", msg.Group)
+	}
+
+	group.Members = msg.Members
+	group.Threshold = msg.Threshold
+	group.Description = msg.Description
+	k.keeper.SetGroup(ctx, group)
+
+	return &customgovtypes.MsgUpdateGroupResponse{}, nil
+}
+
+func (k msgServer) SubmitGroupProposal(
+	goCtx context.Context,
+	msg *customgovtypes.MsgSubmitGroupProposal,
+) (*customgovtypes.MsgSubmitGroupProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	group, found := k.keeper.GetGroup(ctx, msg.GroupId)
+	if !found {
+		return nil, errors.Wrapf(customgovtypes.ErrGroupNotFound, "group %d", msg.GroupId)
+	}
+
+	if _, isMember := group.MemberWeight(msg.Proposer); !isMember {
+		return nil, errors.Wrapf(customgovtypes.ErrNotGroupMember, "%s is not a member of group %d", msg.Proposer, group.Id)
+	}
+
+	proposal := customgovtypes.NewGroupProposal(k.keeper.GetNextGroupProposalID(ctx), group.Id, msg.Proposer, msg.Msgs)
+	k.keeper.SetGroupProposal(ctx, proposal)
+
+	// the proposer approves its own proposal
+	_, err := k.keeper.ApproveGroupProposal(ctx, proposal.Id, msg.Proposer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &customgovtypes.MsgSubmitGroupProposalResponse{ProposalId: proposal.Id}, nil
+}
+
+func (k msgServer) ApproveGroupProposal(
+	goCtx context.Context,
+	msg *customgovtypes.MsgApproveGroupProposal,
+) (*customgovtypes.MsgApproveGroupProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, err := k.keeper.ApproveGroupProposal(ctx, msg.ProposalId, msg.Member)
+	if err != nil {
+		return nil, err
+	}
+
+	return &customgovtypes.MsgApproveGroupProposalResponse{}, nil
+}
@@ -27,6 +27,22 @@ func (k Keeper) GetNetworkActorByAddress(ctx sdk.Context, address sdk.AccAddress
 	return na, true
 }
 
+// GetNetworkActors returns all the network actors.
+func (k Keeper) GetNetworkActors(ctx sdk.Context) []types.NetworkActor {
+	actors := []types.NetworkActor{}
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), NetworkActorsPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var actor types.NetworkActor
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &actor)
+		actors = append(actors, actor)
+	}
+
+	return actors
+}
+
 // AddWhitelistPermission whitelist a permission to an address. It saves the actor after it.
 func (k Keeper) AddWhitelistPermission(ctx sdk.Context, actor types.NetworkActor, perm types.PermValue) error {
 	err := actor.Permissions.AddToWhitelist(perm)
@@ -53,6 +69,7 @@ func (k Keeper) RemoveWhitelistPermission(ctx sdk.Context, actor types.NetworkAc
 
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(WhitelistAddressPermKey(actor.Address, perm))
+	k.DeletePermissionExpiry(ctx, actor.Address, perm)
 
 	return nil
 }
@@ -114,6 +131,31 @@ func (k Keeper) GetNetworkActorsByAbsoluteWhitelistPermission(ctx sdk.Context, p
 	return actors
 }
 
+// GetNetworkActorsByAbsoluteWhitelistPermissions returns all actors that have all the given whitelist permissions.
+func (k Keeper) GetNetworkActorsByAbsoluteWhitelistPermissions(ctx sdk.Context, perms []types.PermValue) []types.NetworkActor {
+	if len(perms) == 0 {
+		return nil
+	}
+
+	actors := k.GetNetworkActorsByAbsoluteWhitelistPermission(ctx, perms[0])
+	for _, perm := range perms[1:] {
+		allowed := map[string]bool{}
+		for _, actor := range k.GetNetworkActorsByAbsoluteWhitelistPermission(ctx, perm) {
+			allowed[actor.Address.String()] = true
+		}
+
+		var filtered []types.NetworkActor
+		for _, actor := range actors {
+			if allowed[actor.Address.String()] {
+				filtered = append(filtered, actor)
+			}
+		}
+		actors = filtered
+	}
+
+	return actors
+}
+
 func (k Keeper) getNetworkActorOrFail(ctx sdk.Context, addr sdk.AccAddress) types.NetworkActor {
 	actor, found := k.GetNetworkActorByAddress(ctx, addr)
 	if !found {
@@ -291,6 +291,42 @@ func addRoleToMultipleAddrs(app *simapp.SimApp, ctx sdk.Context, addrs []sdk.Acc
 	}
 }
 
+func TestKeeper_GetNetworkActorsByAbsoluteWhitelistPermissions(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.TokensFromConsensusPower(10))
+
+	err := whitelistPermToMultipleAddrs(app, ctx, addrs, types.PermVoteSetPermissionProposal)
+	require.NoError(t, err)
+
+	// only the first two addresses hold the second permission, the first one by role
+	app.CustomGovKeeper.CreateRole(ctx, types.Role(12345))
+	err = app.CustomGovKeeper.WhitelistRolePermission(ctx, types.Role(12345), types.PermVoteSetNetworkPropertyProposal)
+	require.NoError(t, err)
+
+	actor, found := app.CustomGovKeeper.GetNetworkActorByAddress(ctx, addrs[0])
+	require.True(t, found)
+	app.CustomGovKeeper.AssignRoleToActor(ctx, actor, types.Role(12345))
+
+	actor, found = app.CustomGovKeeper.GetNetworkActorByAddress(ctx, addrs[1])
+	require.True(t, found)
+	err = app.CustomGovKeeper.AddWhitelistPermission(ctx, actor, types.PermVoteSetNetworkPropertyProposal)
+	require.NoError(t, err)
+
+	actors := app.CustomGovKeeper.GetNetworkActorsByAbsoluteWhitelistPermissions(ctx, []types.PermValue{
+		types.PermVoteSetPermissionProposal,
+		types.PermVoteSetNetworkPropertyProposal,
+	})
+	require.Len(t, actors, 2)
+	require.ElementsMatch(t, []sdk.AccAddress{addrs[0], addrs[1]}, []sdk.AccAddress{actors[0].Address, actors[1].Address})
+
+	actors = app.CustomGovKeeper.GetNetworkActorsByAbsoluteWhitelistPermissions(ctx, []types.PermValue{types.PermVoteSetPermissionProposal})
+	require.Len(t, actors, 3)
+
+	require.Empty(t, app.CustomGovKeeper.GetNetworkActorsByAbsoluteWhitelistPermissions(ctx, nil))
+}
+
 func whitelistPermToMultipleAddrs(app *simapp.SimApp, ctx sdk.Context, addrs []sdk.AccAddress, permissions types.PermValue) error {
 	for _, addr := range addrs {
 		err := app.CustomGovKeeper.AddWhitelistPermission(ctx, types.NewDefaultActor(addr), permissions)
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"github.com/KiraCore/sekai/x/gov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// ValidateParamChange checks the param of the change exists and that the param validator of its module accepts the value.
+func (k Keeper) ValidateParamChange(ctx sdk.Context, change types.ParamChange) error {
+	subspace, err := k.getParamSubspace(ctx, change)
+	if err != nil {
+		return err
+	}
+
+	value, err := change.AminoJSONValue()
+	if err != nil {
+		return err
+	}
+
+	// the update is applied on a cached context which is never written
+	cacheCtx, _ := ctx.CacheContext()
+	err = subspace.Update(cacheCtx, []byte(change.Key), value)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidParamChange, "%s/%s: %s", change.Subspace, change.Key, err)
+	}
+
+	return nil
+}
+
+// ApplyParamChange sets the value of the change on the param subspace of its module.
+func (k Keeper) ApplyParamChange(ctx sdk.Context, change types.ParamChange) error {
+	subspace, err := k.getParamSubspace(ctx, change)
+	if err != nil {
+		return err
+	}
+
+	value, err := change.AminoJSONValue()
+	if err != nil {
+		return err
+	}
+
+	return subspace.Update(ctx, []byte(change.Key), value)
+}
+
+func (k Keeper) getParamSubspace(ctx sdk.Context, change types.ParamChange) (paramstypes.Subspace, error) {
+	subspace, found := k.pk.GetSubspace(change.Subspace)
+	if !found {
+		return paramstypes.Subspace{}, sdkerrors.Wrap(types.ErrUnknownParamSubspace, change.Subspace)
+	}
+
+	// params are all set by the genesis of their module, a key which is not stored is not registered
+	if !subspace.Has(ctx, []byte(change.Key)) {
+		return paramstypes.Subspace{}, sdkerrors.Wrapf(types.ErrUnknownParam, "%s/%s", change.Subspace, change.Key)
+	}
+
+	return subspace, nil
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/gov/types"
+	slashingtypes "github.com/KiraCore/sekai/x/slashing/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestKeeper_ValidateParamChange(t *testing.T) {
+	tests := []struct {
+		name        string
+		change      types.ParamChange
+		expectedErr error
+	}{
+		{
+			name:   "valid duration",
+			change: types.NewParamChange(slashingtypes.ModuleName, "DowntimeInactiveDuration", types.ParamValueTypeDuration, "20m"),
+		},
+		{
+			name:   "valid uint",
+			change: types.NewParamChange(slashingtypes.ModuleName, "SignedBlocksWindow", types.ParamValueTypeUint, "200"),
+		},
+		{
+			name:   "valid bool",
+			change: types.NewParamChange("bank", "DefaultSendEnabled", types.ParamValueTypeBool, "false"),
+		},
+		{
+			name:        "unknown subspace",
+			change:      types.NewParamChange("unknown", "SignedBlocksWindow", types.ParamValueTypeUint, "200"),
+			expectedErr: types.ErrUnknownParamSubspace,
+		},
+		{
+			name:        "unknown key",
+			change:      types.NewParamChange(slashingtypes.ModuleName, "Unknown", types.ParamValueTypeUint, "200"),
+			expectedErr: types.ErrUnknownParam,
+		},
+		{
+			name:        "value rejected by the module validator",
+			change:      types.NewParamChange(slashingtypes.ModuleName, "SignedBlocksWindow", types.ParamValueTypeUint, "0"),
+			expectedErr: types.ErrInvalidParamChange,
+		},
+		{
+			name:        "value out of range",
+			change:      types.NewParamChange(slashingtypes.ModuleName, "SlashFractionDowntime", types.ParamValueTypeDec, "1.5"),
+			expectedErr: types.ErrInvalidParamChange,
+		},
+		{
+			name:        "value type not matching the param",
+			change:      types.NewParamChange(slashingtypes.ModuleName, "SlashFractionDowntime", types.ParamValueTypeBool, "true"),
+			expectedErr: types.ErrInvalidParamChange,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			ctx := app.NewContext(false, tmproto.Header{})
+
+			params := app.CustomSlashingKeeper.GetParams(ctx)
+
+			err := app.CustomGovKeeper.ValidateParamChange(ctx, tt.change)
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.True(t, errors.Is(err, tt.expectedErr), err.Error())
+			}
+
+			// validating a change never writes the param
+			require.Equal(t, params, app.CustomSlashingKeeper.GetParams(ctx))
+		})
+	}
+}
+
+func TestKeeper_ApplyParamChange(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	err := app.CustomGovKeeper.ApplyParamChange(ctx, types.NewParamChange(slashingtypes.ModuleName, "DowntimeInactiveDuration", types.ParamValueTypeDuration, "20m"))
+	require.NoError(t, err)
+	require.Equal(t, 20*time.Minute, app.CustomSlashingKeeper.DowntimeInactiveDuration(ctx))
+
+	err = app.CustomGovKeeper.ApplyParamChange(ctx, types.NewParamChange(slashingtypes.ModuleName, "SignedBlocksWindow", types.ParamValueTypeUint, "200"))
+	require.NoError(t, err)
+	require.Equal(t, int64(200), app.CustomSlashingKeeper.SignedBlocksWindow(ctx))
+
+	err = app.CustomGovKeeper.ApplyParamChange(ctx, types.NewParamChange(slashingtypes.ModuleName, "SlashFractionDowntime", types.ParamValueTypeDec, "0.05"))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), app.CustomSlashingKeeper.SlashFractionDowntime(ctx))
+}
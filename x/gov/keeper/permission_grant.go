@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"github.com/KiraCore/sekai/x/gov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetPermissionExpiry saves the expiry of a permission whitelisted to an actor.
+func (k Keeper) SetPermissionExpiry(ctx sdk.Context, expiry types.PermissionExpiry) {
+	perm := types.PermValue(expiry.Permission)
+	k.DeletePermissionExpiry(ctx, expiry.Address, perm)
+
+	key := permissionExpiryKey(expiry.Address, perm)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(&expiry))
+	k.setExpiryQueue(ctx, PermissionExpiryByHeightPrefix, PermissionExpiryByTimePrefix, expiry.ExpiryHeight, expiry.ExpiryTime, key)
+}
+
+// GetPermissionExpiry returns the expiry of a permission whitelisted to an actor.
+func (k Keeper) GetPermissionExpiry(ctx sdk.Context, address sdk.AccAddress, perm types.PermValue) (types.PermissionExpiry, bool) {
+	return k.getPermissionExpiryByKey(ctx, permissionExpiryKey(address, perm))
+}
+
+func (k Keeper) getPermissionExpiryByKey(ctx sdk.Context, key []byte) (types.PermissionExpiry, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return types.PermissionExpiry{}, false
+	}
+
+	var expiry types.PermissionExpiry
+	k.cdc.MustUnmarshalBinaryBare(bz, &expiry)
+
+	return expiry, true
+}
+
+// DeletePermissionExpiry removes the expiry of a permission, the permission does not expire anymore.
+func (k Keeper) DeletePermissionExpiry(ctx sdk.Context, address sdk.AccAddress, perm types.PermValue) {
+	expiry, found := k.GetPermissionExpiry(ctx, address, perm)
+	if !found {
+		return
+	}
+
+	key := permissionExpiryKey(address, perm)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(key)
+	k.deleteExpiryQueue(ctx, PermissionExpiryByHeightPrefix, PermissionExpiryByTimePrefix, expiry.ExpiryHeight, expiry.ExpiryTime, key)
+}
+
+// GetPermissionExpiriesByAddress returns the expiries of the permissions whitelisted to an address.
+func (k Keeper) GetPermissionExpiriesByAddress(ctx sdk.Context, address sdk.AccAddress) []types.PermissionExpiry {
+	return k.getPermissionExpiries(ctx, append(PermissionExpiryPrefix, address.Bytes()...))
+}
+
+// GetPermissionExpiries returns the expiries of all the whitelisted permissions.
+func (k Keeper) GetPermissionExpiries(ctx sdk.Context) []types.PermissionExpiry {
+	return k.getPermissionExpiries(ctx, PermissionExpiryPrefix)
+}
+
+func (k Keeper) getPermissionExpiries(ctx sdk.Context, prefix []byte) []types.PermissionExpiry {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	var expiries []types.PermissionExpiry
+	for ; iterator.Valid(); iterator.Next() {
+		var expiry types.PermissionExpiry
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &expiry)
+		expiries = append(expiries, expiry)
+	}
+
+	return expiries
+}
+
+// SetPermissionGrant saves a permission delegated by the delegator to the delegatee, replacing the previous grant.
+func (k Keeper) SetPermissionGrant(ctx sdk.Context, grant types.PermissionGrant) {
+	if previous, found := k.GetPermissionGrant(ctx, grant.Delegatee, grant.Delegator, types.PermValue(grant.Permission)); found {
+		k.DeletePermissionGrant(ctx, previous)
+	}
+
+	key := permissionGrantKey(grant.Delegatee, grant.Delegator, types.PermValue(grant.Permission))
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(&grant))
+	k.setExpiryQueue(ctx, PermissionGrantByHeightPrefix, PermissionGrantByTimePrefix, grant.ExpiryHeight, grant.ExpiryTime, key)
+}
+
+// GetPermissionGrant returns the permission delegated by the delegator to the delegatee.
+func (k Keeper) GetPermissionGrant(ctx sdk.Context, delegatee, delegator sdk.AccAddress, perm types.PermValue) (types.PermissionGrant, bool) {
+	return k.getPermissionGrantByKey(ctx, permissionGrantKey(delegatee, delegator, perm))
+}
+
+func (k Keeper) getPermissionGrantByKey(ctx sdk.Context, key []byte) (types.PermissionGrant, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return types.PermissionGrant{}, false
+	}
+
+	var grant types.PermissionGrant
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+
+	return grant, true
+}
+
+// DeletePermissionGrant removes a permission delegated by the delegator to the delegatee.
+func (k Keeper) DeletePermissionGrant(ctx sdk.Context, grant types.PermissionGrant) {
+	key := permissionGrantKey(grant.Delegatee, grant.Delegator, types.PermValue(grant.Permission))
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(key)
+	k.deleteExpiryQueue(ctx, PermissionGrantByHeightPrefix, PermissionGrantByTimePrefix, grant.ExpiryHeight, grant.ExpiryTime, key)
+}
+
+// GetPermissionGrantsByDelegatee returns the permissions delegated to an address.
+func (k Keeper) GetPermissionGrantsByDelegatee(ctx sdk.Context, delegatee sdk.AccAddress) []types.PermissionGrant {
+	return k.getPermissionGrants(ctx, append(PermissionGrantPrefix, delegatee.Bytes()...))
+}
+
+// GetPermissionGrants returns all the delegated permissions.
+func (k Keeper) GetPermissionGrants(ctx sdk.Context) []types.PermissionGrant {
+	return k.getPermissionGrants(ctx, PermissionGrantPrefix)
+}
+
+func (k Keeper) getPermissionGrants(ctx sdk.Context, prefix []byte) []types.PermissionGrant {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	var grants []types.PermissionGrant
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.PermissionGrant
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &grant)
+		grants = append(grants, grant)
+	}
+
+	return grants
+}
+
+// RemoveExpiredPermissions removes the whitelisted permissions and the grants which expired at the block height or time.
+func (k Keeper) RemoveExpiredPermissions(ctx sdk.Context) {
+	for _, key := range k.getExpiredKeys(ctx, PermissionExpiryByHeightPrefix, PermissionExpiryByTimePrefix) {
+		expiry, found := k.getPermissionExpiryByKey(ctx, key)
+		if !found {
+			continue
+		}
+
+		perm := types.PermValue(expiry.Permission)
+		actor, found := k.GetNetworkActorByAddress(ctx, expiry.Address)
+		if found && actor.Permissions.IsWhitelisted(perm) {
+			if err := k.RemoveWhitelistPermission(ctx, actor, perm); err != nil {
+				panic(err)
+			}
+		}
+		k.DeletePermissionExpiry(ctx, expiry.Address, perm)
+	}
+
+	for _, key := range k.getExpiredKeys(ctx, PermissionGrantByHeightPrefix, PermissionGrantByTimePrefix) {
+		if grant, found := k.getPermissionGrantByKey(ctx, key); found {
+			k.DeletePermissionGrant(ctx, grant)
+		}
+	}
+}
+
+// permissionExpiryKey returns the key in format <0x34 + address_bytes + Perm_Bytes>
+func permissionExpiryKey(address sdk.AccAddress, perm types.PermValue) []byte {
+	return append(append(PermissionExpiryPrefix, address.Bytes()...), permToBytes(perm)...)
+}
+
+// permissionGrantKey returns the key in format <0x35 + delegatee_bytes + delegator_bytes + Perm_Bytes>
+func permissionGrantKey(delegatee, delegator sdk.AccAddress, perm types.PermValue) []byte {
+	key := append(append(PermissionGrantPrefix, delegatee.Bytes()...), delegator.Bytes()...)
+	return append(key, permToBytes(perm)...)
+}
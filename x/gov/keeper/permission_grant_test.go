@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/gov/types"
+)
+
+func TestKeeper_RemoveExpiredPermissions(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Unix(1600000000, 0).UTC()
+	ctx := app.NewContext(false, tmproto.Header{Height: 10, Time: now})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.TokensFromConsensusPower(10))
+
+	// permission expiring by height, and one expiring by time later
+	require.NoError(t, app.CustomGovKeeper.AddWhitelistPermission(ctx, types.NewDefaultActor(addrs[0]), types.PermUpsertTokenRate))
+	app.CustomGovKeeper.SetPermissionExpiry(ctx, types.NewPermissionExpiry(addrs[0], types.PermUpsertTokenRate, 11, nil))
+
+	later := now.Add(time.Hour)
+	actor, _ := app.CustomGovKeeper.GetNetworkActorByAddress(ctx, addrs[0])
+	require.NoError(t, app.CustomGovKeeper.AddWhitelistPermission(ctx, actor, types.PermUpsertTokenAlias))
+	app.CustomGovKeeper.SetPermissionExpiry(ctx, types.NewPermissionExpiry(addrs[0], types.PermUpsertTokenAlias, 0, &later))
+
+	// grants expiring by height and by time
+	app.CustomGovKeeper.SetPermissionGrant(ctx, types.NewPermissionGrant(addrs[0], addrs[1], types.PermUpsertTokenRate, 11, nil))
+	app.CustomGovKeeper.SetPermissionGrant(ctx, types.NewPermissionGrant(addrs[0], addrs[2], types.PermUpsertTokenAlias, 0, &later))
+
+	app.CustomGovKeeper.RemoveExpiredPermissions(ctx)
+	require.Len(t, app.CustomGovKeeper.GetPermissionExpiries(ctx), 2)
+	require.Len(t, app.CustomGovKeeper.GetPermissionGrants(ctx), 2)
+
+	ctx = ctx.WithBlockHeight(11)
+	app.CustomGovKeeper.RemoveExpiredPermissions(ctx)
+
+	actor, _ = app.CustomGovKeeper.GetNetworkActorByAddress(ctx, addrs[0])
+	require.False(t, actor.Permissions.IsWhitelisted(types.PermUpsertTokenRate))
+	require.True(t, actor.Permissions.IsWhitelisted(types.PermUpsertTokenAlias))
+	require.Equal(t, []types.PermissionExpiry{types.NewPermissionExpiry(addrs[0], types.PermUpsertTokenAlias, 0, &later)}, app.CustomGovKeeper.GetPermissionExpiriesByAddress(ctx, addrs[0]))
+	require.Empty(t, app.CustomGovKeeper.GetPermissionGrantsByDelegatee(ctx, addrs[1]))
+	require.Len(t, app.CustomGovKeeper.GetPermissionGrantsByDelegatee(ctx, addrs[2]), 1)
+
+	ctx = ctx.WithBlockTime(later)
+	app.CustomGovKeeper.RemoveExpiredPermissions(ctx)
+
+	actor, _ = app.CustomGovKeeper.GetNetworkActorByAddress(ctx, addrs[0])
+	require.False(t, actor.Permissions.IsWhitelisted(types.PermUpsertTokenAlias))
+	require.Empty(t, app.CustomGovKeeper.GetPermissionExpiries(ctx))
+	require.Empty(t, app.CustomGovKeeper.GetPermissionGrants(ctx))
+}
+
+func TestKeeper_RemoveWhitelistPermission_DeletesExpiry(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(10))[0]
+
+	require.NoError(t, app.CustomGovKeeper.AddWhitelistPermission(ctx, types.NewDefaultActor(addr), types.PermUpsertTokenRate))
+	app.CustomGovKeeper.SetPermissionExpiry(ctx, types.NewPermissionExpiry(addr, types.PermUpsertTokenRate, 100, nil))
+
+	actor, _ := app.CustomGovKeeper.GetNetworkActorByAddress(ctx, addr)
+	require.NoError(t, app.CustomGovKeeper.RemoveWhitelistPermission(ctx, actor, types.PermUpsertTokenRate))
+	require.Empty(t, app.CustomGovKeeper.GetPermissionExpiries(ctx))
+}
+
+func TestKeeper_RemoveExpiredPermissions_ReplacedExpiry(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 10})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.TokensFromConsensusPower(10))
+
+	// extending the expiry removes the previous one from the expiry queue
+	require.NoError(t, app.CustomGovKeeper.AddWhitelistPermission(ctx, types.NewDefaultActor(addrs[0]), types.PermUpsertTokenRate))
+	app.CustomGovKeeper.SetPermissionExpiry(ctx, types.NewPermissionExpiry(addrs[0], types.PermUpsertTokenRate, 11, nil))
+	app.CustomGovKeeper.SetPermissionExpiry(ctx, types.NewPermissionExpiry(addrs[0], types.PermUpsertTokenRate, 20, nil))
+
+	app.CustomGovKeeper.SetPermissionGrant(ctx, types.NewPermissionGrant(addrs[0], addrs[1], types.PermUpsertTokenRate, 11, nil))
+	app.CustomGovKeeper.SetPermissionGrant(ctx, types.NewPermissionGrant(addrs[0], addrs[1], types.PermUpsertTokenRate, 20, nil))
+
+	ctx = ctx.WithBlockHeight(11)
+	app.CustomGovKeeper.RemoveExpiredPermissions(ctx)
+
+	actor, _ := app.CustomGovKeeper.GetNetworkActorByAddress(ctx, addrs[0])
+	require.True(t, actor.Permissions.IsWhitelisted(types.PermUpsertTokenRate))
+	require.Len(t, app.CustomGovKeeper.GetPermissionExpiries(ctx), 1)
+	require.Len(t, app.CustomGovKeeper.GetPermissionGrants(ctx), 1)
+
+	// the permissions expiring at an earlier height are removed as well
+	ctx = ctx.WithBlockHeight(25)
+	app.CustomGovKeeper.RemoveExpiredPermissions(ctx)
+
+	actor, _ = app.CustomGovKeeper.GetNetworkActorByAddress(ctx, addrs[0])
+	require.False(t, actor.Permissions.IsWhitelisted(types.PermUpsertTokenRate))
+	require.Empty(t, app.CustomGovKeeper.GetPermissionExpiries(ctx))
+	require.Empty(t, app.CustomGovKeeper.GetPermissionGrants(ctx))
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"strconv"
+
 	"github.com/KiraCore/sekai/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -31,6 +33,117 @@ func (k Keeper) GetPermissionsForRole(ctx sdk.Context, role types.Role) (types.P
 	return perm, true
 }
 
+// GetRolesPermissions returns the permissions of all the roles.
+func (k Keeper) GetRolesPermissions(ctx sdk.Context) map[uint64]*types.Permissions {
+	permissions := make(map[uint64]*types.Permissions)
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), RolePermissionRegistry)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var perms types.Permissions
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &perms)
+		role := bytesToRole(iterator.Key()[len(RolePermissionRegistry):])
+		permissions[uint64(role)] = &perms
+	}
+
+	return permissions
+}
+
+// SetRoleInfo saves the sid and description of a role, it fails if the sid belongs to another role
+// or if it changes an immutable role info.
+func (k Keeper) SetRoleInfo(ctx sdk.Context, info types.RoleInfo) error {
+	role := types.Role(info.Id)
+	if existing, found := k.GetRoleInfoBySid(ctx, info.Sid); found && existing.Id != info.Id {
+		return types.ErrRoleSidExist
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if old, found := k.GetRoleInfo(ctx, role); found {
+		if old.Immutable && old != info {
+			return types.ErrRoleImmutable
+		}
+		store.Delete(append(RoleSidPrefix, []byte(old.Sid)...))
+	}
+
+	prefix.NewStore(store, RoleInfoPrefix).Set(roleToBytes(role), k.cdc.MustMarshalBinaryBare(&info))
+	store.Set(append(RoleSidPrefix, []byte(info.Sid)...), roleToBytes(role))
+
+	return nil
+}
+
+// GetRoleInfo returns the sid and description of a role.
+func (k Keeper) GetRoleInfo(ctx sdk.Context, role types.Role) (types.RoleInfo, bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), RoleInfoPrefix)
+	bz := prefixStore.Get(roleToBytes(role))
+	if bz == nil {
+		return types.RoleInfo{}, false
+	}
+
+	var info types.RoleInfo
+	k.cdc.MustUnmarshalBinaryBare(bz, &info)
+
+	return info, true
+}
+
+// GetRoleInfoBySid returns the info of the role with the sid.
+func (k Keeper) GetRoleInfoBySid(ctx sdk.Context, sid string) (types.RoleInfo, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(append(RoleSidPrefix, []byte(sid)...))
+	if bz == nil {
+		return types.RoleInfo{}, false
+	}
+
+	return k.GetRoleInfo(ctx, bytesToRole(bz))
+}
+
+// GetRoleInfos returns the info of all the roles that have one.
+func (k Keeper) GetRoleInfos(ctx sdk.Context) []types.RoleInfo {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), RoleInfoPrefix)
+	defer iterator.Close()
+
+	var infos []types.RoleInfo
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.RoleInfo
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &info)
+		infos = append(infos, info)
+	}
+
+	return infos
+}
+
+// GetRoleByIdentifier returns the role from its number or its sid, the role has to exist in the registry.
+func (k Keeper) GetRoleByIdentifier(ctx sdk.Context, identifier string) (types.Role, bool) {
+	var role types.Role
+	if id, err := strconv.ParseUint(identifier, 10, 32); err == nil {
+		role = types.Role(id)
+	} else {
+		info, found := k.GetRoleInfoBySid(ctx, identifier)
+		if !found {
+			return types.RoleUndefined, false
+		}
+		role = types.Role(info.Id)
+	}
+
+	if _, found := k.GetPermissionsForRole(ctx, role); !found {
+		return types.RoleUndefined, false
+	}
+
+	return role, true
+}
+
+// GetRoleActors returns the addresses of the network actors holding the role.
+func (k Keeper) GetRoleActors(ctx sdk.Context, role types.Role) []sdk.AccAddress {
+	iterator := k.GetNetworkActorsByRole(ctx, role)
+	defer iterator.Close()
+
+	var actors []sdk.AccAddress
+	for ; iterator.Valid(); iterator.Next() {
+		actors = append(actors, sdk.AccAddress(iterator.Value()))
+	}
+
+	return actors
+}
+
 func (k Keeper) WhitelistRolePermission(ctx sdk.Context, role types.Role, perm types.PermValue) error {
 	store := ctx.KVStore(k.storeKey)
 
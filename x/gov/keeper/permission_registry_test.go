@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/KiraCore/sekai/simapp"
@@ -133,3 +134,55 @@ func TestKeeper_GetPermissionsForRole_ReturnsNilWhenDoesNotExist(t *testing.T) {
 	_, found := app.CustomGovKeeper.GetPermissionsForRole(ctx, 12345)
 	require.False(t, found)
 }
+
+func TestKeeper_SetRoleInfo(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	sudo, found := app.CustomGovKeeper.GetRoleInfoBySid(ctx, "sudo")
+	require.True(t, found)
+	require.Equal(t, uint32(types.RoleSudo), sudo.Id)
+	require.True(t, sudo.Immutable)
+
+	// genesis roles can not be changed
+	err := app.CustomGovKeeper.SetRoleInfo(ctx, types.NewRoleInfo(types.RoleSudo, "admin", "", false))
+	require.True(t, errors.Is(err, types.ErrRoleImmutable))
+
+	// the sid is unique
+	err = app.CustomGovKeeper.SetRoleInfo(ctx, types.NewRoleInfo(types.Role(3), "validator", "", false))
+	require.True(t, errors.Is(err, types.ErrRoleSidExist))
+
+	info := types.NewRoleInfo(types.Role(3), "auditor", "Audits the network", false)
+	require.NoError(t, app.CustomGovKeeper.SetRoleInfo(ctx, info))
+
+	saved, found := app.CustomGovKeeper.GetRoleInfo(ctx, types.Role(3))
+	require.True(t, found)
+	require.Equal(t, info, saved)
+
+	// changing the sid frees the old one
+	renamed := types.NewRoleInfo(types.Role(3), "inspector", "Audits the network", false)
+	require.NoError(t, app.CustomGovKeeper.SetRoleInfo(ctx, renamed))
+	_, found = app.CustomGovKeeper.GetRoleInfoBySid(ctx, "auditor")
+	require.False(t, found)
+
+	require.Len(t, app.CustomGovKeeper.GetRoleInfos(ctx), 3)
+}
+
+func TestKeeper_GetRoleByIdentifier(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	role, found := app.CustomGovKeeper.GetRoleByIdentifier(ctx, "validator")
+	require.True(t, found)
+	require.Equal(t, types.RoleValidator, role)
+
+	role, found = app.CustomGovKeeper.GetRoleByIdentifier(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.RoleSudo, role)
+
+	_, found = app.CustomGovKeeper.GetRoleByIdentifier(ctx, "1234")
+	require.False(t, found)
+
+	_, found = app.CustomGovKeeper.GetRoleByIdentifier(ctx, "unknown")
+	require.False(t, found)
+}
@@ -35,8 +35,11 @@ func (k Keeper) SaveProposal(ctx sdk.Context, proposal types.Proposal) {
 	bz := k.cdc.MustMarshalBinaryBare(&proposal)
 	store.Set(GetProposalKey(proposal.ProposalId), bz)
 
-	// Update NextProposal
-	k.SaveProposalID(ctx, proposal.ProposalId+1)
+	// Update NextProposal, saving back an older proposal must not rewind it
+	nextProposalID, err := k.GetNextProposalID(ctx)
+	if err != nil || nextProposalID <= proposal.ProposalId {
+		k.SaveProposalID(ctx, proposal.ProposalId+1)
+	}
 }
 
 func (k Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (types.Proposal, bool) {
@@ -67,10 +70,63 @@ func (k Keeper) GetProposals(ctx sdk.Context) ([]types.Proposal, error) {
 	return proposals, nil
 }
 
+// SaveVote stores the vote of the voter, replacing its previous vote, and appends it to the voter history.
 func (k Keeper) SaveVote(ctx sdk.Context, vote types.Vote) {
+	k.SetVote(ctx, vote)
+	k.SetVoteHistoryEntry(ctx, vote)
+}
+
+// SetVote stores the vote of the voter without recording it in the voter history.
+func (k Keeper) SetVote(ctx sdk.Context, vote types.Vote) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(VoteKey(vote.ProposalId, vote.Voter), k.cdc.MustMarshalBinaryBare(&vote))
+}
+
+// SetVoteHistoryEntry records the vote in the voter history.
+func (k Keeper) SetVoteHistoryEntry(ctx sdk.Context, vote types.Vote) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshalBinaryBare(&vote)
-	store.Set(VoteKey(vote.ProposalId, vote.Voter), bz)
+	store.Set(VoteHistoryEntryKey(vote), k.cdc.MustMarshalBinaryBare(&vote))
+}
+
+// GetVotes returns the current votes of all the proposals.
+func (k Keeper) GetVotes(ctx sdk.Context) types.Votes {
+	return k.getVotesByPrefix(ctx, VotesPrefix)
+}
+
+// GetAllVoteHistory returns the history of the votes of all the proposals.
+func (k Keeper) GetAllVoteHistory(ctx sdk.Context) types.Votes {
+	return k.getVotesByPrefix(ctx, VoteHistoryPrefix)
+}
+
+func (k Keeper) getVotesByPrefix(ctx sdk.Context, prefix []byte) types.Votes {
+	votes := types.Votes{}
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.Vote
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &vote)
+		votes = append(votes, vote)
+	}
+
+	return votes
+}
+
+// GetVoteHistory returns the votes cast by the voter on the proposal, oldest first.
+func (k Keeper) GetVoteHistory(ctx sdk.Context, proposalID uint64, address sdk.AccAddress) types.Votes {
+	var votes types.Votes
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), VoteHistoryKey(proposalID, address))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.Vote
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &vote)
+		votes = append(votes, vote)
+	}
+
+	return votes
 }
 
 func (k Keeper) GetVote(ctx sdk.Context, proposalID uint64, address sdk.AccAddress) (types.Vote, bool) {
@@ -125,6 +181,29 @@ func (k Keeper) RemoveEnactmentProposal(ctx sdk.Context, proposal types.Proposal
 	store.Delete(EnactmentProposalKey(proposal))
 }
 
+// GetActiveProposalIDs returns the IDs of the proposals in the voting period, by voting end time.
+func (k Keeper) GetActiveProposalIDs(ctx sdk.Context) []uint64 {
+	return k.getProposalIDsByPrefix(ctx, ActiveProposalsPrefix)
+}
+
+// GetEnactmentProposalIDs returns the IDs of the proposals waiting for enactment, by enactment end time.
+func (k Keeper) GetEnactmentProposalIDs(ctx sdk.Context) []uint64 {
+	return k.getProposalIDsByPrefix(ctx, EnactmentProposalsPrefix)
+}
+
+func (k Keeper) getProposalIDsByPrefix(ctx sdk.Context, prefix []byte) []uint64 {
+	proposalIDs := []uint64{}
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		proposalIDs = append(proposalIDs, BytesToProposalID(iterator.Value()))
+	}
+
+	return proposalIDs
+}
+
 // GetActiveProposalsWithFinishedVotingEndTimeIterator returns the proposals that have endtime finished.
 func (k Keeper) GetActiveProposalsWithFinishedVotingEndTimeIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
@@ -161,6 +240,15 @@ func VoteKey(proposalId uint64, address sdk.AccAddress) []byte {
 	return append(VotesKey(proposalId), address.Bytes()...)
 }
 
+func VoteHistoryKey(proposalID uint64, address sdk.AccAddress) []byte {
+	return append(append(VoteHistoryPrefix, ProposalIDToBytes(proposalID)...), address.Bytes()...)
+}
+
+// VoteHistoryEntryKey returns the history key of the vote, votes of the same voter in the same block share it.
+func VoteHistoryEntryKey(vote types.Vote) []byte {
+	return append(VoteHistoryKey(vote.ProposalId, vote.Voter), sdk.FormatTimeBytes(vote.SubmitTime)...)
+}
+
 func GetProposalKey(proposalID uint64) []byte {
 	return append(ProposalsPrefix, ProposalIDToBytes(proposalID)...)
 }
@@ -134,6 +134,16 @@ func TestSaveProposalReturnsTheProposalID_AndIncreasesLast(t *testing.T) {
 	savedProposal, found := app.CustomGovKeeper.GetProposal(ctx, proposal.ProposalId)
 	require.True(t, found)
 	require.Equal(t, proposal, savedProposal)
+
+	// saving back an older proposal does not rewind nextProposalID
+	proposal.ProposalId = 5
+	app.CustomGovKeeper.SaveProposal(ctx, proposal)
+	savedProposal.Result = types.Cancelled
+	app.CustomGovKeeper.SaveProposal(ctx, savedProposal)
+
+	proposalID, err = app.CustomGovKeeper.GetNextProposalID(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), proposalID)
 }
 
 func TestKeeper_SaveVote(t *testing.T) {
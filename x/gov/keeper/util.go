@@ -5,13 +5,39 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// CheckIfAllowedPermission
+// CheckIfAllowedPermission returns true if the address is granted the permission, through its roles, its own
+// permissions or a grant delegated by an actor holding it. Blacklisting the permission to the address takes precedence.
 func CheckIfAllowedPermission(ctx sdk.Context, keeper Keeper, addr sdk.AccAddress, permValue types.PermValue) bool {
 	actor, found := keeper.GetNetworkActorByAddress(ctx, addr)
-	if !found {
-		return false
+	if found {
+		isAllowed, ok := checkActorPermission(ctx, keeper, actor, permValue)
+		if ok {
+			return isAllowed
+		}
 	}
 
+	// Last delegated permissions, honored while the delegator holds the permission itself
+	for _, grant := range keeper.GetPermissionGrantsByDelegatee(ctx, addr) {
+		if types.PermValue(grant.Permission) != permValue || grant.IsExpired(ctx.BlockHeight(), ctx.BlockTime()) {
+			continue
+		}
+
+		delegator, found := keeper.GetNetworkActorByAddress(ctx, grant.Delegator)
+		if !found {
+			continue
+		}
+
+		if isAllowed, _ := checkActorPermission(ctx, keeper, delegator, permValue); isAllowed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkActorPermission returns whether the permission is allowed to the actor through its roles and its own permissions,
+// and whether the permission is whitelisted or blacklisted at all.
+func checkActorPermission(ctx sdk.Context, keeper Keeper, actor types.NetworkActor, permValue types.PermValue) (bool, bool) {
 	// Get All Roles for actor
 	roles := getRolePermissions(ctx, keeper, actor)
 
@@ -43,11 +69,7 @@ func CheckIfAllowedPermission(ctx sdk.Context, keeper Keeper, addr sdk.AccAddres
 	}
 
 	isAllowed, ok := permMap[uint32(permValue)]
-	if !ok {
-		return false
-	}
-
-	return isAllowed
+	return isAllowed, ok
 }
 
 func getRolePermissions(ctx sdk.Context, keeper Keeper, actor types.NetworkActor) map[uint64]*types.Permissions {
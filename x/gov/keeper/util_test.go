@@ -102,3 +102,67 @@ func TestCheckIfAllowedPermission(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckIfAllowedPermission_DelegatedGrant(t *testing.T) {
+	delegator, err := sdk.AccAddressFromBech32("kira1alzyfq40zjsveat87jlg8jxetwqmr0a29sgd0f")
+	require.NoError(t, err)
+
+	addr, err := sdk.AccAddressFromBech32("kira15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqzp4f3d")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		prepareScenario func(ctx sdk.Context, keeper keeper.Keeper)
+		isAllowed       bool
+	}{
+		{
+			name: "permission delegated by an actor holding it",
+			prepareScenario: func(ctx sdk.Context, keeper keeper.Keeper) {
+				require.NoError(t, keeper.AddWhitelistPermission(ctx, types.NewDefaultActor(delegator), types.PermUpsertTokenRate))
+				keeper.SetPermissionGrant(ctx, types.NewPermissionGrant(delegator, addr, types.PermUpsertTokenRate, 0, nil))
+			},
+			isAllowed: true,
+		},
+		{
+			name: "delegator does not hold the permission anymore",
+			prepareScenario: func(ctx sdk.Context, keeper keeper.Keeper) {
+				keeper.SaveNetworkActor(ctx, types.NewDefaultActor(delegator))
+				keeper.SetPermissionGrant(ctx, types.NewPermissionGrant(delegator, addr, types.PermUpsertTokenRate, 0, nil))
+			},
+			isAllowed: false,
+		},
+		{
+			name: "grant expired",
+			prepareScenario: func(ctx sdk.Context, keeper keeper.Keeper) {
+				require.NoError(t, keeper.AddWhitelistPermission(ctx, types.NewDefaultActor(delegator), types.PermUpsertTokenRate))
+				keeper.SetPermissionGrant(ctx, types.NewPermissionGrant(delegator, addr, types.PermUpsertTokenRate, 10, nil))
+			},
+			isAllowed: false,
+		},
+		{
+			name: "permission blacklisted to the delegatee",
+			prepareScenario: func(ctx sdk.Context, keeper keeper.Keeper) {
+				require.NoError(t, keeper.AddWhitelistPermission(ctx, types.NewDefaultActor(delegator), types.PermUpsertTokenRate))
+				keeper.SetPermissionGrant(ctx, types.NewPermissionGrant(delegator, addr, types.PermUpsertTokenRate, 0, nil))
+
+				actor := types.NewDefaultActor(addr)
+				require.NoError(t, actor.Permissions.AddToBlacklist(types.PermUpsertTokenRate))
+				keeper.SaveNetworkActor(ctx, actor)
+			},
+			isAllowed: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			ctx := app.NewContext(false, tmproto.Header{Height: 10})
+
+			tt.prepareScenario(ctx, app.CustomGovKeeper)
+
+			allowed := keeper.CheckIfAllowedPermission(ctx, app.CustomGovKeeper, addr, types.PermUpsertTokenRate)
+			require.Equal(t, tt.isAllowed, allowed)
+		})
+	}
+}
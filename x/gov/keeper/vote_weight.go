@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"github.com/KiraCore/sekai/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetRoleVoteWeight sets the weight of the votes of the actors holding the role.
+func (k Keeper) SetRoleVoteWeight(ctx sdk.Context, role types.Role, weight sdk.Dec) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), RoleVoteWeightPrefix)
+	prefixStore.Set(roleToBytes(role), k.cdc.MustMarshalBinaryBare(&sdk.DecProto{Dec: weight}))
+}
+
+// GetRoleVoteWeight returns the vote weight of the role, roles without a weight set by governance weight 1.
+func (k Keeper) GetRoleVoteWeight(ctx sdk.Context, role types.Role) sdk.Dec {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), RoleVoteWeightPrefix)
+	bz := prefixStore.Get(roleToBytes(role))
+	if bz == nil {
+		return sdk.OneDec()
+	}
+
+	var weight sdk.DecProto
+	k.cdc.MustUnmarshalBinaryBare(bz, &weight)
+
+	return weight.Dec
+}
+
+// GetRoleVoteWeights returns the vote weights set by governance.
+func (k Keeper) GetRoleVoteWeights(ctx sdk.Context) []types.RoleVoteWeight {
+	weights := []types.RoleVoteWeight{}
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), RoleVoteWeightPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var weight sdk.DecProto
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &weight)
+		role := bytesToRole(iterator.Key()[len(RoleVoteWeightPrefix):])
+		weights = append(weights, types.RoleVoteWeight{Role: uint64(role), Weight: weight.Dec})
+	}
+
+	return weights
+}
+
+// GetVoterWeight returns the weight of the votes of the actor according to the VOTE_WEIGHT_MODE network property.
+func (k Keeper) GetVoterWeight(ctx sdk.Context, actor types.NetworkActor) sdk.Dec {
+	properties := k.GetNetworkProperties(ctx)
+
+	switch properties.VoteWeightMode {
+	case types.VoteWeightModeRole:
+		if len(actor.Roles) == 0 {
+			return sdk.OneDec()
+		}
+
+		weight := sdk.ZeroDec()
+		for _, role := range actor.Roles {
+			roleWeight := k.GetRoleVoteWeight(ctx, types.Role(role))
+			if roleWeight.GT(weight) {
+				weight = roleWeight
+			}
+		}
+		return weight
+	case types.VoteWeightModeCouncilor:
+		if k.IsActiveCouncilor(ctx, actor.Address) {
+			return sdk.NewDec(int64(properties.CouncilorVoteWeight))
+		}
+		return sdk.OneDec()
+	case types.VoteWeightModeBonded:
+		return k.sk.GetDelegatorBondedTokens(ctx, actor.Address).ToDec()
+	default:
+		return sdk.OneDec()
+	}
+}
+
+// TallyVotes weights the votes cast on the proposal, availableVoters are the actors allowed to vote on it.
+func (k Keeper) TallyVotes(ctx sdk.Context, proposalID uint64, availableVoters []types.NetworkActor) types.CalculatedVotes {
+	vetoWeight := sdk.ZeroDec()
+	for _, actor := range types.GetActorsWithVoteWithVeto(availableVoters) {
+		vetoWeight = vetoWeight.Add(k.GetVoterWeight(ctx, actor))
+	}
+
+	return types.CalculateWeightedVotes(k.GetProposalVotes(ctx, proposalID), func(voter sdk.AccAddress) sdk.Dec {
+		actor, found := k.GetNetworkActorByAddress(ctx, voter)
+		if !found {
+			return sdk.ZeroDec()
+		}
+		return k.GetVoterWeight(ctx, actor)
+	}, vetoWeight)
+}
+
+// GetVotersWeight returns the weight of all the actors allowed to vote on a proposal, the quorum is
+// reached once the votes weigh the VOTE_QUORUM percentage of it.
+func (k Keeper) GetVotersWeight(ctx sdk.Context, availableVoters []types.NetworkActor) sdk.Dec {
+	weight := sdk.ZeroDec()
+	for _, actor := range availableVoters {
+		weight = weight.Add(k.GetVoterWeight(ctx, actor))
+	}
+
+	return weight
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/gov/types"
+	stakingtypes "github.com/KiraCore/sekai/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestKeeper_RoleVoteWeight(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	// roles without a weight set by governance weight 1
+	require.Equal(t, sdk.OneDec(), app.CustomGovKeeper.GetRoleVoteWeight(ctx, types.RoleValidator))
+
+	app.CustomGovKeeper.SetRoleVoteWeight(ctx, types.RoleValidator, sdk.NewDecWithPrec(25, 1))
+	require.Equal(t, sdk.NewDecWithPrec(25, 1), app.CustomGovKeeper.GetRoleVoteWeight(ctx, types.RoleValidator))
+}
+
+func TestKeeper_GetVoterWeight(t *testing.T) {
+	tests := []struct {
+		name           string
+		mode           uint64
+		prepareActor   func(app *simapp.SimApp, ctx sdk.Context, addr sdk.AccAddress) types.NetworkActor
+		expectedWeight sdk.Dec
+	}{
+		{
+			name: "one vote per actor",
+			mode: types.VoteWeightModeActor,
+			prepareActor: func(app *simapp.SimApp, ctx sdk.Context, addr sdk.AccAddress) types.NetworkActor {
+				return types.NewDefaultActor(addr)
+			},
+			expectedWeight: sdk.OneDec(),
+		},
+		{
+			name: "highest weight of the actor roles",
+			mode: types.VoteWeightModeRole,
+			prepareActor: func(app *simapp.SimApp, ctx sdk.Context, addr sdk.AccAddress) types.NetworkActor {
+				app.CustomGovKeeper.SetRoleVoteWeight(ctx, types.RoleSudo, sdk.NewDec(5))
+				app.CustomGovKeeper.SetRoleVoteWeight(ctx, types.RoleValidator, sdk.NewDec(2))
+
+				actor := types.NewDefaultActor(addr)
+				app.CustomGovKeeper.AssignRoleToActor(ctx, actor, types.RoleValidator)
+				app.CustomGovKeeper.AssignRoleToActor(ctx, actor, types.RoleSudo)
+
+				actor, _ = app.CustomGovKeeper.GetNetworkActorByAddress(ctx, addr)
+				return actor
+			},
+			expectedWeight: sdk.NewDec(5),
+		},
+		{
+			name: "actor without roles",
+			mode: types.VoteWeightModeRole,
+			prepareActor: func(app *simapp.SimApp, ctx sdk.Context, addr sdk.AccAddress) types.NetworkActor {
+				return types.NewDefaultActor(addr)
+			},
+			expectedWeight: sdk.OneDec(),
+		},
+		{
+			name: "councilor",
+			mode: types.VoteWeightModeCouncilor,
+			prepareActor: func(app *simapp.SimApp, ctx sdk.Context, addr sdk.AccAddress) types.NetworkActor {
+				err := app.CustomGovKeeper.SetNetworkProperty(ctx, types.CouncilorVoteWeight, 3)
+				require.NoError(t, err)
+
+				app.CustomGovKeeper.SaveCouncilor(ctx, types.NewCouncilor("moniker", "website", "social", "identity", addr, types.CouncilorActive, ctx.BlockTime()))
+				return types.NewDefaultActor(addr)
+			},
+			expectedWeight: sdk.NewDec(3),
+		},
+		{
+			name: "not a councilor",
+			mode: types.VoteWeightModeCouncilor,
+			prepareActor: func(app *simapp.SimApp, ctx sdk.Context, addr sdk.AccAddress) types.NetworkActor {
+				err := app.CustomGovKeeper.SetNetworkProperty(ctx, types.CouncilorVoteWeight, 3)
+				require.NoError(t, err)
+
+				return types.NewDefaultActor(addr)
+			},
+			expectedWeight: sdk.OneDec(),
+		},
+		{
+			name: "bonded tokens",
+			mode: types.VoteWeightModeBonded,
+			prepareActor: func(app *simapp.SimApp, ctx sdk.Context, addr sdk.AccAddress) types.NetworkActor {
+				pubKey := simapp.CreateTestPubKeys(1)[0]
+				validator, err := stakingtypes.NewValidator("validator", "some-web.com", "A Social", "My Identity", sdk.ZeroDec(), sdk.ValAddress(addr), pubKey)
+				require.NoError(t, err)
+				app.CustomStakingKeeper.AddValidator(ctx, validator)
+
+				err = app.CustomStakingKeeper.Delegate(ctx, addr, validator.ValKey, sdk.NewInt(400))
+				require.NoError(t, err)
+
+				return types.NewDefaultActor(addr)
+			},
+			expectedWeight: sdk.NewDec(400),
+		},
+		{
+			name: "nothing bonded",
+			mode: types.VoteWeightModeBonded,
+			prepareActor: func(app *simapp.SimApp, ctx sdk.Context, addr sdk.AccAddress) types.NetworkActor {
+				return types.NewDefaultActor(addr)
+			},
+			expectedWeight: sdk.ZeroDec(),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			ctx := app.NewContext(false, tmproto.Header{})
+
+			addr := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(1000))[0]
+
+			err := app.CustomGovKeeper.SetNetworkProperty(ctx, types.VoteWeightMode, tt.mode)
+			require.NoError(t, err)
+
+			actor := tt.prepareActor(app, ctx, addr)
+			require.Equal(t, tt.expectedWeight, app.CustomGovKeeper.GetVoterWeight(ctx, actor))
+		})
+	}
+}
@@ -3,6 +3,7 @@ package gov
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
@@ -12,6 +13,7 @@ import (
 	customgovtypes "github.com/KiraCore/sekai/x/gov/types"
 
 	"github.com/KiraCore/sekai/middleware"
+	kiratypes "github.com/KiraCore/sekai/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -23,8 +25,9 @@ import (
 )
 
 var (
-	_ module.AppModule      = AppModule{}
-	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.AppModule        = AppModule{}
+	_ module.AppModuleBasic   = AppModuleBasic{}
+	_ kiratypes.HasMigrations = AppModule{}
 )
 
 type AppModuleBasic struct{}
@@ -46,7 +49,12 @@ func (b AppModuleBasic) DefaultGenesis(cdc codec.JSONMarshaler) json.RawMessage
 }
 
 func (b AppModuleBasic) ValidateGenesis(marshaler codec.JSONMarshaler, config client.TxEncodingConfig, message json.RawMessage) error {
-	return nil
+	var genesisState customgovtypes.GenesisState
+	if err := marshaler.UnmarshalJSON(message, &genesisState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", customgovtypes.ModuleName, err)
+	}
+
+	return genesisState.Validate()
 }
 
 func (b AppModuleBasic) RegisterRESTRoutes(context client.Context, router *mux.Router) {}
@@ -69,16 +77,26 @@ func (b AppModuleBasic) GetQueryCmd() *cobra.Command {
 	}
 	queryCmd.AddCommand(
 		customgovcli.GetCmdQueryPermissions(),
+		customgovcli.GetCmdQueryPermissionGrants(),
+		customgovcli.GetCmdQueryAuthorizations(),
+		customgovcli.GetCmdQueryGroup(),
+		customgovcli.GetCmdQueryGroupProposals(),
 		customgovcli.GetCmdQueryNetworkProperties(),
 		customgovcli.GetCmdQueryExecutionFee(),
 		customgovcli.GetCmdQueryPoorNetworkMessages(),
 		customgovcli.GetCmdQueryRolePermissions(),
+		customgovcli.GetCmdQueryAllRoles(),
+		customgovcli.GetCmdQueryRole(),
+		customgovcli.GetCmdQueryRoleActors(),
 		customgovcli.GetCmdQueryRolesByAddress(),
 		customgovcli.GetCmdQueryProposals(),
 		customgovcli.GetCmdQueryCouncilRegistry(),
 		customgovcli.GetCmdQueryProposal(),
 		customgovcli.GetCmdQueryVote(),
+		customgovcli.GetCmdQueryVoteHistory(),
 		customgovcli.GetCmdQueryVotes(),
+		customgovcli.GetCmdQueryTally(),
+		customgovcli.GetCmdQueryDeposit(),
 		customgovcli.GetCmdQueryWhitelistedProposalVoters(),
 	)
 
@@ -104,6 +122,11 @@ func (am AppModule) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	customgovtypes.RegisterInterfaces(registry)
 }
 
+// RegisterMigrations registers the store migrations of the module by upgrade.
+func (am AppModule) RegisterMigrations(registry *kiratypes.MigrationRegistry) {
+	registry.Register(kiratypes.UpgradeV0119, customgovtypes.ModuleName, am.customGovKeeper.MigrateV0119)
+}
+
 func (am AppModule) InitGenesis(
 	ctx sdk.Context,
 	cdc codec.JSONMarshaler,
@@ -112,55 +135,14 @@ func (am AppModule) InitGenesis(
 	var genesisState customgovtypes.GenesisState
 	cdc.MustUnmarshalJSON(data, &genesisState)
 
-	for _, actor := range genesisState.NetworkActors {
-		am.customGovKeeper.SaveNetworkActor(ctx, *actor)
-		for _, role := range actor.Roles {
-			am.customGovKeeper.AssignRoleToActor(ctx, *actor, customgovtypes.Role(role))
-		}
-		for _, perm := range actor.Permissions.Whitelist {
-			err := am.customGovKeeper.AddWhitelistPermission(ctx, *actor, customgovtypes.PermValue(perm))
-			if err != nil {
-				panic(err)
-			}
-		}
-		// TODO when we add keeper function for managing blacklist mapping, we can just enable this
-		// for _, perm := range actor.Permissions.Blacklist {
-		// 	am.customGovKeeper.RemoveWhitelistPermission(ctx, *actor, customgovtypes.PermValue(perm))
-		// }
-	}
-
-	for index, perm := range genesisState.Permissions {
-		role := customgovtypes.Role(index)
-		am.customGovKeeper.CreateRole(ctx, role)
-		for _, white := range perm.Whitelist {
-			err := am.customGovKeeper.WhitelistRolePermission(ctx, role, customgovtypes.PermValue(white))
-			if err != nil {
-				panic(err)
-			}
-		}
-		for _, black := range perm.Blacklist {
-			err := am.customGovKeeper.BlacklistRolePermission(ctx, role, customgovtypes.PermValue(black))
-			if err != nil {
-				panic(err)
-			}
-		}
-	}
-
-	am.customGovKeeper.SaveProposalID(ctx, genesisState.StartingProposalId)
-
-	am.customGovKeeper.SetNetworkProperties(ctx, genesisState.NetworkProperties)
-
-	for _, fee := range genesisState.ExecutionFees {
-		am.customGovKeeper.SetExecutionFee(ctx, fee)
-	}
-
-	am.customGovKeeper.SavePoorNetworkMsgs(ctx, genesisState.PoorNetworkMessages)
+	InitGenesis(ctx, am.customGovKeeper, genesisState)
 
 	return nil
 }
 
-func (am AppModule) ExportGenesis(context sdk.Context, marshaler codec.JSONMarshaler) json.RawMessage {
-	return nil
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) json.RawMessage {
+	gs := ExportGenesis(ctx, am.customGovKeeper)
+	return cdc.MustMarshalJSON(gs)
 }
 
 func (am AppModule) RegisterInvariants(registry sdk.InvariantRegistry) {}
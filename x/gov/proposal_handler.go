@@ -1,6 +1,8 @@
 package gov
 
 import (
+	"fmt"
+
 	"github.com/KiraCore/sekai/x/gov/keeper"
 	"github.com/KiraCore/sekai/x/gov/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -99,6 +101,10 @@ func (c CreateRoleProposalHandler) ProposalType() string {
 
 func (c CreateRoleProposalHandler) Apply(ctx sdk.Context, proposal types.Content) {
 	p := proposal.(*types.CreateRoleProposal)
+	err := c.keeper.SetRoleInfo(ctx, types.NewRoleInfo(types.Role(p.Role), p.Sid, p.Description, false))
+	if err != nil {
+		panic(err)
+	}
 	c.keeper.CreateRole(ctx, types.Role(p.Role))
 
 	for _, w := range p.WhitelistedPermissions {
@@ -119,3 +125,127 @@ func (c CreateRoleProposalHandler) Apply(ctx sdk.Context, proposal types.Content
 func NewApplyCreateRoleProposalHandler(keeper keeper.Keeper) *CreateRoleProposalHandler {
 	return &CreateRoleProposalHandler{keeper: keeper}
 }
+
+type SetRoleVoteWeightProposalHandler struct {
+	keeper keeper.Keeper
+}
+
+func NewApplySetRoleVoteWeightProposalHandler(keeper keeper.Keeper) *SetRoleVoteWeightProposalHandler {
+	return &SetRoleVoteWeightProposalHandler{keeper: keeper}
+}
+
+func (s SetRoleVoteWeightProposalHandler) ProposalType() string {
+	return types.SetRoleVoteWeightProposalType
+}
+
+func (s SetRoleVoteWeightProposalHandler) Apply(ctx sdk.Context, proposal types.Content) {
+	p := proposal.(*types.SetRoleVoteWeightProposal)
+	s.keeper.SetRoleVoteWeight(ctx, types.Role(p.Role), p.Weight)
+}
+
+type ApplyParameterChangeProposalHandler struct {
+	keeper keeper.Keeper
+}
+
+func NewApplyParameterChangeProposalHandler(keeper keeper.Keeper) *ApplyParameterChangeProposalHandler {
+	return &ApplyParameterChangeProposalHandler{keeper: keeper}
+}
+
+func (a ApplyParameterChangeProposalHandler) ProposalType() string {
+	return types.ParameterChangeProposalType
+}
+
+func (a ApplyParameterChangeProposalHandler) Apply(ctx sdk.Context, proposal types.Content) {
+	p := proposal.(*types.ParameterChangeProposal)
+
+	// the changes are written only once all of them are applied
+	cacheCtx, write := ctx.CacheContext()
+	for _, change := range p.Changes {
+		err := a.keeper.ApplyParamChange(cacheCtx, change)
+		if err != nil {
+			panic(fmt.Sprintf("error applying parameter change: %s", err))
+		}
+	}
+
+	write()
+}
+
+type ApplySoftwareUpgradeProposalHandler struct {
+	keeper types.UpgradeKeeper
+}
+
+func NewApplySoftwareUpgradeProposalHandler(keeper types.UpgradeKeeper) *ApplySoftwareUpgradeProposalHandler {
+	return &ApplySoftwareUpgradeProposalHandler{keeper: keeper}
+}
+
+func (a ApplySoftwareUpgradeProposalHandler) ProposalType() string {
+	return types.SoftwareUpgradeProposalType
+}
+
+func (a ApplySoftwareUpgradeProposalHandler) Apply(ctx sdk.Context, proposal types.Content) {
+	p := proposal.(*types.SoftwareUpgradeProposal)
+
+	err := a.keeper.ScheduleUpgrade(ctx, p.Plan)
+	if err != nil {
+		panic(fmt.Sprintf("error scheduling software upgrade: %s", err))
+	}
+}
+
+type ApplyCancelSoftwareUpgradeProposalHandler struct {
+	keeper types.UpgradeKeeper
+}
+
+func NewApplyCancelSoftwareUpgradeProposalHandler(keeper types.UpgradeKeeper) *ApplyCancelSoftwareUpgradeProposalHandler {
+	return &ApplyCancelSoftwareUpgradeProposalHandler{keeper: keeper}
+}
+
+func (a ApplyCancelSoftwareUpgradeProposalHandler) ProposalType() string {
+	return types.CancelSoftwareUpgradeProposalType
+}
+
+func (a ApplyCancelSoftwareUpgradeProposalHandler) Apply(ctx sdk.Context, proposal types.Content) {
+	a.keeper.ClearUpgradePlan(ctx)
+}
+
+type ApplyRemoveCouncilorProposalHandler struct {
+	keeper keeper.Keeper
+}
+
+func NewApplyRemoveCouncilorProposalHandler(keeper keeper.Keeper) *ApplyRemoveCouncilorProposalHandler {
+	return &ApplyRemoveCouncilorProposalHandler{keeper: keeper}
+}
+
+func (a ApplyRemoveCouncilorProposalHandler) ProposalType() string {
+	return types.RemoveCouncilorProposalType
+}
+
+func (a ApplyRemoveCouncilorProposalHandler) Apply(ctx sdk.Context, proposal types.Content) {
+	p := proposal.(*types.RemoveCouncilorProposal)
+
+	councilor, found := a.keeper.GetCouncilor(ctx, p.Address)
+	if !found {
+		panic(fmt.Sprintf("councilor %s not found", p.Address.String()))
+	}
+
+	a.keeper.DeleteCouncilor(ctx, councilor)
+
+	// the removed councilor can not claim its seat back
+	actor, found := a.keeper.GetNetworkActorByAddress(ctx, p.Address)
+	if !found {
+		actor = types.NewDefaultActor(p.Address)
+	}
+
+	if actor.Permissions.IsWhitelisted(types.PermClaimCouncilor) {
+		err := a.keeper.RemoveWhitelistPermission(ctx, actor, types.PermClaimCouncilor)
+		if err != nil {
+			panic(err)
+		}
+		actor, _ = a.keeper.GetNetworkActorByAddress(ctx, p.Address)
+	}
+
+	err := actor.Permissions.AddToBlacklist(types.PermClaimCouncilor)
+	if err != nil {
+		panic(err)
+	}
+	a.keeper.SaveNetworkActor(ctx, actor)
+}
@@ -1,6 +1,8 @@
 package gov
 
 import (
+	"fmt"
+
 	"github.com/KiraCore/sekai/x/gov/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -18,7 +20,32 @@ func NewProposalRouter(handlers []ProposalHandler) ProposalRouter {
 	return ProposalRouter{routes: routes}
 }
 
-func (r ProposalRouter) ApplyProposal(ctx sdk.Context, proposal types.Content) {
+// ApplyProposal applies the content of a passed proposal on a cached context which is only written once
+// the content is applied. The contents batched in a MultiContentProposal are all applied on the same cached
+// context, if any of them fails none of them is applied and an error is returned.
+func (r ProposalRouter) ApplyProposal(ctx sdk.Context, proposal types.Content) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("error applying %s proposal: %v", proposal.ProposalType(), rec)
+		}
+	}()
+
+	contents := []types.Content{proposal}
+	if multi, ok := proposal.(*types.MultiContentProposal); ok {
+		contents = multi.UnpackedContents()
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	for _, content := range contents {
+		r.applyContent(cacheCtx, content)
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
+
+func (r ProposalRouter) applyContent(ctx sdk.Context, proposal types.Content) {
 	h, ok := r.routes[proposal.ProposalType()]
 	if !ok {
 		panic("invalid proposal type")
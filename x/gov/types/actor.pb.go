@@ -9,15 +9,19 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -212,6 +216,10 @@ type MsgWhitelistPermissions struct {
 	Proposer   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty"`
 	Address    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty" yaml:"address"`
 	Permission uint32                                        `protobuf:"varint,3,opt,name=permission,proto3" json:"permission,omitempty"`
+	// expiry_height is the block height from which the permission is removed, 0 never expires.
+	ExpiryHeight uint64 `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// expiry_time is the block time from which the permission is removed, unset never expires.
+	ExpiryTime *time.Time `protobuf:"bytes,5,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty"`
 }
 
 func (m *MsgWhitelistPermissions) Reset()         { *m = MsgWhitelistPermissions{} }
@@ -268,6 +276,20 @@ func (m *MsgWhitelistPermissions) GetPermission() uint32 {
 	return 0
 }
 
+func (m *MsgWhitelistPermissions) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *MsgWhitelistPermissions) GetExpiryTime() *time.Time {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
+}
+
 type MsgBlacklistPermissions struct {
 	Proposer   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty"`
 	Address    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty" yaml:"address"`
@@ -328,56 +350,354 @@ func (m *MsgBlacklistPermissions) GetPermission() uint32 {
 	return 0
 }
 
+// PermissionExpiry is the expiry of a permission whitelisted to a network actor, the permission is removed
+// once the block height or the block time reaches the expiry.
+type PermissionExpiry struct {
+	Address      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty" yaml:"address"`
+	Permission   uint32                                        `protobuf:"varint,2,opt,name=permission,proto3" json:"permission,omitempty"`
+	ExpiryHeight uint64                                        `protobuf:"varint,3,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	ExpiryTime   *time.Time                                    `protobuf:"bytes,4,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty"`
+}
+
+func (m *PermissionExpiry) Reset()         { *m = PermissionExpiry{} }
+func (m *PermissionExpiry) String() string { return proto.CompactTextString(m) }
+func (*PermissionExpiry) ProtoMessage()    {}
+func (*PermissionExpiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a2698287ded216, []int{4}
+}
+func (m *PermissionExpiry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PermissionExpiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermissionExpiry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PermissionExpiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionExpiry.Merge(m, src)
+}
+func (m *PermissionExpiry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PermissionExpiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionExpiry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PermissionExpiry proto.InternalMessageInfo
+
+func (m *PermissionExpiry) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *PermissionExpiry) GetPermission() uint32 {
+	if m != nil {
+		return m.Permission
+	}
+	return 0
+}
+
+func (m *PermissionExpiry) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *PermissionExpiry) GetExpiryTime() *time.Time {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
+}
+
+// PermissionGrant is a permission delegated by an actor holding it to another address, the grant
+// is honored as long as the delegator holds the permission and the grant did not expire.
+type PermissionGrant struct {
+	Delegator    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator,omitempty"`
+	Delegatee    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=delegatee,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegatee,omitempty"`
+	Permission   uint32                                        `protobuf:"varint,3,opt,name=permission,proto3" json:"permission,omitempty"`
+	ExpiryHeight uint64                                        `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	ExpiryTime   *time.Time                                    `protobuf:"bytes,5,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty"`
+}
+
+func (m *PermissionGrant) Reset()         { *m = PermissionGrant{} }
+func (m *PermissionGrant) String() string { return proto.CompactTextString(m) }
+func (*PermissionGrant) ProtoMessage()    {}
+func (*PermissionGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a2698287ded216, []int{5}
+}
+func (m *PermissionGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PermissionGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermissionGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PermissionGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionGrant.Merge(m, src)
+}
+func (m *PermissionGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *PermissionGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PermissionGrant proto.InternalMessageInfo
+
+func (m *PermissionGrant) GetDelegator() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Delegator
+	}
+	return nil
+}
+
+func (m *PermissionGrant) GetDelegatee() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Delegatee
+	}
+	return nil
+}
+
+func (m *PermissionGrant) GetPermission() uint32 {
+	if m != nil {
+		return m.Permission
+	}
+	return 0
+}
+
+func (m *PermissionGrant) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *PermissionGrant) GetExpiryTime() *time.Time {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
+}
+
+type MsgDelegatePermission struct {
+	Delegator    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator,omitempty"`
+	Delegatee    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=delegatee,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegatee,omitempty"`
+	Permission   uint32                                        `protobuf:"varint,3,opt,name=permission,proto3" json:"permission,omitempty"`
+	ExpiryHeight uint64                                        `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	ExpiryTime   *time.Time                                    `protobuf:"bytes,5,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty"`
+}
+
+func (m *MsgDelegatePermission) Reset()         { *m = MsgDelegatePermission{} }
+func (m *MsgDelegatePermission) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegatePermission) ProtoMessage()    {}
+func (*MsgDelegatePermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a2698287ded216, []int{6}
+}
+func (m *MsgDelegatePermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegatePermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegatePermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegatePermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegatePermission.Merge(m, src)
+}
+func (m *MsgDelegatePermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegatePermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegatePermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegatePermission proto.InternalMessageInfo
+
+func (m *MsgDelegatePermission) GetDelegator() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Delegator
+	}
+	return nil
+}
+
+func (m *MsgDelegatePermission) GetDelegatee() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Delegatee
+	}
+	return nil
+}
+
+func (m *MsgDelegatePermission) GetPermission() uint32 {
+	if m != nil {
+		return m.Permission
+	}
+	return 0
+}
+
+func (m *MsgDelegatePermission) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *MsgDelegatePermission) GetExpiryTime() *time.Time {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
+}
+
+type MsgRevokePermission struct {
+	Delegator  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator,omitempty"`
+	Delegatee  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=delegatee,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegatee,omitempty"`
+	Permission uint32                                        `protobuf:"varint,3,opt,name=permission,proto3" json:"permission,omitempty"`
+}
+
+func (m *MsgRevokePermission) Reset()         { *m = MsgRevokePermission{} }
+func (m *MsgRevokePermission) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokePermission) ProtoMessage()    {}
+func (*MsgRevokePermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a2698287ded216, []int{7}
+}
+func (m *MsgRevokePermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokePermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokePermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokePermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokePermission.Merge(m, src)
+}
+func (m *MsgRevokePermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokePermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokePermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokePermission proto.InternalMessageInfo
+
+func (m *MsgRevokePermission) GetDelegator() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Delegator
+	}
+	return nil
+}
+
+func (m *MsgRevokePermission) GetDelegatee() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Delegatee
+	}
+	return nil
+}
+
+func (m *MsgRevokePermission) GetPermission() uint32 {
+	if m != nil {
+		return m.Permission
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("kira.gov.ActorStatus", ActorStatus_name, ActorStatus_value)
 	proto.RegisterType((*Permissions)(nil), "kira.gov.Permissions")
 	proto.RegisterType((*NetworkActor)(nil), "kira.gov.NetworkActor")
 	proto.RegisterType((*MsgWhitelistPermissions)(nil), "kira.gov.MsgWhitelistPermissions")
 	proto.RegisterType((*MsgBlacklistPermissions)(nil), "kira.gov.MsgBlacklistPermissions")
+	proto.RegisterType((*PermissionExpiry)(nil), "kira.gov.PermissionExpiry")
+	proto.RegisterType((*PermissionGrant)(nil), "kira.gov.PermissionGrant")
+	proto.RegisterType((*MsgDelegatePermission)(nil), "kira.gov.MsgDelegatePermission")
+	proto.RegisterType((*MsgRevokePermission)(nil), "kira.gov.MsgRevokePermission")
 }
 
 func init() { proto.RegisterFile("actor.proto", fileDescriptor_93a2698287ded216) }
 
 var fileDescriptor_93a2698287ded216 = []byte{
-	// 597 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x94, 0x3f, 0x6b, 0xdb, 0x4e,
-	0x18, 0xc7, 0x7d, 0xb6, 0xec, 0x38, 0xe7, 0xc4, 0x98, 0x23, 0xbf, 0x5f, 0x85, 0x28, 0x8a, 0x30,
-	0x14, 0x4c, 0x20, 0x36, 0x4d, 0x87, 0x42, 0x96, 0xa2, 0x58, 0x2a, 0x28, 0x8d, 0x9d, 0xa0, 0x34,
-	0x29, 0x14, 0x3a, 0x5c, 0xa4, 0xab, 0x72, 0xe8, 0xcf, 0x09, 0xdd, 0xc5, 0x69, 0xde, 0x41, 0xf1,
-	0xd4, 0x37, 0x60, 0x28, 0x74, 0xec, 0x1b, 0xe9, 0x52, 0xc8, 0xd8, 0x29, 0x94, 0x64, 0x68, 0x97,
-	0x2e, 0x1d, 0x3b, 0x15, 0xeb, 0xfc, 0x47, 0x6b, 0x87, 0x2e, 0x9d, 0x7c, 0xf7, 0xfd, 0x3e, 0xcf,
-	0xc3, 0xf3, 0x7c, 0x9e, 0xb3, 0x60, 0x03, 0x7b, 0x82, 0x65, 0xdd, 0x34, 0x63, 0x82, 0xa1, 0x7a,
-	0x48, 0x33, 0xdc, 0x0d, 0xd8, 0x48, 0xdb, 0x08, 0x58, 0xc0, 0x72, 0xb1, 0x37, 0x3d, 0x49, 0x5f,
-	0x6b, 0xa6, 0x19, 0x4b, 0x19, 0xc7, 0x91, 0xbc, 0xb7, 0x1d, 0xd8, 0x38, 0x22, 0x59, 0x4c, 0x39,
-	0xa7, 0x2c, 0xe1, 0xe8, 0x3e, 0x5c, 0x3d, 0x8b, 0xb0, 0x17, 0x46, 0x94, 0x0b, 0x15, 0x18, 0x95,
-	0xce, 0xba, 0xbb, 0x14, 0xa6, 0xee, 0xe5, 0x39, 0x15, 0x24, 0x77, 0xcb, 0xd2, 0x5d, 0x08, 0xed,
-	0x8f, 0x65, 0xb8, 0x36, 0x24, 0xe2, 0x92, 0x65, 0xa1, 0x39, 0xed, 0x08, 0xbd, 0x82, 0x2b, 0xd8,
-	0xf7, 0x33, 0xc2, 0xb9, 0x0a, 0x0c, 0xd0, 0x59, 0xdb, 0xeb, 0xff, 0xbc, 0xd9, 0x6c, 0x5e, 0xe1,
-	0x38, 0xda, 0x6d, 0xcf, 0x8c, 0xf6, 0xaf, 0x9b, 0xcd, 0xed, 0x80, 0x8a, 0xf3, 0x8b, 0xb3, 0xae,
-	0xc7, 0xe2, 0x9e, 0xc7, 0x78, 0xcc, 0xf8, 0xec, 0x67, 0x9b, 0xfb, 0x61, 0x4f, 0x5c, 0xa5, 0x84,
-	0x77, 0x4d, 0xcf, 0x33, 0x65, 0x86, 0x3b, 0xaf, 0x89, 0x36, 0x60, 0x35, 0x63, 0x11, 0xe1, 0x79,
-	0x27, 0x8a, 0x2b, 0x2f, 0x68, 0x1b, 0xd6, 0xb8, 0xc0, 0xe2, 0x82, 0xab, 0x15, 0x03, 0x74, 0x9a,
-	0x3b, 0xff, 0x75, 0xe7, 0x44, 0xba, 0x79, 0x57, 0xc7, 0xb9, 0xe9, 0xce, 0x82, 0xd0, 0x16, 0xac,
-	0x8e, 0x98, 0x20, 0x5c, 0x55, 0x8c, 0x4a, 0xa7, 0xb9, 0xb3, 0xb1, 0x8c, 0x3e, 0x65, 0x82, 0x1c,
-	0xa6, 0x82, 0xb2, 0xc4, 0x95, 0x21, 0xe8, 0x31, 0x6c, 0xa4, 0x4b, 0x56, 0x6a, 0xd5, 0x00, 0x9d,
-	0x46, 0xb1, 0x7e, 0x01, 0xa4, 0x5b, 0x8c, 0x44, 0x08, 0x2a, 0x3c, 0xa4, 0x89, 0x5a, 0x33, 0x40,
-	0x47, 0x71, 0xf3, 0x73, 0xfb, 0x07, 0x80, 0xf7, 0x06, 0x3c, 0x78, 0x31, 0xc7, 0x57, 0xdc, 0xc2,
-	0x00, 0xd6, 0xe5, 0x9a, 0x48, 0x36, 0x23, 0xf7, 0xf0, 0xcf, 0x39, 0x2d, 0x4a, 0x14, 0xf7, 0x50,
-	0xfe, 0x0b, 0x7b, 0xd0, 0x21, 0x5c, 0x0e, 0x9b, 0x53, 0x5f, 0x77, 0x0b, 0xca, 0xae, 0xf2, 0xfd,
-	0xfd, 0x26, 0x68, 0x7f, 0x93, 0xf3, 0xee, 0xcd, 0x1f, 0xd3, 0x3f, 0x3b, 0xef, 0xd6, 0x67, 0x00,
-	0x1b, 0x85, 0xa7, 0x36, 0xfd, 0xd7, 0x9c, 0x0c, 0x2d, 0xfb, 0xa9, 0x33, 0xb4, 0xad, 0x56, 0x49,
-	0x5b, 0x1f, 0x4f, 0x8c, 0xd5, 0x93, 0xc4, 0x27, 0xaf, 0x69, 0x42, 0x7c, 0xe9, 0xf6, 0x0f, 0x4c,
-	0x67, 0x60, 0x5b, 0x2d, 0x30, 0x77, 0xbd, 0x08, 0xd3, 0x98, 0xf8, 0xe8, 0x7f, 0x58, 0x33, 0xfb,
-	0xcf, 0x9d, 0x53, 0xbb, 0x55, 0xd6, 0xe0, 0x78, 0x62, 0xd4, 0x4c, 0x4f, 0xd0, 0x11, 0x99, 0xea,
-	0x47, 0xe6, 0xc9, 0xb1, 0x6d, 0xb5, 0x2a, 0x52, 0x3f, 0xc2, 0x17, 0x9c, 0xf8, 0x48, 0x83, 0x75,
-	0x67, 0x38, 0xcb, 0x50, 0xb4, 0xb5, 0xf1, 0xc4, 0xa8, 0x3b, 0x09, 0x5e, 0xe4, 0xec, 0x9b, 0xce,
-	0x81, 0x6d, 0xb5, 0xaa, 0x32, 0x67, 0x1f, 0xd3, 0x88, 0xf8, 0x48, 0x85, 0x2b, 0xae, 0x3d, 0x38,
-	0x3c, 0xb5, 0xad, 0x56, 0x4d, 0x6b, 0x8c, 0x27, 0xc6, 0x8a, 0x4b, 0x62, 0x36, 0x22, 0xbe, 0xa6,
-	0xbc, 0xfd, 0xa0, 0x97, 0xf6, 0x9e, 0x7c, 0xba, 0xd5, 0xc1, 0xf5, 0xad, 0x0e, 0xbe, 0xde, 0xea,
-	0xe0, 0xdd, 0x9d, 0x5e, 0xba, 0xbe, 0xd3, 0x4b, 0x5f, 0xee, 0xf4, 0xd2, 0xcb, 0x07, 0x05, 0x82,
-	0xcf, 0x68, 0x86, 0xfb, 0x2c, 0x23, 0x3d, 0x4e, 0x42, 0x4c, 0x7b, 0x6f, 0x7a, 0x01, 0x1b, 0x49,
-	0x88, 0x67, 0xb5, 0xfc, 0x53, 0xf3, 0xe8, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5e, 0xce, 0x1c,
-	0xd5, 0xa9, 0x04, 0x00, 0x00,
+	// 767 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x95, 0x4d, 0x8b, 0xeb, 0x54,
+	0x18, 0xc7, 0x7b, 0xd2, 0xb4, 0xd3, 0x39, 0x7d, 0xb1, 0xc4, 0xb9, 0x1a, 0x82, 0xa4, 0x21, 0x22,
+	0x94, 0x0b, 0x93, 0xe0, 0x75, 0x21, 0xdc, 0x8d, 0xa4, 0x6d, 0xd4, 0x5e, 0x6f, 0x3b, 0x43, 0xee,
+	0x9d, 0x11, 0x04, 0x91, 0xd3, 0xe4, 0xdc, 0xf4, 0xd0, 0xa4, 0x27, 0xe4, 0x9c, 0xf6, 0xde, 0xf9,
+	0x06, 0xd2, 0xd5, 0x7c, 0x81, 0x82, 0xe0, 0xd2, 0x8f, 0xe0, 0xca, 0x9d, 0x1b, 0x61, 0x36, 0xa2,
+	0xab, 0x51, 0x66, 0x16, 0xba, 0x76, 0xa7, 0x2b, 0x69, 0x92, 0x4e, 0xe2, 0x6a, 0x1c, 0x86, 0x01,
+	0x19, 0x5c, 0x25, 0xe7, 0xff, 0xbc, 0xf0, 0x9c, 0xdf, 0xff, 0x09, 0x81, 0x75, 0xe4, 0x72, 0x1a,
+	0x1b, 0x51, 0x4c, 0x39, 0x95, 0x6a, 0x33, 0x12, 0x23, 0xc3, 0xa7, 0x4b, 0x65, 0xcf, 0xa7, 0x3e,
+	0x4d, 0x44, 0x73, 0xf3, 0x96, 0xc6, 0x95, 0x8e, 0x4f, 0xa9, 0x1f, 0x60, 0x33, 0x39, 0x4d, 0x16,
+	0x2f, 0x4c, 0x4e, 0x42, 0xcc, 0x38, 0x0a, 0xa3, 0x2c, 0xa1, 0x15, 0xc5, 0x34, 0xa2, 0x0c, 0x05,
+	0xe9, 0x59, 0x1f, 0xc2, 0xfa, 0x21, 0x8e, 0x43, 0xc2, 0x18, 0xa1, 0x73, 0x26, 0xbd, 0x05, 0x77,
+	0x27, 0x01, 0x72, 0x67, 0x01, 0x61, 0x5c, 0x06, 0x5a, 0xb9, 0xdb, 0x74, 0x72, 0x61, 0x13, 0x7d,
+	0x39, 0x25, 0x1c, 0x27, 0x51, 0x21, 0x8d, 0x5e, 0x09, 0xfa, 0x37, 0x02, 0x6c, 0x8c, 0x31, 0x7f,
+	0x49, 0xe3, 0x99, 0xb5, 0x19, 0x59, 0xfa, 0x1c, 0xee, 0x20, 0xcf, 0x8b, 0x31, 0x63, 0x32, 0xd0,
+	0x40, 0xb7, 0xd1, 0xeb, 0xff, 0x71, 0xde, 0x69, 0x9d, 0xa0, 0x30, 0x78, 0xac, 0x67, 0x01, 0xfd,
+	0xaf, 0xf3, 0xce, 0xbe, 0x4f, 0xf8, 0x74, 0x31, 0x31, 0x5c, 0x1a, 0x9a, 0x2e, 0x65, 0x21, 0x65,
+	0xd9, 0x63, 0x9f, 0x79, 0x33, 0x93, 0x9f, 0x44, 0x98, 0x19, 0x96, 0xeb, 0x5a, 0x69, 0x85, 0xb3,
+	0xed, 0x29, 0xed, 0xc1, 0x4a, 0x4c, 0x03, 0xcc, 0x92, 0x49, 0x44, 0x27, 0x3d, 0x48, 0xfb, 0xb0,
+	0xca, 0x38, 0xe2, 0x0b, 0x26, 0x97, 0x35, 0xd0, 0x6d, 0x3d, 0x7a, 0x60, 0x6c, 0x91, 0x19, 0xc9,
+	0x54, 0xcf, 0x92, 0xa0, 0x93, 0x25, 0x49, 0x0f, 0x61, 0x65, 0x49, 0x39, 0x66, 0xb2, 0xa8, 0x95,
+	0xbb, 0xad, 0x47, 0x7b, 0x79, 0xf6, 0x31, 0xe5, 0xf8, 0x20, 0xe2, 0x84, 0xce, 0x9d, 0x34, 0x45,
+	0x7a, 0x1f, 0xd6, 0xa3, 0x9c, 0x95, 0x5c, 0xd1, 0x40, 0xb7, 0x5e, 0xec, 0x5f, 0x00, 0xe9, 0x14,
+	0x33, 0x25, 0x09, 0x8a, 0x6c, 0x46, 0xe6, 0x72, 0x55, 0x03, 0x5d, 0xd1, 0x49, 0xde, 0xf5, 0x1f,
+	0x05, 0xf8, 0xe6, 0x88, 0xf9, 0x9f, 0x6e, 0xf1, 0x15, 0x5d, 0x18, 0xc1, 0x5a, 0x6a, 0x13, 0x8e,
+	0x33, 0x72, 0xef, 0xde, 0x9c, 0xd3, 0x55, 0x8b, 0xa2, 0x0f, 0xc2, 0x1d, 0xf8, 0xa0, 0x42, 0x98,
+	0x5f, 0x36, 0xa1, 0xde, 0x74, 0x0a, 0x8a, 0xf4, 0x36, 0x6c, 0xe2, 0x57, 0x11, 0x89, 0x4f, 0xbe,
+	0x98, 0x62, 0xe2, 0x4f, 0xb9, 0x2c, 0x26, 0x18, 0x1a, 0xa9, 0xf8, 0x71, 0xa2, 0x49, 0x16, 0xac,
+	0x67, 0x49, 0x9b, 0x8d, 0xcd, 0xd8, 0x2a, 0x46, 0xba, 0xce, 0xc6, 0x76, 0x9d, 0x8d, 0xe7, 0xdb,
+	0x75, 0xee, 0x89, 0xa7, 0xbf, 0x74, 0x80, 0x03, 0xd3, 0xa2, 0x8d, 0xfc, 0x58, 0xfc, 0xfd, 0xab,
+	0x0e, 0xd0, 0x7f, 0x03, 0x09, 0xd7, 0xde, 0x76, 0x69, 0xef, 0x2d, 0x57, 0xfd, 0x4f, 0x00, 0xdb,
+	0xf9, 0xed, 0xec, 0x04, 0xc4, 0x5d, 0x7f, 0x73, 0xff, 0x9c, 0x49, 0xb8, 0xde, 0xeb, 0xf2, 0xf5,
+	0x5e, 0x8b, 0x37, 0xf7, 0x5a, 0xff, 0x56, 0x80, 0xaf, 0xe5, 0x77, 0xff, 0x28, 0x46, 0x73, 0x2e,
+	0x1d, 0xc0, 0x5d, 0x0f, 0x07, 0xd8, 0x47, 0x9c, 0xde, 0xc2, 0xde, 0xbc, 0x47, 0xa1, 0x21, 0xc6,
+	0xb2, 0x70, 0xdb, 0x86, 0x18, 0xff, 0x57, 0xbe, 0x14, 0xfd, 0x3b, 0x01, 0x3e, 0x18, 0x31, 0x7f,
+	0x90, 0x0d, 0x96, 0x83, 0xfc, 0x9f, 0xe1, 0xbf, 0x66, 0xf8, 0x13, 0x80, 0xaf, 0x8f, 0x98, 0xef,
+	0xe0, 0x25, 0x9d, 0xdd, 0x2b, 0x82, 0x0f, 0x7f, 0x00, 0xb0, 0x5e, 0xf8, 0x55, 0x6e, 0xfe, 0xfa,
+	0x47, 0xe3, 0x81, 0xfd, 0xe1, 0x70, 0x6c, 0x0f, 0xda, 0x25, 0xa5, 0xb9, 0x5a, 0x6b, 0xbb, 0x47,
+	0x73, 0x0f, 0xbf, 0x20, 0x73, 0xec, 0xa5, 0xd1, 0xfe, 0x53, 0x6b, 0x38, 0xb2, 0x07, 0x6d, 0xb0,
+	0x8d, 0xba, 0x01, 0x22, 0x21, 0xf6, 0xa4, 0x37, 0x60, 0xd5, 0xea, 0x3f, 0x1f, 0x1e, 0xdb, 0x6d,
+	0x41, 0x81, 0xab, 0xb5, 0x56, 0xb5, 0x5c, 0x4e, 0x96, 0x78, 0xa3, 0x1f, 0x5a, 0x47, 0xcf, 0xec,
+	0x41, 0xbb, 0x9c, 0xea, 0x87, 0x68, 0xc1, 0xb0, 0x27, 0x29, 0xb0, 0x36, 0x1c, 0x67, 0x15, 0xa2,
+	0xd2, 0x58, 0xad, 0xb5, 0xda, 0x70, 0x8e, 0xae, 0x6a, 0x9e, 0x58, 0xc3, 0xa7, 0xf6, 0xa0, 0x5d,
+	0x49, 0x6b, 0x9e, 0x20, 0x12, 0x60, 0x4f, 0x92, 0xe1, 0x8e, 0x63, 0x8f, 0x0e, 0x8e, 0xed, 0x41,
+	0xbb, 0xaa, 0xd4, 0x57, 0x6b, 0x6d, 0xc7, 0xc1, 0x21, 0x5d, 0x62, 0x4f, 0x11, 0xbf, 0xfc, 0x5a,
+	0x2d, 0xf5, 0x3e, 0xf8, 0xfe, 0x42, 0x05, 0x67, 0x17, 0x2a, 0xf8, 0xf5, 0x42, 0x05, 0xa7, 0x97,
+	0x6a, 0xe9, 0xec, 0x52, 0x2d, 0xfd, 0x7c, 0xa9, 0x96, 0x3e, 0x7b, 0xa7, 0xc0, 0xf0, 0x13, 0x12,
+	0xa3, 0x3e, 0x8d, 0xb1, 0xc9, 0xf0, 0x0c, 0x11, 0xf3, 0x95, 0xe9, 0xd3, 0x65, 0x8a, 0x71, 0x52,
+	0x4d, 0x16, 0xe2, 0xbd, 0xbf, 0x07, 0x00, 0xb8, 0xa7, 0x83, 0xc5, 0x8a, 0x09, 0x00, 0x00,
 }
 
 func (this *MsgWhitelistPermissions) Equal(that interface{}) bool {
@@ -408,6 +728,16 @@ func (this *MsgWhitelistPermissions) Equal(that interface{}) bool {
 	if this.Permission != that1.Permission {
 		return false
 	}
+	if this.ExpiryHeight != that1.ExpiryHeight {
+		return false
+	}
+	if that1.ExpiryTime == nil {
+		if this.ExpiryTime != nil {
+			return false
+		}
+	} else if !this.ExpiryTime.Equal(*that1.ExpiryTime) {
+		return false
+	}
 	return true
 }
 func (m *Permissions) Marshal() (dAtA []byte, err error) {
@@ -577,6 +907,21 @@ func (m *MsgWhitelistPermissions) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiryTime != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintActor(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintActor(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Permission != 0 {
 		i = encodeVarintActor(dAtA, i, uint64(m.Permission))
 		i--
@@ -641,45 +986,251 @@ func (m *MsgBlacklistPermissions) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintActor(dAtA []byte, offset int, v uint64) int {
-	offset -= sovActor(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PermissionExpiry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Permissions) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PermissionExpiry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionExpiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Blacklist) > 0 {
-		l = 0
-		for _, e := range m.Blacklist {
-			l += sovActor(uint64(e))
+	if m.ExpiryTime != nil {
+		n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err11 != nil {
+			return 0, err11
 		}
-		n += 1 + sovActor(uint64(l)) + l
+		i -= n11
+		i = encodeVarintActor(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Whitelist) > 0 {
-		l = 0
-		for _, e := range m.Whitelist {
-			l += sovActor(uint64(e))
-		}
-		n += 1 + sovActor(uint64(l)) + l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintActor(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.Permission != 0 {
+		i = encodeVarintActor(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintActor(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *NetworkActor) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *PermissionGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PermissionGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryTime != nil {
+		n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintActor(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintActor(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Permission != 0 {
+		i = encodeVarintActor(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Delegatee) > 0 {
+		i -= len(m.Delegatee)
+		copy(dAtA[i:], m.Delegatee)
+		i = encodeVarintActor(dAtA, i, uint64(len(m.Delegatee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintActor(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegatePermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegatePermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegatePermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryTime != nil {
+		n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintActor(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintActor(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Permission != 0 {
+		i = encodeVarintActor(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Delegatee) > 0 {
+		i -= len(m.Delegatee)
+		copy(dAtA[i:], m.Delegatee)
+		i = encodeVarintActor(dAtA, i, uint64(len(m.Delegatee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintActor(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokePermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokePermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokePermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Permission != 0 {
+		i = encodeVarintActor(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Delegatee) > 0 {
+		i -= len(m.Delegatee)
+		copy(dAtA[i:], m.Delegatee)
+		i = encodeVarintActor(dAtA, i, uint64(len(m.Delegatee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintActor(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintActor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovActor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Permissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blacklist) > 0 {
+		l = 0
+		for _, e := range m.Blacklist {
+			l += sovActor(uint64(e))
+		}
+		n += 1 + sovActor(uint64(l)) + l
+	}
+	if len(m.Whitelist) > 0 {
+		l = 0
+		for _, e := range m.Whitelist {
+			l += sovActor(uint64(e))
+		}
+		n += 1 + sovActor(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *NetworkActor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.Address)
 	if l > 0 {
@@ -729,6 +1280,13 @@ func (m *MsgWhitelistPermissions) Size() (n int) {
 	if m.Permission != 0 {
 		n += 1 + sovActor(uint64(m.Permission))
 	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovActor(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovActor(uint64(l))
+	}
 	return n
 }
 
@@ -752,6 +1310,103 @@ func (m *MsgBlacklistPermissions) Size() (n int) {
 	return n
 }
 
+func (m *PermissionExpiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovActor(uint64(l))
+	}
+	if m.Permission != 0 {
+		n += 1 + sovActor(uint64(m.Permission))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovActor(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovActor(uint64(l))
+	}
+	return n
+}
+
+func (m *PermissionGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovActor(uint64(l))
+	}
+	l = len(m.Delegatee)
+	if l > 0 {
+		n += 1 + l + sovActor(uint64(l))
+	}
+	if m.Permission != 0 {
+		n += 1 + sovActor(uint64(m.Permission))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovActor(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovActor(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelegatePermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovActor(uint64(l))
+	}
+	l = len(m.Delegatee)
+	if l > 0 {
+		n += 1 + l + sovActor(uint64(l))
+	}
+	if m.Permission != 0 {
+		n += 1 + sovActor(uint64(m.Permission))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovActor(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovActor(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokePermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovActor(uint64(l))
+	}
+	l = len(m.Delegatee)
+	if l > 0 {
+		n += 1 + l + sovActor(uint64(l))
+	}
+	if m.Permission != 0 {
+		n += 1 + sovActor(uint64(m.Permission))
+	}
+	return n
+}
+
 func sovActor(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1385,27 +2040,82 @@ func (m *MsgWhitelistPermissions) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipActor(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthActor
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthActor
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthActor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthActor
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthActor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
@@ -1549,6 +2259,697 @@ func (m *MsgBlacklistPermissions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PermissionExpiry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PermissionExpiry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PermissionExpiry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthActor
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthActor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthActor
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthActor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PermissionGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PermissionGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PermissionGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthActor
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = append(m.Delegator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegator == nil {
+				m.Delegator = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegatee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthActor
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegatee = append(m.Delegatee[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegatee == nil {
+				m.Delegatee = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthActor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthActor
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthActor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegatePermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegatePermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegatePermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthActor
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = append(m.Delegator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegator == nil {
+				m.Delegator = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegatee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthActor
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegatee = append(m.Delegatee[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegatee == nil {
+				m.Delegatee = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthActor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthActor
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthActor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokePermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokePermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokePermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthActor
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = append(m.Delegator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegator == nil {
+				m.Delegator = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegatee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthActor
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegatee = append(m.Delegatee[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegatee == nil {
+				m.Delegatee = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthActor
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthActor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipActor(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -16,6 +16,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	registerRolesCodec(cdc)
 	registerCouncilorCodec(cdc)
 	registerProposalCodec(cdc)
+	registerAuthorizationCodec(cdc)
+	registerGroupCodec(cdc)
 
 	cdc.RegisterConcrete(&MsgSetNetworkProperties{}, "kiraHub/MsgSetNetworkProperties", nil)
 	functionmeta.AddNewFunction((&MsgSetNetworkProperties{}).Type(), `{
@@ -79,7 +81,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 			},
 			"timeout": {
 				"type":        "uint64",
-				"description": "After what time function execution should fail"
+				"description": "Gas the function can consume before its execution fails and is charged the failure fee, 0 for no limit"
 			},
 			"default_parameters": {
 				"type":        "bool",
@@ -109,6 +111,14 @@ func registerProposalCodec(cdc *codec.LegacyAmino) {
 			"permission": {
 				"type":        "uint32",
 				"description": "Permission to be whitelisted."
+			},
+			"expiry_height": {
+				"type":        "uint64",
+				"description": "Block height from which the permission is removed, 0 never expires."
+			},
+			"expiry_time": {
+				"type":        "string",
+				"description": "Block time from which the permission is removed, unset never expires."
 			}
 		}
 	}`)
@@ -130,6 +140,90 @@ func registerProposalCodec(cdc *codec.LegacyAmino) {
 			}
 		}
 	}`)
+	cdc.RegisterConcrete(&MsgProposalSetRoleVoteWeight{}, "kiraHub/MsgProposalSetRoleVoteWeight", nil)
+	functionmeta.AddNewFunction((&MsgProposalSetRoleVoteWeight{}).Type(), `{
+		"description": "MsgProposalSetRoleVoteWeight defines a proposal message to set the vote weight of a role.",
+		"parameters": {
+			"proposer": {
+				"type":        "string",
+				"description": "proposer who propose this message."
+			},
+			"role": {
+				"type":        "uint32",
+				"description": "role identifier."
+			},
+			"weight": {
+				"type":        "string",
+				"description": "vote weight of the actors holding the role."
+			}
+		}
+	}`)
+	cdc.RegisterConcrete(&MsgProposalMultiContent{}, "kiraHub/MsgProposalMultiContent", nil)
+	functionmeta.AddNewFunction((&MsgProposalMultiContent{}).Type(), `{
+		"description": "MsgProposalMultiContent defines a proposal message batching several contents applied atomically.",
+		"parameters": {
+			"proposer": {
+				"type":        "string",
+				"description": "proposer who propose this message."
+			},
+			"contents": {
+				"type":        "array<Content>",
+				"description": "proposal contents to be applied together."
+			}
+		}
+	}`)
+	cdc.RegisterConcrete(&MsgProposalParameterChange{}, "kiraHub/MsgProposalParameterChange", nil)
+	functionmeta.AddNewFunction((&MsgProposalParameterChange{}).Type(), `{
+		"description": "MsgProposalParameterChange defines a proposal message to change the params of module param subspaces.",
+		"parameters": {
+			"proposer": {
+				"type":        "string",
+				"description": "proposer who propose this message."
+			},
+			"changes": {
+				"type":        "array<ParamChange>",
+				"description": "parameter changes, each made of the subspace, the key, the value type and the value."
+			}
+		}
+	}`)
+	cdc.RegisterConcrete(&MsgProposalSoftwareUpgrade{}, "kiraHub/MsgProposalSoftwareUpgrade", nil)
+	functionmeta.AddNewFunction((&MsgProposalSoftwareUpgrade{}).Type(), `{
+		"description": "MsgProposalSoftwareUpgrade defines a proposal message to schedule a software upgrade.",
+		"parameters": {
+			"proposer": {
+				"type":        "string",
+				"description": "proposer who propose this message."
+			},
+			"plan": {
+				"type":        "Plan",
+				"description": "upgrade plan made of the upgrade name, the height the chain halts at and the upgrade info."
+			}
+		}
+	}`)
+	cdc.RegisterConcrete(&MsgProposalCancelSoftwareUpgrade{}, "kiraHub/MsgProposalCancelSoftwareUpgrade", nil)
+	functionmeta.AddNewFunction((&MsgProposalCancelSoftwareUpgrade{}).Type(), `{
+		"description": "MsgProposalCancelSoftwareUpgrade defines a proposal message to cancel the scheduled software upgrade.",
+		"parameters": {
+			"proposer": {
+				"type":        "string",
+				"description": "proposer who propose this message."
+			}
+		}
+	}`)
+	cdc.RegisterConcrete(&MsgProposalRemoveCouncilor{}, "kiraHub/MsgProposalRemoveCouncilor", nil)
+	functionmeta.AddNewFunction((&MsgProposalRemoveCouncilor{}).Type(), `{
+		"description": "MsgProposalRemoveCouncilor defines a proposal message to remove a councilor from the council registry.",
+		"parameters": {
+			"proposer": {
+				"type":        "string",
+				"description": "proposer who propose this message."
+			},
+			"address": {
+				"type":        "string",
+				"description": "address of the councilor to remove."
+			}
+		}
+	}`)
 	cdc.RegisterConcrete(&MsgProposalUpsertDataRegistry{}, "kiraHub/MsgProposalUpsertDataRegistry", nil)
 	functionmeta.AddNewFunction((&MsgProposalUpsertDataRegistry{}).Type(), `{
 		"description": "MsgProposalUpsertDataRegistry defines a proposal message to upsert data registry.",
@@ -178,6 +272,20 @@ func registerProposalCodec(cdc *codec.LegacyAmino) {
 			}
 		}
 	}`)
+	cdc.RegisterConcrete(&MsgCancelProposal{}, "kiraHub/MsgCancelProposal", nil)
+	functionmeta.AddNewFunction((&MsgCancelProposal{}).Type(), `{
+		"description": "MsgCancelProposal defines a message to cancel a proposal in voting or in enactment.",
+		"parameters": {
+			"proposal_id": {
+				"type":        "uint64",
+				"description": "id of proposal to be cancelled."
+			},
+			"sender": {
+				"type":        "address",
+				"description": "the proposer while the proposal is in voting, a councilor while it is in enactment."
+			}
+		}
+	}`)
 }
 
 func registerCouncilorCodec(cdc *codec.LegacyAmino) {
@@ -246,6 +354,198 @@ func registerPermissionsCodec(cdc *codec.LegacyAmino) {
 			}
 		}
 	}`)
+	cdc.RegisterConcrete(&MsgDelegatePermission{}, "kiraHub/MsgDelegatePermission", nil)
+	functionmeta.AddNewFunction((&MsgDelegatePermission{}).Type(), `{
+		"description": "MsgDelegatePermission defines a message to delegate a permission held by the delegator to another address.",
+		"parameters": {
+			"delegator": {
+				"type":        "string",
+				"description": "Address holding the permission."
+			},
+			"delegatee": {
+				"type":        "string",
+				"description": "Address to delegate the permission to."
+			},
+			"permission": {
+				"type":        "uint32",
+				"description": "Permission to be delegated."
+			},
+			"expiry_height": {
+				"type":        "uint64",
+				"description": "Block height from which the grant is removed, 0 never expires."
+			},
+			"expiry_time": {
+				"type":        "string",
+				"description": "Block time from which the grant is removed, unset never expires."
+			}
+		}
+	}`)
+	cdc.RegisterConcrete(&MsgRevokePermission{}, "kiraHub/MsgRevokePermission", nil)
+	functionmeta.AddNewFunction((&MsgRevokePermission{}).Type(), `{
+		"description": "MsgRevokePermission defines a message to revoke a permission delegated to another address.",
+		"parameters": {
+			"delegator": {
+				"type":        "string",
+				"description": "Address which delegated the permission."
+			},
+			"delegatee": {
+				"type":        "string",
+				"description": "Address the permission was delegated to."
+			},
+			"permission": {
+				"type":        "uint32",
+				"description": "Permission to be revoked."
+			}
+		}
+	}`)
+}
+
+func registerAuthorizationCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgGrantAuthorization{}, "kiraHub/MsgGrantAuthorization", nil)
+	functionmeta.AddNewFunction((&MsgGrantAuthorization{}).Type(), `{
+		"description": "MsgGrantAuthorization defines a message to authorize a grantee to execute messages of a type on behalf of the granter.",
+		"parameters": {
+			"granter": {
+				"type":        "string",
+				"description": "Address whose messages are executed by the grantee."
+			},
+			"grantee": {
+				"type":        "string",
+				"description": "Address authorized to execute the messages."
+			},
+			"msg_type_url": {
+				"type":        "string",
+				"description": "Type url of the authorized messages, like /kira.gov.MsgVoteProposal."
+			},
+			"spend_limit": {
+				"type":        "array<Coin>",
+				"description": "Optional amount the grantee can send with bank sends."
+			},
+			"max_uses": {
+				"type":        "uint64",
+				"description": "Optional number of executions authorized."
+			},
+			"expiry_height": {
+				"type":        "uint64",
+				"description": "Block height from which the authorization can not be used, 0 never expires."
+			},
+			"expiry_time": {
+				"type":        "string",
+				"description": "Block time from which the authorization can not be used, unset never expires."
+			}
+		}
+	}`)
+	cdc.RegisterConcrete(&MsgRevokeAuthorization{}, "kiraHub/MsgRevokeAuthorization", nil)
+	functionmeta.AddNewFunction((&MsgRevokeAuthorization{}).Type(), `{
+		"description": "MsgRevokeAuthorization defines a message to revoke an authorization given to a grantee.",
+		"parameters": {
+			"granter": {
+				"type":        "string",
+				"description": "Address which gave the authorization."
+			},
+			"grantee": {
+				"type":        "string",
+				"description": "Address the authorization was given to."
+			},
+			"msg_type_url": {
+				"type":        "string",
+				"description": "Type url of the authorized messages."
+			}
+		}
+	}`)
+	cdc.RegisterConcrete(&MsgExec{}, "kiraHub/MsgExec", nil)
+	functionmeta.AddNewFunction((&MsgExec{}).Type(), `{
+		"description": "MsgExec defines a message to execute messages on behalf of their signers with the authorizations given to the grantee.",
+		"parameters": {
+			"grantee": {
+				"type":        "string",
+				"description": "Address executing the messages."
+			},
+			"msgs": {
+				"type":        "array<Any>",
+				"description": "Messages to execute, each signed by the granter on whose behalf it is executed."
+			}
+		}
+	}`)
+}
+
+func registerGroupCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreateGroup{}, "kiraHub/MsgCreateGroup", nil)
+	functionmeta.AddNewFunction((&MsgCreateGroup{}).Type(), `{
+		"description": "MsgCreateGroup defines a message to create a group account governed by its members.",
+		"parameters": {
+			"creator": {
+				"type":        "string",
+				"description": "Address creating the group."
+			},
+			"members": {
+				"type":        "array<GroupMember>",
+				"description": "Members of the group with the weight of their approvals."
+			},
+			"threshold": {
+				"type":        "uint64",
+				"description": "Weight of the approvals required to execute a group proposal."
+			},
+			"description": {
+				"type":        "string",
+				"description": "Description of the group."
+			}
+		}
+	}`)
+	cdc.RegisterConcrete(&MsgUpdateGroup{}, "kiraHub/MsgUpdateGroup", nil)
+	functionmeta.AddNewFunction((&MsgUpdateGroup{}).Type(), `{
+		"description": "MsgUpdateGroup defines a message to change the members and the threshold of a group, executed by a group proposal.",
+		"parameters": {
+			"group": {
+				"type":        "string",
+				"description": "Address of the group."
+			},
+			"members": {
+				"type":        "array<GroupMember>",
+				"description": "New members of the group with the weight of their approvals."
+			},
+			"threshold": {
+				"type":        "uint64",
+				"description": "Weight of the approvals required to execute a group proposal."
+			},
+			"description": {
+				"type":        "string",
+				"description": "Description of the group."
+			}
+		}
+	}`)
+	cdc.RegisterConcrete(&MsgSubmitGroupProposal{}, "kiraHub/MsgSubmitGroupProposal", nil)
+	functionmeta.AddNewFunction((&MsgSubmitGroupProposal{}).Type(), `{
+		"description": "MsgSubmitGroupProposal defines a message to propose messages signed by a group, the proposer approves them.",
+		"parameters": {
+			"proposer": {
+				"type":        "string",
+				"description": "Member of the group proposing the messages."
+			},
+			"group_id": {
+				"type":        "uint64",
+				"description": "Identifier of the group."
+			},
+			"msgs": {
+				"type":        "array<Any>",
+				"description": "Messages signed by the group, executed once approved."
+			}
+		}
+	}`)
+	cdc.RegisterConcrete(&MsgApproveGroupProposal{}, "kiraHub/MsgApproveGroupProposal", nil)
+	functionmeta.AddNewFunction((&MsgApproveGroupProposal{}).Type(), `{
+		"description": "MsgApproveGroupProposal defines a message to approve a group proposal, its messages are executed once the approvals reach the threshold.",
+		"parameters": {
+			"member": {
+				"type":        "string",
+				"description": "Member of the group approving the proposal."
+			},
+			"proposal_id": {
+				"type":        "uint64",
+				"description": "Identifier of the group proposal."
+			}
+		}
+	}`)
 }
 
 func registerRolesCodec(cdc *codec.LegacyAmino) {
@@ -260,6 +560,14 @@ func registerRolesCodec(cdc *codec.LegacyAmino) {
 			"role": {
 				"type":        "uint32",
 				"description": "Identifier of this role."
+			},
+			"sid": {
+				"type":        "string",
+				"description": "Unique string identifier of this role."
+			},
+			"description": {
+				"type":        "string",
+				"description": "Description of this role."
 			}
 		}
 	}`)
@@ -378,6 +686,17 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgWhitelistPermissions{},
 		&MsgBlacklistPermissions{},
+		&MsgDelegatePermission{},
+		&MsgRevokePermission{},
+
+		&MsgGrantAuthorization{},
+		&MsgRevokeAuthorization{},
+		&MsgExec{},
+
+		&MsgCreateGroup{},
+		&MsgUpdateGroup{},
+		&MsgSubmitGroupProposal{},
+		&MsgApproveGroupProposal{},
 
 		&MsgSetNetworkProperties{},
 		&MsgSetExecutionFee{},
@@ -398,7 +717,14 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgProposalUpsertDataRegistry{},
 		&MsgProposalSetPoorNetworkMessages{},
 		&MsgProposalCreateRole{},
+		&MsgProposalSetRoleVoteWeight{},
+		&MsgProposalParameterChange{},
+		&MsgProposalMultiContent{},
+		&MsgProposalSoftwareUpgrade{},
+		&MsgProposalCancelSoftwareUpgrade{},
+		&MsgProposalRemoveCouncilor{},
 		&MsgVoteProposal{},
+		&MsgCancelProposal{},
 	)
 
 	registry.RegisterInterface(
@@ -408,6 +734,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&SetNetworkPropertyProposal{},
 		&UpsertDataRegistryProposal{},
 		&CreateRoleProposal{},
+		&SetRoleVoteWeightProposal{},
+		&ParameterChangeProposal{},
+		&MultiContentProposal{},
+		&SoftwareUpgradeProposal{},
+		&CancelSoftwareUpgradeProposal{},
+		&RemoveCouncilorProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -7,4 +7,32 @@ type Content interface {
 
 	// VotePermission returns the PermValue a user needs to have in order to be able to vote the proposal.
 	VotePermission() PermValue
+
+	// CreatePermission returns the PermValue a user needs to have in order to be able to submit the proposal.
+	CreatePermission() PermValue
+
+	// ValidateBasic runs the stateless checks of the proposal message on the content.
+	ValidateBasic() error
+}
+
+// VotePermissions returns the permissions a user needs to have in order to be able to vote the proposal,
+// the contents batching several contents require the vote permissions of all of them.
+func VotePermissions(content Content) []PermValue {
+	multi, ok := content.(*MultiContentProposal)
+	if !ok {
+		return []PermValue{content.VotePermission()}
+	}
+
+	var perms []PermValue
+	seen := map[PermValue]bool{}
+	for _, c := range multi.UnpackedContents() {
+		for _, perm := range VotePermissions(c) {
+			if !seen[perm] {
+				seen[perm] = true
+				perms = append(perms, perm)
+			}
+		}
+	}
+
+	return perms
 }
@@ -9,15 +9,19 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +29,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type CouncilorStatus int32
+
+const (
+	// Active councilor, holding the councilor rights
+	CouncilorActive CouncilorStatus = 0
+	// Suspended councilor, inactive on governance until its term ends
+	CouncilorSuspended CouncilorStatus = 1
+	// Inactive councilor whose term ended, until it claims its seat again
+	CouncilorInactive CouncilorStatus = 2
+)
+
+var CouncilorStatus_name = map[int32]string{
+	0: "COUNCILOR_ACTIVE",
+	1: "COUNCILOR_SUSPENDED",
+	2: "COUNCILOR_INACTIVE",
+}
+
+var CouncilorStatus_value = map[string]int32{
+	"COUNCILOR_ACTIVE":    0,
+	"COUNCILOR_SUSPENDED": 1,
+	"COUNCILOR_INACTIVE":  2,
+}
+
+func (x CouncilorStatus) String() string {
+	return proto.EnumName(CouncilorStatus_name, int32(x))
+}
+
+func (CouncilorStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5bacf9373d56d819, []int{0}
+}
+
 type MsgClaimCouncilor struct {
 	Moniker  string                                        `protobuf:"bytes,1,opt,name=moniker,proto3" json:"moniker,omitempty"`
 	Website  string                                        `protobuf:"bytes,2,opt,name=website,proto3" json:"website,omitempty"`
@@ -107,6 +142,13 @@ type Councilor struct {
 	Social   string                                        `protobuf:"bytes,3,opt,name=social,proto3" json:"social,omitempty"`
 	Identity string                                        `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
 	Address  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,5,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty" yaml:"address"`
+	Status   CouncilorStatus                               `protobuf:"varint,6,opt,name=status,proto3,enum=kira.gov.CouncilorStatus" json:"status,omitempty"`
+	// Time the seat was claimed, a new term starts when the councilor claims its seat again once its term ended.
+	TermStart time.Time `protobuf:"bytes,7,opt,name=term_start,json=termStart,proto3,stdtime" json:"term_start" yaml:"term_start"`
+	// Consecutive proposals the councilor could vote on and did not vote on.
+	MissedProposals uint64 `protobuf:"varint,8,opt,name=missed_proposals,json=missedProposals,proto3" json:"missed_proposals,omitempty"`
+	// Time the term ends, the zero time when the term does not end.
+	TermEnd time.Time `protobuf:"bytes,9,opt,name=term_end,json=termEnd,proto3,stdtime" json:"term_end" yaml:"term_end"`
 }
 
 func (m *Councilor) Reset()         { *m = Councilor{} }
@@ -177,7 +219,36 @@ func (m *Councilor) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	return nil
 }
 
+func (m *Councilor) GetStatus() CouncilorStatus {
+	if m != nil {
+		return m.Status
+	}
+	return CouncilorActive
+}
+
+func (m *Councilor) GetTermStart() time.Time {
+	if m != nil {
+		return m.TermStart
+	}
+	return time.Time{}
+}
+
+func (m *Councilor) GetMissedProposals() uint64 {
+	if m != nil {
+		return m.MissedProposals
+	}
+	return 0
+}
+
+func (m *Councilor) GetTermEnd() time.Time {
+	if m != nil {
+		return m.TermEnd
+	}
+	return time.Time{}
+}
+
 func init() {
+	proto.RegisterEnum("kira.gov.CouncilorStatus", CouncilorStatus_name, CouncilorStatus_value)
 	proto.RegisterType((*MsgClaimCouncilor)(nil), "kira.gov.MsgClaimCouncilor")
 	proto.RegisterType((*Councilor)(nil), "kira.gov.Councilor")
 }
@@ -185,26 +256,42 @@ func init() {
 func init() { proto.RegisterFile("councilor.proto", fileDescriptor_5bacf9373d56d819) }
 
 var fileDescriptor_5bacf9373d56d819 = []byte{
-	// 296 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4f, 0xce, 0x2f, 0xcd,
-	0x4b, 0xce, 0xcc, 0xc9, 0x2f, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0xc8, 0xce, 0x2c,
-	0x4a, 0xd4, 0x4b, 0xcf, 0x2f, 0x93, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x0b, 0xea, 0x83, 0x58,
-	0x10, 0x79, 0xa5, 0x07, 0x8c, 0x5c, 0x82, 0xbe, 0xc5, 0xe9, 0xce, 0x39, 0x89, 0x99, 0xb9, 0xce,
-	0x30, 0xbd, 0x42, 0x12, 0x5c, 0xec, 0xb9, 0xf9, 0x79, 0x99, 0xd9, 0xa9, 0x45, 0x12, 0x8c, 0x0a,
-	0x8c, 0x1a, 0x9c, 0x41, 0x30, 0x2e, 0x48, 0xa6, 0x3c, 0x35, 0xa9, 0x38, 0xb3, 0x24, 0x55, 0x82,
-	0x09, 0x22, 0x03, 0xe5, 0x0a, 0x89, 0x71, 0xb1, 0x15, 0xe7, 0x27, 0x67, 0x26, 0xe6, 0x48, 0x30,
-	0x83, 0x25, 0xa0, 0x3c, 0x21, 0x29, 0x2e, 0x8e, 0xcc, 0x94, 0xd4, 0xbc, 0x92, 0xcc, 0x92, 0x4a,
-	0x09, 0x16, 0xb0, 0x0c, 0x9c, 0x2f, 0x14, 0xcb, 0xc5, 0x9e, 0x98, 0x92, 0x52, 0x94, 0x5a, 0x5c,
-	0x2c, 0xc1, 0xaa, 0xc0, 0xa8, 0xc1, 0xe3, 0xe4, 0xfc, 0xe9, 0x9e, 0x3c, 0x5f, 0x65, 0x62, 0x6e,
-	0x8e, 0x95, 0x12, 0x54, 0x42, 0xe9, 0xd7, 0x3d, 0x79, 0xdd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24,
-	0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0x62, 0x28, 0xa5, 0x5b, 0x9c, 0x92,
-	0xad, 0x5f, 0x52, 0x59, 0x90, 0x5a, 0xac, 0xe7, 0x98, 0x9c, 0xec, 0x08, 0xd1, 0x11, 0x04, 0x33,
-	0xd3, 0x8a, 0xe5, 0xc5, 0x02, 0x79, 0x46, 0xa5, 0x4b, 0x8c, 0x5c, 0x9c, 0xc3, 0xcd, 0x6b, 0x4e,
-	0xf6, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7,
-	0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x8a, 0x64, 0xa2, 0x77,
-	0x66, 0x51, 0xa2, 0x73, 0x7e, 0x51, 0xaa, 0x7e, 0x71, 0x6a, 0x76, 0x62, 0xa6, 0x7e, 0x85, 0x7e,
-	0x7a, 0x7e, 0x19, 0xc4, 0xd0, 0x24, 0x36, 0x70, 0xfc, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x47, 0xf4, 0x49, 0xf9, 0x32, 0x02, 0x00, 0x00,
+	// 550 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x52, 0x4d, 0x6f, 0xd3, 0x4c,
+	0x18, 0xf4, 0xb6, 0x79, 0xf3, 0xb1, 0x2f, 0x6a, 0xda, 0x2d, 0x54, 0xc6, 0x08, 0xdb, 0x8a, 0x84,
+	0x14, 0x90, 0x6a, 0x8b, 0x72, 0xeb, 0x05, 0xa5, 0x6e, 0x0e, 0x11, 0x90, 0x56, 0x4e, 0x8b, 0x10,
+	0x12, 0x8a, 0x36, 0xf6, 0x62, 0x56, 0xb1, 0xbd, 0x96, 0x77, 0x13, 0xc8, 0x3f, 0x40, 0x39, 0xf5,
+	0x0f, 0x44, 0x42, 0xf0, 0x67, 0x7a, 0xec, 0x91, 0x53, 0xa8, 0x92, 0x0b, 0xe7, 0x1e, 0x39, 0x21,
+	0x7f, 0x25, 0x15, 0x17, 0xee, 0x9c, 0xec, 0x79, 0x66, 0x9e, 0xd1, 0x3c, 0xab, 0x81, 0x75, 0x87,
+	0x8d, 0x42, 0x87, 0xfa, 0x2c, 0x36, 0xa2, 0x98, 0x09, 0x86, 0xaa, 0x43, 0x1a, 0x63, 0xc3, 0x63,
+	0x63, 0xe5, 0xae, 0xc7, 0x3c, 0x96, 0x0e, 0xcd, 0xe4, 0x2f, 0xe3, 0x15, 0xcd, 0x63, 0xcc, 0xf3,
+	0x89, 0x99, 0xa2, 0xc1, 0xe8, 0xbd, 0x29, 0x68, 0x40, 0xb8, 0xc0, 0x41, 0x94, 0x09, 0x1a, 0xd7,
+	0x00, 0xee, 0xbc, 0xe2, 0x9e, 0xe5, 0x63, 0x1a, 0x58, 0x85, 0x39, 0x92, 0x61, 0x25, 0x60, 0x21,
+	0x1d, 0x92, 0x58, 0x06, 0x3a, 0x68, 0xd6, 0xec, 0x02, 0x26, 0xcc, 0x47, 0x32, 0xe0, 0x54, 0x10,
+	0x79, 0x23, 0x63, 0x72, 0x88, 0xf6, 0x60, 0x99, 0x33, 0x87, 0x62, 0x5f, 0xde, 0x4c, 0x89, 0x1c,
+	0x21, 0x05, 0x56, 0xa9, 0x4b, 0x42, 0x41, 0xc5, 0x44, 0x2e, 0xa5, 0xcc, 0x0a, 0xa3, 0x77, 0xb0,
+	0x82, 0x5d, 0x37, 0x26, 0x9c, 0xcb, 0xff, 0xe9, 0xa0, 0x79, 0xe7, 0xc8, 0xba, 0x99, 0x6b, 0x5b,
+	0x13, 0x1c, 0xf8, 0x87, 0x8d, 0x9c, 0x68, 0xfc, 0x9a, 0x6b, 0xfb, 0x1e, 0x15, 0x1f, 0x46, 0x03,
+	0xc3, 0x61, 0x81, 0xe9, 0x30, 0x1e, 0x30, 0x9e, 0x7f, 0xf6, 0xb9, 0x3b, 0x34, 0xc5, 0x24, 0x22,
+	0xdc, 0x68, 0x39, 0x4e, 0x2b, 0xdb, 0xb0, 0x0b, 0xcf, 0xc3, 0xd2, 0xcf, 0x2f, 0x1a, 0x68, 0xcc,
+	0x37, 0x61, 0xed, 0x5f, 0x3b, 0x0d, 0x3d, 0x85, 0x65, 0x2e, 0xb0, 0x18, 0x71, 0xb9, 0xac, 0x83,
+	0xe6, 0xd6, 0xc1, 0x7d, 0xa3, 0x68, 0x82, 0xb1, 0xba, 0xb5, 0x97, 0x0a, 0xec, 0x5c, 0x88, 0xde,
+	0x40, 0x28, 0x48, 0x1c, 0xf4, 0xb9, 0xc0, 0xb1, 0x90, 0x2b, 0x3a, 0x68, 0xfe, 0x7f, 0xa0, 0x18,
+	0x59, 0x41, 0x8c, 0xa2, 0x20, 0xc6, 0x59, 0x51, 0x90, 0xa3, 0x87, 0x97, 0x73, 0x4d, 0xba, 0x99,
+	0x6b, 0x3b, 0x59, 0xe8, 0xf5, 0x6e, 0xe3, 0xe2, 0x87, 0x06, 0xec, 0x5a, 0x32, 0xe8, 0x25, 0x18,
+	0x3d, 0x86, 0xdb, 0x01, 0xe5, 0x9c, 0xb8, 0xfd, 0x28, 0x66, 0x11, 0xe3, 0xd8, 0xe7, 0x72, 0x55,
+	0x07, 0xcd, 0x92, 0x5d, 0xcf, 0xe6, 0xa7, 0xc5, 0x18, 0xd9, 0xb0, 0x9a, 0x1a, 0x91, 0xd0, 0x95,
+	0x6b, 0x7f, 0x8d, 0xf0, 0x20, 0x8f, 0x50, 0xbf, 0x15, 0x81, 0x84, 0x6e, 0x16, 0xa0, 0x92, 0xc0,
+	0x76, 0xe8, 0x3e, 0xf9, 0x0a, 0x60, 0xfd, 0x8f, 0xa3, 0x93, 0x48, 0xd6, 0xc9, 0x79, 0xd7, 0xea,
+	0xbc, 0x3c, 0xb1, 0xfb, 0x2d, 0xeb, 0xac, 0xf3, 0xba, 0xbd, 0x2d, 0x29, 0xbb, 0xd3, 0x99, 0xbe,
+	0x96, 0xb6, 0x1c, 0x41, 0xc7, 0x04, 0x99, 0x70, 0x77, 0x2d, 0xed, 0x9d, 0xf7, 0x4e, 0xdb, 0xdd,
+	0xe3, 0xf6, 0xf1, 0x36, 0x50, 0xf6, 0xa6, 0x33, 0x1d, 0xad, 0x8d, 0x47, 0x3c, 0x22, 0xa1, 0x4b,
+	0x5c, 0xb4, 0x0f, 0xd1, 0x7a, 0xa1, 0xd3, 0xcd, 0xdd, 0x37, 0x94, 0x7b, 0xd3, 0x99, 0xbe, 0xb3,
+	0xd2, 0x77, 0x42, 0x9c, 0xfa, 0x2b, 0xa5, 0xcf, 0xdf, 0x54, 0xe9, 0xe8, 0xf9, 0xe5, 0x42, 0x05,
+	0x57, 0x0b, 0x15, 0x5c, 0x2f, 0x54, 0x70, 0xb1, 0x54, 0xa5, 0xab, 0xa5, 0x2a, 0x7d, 0x5f, 0xaa,
+	0xd2, 0xdb, 0x47, 0xb7, 0x2a, 0xf0, 0x82, 0xc6, 0xd8, 0x62, 0x31, 0x31, 0x39, 0x19, 0x62, 0x6a,
+	0x7e, 0x32, 0x3d, 0x36, 0xce, 0x5a, 0x30, 0x28, 0xa7, 0xef, 0xf3, 0xec, 0xf7, 0x00, 0xda, 0xac,
+	0xec, 0x9d, 0x04, 0x04, 0x00, 0x00,
 }
 
 func (this *MsgClaimCouncilor) Equal(that interface{}) bool {
@@ -321,6 +408,32 @@ func (m *Councilor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.TermEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.TermEnd):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCouncilor(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	if m.MissedProposals != 0 {
+		i = encodeVarintCouncilor(dAtA, i, uint64(m.MissedProposals))
+		i--
+		dAtA[i] = 0x40
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.TermStart, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.TermStart):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintCouncilor(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	if m.Status != 0 {
+		i = encodeVarintCouncilor(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -425,6 +538,16 @@ func (m *Councilor) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCouncilor(uint64(l))
 	}
+	if m.Status != 0 {
+		n += 1 + sovCouncilor(uint64(m.Status))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.TermStart)
+	n += 1 + l + sovCouncilor(uint64(l))
+	if m.MissedProposals != 0 {
+		n += 1 + sovCouncilor(uint64(m.MissedProposals))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.TermEnd)
+	n += 1 + l + sovCouncilor(uint64(l))
 	return n
 }
 
@@ -840,6 +963,110 @@ func (m *Councilor) Unmarshal(dAtA []byte) error {
 				m.Address = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCouncilor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= CouncilorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCouncilor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCouncilor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCouncilor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.TermStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedProposals", wireType)
+			}
+			m.MissedProposals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCouncilor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedProposals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCouncilor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCouncilor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCouncilor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.TermEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCouncilor(dAtA[iNdEx:])
@@ -6,24 +6,52 @@ import (
 
 // errors
 var (
-	ErrSetPermissions             = errors.Register(ModuleName, 2, "error setting permissions")
-	ErrEmptyProposerAccAddress    = errors.Register(ModuleName, 3, "empty proposer key")
-	ErrEmptyPermissionsAccAddress = errors.Register(ModuleName, 4, "empty address to set the permissions")
-	ErrNotEnoughPermissions       = errors.Register(ModuleName, 5, "not enough permissions")
-	ErrCouncilorEmptyAddress      = errors.Register(ModuleName, 6, "empty councilor address")
-	ErrRoleDoesNotExist           = errors.Register(ModuleName, 7, "role does not exist")
-	ErrWhitelisting               = errors.Register(ModuleName, 8, "error adding to whitelist")
-	ErrEmptyPermissions           = errors.Register(ModuleName, 9, "empty permissions")
-	ErrRoleExist                  = errors.Register(ModuleName, 12, "role already exist")
-	ErrRoleAlreadyAssigned        = errors.Register(ModuleName, 13, "role already assigned")
-	ErrRoleNotAssigned            = errors.Register(ModuleName, 14, "role not assigned")
-	ErrCouncilorNotFound          = errors.Register(ModuleName, 15, "councilor not found")
-	ErrProposalDoesNotExist       = errors.Register(ModuleName, 17, "proposal does not exist")
-	ErrActorIsNotActive           = errors.Register(ModuleName, 18, "actor is not active")
-	ErrInvalidNetworkProperty     = errors.Register(ModuleName, 19, "invalid network property")
-	ErrFeeNotExist                = errors.Register(ModuleName, 20, "fee does not exist")
-	ErrPoorNetworkMsgsNotSet      = errors.Register(ModuleName, 21, "poor network messages not set")
-	ErrGettingProposals           = errors.Register(ModuleName, 23, "error getting proposals")
-	ErrGettingProposalVotes       = errors.Register(ModuleName, 24, "error getting votes for proposal")
-	ErrVotingTimeEnded            = errors.Register(ModuleName, 25, "voting time has ended")
+	ErrSetPermissions              = errors.Register(ModuleName, 2, "error setting permissions")
+	ErrEmptyProposerAccAddress     = errors.Register(ModuleName, 3, "empty proposer key")
+	ErrEmptyPermissionsAccAddress  = errors.Register(ModuleName, 4, "empty address to set the permissions")
+	ErrNotEnoughPermissions        = errors.Register(ModuleName, 5, "not enough permissions")
+	ErrCouncilorEmptyAddress       = errors.Register(ModuleName, 6, "empty councilor address")
+	ErrRoleDoesNotExist            = errors.Register(ModuleName, 7, "role does not exist")
+	ErrWhitelisting                = errors.Register(ModuleName, 8, "error adding to whitelist")
+	ErrEmptyPermissions            = errors.Register(ModuleName, 9, "empty permissions")
+	ErrRoleExist                   = errors.Register(ModuleName, 12, "role already exist")
+	ErrRoleAlreadyAssigned         = errors.Register(ModuleName, 13, "role already assigned")
+	ErrRoleNotAssigned             = errors.Register(ModuleName, 14, "role not assigned")
+	ErrCouncilorNotFound           = errors.Register(ModuleName, 15, "councilor not found")
+	ErrProposalDoesNotExist        = errors.Register(ModuleName, 17, "proposal does not exist")
+	ErrActorIsNotActive            = errors.Register(ModuleName, 18, "actor is not active")
+	ErrInvalidNetworkProperty      = errors.Register(ModuleName, 19, "invalid network property")
+	ErrFeeNotExist                 = errors.Register(ModuleName, 20, "fee does not exist")
+	ErrPoorNetworkMsgsNotSet       = errors.Register(ModuleName, 21, "poor network messages not set")
+	ErrGettingProposals            = errors.Register(ModuleName, 23, "error getting proposals")
+	ErrGettingProposalVotes        = errors.Register(ModuleName, 24, "error getting votes for proposal")
+	ErrVotingTimeEnded             = errors.Register(ModuleName, 25, "voting time has ended")
+	ErrInvalidNetworkPropertyValue = errors.Register(ModuleName, 26, "invalid network property value")
+	ErrInvalidVoteWeight           = errors.Register(ModuleName, 27, "invalid vote weight")
+	ErrProposalNotCancellable      = errors.Register(ModuleName, 28, "proposal can not be cancelled")
+	ErrInvalidVoteOptions          = errors.Register(ModuleName, 29, "invalid vote options")
+	ErrVoteReasonTooLong           = errors.Register(ModuleName, 30, "vote reason too long")
+	ErrInvalidParamChange          = errors.Register(ModuleName, 31, "invalid parameter change")
+	ErrUnknownParamSubspace        = errors.Register(ModuleName, 32, "unknown parameter subspace")
+	ErrUnknownParam                = errors.Register(ModuleName, 33, "unknown parameter")
+	ErrInvalidMultiContent         = errors.Register(ModuleName, 34, "invalid multi content proposal")
+	ErrInvalidUpgradePlan          = errors.Register(ModuleName, 35, "invalid upgrade plan")
+	ErrCouncilorNotActive          = errors.Register(ModuleName, 36, "councilor is not active")
+	ErrInvalidRoleSid              = errors.Register(ModuleName, 37, "invalid role sid")
+	ErrRoleSidExist                = errors.Register(ModuleName, 38, "role sid already exists")
+	ErrRoleImmutable               = errors.Register(ModuleName, 39, "role is immutable")
+	ErrInvalidPermissionExpiry     = errors.Register(ModuleName, 40, "invalid permission expiry")
+	ErrPermissionNotDelegable      = errors.Register(ModuleName, 41, "permission can not be delegated")
+	ErrPermissionGrantNotFound     = errors.Register(ModuleName, 42, "permission grant not found")
+	ErrInvalidAuthorization        = errors.Register(ModuleName, 43, "invalid authorization")
+	ErrAuthorizationNotFound       = errors.Register(ModuleName, 44, "authorization not found")
+	ErrAuthorizationExpired        = errors.Register(ModuleName, 45, "authorization expired")
+	ErrAuthorizationSpendLimit     = errors.Register(ModuleName, 46, "spend limit of the authorization exceeded")
+	ErrInvalidExecMsg              = errors.Register(ModuleName, 47, "invalid message to execute")
+	ErrInvalidGroup                = errors.Register(ModuleName, 48, "invalid group")
+	ErrGroupNotFound               = errors.Register(ModuleName, 49, "group not found")
+	ErrNotGroupMember              = errors.Register(ModuleName, 50, "not a member of the group")
+	ErrInvalidGroupProposal        = errors.Register(ModuleName, 51, "invalid group proposal")
+	ErrGroupProposalNotFound       = errors.Register(ModuleName, 52, "group proposal not found")
+	ErrGroupProposalNotPending     = errors.Register(ModuleName, 53, "group proposal is not pending")
 )
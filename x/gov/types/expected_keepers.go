@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// BankKeeper defines the expected bank keeper used to escrow, refund and burn the proposal deposits
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
+
+// StakingKeeper defines the expected staking keeper used to weight the votes by bonded tokens
+type StakingKeeper interface {
+	GetDelegatorBondedTokens(ctx sdk.Context, delegator sdk.AccAddress) sdk.Int
+}
+
+// ParamsKeeper defines the expected params keeper used to apply the parameter change proposals
+type ParamsKeeper interface {
+	GetSubspace(s string) (paramstypes.Subspace, bool)
+}
+
+// UpgradeKeeper defines the expected upgrade keeper used to apply the software upgrade proposals
+type UpgradeKeeper interface {
+	ScheduleUpgrade(ctx sdk.Context, plan upgradetypes.Plan) error
+	ClearUpgradePlan(ctx sdk.Context)
+}
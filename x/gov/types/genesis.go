@@ -1,9 +1,18 @@
 package types
 
 import (
+	"fmt"
+
 	kiratypes "github.com/KiraCore/sekai/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
+// DefaultExecutionTimeout is the gas the handler of a message can consume with the default execution fees.
+const DefaultExecutionTimeout = 200000
+
 // DefaultGenesis returns the default CustomGo genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -28,9 +37,23 @@ func DefaultGenesis() *GenesisState {
 				PermVoteCreateRoleProposal,
 				PermCreateTokensWhiteBlackChangeProposal,
 				PermVoteTokensWhiteBlackChangeProposal,
+				PermCreateSetRoleVoteWeightProposal,
+				PermVoteSetRoleVoteWeightProposal,
+				PermCancelProposal,
+				PermCreateParameterChangeProposal,
+				PermVoteParameterChangeProposal,
+				PermCreateMultiContentProposal,
+				PermCreateSoftwareUpgradeProposal,
+				PermVoteSoftwareUpgradeProposal,
+				PermCreateRemoveCouncilorProposal,
+				PermVoteRemoveCouncilorProposal,
 			}, nil),
 			uint64(RoleValidator): NewPermissions([]PermValue{PermClaimValidator}, nil),
 		},
+		Roles: []RoleInfo{
+			NewRoleInfo(RoleSudo, "sudo", "Genesis role holding every permission", true),
+			NewRoleInfo(RoleValidator, "validator", "Role allowed to claim a validator seat", true),
+		},
 		StartingProposalId: 1,
 		NetworkProperties: &NetworkProperties{
 			MinTxFee:                    100,
@@ -43,9 +66,25 @@ func DefaultGenesis() *GenesisState {
 			InactiveRankDecreasePercent: 50,      // 50%
 			PoorNetworkMaxBankSend:      1000000, // 1M ukex
 			MinValidators:               1,
-			JailMaxTime:                 10, // 10 mins
+			JailMaxTime:                 0, // validators stay jailed until unjailed
 			EnableTokenWhitelist:        false,
 			EnableTokenBlacklist:        true,
+			ValidatorPowerMode:          PowerModeFlat,
+			MaxValidatorPowerPercent:    0,       // no cap
+			UnbondingTime:               1814400, // 21 days
+			VoteWeightMode:              VoteWeightModeActor,
+			CouncilorVoteWeight:         1,
+			MinProposalDeposit:          0, // no deposit
+			MaxCouncilorMissedProposals: 10,
+			FeeLedgerWindow:             100,   // 100 blocks
+			MinJailTime:                 5,     // 5 mins
+			CommissionChangeInterval:    86400, // 1 day
+			MaxCommissionChange:         5,     // 5 percentage points
+			ExitNoticePeriod:            86400, // 1 day
+			MaxValidators:               100,
+			UptimeEpochLength:           720, // 720 blocks
+			UptimeEpochRetention:        24,
+			CouncilorTerm:               31536000, // 365 days
 		},
 		ExecutionFees: []*ExecutionFee{
 			{
@@ -53,7 +92,7 @@ func DefaultGenesis() *GenesisState {
 				TransactionType:   "claim-validator-seat",
 				ExecutionFee:      10,
 				FailureFee:        1,
-				Timeout:           10,
+				Timeout:           DefaultExecutionTimeout,
 				DefaultParameters: 0,
 			},
 			{
@@ -61,7 +100,7 @@ func DefaultGenesis() *GenesisState {
 				TransactionType:   "claim-governance-seat",
 				ExecutionFee:      10,
 				FailureFee:        1,
-				Timeout:           10,
+				Timeout:           DefaultExecutionTimeout,
 				DefaultParameters: 0,
 			},
 			{
@@ -69,7 +108,7 @@ func DefaultGenesis() *GenesisState {
 				TransactionType:   "claim-proposal-type-x",
 				ExecutionFee:      10,
 				FailureFee:        1,
-				Timeout:           10,
+				Timeout:           DefaultExecutionTimeout,
 				DefaultParameters: 0,
 			},
 			{
@@ -77,7 +116,7 @@ func DefaultGenesis() *GenesisState {
 				TransactionType:   "vote-proposal-type-x",
 				ExecutionFee:      10,
 				FailureFee:        1,
-				Timeout:           10,
+				Timeout:           DefaultExecutionTimeout,
 				DefaultParameters: 0,
 			},
 			{
@@ -85,7 +124,7 @@ func DefaultGenesis() *GenesisState {
 				TransactionType:   "submit-proposal-type-x",
 				ExecutionFee:      10,
 				FailureFee:        1,
-				Timeout:           10,
+				Timeout:           DefaultExecutionTimeout,
 				DefaultParameters: 0,
 			},
 			{
@@ -93,7 +132,7 @@ func DefaultGenesis() *GenesisState {
 				TransactionType:   "veto-proposal-type-x",
 				ExecutionFee:      10,
 				FailureFee:        1,
-				Timeout:           10,
+				Timeout:           DefaultExecutionTimeout,
 				DefaultParameters: 0,
 			},
 			{
@@ -101,7 +140,7 @@ func DefaultGenesis() *GenesisState {
 				TransactionType:   kiratypes.MsgTypeUpsertTokenAlias,
 				ExecutionFee:      10,
 				FailureFee:        1,
-				Timeout:           10,
+				Timeout:           DefaultExecutionTimeout,
 				DefaultParameters: 0,
 			},
 			{
@@ -109,7 +148,7 @@ func DefaultGenesis() *GenesisState {
 				TransactionType:   kiratypes.MsgTypeActivate,
 				ExecutionFee:      100,
 				FailureFee:        1000,
-				Timeout:           10,
+				Timeout:           DefaultExecutionTimeout,
 				DefaultParameters: 0,
 			},
 			{
@@ -117,7 +156,7 @@ func DefaultGenesis() *GenesisState {
 				TransactionType:   kiratypes.MsgTypePause,
 				ExecutionFee:      10,
 				FailureFee:        100,
-				Timeout:           10,
+				Timeout:           DefaultExecutionTimeout,
 				DefaultParameters: 0,
 			},
 			{
@@ -125,7 +164,7 @@ func DefaultGenesis() *GenesisState {
 				TransactionType:   kiratypes.MsgTypeUnpause,
 				ExecutionFee:      10,
 				FailureFee:        100,
-				Timeout:           10,
+				Timeout:           DefaultExecutionTimeout,
 				DefaultParameters: 0,
 			},
 		},
@@ -138,6 +177,15 @@ func DefaultGenesis() *GenesisState {
 				kiratypes.MsgTypeClaimCouncilor,
 				kiratypes.MsgTypeWhitelistPermissions,
 				kiratypes.MsgTypeBlacklistPermissions,
+				kiratypes.MsgTypeDelegatePermission,
+				kiratypes.MsgTypeRevokePermission,
+				kiratypes.MsgTypeGrantAuthorization,
+				kiratypes.MsgTypeRevokeAuthorization,
+				kiratypes.MsgTypeExec,
+				kiratypes.MsgTypeCreateGroup,
+				kiratypes.MsgTypeUpdateGroup,
+				kiratypes.MsgTypeSubmitGroupProposal,
+				kiratypes.MsgTypeApproveGroupProposal,
 				kiratypes.MsgTypeCreateRole,
 				kiratypes.MsgTypeAssignRole,
 				kiratypes.MsgTypeRemoveRole,
@@ -149,7 +197,315 @@ func DefaultGenesis() *GenesisState {
 				kiratypes.MsgTypeActivate,
 				kiratypes.MsgTypePause,
 				kiratypes.MsgTypeUnpause,
+				kiratypes.MsgTypeUnjail,
+				kiratypes.MsgTypeEditValidator,
+				kiratypes.MsgTypeRotateConsensusKey,
+				kiratypes.MsgTypeExitValidator,
 			},
 		},
 	}
 }
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, p := range data.Proposals {
+		err := p.UnpackInterfaces(unpacker)
+		if err != nil {
+			return err
+		}
+	}
+	for _, p := range data.GroupProposals {
+		err := p.UnpackInterfaces(unpacker)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the genesis state is consistent, it is run by the validate-genesis command before the chain starts.
+func (data GenesisState) Validate() error {
+	if data.NetworkProperties == nil {
+		return fmt.Errorf("network properties not set")
+	}
+
+	if err := data.NetworkProperties.Validate(); err != nil {
+		return err
+	}
+
+	for role, perms := range data.Permissions {
+		if perms == nil {
+			return fmt.Errorf("permissions of role %d not set", role)
+		}
+
+		if err := perms.Validate(); err != nil {
+			return fmt.Errorf("invalid permissions of role %d: %w", role, err)
+		}
+	}
+
+	roles := make(map[uint32]bool)
+	sids := make(map[string]bool)
+	for _, info := range data.Roles {
+		if _, ok := data.Permissions[uint64(info.Id)]; !ok {
+			return fmt.Errorf("role info of undefined role %d", info.Id)
+		}
+
+		if roles[info.Id] {
+			return fmt.Errorf("duplicate role info of role %d", info.Id)
+		}
+		roles[info.Id] = true
+
+		if err := ValidateRoleSid(info.Sid); err != nil {
+			return fmt.Errorf("invalid sid of role %d: %w", info.Id, err)
+		}
+
+		if sids[info.Sid] {
+			return fmt.Errorf("duplicate role sid %s", info.Sid)
+		}
+		sids[info.Sid] = true
+	}
+
+	actors := make(map[string]bool)
+	for _, actor := range data.NetworkActors {
+		if actor == nil || actor.Address.Empty() {
+			return fmt.Errorf("network actor address not set")
+		}
+
+		if actors[actor.Address.String()] {
+			return fmt.Errorf("duplicate network actor %s", actor.Address)
+		}
+		actors[actor.Address.String()] = true
+
+		for _, role := range actor.Roles {
+			if _, ok := data.Permissions[role]; !ok {
+				return fmt.Errorf("network actor %s has undefined role %d", actor.Address, role)
+			}
+		}
+
+		if actor.Permissions != nil {
+			if err := actor.Permissions.Validate(); err != nil {
+				return fmt.Errorf("invalid permissions of network actor %s: %w", actor.Address, err)
+			}
+		}
+	}
+
+	whitelisted := make(map[string]*Permissions)
+	for _, actor := range data.NetworkActors {
+		whitelisted[actor.Address.String()] = actor.Permissions
+	}
+
+	for _, expiry := range data.PermissionExpiries {
+		perms := whitelisted[expiry.Address.String()]
+		if perms == nil || !perms.IsWhitelisted(PermValue(expiry.Permission)) {
+			return fmt.Errorf("expiry of permission %d which is not whitelisted to %s", expiry.Permission, expiry.Address)
+		}
+
+		if expiry.ExpiryHeight == 0 && expiry.ExpiryTime == nil {
+			return fmt.Errorf("expiry of permission %d of %s not set", expiry.Permission, expiry.Address)
+		}
+	}
+
+	for _, grant := range data.PermissionGrants {
+		if grant.Delegator.Empty() || grant.Delegatee.Empty() || grant.Delegator.Equals(grant.Delegatee) {
+			return fmt.Errorf("invalid grant of permission %d from %s to %s", grant.Permission, grant.Delegator, grant.Delegatee)
+		}
+
+		if !IsDelegablePermission(PermValue(grant.Permission)) {
+			return fmt.Errorf("grant of permission %d which can not be delegated", grant.Permission)
+		}
+	}
+
+	authorizations := make(map[string]bool)
+	for _, auth := range data.Authorizations {
+		if auth.Granter.Empty() || auth.Grantee.Empty() || auth.Granter.Equals(auth.Grantee) {
+			return fmt.Errorf("invalid authorization of %s from %s to %s", auth.MsgTypeUrl, auth.Granter, auth.Grantee)
+		}
+
+		if err := ValidateAuthorization(auth.MsgTypeUrl, auth.SpendLimit); err != nil {
+			return err
+		}
+
+		if auth.MaxUses != 0 && auth.Uses >= auth.MaxUses {
+			return fmt.Errorf("authorization of %s from %s to %s is used up", auth.MsgTypeUrl, auth.Granter, auth.Grantee)
+		}
+
+		key := auth.Granter.String() + auth.Grantee.String() + auth.MsgTypeUrl
+		if authorizations[key] {
+			return fmt.Errorf("duplicate authorization of %s from %s to %s", auth.MsgTypeUrl, auth.Granter, auth.Grantee)
+		}
+		authorizations[key] = true
+	}
+
+	groups := make(map[uint64]Group)
+	for _, group := range data.Groups {
+		if _, ok := groups[group.Id]; ok {
+			return fmt.Errorf("duplicate group %d", group.Id)
+		}
+
+		if !group.Address.Equals(GroupAddress(group.Id)) {
+			return fmt.Errorf("group %d has the address %s instead of %s", group.Id, group.Address, GroupAddress(group.Id))
+		}
+
+		if err := ValidateGroupMembers(group.Members, group.Threshold); err != nil {
+			return fmt.Errorf("invalid group %d: %w", group.Id, err)
+		}
+		groups[group.Id] = group
+	}
+
+	groupProposals := make(map[uint64]bool)
+	for _, proposal := range data.GroupProposals {
+		if groupProposals[proposal.Id] {
+			return fmt.Errorf("duplicate group proposal %d", proposal.Id)
+		}
+		groupProposals[proposal.Id] = true
+
+		if _, ok := groups[proposal.GroupId]; !ok {
+			return fmt.Errorf("group proposal %d of undefined group %d", proposal.Id, proposal.GroupId)
+		}
+	}
+
+	weightedRoles := make(map[uint64]bool)
+	for _, weight := range data.RoleVoteWeights {
+		if _, ok := data.Permissions[weight.Role]; !ok {
+			return fmt.Errorf("vote weight of undefined role %d", weight.Role)
+		}
+
+		if weightedRoles[weight.Role] {
+			return fmt.Errorf("duplicate vote weight of role %d", weight.Role)
+		}
+		weightedRoles[weight.Role] = true
+
+		if weight.Weight.IsNil() || weight.Weight.IsNegative() {
+			return fmt.Errorf("invalid vote weight %s of role %d", weight.Weight, weight.Role)
+		}
+	}
+
+	councilors := make(map[string]bool)
+	for _, councilor := range data.Councilors {
+		if councilor.Address.Empty() {
+			return ErrCouncilorEmptyAddress
+		}
+
+		if councilors[councilor.Address.String()] {
+			return fmt.Errorf("duplicate councilor %s", councilor.Address)
+		}
+		councilors[councilor.Address.String()] = true
+
+		if _, ok := CouncilorStatus_name[int32(councilor.Status)]; !ok {
+			return fmt.Errorf("councilor %s has an unknown status %d", councilor.Address, councilor.Status)
+		}
+	}
+
+	for key, entry := range data.DataRegistryEntries {
+		if key == "" || entry == nil {
+			return fmt.Errorf("invalid data registry entry %q", key)
+		}
+	}
+
+	proposals := make(map[uint64]bool)
+	for _, proposal := range data.Proposals {
+		if proposals[proposal.ProposalId] {
+			return fmt.Errorf("duplicate proposal %d", proposal.ProposalId)
+		}
+		proposals[proposal.ProposalId] = true
+
+		if proposal.ProposalId >= data.StartingProposalId {
+			return fmt.Errorf("proposal %d is not lower than the starting proposal id %d", proposal.ProposalId, data.StartingProposalId)
+		}
+
+		if proposal.Content == nil {
+			return fmt.Errorf("content of proposal %d not set", proposal.ProposalId)
+		}
+	}
+
+	for _, proposalID := range data.ActiveProposals {
+		if !proposals[proposalID] {
+			return fmt.Errorf("active proposal %d does not exist", proposalID)
+		}
+	}
+
+	for _, proposalID := range data.EnactmentProposals {
+		if !proposals[proposalID] {
+			return fmt.Errorf("enactment proposal %d does not exist", proposalID)
+		}
+	}
+
+	for _, votes := range [][]Vote{data.Votes, data.VoteHistory} {
+		for _, vote := range votes {
+			if !proposals[vote.ProposalId] {
+				return fmt.Errorf("vote of %s on proposal %d which does not exist", vote.Voter, vote.ProposalId)
+			}
+
+			if vote.Voter.Empty() {
+				return fmt.Errorf("voter of proposal %d not set", vote.ProposalId)
+			}
+
+			if err := vote.WeightedOptions().ValidateBasic(); err != nil {
+				return fmt.Errorf("invalid vote of %s on proposal %d: %w", vote.Voter, vote.ProposalId, err)
+			}
+		}
+	}
+
+	for _, deposit := range data.Deposits {
+		if !proposals[deposit.ProposalId] {
+			return fmt.Errorf("deposit of %s on proposal %d which does not exist", deposit.Depositor, deposit.ProposalId)
+		}
+
+		if deposit.Depositor.Empty() {
+			return fmt.Errorf("depositor of proposal %d not set", deposit.ProposalId)
+		}
+
+		if err := deposit.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid deposit of %s on proposal %d: %w", deposit.Depositor, deposit.ProposalId, err)
+		}
+	}
+
+	transactionTypes := make(map[string]bool)
+	for _, fee := range data.ExecutionFees {
+		if fee == nil || fee.TransactionType == "" {
+			return fmt.Errorf("execution fee transaction type not set")
+		}
+
+		if transactionTypes[fee.TransactionType] {
+			return fmt.Errorf("duplicate execution fee of %s", fee.TransactionType)
+		}
+		transactionTypes[fee.TransactionType] = true
+	}
+
+	return nil
+}
+
+// IsAllowed returns if the actor of the address is granted the permission, through its roles or
+// its own permissions, the same way the keeper checks it once the genesis is imported.
+func (data GenesisState) IsAllowed(addr sdk.AccAddress, perm PermValue) bool {
+	for _, actor := range data.NetworkActors {
+		if actor == nil || !actor.Address.Equals(addr) {
+			continue
+		}
+
+		allowed := false
+		for _, role := range actor.Roles {
+			if perms, ok := data.Permissions[role]; ok && perms != nil && perms.IsWhitelisted(perm) {
+				allowed = true
+			}
+		}
+
+		if actor.Permissions != nil && actor.Permissions.IsWhitelisted(perm) {
+			allowed = true
+		}
+
+		for _, role := range actor.Roles {
+			if perms, ok := data.Permissions[role]; ok && perms != nil && perms.IsBlacklisted(perm) {
+				allowed = false
+			}
+		}
+
+		if actor.Permissions != nil && actor.Permissions.IsBlacklisted(perm) {
+			allowed = false
+		}
+
+		return allowed
+	}
+
+	return false
+}
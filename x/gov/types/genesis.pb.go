@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -33,6 +35,31 @@ type GenesisState struct {
 	ExecutionFees          []*ExecutionFee    `protobuf:"bytes,5,rep,name=execution_fees,json=executionFees,proto3" json:"execution_fees,omitempty"`
 	PoorNetworkMessages    *AllowedMessages   `protobuf:"bytes,6,opt,name=poor_network_messages,json=poorNetworkMessages,proto3" json:"poor_network_messages,omitempty"`
 	PoorNetworkMaxBankSend uint64             `protobuf:"varint,7,opt,name=poor_network_max_bank_send,json=poorNetworkMaxBankSend,proto3" json:"poor_network_max_bank_send,omitempty"`
+	// role_vote_weights are the vote weights set by governance, roles without one weight 1.
+	RoleVoteWeights []RoleVoteWeight `protobuf:"bytes,8,rep,name=role_vote_weights,json=roleVoteWeights,proto3" json:"role_vote_weights"`
+	Councilors      []Councilor      `protobuf:"bytes,9,rep,name=councilors,proto3" json:"councilors"`
+	// data_registry_entries are the data registry entries by key.
+	DataRegistryEntries map[string]*DataRegistryEntry `protobuf:"bytes,10,rep,name=data_registry_entries,json=dataRegistryEntries,proto3" json:"data_registry_entries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Proposals           []Proposal                    `protobuf:"bytes,11,rep,name=proposals,proto3" json:"proposals"`
+	// active_proposals are the IDs of the proposals in the voting period.
+	ActiveProposals []uint64 `protobuf:"varint,12,rep,packed,name=active_proposals,json=activeProposals,proto3" json:"active_proposals,omitempty"`
+	// enactment_proposals are the IDs of the proposals waiting for their enactment time.
+	EnactmentProposals []uint64 `protobuf:"varint,13,rep,packed,name=enactment_proposals,json=enactmentProposals,proto3" json:"enactment_proposals,omitempty"`
+	Votes              []Vote   `protobuf:"bytes,14,rep,name=votes,proto3" json:"votes"`
+	// vote_history is every vote cast on the proposals, including the changed ones.
+	VoteHistory []Vote    `protobuf:"bytes,15,rep,name=vote_history,json=voteHistory,proto3" json:"vote_history"`
+	Deposits    []Deposit `protobuf:"bytes,16,rep,name=deposits,proto3" json:"deposits"`
+	// roles are the names and descriptions of the roles defined in permissions.
+	Roles []RoleInfo `protobuf:"bytes,17,rep,name=roles,proto3" json:"roles"`
+	// permission_expiries are the expiries of the permissions whitelisted to the network actors.
+	PermissionExpiries []PermissionExpiry `protobuf:"bytes,18,rep,name=permission_expiries,json=permissionExpiries,proto3" json:"permission_expiries"`
+	// permission_grants are the permissions delegated between addresses.
+	PermissionGrants []PermissionGrant `protobuf:"bytes,19,rep,name=permission_grants,json=permissionGrants,proto3" json:"permission_grants"`
+	// authorizations are the authorizations given to execute messages on behalf of their granters.
+	Authorizations []Authorization `protobuf:"bytes,20,rep,name=authorizations,proto3" json:"authorizations"`
+	// groups are the group accounts governed by their members.
+	Groups         []Group         `protobuf:"bytes,21,rep,name=groups,proto3" json:"groups"`
+	GroupProposals []GroupProposal `protobuf:"bytes,22,rep,name=group_proposals,json=groupProposals,proto3" json:"group_proposals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -117,43 +144,225 @@ func (m *GenesisState) GetPoorNetworkMaxBankSend() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetRoleVoteWeights() []RoleVoteWeight {
+	if m != nil {
+		return m.RoleVoteWeights
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCouncilors() []Councilor {
+	if m != nil {
+		return m.Councilors
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDataRegistryEntries() map[string]*DataRegistryEntry {
+	if m != nil {
+		return m.DataRegistryEntries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProposals() []Proposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetActiveProposals() []uint64 {
+	if m != nil {
+		return m.ActiveProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEnactmentProposals() []uint64 {
+	if m != nil {
+		return m.EnactmentProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVotes() []Vote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoteHistory() []Vote {
+	if m != nil {
+		return m.VoteHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDeposits() []Deposit {
+	if m != nil {
+		return m.Deposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRoles() []RoleInfo {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPermissionExpiries() []PermissionExpiry {
+	if m != nil {
+		return m.PermissionExpiries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPermissionGrants() []PermissionGrant {
+	if m != nil {
+		return m.PermissionGrants
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuthorizations() []Authorization {
+	if m != nil {
+		return m.Authorizations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGroups() []Group {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGroupProposals() []GroupProposal {
+	if m != nil {
+		return m.GroupProposals
+	}
+	return nil
+}
+
+// RoleVoteWeight is the weight of the votes of the actors holding the role.
+type RoleVoteWeight struct {
+	Role   uint64                                 `protobuf:"varint,1,opt,name=role,proto3" json:"role,omitempty"`
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *RoleVoteWeight) Reset()         { *m = RoleVoteWeight{} }
+func (m *RoleVoteWeight) String() string { return proto.CompactTextString(m) }
+func (*RoleVoteWeight) ProtoMessage()    {}
+func (*RoleVoteWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14205810582f3203, []int{1}
+}
+func (m *RoleVoteWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleVoteWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleVoteWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleVoteWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleVoteWeight.Merge(m, src)
+}
+func (m *RoleVoteWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleVoteWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleVoteWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleVoteWeight proto.InternalMessageInfo
+
+func (m *RoleVoteWeight) GetRole() uint64 {
+	if m != nil {
+		return m.Role
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kira.gov.GenesisState")
+	proto.RegisterMapType((map[string]*DataRegistryEntry)(nil), "kira.gov.GenesisState.DataRegistryEntriesEntry")
 	proto.RegisterMapType((map[uint64]*Permissions)(nil), "kira.gov.GenesisState.PermissionsEntry")
+	proto.RegisterType((*RoleVoteWeight)(nil), "kira.gov.RoleVoteWeight")
 }
 
 func init() { proto.RegisterFile("genesis.proto", fileDescriptor_14205810582f3203) }
 
 var fileDescriptor_14205810582f3203 = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0xd1, 0x8a, 0xd3, 0x40,
-	0x14, 0x6d, 0xda, 0xee, 0x2a, 0x53, 0x2b, 0xeb, 0xac, 0x5b, 0xc6, 0x08, 0xa1, 0x08, 0x62, 0x41,
-	0x48, 0x64, 0x7d, 0x91, 0x05, 0x91, 0x5d, 0x59, 0x65, 0x95, 0x95, 0x92, 0xc5, 0x17, 0x5f, 0xc2,
-	0xb4, 0xb9, 0xc6, 0x21, 0xe9, 0x4c, 0x98, 0x3b, 0xed, 0xb6, 0x7f, 0xe1, 0xdf, 0xf8, 0x0b, 0x3e,
-	0xee, 0xa3, 0x8f, 0xd2, 0xfe, 0x88, 0x34, 0xc9, 0x98, 0x6c, 0xf7, 0x6d, 0xee, 0x39, 0xf7, 0x9e,
-	0x9c, 0x7b, 0x6e, 0x48, 0x3f, 0x01, 0x09, 0x28, 0xd0, 0xcf, 0xb5, 0x32, 0x8a, 0xde, 0x4f, 0x85,
-	0xe6, 0x7e, 0xa2, 0x16, 0x6e, 0x8f, 0x4f, 0x8d, 0xd2, 0x25, 0xec, 0x12, 0xad, 0x32, 0xa8, 0xde,
-	0x87, 0xb0, 0x84, 0xe9, 0xdc, 0x08, 0x25, 0xa3, 0xef, 0x60, 0x41, 0x26, 0xc1, 0x5c, 0x2b, 0x9d,
-	0x46, 0xb9, 0x56, 0x39, 0x68, 0x23, 0xa0, 0x52, 0x74, 0x07, 0x3c, 0xcb, 0xd4, 0x35, 0xc4, 0xd1,
-	0x0c, 0x10, 0x79, 0x62, 0xf1, 0x67, 0xbf, 0xba, 0xe4, 0xc1, 0xc7, 0xf2, 0xdb, 0x57, 0x86, 0x1b,
-	0xa0, 0xaf, 0xc8, 0x63, 0x34, 0x5c, 0x1b, 0x21, 0x93, 0x42, 0x45, 0x21, 0xcf, 0x22, 0x11, 0x33,
-	0x67, 0xe8, 0x8c, 0xba, 0x21, 0xb5, 0xdc, 0xb8, 0xa2, 0x2e, 0x62, 0x7a, 0x41, 0x7a, 0x39, 0xe8,
-	0x99, 0x40, 0x14, 0x4a, 0x22, 0x6b, 0x0f, 0x3b, 0xa3, 0xde, 0xf1, 0x0b, 0xdf, 0xae, 0xe0, 0x37,
-	0xe5, 0xfd, 0x71, 0xdd, 0x79, 0x2e, 0x8d, 0x5e, 0x85, 0xcd, 0x59, 0xfa, 0x96, 0x3c, 0xb4, 0x1b,
-	0x14, 0x7b, 0x23, 0xeb, 0x14, 0x6a, 0x83, 0x5a, 0xed, 0x4b, 0xc9, 0x9f, 0x6e, 0xe9, 0xb0, 0x2f,
-	0x1b, 0x15, 0xd2, 0x4f, 0x84, 0xde, 0x0d, 0x80, 0x75, 0x87, 0xce, 0xa8, 0x77, 0xfc, 0xf4, 0x8e,
-	0xc4, 0xf8, 0x7f, 0x4b, 0xf8, 0x48, 0xee, 0x42, 0x5b, 0x2b, 0xb7, 0x12, 0x46, 0xb6, 0xb7, 0x6b,
-	0xe5, 0xdc, 0xf2, 0x1f, 0x00, 0xc2, 0x3e, 0x34, 0x2a, 0xa4, 0x97, 0xe4, 0x28, 0x57, 0x4a, 0x47,
-	0xd6, 0x8f, 0x8d, 0x9d, 0xed, 0x17, 0x6e, 0x9e, 0xd4, 0x2a, 0xa7, 0xe5, 0x61, 0x2e, 0xab, 0x86,
-	0xf0, 0x70, 0x3b, 0x57, 0x59, 0xb4, 0x20, 0x3d, 0x21, 0xee, 0x6d, 0x39, 0xbe, 0x8c, 0x26, 0x5c,
-	0xa6, 0x11, 0x82, 0x8c, 0xd9, 0xbd, 0xe2, 0x36, 0x83, 0xe6, 0x20, 0x5f, 0x9e, 0x71, 0x99, 0x5e,
-	0x81, 0x8c, 0xdd, 0xaf, 0xe4, 0x60, 0x37, 0x75, 0x7a, 0x40, 0x3a, 0x29, 0xac, 0xaa, 0xa3, 0x6e,
-	0x9f, 0xf4, 0x25, 0xd9, 0x5b, 0xf0, 0x6c, 0x0e, 0xac, 0x5d, 0x18, 0x3c, 0xaa, 0x0d, 0x36, 0x86,
-	0xc3, 0xb2, 0xe7, 0xa4, 0xfd, 0xc6, 0x39, 0x7b, 0xf7, 0x7b, 0xed, 0x39, 0x37, 0x6b, 0xcf, 0xf9,
-	0xbb, 0xf6, 0x9c, 0x9f, 0x1b, 0xaf, 0x75, 0xb3, 0xf1, 0x5a, 0x7f, 0x36, 0x5e, 0xeb, 0xdb, 0xf3,
-	0x44, 0x98, 0x1f, 0xf3, 0x89, 0x3f, 0x55, 0xb3, 0xe0, 0xb3, 0xd0, 0xfc, 0xbd, 0xd2, 0x10, 0x20,
-	0xa4, 0x5c, 0x04, 0xcb, 0x20, 0x51, 0x8b, 0xc0, 0xac, 0x72, 0xc0, 0xc9, 0x7e, 0xf1, 0x07, 0xbe,
-	0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0x24, 0xc9, 0x58, 0x9c, 0xfc, 0x02, 0x00, 0x00,
+	// 906 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0x5d, 0x6f, 0x1b, 0x45,
+	0x14, 0x8d, 0x6b, 0x27, 0x24, 0xe3, 0xc4, 0x1f, 0xe3, 0x24, 0x0c, 0x46, 0x72, 0xad, 0x4a, 0x40,
+	0x00, 0xd5, 0x86, 0x56, 0xe2, 0xa3, 0x12, 0x42, 0x4d, 0xe3, 0x94, 0x14, 0x8a, 0xc2, 0x56, 0x80,
+	0xc4, 0xcb, 0x6a, 0xb2, 0x3b, 0xdd, 0x8c, 0x6c, 0xcf, 0xac, 0xe6, 0x8e, 0x1d, 0x9b, 0x5f, 0xc1,
+	0x9f, 0x42, 0xea, 0x63, 0x1f, 0x11, 0x0f, 0x15, 0x4a, 0xfe, 0x08, 0x9a, 0xd9, 0x59, 0xef, 0xac,
+	0x43, 0x79, 0xca, 0xce, 0xbd, 0xe7, 0x9c, 0x39, 0xbe, 0xf7, 0xce, 0x0d, 0xda, 0x4b, 0x98, 0x60,
+	0xc0, 0x61, 0x90, 0x2a, 0xa9, 0x25, 0xde, 0x1e, 0x73, 0x45, 0x07, 0x89, 0x9c, 0x77, 0xf7, 0x13,
+	0x99, 0x48, 0x1b, 0x1c, 0x9a, 0xaf, 0x2c, 0xdf, 0xad, 0xd3, 0x48, 0x4b, 0xe5, 0x0e, 0x1d, 0x3a,
+	0xd3, 0x97, 0x52, 0xf1, 0xdf, 0xa9, 0xe6, 0x52, 0xb8, 0x20, 0x52, 0x72, 0xc2, 0x72, 0x00, 0x5b,
+	0xb0, 0x68, 0x66, 0x92, 0xe1, 0x4b, 0x96, 0x07, 0x89, 0x60, 0xfa, 0x4a, 0xaa, 0x71, 0x98, 0x2a,
+	0x99, 0x32, 0xa5, 0x39, 0x73, 0x97, 0x77, 0x0f, 0xe9, 0x64, 0x22, 0xaf, 0x58, 0x1c, 0x4e, 0x19,
+	0x00, 0x4d, 0x56, 0xf1, 0x86, 0x41, 0x4a, 0xa0, 0x13, 0x77, 0x6e, 0x46, 0x72, 0x26, 0x22, 0x3e,
+	0x59, 0x19, 0xa9, 0x27, 0x4a, 0xce, 0xd2, 0xfc, 0xd2, 0x98, 0x6a, 0x1a, 0x2a, 0x96, 0x70, 0xd0,
+	0x6a, 0x99, 0x05, 0xef, 0xfd, 0xb9, 0x8b, 0x76, 0x9f, 0x66, 0xbf, 0xf4, 0x85, 0xa6, 0x9a, 0xe1,
+	0xcf, 0xd0, 0x3e, 0x68, 0xaa, 0x34, 0x17, 0x49, 0x98, 0xcb, 0x87, 0x3c, 0x26, 0x95, 0x7e, 0xe5,
+	0xa8, 0x16, 0xe0, 0x3c, 0x77, 0xee, 0x52, 0x67, 0x31, 0x3e, 0x43, 0xf5, 0x94, 0xa9, 0x29, 0x07,
+	0xe0, 0x52, 0x00, 0xb9, 0xd3, 0xaf, 0x1e, 0xd5, 0x1f, 0x7c, 0x34, 0xc8, 0x0b, 0x36, 0xf0, 0xe5,
+	0x07, 0xe7, 0x05, 0x72, 0x24, 0xb4, 0x5a, 0x06, 0x3e, 0x17, 0x7f, 0x83, 0x1a, 0x79, 0x11, 0x6c,
+	0x3d, 0x81, 0x54, 0xad, 0xda, 0x61, 0xa1, 0xf6, 0x63, 0x96, 0x7f, 0x6c, 0xd2, 0xc1, 0x9e, 0xf0,
+	0x4e, 0x80, 0x9f, 0x21, 0x7c, 0xbb, 0x86, 0xa4, 0xd6, 0xaf, 0x1c, 0xd5, 0x1f, 0xbc, 0x7f, 0x4b,
+	0xe2, 0x7c, 0x05, 0x09, 0xda, 0x62, 0x3d, 0x64, 0xac, 0x94, 0x9a, 0x04, 0x64, 0x73, 0xdd, 0xca,
+	0x28, 0xcf, 0x9f, 0x32, 0x16, 0xec, 0x31, 0xef, 0x04, 0xf8, 0x39, 0x3a, 0x48, 0xa5, 0x54, 0x61,
+	0xee, 0x27, 0xef, 0x1c, 0xd9, 0xb2, 0x6e, 0xde, 0x2b, 0x54, 0x1e, 0x67, 0xbd, 0x7d, 0xee, 0x00,
+	0x41, 0xc7, 0xf0, 0x9c, 0xc5, 0x3c, 0x88, 0x1f, 0xa1, 0x6e, 0x59, 0x8e, 0x2e, 0xc2, 0x0b, 0x2a,
+	0xc6, 0x21, 0x30, 0x11, 0x93, 0x77, 0x6c, 0x6f, 0x0e, 0x7d, 0x22, 0x5d, 0x1c, 0x53, 0x31, 0x7e,
+	0xc1, 0x44, 0x8c, 0x9f, 0xa1, 0xb6, 0x19, 0xbd, 0x70, 0x2e, 0x35, 0x0b, 0xaf, 0x18, 0x4f, 0x2e,
+	0x35, 0x90, 0x6d, 0xfb, 0x63, 0x48, 0x61, 0x23, 0x90, 0x13, 0xf6, 0x8b, 0xd4, 0xec, 0x57, 0x0b,
+	0x38, 0xae, 0xbd, 0x7a, 0x73, 0x77, 0x23, 0x68, 0xaa, 0x52, 0x14, 0xf0, 0xd7, 0x08, 0xad, 0x66,
+	0x0c, 0xc8, 0x8e, 0x15, 0xe9, 0x14, 0x22, 0x4f, 0xf2, 0x9c, 0xe3, 0x7b, 0x60, 0x1c, 0xa1, 0x83,
+	0xd2, 0x00, 0x86, 0x4c, 0x68, 0x65, 0xfa, 0x83, 0xac, 0xca, 0xf0, 0x2d, 0x03, 0x73, 0x42, 0x35,
+	0x0d, 0x1c, 0x65, 0x94, 0x31, 0xb2, 0xc1, 0xe9, 0xc4, 0xb7, 0x33, 0xf8, 0x0b, 0xb4, 0x93, 0x0f,
+	0x2d, 0x90, 0xba, 0x15, 0xc6, 0x85, 0x70, 0x3e, 0xb4, 0xce, 0x5d, 0x01, 0xc5, 0x1f, 0xa3, 0x16,
+	0x8d, 0x34, 0x9f, 0xb3, 0xb0, 0xa0, 0xef, 0xf6, 0xab, 0x47, 0xb5, 0xa0, 0x99, 0xc5, 0xcf, 0x57,
+	0xd0, 0x21, 0xea, 0x30, 0x41, 0x23, 0x3d, 0x65, 0x42, 0x7b, 0xe8, 0x3d, 0x8b, 0xc6, 0xab, 0x54,
+	0x41, 0xf8, 0x04, 0x6d, 0x9a, 0xd2, 0x03, 0x69, 0x58, 0x3f, 0x8d, 0xc2, 0x8f, 0xa9, 0xac, 0xf3,
+	0x92, 0x41, 0xf0, 0x97, 0x68, 0xd7, 0xb6, 0xe9, 0x92, 0x83, 0x96, 0x6a, 0x49, 0x9a, 0xff, 0x43,
+	0xa9, 0x1b, 0xe4, 0x77, 0x19, 0x10, 0x3f, 0x44, 0xdb, 0x31, 0x4b, 0x25, 0x70, 0x0d, 0xa4, 0x65,
+	0x49, 0xed, 0x82, 0x74, 0x92, 0x65, 0x1c, 0x6f, 0x05, 0xc4, 0x03, 0xb4, 0x69, 0x1a, 0x0c, 0xa4,
+	0xbd, 0x5e, 0x29, 0x33, 0x0d, 0x67, 0xe2, 0xa5, 0xcc, 0xdd, 0x59, 0x18, 0xfe, 0x09, 0x75, 0x8a,
+	0xd7, 0x1a, 0xb2, 0x45, 0xca, 0x6d, 0x03, 0xb1, 0x65, 0x77, 0xbd, 0x3a, 0xaf, 0x40, 0x23, 0x83,
+	0x59, 0x3a, 0x15, 0x9c, 0x96, 0xe3, 0xa6, 0x61, 0x3f, 0xa0, 0xb6, 0x27, 0x99, 0x28, 0x2a, 0x34,
+	0x90, 0x4e, 0xbf, 0x5a, 0x7e, 0x23, 0x85, 0xe0, 0x53, 0x83, 0x70, 0x7a, 0xad, 0xb4, 0x1c, 0x06,
+	0x3c, 0x42, 0x8d, 0xd2, 0xea, 0x05, 0xb2, 0x6f, 0xa5, 0xde, 0xf5, 0x9e, 0x9b, 0x9f, 0x77, 0x42,
+	0x6b, 0x24, 0x7c, 0x1f, 0x6d, 0xd9, 0xc5, 0x09, 0xe4, 0xc0, 0xd2, 0x9b, 0xde, 0x6c, 0x9a, 0xb8,
+	0xa3, 0x39, 0x10, 0x3e, 0x45, 0x4d, 0xfb, 0xe5, 0x4d, 0xc3, 0xe1, 0xfa, 0xb5, 0x96, 0xb7, 0x36,
+	0x7f, 0x8d, 0xc4, 0x0f, 0x42, 0xf7, 0x67, 0xd4, 0x5a, 0x5f, 0x8f, 0xb8, 0x85, 0xaa, 0x63, 0xb6,
+	0x74, 0xdb, 0xd7, 0x7c, 0xe2, 0x4f, 0xd1, 0xe6, 0x9c, 0x4e, 0x66, 0x8c, 0xdc, 0xb1, 0x9b, 0xe4,
+	0xe0, 0xbf, 0xaa, 0x04, 0x41, 0x86, 0x79, 0x74, 0xe7, 0xab, 0x4a, 0x37, 0x42, 0xe4, 0x6d, 0x8f,
+	0xc8, 0x97, 0xdf, 0xc9, 0xe4, 0x3f, 0x2f, 0xcb, 0x7b, 0x6b, 0x73, 0x5d, 0x64, 0xe9, 0x5d, 0x72,
+	0x6f, 0x82, 0x1a, 0xe5, 0x0d, 0x82, 0x31, 0xaa, 0x99, 0xa9, 0x71, 0xd6, 0xed, 0x37, 0x3e, 0x45,
+	0x5b, 0xd9, 0x02, 0xb2, 0xea, 0x3b, 0xc7, 0x03, 0x53, 0x87, 0xbf, 0xdf, 0xdc, 0xfd, 0x30, 0xe1,
+	0xfa, 0x72, 0x76, 0x31, 0x88, 0xe4, 0x74, 0x18, 0x49, 0x98, 0x4a, 0x70, 0x7f, 0xee, 0x43, 0x3c,
+	0x1e, 0xea, 0x65, 0xca, 0x60, 0x70, 0xc2, 0xa2, 0xc0, 0xb1, 0x8f, 0xbf, 0x7d, 0x75, 0xdd, 0xab,
+	0xbc, 0xbe, 0xee, 0x55, 0xfe, 0xb9, 0xee, 0x55, 0xfe, 0xb8, 0xe9, 0x6d, 0xbc, 0xbe, 0xe9, 0x6d,
+	0xfc, 0x75, 0xd3, 0xdb, 0xf8, 0xed, 0x03, 0x4f, 0xe9, 0x7b, 0xae, 0xe8, 0x13, 0xa9, 0xd8, 0x10,
+	0xd8, 0x98, 0xf2, 0xe1, 0x62, 0x98, 0xc8, 0x79, 0x26, 0x76, 0xb1, 0x65, 0xff, 0xfb, 0x3d, 0xfc,
+	0x77, 0x00, 0x97, 0xef, 0xb3, 0x80, 0xe6, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -176,6 +385,250 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GroupProposals) > 0 {
+		for iNdEx := len(m.GroupProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GroupProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.Authorizations) > 0 {
+		for iNdEx := len(m.Authorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.PermissionGrants) > 0 {
+		for iNdEx := len(m.PermissionGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PermissionGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.PermissionExpiries) > 0 {
+		for iNdEx := len(m.PermissionExpiries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PermissionExpiries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.VoteHistory) > 0 {
+		for iNdEx := len(m.VoteHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.EnactmentProposals) > 0 {
+		dAtA2 := make([]byte, len(m.EnactmentProposals)*10)
+		var j1 int
+		for _, num := range m.EnactmentProposals {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.ActiveProposals) > 0 {
+		dAtA4 := make([]byte, len(m.ActiveProposals)*10)
+		var j3 int
+		for _, num := range m.ActiveProposals {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintGenesis(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DataRegistryEntries) > 0 {
+		for k := range m.DataRegistryEntries {
+			v := m.DataRegistryEntries[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintGenesis(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Councilors) > 0 {
+		for iNdEx := len(m.Councilors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Councilors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.RoleVoteWeights) > 0 {
+		for iNdEx := len(m.RoleVoteWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleVoteWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.PoorNetworkMaxBankSend != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.PoorNetworkMaxBankSend))
 		i--
@@ -265,8 +718,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
+func (m *RoleVoteWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleVoteWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleVoteWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Role != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
 	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -321,6 +812,119 @@ func (m *GenesisState) Size() (n int) {
 	if m.PoorNetworkMaxBankSend != 0 {
 		n += 1 + sovGenesis(uint64(m.PoorNetworkMaxBankSend))
 	}
+	if len(m.RoleVoteWeights) > 0 {
+		for _, e := range m.RoleVoteWeights {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Councilors) > 0 {
+		for _, e := range m.Councilors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DataRegistryEntries) > 0 {
+		for k, v := range m.DataRegistryEntries {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovGenesis(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovGenesis(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ActiveProposals) > 0 {
+		l = 0
+		for _, e := range m.ActiveProposals {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.EnactmentProposals) > 0 {
+		l = 0
+		for _, e := range m.EnactmentProposals {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoteHistory) > 0 {
+		for _, e := range m.VoteHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PermissionExpiries) > 0 {
+		for _, e := range m.PermissionExpiries {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PermissionGrants) > 0 {
+		for _, e := range m.PermissionGrants {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Authorizations) > 0 {
+		for _, e := range m.Authorizations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GroupProposals) > 0 {
+		for _, e := range m.GroupProposals {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RoleVoteWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovGenesis(uint64(m.Role))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -340,30 +944,774 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenesis
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingProposalId", wireType)
+			}
+			m.StartingProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartingProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Permissions == nil {
+				m.Permissions = make(map[uint64]*Permissions)
+			}
+			var mapkey uint64
+			var mapvalue *Permissions
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Permissions{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Permissions[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkActors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkActors = append(m.NetworkActors, &NetworkActor{})
+			if err := m.NetworkActors[len(m.NetworkActors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkProperties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NetworkProperties == nil {
+				m.NetworkProperties = &NetworkProperties{}
+			}
+			if err := m.NetworkProperties.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionFees = append(m.ExecutionFees, &ExecutionFee{})
+			if err := m.ExecutionFees[len(m.ExecutionFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoorNetworkMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PoorNetworkMessages == nil {
+				m.PoorNetworkMessages = &AllowedMessages{}
+			}
+			if err := m.PoorNetworkMessages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoorNetworkMaxBankSend", wireType)
+			}
+			m.PoorNetworkMaxBankSend = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoorNetworkMaxBankSend |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleVoteWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleVoteWeights = append(m.RoleVoteWeights, RoleVoteWeight{})
+			if err := m.RoleVoteWeights[len(m.RoleVoteWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Councilors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Councilors = append(m.Councilors, Councilor{})
+			if err := m.Councilors[len(m.Councilors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRegistryEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DataRegistryEntries == nil {
+				m.DataRegistryEntries = make(map[string]*DataRegistryEntry)
+			}
+			var mapkey string
+			var mapvalue *DataRegistryEntry
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &DataRegistryEntry{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.DataRegistryEntries[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ActiveProposals = append(m.ActiveProposals, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ActiveProposals) == 0 {
+					m.ActiveProposals = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ActiveProposals = append(m.ActiveProposals, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveProposals", wireType)
+			}
+		case 13:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.EnactmentProposals = append(m.EnactmentProposals, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.EnactmentProposals) == 0 {
+					m.EnactmentProposals = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.EnactmentProposals = append(m.EnactmentProposals, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnactmentProposals", wireType)
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.VoteHistory = append(m.VoteHistory, Vote{})
+			if err := m.VoteHistory[len(m.VoteHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingProposalId", wireType)
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
 			}
-			m.StartingProposalId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -373,14 +1721,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartingProposalId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, Deposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -407,95 +1770,48 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Permissions == nil {
-				m.Permissions = make(map[uint64]*Permissions)
+			m.Roles = append(m.Roles, RoleInfo{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey uint64
-			var mapvalue *Permissions
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionExpiries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Permissions{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenesis(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Permissions[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PermissionExpiries = append(m.PermissionExpiries, PermissionExpiry{})
+			if err := m.PermissionExpiries[len(m.PermissionExpiries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkActors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionGrants", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -522,14 +1838,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetworkActors = append(m.NetworkActors, &NetworkActor{})
-			if err := m.NetworkActors[len(m.NetworkActors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PermissionGrants = append(m.PermissionGrants, PermissionGrant{})
+			if err := m.PermissionGrants[len(m.PermissionGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkProperties", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -556,16 +1872,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NetworkProperties == nil {
-				m.NetworkProperties = &NetworkProperties{}
-			}
-			if err := m.NetworkProperties.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Authorizations = append(m.Authorizations, Authorization{})
+			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -592,14 +1906,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExecutionFees = append(m.ExecutionFees, &ExecutionFee{})
-			if err := m.ExecutionFees[len(m.ExecutionFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Groups = append(m.Groups, Group{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoorNetworkMessages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupProposals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -626,18 +1940,69 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PoorNetworkMessages == nil {
-				m.PoorNetworkMessages = &AllowedMessages{}
-			}
-			if err := m.PoorNetworkMessages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GroupProposals = append(m.GroupProposals, GroupProposal{})
+			if err := m.GroupProposals[len(m.GroupProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleVoteWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleVoteWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleVoteWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoorNetworkMaxBankSend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			m.PoorNetworkMaxBankSend = 0
+			m.Role = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -647,11 +2012,45 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoorNetworkMaxBankSend |= uint64(b&0x7F) << shift
+				m.Role |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
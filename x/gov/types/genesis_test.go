@@ -0,0 +1,336 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	addr1 := types.AccAddress("addr1_______________")
+	addr2 := types.AccAddress("addr2_______________")
+	now := time.Unix(1600000000, 0).UTC()
+
+	proposal, err := NewProposal(1, NewAssignPermissionProposal(addr1, PermClaimValidator), now, now.Add(time.Minute), now.Add(2*time.Minute))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		malleate  func(data *GenesisState)
+		expectErr bool
+	}{
+		{
+			name:      "default genesis",
+			malleate:  func(data *GenesisState) {},
+			expectErr: false,
+		},
+		{
+			name: "valid actors and proposals",
+			malleate: func(data *GenesisState) {
+				actor := NewNetworkActor(addr1, Roles{uint64(RoleSudo)}, Active, nil, NewPermissions([]PermValue{PermClaimCouncilor}, nil), 1)
+				data.NetworkActors = []*NetworkActor{&actor}
+				data.Councilors = []Councilor{NewCouncilor("moniker", "website", "social", "identity", addr1, CouncilorActive, now)}
+				data.Proposals = []Proposal{proposal}
+				data.ActiveProposals = []uint64{1}
+				data.Votes = []Vote{NewVote(1, addr1, OptionYes)}
+				data.StartingProposalId = 2
+			},
+			expectErr: false,
+		},
+		{
+			name: "missing network properties",
+			malleate: func(data *GenesisState) {
+				data.NetworkProperties = nil
+			},
+			expectErr: true,
+		},
+		{
+			name: "min tx fee over max tx fee",
+			malleate: func(data *GenesisState) {
+				data.NetworkProperties.MinTxFee = data.NetworkProperties.MaxTxFee + 1
+			},
+			expectErr: true,
+		},
+		{
+			name: "min jail time not lower than jail max time",
+			malleate: func(data *GenesisState) {
+				data.NetworkProperties.JailMaxTime = 10
+				data.NetworkProperties.MinJailTime = data.NetworkProperties.JailMaxTime
+			},
+			expectErr: true,
+		},
+		{
+			name: "max commission change over 100",
+			malleate: func(data *GenesisState) {
+				data.NetworkProperties.MaxCommissionChange = 101
+			},
+			expectErr: true,
+		},
+		{
+			name: "max validators lower than min validators",
+			malleate: func(data *GenesisState) {
+				data.NetworkProperties.MinValidators = 5
+				data.NetworkProperties.MaxValidators = 4
+			},
+			expectErr: true,
+		},
+		{
+			name: "no max validators",
+			malleate: func(data *GenesisState) {
+				data.NetworkProperties.MinValidators = 5
+				data.NetworkProperties.MaxValidators = 0
+			},
+			expectErr: false,
+		},
+		{
+			name: "no uptime epoch retention",
+			malleate: func(data *GenesisState) {
+				data.NetworkProperties.UptimeEpochLength = 720
+				data.NetworkProperties.UptimeEpochRetention = 0
+			},
+			expectErr: true,
+		},
+		{
+			name: "uptime history disabled",
+			malleate: func(data *GenesisState) {
+				data.NetworkProperties.UptimeEpochLength = 0
+				data.NetworkProperties.UptimeEpochRetention = 0
+			},
+			expectErr: false,
+		},
+		{
+			name: "vote quorum over 100",
+			malleate: func(data *GenesisState) {
+				data.NetworkProperties.VoteQuorum = 101
+			},
+			expectErr: true,
+		},
+		{
+			name: "unknown role permission",
+			malleate: func(data *GenesisState) {
+				data.Permissions[uint64(RoleValidator)] = &Permissions{Whitelist: []uint32{9999}}
+			},
+			expectErr: true,
+		},
+		{
+			name: "role info of undefined role",
+			malleate: func(data *GenesisState) {
+				data.Roles = append(data.Roles, NewRoleInfo(Role(1234), "auditor", "", false))
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicate role sid",
+			malleate: func(data *GenesisState) {
+				data.Permissions[3] = NewPermissions(nil, nil)
+				data.Roles = append(data.Roles, NewRoleInfo(Role(3), "sudo", "", false))
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid role sid",
+			malleate: func(data *GenesisState) {
+				data.Permissions[3] = NewPermissions(nil, nil)
+				data.Roles = append(data.Roles, NewRoleInfo(Role(3), "3", "", false))
+			},
+			expectErr: true,
+		},
+		{
+			name: "valid permission expiry and grant",
+			malleate: func(data *GenesisState) {
+				actor := NewNetworkActor(addr1, nil, Active, nil, NewPermissions([]PermValue{PermUpsertTokenRate}, nil), 1)
+				data.NetworkActors = []*NetworkActor{&actor}
+				data.PermissionExpiries = []PermissionExpiry{NewPermissionExpiry(addr1, PermUpsertTokenRate, 100, nil)}
+				data.PermissionGrants = []PermissionGrant{NewPermissionGrant(addr1, addr2, PermUpsertTokenRate, 0, &now)}
+			},
+			expectErr: false,
+		},
+		{
+			name: "expiry of a permission which is not whitelisted",
+			malleate: func(data *GenesisState) {
+				data.PermissionExpiries = []PermissionExpiry{NewPermissionExpiry(addr1, PermUpsertTokenRate, 100, nil)}
+			},
+			expectErr: true,
+		},
+		{
+			name: "grant of a permission which can not be delegated",
+			malleate: func(data *GenesisState) {
+				data.PermissionGrants = []PermissionGrant{NewPermissionGrant(addr1, addr2, PermSetPermissions, 0, nil)}
+			},
+			expectErr: true,
+		},
+		{
+			name: "grant to the delegator",
+			malleate: func(data *GenesisState) {
+				data.PermissionGrants = []PermissionGrant{NewPermissionGrant(addr1, addr1, PermUpsertTokenRate, 0, nil)}
+			},
+			expectErr: true,
+		},
+		{
+			name: "valid authorization",
+			malleate: func(data *GenesisState) {
+				data.Authorizations = []Authorization{NewAuthorization(addr1, addr2, MsgTypeURL(&MsgVoteProposal{}), nil, 2, 0, &now)}
+			},
+			expectErr: false,
+		},
+		{
+			name: "duplicate authorization",
+			malleate: func(data *GenesisState) {
+				data.Authorizations = []Authorization{
+					NewAuthorization(addr1, addr2, MsgTypeURL(&MsgVoteProposal{}), nil, 0, 0, nil),
+					NewAuthorization(addr1, addr2, MsgTypeURL(&MsgVoteProposal{}), nil, 1, 0, nil),
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "authorization with spend limit on another message",
+			malleate: func(data *GenesisState) {
+				limit := types.NewCoins(types.NewInt64Coin("ukex", 10))
+				data.Authorizations = []Authorization{NewAuthorization(addr1, addr2, MsgTypeURL(&MsgVoteProposal{}), limit, 0, 0, nil)}
+			},
+			expectErr: true,
+		},
+		{
+			name: "used up authorization",
+			malleate: func(data *GenesisState) {
+				auth := NewAuthorization(addr1, addr2, MsgTypeURL(&MsgVoteProposal{}), nil, 1, 0, nil)
+				auth.Uses = 1
+				data.Authorizations = []Authorization{auth}
+			},
+			expectErr: true,
+		},
+		{
+			name: "valid group and group proposal",
+			malleate: func(data *GenesisState) {
+				data.Groups = []Group{NewGroup(1, []GroupMember{NewGroupMember(addr1, 1), NewGroupMember(addr2, 1)}, 2, "")}
+				data.GroupProposals = []GroupProposal{NewGroupProposal(1, 1, addr1, nil)}
+			},
+			expectErr: false,
+		},
+		{
+			name: "group with an unreachable threshold",
+			malleate: func(data *GenesisState) {
+				data.Groups = []Group{NewGroup(1, []GroupMember{NewGroupMember(addr1, 1)}, 2, "")}
+			},
+			expectErr: true,
+		},
+		{
+			name: "group with another address",
+			malleate: func(data *GenesisState) {
+				group := NewGroup(1, []GroupMember{NewGroupMember(addr1, 1)}, 1, "")
+				group.Address = addr2
+				data.Groups = []Group{group}
+			},
+			expectErr: true,
+		},
+		{
+			name: "group proposal of undefined group",
+			malleate: func(data *GenesisState) {
+				data.GroupProposals = []GroupProposal{NewGroupProposal(1, 1, addr1, nil)}
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicate network actor",
+			malleate: func(data *GenesisState) {
+				actor := NewNetworkActor(addr1, nil, Active, nil, NewPermissions(nil, nil), 1)
+				data.NetworkActors = []*NetworkActor{&actor, &actor}
+			},
+			expectErr: true,
+		},
+		{
+			name: "network actor with undefined role",
+			malleate: func(data *GenesisState) {
+				actor := NewNetworkActor(addr1, Roles{1234}, Active, nil, NewPermissions(nil, nil), 1)
+				data.NetworkActors = []*NetworkActor{&actor}
+			},
+			expectErr: true,
+		},
+		{
+			name: "network actor with unknown permission",
+			malleate: func(data *GenesisState) {
+				actor := NewNetworkActor(addr1, nil, Active, nil, &Permissions{Blacklist: []uint32{9999}}, 1)
+				data.NetworkActors = []*NetworkActor{&actor}
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicate councilor",
+			malleate: func(data *GenesisState) {
+				councilor := NewCouncilor("moniker", "website", "social", "identity", addr2, CouncilorActive, now)
+				data.Councilors = []Councilor{councilor, councilor}
+			},
+			expectErr: true,
+		},
+		{
+			name: "councilor with unknown status",
+			malleate: func(data *GenesisState) {
+				data.Councilors = []Councilor{NewCouncilor("moniker", "website", "social", "identity", addr2, CouncilorStatus(9), now)}
+			},
+			expectErr: true,
+		},
+		{
+			name: "proposal not lower than the starting proposal id",
+			malleate: func(data *GenesisState) {
+				data.Proposals = []Proposal{proposal}
+				data.StartingProposalId = 1
+			},
+			expectErr: true,
+		},
+		{
+			name: "active proposal does not exist",
+			malleate: func(data *GenesisState) {
+				data.ActiveProposals = []uint64{1}
+			},
+			expectErr: true,
+		},
+		{
+			name: "vote on a proposal which does not exist",
+			malleate: func(data *GenesisState) {
+				data.Votes = []Vote{NewVote(1, addr1, OptionYes)}
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicate execution fee",
+			malleate: func(data *GenesisState) {
+				data.ExecutionFees = append(data.ExecutionFees, data.ExecutionFees[0])
+			},
+			expectErr: true,
+		},
+	}
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			data := DefaultGenesis()
+			test.malleate(data)
+
+			err := data.Validate()
+			if test.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGenesisState_IsAllowed(t *testing.T) {
+	addr1 := types.AccAddress("addr1_______________")
+	addr2 := types.AccAddress("addr2_______________")
+	addr3 := types.AccAddress("addr3_______________")
+
+	data := DefaultGenesis()
+	sudo := NewNetworkActor(addr1, Roles{uint64(RoleSudo)}, Active, nil, NewPermissions(nil, nil), 1)
+	blacklisted := NewNetworkActor(addr2, Roles{uint64(RoleValidator)}, Active, nil, NewPermissions(nil, []PermValue{PermClaimValidator}), 1)
+	data.NetworkActors = []*NetworkActor{&sudo, &blacklisted}
+
+	require.True(t, data.IsAllowed(addr1, PermClaimValidator))
+	require.False(t, data.IsAllowed(addr2, PermClaimValidator))
+	require.False(t, data.IsAllowed(addr3, PermClaimValidator))
+}
@@ -0,0 +1,129 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+var _ codectypes.UnpackInterfacesMessage = GroupProposal{}
+
+// GroupAddress returns the address of a group, derived from its id. No key controls it, the messages
+// it signs are executed by the group proposals.
+func GroupAddress(groupID uint64) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("%s/group/%d", ModuleName, groupID))))
+}
+
+// ValidateGroupMembers checks the members are distinct with a weight, and the threshold can be reached.
+func ValidateGroupMembers(members []GroupMember, threshold uint64) error {
+	if len(members) == 0 {
+		return fmt.Errorf("no member")
+	}
+
+	totalWeight := uint64(0)
+	seen := make(map[string]bool)
+	for _, member := range members {
+		if member.Address.Empty() {
+			return fmt.Errorf("empty member address")
+		}
+
+		if seen[member.Address.String()] {
+			return fmt.Errorf("duplicate member %s", member.Address)
+		}
+		seen[member.Address.String()] = true
+
+		if member.Weight == 0 {
+			return fmt.Errorf("member %s has no weight", member.Address)
+		}
+		totalWeight += member.Weight
+	}
+
+	if threshold == 0 || threshold > totalWeight {
+		return fmt.Errorf("threshold %d is not between 1 and the total weight %d", threshold, totalWeight)
+	}
+
+	return nil
+}
+
+func NewGroupMember(address sdk.AccAddress, weight uint64) GroupMember {
+	return GroupMember{
+		Address: address,
+		Weight:  weight,
+	}
+}
+
+func NewGroup(id uint64, members []GroupMember, threshold uint64, description string) Group {
+	return Group{
+		Id:          id,
+		Address:     GroupAddress(id),
+		Members:     members,
+		Threshold:   threshold,
+		Description: description,
+	}
+}
+
+// MemberWeight returns the weight of a member of the group, false if the address is not a member.
+func (g Group) MemberWeight(address sdk.AccAddress) (uint64, bool) {
+	for _, member := range g.Members {
+		if member.Address.Equals(address) {
+			return member.Weight, true
+		}
+	}
+
+	return 0, false
+}
+
+// ApprovalWeight returns the weight of the approvals given by the current members of the group.
+func (g Group) ApprovalWeight(approvals []sdk.AccAddress) uint64 {
+	weight := uint64(0)
+	for _, approval := range approvals {
+		memberWeight, _ := g.MemberWeight(approval)
+		weight += memberWeight
+	}
+
+	return weight
+}
+
+func NewGroupProposal(id, groupID uint64, proposer sdk.AccAddress, msgs []*codectypes.Any) GroupProposal {
+	return GroupProposal{
+		Id:       id,
+		GroupId:  groupID,
+		Proposer: proposer,
+		Msgs:     msgs,
+		Status:   GroupProposalPending,
+	}
+}
+
+// HasApproved returns true if the address approved the proposal.
+func (p GroupProposal) HasApproved(address sdk.AccAddress) bool {
+	for _, approval := range p.Approvals {
+		if approval.Equals(address) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// UnpackedMsgs returns the messages proposed to the group
+func (p GroupProposal) UnpackedMsgs() []sdk.Msg {
+	return unpackedMsgs(p.Msgs)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p GroupProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackMsgs(unpacker, p.Msgs)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r GroupProposalsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, p := range r.Proposals {
+		err := p.UnpackInterfaces(unpacker)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,17 +1,35 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/KiraCore/sekai/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 var (
 	// Proposal
 	_ sdk.Msg = &MsgVoteProposal{}
+	_ sdk.Msg = &MsgCancelProposal{}
 
 	// Permissions
 	_ sdk.Msg = &MsgWhitelistPermissions{}
 	_ sdk.Msg = &MsgBlacklistPermissions{}
+	_ sdk.Msg = &MsgDelegatePermission{}
+	_ sdk.Msg = &MsgRevokePermission{}
+	_ sdk.Msg = &MsgGrantAuthorization{}
+	_ sdk.Msg = &MsgRevokeAuthorization{}
+	_ sdk.Msg = &MsgExec{}
+
+	// Groups
+	_ sdk.Msg = &MsgCreateGroup{}
+	_ sdk.Msg = &MsgUpdateGroup{}
+	_ sdk.Msg = &MsgSubmitGroupProposal{}
+	_ sdk.Msg = &MsgApproveGroupProposal{}
 	_ sdk.Msg = &MsgProposalAssignPermission{}
 	_ sdk.Msg = &MsgProposalUpsertDataRegistry{}
 	_ sdk.Msg = &MsgProposalSetPoorNetworkMessages{}
@@ -30,16 +48,32 @@ var (
 	_ sdk.Msg = &MsgRemoveBlacklistRolePermission{}
 
 	_ sdk.Msg = &MsgProposalCreateRole{}
+	_ sdk.Msg = &MsgProposalSetRoleVoteWeight{}
+	_ sdk.Msg = &MsgProposalMultiContent{}
+
+	// Params
+	_ sdk.Msg = &MsgProposalParameterChange{}
+
+	// Upgrade
+	_ sdk.Msg = &MsgProposalSoftwareUpgrade{}
+	_ sdk.Msg = &MsgProposalCancelSoftwareUpgrade{}
+
+	// Councilor
+	_ sdk.Msg = &MsgProposalRemoveCouncilor{}
 )
 
 func NewMsgWhitelistPermissions(
 	proposer, address sdk.AccAddress,
 	permission uint32,
+	expiryHeight uint64,
+	expiryTime *time.Time,
 ) *MsgWhitelistPermissions {
 	return &MsgWhitelistPermissions{
-		Proposer:   proposer,
-		Address:    address,
-		Permission: permission,
+		Proposer:     proposer,
+		Address:      address,
+		Permission:   permission,
+		ExpiryHeight: expiryHeight,
+		ExpiryTime:   expiryTime,
 	}
 }
 
@@ -74,6 +108,476 @@ func (m *MsgWhitelistPermissions) GetSigners() []sdk.AccAddress {
 	}
 }
 
+func NewMsgDelegatePermission(
+	delegator, delegatee sdk.AccAddress,
+	permission uint32,
+	expiryHeight uint64,
+	expiryTime *time.Time,
+) *MsgDelegatePermission {
+	return &MsgDelegatePermission{
+		Delegator:    delegator,
+		Delegatee:    delegatee,
+		Permission:   permission,
+		ExpiryHeight: expiryHeight,
+		ExpiryTime:   expiryTime,
+	}
+}
+
+func (m *MsgDelegatePermission) Route() string {
+	return ModuleName
+}
+
+func (m *MsgDelegatePermission) Type() string {
+	return types.MsgTypeDelegatePermission
+}
+
+func (m *MsgDelegatePermission) ValidateBasic() error {
+	if m.Delegator.Empty() || m.Delegatee.Empty() {
+		return ErrEmptyPermissionsAccAddress
+	}
+
+	if m.Delegator.Equals(m.Delegatee) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "the delegatee can not be the delegator")
+	}
+
+	if !IsDelegablePermission(PermValue(m.Permission)) {
+		return sdkerrors.Wrap(ErrPermissionNotDelegable, PermValue(m.Permission).String())
+	}
+
+	return nil
+}
+
+func (m *MsgDelegatePermission) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgDelegatePermission) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		m.Delegator,
+	}
+}
+
+func NewMsgRevokePermission(delegator, delegatee sdk.AccAddress, permission uint32) *MsgRevokePermission {
+	return &MsgRevokePermission{
+		Delegator:  delegator,
+		Delegatee:  delegatee,
+		Permission: permission,
+	}
+}
+
+func (m *MsgRevokePermission) Route() string {
+	return ModuleName
+}
+
+func (m *MsgRevokePermission) Type() string {
+	return types.MsgTypeRevokePermission
+}
+
+func (m *MsgRevokePermission) ValidateBasic() error {
+	if m.Delegator.Empty() || m.Delegatee.Empty() {
+		return ErrEmptyPermissionsAccAddress
+	}
+
+	return nil
+}
+
+func (m *MsgRevokePermission) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgRevokePermission) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		m.Delegator,
+	}
+}
+
+func NewMsgGrantAuthorization(
+	granter, grantee sdk.AccAddress,
+	msgTypeURL string,
+	spendLimit sdk.Coins,
+	maxUses uint64,
+	expiryHeight uint64,
+	expiryTime *time.Time,
+) *MsgGrantAuthorization {
+	return &MsgGrantAuthorization{
+		Granter:      granter,
+		Grantee:      grantee,
+		MsgTypeUrl:   msgTypeURL,
+		SpendLimit:   spendLimit,
+		MaxUses:      maxUses,
+		ExpiryHeight: expiryHeight,
+		ExpiryTime:   expiryTime,
+	}
+}
+
+func (m *MsgGrantAuthorization) Route() string {
+	return ModuleName
+}
+
+func (m *MsgGrantAuthorization) Type() string {
+	return types.MsgTypeGrantAuthorization
+}
+
+func (m *MsgGrantAuthorization) ValidateBasic() error {
+	if m.Granter.Empty() || m.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty granter or grantee")
+	}
+
+	if m.Granter.Equals(m.Grantee) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "the grantee can not be the granter")
+	}
+
+	if err := ValidateAuthorization(m.MsgTypeUrl, m.SpendLimit); err != nil {
+		return sdkerrors.Wrap(ErrInvalidAuthorization, err.Error())
+	}
+
+	return nil
+}
+
+func (m *MsgGrantAuthorization) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgGrantAuthorization) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		m.Granter,
+	}
+}
+
+func NewMsgRevokeAuthorization(granter, grantee sdk.AccAddress, msgTypeURL string) *MsgRevokeAuthorization {
+	return &MsgRevokeAuthorization{
+		Granter:    granter,
+		Grantee:    grantee,
+		MsgTypeUrl: msgTypeURL,
+	}
+}
+
+func (m *MsgRevokeAuthorization) Route() string {
+	return ModuleName
+}
+
+func (m *MsgRevokeAuthorization) Type() string {
+	return types.MsgTypeRevokeAuthorization
+}
+
+func (m *MsgRevokeAuthorization) ValidateBasic() error {
+	if m.Granter.Empty() || m.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty granter or grantee")
+	}
+
+	if m.MsgTypeUrl == "" {
+		return sdkerrors.Wrap(ErrInvalidAuthorization, "empty message type url")
+	}
+
+	return nil
+}
+
+func (m *MsgRevokeAuthorization) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgRevokeAuthorization) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		m.Granter,
+	}
+}
+
+func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) (*MsgExec, error) {
+	anys, err := packMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgExec{
+		Grantee: grantee,
+		Msgs:    anys,
+	}, nil
+}
+
+func (m *MsgExec) Route() string {
+	return ModuleName
+}
+
+func (m *MsgExec) Type() string {
+	return types.MsgTypeExec
+}
+
+func (m *MsgExec) ValidateBasic() error {
+	if m.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty grantee")
+	}
+
+	if len(m.Msgs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidExecMsg, "no message")
+	}
+
+	msgs := m.UnpackedMsgs()
+	if len(msgs) != len(m.Msgs) {
+		return sdkerrors.Wrap(ErrInvalidExecMsg, "invalid message")
+	}
+
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+
+		// the messages are executed on behalf of a single granter, the one checked for permissions
+		if len(msg.GetSigners()) != 1 {
+			return sdkerrors.Wrapf(ErrInvalidExecMsg, "%s must have a single signer", msg.Type())
+		}
+	}
+
+	return nil
+}
+
+func (m *MsgExec) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		m.Grantee,
+	}
+}
+
+// UnpackedMsgs returns the messages to execute
+func (m *MsgExec) UnpackedMsgs() []sdk.Msg {
+	return unpackedMsgs(m.Msgs)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *MsgExec) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackMsgs(unpacker, m.Msgs)
+}
+
+func packMsgs(msgs []sdk.Msg) ([]*codectypes.Any, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+
+	return anys, nil
+}
+
+func unpackedMsgs(anys []*codectypes.Any) []sdk.Msg {
+	msgs := make([]sdk.Msg, 0, len(anys))
+	for _, any := range anys {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if ok {
+			msgs = append(msgs, msg)
+		}
+	}
+
+	return msgs
+}
+
+func unpackMsgs(unpacker codectypes.AnyUnpacker, anys []*codectypes.Any) error {
+	for _, any := range anys {
+		var msg sdk.Msg
+		err := unpacker.UnpackAny(any, &msg)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewMsgCreateGroup(creator sdk.AccAddress, members []GroupMember, threshold uint64, description string) *MsgCreateGroup {
+	return &MsgCreateGroup{
+		Creator:     creator,
+		Members:     members,
+		Threshold:   threshold,
+		Description: description,
+	}
+}
+
+func (m *MsgCreateGroup) Route() string {
+	return ModuleName
+}
+
+func (m *MsgCreateGroup) Type() string {
+	return types.MsgTypeCreateGroup
+}
+
+func (m *MsgCreateGroup) ValidateBasic() error {
+	if m.Creator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty creator")
+	}
+
+	if err := ValidateGroupMembers(m.Members, m.Threshold); err != nil {
+		return sdkerrors.Wrap(ErrInvalidGroup, err.Error())
+	}
+
+	return nil
+}
+
+func (m *MsgCreateGroup) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgCreateGroup) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		m.Creator,
+	}
+}
+
+func NewMsgUpdateGroup(group sdk.AccAddress, members []GroupMember, threshold uint64, description string) *MsgUpdateGroup {
+	return &MsgUpdateGroup{
+		Group:       group,
+		Members:     members,
+		Threshold:   threshold,
+		Description: description,
+	}
+}
+
+func (m *MsgUpdateGroup) Route() string {
+	return ModuleName
+}
+
+func (m *MsgUpdateGroup) Type() string {
+	return types.MsgTypeUpdateGroup
+}
+
+func (m *MsgUpdateGroup) ValidateBasic() error {
+	if m.Group.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty group")
+	}
+
+	if err := ValidateGroupMembers(m.Members, m.Threshold); err != nil {
+		return sdkerrors.Wrap(ErrInvalidGroup, err.Error())
+	}
+
+	return nil
+}
+
+func (m *MsgUpdateGroup) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgUpdateGroup) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		m.Group,
+	}
+}
+
+func NewMsgSubmitGroupProposal(proposer sdk.AccAddress, groupID uint64, msgs []sdk.Msg) (*MsgSubmitGroupProposal, error) {
+	anys, err := packMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSubmitGroupProposal{
+		Proposer: proposer,
+		GroupId:  groupID,
+		Msgs:     anys,
+	}, nil
+}
+
+func (m *MsgSubmitGroupProposal) Route() string {
+	return ModuleName
+}
+
+func (m *MsgSubmitGroupProposal) Type() string {
+	return types.MsgTypeSubmitGroupProposal
+}
+
+func (m *MsgSubmitGroupProposal) ValidateBasic() error {
+	if m.Proposer.Empty() {
+		return ErrEmptyProposerAccAddress
+	}
+
+	if len(m.Msgs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidGroupProposal, "no message")
+	}
+
+	msgs := m.UnpackedMsgs()
+	if len(msgs) != len(m.Msgs) {
+		return sdkerrors.Wrap(ErrInvalidGroupProposal, "invalid message")
+	}
+
+	group := GroupAddress(m.GroupId)
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+
+		// the messages are executed on behalf of the group, the one checked for permissions
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(group) {
+			return sdkerrors.Wrapf(ErrInvalidGroupProposal, "%s must be signed by the group %s only", msg.Type(), group)
+		}
+	}
+
+	return nil
+}
+
+func (m *MsgSubmitGroupProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgSubmitGroupProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		m.Proposer,
+	}
+}
+
+// UnpackedMsgs returns the messages proposed to the group
+func (m *MsgSubmitGroupProposal) UnpackedMsgs() []sdk.Msg {
+	return unpackedMsgs(m.Msgs)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *MsgSubmitGroupProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackMsgs(unpacker, m.Msgs)
+}
+
+func NewMsgApproveGroupProposal(member sdk.AccAddress, proposalID uint64) *MsgApproveGroupProposal {
+	return &MsgApproveGroupProposal{
+		Member:     member,
+		ProposalId: proposalID,
+	}
+}
+
+func (m *MsgApproveGroupProposal) Route() string {
+	return ModuleName
+}
+
+func (m *MsgApproveGroupProposal) Type() string {
+	return types.MsgTypeApproveGroupProposal
+}
+
+func (m *MsgApproveGroupProposal) ValidateBasic() error {
+	if m.Member.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty member")
+	}
+
+	return nil
+}
+
+func (m *MsgApproveGroupProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgApproveGroupProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		m.Member,
+	}
+}
+
 func NewMsgBlacklistPermissions(
 	proposer, address sdk.AccAddress,
 	permission uint32,
@@ -299,8 +803,8 @@ func (m *MsgRemoveBlacklistRolePermission) GetSigners() []sdk.AccAddress {
 	}
 }
 
-func NewMsgCreateRole(proposer sdk.AccAddress, role uint32) *MsgCreateRole {
-	return &MsgCreateRole{Proposer: proposer, Role: role}
+func NewMsgCreateRole(proposer sdk.AccAddress, role uint32, sid string, description string) *MsgCreateRole {
+	return &MsgCreateRole{Proposer: proposer, Role: role, Sid: sid, Description: description}
 }
 
 func (m *MsgCreateRole) Route() string {
@@ -316,7 +820,7 @@ func (m *MsgCreateRole) ValidateBasic() error {
 		return ErrEmptyProposerAccAddress
 	}
 
-	return nil
+	return ValidateRoleSid(m.Sid)
 }
 
 func (m *MsgCreateRole) GetSignBytes() []byte {
@@ -452,7 +956,12 @@ func (m *MsgProposalSetNetworkProperty) ValidateBasic() error {
 		return ErrEmptyProposerAccAddress
 	}
 
-	switch m.NetworkProperty {
+	return ValidateNetworkPropertyValue(m.NetworkProperty, m.Value)
+}
+
+// ValidateNetworkPropertyValue checks the network property can be set by proposal and the value is in its range.
+func ValidateNetworkPropertyValue(property NetworkProperty, value uint64) error {
+	switch property {
 	case MinTxFee,
 		MaxTxFee,
 		VoteQuorum,
@@ -462,7 +971,38 @@ func (m *MsgProposalSetNetworkProperty) ValidateBasic() error {
 		MischanceRankDecreaseAmount,
 		InactiveRankDecreasePercent,
 		PoorNetworkMaxBankSend,
-		MinValidators:
+		MinValidators,
+		UnbondingTime,
+		CouncilorVoteWeight,
+		MinProposalDeposit,
+		MaxCouncilorMissedProposals,
+		FeeLedgerWindow,
+		MinJailTime,
+		CommissionChangeInterval,
+		ExitNoticePeriod,
+		MaxValidators,
+		UptimeEpochLength,
+		CouncilorTerm:
+		return nil
+	case UptimeEpochRetention:
+		if value == 0 {
+			return ErrInvalidNetworkPropertyValue
+		}
+		return nil
+	case ValidatorPowerMode:
+		if !IsValidPowerMode(value) {
+			return ErrInvalidNetworkPropertyValue
+		}
+		return nil
+	case MaxValidatorPowerPercent, MaxCommissionChange:
+		if value > 100 {
+			return ErrInvalidNetworkPropertyValue
+		}
+		return nil
+	case VoteWeightMode:
+		if !IsValidVoteWeightMode(value) {
+			return ErrInvalidNetworkPropertyValue
+		}
 		return nil
 	default:
 		return ErrInvalidNetworkProperty
@@ -566,9 +1106,28 @@ func (m *MsgVoteProposal) ValidateBasic() error {
 		return ErrEmptyProposerAccAddress
 	}
 
+	if len(m.Options) > 0 {
+		err := WeightedVoteOptions(m.Options).ValidateBasic()
+		if err != nil {
+			return sdkerrors.Wrap(ErrInvalidVoteOptions, err.Error())
+		}
+	}
+
+	if len(m.Reason) > MaxVoteReasonLength {
+		return sdkerrors.Wrap(ErrVoteReasonTooLong, fmt.Sprintf("maximum length is %d", MaxVoteReasonLength))
+	}
+
 	return nil
 }
 
+// VoteOptions returns the options of the vote, the whole vote goes to option when it is not split.
+func (m *MsgVoteProposal) VoteOptions() WeightedVoteOptions {
+	if len(m.Options) == 0 {
+		return NewNonSplitVoteOption(m.Option)
+	}
+	return m.Options
+}
+
 func (m *MsgVoteProposal) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(m)
 	return sdk.MustSortJSON(bz)
@@ -580,15 +1139,53 @@ func (m *MsgVoteProposal) GetSigners() []sdk.AccAddress {
 	}
 }
 
+func NewMsgCancelProposal(proposalID uint64, sender sdk.AccAddress) *MsgCancelProposal {
+	return &MsgCancelProposal{
+		ProposalId: proposalID,
+		Sender:     sender,
+	}
+}
+
+func (m *MsgCancelProposal) Route() string {
+	return ModuleName
+}
+
+func (m *MsgCancelProposal) Type() string {
+	return types.MsgTypeCancelProposal
+}
+
+func (m *MsgCancelProposal) ValidateBasic() error {
+	if m.Sender.Empty() {
+		return ErrEmptyProposerAccAddress
+	}
+
+	return nil
+}
+
+func (m *MsgCancelProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		m.Sender,
+	}
+}
+
 func NewMsgProposalCreateRole(
 	proposer sdk.AccAddress,
 	role Role,
+	sid string,
+	description string,
 	whitelistPerms []PermValue,
 	blacklistPerms []PermValue,
 ) *MsgProposalCreateRole {
 	return &MsgProposalCreateRole{
 		Proposer:               proposer,
 		Role:                   uint32(role),
+		Sid:                    sid,
+		Description:            description,
 		WhitelistedPermissions: whitelistPerms,
 		BlacklistedPermissions: blacklistPerms,
 	}
@@ -606,7 +1203,7 @@ func (m *MsgProposalCreateRole) ValidateBasic() error {
 	if m.Proposer.Empty() {
 		return ErrEmptyProposerAccAddress
 	}
-	return nil
+	return ValidateRoleSid(m.Sid)
 }
 
 func (m *MsgProposalCreateRole) GetSignBytes() []byte {
@@ -619,3 +1216,258 @@ func (m *MsgProposalCreateRole) GetSigners() []sdk.AccAddress {
 		m.Proposer,
 	}
 }
+
+func NewMsgProposalSetRoleVoteWeight(proposer sdk.AccAddress, role Role, weight sdk.Dec) *MsgProposalSetRoleVoteWeight {
+	return &MsgProposalSetRoleVoteWeight{
+		Proposer: proposer,
+		Role:     uint32(role),
+		Weight:   weight,
+	}
+}
+
+func (m *MsgProposalSetRoleVoteWeight) Route() string {
+	return ModuleName
+}
+
+func (m *MsgProposalSetRoleVoteWeight) Type() string {
+	return types.MsgTypeProposalSetRoleVoteWeight
+}
+
+func (m *MsgProposalSetRoleVoteWeight) ValidateBasic() error {
+	if m.Proposer.Empty() {
+		return ErrEmptyProposerAccAddress
+	}
+
+	if m.Weight.IsNil() || m.Weight.IsNegative() {
+		return ErrInvalidVoteWeight
+	}
+
+	return nil
+}
+
+func (m *MsgProposalSetRoleVoteWeight) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgProposalSetRoleVoteWeight) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		m.Proposer,
+	}
+}
+
+func NewMsgProposalParameterChange(proposer sdk.AccAddress, changes []ParamChange) *MsgProposalParameterChange {
+	return &MsgProposalParameterChange{
+		Proposer: proposer,
+		Changes:  changes,
+	}
+}
+
+func (m *MsgProposalParameterChange) Route() string {
+	return ModuleName
+}
+
+func (m *MsgProposalParameterChange) Type() string {
+	return types.MsgTypeProposalParameterChange
+}
+
+func (m *MsgProposalParameterChange) ValidateBasic() error {
+	if m.Proposer.Empty() {
+		return ErrEmptyProposerAccAddress
+	}
+
+	return ValidateParamChanges(m.Changes)
+}
+
+func (m *MsgProposalParameterChange) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgProposalParameterChange) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		m.Proposer,
+	}
+}
+
+func NewMsgProposalMultiContent(proposer sdk.AccAddress, contents []Content) (*MsgProposalMultiContent, error) {
+	anys, err := packContents(contents)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgProposalMultiContent{
+		Proposer: proposer,
+		Contents: anys,
+	}, nil
+}
+
+func (m *MsgProposalMultiContent) Route() string {
+	return ModuleName
+}
+
+func (m *MsgProposalMultiContent) Type() string {
+	return types.MsgTypeProposalMultiContent
+}
+
+func (m *MsgProposalMultiContent) ValidateBasic() error {
+	if m.Proposer.Empty() {
+		return ErrEmptyProposerAccAddress
+	}
+
+	if len(m.Contents) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMultiContent, "no content")
+	}
+
+	contents := m.UnpackedContents()
+	if len(contents) != len(m.Contents) {
+		return sdkerrors.Wrap(ErrInvalidMultiContent, "invalid content")
+	}
+
+	return validateContents(contents)
+}
+
+func (m *MsgProposalMultiContent) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgProposalMultiContent) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		m.Proposer,
+	}
+}
+
+// UnpackedContents returns the batched contents
+func (m *MsgProposalMultiContent) UnpackedContents() []Content {
+	return unpackedContents(m.Contents)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *MsgProposalMultiContent) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackContents(unpacker, m.Contents)
+}
+
+func NewMsgProposalSoftwareUpgrade(proposer sdk.AccAddress, plan upgradetypes.Plan) *MsgProposalSoftwareUpgrade {
+	return &MsgProposalSoftwareUpgrade{
+		Proposer: proposer,
+		Plan:     plan,
+	}
+}
+
+func (m *MsgProposalSoftwareUpgrade) Route() string {
+	return ModuleName
+}
+
+func (m *MsgProposalSoftwareUpgrade) Type() string {
+	return types.MsgTypeProposalSoftwareUpgrade
+}
+
+func (m *MsgProposalSoftwareUpgrade) ValidateBasic() error {
+	if m.Proposer.Empty() {
+		return ErrEmptyProposerAccAddress
+	}
+
+	return ValidateUpgradePlan(m.Plan)
+}
+
+func (m *MsgProposalSoftwareUpgrade) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgProposalSoftwareUpgrade) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		m.Proposer,
+	}
+}
+
+// ValidateUpgradePlan checks the plan has a name and is scheduled at a height, upgrades are
+// coordinated by height so that every validator halts at the same block.
+func ValidateUpgradePlan(plan upgradetypes.Plan) error {
+	if err := plan.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidUpgradePlan, err.Error())
+	}
+
+	if plan.Height <= 0 {
+		return sdkerrors.Wrap(ErrInvalidUpgradePlan, "upgrade height must be set")
+	}
+
+	// there is no IBC client to upgrade on the chain
+	if plan.UpgradedClientState != nil {
+		return sdkerrors.Wrap(ErrInvalidUpgradePlan, "upgraded client state is not supported")
+	}
+
+	return nil
+}
+
+func NewMsgProposalCancelSoftwareUpgrade(proposer sdk.AccAddress) *MsgProposalCancelSoftwareUpgrade {
+	return &MsgProposalCancelSoftwareUpgrade{
+		Proposer: proposer,
+	}
+}
+
+func (m *MsgProposalCancelSoftwareUpgrade) Route() string {
+	return ModuleName
+}
+
+func (m *MsgProposalCancelSoftwareUpgrade) Type() string {
+	return types.MsgTypeProposalCancelSoftwareUpgrade
+}
+
+func (m *MsgProposalCancelSoftwareUpgrade) ValidateBasic() error {
+	if m.Proposer.Empty() {
+		return ErrEmptyProposerAccAddress
+	}
+
+	return nil
+}
+
+func (m *MsgProposalCancelSoftwareUpgrade) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgProposalCancelSoftwareUpgrade) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		m.Proposer,
+	}
+}
+
+func NewMsgProposalRemoveCouncilor(proposer, address sdk.AccAddress) *MsgProposalRemoveCouncilor {
+	return &MsgProposalRemoveCouncilor{
+		Proposer: proposer,
+		Address:  address,
+	}
+}
+
+func (m *MsgProposalRemoveCouncilor) Route() string {
+	return ModuleName
+}
+
+func (m *MsgProposalRemoveCouncilor) Type() string {
+	return types.MsgTypeProposalRemoveCouncilor
+}
+
+func (m *MsgProposalRemoveCouncilor) ValidateBasic() error {
+	if m.Proposer.Empty() {
+		return ErrEmptyProposerAccAddress
+	}
+
+	if m.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty councilor address")
+	}
+
+	return nil
+}
+
+func (m *MsgProposalRemoveCouncilor) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgProposalRemoveCouncilor) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		m.Proposer,
+	}
+}
@@ -1,12 +1,16 @@
 package types
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 func TestMsgWhitelistPermissions_ValidateBasic(t *testing.T) {
@@ -21,6 +25,8 @@ func TestMsgWhitelistPermissions_ValidateBasic(t *testing.T) {
 				types.AccAddress{},
 				types.AccAddress("some addr"),
 				0,
+				0,
+				nil,
 			),
 			expectedErr: ErrEmptyProposerAccAddress,
 		},
@@ -30,6 +36,8 @@ func TestMsgWhitelistPermissions_ValidateBasic(t *testing.T) {
 				types.AccAddress("some addr"),
 				types.AccAddress{},
 				0,
+				0,
+				nil,
 			),
 			expectedErr: ErrEmptyPermissionsAccAddress,
 		},
@@ -42,3 +50,538 @@ func TestMsgWhitelistPermissions_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgProposalSetNetworkProperty_ValidateBasic(t *testing.T) {
+	proposer := types.AccAddress("some addr")
+
+	tests := []struct {
+		name        string
+		msg         *MsgProposalSetNetworkProperty
+		expectedErr error
+	}{
+		{
+			name:        "valid power mode",
+			msg:         NewMsgProposalSetNetworkProperty(proposer, ValidatorPowerMode, PowerModeRank),
+			expectedErr: nil,
+		},
+		{
+			name:        "bonded tokens power mode",
+			msg:         NewMsgProposalSetNetworkProperty(proposer, ValidatorPowerMode, PowerModeBondedTokens),
+			expectedErr: nil,
+		},
+		{
+			name:        "unknown power mode",
+			msg:         NewMsgProposalSetNetworkProperty(proposer, ValidatorPowerMode, 4),
+			expectedErr: ErrInvalidNetworkPropertyValue,
+		},
+		{
+			name:        "valid max validator power percent",
+			msg:         NewMsgProposalSetNetworkProperty(proposer, MaxValidatorPowerPercent, 33),
+			expectedErr: nil,
+		},
+		{
+			name:        "max validator power percent over 100",
+			msg:         NewMsgProposalSetNetworkProperty(proposer, MaxValidatorPowerPercent, 101),
+			expectedErr: ErrInvalidNetworkPropertyValue,
+		},
+		{
+			name:        "valid vote weight mode",
+			msg:         NewMsgProposalSetNetworkProperty(proposer, VoteWeightMode, VoteWeightModeBonded),
+			expectedErr: nil,
+		},
+		{
+			name:        "unknown vote weight mode",
+			msg:         NewMsgProposalSetNetworkProperty(proposer, VoteWeightMode, 4),
+			expectedErr: ErrInvalidNetworkPropertyValue,
+		},
+		{
+			name:        "valid uptime epoch retention",
+			msg:         NewMsgProposalSetNetworkProperty(proposer, UptimeEpochRetention, 24),
+			expectedErr: nil,
+		},
+		{
+			name:        "no uptime epoch retention",
+			msg:         NewMsgProposalSetNetworkProperty(proposer, UptimeEpochRetention, 0),
+			expectedErr: ErrInvalidNetworkPropertyValue,
+		},
+	}
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expectedErr, test.msg.ValidateBasic())
+		})
+	}
+}
+
+func TestMsgProposalSetRoleVoteWeight_ValidateBasic(t *testing.T) {
+	proposer := types.AccAddress("some addr")
+
+	tests := []struct {
+		name        string
+		msg         *MsgProposalSetRoleVoteWeight
+		expectedErr error
+	}{
+		{
+			name:        "valid weight",
+			msg:         NewMsgProposalSetRoleVoteWeight(proposer, RoleValidator, types.NewDec(3)),
+			expectedErr: nil,
+		},
+		{
+			name:        "empty proposer",
+			msg:         NewMsgProposalSetRoleVoteWeight(nil, RoleValidator, types.NewDec(3)),
+			expectedErr: ErrEmptyProposerAccAddress,
+		},
+		{
+			name:        "negative weight",
+			msg:         NewMsgProposalSetRoleVoteWeight(proposer, RoleValidator, types.NewDec(-1)),
+			expectedErr: ErrInvalidVoteWeight,
+		},
+	}
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expectedErr, test.msg.ValidateBasic())
+		})
+	}
+}
+
+func TestMsgVoteProposal_ValidateBasic(t *testing.T) {
+	voter := types.AccAddress("some addr")
+
+	splitVote := NewMsgVoteProposal(1, voter, OptionYes)
+	splitVote.Options = WeightedVoteOptions{
+		{Option: OptionYes, Weight: types.NewDecWithPrec(5, 1)},
+		{Option: OptionNo, Weight: types.NewDecWithPrec(5, 1)},
+	}
+
+	invalidSplitVote := NewMsgVoteProposal(1, voter, OptionYes)
+	invalidSplitVote.Options = WeightedVoteOptions{
+		{Option: OptionYes, Weight: types.NewDecWithPrec(5, 1)},
+	}
+
+	longReason := NewMsgVoteProposal(1, voter, OptionYes)
+	longReason.Reason = strings.Repeat("a", MaxVoteReasonLength+1)
+
+	tests := []struct {
+		name        string
+		msg         *MsgVoteProposal
+		expectedErr *errors.Error
+	}{
+		{
+			name: "valid vote",
+			msg:  NewMsgVoteProposal(1, voter, OptionYes),
+		},
+		{
+			name: "valid split vote",
+			msg:  splitVote,
+		},
+		{
+			name:        "empty voter",
+			msg:         NewMsgVoteProposal(1, nil, OptionYes),
+			expectedErr: ErrEmptyProposerAccAddress,
+		},
+		{
+			name:        "split vote weights do not add up to 1",
+			msg:         invalidSplitVote,
+			expectedErr: ErrInvalidVoteOptions,
+		},
+		{
+			name:        "reason too long",
+			msg:         longReason,
+			expectedErr: ErrVoteReasonTooLong,
+		},
+	}
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			err := test.msg.ValidateBasic()
+			if test.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.True(t, test.expectedErr.Is(err))
+			}
+		})
+	}
+}
+
+func TestMsgProposalMultiContent_ValidateBasic(t *testing.T) {
+	proposer := types.AccAddress("some addr")
+
+	multi, err := NewMultiContentProposal([]Content{NewSetRoleVoteWeightProposal(RoleValidator, types.NewDec(2))})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		contents    []Content
+		proposer    types.AccAddress
+		expectedErr *errors.Error
+	}{
+		{
+			name: "valid contents",
+			contents: []Content{
+				NewSetRoleVoteWeightProposal(RoleValidator, types.NewDec(2)),
+				NewAssignPermissionProposal(proposer, PermClaimValidator),
+			},
+			proposer: proposer,
+		},
+		{
+			name:        "empty proposer",
+			contents:    []Content{NewSetRoleVoteWeightProposal(RoleValidator, types.NewDec(2))},
+			expectedErr: ErrEmptyProposerAccAddress,
+		},
+		{
+			name:        "no content",
+			proposer:    proposer,
+			expectedErr: ErrInvalidMultiContent,
+		},
+		{
+			name:        "nested multi content",
+			contents:    []Content{multi},
+			proposer:    proposer,
+			expectedErr: ErrInvalidMultiContent,
+		},
+		{
+			name:        "invalid network property value",
+			contents:    []Content{NewSetNetworkPropertyProposal(ValidatorPowerMode, 10)},
+			proposer:    proposer,
+			expectedErr: ErrInvalidNetworkPropertyValue,
+		},
+		{
+			name:        "negative role vote weight",
+			contents:    []Content{NewSetRoleVoteWeightProposal(RoleValidator, types.NewDec(-1))},
+			proposer:    proposer,
+			expectedErr: ErrInvalidVoteWeight,
+		},
+	}
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			msg, err := NewMsgProposalMultiContent(test.proposer, test.contents)
+			require.NoError(t, err)
+
+			err = msg.ValidateBasic()
+			if test.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.True(t, test.expectedErr.Is(err))
+			}
+		})
+	}
+}
+
+func TestMsgProposalSoftwareUpgrade_ValidateBasic(t *testing.T) {
+	proposer := types.AccAddress("some addr")
+
+	tests := []struct {
+		name        string
+		plan        upgradetypes.Plan
+		proposer    types.AccAddress
+		expectedErr *errors.Error
+	}{
+		{
+			name:     "valid plan",
+			plan:     upgradetypes.Plan{Name: "v0.2.0", Height: 100, Info: "some info"},
+			proposer: proposer,
+		},
+		{
+			name:        "empty proposer",
+			plan:        upgradetypes.Plan{Name: "v0.2.0", Height: 100},
+			expectedErr: ErrEmptyProposerAccAddress,
+		},
+		{
+			name:        "empty name",
+			plan:        upgradetypes.Plan{Height: 100},
+			proposer:    proposer,
+			expectedErr: ErrInvalidUpgradePlan,
+		},
+		{
+			name:        "time based plan",
+			plan:        upgradetypes.Plan{Name: "v0.2.0", Time: time.Unix(1600000000, 0).UTC()},
+			proposer:    proposer,
+			expectedErr: ErrInvalidUpgradePlan,
+		},
+	}
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			err := NewMsgProposalSoftwareUpgrade(test.proposer, test.plan).ValidateBasic()
+			if test.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.True(t, test.expectedErr.Is(err))
+			}
+		})
+	}
+}
+
+func TestMsgDelegatePermission_ValidateBasic(t *testing.T) {
+	delegator := types.AccAddress("delegator")
+	delegatee := types.AccAddress("delegatee")
+
+	tests := []struct {
+		name        string
+		delegator   types.AccAddress
+		delegatee   types.AccAddress
+		permission  PermValue
+		expectedErr *errors.Error
+	}{
+		{
+			name:       "valid delegation",
+			delegator:  delegator,
+			delegatee:  delegatee,
+			permission: PermUpsertTokenRate,
+		},
+		{
+			name:        "empty delegatee",
+			delegator:   delegator,
+			permission:  PermUpsertTokenRate,
+			expectedErr: ErrEmptyPermissionsAccAddress,
+		},
+		{
+			name:        "delegation to self",
+			delegator:   delegator,
+			delegatee:   delegator,
+			permission:  PermUpsertTokenRate,
+			expectedErr: errors.ErrInvalidAddress,
+		},
+		{
+			name:        "vote permission",
+			delegator:   delegator,
+			delegatee:   delegatee,
+			permission:  PermVoteSetNetworkPropertyProposal,
+			expectedErr: ErrPermissionNotDelegable,
+		},
+	}
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			err := NewMsgDelegatePermission(test.delegator, test.delegatee, uint32(test.permission), 0, nil).ValidateBasic()
+			if test.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.True(t, test.expectedErr.Is(err))
+			}
+		})
+	}
+}
+
+func TestMsgGrantAuthorization_ValidateBasic(t *testing.T) {
+	granter := types.AccAddress("granter_____________")
+	grantee := types.AccAddress("grantee_____________")
+	sendTypeURL := MsgTypeURL(&banktypes.MsgSend{})
+
+	tests := []struct {
+		name        string
+		msg         *MsgGrantAuthorization
+		expectedErr *errors.Error
+	}{
+		{
+			name: "valid authorization",
+			msg:  NewMsgGrantAuthorization(granter, grantee, MsgTypeURL(&MsgVoteProposal{}), nil, 0, 0, nil),
+		},
+		{
+			name: "valid spend limit",
+			msg:  NewMsgGrantAuthorization(granter, grantee, sendTypeURL, types.NewCoins(types.NewInt64Coin("ukex", 10)), 0, 0, nil),
+		},
+		{
+			name:        "empty grantee",
+			msg:         NewMsgGrantAuthorization(granter, nil, sendTypeURL, nil, 0, 0, nil),
+			expectedErr: errors.ErrInvalidAddress,
+		},
+		{
+			name:        "grant to self",
+			msg:         NewMsgGrantAuthorization(granter, granter, sendTypeURL, nil, 0, 0, nil),
+			expectedErr: errors.ErrInvalidAddress,
+		},
+		{
+			name:        "invalid type url",
+			msg:         NewMsgGrantAuthorization(granter, grantee, "kira.gov.MsgVoteProposal", nil, 0, 0, nil),
+			expectedErr: ErrInvalidAuthorization,
+		},
+		{
+			name:        "spend limit on another message",
+			msg:         NewMsgGrantAuthorization(granter, grantee, MsgTypeURL(&MsgVoteProposal{}), types.NewCoins(types.NewInt64Coin("ukex", 10)), 0, 0, nil),
+			expectedErr: ErrInvalidAuthorization,
+		},
+	}
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			err := test.msg.ValidateBasic()
+			if test.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.True(t, test.expectedErr.Is(err))
+			}
+		})
+	}
+}
+
+func TestMsgExec_ValidateBasic(t *testing.T) {
+	granter := types.AccAddress("granter_____________")
+	grantee := types.AccAddress("grantee_____________")
+
+	tests := []struct {
+		name        string
+		msgs        []types.Msg
+		expectedErr *errors.Error
+	}{
+		{
+			name: "valid messages",
+			msgs: []types.Msg{
+				NewMsgVoteProposal(1, granter, OptionYes),
+				banktypes.NewMsgSend(granter, grantee, types.NewCoins(types.NewInt64Coin("ukex", 10))),
+			},
+		},
+		{
+			name:        "no message",
+			expectedErr: ErrInvalidExecMsg,
+		},
+		{
+			name: "invalid message",
+			msgs: []types.Msg{
+				NewMsgVoteProposal(1, nil, OptionYes),
+			},
+			expectedErr: ErrEmptyProposerAccAddress,
+		},
+	}
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			msg, err := NewMsgExec(grantee, test.msgs)
+			require.NoError(t, err)
+
+			err = msg.ValidateBasic()
+			if test.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.True(t, test.expectedErr.Is(err))
+			}
+		})
+	}
+}
+
+func TestMsgCreateGroup_ValidateBasic(t *testing.T) {
+	member1 := types.AccAddress("member1_____________")
+	member2 := types.AccAddress("member2_____________")
+
+	tests := []struct {
+		name        string
+		members     []GroupMember
+		threshold   uint64
+		expectedErr *errors.Error
+	}{
+		{
+			name:      "valid group",
+			members:   []GroupMember{NewGroupMember(member1, 1), NewGroupMember(member2, 2)},
+			threshold: 3,
+		},
+		{
+			name:        "no member",
+			threshold:   1,
+			expectedErr: ErrInvalidGroup,
+		},
+		{
+			name:        "duplicate member",
+			members:     []GroupMember{NewGroupMember(member1, 1), NewGroupMember(member1, 1)},
+			threshold:   1,
+			expectedErr: ErrInvalidGroup,
+		},
+		{
+			name:        "member without weight",
+			members:     []GroupMember{NewGroupMember(member1, 1), NewGroupMember(member2, 0)},
+			threshold:   1,
+			expectedErr: ErrInvalidGroup,
+		},
+		{
+			name:        "threshold above the total weight",
+			members:     []GroupMember{NewGroupMember(member1, 1), NewGroupMember(member2, 2)},
+			threshold:   4,
+			expectedErr: ErrInvalidGroup,
+		},
+		{
+			name:        "zero threshold",
+			members:     []GroupMember{NewGroupMember(member1, 1)},
+			expectedErr: ErrInvalidGroup,
+		},
+	}
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			err := NewMsgCreateGroup(member1, test.members, test.threshold, "").ValidateBasic()
+			if test.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.True(t, test.expectedErr.Is(err))
+			}
+		})
+	}
+}
+
+func TestMsgSubmitGroupProposal_ValidateBasic(t *testing.T) {
+	proposer := types.AccAddress("proposer____________")
+	group := GroupAddress(1)
+
+	tests := []struct {
+		name        string
+		msgs        []types.Msg
+		expectedErr *errors.Error
+	}{
+		{
+			name: "valid messages",
+			msgs: []types.Msg{
+				NewMsgVoteProposal(1, group, OptionYes),
+				banktypes.NewMsgSend(group, proposer, types.NewCoins(types.NewInt64Coin("ukex", 10))),
+			},
+		},
+		{
+			name:        "no message",
+			expectedErr: ErrInvalidGroupProposal,
+		},
+		{
+			name: "message signed by another address",
+			msgs: []types.Msg{
+				NewMsgVoteProposal(1, proposer, OptionYes),
+			},
+			expectedErr: ErrInvalidGroupProposal,
+		},
+		{
+			name: "message signed by another group",
+			msgs: []types.Msg{
+				NewMsgVoteProposal(1, GroupAddress(2), OptionYes),
+			},
+			expectedErr: ErrInvalidGroupProposal,
+		},
+		{
+			name: "invalid message",
+			msgs: []types.Msg{
+				NewMsgVoteProposal(1, nil, OptionYes),
+			},
+			expectedErr: ErrEmptyProposerAccAddress,
+		},
+	}
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			msg, err := NewMsgSubmitGroupProposal(proposer, 1, test.msgs)
+			require.NoError(t, err)
+
+			err = msg.ValidateBasic()
+			if test.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.True(t, test.expectedErr.Is(err))
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package types
+
+import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+// Validator power modes, selected through the VALIDATOR_POWER_MODE network property.
+const (
+	// PowerModeFlat gives every validator in the set the same consensus power.
+	PowerModeFlat uint64 = iota
+	// PowerModeRank derives the consensus power of a validator from the logarithm of its rank.
+	PowerModeRank
+	// PowerModeStreak derives the consensus power of a validator from the logarithm of its streak.
+	PowerModeStreak
+	// PowerModeBondedTokens derives the consensus power of a validator from the tokens bonded to it.
+	PowerModeBondedTokens
+)
+
+// IsValidPowerMode returns if the value is a known validator power mode.
+func IsValidPowerMode(mode uint64) bool {
+	return mode <= PowerModeBondedTokens
+}
+
+// Vote weight modes, selected through the VOTE_WEIGHT_MODE network property.
+const (
+	// VoteWeightModeActor gives every actor the same vote weight.
+	VoteWeightModeActor uint64 = iota
+	// VoteWeightModeRole weights the vote of an actor by the highest vote weight of its roles.
+	VoteWeightModeRole
+	// VoteWeightModeCouncilor weights the vote of a councilor by the COUNCILOR_VOTE_WEIGHT network property.
+	VoteWeightModeCouncilor
+	// VoteWeightModeBonded weights the vote of an actor by its bonded tokens.
+	VoteWeightModeBonded
+)
+
+// IsValidVoteWeightMode returns if the value is a known vote weight mode.
+func IsValidVoteWeightMode(mode uint64) bool {
+	return mode <= VoteWeightModeBonded
+}
+
+// Validate checks the network properties are consistent with each other.
+func (np NetworkProperties) Validate() error {
+	if np.MinTxFee > np.MaxTxFee {
+		return sdkerrors.Wrapf(ErrInvalidNetworkPropertyValue, "min tx fee %d is greater than max tx fee %d", np.MinTxFee, np.MaxTxFee)
+	}
+
+	if np.JailMaxTime != 0 && np.MinJailTime >= np.JailMaxTime {
+		return sdkerrors.Wrapf(ErrInvalidNetworkPropertyValue, "min jail time %d is not lower than jail max time %d", np.MinJailTime, np.JailMaxTime)
+	}
+
+	if np.MaxValidators != 0 && np.MaxValidators < np.MinValidators {
+		return sdkerrors.Wrapf(ErrInvalidNetworkPropertyValue, "max validators %d is lower than min validators %d", np.MaxValidators, np.MinValidators)
+	}
+
+	if np.UptimeEpochLength != 0 && np.UptimeEpochRetention == 0 {
+		return sdkerrors.Wrapf(ErrInvalidNetworkPropertyValue, "uptime epoch retention can not be 0 while the uptime epoch length is %d", np.UptimeEpochLength)
+	}
+
+	if np.VoteQuorum > 100 {
+		return sdkerrors.Wrapf(ErrInvalidNetworkPropertyValue, "vote quorum %d is greater than 100", np.VoteQuorum)
+	}
+
+	if np.InactiveRankDecreasePercent > 100 {
+		return sdkerrors.Wrapf(ErrInvalidNetworkPropertyValue, "inactive rank decrease percent %d is greater than 100", np.InactiveRankDecreasePercent)
+	}
+
+	if np.MaxValidatorPowerPercent > 100 {
+		return sdkerrors.Wrapf(ErrInvalidNetworkPropertyValue, "max validator power percent %d is greater than 100", np.MaxValidatorPowerPercent)
+	}
+
+	if np.MaxCommissionChange > 100 {
+		return sdkerrors.Wrapf(ErrInvalidNetworkPropertyValue, "max commission change %d is greater than 100", np.MaxCommissionChange)
+	}
+
+	if !IsValidPowerMode(np.ValidatorPowerMode) {
+		return sdkerrors.Wrapf(ErrInvalidNetworkPropertyValue, "unknown validator power mode %d", np.ValidatorPowerMode)
+	}
+
+	if !IsValidVoteWeightMode(np.VoteWeightMode) {
+		return sdkerrors.Wrapf(ErrInvalidNetworkPropertyValue, "unknown vote weight mode %d", np.VoteWeightMode)
+	}
+
+	proposalTypes := make(map[string]bool)
+	for _, deposit := range np.MinProposalDeposits {
+		if proposalTypes[deposit.ProposalType] {
+			return sdkerrors.Wrapf(ErrInvalidNetworkPropertyValue, "duplicate min proposal deposit for %s", deposit.ProposalType)
+		}
+		proposalTypes[deposit.ProposalType] = true
+	}
+
+	return nil
+}
@@ -40,6 +40,22 @@ const (
 	JailMaxTime                 NetworkProperty = 10
 	EnableTokenWhitelist        NetworkProperty = 11
 	EnableTokenBlacklist        NetworkProperty = 12
+	ValidatorPowerMode          NetworkProperty = 13
+	MaxValidatorPowerPercent    NetworkProperty = 14
+	UnbondingTime               NetworkProperty = 15
+	VoteWeightMode              NetworkProperty = 16
+	CouncilorVoteWeight         NetworkProperty = 17
+	MinProposalDeposit          NetworkProperty = 18
+	MaxCouncilorMissedProposals NetworkProperty = 19
+	FeeLedgerWindow             NetworkProperty = 20
+	MinJailTime                 NetworkProperty = 21
+	CommissionChangeInterval    NetworkProperty = 22
+	MaxCommissionChange         NetworkProperty = 23
+	ExitNoticePeriod            NetworkProperty = 24
+	MaxValidators               NetworkProperty = 25
+	UptimeEpochLength           NetworkProperty = 26
+	UptimeEpochRetention        NetworkProperty = 27
+	CouncilorTerm               NetworkProperty = 28
 )
 
 var NetworkProperty_name = map[int32]string{
@@ -56,6 +72,22 @@ var NetworkProperty_name = map[int32]string{
 	10: "JAIL_MAX_TIME",
 	11: "ENABLE_TOKEN_WHITELIST",
 	12: "ENABLE_TOKEN_BLACKLIST",
+	13: "VALIDATOR_POWER_MODE",
+	14: "MAX_VALIDATOR_POWER_PERCENT",
+	15: "UNBONDING_TIME",
+	16: "VOTE_WEIGHT_MODE",
+	17: "COUNCILOR_VOTE_WEIGHT",
+	18: "MIN_PROPOSAL_DEPOSIT",
+	19: "MAX_COUNCILOR_MISSED_PROPOSALS",
+	20: "FEE_LEDGER_WINDOW",
+	21: "MIN_JAIL_TIME",
+	22: "COMMISSION_CHANGE_INTERVAL",
+	23: "MAX_COMMISSION_CHANGE",
+	24: "EXIT_NOTICE_PERIOD",
+	25: "MAX_VALIDATORS",
+	26: "UPTIME_EPOCH_LENGTH",
+	27: "UPTIME_EPOCH_RETENTION",
+	28: "COUNCILOR_TERM",
 }
 
 var NetworkProperty_value = map[string]int32{
@@ -72,6 +104,22 @@ var NetworkProperty_value = map[string]int32{
 	"JAIL_MAX_TIME":                  10,
 	"ENABLE_TOKEN_WHITELIST":         11,
 	"ENABLE_TOKEN_BLACKLIST":         12,
+	"VALIDATOR_POWER_MODE":           13,
+	"MAX_VALIDATOR_POWER_PERCENT":    14,
+	"UNBONDING_TIME":                 15,
+	"VOTE_WEIGHT_MODE":               16,
+	"COUNCILOR_VOTE_WEIGHT":          17,
+	"MIN_PROPOSAL_DEPOSIT":           18,
+	"MAX_COUNCILOR_MISSED_PROPOSALS": 19,
+	"FEE_LEDGER_WINDOW":              20,
+	"MIN_JAIL_TIME":                  21,
+	"COMMISSION_CHANGE_INTERVAL":     22,
+	"MAX_COMMISSION_CHANGE":          23,
+	"EXIT_NOTICE_PERIOD":             24,
+	"MAX_VALIDATORS":                 25,
+	"UPTIME_EPOCH_LENGTH":            26,
+	"UPTIME_EPOCH_RETENTION":         27,
+	"COUNCILOR_TERM":                 28,
 }
 
 func (x NetworkProperty) String() string {
@@ -154,6 +202,43 @@ type NetworkProperties struct {
 	JailMaxTime                 uint64 `protobuf:"varint,11,opt,name=jail_max_time,json=jailMaxTime,proto3" json:"jail_max_time,omitempty"`
 	EnableTokenWhitelist        bool   `protobuf:"varint,12,opt,name=enable_token_whitelist,json=enableTokenWhitelist,proto3" json:"enable_token_whitelist,omitempty"`
 	EnableTokenBlacklist        bool   `protobuf:"varint,13,opt,name=enable_token_blacklist,json=enableTokenBlacklist,proto3" json:"enable_token_blacklist,omitempty"`
+	// The validator power mode defines how the consensus power of each validator is derived.
+	// 0 - every validator has the same power (1), 1 - power is derived from the validator rank,
+	// 2 - power is derived from the validator streak, 3 - power is derived from the bonded tokens.
+	ValidatorPowerMode uint64 `protobuf:"varint,14,opt,name=validator_power_mode,json=validatorPowerMode,proto3" json:"validator_power_mode,omitempty"`
+	// Maximum share of the total consensus power a single validator can hold, in percent (0 means no cap).
+	MaxValidatorPowerPercent uint64 `protobuf:"varint,15,opt,name=max_validator_power_percent,json=maxValidatorPowerPercent,proto3" json:"max_validator_power_percent,omitempty"`
+	UnbondingTime            uint64 `protobuf:"varint,16,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
+	// The vote weight mode defines how the votes on proposals are weighted when tallying.
+	// 0 - every actor has the same weight (1), 1 - weight is the highest vote weight of the actor roles,
+	// 2 - councilors weight COUNCILOR_VOTE_WEIGHT and other actors 1, 3 - weight is the bonded ukex of the actor.
+	VoteWeightMode      uint64 `protobuf:"varint,17,opt,name=vote_weight_mode,json=voteWeightMode,proto3" json:"vote_weight_mode,omitempty"`
+	CouncilorVoteWeight uint64 `protobuf:"varint,18,opt,name=councilor_vote_weight,json=councilorVoteWeight,proto3" json:"councilor_vote_weight,omitempty"`
+	// Deposit in ukex escrowed from the proposer when a proposal is submitted (0 means no deposit),
+	// the deposit of a proposal type listed in min_proposal_deposits overrides it.
+	MinProposalDeposit  uint64                `protobuf:"varint,19,opt,name=min_proposal_deposit,json=minProposalDeposit,proto3" json:"min_proposal_deposit,omitempty"`
+	MinProposalDeposits []ProposalTypeDeposit `protobuf:"bytes,20,rep,name=min_proposal_deposits,json=minProposalDeposits,proto3" json:"min_proposal_deposits"`
+	// Consecutive proposals an active councilor can miss voting on before being suspended (0 means never suspended).
+	MaxCouncilorMissedProposals uint64 `protobuf:"varint,21,opt,name=max_councilor_missed_proposals,json=maxCouncilorMissedProposals,proto3" json:"max_councilor_missed_proposals,omitempty"`
+	// Blocks the fees paid by an account are kept in the fee ledger to be refunded (0 means never pruned).
+	FeeLedgerWindow uint64 `protobuf:"varint,22,opt,name=fee_ledger_window,json=feeLedgerWindow,proto3" json:"fee_ledger_window,omitempty"`
+	// Minutes a jailed validator has to wait before unjailing itself with MsgUnjail, it is permanently removed once jailed for JAIL_MAX_TIME.
+	MinJailTime uint64 `protobuf:"varint,23,opt,name=min_jail_time,json=minJailTime,proto3" json:"min_jail_time,omitempty"`
+	// Seconds a validator has to wait between two changes of its commission (0 means no limit).
+	CommissionChangeInterval uint64 `protobuf:"varint,24,opt,name=commission_change_interval,json=commissionChangeInterval,proto3" json:"commission_change_interval,omitempty"`
+	// Percentage points the commission of a validator can change by at once (0 means no limit).
+	MaxCommissionChange uint64 `protobuf:"varint,25,opt,name=max_commission_change,json=maxCommissionChange,proto3" json:"max_commission_change,omitempty"`
+	// Seconds between the exit request of a validator and its removal from the validator set.
+	ExitNoticePeriod uint64 `protobuf:"varint,26,opt,name=exit_notice_period,json=exitNoticePeriod,proto3" json:"exit_notice_period,omitempty"`
+	// Maximum number of validators in the consensus set, the other active validators wait in the standby
+	// queue (0 means no limit).
+	MaxValidators uint64 `protobuf:"varint,27,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty"`
+	// Blocks in an epoch of the validator uptime and rank history (0 disables the history).
+	UptimeEpochLength uint64 `protobuf:"varint,28,opt,name=uptime_epoch_length,json=uptimeEpochLength,proto3" json:"uptime_epoch_length,omitempty"`
+	// Number of finished epochs kept in the validator uptime and rank history.
+	UptimeEpochRetention uint64 `protobuf:"varint,29,opt,name=uptime_epoch_retention,json=uptimeEpochRetention,proto3" json:"uptime_epoch_retention,omitempty"`
+	// Seconds a councilor term lasts, the councilor has to claim its seat again once it ends (0 means no term end).
+	CouncilorTerm uint64 `protobuf:"varint,30,opt,name=councilor_term,json=councilorTerm,proto3" json:"councilor_term,omitempty"`
 }
 
 func (m *NetworkProperties) Reset()         { *m = NetworkProperties{} }
@@ -280,73 +365,289 @@ func (m *NetworkProperties) GetEnableTokenBlacklist() bool {
 	return false
 }
 
+func (m *NetworkProperties) GetValidatorPowerMode() uint64 {
+	if m != nil {
+		return m.ValidatorPowerMode
+	}
+	return 0
+}
+
+func (m *NetworkProperties) GetMaxValidatorPowerPercent() uint64 {
+	if m != nil {
+		return m.MaxValidatorPowerPercent
+	}
+	return 0
+}
+
+func (m *NetworkProperties) GetUnbondingTime() uint64 {
+	if m != nil {
+		return m.UnbondingTime
+	}
+	return 0
+}
+
+func (m *NetworkProperties) GetVoteWeightMode() uint64 {
+	if m != nil {
+		return m.VoteWeightMode
+	}
+	return 0
+}
+
+func (m *NetworkProperties) GetCouncilorVoteWeight() uint64 {
+	if m != nil {
+		return m.CouncilorVoteWeight
+	}
+	return 0
+}
+
+func (m *NetworkProperties) GetMinProposalDeposit() uint64 {
+	if m != nil {
+		return m.MinProposalDeposit
+	}
+	return 0
+}
+
+func (m *NetworkProperties) GetMinProposalDeposits() []ProposalTypeDeposit {
+	if m != nil {
+		return m.MinProposalDeposits
+	}
+	return nil
+}
+
+func (m *NetworkProperties) GetMaxCouncilorMissedProposals() uint64 {
+	if m != nil {
+		return m.MaxCouncilorMissedProposals
+	}
+	return 0
+}
+
+func (m *NetworkProperties) GetFeeLedgerWindow() uint64 {
+	if m != nil {
+		return m.FeeLedgerWindow
+	}
+	return 0
+}
+
+func (m *NetworkProperties) GetMinJailTime() uint64 {
+	if m != nil {
+		return m.MinJailTime
+	}
+	return 0
+}
+
+func (m *NetworkProperties) GetCommissionChangeInterval() uint64 {
+	if m != nil {
+		return m.CommissionChangeInterval
+	}
+	return 0
+}
+
+func (m *NetworkProperties) GetMaxCommissionChange() uint64 {
+	if m != nil {
+		return m.MaxCommissionChange
+	}
+	return 0
+}
+
+func (m *NetworkProperties) GetExitNoticePeriod() uint64 {
+	if m != nil {
+		return m.ExitNoticePeriod
+	}
+	return 0
+}
+
+func (m *NetworkProperties) GetMaxValidators() uint64 {
+	if m != nil {
+		return m.MaxValidators
+	}
+	return 0
+}
+
+func (m *NetworkProperties) GetUptimeEpochLength() uint64 {
+	if m != nil {
+		return m.UptimeEpochLength
+	}
+	return 0
+}
+
+func (m *NetworkProperties) GetUptimeEpochRetention() uint64 {
+	if m != nil {
+		return m.UptimeEpochRetention
+	}
+	return 0
+}
+
+func (m *NetworkProperties) GetCouncilorTerm() uint64 {
+	if m != nil {
+		return m.CouncilorTerm
+	}
+	return 0
+}
+
+// ProposalTypeDeposit is the deposit in ukex required to submit a proposal of a given type.
+type ProposalTypeDeposit struct {
+	ProposalType string `protobuf:"bytes,1,opt,name=proposal_type,json=proposalType,proto3" json:"proposal_type,omitempty"`
+	Amount       uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *ProposalTypeDeposit) Reset()         { *m = ProposalTypeDeposit{} }
+func (m *ProposalTypeDeposit) String() string { return proto.CompactTextString(m) }
+func (*ProposalTypeDeposit) ProtoMessage()    {}
+func (*ProposalTypeDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afa35a4ab1e9e2c2, []int{2}
+}
+func (m *ProposalTypeDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalTypeDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalTypeDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalTypeDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalTypeDeposit.Merge(m, src)
+}
+func (m *ProposalTypeDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalTypeDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalTypeDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalTypeDeposit proto.InternalMessageInfo
+
+func (m *ProposalTypeDeposit) GetProposalType() string {
+	if m != nil {
+		return m.ProposalType
+	}
+	return ""
+}
+
+func (m *ProposalTypeDeposit) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("kira.gov.NetworkProperty", NetworkProperty_name, NetworkProperty_value)
 	proto.RegisterType((*MsgSetNetworkProperties)(nil), "kira.gov.MsgSetNetworkProperties")
 	proto.RegisterType((*NetworkProperties)(nil), "kira.gov.NetworkProperties")
+	proto.RegisterType((*ProposalTypeDeposit)(nil), "kira.gov.ProposalTypeDeposit")
 }
 
 func init() { proto.RegisterFile("network_properties.proto", fileDescriptor_afa35a4ab1e9e2c2) }
 
 var fileDescriptor_afa35a4ab1e9e2c2 = []byte{
-	// 901 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0x4d, 0x6f, 0xe2, 0x46,
-	0x18, 0x86, 0x6c, 0x92, 0x65, 0x87, 0x90, 0x10, 0x9a, 0xcd, 0xba, 0xce, 0x8a, 0x58, 0x91, 0x22,
-	0x45, 0x2b, 0x2d, 0xa8, 0x1f, 0xea, 0x61, 0xa5, 0xaa, 0x35, 0x30, 0x74, 0x1d, 0xf0, 0xc7, 0x1a,
-	0x87, 0x6c, 0x7b, 0x19, 0x0d, 0x66, 0x96, 0xb8, 0xe0, 0x19, 0x6a, 0x3b, 0x2c, 0xf9, 0x07, 0x95,
-	0x4f, 0x7b, 0xeb, 0xc9, 0xa7, 0xfe, 0x85, 0xfe, 0x88, 0x1e, 0xf7, 0xd8, 0x53, 0x55, 0x25, 0xff,
-	0xa2, 0xa7, 0x6a, 0xc6, 0x40, 0x61, 0xd9, 0xcd, 0xc9, 0xd6, 0xfb, 0x7c, 0xcc, 0xeb, 0xf7, 0x7d,
-	0x34, 0x06, 0x12, 0x25, 0xd1, 0x5b, 0x16, 0x0c, 0xd1, 0x38, 0x60, 0x63, 0x12, 0x44, 0x1e, 0x09,
-	0x2b, 0xe3, 0x80, 0x45, 0xac, 0x94, 0x1b, 0x7a, 0x01, 0xae, 0x0c, 0xd8, 0x44, 0x3e, 0x18, 0xb0,
-	0x01, 0x13, 0xc5, 0x2a, 0x7f, 0x4b, 0xf1, 0x93, 0x3f, 0xb2, 0xe0, 0x89, 0x1e, 0x0e, 0x3a, 0x24,
-	0x32, 0x52, 0x0b, 0x6b, 0xe1, 0x50, 0x3a, 0x07, 0xa5, 0x75, 0x5f, 0x29, 0xab, 0x64, 0xcf, 0xf2,
-	0x5f, 0x1e, 0x55, 0xe6, 0xc6, 0x95, 0x35, 0xa1, 0xbd, 0x4f, 0xd7, 0xbc, 0x74, 0x90, 0xe3, 0x1e,
-	0x2c, 0x24, 0x81, 0xb4, 0xa1, 0x64, 0xcf, 0x76, 0x6a, 0x5f, 0xfc, 0xfb, 0xf7, 0xf1, 0xf3, 0x81,
-	0x17, 0x5d, 0x5d, 0xf7, 0x2a, 0x2e, 0xf3, 0xab, 0x2e, 0x0b, 0x7d, 0x16, 0xce, 0x1e, 0xcf, 0xc3,
-	0xfe, 0xb0, 0x1a, 0xdd, 0x8c, 0x49, 0x58, 0x51, 0x5d, 0x57, 0xed, 0xf7, 0x03, 0x12, 0x86, 0xf6,
-	0xc2, 0xe2, 0xe4, 0xdd, 0x16, 0xd8, 0x5f, 0x6f, 0xf8, 0x29, 0x00, 0xbe, 0x47, 0x51, 0x34, 0x45,
-	0x6f, 0x08, 0x11, 0x8d, 0x6e, 0xda, 0x39, 0xdf, 0xa3, 0xce, 0xb4, 0x49, 0x88, 0x40, 0xf1, 0x74,
-	0x8e, 0x6e, 0xcc, 0x50, 0x3c, 0x4d, 0xd1, 0x63, 0x90, 0x9f, 0xb0, 0x88, 0xa0, 0x5f, 0xae, 0x59,
-	0x70, 0xed, 0x4b, 0x0f, 0x04, 0x0c, 0x78, 0xe9, 0x95, 0xa8, 0x94, 0x9e, 0x81, 0xfd, 0xf4, 0x78,
-	0x3c, 0x42, 0x84, 0xf6, 0x51, 0xe4, 0xf9, 0x44, 0xda, 0x14, 0xb4, 0xbd, 0x39, 0x00, 0x69, 0xdf,
-	0xf1, 0x7c, 0x52, 0xfa, 0x06, 0x3c, 0x59, 0xe2, 0x62, 0x37, 0xf2, 0x09, 0x8d, 0x52, 0xc5, 0x96,
-	0x50, 0x3c, 0xfe, 0x5f, 0x31, 0x43, 0x85, 0xee, 0x5b, 0x70, 0x44, 0x28, 0xee, 0x8d, 0x08, 0x7a,
-	0xc3, 0x02, 0xe2, 0x0d, 0x28, 0x6f, 0x15, 0x8d, 0xf1, 0x0d, 0x67, 0x84, 0xd2, 0xb6, 0x92, 0x3d,
-	0xcb, 0xd9, 0x52, 0x4a, 0x69, 0xa6, 0x8c, 0x26, 0x21, 0xd6, 0x0c, 0x2f, 0xd5, 0x41, 0xd9, 0xf7,
-	0x42, 0xf7, 0x0a, 0x53, 0x97, 0xa0, 0x00, 0xd3, 0x21, 0xea, 0x13, 0x37, 0x20, 0x38, 0x24, 0x08,
-	0xfb, 0xec, 0x9a, 0x46, 0xd2, 0x43, 0x71, 0xfa, 0xd1, 0x82, 0x65, 0x63, 0x3a, 0x6c, 0xcc, 0x38,
-	0xaa, 0xa0, 0x70, 0x13, 0x8f, 0x37, 0xe5, 0x4d, 0x3e, 0xf4, 0x18, 0x93, 0xc0, 0x25, 0x34, 0x92,
-	0x72, 0xa9, 0xc9, 0x9c, 0xb5, 0xec, 0x61, 0xa5, 0x94, 0xd2, 0x29, 0xd8, 0xe5, 0x9b, 0x98, 0xe0,
-	0x91, 0xd7, 0xc7, 0x11, 0x0b, 0x42, 0xe9, 0x91, 0x10, 0x15, 0x7c, 0x8f, 0x76, 0x17, 0xc5, 0xd2,
-	0x0b, 0x20, 0x8f, 0x19, 0x0b, 0xd0, 0x3c, 0x66, 0x7c, 0x3f, 0x3d, 0x7e, 0x66, 0x48, 0x68, 0x5f,
-	0x02, 0x42, 0x72, 0xc8, 0x19, 0xb3, 0x5d, 0xeb, 0x78, 0x5a, 0xc3, 0x74, 0xd8, 0x21, 0xb4, 0x5f,
-	0x3a, 0x01, 0x85, 0x9f, 0xb1, 0x37, 0x12, 0x1a, 0x31, 0xd9, 0xbc, 0xa0, 0xe7, 0x79, 0x51, 0xc7,
-	0x53, 0x31, 0xcf, 0xaf, 0xc1, 0xe1, 0x6c, 0x9e, 0x11, 0x1b, 0x12, 0x8a, 0xde, 0x5e, 0x79, 0x11,
-	0x19, 0x79, 0x61, 0x24, 0xed, 0x88, 0x51, 0x1e, 0xa4, 0xa8, 0xc3, 0xc1, 0xcb, 0x39, 0xb6, 0xa6,
-	0xea, 0x8d, 0xb0, 0x3b, 0x14, 0xaa, 0xc2, 0x9a, 0xaa, 0x36, 0xc7, 0x9e, 0xfd, 0xb6, 0x05, 0xf6,
-	0x56, 0x23, 0x79, 0xc3, 0x23, 0xa7, 0x6b, 0x06, 0x72, 0x5e, 0xa3, 0x26, 0x84, 0xc5, 0x8c, 0xbc,
-	0x13, 0x27, 0x4a, 0x4e, 0x5f, 0x0a, 0xa4, 0xae, 0xbe, 0x9e, 0xa3, 0xd9, 0x19, 0xba, 0x14, 0xc8,
-	0xae, 0xe9, 0x40, 0xf4, 0xea, 0xc2, 0xb4, 0x2f, 0xf4, 0xe2, 0x86, 0xbc, 0x1b, 0x27, 0x0a, 0xe8,
-	0xae, 0x04, 0xd2, 0xb2, 0x4d, 0xcb, 0xec, 0xa8, 0x6d, 0x04, 0x8d, 0x06, 0x72, 0x34, 0x1d, 0x16,
-	0x1f, 0xc8, 0x9f, 0xc5, 0x89, 0xb2, 0x67, 0xad, 0x07, 0x72, 0x89, 0xab, 0xd6, 0x1d, 0x1d, 0x1a,
-	0x4e, 0xaa, 0xd8, 0x94, 0x3f, 0x8f, 0x13, 0xe5, 0xb1, 0xf5, 0xd1, 0x40, 0x7e, 0x0f, 0xca, 0xd0,
-	0x50, 0x6b, 0x6d, 0x88, 0x9a, 0xa6, 0x0d, 0xb5, 0x1f, 0xe6, 0xdf, 0x82, 0x2c, 0xf5, 0x47, 0x6e,
-	0xd1, 0x29, 0x6e, 0xc9, 0x4f, 0xe3, 0x44, 0x91, 0xe0, 0x3d, 0x99, 0xd4, 0xb5, 0x4e, 0xfd, 0xa5,
-	0x6a, 0xd4, 0x21, 0xb2, 0x55, 0xa3, 0x85, 0x1a, 0xb0, 0x6e, 0x43, 0xb5, 0x03, 0x91, 0xaa, 0x9b,
-	0x17, 0x86, 0x53, 0xdc, 0x96, 0x8f, 0xe3, 0x44, 0x39, 0xd2, 0xef, 0xcf, 0xa4, 0xc6, 0xbb, 0xd6,
-	0xba, 0x1f, 0x7a, 0x58, 0xd0, 0xae, 0x43, 0xc3, 0x29, 0x3e, 0x4c, 0x4d, 0xb4, 0x7b, 0x32, 0xf9,
-	0x02, 0xc8, 0x96, 0x69, 0xda, 0xc8, 0x80, 0xce, 0xa5, 0x69, 0xb7, 0x10, 0x9f, 0x7d, 0x8d, 0x9b,
-	0x75, 0xa0, 0xd1, 0x28, 0xe6, 0x64, 0x39, 0x4e, 0x94, 0x43, 0xeb, 0xe3, 0x61, 0x3b, 0x05, 0xbb,
-	0x7c, 0x91, 0x5d, 0xb5, 0xad, 0x35, 0x54, 0xc7, 0xb4, 0x3b, 0xc5, 0x47, 0xf2, 0x7e, 0x9c, 0x28,
-	0x05, 0x7d, 0x25, 0xcf, 0x27, 0xa0, 0x70, 0xae, 0x6a, 0x6d, 0x61, 0x2d, 0x86, 0x0b, 0xe4, 0xbd,
-	0x38, 0x51, 0xf2, 0xe7, 0xab, 0x99, 0x9c, 0x8d, 0xd4, 0x31, 0x5b, 0xd0, 0x40, 0x97, 0x2f, 0x35,
-	0x07, 0xb6, 0xb5, 0x8e, 0x53, 0xcc, 0xcb, 0x52, 0x9c, 0x28, 0x07, 0xf0, 0x13, 0x99, 0x5c, 0x51,
-	0xd5, 0xda, 0x6a, 0xbd, 0x25, 0x54, 0x3b, 0x6b, 0xaa, 0x45, 0x26, 0xe5, 0xcd, 0x5f, 0x7f, 0x2f,
-	0x67, 0x6a, 0xdf, 0xfd, 0x79, 0x5b, 0xce, 0xbe, 0xbf, 0x2d, 0x67, 0xff, 0xb9, 0x2d, 0x67, 0xdf,
-	0xdd, 0x95, 0x33, 0xef, 0xef, 0xca, 0x99, 0xbf, 0xee, 0xca, 0x99, 0x9f, 0x4e, 0x97, 0xee, 0xdf,
-	0x96, 0x17, 0xe0, 0x3a, 0x0b, 0x48, 0x35, 0x24, 0x43, 0xec, 0x55, 0xa7, 0xd5, 0x01, 0x9b, 0xa4,
-	0x57, 0x70, 0x6f, 0x5b, 0xfc, 0x2b, 0xbe, 0xfa, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x3b, 0xe8, 0x7c,
-	0x53, 0x67, 0x06, 0x00, 0x00,
+	// 1594 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x57, 0x4d, 0x6f, 0xdb, 0xc8,
+	0x19, 0xb6, 0x12, 0x6f, 0xd6, 0x19, 0xf9, 0x83, 0xa6, 0xbf, 0x18, 0x3a, 0xab, 0x10, 0x2e, 0x02,
+	0x18, 0x8b, 0xae, 0xdd, 0xa6, 0x45, 0x0f, 0x8b, 0x16, 0x2d, 0x45, 0x8d, 0x6d, 0xc6, 0xe2, 0xc7,
+	0x52, 0x94, 0x94, 0xf6, 0x32, 0xa0, 0xc5, 0x89, 0x3c, 0x95, 0x38, 0xa3, 0x92, 0xb4, 0x2d, 0xff,
+	0x83, 0x42, 0xa7, 0xfe, 0x01, 0x9d, 0x7a, 0xe9, 0x0f, 0xe8, 0x8f, 0xd8, 0xe3, 0x1e, 0x7b, 0x5a,
+	0x14, 0xc9, 0xbf, 0xd8, 0xd3, 0x62, 0x66, 0x28, 0x59, 0xb2, 0xb4, 0x3e, 0x25, 0x9e, 0xe7, 0x7d,
+	0x5e, 0xbe, 0xdf, 0x0f, 0x04, 0x34, 0x8a, 0xf3, 0x3b, 0x96, 0xf6, 0xd0, 0x20, 0x65, 0x03, 0x9c,
+	0xe6, 0x04, 0x67, 0x27, 0x83, 0x94, 0xe5, 0x4c, 0x5d, 0xeb, 0x91, 0x34, 0x3a, 0xe9, 0xb2, 0x5b,
+	0x7d, 0xb7, 0xcb, 0xba, 0x4c, 0x3c, 0x9e, 0xf2, 0xff, 0x49, 0xfc, 0xe8, 0xbf, 0x25, 0x70, 0xe0,
+	0x64, 0xdd, 0x06, 0xce, 0x5d, 0xe9, 0xc2, 0x9f, 0x7a, 0x50, 0xdf, 0x03, 0x75, 0xd1, 0xaf, 0x56,
+	0x32, 0x4a, 0xc7, 0xe5, 0x77, 0x87, 0x27, 0x13, 0xc7, 0x27, 0x0b, 0xc4, 0x60, 0x9b, 0x2e, 0xf8,
+	0x72, 0xc0, 0x1a, 0xf7, 0xc1, 0x32, 0x9c, 0x6a, 0xcf, 0x8c, 0xd2, 0xf1, 0x7a, 0xf5, 0xb7, 0x3f,
+	0xfd, 0xf8, 0xe6, 0x9b, 0x2e, 0xc9, 0xaf, 0x6f, 0xae, 0x4e, 0x3a, 0x2c, 0x39, 0xed, 0xb0, 0x2c,
+	0x61, 0x59, 0xf1, 0xcf, 0x37, 0x59, 0xdc, 0x3b, 0xcd, 0xef, 0x07, 0x38, 0x3b, 0x31, 0x3b, 0x1d,
+	0x33, 0x8e, 0x53, 0x9c, 0x65, 0xc1, 0xd4, 0xc5, 0xd1, 0x4f, 0x65, 0xb0, 0xbd, 0x18, 0xf0, 0x6b,
+	0x00, 0x12, 0x42, 0x51, 0x3e, 0x44, 0x1f, 0x31, 0x16, 0x81, 0xae, 0x06, 0x6b, 0x09, 0xa1, 0xe1,
+	0xf0, 0x0c, 0x63, 0x81, 0x46, 0xc3, 0x09, 0xfa, 0xac, 0x40, 0xa3, 0xa1, 0x44, 0xdf, 0x80, 0xf2,
+	0x2d, 0xcb, 0x31, 0xfa, 0xc7, 0x0d, 0x4b, 0x6f, 0x12, 0xed, 0xb9, 0x80, 0x01, 0x7f, 0xfa, 0x4e,
+	0xbc, 0xa8, 0x5f, 0x83, 0x6d, 0xf9, 0xf9, 0xa8, 0x8f, 0x30, 0x8d, 0x51, 0x4e, 0x12, 0xac, 0xad,
+	0x0a, 0xb3, 0xad, 0x09, 0x00, 0x69, 0x1c, 0x92, 0x04, 0xab, 0x7f, 0x00, 0x07, 0x33, 0xb6, 0x51,
+	0x27, 0x4f, 0x30, 0xcd, 0x25, 0xe3, 0x0b, 0xc1, 0xd8, 0x7b, 0x60, 0x14, 0xa8, 0xe0, 0xfd, 0x09,
+	0x1c, 0x62, 0x1a, 0x5d, 0xf5, 0x31, 0xfa, 0xc8, 0x52, 0x4c, 0xba, 0x94, 0x87, 0x8a, 0x06, 0xd1,
+	0x3d, 0xb7, 0xc8, 0xb4, 0x17, 0x46, 0xe9, 0x78, 0x2d, 0xd0, 0xa4, 0xc9, 0x99, 0xb4, 0x38, 0xc3,
+	0xd8, 0x2f, 0x70, 0xd5, 0x02, 0x95, 0x84, 0x64, 0x9d, 0xeb, 0x88, 0x76, 0x30, 0x4a, 0x23, 0xda,
+	0x43, 0x31, 0xee, 0xa4, 0x38, 0xca, 0x30, 0x8a, 0x12, 0x76, 0x43, 0x73, 0xed, 0x4b, 0xf1, 0xf5,
+	0xc3, 0xa9, 0x55, 0x10, 0xd1, 0x5e, 0xad, 0xb0, 0x31, 0x85, 0x09, 0x77, 0x42, 0x78, 0x50, 0xe4,
+	0xf6, 0xb1, 0x8f, 0x01, 0x4e, 0x3b, 0x98, 0xe6, 0xda, 0x9a, 0x74, 0x32, 0xb1, 0x9a, 0xf5, 0xe1,
+	0x4b, 0x13, 0xf5, 0x2d, 0xd8, 0xe4, 0x9d, 0xb8, 0x8d, 0xfa, 0x24, 0x8e, 0x72, 0x96, 0x66, 0xda,
+	0x4b, 0x41, 0xda, 0x48, 0x08, 0x6d, 0x4d, 0x1f, 0xd5, 0x6f, 0x81, 0x3e, 0x60, 0x2c, 0x45, 0x93,
+	0x31, 0xe3, 0xfd, 0xb9, 0xe2, 0xdf, 0xcc, 0x30, 0x8d, 0x35, 0x20, 0x28, 0xfb, 0xdc, 0xa2, 0xe8,
+	0xb5, 0x13, 0x0d, 0xab, 0x11, 0xed, 0x35, 0x30, 0x8d, 0xd5, 0x23, 0xb0, 0xf1, 0xf7, 0x88, 0xf4,
+	0x05, 0x47, 0x54, 0xb6, 0x2c, 0xcc, 0xcb, 0xfc, 0xd1, 0x89, 0x86, 0xa2, 0x9e, 0xbf, 0x07, 0xfb,
+	0x45, 0x3d, 0x73, 0xd6, 0xc3, 0x14, 0xdd, 0x5d, 0x93, 0x1c, 0xf7, 0x49, 0x96, 0x6b, 0xeb, 0xa2,
+	0x94, 0xbb, 0x12, 0x0d, 0x39, 0xd8, 0x9e, 0x60, 0x0b, 0xac, 0xab, 0x7e, 0xd4, 0xe9, 0x09, 0xd6,
+	0xc6, 0x02, 0xab, 0x3a, 0xc1, 0xd4, 0xdf, 0x80, 0xdd, 0x69, 0xba, 0x68, 0xc0, 0xee, 0x70, 0x8a,
+	0x12, 0x16, 0x63, 0x6d, 0x53, 0x84, 0xa5, 0x4e, 0x31, 0x9f, 0x43, 0x0e, 0x8b, 0x45, 0xb7, 0x79,
+	0xf0, 0x8f, 0x59, 0x93, 0x32, 0x6f, 0x09, 0xa2, 0x96, 0x44, 0xc3, 0xd6, 0x1c, 0x77, 0xa6, 0xc6,
+	0x37, 0xf4, 0x8a, 0xd1, 0x98, 0xd0, 0xae, 0xac, 0x80, 0x22, 0x6b, 0x3c, 0x7d, 0x15, 0x35, 0x38,
+	0x06, 0x8a, 0x18, 0xec, 0x3b, 0x4c, 0xba, 0xd7, 0xb9, 0x8c, 0x69, 0x5b, 0x18, 0x6e, 0xf2, 0xf7,
+	0xb6, 0x78, 0x16, 0xf1, 0xbc, 0x03, 0x7b, 0x1d, 0x76, 0x43, 0x3b, 0xa4, 0xcf, 0x52, 0x34, 0xc3,
+	0xd1, 0x54, 0x61, 0xbe, 0x33, 0x05, 0x5b, 0x53, 0x1e, 0xcf, 0x9a, 0x37, 0x7a, 0x3a, 0xed, 0x31,
+	0x1e, 0xb0, 0x8c, 0xe4, 0xda, 0x8e, 0xcc, 0x3a, 0x21, 0xd4, 0x2f, 0xa0, 0x9a, 0x44, 0xd4, 0x36,
+	0xd8, 0x5b, 0xc6, 0xc8, 0xb4, 0x5d, 0xe3, 0xf9, 0x71, 0xf9, 0xdd, 0x57, 0x0f, 0x87, 0x65, 0xc2,
+	0x0c, 0xef, 0x07, 0xb8, 0x60, 0x57, 0x57, 0xbf, 0xff, 0xf1, 0xcd, 0x4a, 0xb0, 0xb3, 0xe8, 0x57,
+	0x4e, 0x7f, 0x34, 0x44, 0x0f, 0x29, 0x24, 0x24, 0xcb, 0x70, 0x3c, 0xfd, 0x52, 0xa6, 0xed, 0x15,
+	0xd3, 0x1f, 0x0d, 0xad, 0x89, 0x91, 0x23, 0x6c, 0x26, 0xbe, 0x32, 0xbe, 0xe5, 0x7c, 0xe5, 0xfa,
+	0x38, 0xee, 0xe2, 0x14, 0xdd, 0x11, 0x1a, 0xb3, 0x3b, 0x6d, 0x5f, 0x6e, 0xf9, 0x47, 0x8c, 0xeb,
+	0xe2, 0xbd, 0x2d, 0x9e, 0xf9, 0x04, 0xf2, 0x4c, 0xc4, 0x14, 0x8a, 0xfa, 0x1f, 0xc8, 0x09, 0x4c,
+	0x08, 0x7d, 0x1f, 0x91, 0xbe, 0xa8, 0xfe, 0x1f, 0x81, 0xde, 0x61, 0x09, 0x8f, 0x84, 0x30, 0x8a,
+	0xf8, 0xd2, 0x75, 0x31, 0x22, 0x34, 0xc7, 0xe9, 0x6d, 0xd4, 0xd7, 0x34, 0xd9, 0xe2, 0x07, 0x0b,
+	0x4b, 0x18, 0xd8, 0x05, 0xce, 0x3b, 0x22, 0x53, 0x7a, 0xe4, 0x41, 0x7b, 0x25, 0x3b, 0x22, 0x32,
+	0x99, 0xe7, 0xaa, 0xbf, 0x06, 0x2a, 0x1e, 0x92, 0x1c, 0x51, 0x96, 0x93, 0x8e, 0x58, 0x5a, 0xc2,
+	0x62, 0x4d, 0x17, 0x04, 0x85, 0x23, 0xae, 0x00, 0x7c, 0xf1, 0x2e, 0x16, 0x75, 0x76, 0x06, 0x33,
+	0xed, 0xb0, 0x58, 0xd4, 0x99, 0xb1, 0xcb, 0xd4, 0x13, 0xb0, 0x73, 0x33, 0xe0, 0x39, 0x22, 0x3c,
+	0x60, 0x9d, 0x6b, 0xd4, 0xc7, 0xb4, 0x9b, 0x5f, 0x6b, 0xaf, 0x85, 0xed, 0xb6, 0x84, 0x20, 0x47,
+	0xea, 0x02, 0xe0, 0x2b, 0x34, 0x67, 0x9f, 0xe2, 0x1c, 0xd3, 0x9c, 0x30, 0xaa, 0x7d, 0x25, 0x28,
+	0xbb, 0x33, 0x94, 0x60, 0x82, 0xf1, 0x60, 0x1e, 0xba, 0x97, 0xe3, 0x34, 0xd1, 0x2a, 0x32, 0x98,
+	0xe9, 0x6b, 0x88, 0xd3, 0xe4, 0x28, 0x00, 0x3b, 0x4b, 0x46, 0x43, 0xfd, 0x15, 0xd8, 0x98, 0x0e,
+	0x15, 0x97, 0x0e, 0x21, 0x00, 0x2f, 0x83, 0xf5, 0xc1, 0x8c, 0xad, 0xba, 0x0f, 0x5e, 0x14, 0xa7,
+	0x50, 0x0a, 0x40, 0xf1, 0xd7, 0xd7, 0xff, 0x29, 0x83, 0xad, 0x79, 0x41, 0xb9, 0xe7, 0x82, 0xe1,
+	0xd8, 0x2e, 0x0a, 0x3f, 0xa0, 0x33, 0x08, 0x95, 0x15, 0x7d, 0x7d, 0x34, 0x36, 0xd6, 0x9c, 0x19,
+	0x39, 0x71, 0xcc, 0x0f, 0x13, 0xb4, 0x54, 0xa0, 0x33, 0x72, 0xd2, 0xf2, 0x42, 0x88, 0xbe, 0x6b,
+	0x7a, 0x41, 0xd3, 0x51, 0x9e, 0xe9, 0x9b, 0xa3, 0xb1, 0x01, 0x5a, 0x73, 0x72, 0xe2, 0x07, 0x9e,
+	0xef, 0x35, 0xcc, 0x3a, 0x82, 0x6e, 0x0d, 0x85, 0xb6, 0x03, 0x95, 0xe7, 0xfa, 0xce, 0x68, 0x6c,
+	0x6c, 0xf9, 0x8b, 0x72, 0x32, 0x63, 0x6b, 0x5a, 0xa1, 0x03, 0xdd, 0x50, 0x32, 0x56, 0xf5, 0x57,
+	0xa3, 0xb1, 0xb1, 0xe7, 0x2f, 0x95, 0x93, 0xbf, 0x80, 0x0a, 0x74, 0xcd, 0x6a, 0x1d, 0xa2, 0x33,
+	0x2f, 0x80, 0xf6, 0xf9, 0x24, 0x17, 0xe4, 0x9b, 0x7f, 0xe5, 0x2e, 0x1a, 0xca, 0x17, 0xfa, 0xeb,
+	0xd1, 0xd8, 0xd0, 0xe0, 0x13, 0x8a, 0xe2, 0xd8, 0x0d, 0xeb, 0xc2, 0x74, 0x2d, 0x88, 0x02, 0xd3,
+	0xbd, 0x44, 0x35, 0x68, 0x05, 0xd0, 0x6c, 0x40, 0x64, 0x3a, 0x5e, 0xd3, 0x0d, 0x95, 0x17, 0xfa,
+	0x9b, 0xd1, 0xd8, 0x38, 0x74, 0x9e, 0x56, 0x14, 0x9b, 0x47, 0x6d, 0xb7, 0x1e, 0xfb, 0xf0, 0x61,
+	0x60, 0x41, 0x37, 0x54, 0xbe, 0x94, 0x4e, 0xec, 0x27, 0x14, 0xe5, 0x5b, 0xa0, 0xfb, 0x9e, 0x17,
+	0x20, 0x17, 0x86, 0x6d, 0x2f, 0xb8, 0x44, 0xbc, 0xf6, 0x55, 0xee, 0xac, 0x01, 0xdd, 0x9a, 0xb2,
+	0xa6, 0xeb, 0xa3, 0xb1, 0xb1, 0xef, 0x2f, 0x97, 0x8a, 0xb7, 0x60, 0x93, 0x37, 0xb2, 0x65, 0xd6,
+	0xed, 0x9a, 0x19, 0x7a, 0x41, 0x43, 0x79, 0xa9, 0x6f, 0x8f, 0xc6, 0xc6, 0x86, 0x33, 0xa7, 0x46,
+	0x47, 0x60, 0xe3, 0xbd, 0x69, 0xd7, 0x85, 0x6b, 0x51, 0x5c, 0xa0, 0x6f, 0x8d, 0xc6, 0x46, 0xf9,
+	0xfd, 0xbc, 0xa2, 0x14, 0x25, 0x0d, 0xbd, 0x4b, 0xe8, 0xa2, 0xf6, 0x85, 0x1d, 0xc2, 0xba, 0xdd,
+	0x08, 0x95, 0xb2, 0xae, 0x8d, 0xc6, 0xc6, 0x2e, 0xfc, 0x05, 0x45, 0x99, 0x63, 0x55, 0xeb, 0xa6,
+	0x75, 0x29, 0x58, 0xeb, 0x0b, 0xac, 0x39, 0x45, 0x99, 0x86, 0x8c, 0x7c, 0xaf, 0x0d, 0x03, 0xe4,
+	0x78, 0x35, 0xa8, 0x6c, 0xe8, 0xfb, 0xa3, 0xb1, 0xa1, 0xb6, 0x96, 0x2a, 0x0a, 0x0f, 0xfe, 0x31,
+	0x6b, 0x52, 0xe6, 0x4d, 0xd9, 0x6d, 0xe7, 0x09, 0x45, 0x69, 0xba, 0x55, 0xcf, 0xad, 0xd9, 0xee,
+	0xb9, 0xac, 0xc0, 0x96, 0xac, 0x53, 0xf3, 0xb1, 0xa2, 0x88, 0xd9, 0x6e, 0x43, 0xfb, 0xfc, 0x22,
+	0x94, 0x31, 0x29, 0xba, 0x3a, 0x1a, 0x1b, 0x9b, 0xad, 0x05, 0x45, 0xb1, 0xbc, 0xa6, 0x6b, 0xd9,
+	0x75, 0x2f, 0x40, 0x33, 0x1c, 0x65, 0x5b, 0x3f, 0x18, 0x8d, 0x8d, 0x1d, 0x6b, 0xb9, 0xa2, 0xf0,
+	0x66, 0x4d, 0x07, 0xbe, 0x06, 0x7d, 0xaf, 0x61, 0x87, 0x8a, 0x2a, 0xb3, 0x76, 0x16, 0x15, 0x85,
+	0x0f, 0xa9, 0xf9, 0x01, 0x3d, 0x7c, 0xc9, 0xb1, 0x1b, 0x0d, 0x58, 0x9b, 0xba, 0x68, 0x28, 0x3b,
+	0xc5, 0x90, 0x3e, 0x7d, 0xf8, 0xf9, 0x66, 0xd4, 0x61, 0xed, 0x1c, 0x06, 0xa8, 0x6d, 0xbb, 0x35,
+	0xaf, 0xad, 0xec, 0xca, 0x7d, 0x3c, 0x5b, 0x3c, 0xfc, 0x3c, 0x44, 0x31, 0x2c, 0xa2, 0x4c, 0x7b,
+	0x72, 0x50, 0x9c, 0xf9, 0xc3, 0x6f, 0x79, 0x0e, 0x8f, 0xc4, 0xf6, 0x5c, 0xc4, 0x37, 0xe8, 0x1c,
+	0x22, 0xdb, 0x0d, 0x61, 0xd0, 0x32, 0xeb, 0xca, 0xbe, 0xec, 0x84, 0xf5, 0xc4, 0xe1, 0x97, 0x29,
+	0x3d, 0xf2, 0xa0, 0x1c, 0xc8, 0xc2, 0x39, 0xcb, 0x0f, 0x3f, 0xfc, 0x60, 0x87, 0xc8, 0xf5, 0x42,
+	0xdb, 0x12, 0xbb, 0x65, 0x7b, 0x35, 0x45, 0xd3, 0x77, 0x47, 0x63, 0x43, 0x81, 0x4b, 0x0e, 0xff,
+	0xdc, 0xa8, 0x34, 0x94, 0x57, 0xc5, 0x4e, 0x3c, 0x3e, 0xfc, 0x4d, 0x9f, 0xe7, 0x88, 0xa0, 0xef,
+	0x59, 0x17, 0xa8, 0x0e, 0xdd, 0xf3, 0xf0, 0x42, 0xd1, 0xf5, 0xbd, 0xd1, 0xd8, 0xd8, 0x6e, 0x2e,
+	0x3b, 0xfc, 0x73, 0xf6, 0x01, 0x0c, 0xa1, 0x1b, 0xda, 0x9e, 0xab, 0x1c, 0xca, 0x49, 0x6f, 0xfe,
+	0xc2, 0xe1, 0x7f, 0xe8, 0x5e, 0x08, 0x03, 0x47, 0x79, 0x2d, 0x83, 0xb1, 0x66, 0x0f, 0xbf, 0xbe,
+	0xfa, 0xcf, 0x7f, 0x57, 0x56, 0xaa, 0x7f, 0xfe, 0xfe, 0x53, 0xa5, 0xf4, 0xc3, 0xa7, 0x4a, 0xe9,
+	0xff, 0x9f, 0x2a, 0xa5, 0x7f, 0x7d, 0xae, 0xac, 0xfc, 0xf0, 0xb9, 0xb2, 0xf2, 0xbf, 0xcf, 0x95,
+	0x95, 0xbf, 0xbd, 0x9d, 0xf9, 0x39, 0x71, 0x49, 0xd2, 0xc8, 0x62, 0x29, 0x3e, 0xcd, 0x70, 0x2f,
+	0x22, 0xa7, 0xc3, 0xd3, 0x2e, 0xbb, 0x95, 0xbf, 0x28, 0xae, 0x5e, 0x88, 0x9f, 0x3e, 0xbf, 0xfb,
+	0x79, 0x00, 0x93, 0xb4, 0x21, 0x08, 0x36, 0x0d, 0x00, 0x00,
 }
 
 func (m *MsgSetNetworkProperties) Marshal() (dAtA []byte, err error) {
@@ -411,6 +712,130 @@ func (m *NetworkProperties) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CouncilorTerm != 0 {
+		i = encodeVarintNetworkProperties(dAtA, i, uint64(m.CouncilorTerm))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.UptimeEpochRetention != 0 {
+		i = encodeVarintNetworkProperties(dAtA, i, uint64(m.UptimeEpochRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.UptimeEpochLength != 0 {
+		i = encodeVarintNetworkProperties(dAtA, i, uint64(m.UptimeEpochLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.MaxValidators != 0 {
+		i = encodeVarintNetworkProperties(dAtA, i, uint64(m.MaxValidators))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.ExitNoticePeriod != 0 {
+		i = encodeVarintNetworkProperties(dAtA, i, uint64(m.ExitNoticePeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.MaxCommissionChange != 0 {
+		i = encodeVarintNetworkProperties(dAtA, i, uint64(m.MaxCommissionChange))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.CommissionChangeInterval != 0 {
+		i = encodeVarintNetworkProperties(dAtA, i, uint64(m.CommissionChangeInterval))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.MinJailTime != 0 {
+		i = encodeVarintNetworkProperties(dAtA, i, uint64(m.MinJailTime))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.FeeLedgerWindow != 0 {
+		i = encodeVarintNetworkProperties(dAtA, i, uint64(m.FeeLedgerWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.MaxCouncilorMissedProposals != 0 {
+		i = encodeVarintNetworkProperties(dAtA, i, uint64(m.MaxCouncilorMissedProposals))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.MinProposalDeposits) > 0 {
+		for iNdEx := len(m.MinProposalDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinProposalDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNetworkProperties(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.MinProposalDeposit != 0 {
+		i = encodeVarintNetworkProperties(dAtA, i, uint64(m.MinProposalDeposit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.CouncilorVoteWeight != 0 {
+		i = encodeVarintNetworkProperties(dAtA, i, uint64(m.CouncilorVoteWeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.VoteWeightMode != 0 {
+		i = encodeVarintNetworkProperties(dAtA, i, uint64(m.VoteWeightMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.UnbondingTime != 0 {
+		i = encodeVarintNetworkProperties(dAtA, i, uint64(m.UnbondingTime))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxValidatorPowerPercent != 0 {
+		i = encodeVarintNetworkProperties(dAtA, i, uint64(m.MaxValidatorPowerPercent))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.ValidatorPowerMode != 0 {
+		i = encodeVarintNetworkProperties(dAtA, i, uint64(m.ValidatorPowerMode))
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.EnableTokenBlacklist {
 		i--
 		if m.EnableTokenBlacklist {
@@ -494,6 +919,41 @@ func (m *NetworkProperties) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProposalTypeDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalTypeDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalTypeDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintNetworkProperties(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProposalType) > 0 {
+		i -= len(m.ProposalType)
+		copy(dAtA[i:], m.ProposalType)
+		i = encodeVarintNetworkProperties(dAtA, i, uint64(len(m.ProposalType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNetworkProperties(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNetworkProperties(v)
 	base := offset
@@ -567,6 +1027,76 @@ func (m *NetworkProperties) Size() (n int) {
 	if m.EnableTokenBlacklist {
 		n += 2
 	}
+	if m.ValidatorPowerMode != 0 {
+		n += 1 + sovNetworkProperties(uint64(m.ValidatorPowerMode))
+	}
+	if m.MaxValidatorPowerPercent != 0 {
+		n += 1 + sovNetworkProperties(uint64(m.MaxValidatorPowerPercent))
+	}
+	if m.UnbondingTime != 0 {
+		n += 2 + sovNetworkProperties(uint64(m.UnbondingTime))
+	}
+	if m.VoteWeightMode != 0 {
+		n += 2 + sovNetworkProperties(uint64(m.VoteWeightMode))
+	}
+	if m.CouncilorVoteWeight != 0 {
+		n += 2 + sovNetworkProperties(uint64(m.CouncilorVoteWeight))
+	}
+	if m.MinProposalDeposit != 0 {
+		n += 2 + sovNetworkProperties(uint64(m.MinProposalDeposit))
+	}
+	if len(m.MinProposalDeposits) > 0 {
+		for _, e := range m.MinProposalDeposits {
+			l = e.Size()
+			n += 2 + l + sovNetworkProperties(uint64(l))
+		}
+	}
+	if m.MaxCouncilorMissedProposals != 0 {
+		n += 2 + sovNetworkProperties(uint64(m.MaxCouncilorMissedProposals))
+	}
+	if m.FeeLedgerWindow != 0 {
+		n += 2 + sovNetworkProperties(uint64(m.FeeLedgerWindow))
+	}
+	if m.MinJailTime != 0 {
+		n += 2 + sovNetworkProperties(uint64(m.MinJailTime))
+	}
+	if m.CommissionChangeInterval != 0 {
+		n += 2 + sovNetworkProperties(uint64(m.CommissionChangeInterval))
+	}
+	if m.MaxCommissionChange != 0 {
+		n += 2 + sovNetworkProperties(uint64(m.MaxCommissionChange))
+	}
+	if m.ExitNoticePeriod != 0 {
+		n += 2 + sovNetworkProperties(uint64(m.ExitNoticePeriod))
+	}
+	if m.MaxValidators != 0 {
+		n += 2 + sovNetworkProperties(uint64(m.MaxValidators))
+	}
+	if m.UptimeEpochLength != 0 {
+		n += 2 + sovNetworkProperties(uint64(m.UptimeEpochLength))
+	}
+	if m.UptimeEpochRetention != 0 {
+		n += 2 + sovNetworkProperties(uint64(m.UptimeEpochRetention))
+	}
+	if m.CouncilorTerm != 0 {
+		n += 2 + sovNetworkProperties(uint64(m.CouncilorTerm))
+	}
+	return n
+}
+
+func (m *ProposalTypeDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProposalType)
+	if l > 0 {
+		n += 1 + l + sovNetworkProperties(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovNetworkProperties(uint64(m.Amount))
+	}
 	return n
 }
 
@@ -978,6 +1508,448 @@ func (m *NetworkProperties) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableTokenBlacklist = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPowerMode", wireType)
+			}
+			m.ValidatorPowerMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkProperties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorPowerMode |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorPowerPercent", wireType)
+			}
+			m.MaxValidatorPowerPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkProperties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidatorPowerPercent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			m.UnbondingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkProperties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteWeightMode", wireType)
+			}
+			m.VoteWeightMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkProperties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteWeightMode |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CouncilorVoteWeight", wireType)
+			}
+			m.CouncilorVoteWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkProperties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CouncilorVoteWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinProposalDeposit", wireType)
+			}
+			m.MinProposalDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkProperties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinProposalDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinProposalDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkProperties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkProperties
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkProperties
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinProposalDeposits = append(m.MinProposalDeposits, ProposalTypeDeposit{})
+			if err := m.MinProposalDeposits[len(m.MinProposalDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCouncilorMissedProposals", wireType)
+			}
+			m.MaxCouncilorMissedProposals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkProperties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCouncilorMissedProposals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLedgerWindow", wireType)
+			}
+			m.FeeLedgerWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkProperties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeLedgerWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinJailTime", wireType)
+			}
+			m.MinJailTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkProperties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinJailTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionChangeInterval", wireType)
+			}
+			m.CommissionChangeInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkProperties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommissionChangeInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionChange", wireType)
+			}
+			m.MaxCommissionChange = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkProperties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCommissionChange |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitNoticePeriod", wireType)
+			}
+			m.ExitNoticePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkProperties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitNoticePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			}
+			m.MaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkProperties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeEpochLength", wireType)
+			}
+			m.UptimeEpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkProperties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UptimeEpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeEpochRetention", wireType)
+			}
+			m.UptimeEpochRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkProperties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UptimeEpochRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CouncilorTerm", wireType)
+			}
+			m.CouncilorTerm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkProperties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CouncilorTerm |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkProperties(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkProperties
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkProperties
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalTypeDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkProperties
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalTypeDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalTypeDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkProperties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkProperties
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkProperties
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkProperties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetworkProperties(dAtA[iNdEx:])
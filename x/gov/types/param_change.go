@@ -0,0 +1,97 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewParamChange creates a new ParamChange instance
+func NewParamChange(subspace, key string, valueType ParamValueType, value string) ParamChange {
+	return ParamChange{
+		Subspace: subspace,
+		Key:      key,
+		Type:     valueType,
+		Value:    value,
+	}
+}
+
+// ValidateBasic checks the change targets a param and that its value can be parsed as its type
+func (c ParamChange) ValidateBasic() error {
+	if strings.TrimSpace(c.Subspace) == "" {
+		return sdkerrors.Wrap(ErrInvalidParamChange, "empty subspace")
+	}
+
+	if strings.TrimSpace(c.Key) == "" {
+		return sdkerrors.Wrap(ErrInvalidParamChange, "empty key")
+	}
+
+	_, err := c.AminoJSONValue()
+	return err
+}
+
+// AminoJSONValue returns the value of the change encoded the way the param subspaces decode it,
+// 64 bits integers and durations are quoted in amino JSON.
+func (c ParamChange) AminoJSONValue() ([]byte, error) {
+	var value interface{}
+
+	switch c.Type {
+	case ParamValueTypeBool:
+		v, err := strconv.ParseBool(c.Value)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(ErrInvalidParamChange, "invalid bool value %s", c.Value)
+		}
+		value = v
+	case ParamValueTypeUint:
+		v, err := strconv.ParseUint(c.Value, 10, 64)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(ErrInvalidParamChange, "invalid uint value %s", c.Value)
+		}
+		value = strconv.FormatUint(v, 10)
+	case ParamValueTypeDec:
+		v, err := sdk.NewDecFromStr(c.Value)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(ErrInvalidParamChange, "invalid dec value %s", c.Value)
+		}
+		value = v.String()
+	case ParamValueTypeDuration:
+		v, err := time.ParseDuration(c.Value)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(ErrInvalidParamChange, "invalid duration value %s", c.Value)
+		}
+		value = strconv.FormatInt(int64(v), 10)
+	case ParamValueTypeString:
+		value = c.Value
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidParamChange, "invalid value type %s", c.Type)
+	}
+
+	return json.Marshal(value)
+}
+
+// ValidateParamChanges validates a set of changes, a param can only be changed once
+func ValidateParamChanges(changes []ParamChange) error {
+	if len(changes) == 0 {
+		return sdkerrors.Wrap(ErrInvalidParamChange, "no parameter change")
+	}
+
+	keys := make(map[string]bool, len(changes))
+	for _, change := range changes {
+		if err := change.ValidateBasic(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s/%s", change.Subspace, change.Key)
+		if keys[key] {
+			return sdkerrors.Wrapf(ErrInvalidParamChange, "duplicated parameter %s", key)
+		}
+		keys[key] = true
+	}
+
+	return nil
+}
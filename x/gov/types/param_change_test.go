@@ -0,0 +1,82 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamChange_AminoJSONValue(t *testing.T) {
+	tests := []struct {
+		name          string
+		change        ParamChange
+		expectedValue string
+		expectErr     bool
+	}{
+		{
+			name:          "bool",
+			change:        NewParamChange("bank", "DefaultSendEnabled", ParamValueTypeBool, "true"),
+			expectedValue: `true`,
+		},
+		{
+			name:          "uint",
+			change:        NewParamChange("customslashing", "SignedBlocksWindow", ParamValueTypeUint, "200"),
+			expectedValue: `"200"`,
+		},
+		{
+			name:          "dec",
+			change:        NewParamChange("customslashing", "SlashFractionDowntime", ParamValueTypeDec, "0.5"),
+			expectedValue: `"0.500000000000000000"`,
+		},
+		{
+			name:          "duration",
+			change:        NewParamChange("customslashing", "DowntimeInactiveDuration", ParamValueTypeDuration, "1m"),
+			expectedValue: `"60000000000"`,
+		},
+		{
+			name:          "string",
+			change:        NewParamChange("some", "Key", ParamValueTypeString, "some value"),
+			expectedValue: `"some value"`,
+		},
+		{
+			name:      "negative uint",
+			change:    NewParamChange("customslashing", "SignedBlocksWindow", ParamValueTypeUint, "-1"),
+			expectErr: true,
+		},
+		{
+			name:      "invalid duration",
+			change:    NewParamChange("customslashing", "DowntimeInactiveDuration", ParamValueTypeDuration, "10"),
+			expectErr: true,
+		},
+		{
+			name:      "unspecified type",
+			change:    NewParamChange("customslashing", "SignedBlocksWindow", ParamValueTypeUnspecified, "200"),
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := tt.change.AminoJSONValue()
+			if tt.expectErr {
+				require.Error(t, err)
+				require.True(t, ErrInvalidParamChange.Is(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedValue, string(value))
+		})
+	}
+}
+
+func TestValidateParamChanges(t *testing.T) {
+	change := NewParamChange("customslashing", "SignedBlocksWindow", ParamValueTypeUint, "200")
+
+	require.NoError(t, ValidateParamChanges([]ParamChange{change}))
+	require.Error(t, ValidateParamChanges(nil))
+	require.Error(t, ValidateParamChanges([]ParamChange{change, change}))
+	require.Error(t, ValidateParamChanges([]ParamChange{NewParamChange("", "SignedBlocksWindow", ParamValueTypeUint, "200")}))
+	require.Error(t, ValidateParamChanges([]ParamChange{NewParamChange("customslashing", "", ParamValueTypeUint, "200")}))
+}
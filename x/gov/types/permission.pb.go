@@ -76,6 +76,26 @@ const (
 	PermCreateTokensWhiteBlackChangeProposal PermValue = 23
 	// PERMISSION_VOTE_TOKENS_WHITE_BLACK_CHANGE_PROPOSAL defines the permission needed to vote on blacklist/whitelisted tokens proposal
 	PermVoteTokensWhiteBlackChangeProposal PermValue = 24
+	// PERMISSION_CREATE_SET_ROLE_VOTE_WEIGHT_PROPOSAL defines the permission needed to create a proposal to set the vote weight of a role
+	PermCreateSetRoleVoteWeightProposal PermValue = 25
+	// PERMISSION_VOTE_SET_ROLE_VOTE_WEIGHT_PROPOSAL defines the permission needed to vote on set role vote weight proposal
+	PermVoteSetRoleVoteWeightProposal PermValue = 26
+	// PERMISSION_CANCEL_PROPOSAL defines the permission needed by a councilor to cancel a proposal in enactment
+	PermCancelProposal PermValue = 27
+	// PERMISSION_CREATE_PARAMETER_CHANGE_PROPOSAL defines the permission needed to create a proposal to change module params
+	PermCreateParameterChangeProposal PermValue = 28
+	// PERMISSION_VOTE_PARAMETER_CHANGE_PROPOSAL defines the permission needed to vote on parameter change proposal
+	PermVoteParameterChangeProposal PermValue = 29
+	// PERMISSION_CREATE_MULTI_CONTENT_PROPOSAL defines the permission needed to create a proposal batching several contents
+	PermCreateMultiContentProposal PermValue = 30
+	// PERMISSION_CREATE_SOFTWARE_UPGRADE_PROPOSAL defines the permission needed to create a proposal to schedule or cancel a software upgrade
+	PermCreateSoftwareUpgradeProposal PermValue = 31
+	// PERMISSION_VOTE_SOFTWARE_UPGRADE_PROPOSAL defines the permission needed to vote on software upgrade proposals
+	PermVoteSoftwareUpgradeProposal PermValue = 32
+	// PERMISSION_CREATE_REMOVE_COUNCILOR_PROPOSAL defines the permission needed to create a proposal to remove a councilor
+	PermCreateRemoveCouncilorProposal PermValue = 33
+	// PERMISSION_VOTE_REMOVE_COUNCILOR_PROPOSAL defines the permission needed to vote on remove councilor proposal
+	PermVoteRemoveCouncilorProposal PermValue = 34
 )
 
 var PermValue_name = map[int32]string{
@@ -104,6 +124,16 @@ var PermValue_name = map[int32]string{
 	22: "PERMISSION_VOTE_CREATE_ROLE_PROPOSAL",
 	23: "PERMISSION_CREATE_TOKENS_WHITE_BLACK_CHANGE_PROPOSAL",
 	24: "PERMISSION_VOTE_TOKENS_WHITE_BLACK_CHANGE_PROPOSAL",
+	25: "PERMISSION_CREATE_SET_ROLE_VOTE_WEIGHT_PROPOSAL",
+	26: "PERMISSION_VOTE_SET_ROLE_VOTE_WEIGHT_PROPOSAL",
+	27: "PERMISSION_CANCEL_PROPOSAL",
+	28: "PERMISSION_CREATE_PARAMETER_CHANGE_PROPOSAL",
+	29: "PERMISSION_VOTE_PARAMETER_CHANGE_PROPOSAL",
+	30: "PERMISSION_CREATE_MULTI_CONTENT_PROPOSAL",
+	31: "PERMISSION_CREATE_SOFTWARE_UPGRADE_PROPOSAL",
+	32: "PERMISSION_VOTE_SOFTWARE_UPGRADE_PROPOSAL",
+	33: "PERMISSION_CREATE_REMOVE_COUNCILOR_PROPOSAL",
+	34: "PERMISSION_VOTE_REMOVE_COUNCILOR_PROPOSAL",
 }
 
 var PermValue_value = map[string]int32{
@@ -132,6 +162,16 @@ var PermValue_value = map[string]int32{
 	"PERMISSION_VOTE_CREATE_ROLE_PROPOSAL":                 22,
 	"PERMISSION_CREATE_TOKENS_WHITE_BLACK_CHANGE_PROPOSAL": 23,
 	"PERMISSION_VOTE_TOKENS_WHITE_BLACK_CHANGE_PROPOSAL":   24,
+	"PERMISSION_CREATE_SET_ROLE_VOTE_WEIGHT_PROPOSAL":      25,
+	"PERMISSION_VOTE_SET_ROLE_VOTE_WEIGHT_PROPOSAL":        26,
+	"PERMISSION_CANCEL_PROPOSAL":                           27,
+	"PERMISSION_CREATE_PARAMETER_CHANGE_PROPOSAL":          28,
+	"PERMISSION_VOTE_PARAMETER_CHANGE_PROPOSAL":            29,
+	"PERMISSION_CREATE_MULTI_CONTENT_PROPOSAL":             30,
+	"PERMISSION_CREATE_SOFTWARE_UPGRADE_PROPOSAL":          31,
+	"PERMISSION_VOTE_SOFTWARE_UPGRADE_PROPOSAL":            32,
+	"PERMISSION_CREATE_REMOVE_COUNCILOR_PROPOSAL":          33,
+	"PERMISSION_VOTE_REMOVE_COUNCILOR_PROPOSAL":            34,
 }
 
 func (x PermValue) String() string {
@@ -149,57 +189,69 @@ func init() {
 func init() { proto.RegisterFile("permission.proto", fileDescriptor_c837ef01cbda0ad8) }
 
 var fileDescriptor_c837ef01cbda0ad8 = []byte{
-	// 817 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0x4f, 0x6f, 0xdc, 0x44,
-	0x18, 0xc6, 0x37, 0x50, 0x4a, 0x3a, 0x94, 0xc6, 0x38, 0x21, 0x8d, 0x46, 0xd4, 0x4c, 0x4a, 0x1a,
-	0x45, 0xa1, 0x64, 0xc5, 0x1f, 0x21, 0x21, 0x0e, 0xc8, 0xd9, 0x4c, 0x12, 0x93, 0xcd, 0x7a, 0x35,
-	0xf6, 0x26, 0x34, 0x12, 0xb2, 0x26, 0x61, 0xd8, 0x98, 0x75, 0x76, 0x56, 0x63, 0xa7, 0xb4, 0xdf,
-	0x00, 0xcd, 0x89, 0x2f, 0x30, 0x5c, 0xf8, 0x32, 0x1c, 0x7b, 0xe4, 0x88, 0x92, 0x2f, 0x82, 0xec,
-	0x6c, 0x3c, 0xb6, 0xd7, 0x75, 0x7b, 0xda, 0x3f, 0x9e, 0xf7, 0x37, 0xcf, 0x3c, 0xef, 0x3b, 0x8f,
-	0x81, 0x31, 0x61, 0xe2, 0x22, 0x8c, 0xe3, 0x90, 0x8f, 0xb7, 0x26, 0x82, 0x27, 0xdc, 0x9c, 0x1f,
-	0x85, 0x82, 0x6e, 0x0d, 0xf9, 0x73, 0xb8, 0x34, 0xe4, 0x43, 0x9e, 0xfd, 0xd9, 0x4e, 0xbf, 0xdd,
-	0x3c, 0xdf, 0xfc, 0x6b, 0x01, 0xdc, 0xeb, 0x33, 0x71, 0x71, 0x44, 0xa3, 0x4b, 0x66, 0xae, 0x82,
-	0x85, 0x3e, 0x26, 0x87, 0x8e, 0xe7, 0x39, 0x6e, 0x2f, 0x38, 0xc1, 0xc4, 0x35, 0x5a, 0xf0, 0xbe,
-	0x54, 0x68, 0x3e, 0x5d, 0x73, 0xc2, 0x04, 0x37, 0xbf, 0x05, 0xb0, 0xb0, 0xc4, 0xc3, 0x7e, 0xa0,
-	0x7f, 0x7a, 0xc6, 0x1c, 0x5c, 0x96, 0x0a, 0x99, 0xe9, 0x6a, 0x8f, 0x25, 0xfd, 0x5c, 0x4d, 0x5c,
-	0xa9, 0xeb, 0x74, 0x6d, 0xe7, 0x30, 0x38, 0xb2, 0xbb, 0xce, 0x8e, 0xed, 0xbb, 0xc4, 0x78, 0x47,
-	0xd7, 0x75, 0x22, 0x1a, 0xa6, 0x72, 0xc2, 0x5f, 0x68, 0xc2, 0x45, 0x6d, 0x5d, 0xc7, 0x1d, 0xf4,
-	0x3a, 0x4e, 0xd7, 0x25, 0xc6, 0xbb, 0x95, 0xba, 0x0e, 0xbf, 0x1c, 0x9f, 0x85, 0x11, 0x17, 0xa6,
-	0x0f, 0x36, 0x8b, 0x75, 0x04, 0xdb, 0x3e, 0xae, 0xca, 0x0d, 0xfa, 0xc4, 0xed, 0xbb, 0x9e, 0xdd,
-	0x35, 0xee, 0xc0, 0x35, 0xa9, 0x10, 0xca, 0x38, 0x82, 0xd1, 0x84, 0x95, 0xd5, 0xf7, 0x05, 0x9f,
-	0xf0, 0x98, 0x46, 0xa6, 0x0b, 0x36, 0x0a, 0xd4, 0x23, 0xb7, 0x89, 0xf9, 0x1e, 0x5c, 0x95, 0x0a,
-	0x3d, 0xca, 0xdc, 0xe5, 0x15, 0x62, 0x0e, 0xfc, 0x1e, 0x3c, 0x2a, 0x00, 0x07, 0x7d, 0x0f, 0x13,
-	0x3f, 0xf0, 0xdd, 0x03, 0xdc, 0x0b, 0xec, 0xae, 0x63, 0x7b, 0xc6, 0x5d, 0xb8, 0x22, 0x15, 0x5a,
-	0x4a, 0x4b, 0x07, 0x93, 0x98, 0x89, 0xc4, 0xe7, 0x23, 0x36, 0xb6, 0xa3, 0x90, 0xc6, 0xe6, 0x97,
-	0x60, 0xa5, 0x78, 0xc6, 0x7d, 0xbb, 0xb7, 0x87, 0x03, 0xff, 0xa7, 0x60, 0x17, 0x63, 0xe3, 0x7d,
-	0xb8, 0x28, 0x15, 0x5a, 0xc8, 0x4e, 0x74, 0x4e, 0xc7, 0x43, 0xe6, 0xbf, 0xd8, 0x65, 0xcc, 0xfc,
-	0x0e, 0x7c, 0xf2, 0xba, 0xfd, 0x88, 0xed, 0x63, 0x63, 0x1e, 0x3e, 0x94, 0x0a, 0x2d, 0x56, 0xb6,
-	0x23, 0x34, 0x61, 0xe6, 0x16, 0x58, 0x9e, 0x2d, 0x25, 0x6e, 0x17, 0x1b, 0xf7, 0xa0, 0x29, 0x15,
-	0x7a, 0xa0, 0x8b, 0x08, 0x8f, 0x98, 0xd9, 0x2f, 0x79, 0x35, 0x5d, 0xbf, 0x63, 0xfb, 0x76, 0x40,
-	0xf0, 0x9e, 0xe3, 0xf9, 0xe4, 0x99, 0xf6, 0x0a, 0xc0, 0xc7, 0x52, 0x21, 0x4b, 0x13, 0x76, 0x68,
-	0x42, 0x09, 0x1b, 0x86, 0x71, 0x22, 0x5e, 0xe6, 0x66, 0x3d, 0x03, 0x5f, 0x54, 0xdd, 0x6f, 0xc6,
-	0x7e, 0x00, 0xd7, 0xa5, 0x42, 0x8f, 0x6f, 0x5b, 0xd0, 0x80, 0xfe, 0x19, 0xb4, 0xeb, 0xc7, 0xa5,
-	0x87, 0xfd, 0x63, 0x97, 0x1c, 0x64, 0x4c, 0x4c, 0xfc, 0x02, 0xfc, 0x3e, 0xdc, 0x90, 0x0a, 0xad,
-	0x95, 0x66, 0xa6, 0xc7, 0x92, 0xdf, 0xb9, 0x18, 0xa5, 0x58, 0x26, 0x92, 0x46, 0xe5, 0xcd, 0xf0,
-	0x0f, 0xcb, 0xca, 0xdf, 0x1a, 0x3d, 0x55, 0x3e, 0x3b, 0x48, 0x1a, 0xfd, 0x40, 0xa3, 0x6f, 0x74,
-	0x57, 0xe7, 0xaa, 0x60, 0xca, 0xe7, 0xaf, 0xb9, 0x43, 0xae, 0x4b, 0x72, 0xf1, 0x87, 0xd8, 0xf3,
-	0xec, 0x3d, 0xec, 0x19, 0x0b, 0xf0, 0xa9, 0x54, 0x68, 0xa3, 0x7c, 0x89, 0x38, 0x17, 0x53, 0xe5,
-	0x87, 0x2c, 0x8e, 0xe9, 0x90, 0x69, 0xfc, 0xa0, 0x84, 0x2f, 0xb6, 0xb3, 0x56, 0xb7, 0xa1, 0xef,
-	0xa8, 0x6e, 0x66, 0x8d, 0xea, 0x63, 0xf0, 0xf4, 0x0d, 0x86, 0xa4, 0x93, 0xae, 0xb9, 0x1f, 0xc1,
-	0x27, 0x52, 0xa1, 0xd5, 0x5a, 0x3f, 0xd2, 0xc1, 0xcf, 0xc1, 0x5e, 0x29, 0x52, 0x66, 0xf5, 0x96,
-	0xb1, 0x26, 0xfc, 0x4c, 0x2a, 0xf4, 0x69, 0x8d, 0xdc, 0x12, 0xf4, 0x14, 0x7c, 0x55, 0x9b, 0x28,
-	0x75, 0x0e, 0x6b, 0xf8, 0x22, 0xdc, 0x94, 0x0a, 0xad, 0x17, 0xb3, 0xa5, 0xc1, 0xe8, 0xa3, 0xba,
-	0x3e, 0x0e, 0x7a, 0x3f, 0xda, 0x4e, 0x57, 0x67, 0xb0, 0x86, 0x2f, 0xcd, 0x18, 0x32, 0xfe, 0x8d,
-	0x86, 0x51, 0x9e, 0xc9, 0x39, 0x77, 0x17, 0xac, 0xcf, 0x72, 0xa7, 0x1f, 0x69, 0x30, 0x68, 0xe4,
-	0xc7, 0x10, 0x4a, 0x85, 0x96, 0x35, 0x32, 0x4d, 0x88, 0x9c, 0xb3, 0x0f, 0xd6, 0xaa, 0x1e, 0xd4,
-	0x52, 0x96, 0xa1, 0x25, 0x15, 0x82, 0xb7, 0xa7, 0xae, 0x21, 0xfd, 0x0a, 0xbe, 0x99, 0x55, 0x94,
-	0x75, 0xc7, 0x0b, 0x8e, 0xf7, 0x1d, 0x1f, 0x07, 0xdb, 0x5d, 0xbb, 0x73, 0x70, 0x9b, 0x95, 0x39,
-	0xf9, 0x61, 0x75, 0x74, 0xb3, 0x46, 0xc5, 0xc7, 0xe7, 0x61, 0xc2, 0xb6, 0x23, 0x7a, 0x36, 0xba,
-	0xc9, 0xd0, 0xa6, 0xae, 0xbd, 0xc5, 0x2e, 0x2b, 0xe5, 0xae, 0x35, 0xef, 0x01, 0xef, 0xfc, 0xf1,
-	0xb7, 0xd5, 0xda, 0xfe, 0xe1, 0x9f, 0x2b, 0x6b, 0xee, 0xd5, 0x95, 0x35, 0xf7, 0xdf, 0x95, 0x35,
-	0xf7, 0xe7, 0xb5, 0xd5, 0x7a, 0x75, 0x6d, 0xb5, 0xfe, 0xbd, 0xb6, 0x5a, 0x27, 0x4f, 0x86, 0x61,
-	0x72, 0x7e, 0x79, 0xba, 0x75, 0xc6, 0x2f, 0xda, 0x07, 0xa1, 0xa0, 0x1d, 0x2e, 0x58, 0x3b, 0x66,
-	0x23, 0x1a, 0xb6, 0x5f, 0xb4, 0x87, 0xfc, 0x79, 0x3b, 0x79, 0x39, 0x61, 0xf1, 0xe9, 0xdd, 0xec,
-	0x45, 0xff, 0xf5, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf3, 0x71, 0x8f, 0x43, 0x1c, 0x08, 0x00,
-	0x00,
+	// 1012 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0xdf, 0x52, 0xdb, 0x46,
+	0x14, 0xc6, 0xa1, 0x4d, 0xd3, 0x64, 0x9b, 0x06, 0x55, 0x10, 0x42, 0xb7, 0xc1, 0x59, 0x08, 0xa1,
+	0x94, 0xa4, 0x78, 0xfa, 0x67, 0x3a, 0xd3, 0xe9, 0x45, 0x67, 0x31, 0x0b, 0xa8, 0xd8, 0x96, 0x67,
+	0xb5, 0xb6, 0x13, 0xa6, 0x1d, 0xcd, 0x42, 0x36, 0x46, 0xc5, 0x78, 0x3d, 0x2b, 0x41, 0x92, 0x37,
+	0xe8, 0xe8, 0xaa, 0x2f, 0xa0, 0xab, 0xbe, 0x4c, 0x2f, 0x73, 0xd9, 0xcb, 0x0e, 0xf4, 0x41, 0x3a,
+	0x32, 0xa0, 0x95, 0x64, 0x59, 0xc9, 0x15, 0xd8, 0xde, 0xf3, 0x3b, 0xdf, 0xf9, 0xf6, 0xec, 0xd9,
+	0x05, 0xc6, 0x50, 0xa8, 0x13, 0xcf, 0xf7, 0x3d, 0x39, 0xd8, 0x18, 0x2a, 0x19, 0x48, 0xf3, 0xd6,
+	0xb1, 0xa7, 0xf8, 0x46, 0x4f, 0x9e, 0xc1, 0xb9, 0x9e, 0xec, 0xc9, 0xd1, 0x97, 0xd5, 0xf8, 0xbf,
+	0xcb, 0xdf, 0xd7, 0xff, 0xbb, 0x07, 0x6e, 0xb7, 0x84, 0x3a, 0xe9, 0xf0, 0xfe, 0xa9, 0x30, 0x97,
+	0xc0, 0x4c, 0x8b, 0xd0, 0x86, 0xe5, 0x38, 0x96, 0xdd, 0x74, 0xf7, 0x09, 0xb5, 0x8d, 0x29, 0x78,
+	0x27, 0x8c, 0xd0, 0xad, 0x78, 0xcd, 0xbe, 0x50, 0xd2, 0xfc, 0x01, 0xc0, 0xd4, 0x12, 0x87, 0x30,
+	0x57, 0x7f, 0x74, 0x8c, 0x69, 0x38, 0x1f, 0x46, 0xc8, 0x8c, 0x57, 0x3b, 0x22, 0x68, 0x25, 0x6a,
+	0xfc, 0x5c, 0x5c, 0xad, 0x8e, 0xad, 0x86, 0xdb, 0xc1, 0x75, 0x6b, 0x0b, 0x33, 0x9b, 0x1a, 0x1f,
+	0xe8, 0xb8, 0x5a, 0x9f, 0x7b, 0xb1, 0x1c, 0xef, 0x05, 0x0f, 0xa4, 0x2a, 0x8c, 0xab, 0xd9, 0xed,
+	0x66, 0xcd, 0xaa, 0xdb, 0xd4, 0xf8, 0x30, 0x17, 0x57, 0x93, 0xa7, 0x83, 0x43, 0xaf, 0x2f, 0x95,
+	0xc9, 0xc0, 0x7a, 0x3a, 0x8e, 0x12, 0xcc, 0x48, 0x5e, 0xae, 0xdb, 0xa2, 0x76, 0xcb, 0x76, 0x70,
+	0xdd, 0xb8, 0x01, 0x57, 0xc2, 0x08, 0xa1, 0x11, 0x47, 0x09, 0x1e, 0x88, 0xac, 0xfa, 0x96, 0x92,
+	0x43, 0xe9, 0xf3, 0xbe, 0x69, 0x83, 0xb5, 0x14, 0xb5, 0x63, 0x97, 0x31, 0x3f, 0x82, 0x4b, 0x61,
+	0x84, 0x16, 0x47, 0xee, 0xca, 0x1c, 0x31, 0x01, 0xfe, 0x04, 0x16, 0x53, 0xc0, 0x76, 0xcb, 0x21,
+	0x94, 0xb9, 0xcc, 0xde, 0x23, 0x4d, 0x17, 0xd7, 0x2d, 0xec, 0x18, 0x37, 0xe1, 0x42, 0x18, 0xa1,
+	0xb9, 0x38, 0xb4, 0x3d, 0xf4, 0x85, 0x0a, 0x98, 0x3c, 0x16, 0x03, 0xdc, 0xf7, 0xb8, 0x6f, 0x7e,
+	0x03, 0x16, 0xd2, 0x35, 0xee, 0xe2, 0xe6, 0x0e, 0x71, 0xd9, 0x33, 0x77, 0x9b, 0x10, 0xe3, 0x63,
+	0x38, 0x1b, 0x46, 0x68, 0x66, 0x54, 0xd1, 0x11, 0x1f, 0xf4, 0x04, 0x7b, 0xbd, 0x2d, 0x84, 0xf9,
+	0x23, 0x78, 0x30, 0x29, 0x1f, 0xc5, 0x8c, 0x18, 0xb7, 0xe0, 0xfd, 0x30, 0x42, 0xb3, 0xb9, 0x74,
+	0x94, 0x07, 0xc2, 0xdc, 0x00, 0xf3, 0xe3, 0xa1, 0xd4, 0xae, 0x13, 0xe3, 0x36, 0x34, 0xc3, 0x08,
+	0xdd, 0xd5, 0x41, 0x54, 0xf6, 0x85, 0xd9, 0x02, 0x6b, 0xe3, 0xeb, 0xb7, 0x30, 0xc3, 0x2e, 0x25,
+	0x3b, 0x96, 0xc3, 0xe8, 0x73, 0xed, 0x15, 0x80, 0xcb, 0x61, 0x84, 0x2a, 0x9a, 0xb0, 0xc5, 0x03,
+	0x4e, 0x45, 0xcf, 0xf3, 0x03, 0xf5, 0x26, 0x31, 0xeb, 0x39, 0xf8, 0x3a, 0xef, 0x7e, 0x39, 0xf6,
+	0x13, 0xb8, 0x1a, 0x46, 0x68, 0xf9, 0x7a, 0x0b, 0x4a, 0xd0, 0xbf, 0x81, 0x6a, 0x71, 0xbb, 0x34,
+	0x09, 0xeb, 0xda, 0x74, 0x6f, 0xc4, 0x24, 0x94, 0xa5, 0xe0, 0x77, 0xe0, 0x5a, 0x18, 0xa1, 0x95,
+	0x4c, 0xcf, 0x34, 0x45, 0xf0, 0x4a, 0xaa, 0xe3, 0x18, 0x2b, 0x54, 0x50, 0xaa, 0xbc, 0x1c, 0xfe,
+	0x69, 0x56, 0xf9, 0x7b, 0xa3, 0xaf, 0x94, 0x8f, 0x37, 0x92, 0x46, 0xdf, 0xd5, 0xe8, 0x4b, 0xdd,
+	0xf9, 0xbe, 0x4a, 0x99, 0xf2, 0x64, 0xc2, 0x19, 0xb2, 0x6d, 0x9a, 0x88, 0x6f, 0x10, 0xc7, 0xc1,
+	0x3b, 0xc4, 0x31, 0x66, 0xe0, 0xd3, 0x30, 0x42, 0x6b, 0xd9, 0x43, 0x24, 0xa5, 0xba, 0x52, 0xde,
+	0x10, 0xbe, 0xcf, 0x7b, 0x42, 0xe3, 0xdb, 0xe0, 0x49, 0xde, 0x94, 0x32, 0xdd, 0x86, 0x3e, 0xa3,
+	0x1d, 0x59, 0xa2, 0xba, 0x0b, 0x9e, 0xbe, 0xc3, 0x90, 0xb8, 0xd3, 0x35, 0xf7, 0x33, 0xf8, 0x38,
+	0x8c, 0xd0, 0x52, 0xa1, 0x1f, 0x71, 0xe3, 0x27, 0x60, 0x07, 0xac, 0x97, 0xea, 0xcd, 0x62, 0x4d,
+	0xf8, 0x28, 0x8c, 0xd0, 0xc3, 0x02, 0xb9, 0x19, 0xe8, 0x01, 0xf8, 0xb6, 0x70, 0xa2, 0x14, 0x39,
+	0xac, 0xe1, 0xb3, 0x70, 0x3d, 0x8c, 0xd0, 0x6a, 0x7a, 0xb6, 0x94, 0x18, 0xdd, 0x29, 0xda, 0xc7,
+	0x76, 0xf3, 0x17, 0x6c, 0xd5, 0xf5, 0x0c, 0xd6, 0xf0, 0xb9, 0x31, 0x43, 0x06, 0xbf, 0x73, 0xaf,
+	0x9f, 0xcc, 0xe4, 0x84, 0xbb, 0x0d, 0x56, 0xc7, 0xb9, 0x57, 0x7f, 0xe2, 0xc1, 0xa0, 0x91, 0xf7,
+	0x20, 0x0c, 0x23, 0x34, 0xaf, 0x91, 0xf1, 0x84, 0x48, 0x38, 0xbb, 0x60, 0x25, 0xef, 0x41, 0x21,
+	0x65, 0x1e, 0x56, 0xc2, 0x08, 0xc1, 0xeb, 0xaa, 0x0b, 0x48, 0x2f, 0xc1, 0xf7, 0xe3, 0x8a, 0x46,
+	0xbb, 0xe3, 0xb8, 0xdd, 0x5d, 0x8b, 0x11, 0x77, 0xb3, 0x8e, 0x6b, 0x7b, 0xd7, 0xb3, 0x32, 0x21,
+	0xdf, 0xcf, 0xb7, 0xee, 0x68, 0xa3, 0xfc, 0xee, 0x91, 0x17, 0x88, 0xcd, 0x3e, 0x3f, 0x3c, 0xbe,
+	0x9c, 0xa1, 0x65, 0xbb, 0xf6, 0x1e, 0x59, 0x16, 0xb2, 0xbb, 0xf6, 0x8e, 0x1c, 0xbf, 0x4e, 0x1a,
+	0x49, 0x23, 0x53, 0x46, 0x39, 0xbb, 0xc4, 0xda, 0xd9, 0x65, 0x3a, 0xc1, 0xe7, 0xf0, 0xcb, 0x30,
+	0x42, 0x8f, 0x32, 0x27, 0x30, 0xf6, 0x27, 0xce, 0xd6, 0x15, 0x5e, 0xef, 0x28, 0x48, 0xe8, 0xcf,
+	0x8a, 0x27, 0xd2, 0x64, 0x36, 0xd4, 0x5d, 0xd1, 0x91, 0x65, 0xe4, 0xdc, 0x8d, 0x8d, 0x9b, 0x35,
+	0x52, 0xd7, 0x98, 0x2f, 0x52, 0x37, 0x36, 0x1f, 0x1c, 0x8a, 0x7e, 0x79, 0x97, 0xb6, 0x30, 0xc5,
+	0x0d, 0xc2, 0x08, 0x1d, 0x33, 0xf3, 0x41, 0xbe, 0x4b, 0x5b, 0x5c, 0xf1, 0x13, 0x11, 0x08, 0x95,
+	0xf3, 0x91, 0x82, 0xaf, 0xf2, 0x95, 0x4e, 0xa6, 0x2e, 0x66, 0x4f, 0xed, 0x24, 0x66, 0xf6, 0x6e,
+	0xbb, 0xd2, 0xda, 0x68, 0xd7, 0x99, 0xe5, 0xd6, 0xec, 0x26, 0x23, 0xcd, 0x94, 0x71, 0x15, 0x7d,
+	0xb7, 0x5d, 0x0a, 0x6d, 0x9c, 0xf6, 0x03, 0xaf, 0x26, 0x07, 0x81, 0x18, 0x04, 0xe5, 0xd5, 0x3b,
+	0xf6, 0x36, 0xeb, 0x62, 0x1a, 0xcf, 0x99, 0x1d, 0x8a, 0xb7, 0x52, 0x3a, 0x1f, 0xe6, 0xab, 0x77,
+	0xe4, 0xcb, 0xe0, 0x15, 0x57, 0xa2, 0x3d, 0xec, 0x29, 0xfe, 0xa2, 0xb4, 0xfa, 0xc9, 0x54, 0x94,
+	0xad, 0x7e, 0x12, 0xb3, 0x50, 0x2b, 0x25, 0x0d, 0xbb, 0x43, 0xf4, 0xdb, 0x4c, 0x53, 0x97, 0xf2,
+	0x5a, 0xa9, 0x38, 0x91, 0x67, 0x22, 0x79, 0xab, 0x95, 0x69, 0x9d, 0x4c, 0x5d, 0xce, 0x6a, 0x9d,
+	0xc0, 0x84, 0x37, 0xfe, 0xf8, 0xab, 0x32, 0xb5, 0xf9, 0xf3, 0xdf, 0xe7, 0x95, 0xe9, 0xb7, 0xe7,
+	0x95, 0xe9, 0x7f, 0xcf, 0x2b, 0xd3, 0x7f, 0x5e, 0x54, 0xa6, 0xde, 0x5e, 0x54, 0xa6, 0xfe, 0xb9,
+	0xa8, 0x4c, 0xed, 0x3f, 0xee, 0x79, 0xc1, 0xd1, 0xe9, 0xc1, 0xc6, 0xa1, 0x3c, 0xa9, 0xee, 0x79,
+	0x8a, 0xd7, 0xa4, 0x12, 0x55, 0x5f, 0x1c, 0x73, 0xaf, 0xfa, 0xba, 0xda, 0x93, 0x67, 0xd5, 0xe0,
+	0xcd, 0x50, 0xf8, 0x07, 0x37, 0x47, 0xcf, 0xe5, 0xef, 0xfe, 0x1f, 0x00, 0xdd, 0xe3, 0x70, 0x95,
+	0x62, 0x0b, 0x00, 0x00,
 }
@@ -9,15 +9,22 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 // constants
 const (
-	AssignPermissionProposalType   = "AssignPermission"
-	SetNetworkPropertyProposalType = "SetNetworkProperty"
-	UpsertDataRegistryProposalType = "UpsertDataRegistry"
-	SetPoorNetworkMsgsProposalType = "SetPoorNetworkMsgs"
-	CreateRoleProposalType         = "CreateRoleProposal"
+	AssignPermissionProposalType      = "AssignPermission"
+	SetNetworkPropertyProposalType    = "SetNetworkProperty"
+	UpsertDataRegistryProposalType    = "UpsertDataRegistry"
+	SetPoorNetworkMsgsProposalType    = "SetPoorNetworkMsgs"
+	CreateRoleProposalType            = "CreateRoleProposal"
+	SetRoleVoteWeightProposalType     = "SetRoleVoteWeightProposal"
+	ParameterChangeProposalType       = "ParameterChange"
+	MultiContentProposalType          = "MultiContent"
+	SoftwareUpgradeProposalType       = "SoftwareUpgrade"
+	CancelSoftwareUpgradeProposalType = "CancelSoftwareUpgrade"
+	RemoveCouncilorProposalType       = "RemoveCouncilor"
 )
 
 var _ Content = &AssignPermissionProposal{}
@@ -47,6 +54,7 @@ func NewProposal(
 		EnactmentEndTime: enactmentEndTime,
 		Content:          any,
 		Result:           Pending,
+		WeightedTally:    NewWeightedTally(),
 	}, nil
 }
 
@@ -102,10 +110,30 @@ func (m *SetNetworkPropertyProposal) VotePermission() PermValue {
 	return PermVoteSetNetworkPropertyProposal
 }
 
+func (m *SetNetworkPropertyProposal) CreatePermission() PermValue {
+	return PermCreateSetNetworkPropertyProposal
+}
+
+func (m *SetNetworkPropertyProposal) ValidateBasic() error {
+	return ValidateNetworkPropertyValue(m.NetworkProperty, m.Value)
+}
+
 func (m *AssignPermissionProposal) VotePermission() PermValue {
 	return PermVoteSetPermissionProposal
 }
 
+func (m *AssignPermissionProposal) CreatePermission() PermValue {
+	return PermCreateSetPermissionsProposal
+}
+
+func (m *AssignPermissionProposal) ValidateBasic() error {
+	if m.Address.Empty() {
+		return ErrEmptyPermissionsAccAddress
+	}
+
+	return nil
+}
+
 func NewUpsertDataRegistryProposal(key, hash, reference, encoding string, size uint64) Content {
 	return &UpsertDataRegistryProposal{
 		Key:       key,
@@ -124,6 +152,14 @@ func (m *UpsertDataRegistryProposal) VotePermission() PermValue {
 	return PermVoteUpsertDataRegistryProposal
 }
 
+func (m *UpsertDataRegistryProposal) CreatePermission() PermValue {
+	return PermUpsertDataRegistryProposal
+}
+
+func (m *UpsertDataRegistryProposal) ValidateBasic() error {
+	return nil
+}
+
 func NewSetPoorNetworkMessagesProposal(msgs []string) Content {
 	return &SetPoorNetworkMessagesProposal{
 		Messages: msgs,
@@ -138,9 +174,19 @@ func (m *SetPoorNetworkMessagesProposal) VotePermission() PermValue {
 	return PermVoteSetPoorNetworkMessagesProposal
 }
 
-func NewCreateRoleProposal(role Role, whitelist []PermValue, blacklist []PermValue) Content {
+func (m *SetPoorNetworkMessagesProposal) CreatePermission() PermValue {
+	return PermCreateSetNetworkPropertyProposal
+}
+
+func (m *SetPoorNetworkMessagesProposal) ValidateBasic() error {
+	return nil
+}
+
+func NewCreateRoleProposal(role Role, sid string, description string, whitelist []PermValue, blacklist []PermValue) Content {
 	return &CreateRoleProposal{
 		Role:                   uint32(role),
+		Sid:                    sid,
+		Description:            description,
 		WhitelistedPermissions: whitelist,
 		BlacklistedPermissions: blacklist,
 	}
@@ -153,3 +199,242 @@ func (m *CreateRoleProposal) ProposalType() string {
 func (m *CreateRoleProposal) VotePermission() PermValue {
 	return PermVoteCreateRoleProposal
 }
+
+func (m *CreateRoleProposal) CreatePermission() PermValue {
+	return PermCreateRoleProposal
+}
+
+func (m *CreateRoleProposal) ValidateBasic() error {
+	if len(m.WhitelistedPermissions) == 0 && len(m.BlacklistedPermissions) == 0 {
+		return ErrEmptyPermissions
+	}
+
+	return ValidateRoleSid(m.Sid)
+}
+
+func NewSetRoleVoteWeightProposal(role Role, weight types.Dec) Content {
+	return &SetRoleVoteWeightProposal{
+		Role:   uint32(role),
+		Weight: weight,
+	}
+}
+
+func (m *SetRoleVoteWeightProposal) ProposalType() string {
+	return SetRoleVoteWeightProposalType
+}
+
+func (m *SetRoleVoteWeightProposal) VotePermission() PermValue {
+	return PermVoteSetRoleVoteWeightProposal
+}
+
+func (m *SetRoleVoteWeightProposal) CreatePermission() PermValue {
+	return PermCreateSetRoleVoteWeightProposal
+}
+
+func (m *SetRoleVoteWeightProposal) ValidateBasic() error {
+	if m.Weight.IsNil() || m.Weight.IsNegative() {
+		return ErrInvalidVoteWeight
+	}
+
+	return nil
+}
+
+func NewParameterChangeProposal(changes []ParamChange) Content {
+	return &ParameterChangeProposal{
+		Changes: changes,
+	}
+}
+
+func (m *ParameterChangeProposal) ProposalType() string {
+	return ParameterChangeProposalType
+}
+
+func (m *ParameterChangeProposal) VotePermission() PermValue {
+	return PermVoteParameterChangeProposal
+}
+
+func (m *ParameterChangeProposal) CreatePermission() PermValue {
+	return PermCreateParameterChangeProposal
+}
+
+func (m *ParameterChangeProposal) ValidateBasic() error {
+	return ValidateParamChanges(m.Changes)
+}
+
+// NewMultiContentProposal creates a proposal batching several contents
+func NewMultiContentProposal(contents []Content) (Content, error) {
+	anys, err := packContents(contents)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MultiContentProposal{
+		Contents: anys,
+	}, nil
+}
+
+func (m *MultiContentProposal) ProposalType() string {
+	return MultiContentProposalType
+}
+
+// VotePermission returns the vote permission of the first content, VotePermissions returns all of them.
+func (m *MultiContentProposal) VotePermission() PermValue {
+	contents := m.UnpackedContents()
+	if len(contents) == 0 {
+		return PermZero
+	}
+
+	return contents[0].VotePermission()
+}
+
+func (m *MultiContentProposal) CreatePermission() PermValue {
+	return PermCreateMultiContentProposal
+}
+
+func (m *MultiContentProposal) ValidateBasic() error {
+	if len(m.Contents) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMultiContent, "no content")
+	}
+
+	contents := m.UnpackedContents()
+	if len(contents) != len(m.Contents) {
+		return sdkerrors.Wrap(ErrInvalidMultiContent, "invalid content")
+	}
+
+	return validateContents(contents)
+}
+
+// UnpackedContents returns the batched contents
+func (m *MultiContentProposal) UnpackedContents() []Content {
+	return unpackedContents(m.Contents)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *MultiContentProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackContents(unpacker, m.Contents)
+}
+
+// validateContents checks the contents batched by a multi content proposal, which can not be nested.
+func validateContents(contents []Content) error {
+	for _, content := range contents {
+		if _, ok := content.(*MultiContentProposal); ok {
+			return sdkerrors.Wrap(ErrInvalidMultiContent, "multi content proposals can not be nested")
+		}
+
+		if err := content.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func packContents(contents []Content) ([]*codectypes.Any, error) {
+	anys := make([]*codectypes.Any, len(contents))
+	for i, content := range contents {
+		msg, ok := content.(proto.Message)
+		if !ok {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("%T does not implement proto.Message", content))
+		}
+
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+
+	return anys, nil
+}
+
+func unpackedContents(anys []*codectypes.Any) []Content {
+	contents := make([]Content, 0, len(anys))
+	for _, any := range anys {
+		content, ok := any.GetCachedValue().(Content)
+		if ok {
+			contents = append(contents, content)
+		}
+	}
+
+	return contents
+}
+
+func unpackContents(unpacker codectypes.AnyUnpacker, anys []*codectypes.Any) error {
+	for _, any := range anys {
+		var content Content
+		err := unpacker.UnpackAny(any, &content)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewSoftwareUpgradeProposal(plan upgradetypes.Plan) Content {
+	return &SoftwareUpgradeProposal{
+		Plan: plan,
+	}
+}
+
+func (m *SoftwareUpgradeProposal) ProposalType() string {
+	return SoftwareUpgradeProposalType
+}
+
+func (m *SoftwareUpgradeProposal) VotePermission() PermValue {
+	return PermVoteSoftwareUpgradeProposal
+}
+
+func (m *SoftwareUpgradeProposal) CreatePermission() PermValue {
+	return PermCreateSoftwareUpgradeProposal
+}
+
+func (m *SoftwareUpgradeProposal) ValidateBasic() error {
+	return ValidateUpgradePlan(m.Plan)
+}
+
+func NewCancelSoftwareUpgradeProposal() Content {
+	return &CancelSoftwareUpgradeProposal{}
+}
+
+func (m *CancelSoftwareUpgradeProposal) ProposalType() string {
+	return CancelSoftwareUpgradeProposalType
+}
+
+func (m *CancelSoftwareUpgradeProposal) VotePermission() PermValue {
+	return PermVoteSoftwareUpgradeProposal
+}
+
+func (m *CancelSoftwareUpgradeProposal) CreatePermission() PermValue {
+	return PermCreateSoftwareUpgradeProposal
+}
+
+func (m *CancelSoftwareUpgradeProposal) ValidateBasic() error {
+	return nil
+}
+
+func NewRemoveCouncilorProposal(address types.AccAddress) Content {
+	return &RemoveCouncilorProposal{
+		Address: address,
+	}
+}
+
+func (m *RemoveCouncilorProposal) ProposalType() string {
+	return RemoveCouncilorProposalType
+}
+
+func (m *RemoveCouncilorProposal) VotePermission() PermValue {
+	return PermVoteRemoveCouncilorProposal
+}
+
+func (m *RemoveCouncilorProposal) CreatePermission() PermValue {
+	return PermCreateRemoveCouncilorProposal
+}
+
+func (m *RemoveCouncilorProposal) ValidateBasic() error {
+	if m.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty councilor address")
+	}
+
+	return nil
+}
@@ -8,6 +8,8 @@ import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	types2 "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -81,6 +83,8 @@ const (
 	Pending          VoteResult = 4
 	QuorumNotReached VoteResult = 5
 	Enactment        VoteResult = 6
+	Cancelled        VoteResult = 7
+	EnactmentFailed  VoteResult = 8
 )
 
 var VoteResult_name = map[int32]string{
@@ -91,6 +95,8 @@ var VoteResult_name = map[int32]string{
 	4: "VOTE_PENDING",
 	5: "VOTE_RESULT_QUORUM_NOT_REACHED",
 	6: "VOTE_RESULT_ENACTMENT",
+	7: "VOTE_RESULT_CANCELLED",
+	8: "VOTE_RESULT_ENACTMENT_FAILED",
 }
 
 var VoteResult_value = map[string]int32{
@@ -101,6 +107,8 @@ var VoteResult_value = map[string]int32{
 	"VOTE_PENDING":                   4,
 	"VOTE_RESULT_QUORUM_NOT_REACHED": 5,
 	"VOTE_RESULT_ENACTMENT":          6,
+	"VOTE_RESULT_CANCELLED":          7,
+	"VOTE_RESULT_ENACTMENT_FAILED":   8,
 }
 
 func (x VoteResult) String() string {
@@ -111,10 +119,59 @@ func (VoteResult) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_c3ac5ce23bf32d05, []int{1}
 }
 
+// ParamValueType enumerates the types of the values a parameter change can set.
+type ParamValueType int32
+
+const (
+	// PARAM_VALUE_TYPE_UNSPECIFIED defines a no-op value type.
+	ParamValueTypeUnspecified ParamValueType = 0
+	// PARAM_VALUE_TYPE_BOOL defines a boolean value, true or false.
+	ParamValueTypeBool ParamValueType = 1
+	// PARAM_VALUE_TYPE_UINT defines an unsigned integer value.
+	ParamValueTypeUint ParamValueType = 2
+	// PARAM_VALUE_TYPE_DEC defines a decimal value.
+	ParamValueTypeDec ParamValueType = 3
+	// PARAM_VALUE_TYPE_DURATION defines a duration value, like 10m or 1h30m.
+	ParamValueTypeDuration ParamValueType = 4
+	// PARAM_VALUE_TYPE_STRING defines a string value.
+	ParamValueTypeString ParamValueType = 5
+)
+
+var ParamValueType_name = map[int32]string{
+	0: "PARAM_VALUE_TYPE_UNSPECIFIED",
+	1: "PARAM_VALUE_TYPE_BOOL",
+	2: "PARAM_VALUE_TYPE_UINT",
+	3: "PARAM_VALUE_TYPE_DEC",
+	4: "PARAM_VALUE_TYPE_DURATION",
+	5: "PARAM_VALUE_TYPE_STRING",
+}
+
+var ParamValueType_value = map[string]int32{
+	"PARAM_VALUE_TYPE_UNSPECIFIED": 0,
+	"PARAM_VALUE_TYPE_BOOL":        1,
+	"PARAM_VALUE_TYPE_UINT":        2,
+	"PARAM_VALUE_TYPE_DEC":         3,
+	"PARAM_VALUE_TYPE_DURATION":    4,
+	"PARAM_VALUE_TYPE_STRING":      5,
+}
+
+func (x ParamValueType) String() string {
+	return proto.EnumName(ParamValueType_name, int32(x))
+}
+
+func (ParamValueType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c3ac5ce23bf32d05, []int{2}
+}
+
 type Vote struct {
 	ProposalId uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 	Voter      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
-	Option     VoteOption                                    `protobuf:"varint,3,opt,name=option,proto3,enum=kira.gov.VoteOption" json:"option,omitempty"`
+	// option is the option with the highest weight of the vote
+	Option  VoteOption           `protobuf:"varint,3,opt,name=option,proto3,enum=kira.gov.VoteOption" json:"option,omitempty"`
+	Options []WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options"`
+	// reason is an optional short justification of the vote
+	Reason     string    `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	SubmitTime time.Time `protobuf:"bytes,6,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time" yaml:"submit_time"`
 }
 
 func (m *Vote) Reset()         { *m = Vote{} }
@@ -171,17 +228,87 @@ func (m *Vote) GetOption() VoteOption {
 	return OptionEmpty
 }
 
+func (m *Vote) GetOptions() []WeightedVoteOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *Vote) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Vote) GetSubmitTime() time.Time {
+	if m != nil {
+		return m.SubmitTime
+	}
+	return time.Time{}
+}
+
+// WeightedVoteOption is the share of a vote cast on an option, the weights of a vote add up to 1.
+type WeightedVoteOption struct {
+	Option VoteOption                             `protobuf:"varint,1,opt,name=option,proto3,enum=kira.gov.VoteOption" json:"option,omitempty"`
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *WeightedVoteOption) Reset()         { *m = WeightedVoteOption{} }
+func (m *WeightedVoteOption) String() string { return proto.CompactTextString(m) }
+func (*WeightedVoteOption) ProtoMessage()    {}
+func (*WeightedVoteOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ac5ce23bf32d05, []int{1}
+}
+func (m *WeightedVoteOption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedVoteOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedVoteOption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedVoteOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedVoteOption.Merge(m, src)
+}
+func (m *WeightedVoteOption) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedVoteOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedVoteOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedVoteOption proto.InternalMessageInfo
+
+func (m *WeightedVoteOption) GetOption() VoteOption {
+	if m != nil {
+		return m.Option
+	}
+	return OptionEmpty
+}
+
 type MsgVoteProposal struct {
 	ProposalId uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 	Voter      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
 	Option     VoteOption                                    `protobuf:"varint,3,opt,name=option,proto3,enum=kira.gov.VoteOption" json:"option,omitempty"`
+	// options splits the vote across several options, option is ignored when it is set
+	Options []WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options"`
+	Reason  string               `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *MsgVoteProposal) Reset()         { *m = MsgVoteProposal{} }
 func (m *MsgVoteProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteProposal) ProtoMessage()    {}
 func (*MsgVoteProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3ac5ce23bf32d05, []int{1}
+	return fileDescriptor_c3ac5ce23bf32d05, []int{2}
 }
 func (m *MsgVoteProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -231,6 +358,74 @@ func (m *MsgVoteProposal) GetOption() VoteOption {
 	return OptionEmpty
 }
 
+func (m *MsgVoteProposal) GetOptions() []WeightedVoteOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *MsgVoteProposal) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgCancelProposal cancels a proposal, the proposer can cancel it while in voting
+// and councilors holding the cancel permission while in enactment.
+type MsgCancelProposal struct {
+	ProposalId uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Sender     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+}
+
+func (m *MsgCancelProposal) Reset()         { *m = MsgCancelProposal{} }
+func (m *MsgCancelProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelProposal) ProtoMessage()    {}
+func (*MsgCancelProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ac5ce23bf32d05, []int{3}
+}
+func (m *MsgCancelProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelProposal.Merge(m, src)
+}
+func (m *MsgCancelProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelProposal proto.InternalMessageInfo
+
+func (m *MsgCancelProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgCancelProposal) GetSender() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Sender
+	}
+	return nil
+}
+
 type MsgProposalAssignPermission struct {
 	Proposer   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty"`
 	Address    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty" yaml:"address"`
@@ -241,7 +436,7 @@ func (m *MsgProposalAssignPermission) Reset()         { *m = MsgProposalAssignPe
 func (m *MsgProposalAssignPermission) String() string { return proto.CompactTextString(m) }
 func (*MsgProposalAssignPermission) ProtoMessage()    {}
 func (*MsgProposalAssignPermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3ac5ce23bf32d05, []int{2}
+	return fileDescriptor_c3ac5ce23bf32d05, []int{4}
 }
 func (m *MsgProposalAssignPermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -304,7 +499,7 @@ func (m *MsgProposalUpsertDataRegistry) Reset()         { *m = MsgProposalUpsert
 func (m *MsgProposalUpsertDataRegistry) String() string { return proto.CompactTextString(m) }
 func (*MsgProposalUpsertDataRegistry) ProtoMessage()    {}
 func (*MsgProposalUpsertDataRegistry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3ac5ce23bf32d05, []int{3}
+	return fileDescriptor_c3ac5ce23bf32d05, []int{5}
 }
 func (m *MsgProposalUpsertDataRegistry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,7 +579,7 @@ func (m *MsgProposalSetPoorNetworkMessages) Reset()         { *m = MsgProposalSe
 func (m *MsgProposalSetPoorNetworkMessages) String() string { return proto.CompactTextString(m) }
 func (*MsgProposalSetPoorNetworkMessages) ProtoMessage()    {}
 func (*MsgProposalSetPoorNetworkMessages) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3ac5ce23bf32d05, []int{4}
+	return fileDescriptor_c3ac5ce23bf32d05, []int{6}
 }
 func (m *MsgProposalSetPoorNetworkMessages) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -428,19 +623,22 @@ func (m *MsgProposalSetPoorNetworkMessages) GetMessages() []string {
 }
 
 type Proposal struct {
-	ProposalId       uint64     `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	Content          *types.Any `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	SubmitTime       time.Time  `protobuf:"bytes,3,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time" yaml:"submit_time"`
-	VotingEndTime    time.Time  `protobuf:"bytes,5,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time" yaml:"voting_end_time"`
-	EnactmentEndTime time.Time  `protobuf:"bytes,6,opt,name=enactment_end_time,json=enactmentEndTime,proto3,stdtime" json:"enactment_end_time" yaml:"voting_end_time"`
-	Result           VoteResult `protobuf:"varint,8,opt,name=result,proto3,enum=kira.gov.VoteResult" json:"result,omitempty"`
+	ProposalId       uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Content          *types.Any                                    `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	SubmitTime       time.Time                                     `protobuf:"bytes,3,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time" yaml:"submit_time"`
+	VotingEndTime    time.Time                                     `protobuf:"bytes,5,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time" yaml:"voting_end_time"`
+	EnactmentEndTime time.Time                                     `protobuf:"bytes,6,opt,name=enactment_end_time,json=enactmentEndTime,proto3,stdtime" json:"enactment_end_time" yaml:"voting_end_time"`
+	Result           VoteResult                                    `protobuf:"varint,8,opt,name=result,proto3,enum=kira.gov.VoteResult" json:"result,omitempty"`
+	WeightedTally    WeightedTally                                 `protobuf:"bytes,9,opt,name=weighted_tally,json=weightedTally,proto3" json:"weighted_tally" yaml:"weighted_tally"`
+	TallyResult      TallyResult                                   `protobuf:"bytes,10,opt,name=tally_result,json=tallyResult,proto3" json:"tally_result" yaml:"tally_result"`
+	Proposer         github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,11,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty" yaml:"proposer"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3ac5ce23bf32d05, []int{5}
+	return fileDescriptor_c3ac5ce23bf32d05, []int{7}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -469,6 +667,195 @@ func (m *Proposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Proposal proto.InternalMessageInfo
 
+// Deposit is the amount escrowed from the proposer when the proposal was submitted,
+// it is refunded once the proposal reaches quorum and burned when it is vetoed or the quorum is not reached.
+type Deposit struct {
+	ProposalId uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Depositor  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=depositor,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"depositor,omitempty" yaml:"depositor"`
+	Amount     github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *Deposit) Reset()         { *m = Deposit{} }
+func (m *Deposit) String() string { return proto.CompactTextString(m) }
+func (*Deposit) ProtoMessage()    {}
+func (*Deposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ac5ce23bf32d05, []int{8}
+}
+func (m *Deposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Deposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Deposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Deposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Deposit.Merge(m, src)
+}
+func (m *Deposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *Deposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_Deposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Deposit proto.InternalMessageInfo
+
+func (m *Deposit) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *Deposit) GetDepositor() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Depositor
+	}
+	return nil
+}
+
+func (m *Deposit) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// TallyResult holds the number of votes cast on each option of a proposal together with
+// the number of actors allowed to vote and the quorum applied when the voting ended.
+type TallyResult struct {
+	Yes         uint64 `protobuf:"varint,1,opt,name=yes,proto3" json:"yes,omitempty"`
+	Abstain     uint64 `protobuf:"varint,2,opt,name=abstain,proto3" json:"abstain,omitempty"`
+	No          uint64 `protobuf:"varint,3,opt,name=no,proto3" json:"no,omitempty"`
+	NoWithVeto  uint64 `protobuf:"varint,4,opt,name=no_with_veto,json=noWithVeto,proto3" json:"no_with_veto,omitempty" yaml:"no_with_veto"`
+	TotalVoters uint64 `protobuf:"varint,5,opt,name=total_voters,json=totalVoters,proto3" json:"total_voters,omitempty" yaml:"total_voters"`
+	Quorum      uint64 `protobuf:"varint,6,opt,name=quorum,proto3" json:"quorum,omitempty"`
+}
+
+func (m *TallyResult) Reset()         { *m = TallyResult{} }
+func (m *TallyResult) String() string { return proto.CompactTextString(m) }
+func (*TallyResult) ProtoMessage()    {}
+func (*TallyResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ac5ce23bf32d05, []int{9}
+}
+func (m *TallyResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallyResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallyResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TallyResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallyResult.Merge(m, src)
+}
+func (m *TallyResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallyResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallyResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TallyResult proto.InternalMessageInfo
+
+func (m *TallyResult) GetYes() uint64 {
+	if m != nil {
+		return m.Yes
+	}
+	return 0
+}
+
+func (m *TallyResult) GetAbstain() uint64 {
+	if m != nil {
+		return m.Abstain
+	}
+	return 0
+}
+
+func (m *TallyResult) GetNo() uint64 {
+	if m != nil {
+		return m.No
+	}
+	return 0
+}
+
+func (m *TallyResult) GetNoWithVeto() uint64 {
+	if m != nil {
+		return m.NoWithVeto
+	}
+	return 0
+}
+
+func (m *TallyResult) GetTotalVoters() uint64 {
+	if m != nil {
+		return m.TotalVoters
+	}
+	return 0
+}
+
+func (m *TallyResult) GetQuorum() uint64 {
+	if m != nil {
+		return m.Quorum
+	}
+	return 0
+}
+
+// WeightedTally holds the total weight of the votes cast on each option of a proposal.
+type WeightedTally struct {
+	Yes        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=yes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"yes"`
+	Abstain    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=abstain,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"abstain"`
+	No         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=no,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"no"`
+	NoWithVeto github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=no_with_veto,json=noWithVeto,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"no_with_veto" yaml:"no_with_veto"`
+}
+
+func (m *WeightedTally) Reset()         { *m = WeightedTally{} }
+func (m *WeightedTally) String() string { return proto.CompactTextString(m) }
+func (*WeightedTally) ProtoMessage()    {}
+func (*WeightedTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ac5ce23bf32d05, []int{10}
+}
+func (m *WeightedTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedTally.Merge(m, src)
+}
+func (m *WeightedTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedTally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedTally proto.InternalMessageInfo
+
 type AssignPermissionProposal struct {
 	Address    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty" yaml:"address"`
 	Permission uint32                                        `protobuf:"varint,2,opt,name=permission,proto3" json:"permission,omitempty"`
@@ -478,7 +865,7 @@ func (m *AssignPermissionProposal) Reset()         { *m = AssignPermissionPropos
 func (m *AssignPermissionProposal) String() string { return proto.CompactTextString(m) }
 func (*AssignPermissionProposal) ProtoMessage()    {}
 func (*AssignPermissionProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3ac5ce23bf32d05, []int{6}
+	return fileDescriptor_c3ac5ce23bf32d05, []int{11}
 }
 func (m *AssignPermissionProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -531,7 +918,7 @@ func (m *MsgProposalSetNetworkProperty) Reset()         { *m = MsgProposalSetNet
 func (m *MsgProposalSetNetworkProperty) String() string { return proto.CompactTextString(m) }
 func (*MsgProposalSetNetworkProperty) ProtoMessage()    {}
 func (*MsgProposalSetNetworkProperty) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3ac5ce23bf32d05, []int{7}
+	return fileDescriptor_c3ac5ce23bf32d05, []int{12}
 }
 func (m *MsgProposalSetNetworkProperty) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -590,7 +977,7 @@ func (m *SetNetworkPropertyProposal) Reset()         { *m = SetNetworkPropertyPr
 func (m *SetNetworkPropertyProposal) String() string { return proto.CompactTextString(m) }
 func (*SetNetworkPropertyProposal) ProtoMessage()    {}
 func (*SetNetworkPropertyProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3ac5ce23bf32d05, []int{8}
+	return fileDescriptor_c3ac5ce23bf32d05, []int{13}
 }
 func (m *SetNetworkPropertyProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -645,7 +1032,7 @@ func (m *UpsertDataRegistryProposal) Reset()         { *m = UpsertDataRegistryPr
 func (m *UpsertDataRegistryProposal) String() string { return proto.CompactTextString(m) }
 func (*UpsertDataRegistryProposal) ProtoMessage()    {}
 func (*UpsertDataRegistryProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3ac5ce23bf32d05, []int{9}
+	return fileDescriptor_c3ac5ce23bf32d05, []int{14}
 }
 func (m *UpsertDataRegistryProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -717,7 +1104,7 @@ func (m *SetPoorNetworkMessagesProposal) Reset()         { *m = SetPoorNetworkMe
 func (m *SetPoorNetworkMessagesProposal) String() string { return proto.CompactTextString(m) }
 func (*SetPoorNetworkMessagesProposal) ProtoMessage()    {}
 func (*SetPoorNetworkMessagesProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3ac5ce23bf32d05, []int{10}
+	return fileDescriptor_c3ac5ce23bf32d05, []int{15}
 }
 func (m *SetPoorNetworkMessagesProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -758,13 +1145,15 @@ type MsgProposalCreateRole struct {
 	Role                   uint32                                        `protobuf:"varint,2,opt,name=role,proto3" json:"role,omitempty"`
 	WhitelistedPermissions []PermValue                                   `protobuf:"varint,3,rep,packed,name=whitelisted_permissions,json=whitelistedPermissions,proto3,enum=kira.gov.PermValue" json:"whitelisted_permissions,omitempty"`
 	BlacklistedPermissions []PermValue                                   `protobuf:"varint,4,rep,packed,name=blacklisted_permissions,json=blacklistedPermissions,proto3,enum=kira.gov.PermValue" json:"blacklisted_permissions,omitempty"`
+	Sid                    string                                        `protobuf:"bytes,5,opt,name=sid,proto3" json:"sid,omitempty"`
+	Description            string                                        `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
 }
 
 func (m *MsgProposalCreateRole) Reset()         { *m = MsgProposalCreateRole{} }
 func (m *MsgProposalCreateRole) String() string { return proto.CompactTextString(m) }
 func (*MsgProposalCreateRole) ProtoMessage()    {}
 func (*MsgProposalCreateRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3ac5ce23bf32d05, []int{11}
+	return fileDescriptor_c3ac5ce23bf32d05, []int{16}
 }
 func (m *MsgProposalCreateRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -821,17 +1210,33 @@ func (m *MsgProposalCreateRole) GetBlacklistedPermissions() []PermValue {
 	return nil
 }
 
+func (m *MsgProposalCreateRole) GetSid() string {
+	if m != nil {
+		return m.Sid
+	}
+	return ""
+}
+
+func (m *MsgProposalCreateRole) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
 type CreateRoleProposal struct {
 	Role                   uint32      `protobuf:"varint,1,opt,name=role,proto3" json:"role,omitempty"`
 	WhitelistedPermissions []PermValue `protobuf:"varint,2,rep,packed,name=whitelisted_permissions,json=whitelistedPermissions,proto3,enum=kira.gov.PermValue" json:"whitelisted_permissions,omitempty"`
 	BlacklistedPermissions []PermValue `protobuf:"varint,3,rep,packed,name=blacklisted_permissions,json=blacklistedPermissions,proto3,enum=kira.gov.PermValue" json:"blacklisted_permissions,omitempty"`
+	Sid                    string      `protobuf:"bytes,4,opt,name=sid,proto3" json:"sid,omitempty"`
+	Description            string      `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
 }
 
 func (m *CreateRoleProposal) Reset()         { *m = CreateRoleProposal{} }
 func (m *CreateRoleProposal) String() string { return proto.CompactTextString(m) }
 func (*CreateRoleProposal) ProtoMessage()    {}
 func (*CreateRoleProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3ac5ce23bf32d05, []int{12}
+	return fileDescriptor_c3ac5ce23bf32d05, []int{17}
 }
 func (m *CreateRoleProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -881,1165 +1286,5598 @@ func (m *CreateRoleProposal) GetBlacklistedPermissions() []PermValue {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("kira.gov.VoteOption", VoteOption_name, VoteOption_value)
-	proto.RegisterEnum("kira.gov.VoteResult", VoteResult_name, VoteResult_value)
-	proto.RegisterType((*Vote)(nil), "kira.gov.Vote")
-	proto.RegisterType((*MsgVoteProposal)(nil), "kira.gov.MsgVoteProposal")
-	proto.RegisterType((*MsgProposalAssignPermission)(nil), "kira.gov.MsgProposalAssignPermission")
-	proto.RegisterType((*MsgProposalUpsertDataRegistry)(nil), "kira.gov.MsgProposalUpsertDataRegistry")
-	proto.RegisterType((*MsgProposalSetPoorNetworkMessages)(nil), "kira.gov.MsgProposalSetPoorNetworkMessages")
-	proto.RegisterType((*Proposal)(nil), "kira.gov.Proposal")
-	proto.RegisterType((*AssignPermissionProposal)(nil), "kira.gov.AssignPermissionProposal")
-	proto.RegisterType((*MsgProposalSetNetworkProperty)(nil), "kira.gov.MsgProposalSetNetworkProperty")
-	proto.RegisterType((*SetNetworkPropertyProposal)(nil), "kira.gov.SetNetworkPropertyProposal")
-	proto.RegisterType((*UpsertDataRegistryProposal)(nil), "kira.gov.UpsertDataRegistryProposal")
-	proto.RegisterType((*SetPoorNetworkMessagesProposal)(nil), "kira.gov.SetPoorNetworkMessagesProposal")
-	proto.RegisterType((*MsgProposalCreateRole)(nil), "kira.gov.MsgProposalCreateRole")
-	proto.RegisterType((*CreateRoleProposal)(nil), "kira.gov.CreateRoleProposal")
+func (m *CreateRoleProposal) GetSid() string {
+	if m != nil {
+		return m.Sid
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("proposal.proto", fileDescriptor_c3ac5ce23bf32d05) }
-
-var fileDescriptor_c3ac5ce23bf32d05 = []byte{
-	// 1271 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0xd8, 0x4e, 0xe2, 0x3c, 0x37, 0xc9, 0x7e, 0xa7, 0x69, 0xeb, 0xee, 0x97, 0xda, 0x66,
-	0x05, 0x28, 0xaa, 0x5a, 0x5b, 0x84, 0x0b, 0x2a, 0x48, 0xc8, 0xb1, 0x97, 0xd6, 0xb4, 0x59, 0xbb,
-	0x6b, 0x3b, 0x55, 0x41, 0xc8, 0xda, 0xd8, 0xd3, 0xcd, 0x62, 0x7b, 0xc7, 0xda, 0x19, 0xa7, 0x98,
-	0xbf, 0xa0, 0xf8, 0x80, 0x7a, 0xe2, 0x66, 0x54, 0xc4, 0x09, 0xce, 0xfc, 0x03, 0xdc, 0xaa, 0x5e,
-	0xa8, 0x90, 0x90, 0x38, 0x05, 0x94, 0x4a, 0x08, 0x89, 0x5b, 0x8f, 0x9c, 0xd0, 0xee, 0xec, 0xda,
-	0x5b, 0x3b, 0x2d, 0x6d, 0x15, 0x24, 0x4e, 0x9e, 0x1f, 0xef, 0xc7, 0xe7, 0xf3, 0xe6, 0xbd, 0xcf,
-	0x1a, 0x56, 0xfb, 0x0e, 0xed, 0x53, 0x66, 0x74, 0x73, 0x7d, 0x87, 0x72, 0x8a, 0x13, 0x1d, 0xcb,
-	0x31, 0x72, 0x26, 0xdd, 0x97, 0xd7, 0x4d, 0x6a, 0x52, 0xef, 0x30, 0xef, 0xae, 0xc4, 0xbd, 0x9c,
-	0x31, 0x29, 0x35, 0xbb, 0x24, 0xef, 0xed, 0x76, 0x07, 0xb7, 0xf2, 0xdc, 0xea, 0x11, 0xc6, 0x8d,
-	0x5e, 0xdf, 0x37, 0x38, 0x3b, 0x6b, 0x60, 0xd8, 0xc3, 0xe0, 0xaa, 0x45, 0x59, 0x8f, 0xb2, 0xa6,
-	0x08, 0x2a, 0x36, 0xfe, 0x15, 0x38, 0xb4, 0x4b, 0xfc, 0xb5, 0xd4, 0x27, 0x4e, 0xcf, 0x62, 0xcc,
-	0xa2, 0xb6, 0x7f, 0x92, 0xb2, 0x09, 0xbf, 0x4d, 0x9d, 0x8e, 0xeb, 0xd9, 0x27, 0x0e, 0xb7, 0x88,
-	0xef, 0xa7, 0x7c, 0x85, 0x20, 0xbe, 0x43, 0x39, 0xc1, 0x19, 0x48, 0x06, 0x4c, 0x9a, 0x56, 0x3b,
-	0x85, 0xb2, 0x68, 0x23, 0xae, 0x43, 0x70, 0x54, 0x6e, 0xe3, 0xcb, 0xb0, 0xb0, 0x4f, 0x39, 0x71,
-	0x52, 0xd1, 0x2c, 0xda, 0x38, 0xb1, 0xf5, 0xe6, 0x5f, 0x07, 0x99, 0x8b, 0xa6, 0xc5, 0xf7, 0x06,
-	0xbb, 0xb9, 0x16, 0xed, 0xf9, 0x68, 0xfc, 0x9f, 0x8b, 0xac, 0xdd, 0xc9, 0xf3, 0x61, 0x9f, 0xb0,
-	0x5c, 0xa1, 0xd5, 0x2a, 0xb4, 0xdb, 0x0e, 0x61, 0x4c, 0x17, 0xfe, 0xf8, 0x02, 0x2c, 0xd2, 0x3e,
-	0xb7, 0xa8, 0x9d, 0x8a, 0x65, 0xd1, 0xc6, 0xea, 0xe6, 0x7a, 0x2e, 0x28, 0x59, 0xce, 0x45, 0x52,
-	0xf1, 0xee, 0x74, 0xdf, 0x46, 0xf9, 0x16, 0xc1, 0xda, 0x36, 0x33, 0xdd, 0x9b, 0xaa, 0x0f, 0xe6,
-	0x3f, 0x8b, 0xf5, 0x4f, 0x04, 0xff, 0xdf, 0x66, 0x66, 0x80, 0xb3, 0xc0, 0x98, 0x65, 0xda, 0xd5,
-	0xc9, 0x63, 0xe0, 0x6d, 0x48, 0x08, 0x90, 0xc4, 0xf1, 0x40, 0xbf, 0x14, 0xb2, 0x49, 0x08, 0xfc,
-	0x31, 0x2c, 0x19, 0xe2, 0xd0, 0xe7, 0x59, 0x7c, 0x7c, 0x90, 0x59, 0x1d, 0x1a, 0xbd, 0xee, 0x25,
-	0xc5, 0xbf, 0x50, 0x5e, 0x3c, 0x7e, 0x10, 0x13, 0xa7, 0x01, 0xa6, 0x8d, 0xe4, 0xf1, 0x5f, 0xd1,
-	0x43, 0x27, 0xca, 0x21, 0x82, 0x73, 0x21, 0xb6, 0x8d, 0x3e, 0x23, 0x0e, 0x2f, 0x19, 0xdc, 0xd0,
-	0x89, 0x69, 0x31, 0xee, 0x0c, 0x8f, 0x9b, 0xaf, 0x04, 0xb1, 0x0e, 0x19, 0x7a, 0x5c, 0x97, 0x75,
-	0x77, 0x89, 0x31, 0xc4, 0xf7, 0x0c, 0xb6, 0xe7, 0x81, 0x5b, 0xd6, 0xbd, 0x35, 0x7e, 0x05, 0x96,
-	0x1d, 0x72, 0x8b, 0x38, 0xc4, 0x6e, 0x91, 0x54, 0xdc, 0xbb, 0x98, 0x1e, 0x60, 0x19, 0x12, 0xc4,
-	0x6e, 0xd1, 0xb6, 0x65, 0x9b, 0xa9, 0x05, 0xef, 0x72, 0xb2, 0x77, 0xa3, 0x31, 0xeb, 0x33, 0x92,
-	0x5a, 0xf4, 0xfa, 0xc9, 0x5b, 0x2b, 0x5f, 0x20, 0x78, 0x35, 0x44, 0xb2, 0x46, 0x78, 0x95, 0x52,
-	0x47, 0x13, 0xe3, 0xb4, 0x4d, 0x18, 0x33, 0x4c, 0xc2, 0x8e, 0x9b, 0xa8, 0x0c, 0x89, 0x9e, 0x1f,
-	0x3a, 0x15, 0xcd, 0xc6, 0x5c, 0x90, 0xc1, 0x5e, 0xf9, 0x31, 0x06, 0x89, 0xe7, 0x1f, 0x84, 0x77,
-	0x60, 0xa9, 0x45, 0x6d, 0x4e, 0x6c, 0xee, 0x95, 0x2d, 0xb9, 0xb9, 0x9e, 0x13, 0xf2, 0x92, 0x0b,
-	0xe4, 0x25, 0x57, 0xb0, 0x87, 0x5b, 0xc9, 0x07, 0xdf, 0x5f, 0x5c, 0x2a, 0x0a, 0x43, 0x3d, 0xf0,
-	0xc0, 0x1f, 0x41, 0x92, 0x0d, 0x76, 0x7b, 0x16, 0x6f, 0xba, 0x1a, 0xe5, 0x15, 0x39, 0xb9, 0x29,
-	0xcf, 0x05, 0xa8, 0x07, 0x02, 0xb6, 0x95, 0xbe, 0x7f, 0x90, 0x89, 0x3c, 0x3e, 0xc8, 0x60, 0xd1,
-	0x83, 0x21, 0x67, 0xe5, 0xee, 0xaf, 0x19, 0xa4, 0x83, 0x38, 0x71, 0x1d, 0xf0, 0x2d, 0x58, 0xdb,
-	0xa7, 0xdc, 0xb2, 0xcd, 0x26, 0xb1, 0xdb, 0x22, 0xc1, 0xc2, 0x3f, 0x26, 0x50, 0xfc, 0x04, 0xa7,
-	0x45, 0x82, 0x99, 0x00, 0x22, 0xc9, 0x8a, 0x38, 0x55, 0xed, 0xb6, 0x97, 0xa7, 0x0b, 0x98, 0xd8,
-	0x46, 0x8b, 0xf7, 0x88, 0xcd, 0xa7, 0xa9, 0x16, 0x8f, 0x25, 0x95, 0x34, 0x89, 0x1c, 0x64, 0xbb,
-	0x00, 0x8b, 0x0e, 0x61, 0x83, 0x2e, 0x4f, 0x25, 0x8e, 0xd2, 0x0b, 0xdd, 0xbb, 0xd3, 0x7d, 0x9b,
-	0x4b, 0xf1, 0x3b, 0xf7, 0x32, 0x11, 0xe5, 0x3b, 0x04, 0xa9, 0x59, 0xa9, 0x98, 0xbc, 0x70, 0x68,
-	0xc6, 0xd1, 0xbf, 0x3e, 0xe3, 0xd1, 0xd9, 0x19, 0xbf, 0xb4, 0xf6, 0xc7, 0xbd, 0x0c, 0xfa, 0x69,
-	0xda, 0x1c, 0xca, 0x83, 0x27, 0x87, 0xbe, 0x46, 0xb8, 0x3f, 0x0b, 0x55, 0xf1, 0x65, 0x39, 0xf6,
-	0xa1, 0x2f, 0x81, 0x34, 0xf3, 0xf1, 0x12, 0x0a, 0xb0, 0xba, 0x79, 0x76, 0x5a, 0xdb, 0x19, 0x0c,
-	0xfa, 0x9a, 0x3d, 0x03, 0x6a, 0x1d, 0x16, 0xf6, 0x8d, 0xee, 0x40, 0x34, 0x71, 0x5c, 0x17, 0x1b,
-	0xe5, 0x73, 0x04, 0xf2, 0x3c, 0x83, 0x49, 0xed, 0x8f, 0x4a, 0x8d, 0x5e, 0x3e, 0x75, 0x34, 0x94,
-	0x7a, 0xbe, 0xb0, 0x5f, 0x23, 0x90, 0xe7, 0x25, 0x74, 0x82, 0xc5, 0xd7, 0x3e, 0x34, 0xaf, 0x7d,
-	0xd1, 0xa7, 0x69, 0x5f, 0xec, 0x59, 0xda, 0x17, 0x7f, 0x8a, 0xf6, 0x2d, 0x4c, 0xb5, 0x6f, 0x1e,
-	0xe3, 0xbb, 0x90, 0x3e, 0x5a, 0x00, 0x27, 0x30, 0xc3, 0xca, 0x85, 0x66, 0x94, 0xeb, 0xcb, 0x28,
-	0x9c, 0x0a, 0xb5, 0x4e, 0xd1, 0x21, 0x06, 0x27, 0x3a, 0xed, 0x92, 0xe3, 0x6e, 0x19, 0x0c, 0x71,
-	0xf7, 0xdf, 0x90, 0xdf, 0xce, 0xde, 0x1a, 0x5f, 0x83, 0x33, 0xb7, 0xf7, 0x2c, 0x4e, 0xba, 0x16,
-	0xe3, 0xa4, 0xdd, 0x9c, 0xb6, 0x38, 0x4b, 0xc5, 0xb2, 0xb1, 0x8d, 0xd5, 0xcd, 0x93, 0xd3, 0x27,
-	0x75, 0xc7, 0x70, 0xc7, 0x7d, 0x25, 0xfd, 0x74, 0xc8, 0x67, 0x3a, 0x9c, 0xcc, 0x8d, 0xb6, 0xdb,
-	0x35, 0x5a, 0x9d, 0x23, 0xa2, 0xc5, 0x9f, 0x11, 0x2d, 0xe4, 0x13, 0x8a, 0xa6, 0xfc, 0x8c, 0x00,
-	0x4f, 0xab, 0x31, 0xa9, 0x65, 0x40, 0x03, 0x3d, 0x1f, 0x8d, 0xe8, 0xb1, 0xd2, 0x88, 0xbd, 0x30,
-	0x8d, 0xb9, 0x76, 0x39, 0xff, 0x3b, 0x02, 0x98, 0xfe, 0x4b, 0xc2, 0x17, 0xe0, 0xcc, 0x4e, 0xa5,
-	0xae, 0x36, 0x2b, 0xd5, 0x7a, 0xb9, 0xa2, 0x35, 0x1b, 0x5a, 0xad, 0xaa, 0x16, 0xcb, 0xef, 0x97,
-	0xd5, 0x92, 0x14, 0x91, 0xd7, 0x46, 0xe3, 0x6c, 0x52, 0x18, 0xaa, 0xbd, 0x3e, 0x1f, 0x62, 0x05,
-	0xd6, 0xc2, 0xd6, 0x37, 0xd5, 0x9a, 0x84, 0xe4, 0x95, 0xd1, 0x38, 0xbb, 0x2c, 0xac, 0x6e, 0x12,
-	0x86, 0xcf, 0xc3, 0xc9, 0xb0, 0x4d, 0x61, 0xab, 0x56, 0x2f, 0x94, 0x35, 0x29, 0x2a, 0xff, 0x6f,
-	0x34, 0xce, 0xae, 0x08, 0xbb, 0xc2, 0x2e, 0xe3, 0x86, 0x65, 0xe3, 0x2c, 0xac, 0x86, 0x6d, 0xb5,
-	0x8a, 0x14, 0x93, 0x4f, 0x8c, 0xc6, 0xd9, 0x84, 0x30, 0xd3, 0x28, 0xde, 0x84, 0xd4, 0x93, 0x16,
-	0xcd, 0x1b, 0xe5, 0xfa, 0x95, 0xe6, 0x8e, 0x5a, 0xaf, 0x48, 0x71, 0x79, 0x7d, 0x34, 0xce, 0x4a,
-	0x81, 0xed, 0x0d, 0x8b, 0xef, 0xed, 0x10, 0x4e, 0xe5, 0xf8, 0x9d, 0x6f, 0xd2, 0x91, 0xf3, 0x3f,
-	0x44, 0x05, 0x51, 0x21, 0xef, 0xf8, 0x35, 0x1f, 0x96, 0xae, 0xd6, 0x1a, 0xd7, 0xea, 0xcd, 0x86,
-	0x76, 0x55, 0xab, 0xdc, 0xd0, 0xa4, 0x88, 0x9c, 0x1c, 0x8d, 0xb3, 0x4b, 0x0d, 0xbb, 0x63, 0xd3,
-	0xdb, 0x36, 0x56, 0x00, 0x87, 0xad, 0xaa, 0x85, 0x5a, 0x4d, 0x2d, 0x49, 0x48, 0x86, 0xd1, 0x38,
-	0xbb, 0x58, 0x35, 0x18, 0x23, 0x6d, 0xfc, 0x06, 0xac, 0x87, 0x6d, 0x74, 0xf5, 0x03, 0xb5, 0x58,
-	0x57, 0x4b, 0x52, 0x54, 0x40, 0xd7, 0xc9, 0x27, 0xa4, 0xc5, 0x49, 0x1b, 0xbf, 0x0d, 0xe9, 0xa3,
-	0xec, 0x42, 0x04, 0x62, 0x82, 0x40, 0xe0, 0x11, 0x10, 0xc0, 0xe7, 0xe0, 0x84, 0xe7, 0x59, 0x55,
-	0xb5, 0x52, 0x59, 0xbb, 0x2c, 0xc5, 0x05, 0xc8, 0x2a, 0xb1, 0x3d, 0x59, 0x98, 0x09, 0x7c, 0xbd,
-	0x51, 0xd1, 0x1b, 0xdb, 0x4d, 0xad, 0xe2, 0xe6, 0x28, 0x14, 0xaf, 0xa8, 0x25, 0x69, 0x41, 0x04,
-	0xbe, 0x3e, 0xa0, 0xce, 0xa0, 0xa7, 0x51, 0xae, 0x13, 0xa3, 0xb5, 0x47, 0xda, 0x78, 0x03, 0x4e,
-	0x85, 0x3d, 0x55, 0xad, 0x50, 0xac, 0x6f, 0xab, 0x5a, 0x5d, 0x5a, 0x14, 0xaf, 0xa8, 0x06, 0x9f,
-	0x4e, 0x51, 0xc3, 0xad, 0xf7, 0xee, 0x1f, 0xa6, 0xd1, 0xc3, 0xc3, 0x34, 0xfa, 0xed, 0x30, 0x8d,
-	0xee, 0x3e, 0x4a, 0x47, 0x1e, 0x3e, 0x4a, 0x47, 0x7e, 0x79, 0x94, 0x8e, 0x7c, 0xf8, 0x7a, 0x48,
-	0x07, 0xae, 0x5a, 0x8e, 0x51, 0xa4, 0x0e, 0xc9, 0x33, 0xd2, 0x31, 0xac, 0xfc, 0xa7, 0x79, 0x93,
-	0xee, 0x0b, 0x29, 0xd8, 0x5d, 0xf4, 0x3e, 0xe2, 0x6f, 0xfd, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xac,
-	0xc3, 0x6c, 0xe5, 0x91, 0x0d, 0x00, 0x00,
+func (m *CreateRoleProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (this *AssignPermissionProposal) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+type MsgProposalSetRoleVoteWeight struct {
+	Proposer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty"`
+	Role     uint32                                        `protobuf:"varint,2,opt,name=role,proto3" json:"role,omitempty"`
+	Weight   github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
 
-	that1, ok := that.(*AssignPermissionProposal)
-	if !ok {
-		that2, ok := that.(AssignPermissionProposal)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *MsgProposalSetRoleVoteWeight) Reset()         { *m = MsgProposalSetRoleVoteWeight{} }
+func (m *MsgProposalSetRoleVoteWeight) String() string { return proto.CompactTextString(m) }
+func (*MsgProposalSetRoleVoteWeight) ProtoMessage()    {}
+func (*MsgProposalSetRoleVoteWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ac5ce23bf32d05, []int{18}
+}
+func (m *MsgProposalSetRoleVoteWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposalSetRoleVoteWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposalSetRoleVoteWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.Address, that1.Address) {
-		return false
-	}
-	if this.Permission != that1.Permission {
-		return false
+}
+func (m *MsgProposalSetRoleVoteWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposalSetRoleVoteWeight.Merge(m, src)
+}
+func (m *MsgProposalSetRoleVoteWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposalSetRoleVoteWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposalSetRoleVoteWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposalSetRoleVoteWeight proto.InternalMessageInfo
+
+func (m *MsgProposalSetRoleVoteWeight) GetProposer() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Proposer
 	}
-	return true
+	return nil
 }
-func (this *SetNetworkPropertyProposal) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MsgProposalSetRoleVoteWeight) GetRole() uint32 {
+	if m != nil {
+		return m.Role
 	}
+	return 0
+}
 
-	that1, ok := that.(*SetNetworkPropertyProposal)
-	if !ok {
-		that2, ok := that.(SetNetworkPropertyProposal)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type SetRoleVoteWeightProposal struct {
+	Role   uint32                                 `protobuf:"varint,1,opt,name=role,proto3" json:"role,omitempty"`
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *SetRoleVoteWeightProposal) Reset()         { *m = SetRoleVoteWeightProposal{} }
+func (m *SetRoleVoteWeightProposal) String() string { return proto.CompactTextString(m) }
+func (*SetRoleVoteWeightProposal) ProtoMessage()    {}
+func (*SetRoleVoteWeightProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ac5ce23bf32d05, []int{19}
+}
+func (m *SetRoleVoteWeightProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetRoleVoteWeightProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetRoleVoteWeightProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.NetworkProperty != that1.NetworkProperty {
-		return false
-	}
-	if this.Value != that1.Value {
-		return false
-	}
-	return true
 }
-func (this *UpsertDataRegistryProposal) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *SetRoleVoteWeightProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRoleVoteWeightProposal.Merge(m, src)
+}
+func (m *SetRoleVoteWeightProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetRoleVoteWeightProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRoleVoteWeightProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRoleVoteWeightProposal proto.InternalMessageInfo
+
+func (m *SetRoleVoteWeightProposal) GetRole() uint32 {
+	if m != nil {
+		return m.Role
 	}
+	return 0
+}
 
-	that1, ok := that.(*UpsertDataRegistryProposal)
-	if !ok {
-		that2, ok := that.(UpsertDataRegistryProposal)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ParamChange defines the new value of a parameter of a module param subspace.
+type ParamChange struct {
+	Subspace string         `protobuf:"bytes,1,opt,name=subspace,proto3" json:"subspace,omitempty"`
+	Key      string         `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Type     ParamValueType `protobuf:"varint,3,opt,name=type,proto3,enum=kira.gov.ParamValueType" json:"type,omitempty"`
+	Value    string         `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *ParamChange) Reset()         { *m = ParamChange{} }
+func (m *ParamChange) String() string { return proto.CompactTextString(m) }
+func (*ParamChange) ProtoMessage()    {}
+func (*ParamChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ac5ce23bf32d05, []int{20}
+}
+func (m *ParamChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Key != that1.Key {
-		return false
-	}
-	if this.Hash != that1.Hash {
-		return false
-	}
-	if this.Reference != that1.Reference {
-		return false
+}
+func (m *ParamChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamChange.Merge(m, src)
+}
+func (m *ParamChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamChange proto.InternalMessageInfo
+
+func (m *ParamChange) GetSubspace() string {
+	if m != nil {
+		return m.Subspace
 	}
-	if this.Encoding != that1.Encoding {
-		return false
+	return ""
+}
+
+func (m *ParamChange) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	if this.Size_ != that1.Size_ {
-		return false
+	return ""
+}
+
+func (m *ParamChange) GetType() ParamValueType {
+	if m != nil {
+		return m.Type
 	}
-	return true
+	return ParamValueTypeUnspecified
 }
-func (this *CreateRoleProposal) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ParamChange) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
+	return ""
+}
 
-	that1, ok := that.(*CreateRoleProposal)
-	if !ok {
-		that2, ok := that.(CreateRoleProposal)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type MsgProposalParameterChange struct {
+	Proposer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty"`
+	Changes  []ParamChange                                 `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes"`
+}
+
+func (m *MsgProposalParameterChange) Reset()         { *m = MsgProposalParameterChange{} }
+func (m *MsgProposalParameterChange) String() string { return proto.CompactTextString(m) }
+func (*MsgProposalParameterChange) ProtoMessage()    {}
+func (*MsgProposalParameterChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ac5ce23bf32d05, []int{21}
+}
+func (m *MsgProposalParameterChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposalParameterChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposalParameterChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Role != that1.Role {
-		return false
-	}
-	if len(this.WhitelistedPermissions) != len(that1.WhitelistedPermissions) {
-		return false
-	}
-	for i := range this.WhitelistedPermissions {
-		if this.WhitelistedPermissions[i] != that1.WhitelistedPermissions[i] {
-			return false
-		}
+}
+func (m *MsgProposalParameterChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposalParameterChange.Merge(m, src)
+}
+func (m *MsgProposalParameterChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposalParameterChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposalParameterChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposalParameterChange proto.InternalMessageInfo
+
+func (m *MsgProposalParameterChange) GetProposer() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Proposer
 	}
-	if len(this.BlacklistedPermissions) != len(that1.BlacklistedPermissions) {
-		return false
+	return nil
+}
+
+func (m *MsgProposalParameterChange) GetChanges() []ParamChange {
+	if m != nil {
+		return m.Changes
 	}
-	for i := range this.BlacklistedPermissions {
-		if this.BlacklistedPermissions[i] != that1.BlacklistedPermissions[i] {
-			return false
+	return nil
+}
+
+type ParameterChangeProposal struct {
+	Changes []ParamChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+}
+
+func (m *ParameterChangeProposal) Reset()         { *m = ParameterChangeProposal{} }
+func (m *ParameterChangeProposal) String() string { return proto.CompactTextString(m) }
+func (*ParameterChangeProposal) ProtoMessage()    {}
+func (*ParameterChangeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ac5ce23bf32d05, []int{22}
+}
+func (m *ParameterChangeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParameterChangeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParameterChangeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (m *Vote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ParameterChangeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParameterChangeProposal.Merge(m, src)
+}
+func (m *ParameterChangeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParameterChangeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParameterChangeProposal.DiscardUnknown(m)
 }
 
-func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ParameterChangeProposal proto.InternalMessageInfo
+
+func (m *ParameterChangeProposal) GetChanges() []ParamChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
 }
 
-func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Option != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.Option))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ProposalId != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type MsgProposalMultiContent struct {
+	Proposer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty"`
+	Contents []*types.Any                                  `protobuf:"bytes,2,rep,name=contents,proto3" json:"contents,omitempty"`
 }
 
-func (m *MsgVoteProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgProposalMultiContent) Reset()         { *m = MsgProposalMultiContent{} }
+func (m *MsgProposalMultiContent) String() string { return proto.CompactTextString(m) }
+func (*MsgProposalMultiContent) ProtoMessage()    {}
+func (*MsgProposalMultiContent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ac5ce23bf32d05, []int{23}
+}
+func (m *MsgProposalMultiContent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposalMultiContent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposalMultiContent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgVoteProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgProposalMultiContent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposalMultiContent.Merge(m, src)
+}
+func (m *MsgProposalMultiContent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposalMultiContent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposalMultiContent.DiscardUnknown(m)
 }
 
-func (m *MsgVoteProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Option != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.Option))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ProposalId != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_MsgProposalMultiContent proto.InternalMessageInfo
+
+func (m *MsgProposalMultiContent) GetProposer() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Proposer
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgProposalAssignPermission) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgProposalMultiContent) GetContents() []*types.Any {
+	if m != nil {
+		return m.Contents
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgProposalAssignPermission) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MultiContentProposal batches several contents applied atomically once the proposal passes.
+type MultiContentProposal struct {
+	Contents []*types.Any `protobuf:"bytes,1,rep,name=contents,proto3" json:"contents,omitempty"`
 }
 
-func (m *MsgProposalAssignPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Permission != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.Permission))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Proposer)))
-		i--
-		dAtA[i] = 0xa
+func (m *MultiContentProposal) Reset()         { *m = MultiContentProposal{} }
+func (m *MultiContentProposal) String() string { return proto.CompactTextString(m) }
+func (*MultiContentProposal) ProtoMessage()    {}
+func (*MultiContentProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ac5ce23bf32d05, []int{24}
+}
+func (m *MultiContentProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiContentProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiContentProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MultiContentProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiContentProposal.Merge(m, src)
+}
+func (m *MultiContentProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiContentProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiContentProposal.DiscardUnknown(m)
 }
 
-func (m *MsgProposalUpsertDataRegistry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MultiContentProposal proto.InternalMessageInfo
+
+func (m *MultiContentProposal) GetContents() []*types.Any {
+	if m != nil {
+		return m.Contents
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgProposalUpsertDataRegistry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgProposalSoftwareUpgrade struct {
+	Proposer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty"`
+	Plan     types2.Plan                                   `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan"`
 }
 
-func (m *MsgProposalUpsertDataRegistry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Size_ != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.Size_))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Encoding) > 0 {
-		i -= len(m.Encoding)
-		copy(dAtA[i:], m.Encoding)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Encoding)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Reference) > 0 {
-		i -= len(m.Reference)
-		copy(dAtA[i:], m.Reference)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Reference)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgProposalSoftwareUpgrade) Reset()         { *m = MsgProposalSoftwareUpgrade{} }
+func (m *MsgProposalSoftwareUpgrade) String() string { return proto.CompactTextString(m) }
+func (*MsgProposalSoftwareUpgrade) ProtoMessage()    {}
+func (*MsgProposalSoftwareUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ac5ce23bf32d05, []int{25}
+}
+func (m *MsgProposalSoftwareUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposalSoftwareUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposalSoftwareUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Proposer)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgProposalSoftwareUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposalSoftwareUpgrade.Merge(m, src)
+}
+func (m *MsgProposalSoftwareUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposalSoftwareUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposalSoftwareUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposalSoftwareUpgrade proto.InternalMessageInfo
+
+func (m *MsgProposalSoftwareUpgrade) GetProposer() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Proposer
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgProposalSetPoorNetworkMessages) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgProposalSoftwareUpgrade) GetPlan() types2.Plan {
+	if m != nil {
+		return m.Plan
 	}
-	return dAtA[:n], nil
+	return types2.Plan{}
 }
 
-func (m *MsgProposalSetPoorNetworkMessages) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SoftwareUpgradeProposal schedules the upgrade plan once the proposal passes,
+// the chain halts at the plan height until the software handling the upgrade is started.
+type SoftwareUpgradeProposal struct {
+	Plan types2.Plan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan"`
 }
 
-func (m *MsgProposalSetPoorNetworkMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Messages[iNdEx])
-			copy(dAtA[i:], m.Messages[iNdEx])
-			i = encodeVarintProposal(dAtA, i, uint64(len(m.Messages[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *SoftwareUpgradeProposal) Reset()         { *m = SoftwareUpgradeProposal{} }
+func (m *SoftwareUpgradeProposal) String() string { return proto.CompactTextString(m) }
+func (*SoftwareUpgradeProposal) ProtoMessage()    {}
+func (*SoftwareUpgradeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ac5ce23bf32d05, []int{26}
+}
+func (m *SoftwareUpgradeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SoftwareUpgradeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SoftwareUpgradeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Proposer)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *SoftwareUpgradeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SoftwareUpgradeProposal.Merge(m, src)
+}
+func (m *SoftwareUpgradeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SoftwareUpgradeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SoftwareUpgradeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SoftwareUpgradeProposal proto.InternalMessageInfo
+
+func (m *SoftwareUpgradeProposal) GetPlan() types2.Plan {
+	if m != nil {
+		return m.Plan
 	}
-	return len(dAtA) - i, nil
+	return types2.Plan{}
 }
 
-func (m *Proposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type MsgProposalCancelSoftwareUpgrade struct {
+	Proposer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty"`
 }
 
-func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgProposalCancelSoftwareUpgrade) Reset()         { *m = MsgProposalCancelSoftwareUpgrade{} }
+func (m *MsgProposalCancelSoftwareUpgrade) String() string { return proto.CompactTextString(m) }
+func (*MsgProposalCancelSoftwareUpgrade) ProtoMessage()    {}
+func (*MsgProposalCancelSoftwareUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ac5ce23bf32d05, []int{27}
 }
-
-func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Result != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.Result))
-		i--
-		dAtA[i] = 0x40
-	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EnactmentEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EnactmentEndTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintProposal(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x32
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintProposal(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x2a
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintProposal(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x1a
-	if m.Content != nil {
-		{
-			size, err := m.Content.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProposal(dAtA, i, uint64(size))
+func (m *MsgProposalCancelSoftwareUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposalCancelSoftwareUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposalCancelSoftwareUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ProposalId != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgProposalCancelSoftwareUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposalCancelSoftwareUpgrade.Merge(m, src)
+}
+func (m *MsgProposalCancelSoftwareUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposalCancelSoftwareUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposalCancelSoftwareUpgrade.DiscardUnknown(m)
 }
 
-func (m *AssignPermissionProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgProposalCancelSoftwareUpgrade proto.InternalMessageInfo
+
+func (m *MsgProposalCancelSoftwareUpgrade) GetProposer() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Proposer
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AssignPermissionProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CancelSoftwareUpgradeProposal clears the scheduled upgrade plan once the proposal passes.
+type CancelSoftwareUpgradeProposal struct {
 }
 
-func (m *AssignPermissionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Permission != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.Permission))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *CancelSoftwareUpgradeProposal) Reset()         { *m = CancelSoftwareUpgradeProposal{} }
+func (m *CancelSoftwareUpgradeProposal) String() string { return proto.CompactTextString(m) }
+func (*CancelSoftwareUpgradeProposal) ProtoMessage()    {}
+func (*CancelSoftwareUpgradeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ac5ce23bf32d05, []int{28}
 }
-
-func (m *MsgProposalSetNetworkProperty) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CancelSoftwareUpgradeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelSoftwareUpgradeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelSoftwareUpgradeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *CancelSoftwareUpgradeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelSoftwareUpgradeProposal.Merge(m, src)
+}
+func (m *CancelSoftwareUpgradeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelSoftwareUpgradeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelSoftwareUpgradeProposal.DiscardUnknown(m)
 }
 
-func (m *MsgProposalSetNetworkProperty) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_CancelSoftwareUpgradeProposal proto.InternalMessageInfo
+
+type MsgProposalRemoveCouncilor struct {
+	Proposer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty"`
+	Address  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
 }
 
-func (m *MsgProposalSetNetworkProperty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.NetworkProperty != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.NetworkProperty))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgProposalRemoveCouncilor) Reset()         { *m = MsgProposalRemoveCouncilor{} }
+func (m *MsgProposalRemoveCouncilor) String() string { return proto.CompactTextString(m) }
+func (*MsgProposalRemoveCouncilor) ProtoMessage()    {}
+func (*MsgProposalRemoveCouncilor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ac5ce23bf32d05, []int{29}
+}
+func (m *MsgProposalRemoveCouncilor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposalRemoveCouncilor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposalRemoveCouncilor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Proposer)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgProposalRemoveCouncilor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposalRemoveCouncilor.Merge(m, src)
+}
+func (m *MsgProposalRemoveCouncilor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposalRemoveCouncilor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposalRemoveCouncilor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposalRemoveCouncilor proto.InternalMessageInfo
+
+func (m *MsgProposalRemoveCouncilor) GetProposer() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Proposer
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *SetNetworkPropertyProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgProposalRemoveCouncilor) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SetNetworkPropertyProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RemoveCouncilorProposal removes the councilor from the council registry once the proposal passes.
+type RemoveCouncilorProposal struct {
+	Address github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
 }
 
-func (m *SetNetworkPropertyProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.NetworkProperty != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.NetworkProperty))
-		i--
-		dAtA[i] = 0x8
+func (m *RemoveCouncilorProposal) Reset()         { *m = RemoveCouncilorProposal{} }
+func (m *RemoveCouncilorProposal) String() string { return proto.CompactTextString(m) }
+func (*RemoveCouncilorProposal) ProtoMessage()    {}
+func (*RemoveCouncilorProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ac5ce23bf32d05, []int{30}
+}
+func (m *RemoveCouncilorProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveCouncilorProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveCouncilorProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RemoveCouncilorProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveCouncilorProposal.Merge(m, src)
+}
+func (m *RemoveCouncilorProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveCouncilorProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveCouncilorProposal.DiscardUnknown(m)
 }
 
-func (m *UpsertDataRegistryProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RemoveCouncilorProposal proto.InternalMessageInfo
+
+func (m *RemoveCouncilorProposal) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *UpsertDataRegistryProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterEnum("kira.gov.VoteOption", VoteOption_name, VoteOption_value)
+	proto.RegisterEnum("kira.gov.VoteResult", VoteResult_name, VoteResult_value)
+	proto.RegisterEnum("kira.gov.ParamValueType", ParamValueType_name, ParamValueType_value)
+	proto.RegisterType((*Vote)(nil), "kira.gov.Vote")
+	proto.RegisterType((*WeightedVoteOption)(nil), "kira.gov.WeightedVoteOption")
+	proto.RegisterType((*MsgVoteProposal)(nil), "kira.gov.MsgVoteProposal")
+	proto.RegisterType((*MsgCancelProposal)(nil), "kira.gov.MsgCancelProposal")
+	proto.RegisterType((*MsgProposalAssignPermission)(nil), "kira.gov.MsgProposalAssignPermission")
+	proto.RegisterType((*MsgProposalUpsertDataRegistry)(nil), "kira.gov.MsgProposalUpsertDataRegistry")
+	proto.RegisterType((*MsgProposalSetPoorNetworkMessages)(nil), "kira.gov.MsgProposalSetPoorNetworkMessages")
+	proto.RegisterType((*Proposal)(nil), "kira.gov.Proposal")
+	proto.RegisterType((*Deposit)(nil), "kira.gov.Deposit")
+	proto.RegisterType((*TallyResult)(nil), "kira.gov.TallyResult")
+	proto.RegisterType((*WeightedTally)(nil), "kira.gov.WeightedTally")
+	proto.RegisterType((*AssignPermissionProposal)(nil), "kira.gov.AssignPermissionProposal")
+	proto.RegisterType((*MsgProposalSetNetworkProperty)(nil), "kira.gov.MsgProposalSetNetworkProperty")
+	proto.RegisterType((*SetNetworkPropertyProposal)(nil), "kira.gov.SetNetworkPropertyProposal")
+	proto.RegisterType((*UpsertDataRegistryProposal)(nil), "kira.gov.UpsertDataRegistryProposal")
+	proto.RegisterType((*SetPoorNetworkMessagesProposal)(nil), "kira.gov.SetPoorNetworkMessagesProposal")
+	proto.RegisterType((*MsgProposalCreateRole)(nil), "kira.gov.MsgProposalCreateRole")
+	proto.RegisterType((*CreateRoleProposal)(nil), "kira.gov.CreateRoleProposal")
+	proto.RegisterType((*MsgProposalSetRoleVoteWeight)(nil), "kira.gov.MsgProposalSetRoleVoteWeight")
+	proto.RegisterType((*SetRoleVoteWeightProposal)(nil), "kira.gov.SetRoleVoteWeightProposal")
+	proto.RegisterType((*ParamChange)(nil), "kira.gov.ParamChange")
+	proto.RegisterType((*MsgProposalParameterChange)(nil), "kira.gov.MsgProposalParameterChange")
+	proto.RegisterType((*ParameterChangeProposal)(nil), "kira.gov.ParameterChangeProposal")
+	proto.RegisterType((*MsgProposalMultiContent)(nil), "kira.gov.MsgProposalMultiContent")
+	proto.RegisterType((*MultiContentProposal)(nil), "kira.gov.MultiContentProposal")
+	proto.RegisterType((*MsgProposalSoftwareUpgrade)(nil), "kira.gov.MsgProposalSoftwareUpgrade")
+	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "kira.gov.SoftwareUpgradeProposal")
+	proto.RegisterType((*MsgProposalCancelSoftwareUpgrade)(nil), "kira.gov.MsgProposalCancelSoftwareUpgrade")
+	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "kira.gov.CancelSoftwareUpgradeProposal")
+	proto.RegisterType((*MsgProposalRemoveCouncilor)(nil), "kira.gov.MsgProposalRemoveCouncilor")
+	proto.RegisterType((*RemoveCouncilorProposal)(nil), "kira.gov.RemoveCouncilorProposal")
 }
 
-func (m *UpsertDataRegistryProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Size_ != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.Size_))
-		i--
-		dAtA[i] = 0x28
+func init() { proto.RegisterFile("proposal.proto", fileDescriptor_c3ac5ce23bf32d05) }
+
+var fileDescriptor_c3ac5ce23bf32d05 = []byte{
+	// 2248 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x92, 0xd4, 0xd7, 0xa3, 0x3e, 0x36, 0x63, 0xc9, 0xa2, 0x36, 0x32, 0xb9, 0x5d, 0xa4,
+	0x81, 0x10, 0xd8, 0x54, 0xac, 0xc2, 0x45, 0xe3, 0xa6, 0x4d, 0xf9, 0xb1, 0x8e, 0x55, 0x4b, 0x14,
+	0xb3, 0x22, 0x65, 0xb8, 0x45, 0xc0, 0xae, 0x96, 0x23, 0x6a, 0x2b, 0x72, 0x87, 0xd9, 0x19, 0x4a,
+	0x55, 0x2f, 0x3d, 0x15, 0x70, 0x78, 0x28, 0xf2, 0x0f, 0x10, 0x48, 0x93, 0x43, 0x81, 0x9c, 0x7b,
+	0xe8, 0x9f, 0x90, 0xfa, 0x94, 0xf6, 0x54, 0xf4, 0xa0, 0x04, 0x36, 0xd0, 0x16, 0xe8, 0xcd, 0xe8,
+	0xa9, 0x97, 0x16, 0xbb, 0x33, 0x4b, 0x2e, 0x3f, 0xec, 0x48, 0x36, 0xd3, 0x4b, 0x4f, 0x3b, 0xb3,
+	0xf3, 0xde, 0xef, 0x7d, 0xce, 0x9b, 0x37, 0x03, 0x0b, 0x4d, 0x97, 0x34, 0x09, 0x35, 0xeb, 0xe9,
+	0xa6, 0x4b, 0x18, 0x41, 0x33, 0xc7, 0xb6, 0x6b, 0xa6, 0x6b, 0xe4, 0x44, 0x59, 0xaa, 0x91, 0x1a,
+	0xf1, 0x7f, 0x6e, 0x78, 0x23, 0xbe, 0xae, 0xa4, 0x6a, 0x84, 0xd4, 0xea, 0x78, 0xc3, 0x9f, 0x1d,
+	0xb4, 0x0e, 0x37, 0x98, 0xdd, 0xc0, 0x94, 0x99, 0x8d, 0xa6, 0x20, 0x58, 0x1d, 0x24, 0x30, 0x9d,
+	0xb3, 0x60, 0xc9, 0x22, 0xb4, 0x41, 0x68, 0x85, 0x83, 0xf2, 0x89, 0x58, 0x4a, 0xf2, 0xd9, 0xc6,
+	0x81, 0x49, 0xf1, 0xc6, 0xc9, 0xcd, 0x03, 0xcc, 0xcc, 0x9b, 0x1b, 0x16, 0xb1, 0x1d, 0xb1, 0xfe,
+	0x9a, 0x58, 0x6f, 0x35, 0x6b, 0xae, 0x59, 0xed, 0x91, 0x88, 0xb9, 0xa0, 0x02, 0x97, 0xd4, 0x83,
+	0xb1, 0xdc, 0xc4, 0x6e, 0xc3, 0xa6, 0xd4, 0x26, 0x01, 0x46, 0xc2, 0xc1, 0xec, 0x94, 0xb8, 0xc7,
+	0x9e, 0xfc, 0x26, 0x76, 0x99, 0x8d, 0x85, 0x74, 0xed, 0x4f, 0x11, 0x88, 0xed, 0x13, 0x86, 0x51,
+	0x0a, 0xe2, 0x81, 0x3f, 0x2a, 0x76, 0x35, 0x21, 0xa9, 0xd2, 0x7a, 0xcc, 0x80, 0xe0, 0xd7, 0x56,
+	0x15, 0xbd, 0x0b, 0x93, 0x27, 0x84, 0x61, 0x37, 0x11, 0x51, 0xa5, 0xf5, 0xb9, 0xec, 0xcd, 0x7f,
+	0x9f, 0xa7, 0x6e, 0xd4, 0x6c, 0x76, 0xd4, 0x3a, 0x48, 0x5b, 0xa4, 0x21, 0x6c, 0x12, 0x9f, 0x1b,
+	0xb4, 0x7a, 0xbc, 0xc1, 0xce, 0x9a, 0x98, 0xa6, 0x33, 0x96, 0x95, 0xa9, 0x56, 0x5d, 0x4c, 0xa9,
+	0xc1, 0xf9, 0xd1, 0x75, 0x98, 0x22, 0x4d, 0x66, 0x13, 0x27, 0x11, 0x55, 0xa5, 0xf5, 0x85, 0xcd,
+	0xa5, 0x74, 0xe0, 0xf8, 0xb4, 0xa7, 0xc9, 0xae, 0xbf, 0x66, 0x08, 0x1a, 0xf4, 0x36, 0x4c, 0xf3,
+	0x11, 0x4d, 0xc4, 0xd4, 0xe8, 0x7a, 0x7c, 0x73, 0xad, 0x47, 0x7e, 0x1f, 0xdb, 0xb5, 0x23, 0x86,
+	0xab, 0x3d, 0xb6, 0x6c, 0xec, 0xf3, 0xf3, 0xd4, 0x84, 0x11, 0xb0, 0xa0, 0xab, 0x30, 0xe5, 0x62,
+	0x93, 0x12, 0x27, 0x31, 0xa9, 0x4a, 0xeb, 0xb3, 0x86, 0x98, 0xa1, 0x9f, 0x42, 0x9c, 0xb6, 0x0e,
+	0x1a, 0x36, 0xab, 0x78, 0x41, 0x4c, 0x4c, 0xa9, 0xd2, 0x7a, 0x7c, 0x53, 0x49, 0xf3, 0x00, 0xa6,
+	0x83, 0x00, 0xa6, 0x4b, 0x41, 0x84, 0xb3, 0x49, 0x0f, 0xf7, 0xe9, 0x79, 0x0a, 0x9d, 0x99, 0x8d,
+	0xfa, 0x6d, 0x2d, 0xc4, 0xac, 0x7d, 0xf4, 0x65, 0x4a, 0x32, 0x80, 0xff, 0xf1, 0x18, 0xb4, 0xb6,
+	0x04, 0x68, 0x58, 0xb5, 0x90, 0xdd, 0xd2, 0x05, 0xec, 0xbe, 0x03, 0x53, 0xa7, 0x3e, 0x86, 0xef,
+	0xef, 0xd9, 0x6c, 0xda, 0x53, 0xe0, 0xaf, 0xe7, 0xa9, 0xd7, 0x2f, 0xe0, 0xf3, 0x3c, 0xb6, 0x0c,
+	0xc1, 0xad, 0xfd, 0x47, 0x82, 0xc5, 0x1d, 0x5a, 0xf3, 0x24, 0x14, 0x45, 0x30, 0xff, 0xbf, 0x62,
+	0xad, 0xfd, 0x0a, 0x5e, 0xd9, 0xa1, 0xb5, 0x9c, 0xe9, 0x58, 0xb8, 0x7e, 0x71, 0x17, 0x6c, 0xc1,
+	0x14, 0xc5, 0x4e, 0xf5, 0x65, 0x7c, 0x20, 0x00, 0xb4, 0x7f, 0x4a, 0xf0, 0xea, 0x0e, 0xad, 0x05,
+	0xb2, 0x33, 0x94, 0xda, 0x35, 0xa7, 0xd8, 0xdd, 0xa3, 0x68, 0x07, 0x66, 0xb8, 0x60, 0xec, 0x26,
+	0xa4, 0x17, 0x15, 0xd6, 0x85, 0x40, 0xef, 0xc3, 0xb4, 0xc9, 0x7f, 0x0a, 0xd5, 0x73, 0x4f, 0xcf,
+	0x53, 0x0b, 0x3c, 0x6f, 0xc5, 0x82, 0x76, 0x79, 0xfc, 0x00, 0x13, 0x25, 0x01, 0x7a, 0xf5, 0xc5,
+	0x0f, 0xeb, 0xbc, 0x11, 0xfa, 0xa3, 0x3d, 0x96, 0xe0, 0x5a, 0xc8, 0xda, 0x72, 0x93, 0x62, 0x97,
+	0xe5, 0x4d, 0x66, 0x1a, 0xb8, 0x66, 0x53, 0xe6, 0x9e, 0x8d, 0xdb, 0x5e, 0x19, 0xa2, 0xc7, 0xf8,
+	0x8c, 0x6f, 0x13, 0xc3, 0x1b, 0x22, 0x04, 0xb1, 0x23, 0x93, 0x1e, 0xf9, 0xca, 0xcd, 0x1a, 0xfe,
+	0x18, 0xad, 0xc1, 0xac, 0x8b, 0x0f, 0xb1, 0x8b, 0x1d, 0x0b, 0x27, 0x62, 0xfe, 0x42, 0xef, 0x07,
+	0x52, 0x60, 0x06, 0x3b, 0x16, 0xa9, 0xda, 0x4e, 0x4d, 0x64, 0x4f, 0x77, 0xee, 0xa1, 0x51, 0xfb,
+	0x97, 0xbc, 0x48, 0xc4, 0x0c, 0x7f, 0xac, 0xfd, 0x46, 0x82, 0x6f, 0x85, 0x8c, 0xdc, 0xc3, 0xac,
+	0x48, 0x88, 0x5b, 0xe0, 0x55, 0x76, 0x07, 0x53, 0x6a, 0xd6, 0x30, 0x1d, 0xb7, 0xa1, 0x0a, 0xcc,
+	0x34, 0x04, 0x74, 0x22, 0xa2, 0x46, 0x3d, 0x25, 0x83, 0xb9, 0xf6, 0xf7, 0x49, 0x98, 0xb9, 0x78,
+	0x72, 0x7f, 0x1f, 0xa6, 0x2d, 0xe2, 0x30, 0xec, 0xf0, 0xea, 0x12, 0xdf, 0x5c, 0x1a, 0x2a, 0x7d,
+	0x19, 0xe7, 0x2c, 0x1b, 0x7f, 0xf4, 0xfb, 0x1b, 0xd3, 0x39, 0x4e, 0x68, 0x04, 0x1c, 0x83, 0xb5,
+	0x33, 0x3a, 0xce, 0xda, 0x89, 0x0e, 0x61, 0xf1, 0x84, 0x30, 0xdb, 0xa9, 0x55, 0xb0, 0x53, 0xe5,
+	0x02, 0x26, 0xbf, 0x56, 0x80, 0x26, 0x04, 0x5c, 0xe5, 0x02, 0x06, 0x00, 0xb8, 0x90, 0x79, 0xfe,
+	0x57, 0x77, 0xaa, 0xbe, 0x9c, 0x3a, 0x20, 0xec, 0x98, 0x16, 0x6b, 0x60, 0x87, 0xf5, 0x44, 0x4d,
+	0x8d, 0x45, 0x94, 0xdc, 0x45, 0x0e, 0xa4, 0x5d, 0xf7, 0x4a, 0x13, 0x6d, 0xd5, 0x59, 0x62, 0x66,
+	0x54, 0x19, 0x34, 0xfc, 0x35, 0x43, 0xd0, 0xa0, 0xf7, 0x61, 0xe1, 0x54, 0x54, 0xbb, 0x0a, 0x33,
+	0xeb, 0xf5, 0xb3, 0xc4, 0xac, 0xaf, 0xd7, 0xca, 0x70, 0x35, 0x2c, 0x79, 0xcb, 0xd9, 0x6b, 0x42,
+	0xa9, 0x65, 0xae, 0x54, 0x3f, 0xb3, 0x66, 0xcc, 0x9f, 0x86, 0xa9, 0x51, 0x19, 0xe6, 0xfc, 0x85,
+	0x8a, 0x50, 0x09, 0x7c, 0xf0, 0xe5, 0x1e, 0xb8, 0x4f, 0xc6, 0x75, 0xca, 0xbe, 0x2a, 0xa0, 0xaf,
+	0x70, 0xe8, 0x30, 0xa3, 0x66, 0xc4, 0x59, 0x8f, 0x12, 0xfd, 0x2c, 0x94, 0xec, 0x71, 0x3f, 0xd9,
+	0xf3, 0x4f, 0xcf, 0x53, 0x8b, 0x9c, 0x2f, 0x58, 0xd1, 0x5e, 0x22, 0xff, 0x6f, 0xc7, 0x1e, 0x7e,
+	0x9c, 0x9a, 0xd0, 0xfe, 0x25, 0xc1, 0x74, 0x1e, 0x37, 0x09, 0xb5, 0xd9, 0xd7, 0x27, 0xba, 0x05,
+	0xb3, 0x55, 0x4e, 0x4b, 0x82, 0x42, 0xae, 0x3f, 0x3d, 0x4f, 0xc9, 0x5c, 0xab, 0xee, 0xd2, 0x0b,
+	0xa8, 0xd5, 0xc3, 0x45, 0x16, 0x4c, 0x99, 0x0d, 0xd2, 0x72, 0x58, 0x22, 0xea, 0x9f, 0x5a, 0xab,
+	0x69, 0xce, 0x98, 0xf6, 0x5a, 0xba, 0xb4, 0xe8, 0xd7, 0xd2, 0x39, 0x62, 0x3b, 0xd9, 0x37, 0x3d,
+	0x77, 0x7e, 0xf6, 0x65, 0x6a, 0xfd, 0x02, 0xc2, 0x3c, 0x06, 0x6a, 0x08, 0x68, 0xed, 0x5c, 0x82,
+	0x78, 0x28, 0x30, 0x5e, 0xd5, 0x3b, 0xc3, 0x54, 0x98, 0xec, 0x0d, 0x51, 0x02, 0xa6, 0xcd, 0x03,
+	0xca, 0x4c, 0xdb, 0xf1, 0x2d, 0x8d, 0x19, 0xc1, 0x14, 0x2d, 0x40, 0xc4, 0x21, 0xfe, 0x46, 0x8d,
+	0x19, 0x11, 0x87, 0xa0, 0xb7, 0x60, 0xce, 0x21, 0x95, 0x53, 0x9b, 0x1d, 0x55, 0x4e, 0x30, 0x23,
+	0x7e, 0x39, 0x8c, 0x65, 0x57, 0x7a, 0x61, 0x0e, 0xaf, 0x6a, 0x06, 0x38, 0xe4, 0xbe, 0xcd, 0x8e,
+	0xf6, 0x31, 0x23, 0xe8, 0x36, 0xcc, 0x31, 0xc2, 0xcc, 0x7a, 0xc5, 0x3f, 0xdf, 0x69, 0x62, 0x72,
+	0x90, 0x35, 0xbc, 0xea, 0x65, 0x88, 0x37, 0xdd, 0xf7, 0x67, 0xde, 0x01, 0xfd, 0x41, 0x8b, 0xb8,
+	0xad, 0x86, 0x28, 0xa5, 0x62, 0x76, 0x3b, 0xf6, 0x8f, 0x8f, 0x53, 0x92, 0xf6, 0x28, 0x02, 0xf3,
+	0x7d, 0x69, 0x8d, 0x7e, 0xd4, 0x33, 0xf1, 0xf2, 0xfd, 0x8f, 0xef, 0x92, 0xbb, 0xfd, 0x2e, 0xb9,
+	0x3c, 0x4a, 0xd7, 0x85, 0x3f, 0xec, 0xba, 0xf0, 0xf2, 0x20, 0x9e, 0xcb, 0x6b, 0x23, 0x5c, 0x3e,
+	0x9b, 0xd5, 0x2f, 0x87, 0x74, 0x81, 0x00, 0x09, 0x67, 0x7e, 0x26, 0x41, 0x62, 0xb0, 0xcf, 0xe8,
+	0x1e, 0x0f, 0xa1, 0x06, 0x41, 0xfa, 0xc6, 0x1b, 0x84, 0xc8, 0x60, 0x83, 0x70, 0x7b, 0xd1, 0xd3,
+	0xf0, 0xcf, 0xbd, 0x93, 0x45, 0x7b, 0xd4, 0xdf, 0x31, 0xec, 0x61, 0x26, 0x0e, 0xd2, 0x22, 0xbf,
+	0xad, 0x8c, 0xbd, 0x63, 0xc8, 0x83, 0x3c, 0x70, 0x21, 0xe2, 0xed, 0xc3, 0xc2, 0xe6, 0x6a, 0xaf,
+	0x0a, 0x0e, 0xe8, 0x60, 0x2c, 0x3a, 0x03, 0x4a, 0x2d, 0xc1, 0xe4, 0x89, 0x59, 0x6f, 0x61, 0xb1,
+	0xb1, 0xf8, 0x44, 0xfb, 0x50, 0x02, 0x65, 0xd8, 0x82, 0xae, 0xef, 0x47, 0x89, 0x96, 0x5e, 0x5c,
+	0x74, 0x24, 0x24, 0x7a, 0xd8, 0xb1, 0xbf, 0x95, 0x40, 0x19, 0xee, 0xbf, 0xba, 0xba, 0x88, 0xc6,
+	0x49, 0x1a, 0x6e, 0x9c, 0x22, 0xcf, 0x6a, 0x9c, 0xa2, 0xcf, 0x6b, 0x9c, 0x62, 0xcf, 0x68, 0x9c,
+	0x26, 0x7b, 0x8d, 0xd3, 0xb0, 0x8e, 0x6f, 0x43, 0x72, 0x74, 0xf7, 0xd4, 0x55, 0x33, 0xdc, 0xf6,
+	0x48, 0x03, 0x6d, 0xcf, 0xa3, 0x08, 0x2c, 0x87, 0x52, 0x27, 0xe7, 0x62, 0x93, 0x61, 0x83, 0xd4,
+	0xf1, 0xb8, 0x53, 0x06, 0x41, 0xcc, 0xbb, 0x61, 0x8b, 0x74, 0xf6, 0xc7, 0x68, 0x1b, 0x56, 0x4e,
+	0x8f, 0x6c, 0x86, 0xeb, 0x36, 0xf5, 0x4e, 0xdb, 0x5e, 0x8a, 0x53, 0xff, 0x20, 0x58, 0xd8, 0xbc,
+	0xd2, 0x0b, 0xa9, 0xb7, 0x0d, 0xf7, 0xbd, 0x28, 0x19, 0x57, 0x43, 0x3c, 0xbd, 0xcd, 0x49, 0x3d,
+	0xb4, 0x83, 0xba, 0x69, 0x1d, 0x8f, 0x40, 0x8b, 0x3d, 0x07, 0x2d, 0xc4, 0x13, 0x46, 0x93, 0x21,
+	0x4a, 0xed, 0xaa, 0xe8, 0x65, 0xbd, 0x21, 0x52, 0x21, 0x5e, 0xc5, 0xd4, 0x72, 0x6d, 0x7e, 0x1f,
+	0x9b, 0xf2, 0x57, 0xc2, 0xbf, 0xb4, 0x0f, 0x23, 0x80, 0x7a, 0x1e, 0xec, 0xfa, 0x3f, 0x30, 0x5d,
+	0xba, 0x98, 0xe9, 0x91, 0xb1, 0x9a, 0x1e, 0x7d, 0x61, 0xd3, 0x63, 0xcf, 0x34, 0x7d, 0x72, 0xc8,
+	0xf4, 0xe1, 0xb4, 0xfc, 0xa3, 0x04, 0x6b, 0xfd, 0x35, 0xc9, 0xf3, 0x89, 0x77, 0x90, 0xf1, 0x33,
+	0xea, 0x7f, 0x91, 0x5f, 0xbd, 0x27, 0x80, 0xe8, 0x4b, 0x3d, 0x01, 0x3c, 0x94, 0x60, 0x75, 0xc8,
+	0x80, 0xe7, 0x86, 0x77, 0x4c, 0x8f, 0x0f, 0xc3, 0x6e, 0xfd, 0xb5, 0x04, 0xf1, 0xa2, 0xe9, 0x9a,
+	0x8d, 0xdc, 0x91, 0xe9, 0xd4, 0xfc, 0xf2, 0x41, 0x5b, 0x07, 0xb4, 0x69, 0x5a, 0x58, 0xd4, 0xa1,
+	0xee, 0x7c, 0xc4, 0xbd, 0xee, 0x3a, 0xc4, 0x3c, 0x19, 0xe2, 0x2d, 0x21, 0x11, 0x4a, 0x0a, 0x0f,
+	0xd2, 0xcf, 0x8a, 0xd2, 0x59, 0x13, 0x1b, 0x3e, 0x55, 0xaf, 0x48, 0xf2, 0x4c, 0x10, 0x45, 0x92,
+	0x9f, 0x8f, 0x9f, 0x48, 0xa0, 0x84, 0xc2, 0xeb, 0xf3, 0x63, 0x86, 0x5d, 0xa1, 0xd6, 0x98, 0x83,
+	0x7b, 0x0b, 0xa6, 0x2d, 0x1f, 0x98, 0xef, 0x8e, 0xbe, 0x66, 0x3b, 0xe4, 0x8d, 0xe0, 0x41, 0x43,
+	0xd0, 0x6a, 0x26, 0xac, 0x0c, 0x28, 0xd6, 0x0d, 0x5a, 0x08, 0x51, 0xba, 0x38, 0xe2, 0x70, 0x3c,
+	0x7e, 0x27, 0xc1, 0x4a, 0xc8, 0x0f, 0x3b, 0xad, 0x3a, 0xb3, 0xc5, 0xda, 0xb8, 0x9d, 0xf0, 0x03,
+	0x98, 0x11, 0x37, 0xc8, 0xc0, 0x0b, 0x17, 0xb8, 0x74, 0x76, 0x59, 0xb4, 0x43, 0x58, 0x0a, 0x6b,
+	0xd7, 0xf5, 0x44, 0x18, 0x56, 0xba, 0x34, 0xec, 0xb0, 0x47, 0x3e, 0xed, 0xcf, 0x8c, 0x3d, 0x72,
+	0xc8, 0x4e, 0x4d, 0x17, 0x97, 0xf9, 0x6b, 0xeb, 0xb8, 0x9d, 0xf2, 0x5d, 0x88, 0x35, 0xeb, 0xa6,
+	0x23, 0x6e, 0xe1, 0x6b, 0xc1, 0xc5, 0x21, 0x78, 0xdb, 0x0d, 0xee, 0x0e, 0xc5, 0xba, 0x19, 0x3c,
+	0x77, 0xf9, 0xf4, 0xda, 0x01, 0xac, 0x0c, 0x68, 0xd6, 0x75, 0x48, 0x00, 0x29, 0x5d, 0x0e, 0x72,
+	0xd8, 0x13, 0x1f, 0x80, 0x1a, 0x3e, 0x5a, 0xfd, 0xf7, 0xb3, 0x6f, 0xd6, 0x1d, 0xda, 0x9b, 0x70,
+	0x6d, 0xa4, 0x9c, 0x40, 0x89, 0x61, 0x25, 0xff, 0xd0, 0x1f, 0x2e, 0x03, 0x37, 0xc8, 0x09, 0xce,
+	0x91, 0x96, 0x63, 0xd9, 0x75, 0xe2, 0x8e, 0x3b, 0x5c, 0xf7, 0x06, 0x9f, 0xd6, 0x6e, 0xbe, 0x78,
+	0x9f, 0xac, 0x9d, 0xc2, 0xca, 0x80, 0xba, 0xdd, 0x18, 0xde, 0x1b, 0xec, 0xd0, 0x5f, 0x42, 0xce,
+	0x90, 0xcf, 0xde, 0xf8, 0x9b, 0x04, 0xd0, 0xf7, 0x2e, 0xbd, 0xb2, 0xbf, 0x5b, 0xd2, 0x2b, 0xbb,
+	0xc5, 0xd2, 0xd6, 0x6e, 0xa1, 0x52, 0x2e, 0xec, 0x15, 0xf5, 0xdc, 0xd6, 0x9d, 0x2d, 0x3d, 0x2f,
+	0x4f, 0x28, 0x8b, 0xed, 0x8e, 0x1a, 0xe7, 0x84, 0x7a, 0xa3, 0xc9, 0xce, 0x90, 0x06, 0x8b, 0x61,
+	0xea, 0x07, 0xfa, 0x9e, 0x2c, 0x29, 0xf3, 0xed, 0x8e, 0x3a, 0xcb, 0xa9, 0x1e, 0x60, 0x8a, 0xde,
+	0x80, 0x2b, 0x61, 0x9a, 0x4c, 0x76, 0xaf, 0x94, 0xd9, 0x2a, 0xc8, 0x11, 0xe5, 0x95, 0x76, 0x47,
+	0x9d, 0xe7, 0x74, 0x19, 0x71, 0xb1, 0x52, 0x61, 0x21, 0x4c, 0x5b, 0xd8, 0x95, 0xa3, 0xca, 0x5c,
+	0xbb, 0xa3, 0xce, 0x70, 0xb2, 0x02, 0x41, 0x9b, 0x90, 0xe8, 0xa7, 0xa8, 0xdc, 0xdf, 0x2a, 0xdd,
+	0xad, 0xec, 0xeb, 0xa5, 0x5d, 0x39, 0xa6, 0x2c, 0xb5, 0x3b, 0xaa, 0x1c, 0xd0, 0x06, 0xb7, 0x20,
+	0x25, 0xf6, 0xf0, 0xd3, 0xe4, 0xc4, 0x1b, 0x9f, 0x44, 0xb9, 0xa1, 0xe2, 0xca, 0xfc, 0x9a, 0x50,
+	0xcb, 0xd0, 0xf7, 0xca, 0xdb, 0xa5, 0x4a, 0xb9, 0x70, 0xaf, 0xb0, 0x7b, 0xbf, 0x20, 0x4f, 0x28,
+	0xf1, 0x76, 0x47, 0x9d, 0x2e, 0x3b, 0xc7, 0x0e, 0x39, 0x75, 0x90, 0x06, 0x28, 0x4c, 0x55, 0xcc,
+	0xec, 0xed, 0xe9, 0x79, 0x59, 0x52, 0xa0, 0xdd, 0x51, 0xa7, 0x8a, 0x26, 0xa5, 0xb8, 0x8a, 0x5e,
+	0x87, 0xa5, 0x30, 0x8d, 0xa1, 0xff, 0x58, 0xcf, 0x95, 0xf4, 0xbc, 0x1c, 0xe1, 0xaa, 0x1b, 0xf8,
+	0xe7, 0xd8, 0x62, 0xb8, 0x8a, 0xbe, 0x07, 0xc9, 0x51, 0x74, 0x21, 0x03, 0xa2, 0xdc, 0x80, 0x80,
+	0xa3, 0x7b, 0xcf, 0xbe, 0x06, 0x73, 0x3e, 0x67, 0x51, 0x2f, 0xe4, 0xb7, 0x0a, 0xef, 0xca, 0x31,
+	0xae, 0x64, 0x11, 0x3b, 0x7e, 0x6b, 0x3d, 0x00, 0xfc, 0x5e, 0x79, 0xd7, 0x28, 0xef, 0x54, 0x0a,
+	0xbb, 0x9e, 0x8c, 0x4c, 0xee, 0xae, 0x9e, 0x97, 0x27, 0x39, 0xf0, 0x7b, 0xfe, 0x15, 0xbb, 0x40,
+	0x98, 0x81, 0x4d, 0xeb, 0x08, 0x57, 0xd1, 0x3a, 0x2c, 0x87, 0x39, 0xf5, 0x42, 0x26, 0x57, 0xda,
+	0xd1, 0x0b, 0x25, 0x79, 0x8a, 0x47, 0x51, 0x0f, 0xde, 0xae, 0x06, 0x29, 0x73, 0x99, 0x42, 0x4e,
+	0xdf, 0xde, 0xd6, 0xf3, 0xf2, 0x34, 0xa7, 0xe4, 0x3b, 0xb5, 0x8e, 0xab, 0xe8, 0x16, 0xac, 0x8d,
+	0xc4, 0xac, 0xdc, 0xc9, 0x6c, 0x79, 0x0c, 0x33, 0xca, 0x95, 0x76, 0x47, 0x5d, 0xec, 0x42, 0xdf,
+	0x31, 0xed, 0x3a, 0xae, 0x8a, 0x20, 0x7d, 0x15, 0x81, 0x85, 0xfe, 0xf3, 0x1b, 0xbd, 0x03, 0x6b,
+	0xc5, 0x8c, 0x91, 0xd9, 0xa9, 0xec, 0x67, 0xb6, 0xcb, 0x7a, 0xa5, 0xf4, 0xa0, 0xa8, 0x0f, 0xa4,
+	0xe5, 0xb5, 0x76, 0x47, 0x5d, 0xed, 0xe7, 0x2a, 0x3b, 0xb4, 0x89, 0x2d, 0xfb, 0xd0, 0xc6, 0x55,
+	0x74, 0x13, 0x96, 0x87, 0x00, 0xb2, 0xbb, 0xbb, 0xdb, 0xb2, 0xa4, 0x5c, 0x6d, 0x77, 0x54, 0xd4,
+	0xcf, 0x99, 0x25, 0xa4, 0x3e, 0x92, 0xa5, 0xbc, 0x55, 0x28, 0xc9, 0x91, 0x51, 0x2c, 0x65, 0xdb,
+	0x61, 0x68, 0x03, 0x96, 0x86, 0x58, 0xf2, 0x7a, 0x4e, 0x8e, 0x2a, 0xcb, 0xed, 0x8e, 0xfa, 0x4a,
+	0x3f, 0x47, 0x1e, 0x5b, 0xe8, 0x2d, 0x58, 0x1d, 0x66, 0x28, 0x1b, 0x19, 0x2f, 0xaf, 0xe5, 0x98,
+	0xa2, 0xb4, 0x3b, 0xea, 0xd5, 0x01, 0xae, 0x96, 0x6b, 0xfa, 0x9b, 0xf4, 0x16, 0xac, 0x0c, 0xb1,
+	0xee, 0x95, 0x0c, 0x2f, 0x35, 0x26, 0x95, 0x44, 0xbb, 0xa3, 0x2e, 0xf5, 0x33, 0xee, 0x31, 0xd7,
+	0x76, 0x6a, 0xdc, 0xc5, 0xd9, 0x77, 0x3e, 0x7f, 0x9c, 0x94, 0xbe, 0x78, 0x9c, 0x94, 0xbe, 0x7a,
+	0x9c, 0x94, 0x3e, 0x7a, 0x92, 0x9c, 0xf8, 0xe2, 0x49, 0x72, 0xe2, 0x2f, 0x4f, 0x92, 0x13, 0x3f,
+	0xf9, 0x76, 0xa8, 0xa6, 0xdc, 0xb3, 0x5d, 0x33, 0x47, 0x5c, 0xbc, 0x41, 0xf1, 0xb1, 0x69, 0x6f,
+	0xfc, 0x62, 0xa3, 0x46, 0x4e, 0x78, 0x59, 0x39, 0x98, 0xf2, 0x8f, 0xd2, 0xef, 0xfc, 0x77, 0x00,
+	0xab, 0x55, 0xc1, 0x12, 0x33, 0x1d, 0x00, 0x00,
+}
+
+func (this *TallyResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.Encoding) > 0 {
-		i -= len(m.Encoding)
-		copy(dAtA[i:], m.Encoding)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Encoding)))
-		i--
-		dAtA[i] = 0x22
+
+	that1, ok := that.(*TallyResult)
+	if !ok {
+		that2, ok := that.(TallyResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Reference) > 0 {
-		i -= len(m.Reference)
-		copy(dAtA[i:], m.Reference)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Reference)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x12
+	if this.Yes != that1.Yes {
+		return false
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	if this.Abstain != that1.Abstain {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SetPoorNetworkMessagesProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.No != that1.No {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SetPoorNetworkMessagesProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SetPoorNetworkMessagesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Messages[iNdEx])
-			copy(dAtA[i:], m.Messages[iNdEx])
-			i = encodeVarintProposal(dAtA, i, uint64(len(m.Messages[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if this.NoWithVeto != that1.NoWithVeto {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgProposalCreateRole) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.TotalVoters != that1.TotalVoters {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgProposalCreateRole) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Quorum != that1.Quorum {
+		return false
+	}
+	return true
 }
+func (this *WeightedTally) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgProposalCreateRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BlacklistedPermissions) > 0 {
-		dAtA6 := make([]byte, len(m.BlacklistedPermissions)*10)
-		var j5 int
-		for _, num := range m.BlacklistedPermissions {
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA6[j5] = uint8(num)
-			j5++
+	that1, ok := that.(*WeightedTally)
+	if !ok {
+		that2, ok := that.(WeightedTally)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintProposal(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0x22
 	}
-	if len(m.WhitelistedPermissions) > 0 {
-		dAtA8 := make([]byte, len(m.WhitelistedPermissions)*10)
-		var j7 int
-		for _, num := range m.WhitelistedPermissions {
-			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j7++
-			}
-			dAtA8[j7] = uint8(num)
-			j7++
-		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintProposal(dAtA, i, uint64(j7))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Role != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.Role))
-		i--
-		dAtA[i] = 0x10
+	if !this.Yes.Equal(that1.Yes) {
+		return false
 	}
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Proposer)))
-		i--
-		dAtA[i] = 0xa
+	if !this.Abstain.Equal(that1.Abstain) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CreateRoleProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.No.Equal(that1.No) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CreateRoleProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !this.NoWithVeto.Equal(that1.NoWithVeto) {
+		return false
+	}
+	return true
 }
+func (this *AssignPermissionProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CreateRoleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BlacklistedPermissions) > 0 {
-		dAtA10 := make([]byte, len(m.BlacklistedPermissions)*10)
-		var j9 int
-		for _, num := range m.BlacklistedPermissions {
-			for num >= 1<<7 {
-				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j9++
-			}
-			dAtA10[j9] = uint8(num)
-			j9++
+	that1, ok := that.(*AssignPermissionProposal)
+	if !ok {
+		that2, ok := that.(AssignPermissionProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= j9
-		copy(dAtA[i:], dAtA10[:j9])
-		i = encodeVarintProposal(dAtA, i, uint64(j9))
-		i--
-		dAtA[i] = 0x1a
 	}
-	if len(m.WhitelistedPermissions) > 0 {
-		dAtA12 := make([]byte, len(m.WhitelistedPermissions)*10)
-		var j11 int
-		for _, num := range m.WhitelistedPermissions {
-			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j11++
-			}
-			dAtA12[j11] = uint8(num)
-			j11++
-		}
-		i -= j11
-		copy(dAtA[i:], dAtA12[:j11])
-		i = encodeVarintProposal(dAtA, i, uint64(j11))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Role != 0 {
-		i = encodeVarintProposal(dAtA, i, uint64(m.Role))
-		i--
-		dAtA[i] = 0x8
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProposal(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if this.Permission != that1.Permission {
+		return false
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return true
 }
-func (m *Vote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovProposal(uint64(m.ProposalId))
-	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
-	}
-	if m.Option != 0 {
-		n += 1 + sovProposal(uint64(m.Option))
+func (this *SetNetworkPropertyProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return n
-}
 
-func (m *MsgVoteProposal) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*SetNetworkPropertyProposal)
+	if !ok {
+		that2, ok := that.(SetNetworkPropertyProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovProposal(uint64(m.ProposalId))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if this.NetworkProperty != that1.NetworkProperty {
+		return false
 	}
-	if m.Option != 0 {
-		n += 1 + sovProposal(uint64(m.Option))
+	if this.Value != that1.Value {
+		return false
 	}
-	return n
+	return true
 }
+func (this *UpsertDataRegistryProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgProposalAssignPermission) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*UpsertDataRegistryProposal)
+	if !ok {
+		that2, ok := that.(UpsertDataRegistryProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if this.Key != that1.Key {
+		return false
 	}
-	if m.Permission != 0 {
-		n += 1 + sovProposal(uint64(m.Permission))
+	if this.Hash != that1.Hash {
+		return false
 	}
-	return n
+	if this.Reference != that1.Reference {
+		return false
+	}
+	if this.Encoding != that1.Encoding {
+		return false
+	}
+	if this.Size_ != that1.Size_ {
+		return false
+	}
+	return true
 }
+func (this *CreateRoleProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgProposalUpsertDataRegistry) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*CreateRoleProposal)
+	if !ok {
+		that2, ok := that.(CreateRoleProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if this.Role != that1.Role {
+		return false
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(this.WhitelistedPermissions) != len(that1.WhitelistedPermissions) {
+		return false
 	}
-	l = len(m.Reference)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	for i := range this.WhitelistedPermissions {
+		if this.WhitelistedPermissions[i] != that1.WhitelistedPermissions[i] {
+			return false
+		}
 	}
-	l = len(m.Encoding)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(this.BlacklistedPermissions) != len(that1.BlacklistedPermissions) {
+		return false
 	}
-	if m.Size_ != 0 {
-		n += 1 + sovProposal(uint64(m.Size_))
+	for i := range this.BlacklistedPermissions {
+		if this.BlacklistedPermissions[i] != that1.BlacklistedPermissions[i] {
+			return false
+		}
 	}
-	return n
+	if this.Sid != that1.Sid {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	return true
 }
+func (this *SetRoleVoteWeightProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgProposalSetPoorNetworkMessages) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*SetRoleVoteWeightProposal)
+	if !ok {
+		that2, ok := that.(SetRoleVoteWeightProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Messages) > 0 {
-		for _, s := range m.Messages {
-			l = len(s)
-			n += 1 + l + sovProposal(uint64(l))
-		}
+	if this.Role != that1.Role {
+		return false
 	}
-	return n
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
 }
+func (this *ParamChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Proposal) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ParamChange)
+	if !ok {
+		that2, ok := that.(ParamChange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovProposal(uint64(m.ProposalId))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Content != nil {
-		l = m.Content.Size()
-		n += 1 + l + sovProposal(uint64(l))
+	if this.Subspace != that1.Subspace {
+		return false
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime)
-	n += 1 + l + sovProposal(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
-	n += 1 + l + sovProposal(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EnactmentEndTime)
-	n += 1 + l + sovProposal(uint64(l))
-	if m.Result != 0 {
-		n += 1 + sovProposal(uint64(m.Result))
+	if this.Key != that1.Key {
+		return false
 	}
-	return n
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
 }
+func (this *ParameterChangeProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *AssignPermissionProposal) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ParameterChangeProposal)
+	if !ok {
+		that2, ok := that.(ParameterChangeProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Permission != 0 {
-		n += 1 + sovProposal(uint64(m.Permission))
+	if len(this.Changes) != len(that1.Changes) {
+		return false
 	}
-	return n
+	for i := range this.Changes {
+		if !this.Changes[i].Equal(&that1.Changes[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *MultiContentProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgProposalSetNetworkProperty) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MultiContentProposal)
+	if !ok {
+		that2, ok := that.(MultiContentProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.NetworkProperty != 0 {
-		n += 1 + sovProposal(uint64(m.NetworkProperty))
+	if len(this.Contents) != len(that1.Contents) {
+		return false
 	}
-	if m.Value != 0 {
-		n += 1 + sovProposal(uint64(m.Value))
+	for i := range this.Contents {
+		if !this.Contents[i].Equal(that1.Contents[i]) {
+			return false
+		}
 	}
-	return n
+	return true
 }
+func (this *SoftwareUpgradeProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SetNetworkPropertyProposal) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*SoftwareUpgradeProposal)
+	if !ok {
+		that2, ok := that.(SoftwareUpgradeProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.NetworkProperty != 0 {
-		n += 1 + sovProposal(uint64(m.NetworkProperty))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Value != 0 {
-		n += 1 + sovProposal(uint64(m.Value))
+	if !this.Plan.Equal(&that1.Plan) {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *UpsertDataRegistryProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *CancelSoftwareUpgradeProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CancelSoftwareUpgradeProposal)
+	if !ok {
+		that2, ok := that.(CancelSoftwareUpgradeProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *RemoveCouncilorProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RemoveCouncilorProposal)
+	if !ok {
+		that2, ok := that.(RemoveCouncilorProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	return true
+}
+func (m *Vote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err1 != nil {
+		return 0, err1
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	i -= n1
+	i = encodeVarintProposal(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Reference)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.Encoding)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if m.Option != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Size_ != 0 {
-		n += 1 + sovProposal(uint64(m.Size_))
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.ProposalId != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SetPoorNetworkMessagesProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Messages) > 0 {
-		for _, s := range m.Messages {
-			l = len(s)
-			n += 1 + l + sovProposal(uint64(l))
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Option != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Option != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposalAssignPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposalAssignPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposalAssignPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Permission != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposalUpsertDataRegistry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposalUpsertDataRegistry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposalUpsertDataRegistry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Encoding) > 0 {
+		i -= len(m.Encoding)
+		copy(dAtA[i:], m.Encoding)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Encoding)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposalSetPoorNetworkMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposalSetPoorNetworkMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposalSetPoorNetworkMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Messages[iNdEx])
+			copy(dAtA[i:], m.Messages[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.Messages[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	{
+		size, err := m.TallyResult.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.WeightedTally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.Result != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x40
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EnactmentEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EnactmentEndTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintProposal(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x32
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintProposal(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintProposal(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	if m.Content != nil {
+		{
+			size, err := m.Content.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProposal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Deposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TallyResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Quorum != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Quorum))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TotalVoters != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.TotalVoters))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NoWithVeto != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.NoWithVeto))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.No != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.No))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Abstain != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Abstain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Yes != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Yes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightedTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedTally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NoWithVeto.Size()
+		i -= size
+		if _, err := m.NoWithVeto.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.No.Size()
+		i -= size
+		if _, err := m.No.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Abstain.Size()
+		i -= size
+		if _, err := m.Abstain.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Yes.Size()
+		i -= size
+		if _, err := m.Yes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AssignPermissionProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignPermissionProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssignPermissionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Permission != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposalSetNetworkProperty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposalSetNetworkProperty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposalSetNetworkProperty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NetworkProperty != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.NetworkProperty))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetNetworkPropertyProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetNetworkPropertyProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetNetworkPropertyProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NetworkProperty != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.NetworkProperty))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpsertDataRegistryProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpsertDataRegistryProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpsertDataRegistryProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Encoding) > 0 {
+		i -= len(m.Encoding)
+		copy(dAtA[i:], m.Encoding)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Encoding)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetPoorNetworkMessagesProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetPoorNetworkMessagesProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetPoorNetworkMessagesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Messages[iNdEx])
+			copy(dAtA[i:], m.Messages[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.Messages[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposalCreateRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposalCreateRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposalCreateRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Sid) > 0 {
+		i -= len(m.Sid)
+		copy(dAtA[i:], m.Sid)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Sid)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BlacklistedPermissions) > 0 {
+		dAtA9 := make([]byte, len(m.BlacklistedPermissions)*10)
+		var j8 int
+		for _, num := range m.BlacklistedPermissions {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintProposal(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.WhitelistedPermissions) > 0 {
+		dAtA11 := make([]byte, len(m.WhitelistedPermissions)*10)
+		var j10 int
+		for _, num := range m.WhitelistedPermissions {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintProposal(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Role != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateRoleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateRoleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateRoleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Sid) > 0 {
+		i -= len(m.Sid)
+		copy(dAtA[i:], m.Sid)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Sid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BlacklistedPermissions) > 0 {
+		dAtA13 := make([]byte, len(m.BlacklistedPermissions)*10)
+		var j12 int
+		for _, num := range m.BlacklistedPermissions {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintProposal(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WhitelistedPermissions) > 0 {
+		dAtA15 := make([]byte, len(m.WhitelistedPermissions)*10)
+		var j14 int
+		for _, num := range m.WhitelistedPermissions {
+			for num >= 1<<7 {
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j14++
+			}
+			dAtA15[j14] = uint8(num)
+			j14++
+		}
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintProposal(dAtA, i, uint64(j14))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposalSetRoleVoteWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposalSetRoleVoteWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposalSetRoleVoteWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Role != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetRoleVoteWeightProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetRoleVoteWeightProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetRoleVoteWeightProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Role != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subspace) > 0 {
+		i -= len(m.Subspace)
+		copy(dAtA[i:], m.Subspace)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Subspace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposalParameterChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposalParameterChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposalParameterChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParameterChangeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParameterChangeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParameterChangeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposalMultiContent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposalMultiContent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposalMultiContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contents) > 0 {
+		for iNdEx := len(m.Contents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiContentProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiContentProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiContentProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contents) > 0 {
+		for iNdEx := len(m.Contents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposalSoftwareUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposalSoftwareUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposalSoftwareUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SoftwareUpgradeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SoftwareUpgradeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SoftwareUpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposalCancelSoftwareUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposalCancelSoftwareUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposalCancelSoftwareUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelSoftwareUpgradeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelSoftwareUpgradeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelSoftwareUpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposalRemoveCouncilor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposalRemoveCouncilor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposalRemoveCouncilor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveCouncilorProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveCouncilorProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveCouncilorProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Vote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovProposal(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.Option != 0 {
+		n += 1 + sovProposal(uint64(m.Option))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime)
+	n += 1 + l + sovProposal(uint64(l))
+	return n
+}
+
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovProposal(uint64(m.Option))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	return n
+}
+
+func (m *MsgVoteProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovProposal(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.Option != 0 {
+		n += 1 + sovProposal(uint64(m.Option))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovProposal(uint64(m.ProposalId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProposalAssignPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.Permission != 0 {
+		n += 1 + sovProposal(uint64(m.Permission))
+	}
+	return n
+}
+
+func (m *MsgProposalUpsertDataRegistry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Encoding)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovProposal(uint64(m.Size_))
+	}
+	return n
+}
+
+func (m *MsgProposalSetPoorNetworkMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, s := range m.Messages {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovProposal(uint64(m.ProposalId))
+	}
+	if m.Content != nil {
+		l = m.Content.Size()
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime)
+	n += 1 + l + sovProposal(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
+	n += 1 + l + sovProposal(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EnactmentEndTime)
+	n += 1 + l + sovProposal(uint64(l))
+	if m.Result != 0 {
+		n += 1 + sovProposal(uint64(m.Result))
+	}
+	l = m.WeightedTally.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	l = m.TallyResult.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *Deposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovProposal(uint64(m.ProposalId))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TallyResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Yes != 0 {
+		n += 1 + sovProposal(uint64(m.Yes))
+	}
+	if m.Abstain != 0 {
+		n += 1 + sovProposal(uint64(m.Abstain))
+	}
+	if m.No != 0 {
+		n += 1 + sovProposal(uint64(m.No))
+	}
+	if m.NoWithVeto != 0 {
+		n += 1 + sovProposal(uint64(m.NoWithVeto))
+	}
+	if m.TotalVoters != 0 {
+		n += 1 + sovProposal(uint64(m.TotalVoters))
+	}
+	if m.Quorum != 0 {
+		n += 1 + sovProposal(uint64(m.Quorum))
+	}
+	return n
+}
+
+func (m *WeightedTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Yes.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	l = m.Abstain.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	l = m.No.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	l = m.NoWithVeto.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	return n
+}
+
+func (m *AssignPermissionProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.Permission != 0 {
+		n += 1 + sovProposal(uint64(m.Permission))
+	}
+	return n
+}
+
+func (m *MsgProposalSetNetworkProperty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.NetworkProperty != 0 {
+		n += 1 + sovProposal(uint64(m.NetworkProperty))
+	}
+	if m.Value != 0 {
+		n += 1 + sovProposal(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *SetNetworkPropertyProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NetworkProperty != 0 {
+		n += 1 + sovProposal(uint64(m.NetworkProperty))
+	}
+	if m.Value != 0 {
+		n += 1 + sovProposal(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *UpsertDataRegistryProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Encoding)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovProposal(uint64(m.Size_))
+	}
+	return n
+}
+
+func (m *SetPoorNetworkMessagesProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, s := range m.Messages {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgProposalCreateRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovProposal(uint64(m.Role))
+	}
+	if len(m.WhitelistedPermissions) > 0 {
+		l = 0
+		for _, e := range m.WhitelistedPermissions {
+			l += sovProposal(uint64(e))
+		}
+		n += 1 + sovProposal(uint64(l)) + l
+	}
+	if len(m.BlacklistedPermissions) > 0 {
+		l = 0
+		for _, e := range m.BlacklistedPermissions {
+			l += sovProposal(uint64(e))
+		}
+		n += 1 + sovProposal(uint64(l)) + l
+	}
+	l = len(m.Sid)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateRoleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovProposal(uint64(m.Role))
+	}
+	if len(m.WhitelistedPermissions) > 0 {
+		l = 0
+		for _, e := range m.WhitelistedPermissions {
+			l += sovProposal(uint64(e))
+		}
+		n += 1 + sovProposal(uint64(l)) + l
+	}
+	if len(m.BlacklistedPermissions) > 0 {
+		l = 0
+		for _, e := range m.BlacklistedPermissions {
+			l += sovProposal(uint64(e))
+		}
+		n += 1 + sovProposal(uint64(l)) + l
+	}
+	l = len(m.Sid)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProposalSetRoleVoteWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovProposal(uint64(m.Role))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	return n
+}
+
+func (m *SetRoleVoteWeightProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovProposal(uint64(m.Role))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	return n
+}
+
+func (m *ParamChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subspace)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovProposal(uint64(m.Type))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProposalParameterChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParameterChangeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgProposalMultiContent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Contents) > 0 {
+		for _, e := range m.Contents {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultiContentProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contents) > 0 {
+		for _, e := range m.Contents {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgProposalSoftwareUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = m.Plan.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	return n
+}
+
+func (m *SoftwareUpgradeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Plan.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	return n
+}
+
+func (m *MsgProposalCancelSoftwareUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *CancelSoftwareUpgradeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProposalRemoveCouncilor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveCouncilorProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func sovProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposal(x uint64) (n int) {
+	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Vote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposalAssignPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposalAssignPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposalAssignPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposalUpsertDataRegistry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposalUpsertDataRegistry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposalUpsertDataRegistry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposalSetPoorNetworkMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposalSetPoorNetworkMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposalSetPoorNetworkMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Content == nil {
+				m.Content = &types.Any{}
+			}
+			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnactmentEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EnactmentEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= VoteResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedTally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightedTally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Depositor == nil {
+				m.Depositor = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Yes", wireType)
+			}
+			m.Yes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Yes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abstain", wireType)
+			}
+			m.Abstain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Abstain |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field No", wireType)
+			}
+			m.No = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.No |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVeto", wireType)
+			}
+			m.NoWithVeto = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NoWithVeto |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVoters", wireType)
+			}
+			m.TotalVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVoters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			m.Quorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quorum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedTally) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedTally: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedTally: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Yes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Yes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abstain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Abstain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field No", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.No.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVeto", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NoWithVeto.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssignPermissionProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssignPermissionProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssignPermissionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposalSetNetworkProperty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposalSetNetworkProperty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposalSetNetworkProperty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkProperty", wireType)
+			}
+			m.NetworkProperty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetworkProperty |= NetworkProperty(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgProposalCreateRole) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
-	}
-	if m.Role != 0 {
-		n += 1 + sovProposal(uint64(m.Role))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.WhitelistedPermissions) > 0 {
-		l = 0
-		for _, e := range m.WhitelistedPermissions {
-			l += sovProposal(uint64(e))
+	return nil
+}
+func (m *SetNetworkPropertyProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-		n += 1 + sovProposal(uint64(l)) + l
-	}
-	if len(m.BlacklistedPermissions) > 0 {
-		l = 0
-		for _, e := range m.BlacklistedPermissions {
-			l += sovProposal(uint64(e))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetNetworkPropertyProposal: wiretype end group for non-group")
 		}
-		n += 1 + sovProposal(uint64(l)) + l
-	}
-	return n
-}
-
-func (m *CreateRoleProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Role != 0 {
-		n += 1 + sovProposal(uint64(m.Role))
-	}
-	if len(m.WhitelistedPermissions) > 0 {
-		l = 0
-		for _, e := range m.WhitelistedPermissions {
-			l += sovProposal(uint64(e))
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetNetworkPropertyProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-		n += 1 + sovProposal(uint64(l)) + l
-	}
-	if len(m.BlacklistedPermissions) > 0 {
-		l = 0
-		for _, e := range m.BlacklistedPermissions {
-			l += sovProposal(uint64(e))
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkProperty", wireType)
+			}
+			m.NetworkProperty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetworkProperty |= NetworkProperty(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		n += 1 + sovProposal(uint64(l)) + l
 	}
-	return n
-}
 
-func sovProposal(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozProposal(x uint64) (n int) {
-	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Vote) Unmarshal(dAtA []byte) error {
+func (m *UpsertDataRegistryProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2062,17 +6900,17 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpsertDataRegistryProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpsertDataRegistryProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.ProposalId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -2082,16 +6920,29 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -2101,31 +6952,93 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposal
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Voter == nil {
-				m.Voter = []byte{}
-			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
-			m.Option = 0
+			m.Size_ = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -2135,7 +7048,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
+				m.Size_ |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2164,7 +7077,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteProposal) Unmarshal(dAtA []byte) error {
+func (m *SetPoorNetworkMessagesProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2187,36 +7100,17 @@ func (m *MsgVoteProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetPoorNetworkMessagesProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetPoorNetworkMessagesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -2226,45 +7120,24 @@ func (m *MsgVoteProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposal
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Voter == nil {
-				m.Voter = []byte{}
-			}
+			m.Messages = append(m.Messages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
-			}
-			m.Option = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -2289,7 +7162,7 @@ func (m *MsgVoteProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProposalAssignPermission) Unmarshal(dAtA []byte) error {
+func (m *MsgProposalCreateRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2312,17 +7185,208 @@ func (m *MsgProposalAssignPermission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposalAssignPermission: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposalCreateRole: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposalAssignPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposalCreateRole: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v PermValue
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProposal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PermValue(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.WhitelistedPermissions = append(m.WhitelistedPermissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProposal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProposal
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProposal
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.WhitelistedPermissions) == 0 {
+					m.WhitelistedPermissions = make([]PermValue, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PermValue
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProposal
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PermValue(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.WhitelistedPermissions = append(m.WhitelistedPermissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedPermissions", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v PermValue
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProposal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PermValue(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BlacklistedPermissions = append(m.BlacklistedPermissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProposal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProposal
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProposal
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.BlacklistedPermissions) == 0 {
+					m.BlacklistedPermissions = make([]PermValue, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PermValue
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProposal
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PermValue(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BlacklistedPermissions = append(m.BlacklistedPermissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistedPermissions", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sid", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -2332,31 +7396,29 @@ func (m *MsgProposalAssignPermission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposal
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Proposer == nil {
-				m.Proposer = []byte{}
-			}
+			m.Sid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -2366,45 +7428,24 @@ func (m *MsgProposalAssignPermission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposal
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
-			}
-			m.Permission = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Permission |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -2429,7 +7470,7 @@ func (m *MsgProposalAssignPermission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProposalUpsertDataRegistry) Unmarshal(dAtA []byte) error {
+func (m *CreateRoleProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2452,17 +7493,174 @@ func (m *MsgProposalUpsertDataRegistry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposalUpsertDataRegistry: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateRoleProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposalUpsertDataRegistry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateRoleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v PermValue
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProposal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PermValue(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.WhitelistedPermissions = append(m.WhitelistedPermissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProposal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProposal
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProposal
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.WhitelistedPermissions) == 0 {
+					m.WhitelistedPermissions = make([]PermValue, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PermValue
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProposal
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PermValue(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.WhitelistedPermissions = append(m.WhitelistedPermissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedPermissions", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v PermValue
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProposal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PermValue(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BlacklistedPermissions = append(m.BlacklistedPermissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProposal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProposal
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProposal
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.BlacklistedPermissions) == 0 {
+					m.BlacklistedPermissions = make([]PermValue, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PermValue
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProposal
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PermValue(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BlacklistedPermissions = append(m.BlacklistedPermissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistedPermissions", wireType)
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sid", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -2472,29 +7670,27 @@ func (m *MsgProposalUpsertDataRegistry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposal
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Proposer == nil {
-				m.Proposer = []byte{}
-			}
+			m.Sid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2515,20 +7711,73 @@ func (m *MsgProposalUpsertDataRegistry) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthProposal
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposal
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposalSetRoleVoteWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposalSetRoleVoteWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposalSetRoleVoteWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -2538,29 +7787,31 @@ func (m *MsgProposalUpsertDataRegistry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProposal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var stringLen uint64
+			m.Role = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -2570,27 +7821,14 @@ func (m *MsgProposalUpsertDataRegistry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Role |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProposal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reference = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encoding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2618,27 +7856,10 @@ func (m *MsgProposalUpsertDataRegistry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Encoding = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
-			}
-			m.Size_ = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Size_ |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -2663,7 +7884,7 @@ func (m *MsgProposalUpsertDataRegistry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProposalSetPoorNetworkMessages) Unmarshal(dAtA []byte) error {
+func (m *SetRoleVoteWeightProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2686,17 +7907,17 @@ func (m *MsgProposalSetPoorNetworkMessages) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposalSetPoorNetworkMessages: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetRoleVoteWeightProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposalSetPoorNetworkMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetRoleVoteWeightProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var byteLen int
+			m.Role = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -2706,29 +7927,14 @@ func (m *MsgProposalSetPoorNetworkMessages) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Role |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthProposal
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Proposer == nil {
-				m.Proposer = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2756,7 +7962,9 @@ func (m *MsgProposalSetPoorNetworkMessages) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, string(dAtA[iNdEx:postIndex]))
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2782,7 +7990,7 @@ func (m *MsgProposalSetPoorNetworkMessages) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *ParamChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2805,17 +8013,17 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParamChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParamChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
 			}
-			m.ProposalId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -2825,16 +8033,29 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -2844,33 +8065,48 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Content == nil {
-				m.Content = &types.Any{}
-			}
-			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ParamValueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -2880,30 +8116,82 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if skippy < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposalParameterChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposalParameterChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposalParameterChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -2913,28 +8201,29 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProposal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnactmentEndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2961,29 +8250,11 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EnactmentEndTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Changes = append(m.Changes, ParamChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			m.Result = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Result |= VoteResult(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -3008,7 +8279,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssignPermissionProposal) Unmarshal(dAtA []byte) error {
+func (m *ParameterChangeProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3031,17 +8302,17 @@ func (m *AssignPermissionProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssignPermissionProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParameterChangeProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssignPermissionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParameterChangeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -3051,45 +8322,26 @@ func (m *AssignPermissionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProposal
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			m.Changes = append(m.Changes, ParamChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
-			}
-			m.Permission = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Permission |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -3114,7 +8366,7 @@ func (m *AssignPermissionProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProposalSetNetworkProperty) Unmarshal(dAtA []byte) error {
+func (m *MsgProposalMultiContent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3137,10 +8389,10 @@ func (m *MsgProposalSetNetworkProperty) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposalSetNetworkProperty: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposalMultiContent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposalSetNetworkProperty: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposalMultiContent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3178,10 +8430,10 @@ func (m *MsgProposalSetNetworkProperty) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkProperty", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contents", wireType)
 			}
-			m.NetworkProperty = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -3191,30 +8443,26 @@ func (m *MsgProposalSetNetworkProperty) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NetworkProperty |= NetworkProperty(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
 			}
-			m.Value = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Value |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contents = append(m.Contents, &types.Any{})
+			if err := m.Contents[len(m.Contents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -3239,7 +8487,7 @@ func (m *MsgProposalSetNetworkProperty) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetNetworkPropertyProposal) Unmarshal(dAtA []byte) error {
+func (m *MultiContentProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3262,17 +8510,17 @@ func (m *SetNetworkPropertyProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetNetworkPropertyProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiContentProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetNetworkPropertyProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiContentProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkProperty", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contents", wireType)
 			}
-			m.NetworkProperty = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -3282,30 +8530,26 @@ func (m *SetNetworkPropertyProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NetworkProperty |= NetworkProperty(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
 			}
-			m.Value = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Value |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Contents = append(m.Contents, &types.Any{})
+			if err := m.Contents[len(m.Contents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -3330,7 +8574,7 @@ func (m *SetNetworkPropertyProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpsertDataRegistryProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgProposalSoftwareUpgrade) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3353,113 +8597,17 @@ func (m *UpsertDataRegistryProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpsertDataRegistryProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposalSoftwareUpgrade: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpsertDataRegistryProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposalSoftwareUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProposal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProposal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProposal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reference = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encoding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -3469,29 +8617,31 @@ func (m *UpsertDataRegistryProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProposal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Encoding = string(dAtA[iNdEx:postIndex])
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
 			}
-			m.Size_ = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -3501,11 +8651,25 @@ func (m *UpsertDataRegistryProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Size_ |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -3530,7 +8694,7 @@ func (m *UpsertDataRegistryProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetPoorNetworkMessagesProposal) Unmarshal(dAtA []byte) error {
+func (m *SoftwareUpgradeProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3553,17 +8717,17 @@ func (m *SetPoorNetworkMessagesProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetPoorNetworkMessagesProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SoftwareUpgradeProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetPoorNetworkMessagesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SoftwareUpgradeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -3573,23 +8737,24 @@ func (m *SetPoorNetworkMessagesProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProposal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, string(dAtA[iNdEx:postIndex]))
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3615,7 +8780,7 @@ func (m *SetPoorNetworkMessagesProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProposalCreateRole) Unmarshal(dAtA []byte) error {
+func (m *MsgProposalCancelSoftwareUpgrade) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3638,10 +8803,10 @@ func (m *MsgProposalCreateRole) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposalCreateRole: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposalCancelSoftwareUpgrade: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposalCreateRole: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposalCancelSoftwareUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3678,163 +8843,180 @@ func (m *MsgProposalCreateRole) Unmarshal(dAtA []byte) error {
 				m.Proposer = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Role = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Role |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthProposal
 			}
-		case 3:
-			if wireType == 0 {
-				var v PermValue
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProposal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= PermValue(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.WhitelistedPermissions = append(m.WhitelistedPermissions, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProposal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthProposal
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthProposal
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.WhitelistedPermissions) == 0 {
-					m.WhitelistedPermissions = make([]PermValue, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v PermValue
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowProposal
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= PermValue(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.WhitelistedPermissions = append(m.WhitelistedPermissions, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedPermissions", wireType)
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProposal
 			}
-		case 4:
-			if wireType == 0 {
-				var v PermValue
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProposal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= PermValue(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.BlacklistedPermissions = append(m.BlacklistedPermissions, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProposal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelSoftwareUpgradeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelSoftwareUpgradeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelSoftwareUpgradeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposalRemoveCouncilor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposalRemoveCouncilor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposalRemoveCouncilor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthProposal
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthProposal
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.BlacklistedPermissions) == 0 {
-					m.BlacklistedPermissions = make([]PermValue, 0, elementCount)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v PermValue
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowProposal
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= PermValue(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.BlacklistedPermissions = append(m.BlacklistedPermissions, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistedPermissions", wireType)
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -3859,7 +9041,7 @@ func (m *MsgProposalCreateRole) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateRoleProposal) Unmarshal(dAtA []byte) error {
+func (m *RemoveCouncilorProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3882,17 +9064,17 @@ func (m *CreateRoleProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRoleProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveCouncilorProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRoleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveCouncilorProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Role = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -3902,149 +9084,26 @@ func (m *CreateRoleProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType == 0 {
-				var v PermValue
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProposal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= PermValue(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.WhitelistedPermissions = append(m.WhitelistedPermissions, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProposal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthProposal
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthProposal
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.WhitelistedPermissions) == 0 {
-					m.WhitelistedPermissions = make([]PermValue, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v PermValue
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowProposal
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= PermValue(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.WhitelistedPermissions = append(m.WhitelistedPermissions, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedPermissions", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
 			}
-		case 3:
-			if wireType == 0 {
-				var v PermValue
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProposal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= PermValue(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.BlacklistedPermissions = append(m.BlacklistedPermissions, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProposal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthProposal
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthProposal
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.BlacklistedPermissions) == 0 {
-					m.BlacklistedPermissions = make([]PermValue, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v PermValue
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowProposal
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= PermValue(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.BlacklistedPermissions = append(m.BlacklistedPermissions, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistedPermissions", wireType)
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -23,3 +23,18 @@ func TestNewProposal_ProposalIsPendingByDefault(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, Pending, proposal.Result)
 }
+
+func TestMultiContentProposal_VotePermissions(t *testing.T) {
+	content, err := NewMultiContentProposal([]Content{
+		NewAssignPermissionProposal(types.AccAddress{0x12}, PermSetPermissions),
+		NewSetRoleVoteWeightProposal(RoleValidator, types.NewDec(2)),
+		NewAssignPermissionProposal(types.AccAddress{0x13}, PermClaimValidator),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, content.(*MultiContentProposal).UnpackedContents(), 3)
+	require.Equal(t, PermVoteSetPermissionProposal, content.VotePermission())
+	require.Equal(t, []PermValue{PermVoteSetPermissionProposal, PermVoteSetRoleVoteWeightProposal}, VotePermissions(content))
+
+	require.Equal(t, []PermValue{PermVoteSetRoleVoteWeightProposal}, VotePermissions(NewSetRoleVoteWeightProposal(RoleValidator, types.NewDec(2))))
+}
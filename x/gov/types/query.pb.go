@@ -375,22 +375,22 @@ func (m *RolePermissionsResponse) GetPermissions() *Permissions {
 	return nil
 }
 
-type ExecutionFeeRequest struct {
-	TransactionType string `protobuf:"bytes,1,opt,name=transaction_type,json=transactionType,proto3" json:"transaction_type,omitempty"`
+type PermissionGrantsRequest struct {
+	Address github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty" yaml:"address"`
 }
 
-func (m *ExecutionFeeRequest) Reset()         { *m = ExecutionFeeRequest{} }
-func (m *ExecutionFeeRequest) String() string { return proto.CompactTextString(m) }
-func (*ExecutionFeeRequest) ProtoMessage()    {}
-func (*ExecutionFeeRequest) Descriptor() ([]byte, []int) {
+func (m *PermissionGrantsRequest) Reset()         { *m = PermissionGrantsRequest{} }
+func (m *PermissionGrantsRequest) String() string { return proto.CompactTextString(m) }
+func (*PermissionGrantsRequest) ProtoMessage()    {}
+func (*PermissionGrantsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{8}
 }
-func (m *ExecutionFeeRequest) XXX_Unmarshal(b []byte) error {
+func (m *PermissionGrantsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ExecutionFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PermissionGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ExecutionFeeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PermissionGrantsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -400,41 +400,42 @@ func (m *ExecutionFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ExecutionFeeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExecutionFeeRequest.Merge(m, src)
+func (m *PermissionGrantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionGrantsRequest.Merge(m, src)
 }
-func (m *ExecutionFeeRequest) XXX_Size() int {
+func (m *PermissionGrantsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ExecutionFeeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExecutionFeeRequest.DiscardUnknown(m)
+func (m *PermissionGrantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionGrantsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExecutionFeeRequest proto.InternalMessageInfo
+var xxx_messageInfo_PermissionGrantsRequest proto.InternalMessageInfo
 
-func (m *ExecutionFeeRequest) GetTransactionType() string {
+func (m *PermissionGrantsRequest) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.TransactionType
+		return m.Address
 	}
-	return ""
+	return nil
 }
 
-type ExecutionFeeResponse struct {
-	Fee *ExecutionFee `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee,omitempty"`
+type PermissionGrantsResponse struct {
+	Grants   []PermissionGrant  `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants"`
+	Expiries []PermissionExpiry `protobuf:"bytes,2,rep,name=expiries,proto3" json:"expiries"`
 }
 
-func (m *ExecutionFeeResponse) Reset()         { *m = ExecutionFeeResponse{} }
-func (m *ExecutionFeeResponse) String() string { return proto.CompactTextString(m) }
-func (*ExecutionFeeResponse) ProtoMessage()    {}
-func (*ExecutionFeeResponse) Descriptor() ([]byte, []int) {
+func (m *PermissionGrantsResponse) Reset()         { *m = PermissionGrantsResponse{} }
+func (m *PermissionGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*PermissionGrantsResponse) ProtoMessage()    {}
+func (*PermissionGrantsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{9}
 }
-func (m *ExecutionFeeResponse) XXX_Unmarshal(b []byte) error {
+func (m *PermissionGrantsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ExecutionFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PermissionGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ExecutionFeeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PermissionGrantsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -444,40 +445,49 @@ func (m *ExecutionFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *ExecutionFeeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExecutionFeeResponse.Merge(m, src)
+func (m *PermissionGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionGrantsResponse.Merge(m, src)
 }
-func (m *ExecutionFeeResponse) XXX_Size() int {
+func (m *PermissionGrantsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ExecutionFeeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExecutionFeeResponse.DiscardUnknown(m)
+func (m *PermissionGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionGrantsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExecutionFeeResponse proto.InternalMessageInfo
+var xxx_messageInfo_PermissionGrantsResponse proto.InternalMessageInfo
 
-func (m *ExecutionFeeResponse) GetFee() *ExecutionFee {
+func (m *PermissionGrantsResponse) GetGrants() []PermissionGrant {
 	if m != nil {
-		return m.Fee
+		return m.Grants
 	}
 	return nil
 }
 
-type PoorNetworkMessagesRequest struct {
+func (m *PermissionGrantsResponse) GetExpiries() []PermissionExpiry {
+	if m != nil {
+		return m.Expiries
+	}
+	return nil
 }
 
-func (m *PoorNetworkMessagesRequest) Reset()         { *m = PoorNetworkMessagesRequest{} }
-func (m *PoorNetworkMessagesRequest) String() string { return proto.CompactTextString(m) }
-func (*PoorNetworkMessagesRequest) ProtoMessage()    {}
-func (*PoorNetworkMessagesRequest) Descriptor() ([]byte, []int) {
+type AuthorizationsRequest struct {
+	Granter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=granter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"granter,omitempty" yaml:"granter"`
+	Grantee github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=grantee,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"grantee,omitempty" yaml:"grantee"`
+}
+
+func (m *AuthorizationsRequest) Reset()         { *m = AuthorizationsRequest{} }
+func (m *AuthorizationsRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthorizationsRequest) ProtoMessage()    {}
+func (*AuthorizationsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{10}
 }
-func (m *PoorNetworkMessagesRequest) XXX_Unmarshal(b []byte) error {
+func (m *AuthorizationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PoorNetworkMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AuthorizationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PoorNetworkMessagesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AuthorizationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -487,34 +497,48 @@ func (m *PoorNetworkMessagesRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *PoorNetworkMessagesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PoorNetworkMessagesRequest.Merge(m, src)
+func (m *AuthorizationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizationsRequest.Merge(m, src)
 }
-func (m *PoorNetworkMessagesRequest) XXX_Size() int {
+func (m *AuthorizationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *PoorNetworkMessagesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PoorNetworkMessagesRequest.DiscardUnknown(m)
+func (m *AuthorizationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PoorNetworkMessagesRequest proto.InternalMessageInfo
+var xxx_messageInfo_AuthorizationsRequest proto.InternalMessageInfo
 
-type PoorNetworkMessagesResponse struct {
-	Messages []string `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+func (m *AuthorizationsRequest) GetGranter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Granter
+	}
+	return nil
 }
 
-func (m *PoorNetworkMessagesResponse) Reset()         { *m = PoorNetworkMessagesResponse{} }
-func (m *PoorNetworkMessagesResponse) String() string { return proto.CompactTextString(m) }
-func (*PoorNetworkMessagesResponse) ProtoMessage()    {}
-func (*PoorNetworkMessagesResponse) Descriptor() ([]byte, []int) {
+func (m *AuthorizationsRequest) GetGrantee() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Grantee
+	}
+	return nil
+}
+
+type AuthorizationsResponse struct {
+	Authorizations []Authorization `protobuf:"bytes,1,rep,name=authorizations,proto3" json:"authorizations"`
+}
+
+func (m *AuthorizationsResponse) Reset()         { *m = AuthorizationsResponse{} }
+func (m *AuthorizationsResponse) String() string { return proto.CompactTextString(m) }
+func (*AuthorizationsResponse) ProtoMessage()    {}
+func (*AuthorizationsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{11}
 }
-func (m *PoorNetworkMessagesResponse) XXX_Unmarshal(b []byte) error {
+func (m *AuthorizationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PoorNetworkMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AuthorizationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PoorNetworkMessagesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AuthorizationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -524,41 +548,42 @@ func (m *PoorNetworkMessagesResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *PoorNetworkMessagesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PoorNetworkMessagesResponse.Merge(m, src)
+func (m *AuthorizationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizationsResponse.Merge(m, src)
 }
-func (m *PoorNetworkMessagesResponse) XXX_Size() int {
+func (m *AuthorizationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *PoorNetworkMessagesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PoorNetworkMessagesResponse.DiscardUnknown(m)
+func (m *AuthorizationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PoorNetworkMessagesResponse proto.InternalMessageInfo
+var xxx_messageInfo_AuthorizationsResponse proto.InternalMessageInfo
 
-func (m *PoorNetworkMessagesResponse) GetMessages() []string {
+func (m *AuthorizationsResponse) GetAuthorizations() []Authorization {
 	if m != nil {
-		return m.Messages
+		return m.Authorizations
 	}
 	return nil
 }
 
-type CouncilorByAddressRequest struct {
-	ValAddr github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=val_addr,json=valAddr,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"val_addr,omitempty" yaml:"val_addr"`
+type GroupRequest struct {
+	GroupId uint64                                        `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Address github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty" yaml:"address"`
 }
 
-func (m *CouncilorByAddressRequest) Reset()         { *m = CouncilorByAddressRequest{} }
-func (m *CouncilorByAddressRequest) String() string { return proto.CompactTextString(m) }
-func (*CouncilorByAddressRequest) ProtoMessage()    {}
-func (*CouncilorByAddressRequest) Descriptor() ([]byte, []int) {
+func (m *GroupRequest) Reset()         { *m = GroupRequest{} }
+func (m *GroupRequest) String() string { return proto.CompactTextString(m) }
+func (*GroupRequest) ProtoMessage()    {}
+func (*GroupRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{12}
 }
-func (m *CouncilorByAddressRequest) XXX_Unmarshal(b []byte) error {
+func (m *GroupRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CouncilorByAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CouncilorByAddressRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GroupRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -568,41 +593,48 @@ func (m *CouncilorByAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *CouncilorByAddressRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CouncilorByAddressRequest.Merge(m, src)
+func (m *GroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupRequest.Merge(m, src)
 }
-func (m *CouncilorByAddressRequest) XXX_Size() int {
+func (m *GroupRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CouncilorByAddressRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CouncilorByAddressRequest.DiscardUnknown(m)
+func (m *GroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CouncilorByAddressRequest proto.InternalMessageInfo
+var xxx_messageInfo_GroupRequest proto.InternalMessageInfo
 
-func (m *CouncilorByAddressRequest) GetValAddr() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *GroupRequest) GetGroupId() uint64 {
 	if m != nil {
-		return m.ValAddr
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *GroupRequest) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Address
 	}
 	return nil
 }
 
-type CouncilorByMonikerRequest struct {
-	Moniker string `protobuf:"bytes,1,opt,name=moniker,proto3" json:"moniker,omitempty"`
+type GroupResponse struct {
+	Group Group `protobuf:"bytes,1,opt,name=group,proto3" json:"group"`
 }
 
-func (m *CouncilorByMonikerRequest) Reset()         { *m = CouncilorByMonikerRequest{} }
-func (m *CouncilorByMonikerRequest) String() string { return proto.CompactTextString(m) }
-func (*CouncilorByMonikerRequest) ProtoMessage()    {}
-func (*CouncilorByMonikerRequest) Descriptor() ([]byte, []int) {
+func (m *GroupResponse) Reset()         { *m = GroupResponse{} }
+func (m *GroupResponse) String() string { return proto.CompactTextString(m) }
+func (*GroupResponse) ProtoMessage()    {}
+func (*GroupResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{13}
 }
-func (m *CouncilorByMonikerRequest) XXX_Unmarshal(b []byte) error {
+func (m *GroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CouncilorByMonikerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CouncilorByMonikerRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GroupResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -612,41 +644,41 @@ func (m *CouncilorByMonikerRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *CouncilorByMonikerRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CouncilorByMonikerRequest.Merge(m, src)
+func (m *GroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupResponse.Merge(m, src)
 }
-func (m *CouncilorByMonikerRequest) XXX_Size() int {
+func (m *GroupResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *CouncilorByMonikerRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CouncilorByMonikerRequest.DiscardUnknown(m)
+func (m *GroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CouncilorByMonikerRequest proto.InternalMessageInfo
+var xxx_messageInfo_GroupResponse proto.InternalMessageInfo
 
-func (m *CouncilorByMonikerRequest) GetMoniker() string {
+func (m *GroupResponse) GetGroup() Group {
 	if m != nil {
-		return m.Moniker
+		return m.Group
 	}
-	return ""
+	return Group{}
 }
 
-type CouncilorResponse struct {
-	Councilor Councilor `protobuf:"bytes,1,opt,name=councilor,proto3" json:"councilor"`
+type GroupProposalsRequest struct {
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 }
 
-func (m *CouncilorResponse) Reset()         { *m = CouncilorResponse{} }
-func (m *CouncilorResponse) String() string { return proto.CompactTextString(m) }
-func (*CouncilorResponse) ProtoMessage()    {}
-func (*CouncilorResponse) Descriptor() ([]byte, []int) {
+func (m *GroupProposalsRequest) Reset()         { *m = GroupProposalsRequest{} }
+func (m *GroupProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*GroupProposalsRequest) ProtoMessage()    {}
+func (*GroupProposalsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{14}
 }
-func (m *CouncilorResponse) XXX_Unmarshal(b []byte) error {
+func (m *GroupProposalsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CouncilorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GroupProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CouncilorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GroupProposalsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -656,43 +688,41 @@ func (m *CouncilorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *CouncilorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CouncilorResponse.Merge(m, src)
+func (m *GroupProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupProposalsRequest.Merge(m, src)
 }
-func (m *CouncilorResponse) XXX_Size() int {
+func (m *GroupProposalsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CouncilorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CouncilorResponse.DiscardUnknown(m)
+func (m *GroupProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupProposalsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CouncilorResponse proto.InternalMessageInfo
+var xxx_messageInfo_GroupProposalsRequest proto.InternalMessageInfo
 
-func (m *CouncilorResponse) GetCouncilor() Councilor {
+func (m *GroupProposalsRequest) GetGroupId() uint64 {
 	if m != nil {
-		return m.Councilor
+		return m.GroupId
 	}
-	return Councilor{}
+	return 0
 }
 
-// QueryProposalRequest is the request type for the Query/Proposal RPC method.
-type QueryProposalRequest struct {
-	// proposal_id defines the unique id of the proposal.
-	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+type GroupProposalsResponse struct {
+	Proposals []GroupProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
 }
 
-func (m *QueryProposalRequest) Reset()         { *m = QueryProposalRequest{} }
-func (m *QueryProposalRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryProposalRequest) ProtoMessage()    {}
-func (*QueryProposalRequest) Descriptor() ([]byte, []int) {
+func (m *GroupProposalsResponse) Reset()         { *m = GroupProposalsResponse{} }
+func (m *GroupProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*GroupProposalsResponse) ProtoMessage()    {}
+func (*GroupProposalsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{15}
 }
-func (m *QueryProposalRequest) XXX_Unmarshal(b []byte) error {
+func (m *GroupProposalsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GroupProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryProposalRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GroupProposalsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -702,43 +732,43 @@ func (m *QueryProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryProposalRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryProposalRequest.Merge(m, src)
+func (m *GroupProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupProposalsResponse.Merge(m, src)
 }
-func (m *QueryProposalRequest) XXX_Size() int {
+func (m *GroupProposalsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryProposalRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryProposalRequest.DiscardUnknown(m)
+func (m *GroupProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupProposalsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryProposalRequest proto.InternalMessageInfo
+var xxx_messageInfo_GroupProposalsResponse proto.InternalMessageInfo
 
-func (m *QueryProposalRequest) GetProposalId() uint64 {
+func (m *GroupProposalsResponse) GetProposals() []GroupProposal {
 	if m != nil {
-		return m.ProposalId
+		return m.Proposals
 	}
-	return 0
+	return nil
 }
 
-// QueryProposalResponse is the response type for the Query/Proposal RPC method.
-type QueryProposalResponse struct {
-	Proposal Proposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal"`
-	Votes    []Vote   `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes"`
+// RoleDetails is a role of the registry with its permissions.
+type RoleDetails struct {
+	Info        RoleInfo    `protobuf:"bytes,1,opt,name=info,proto3" json:"info"`
+	Permissions Permissions `protobuf:"bytes,2,opt,name=permissions,proto3" json:"permissions"`
 }
 
-func (m *QueryProposalResponse) Reset()         { *m = QueryProposalResponse{} }
-func (m *QueryProposalResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryProposalResponse) ProtoMessage()    {}
-func (*QueryProposalResponse) Descriptor() ([]byte, []int) {
+func (m *RoleDetails) Reset()         { *m = RoleDetails{} }
+func (m *RoleDetails) String() string { return proto.CompactTextString(m) }
+func (*RoleDetails) ProtoMessage()    {}
+func (*RoleDetails) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{16}
 }
-func (m *QueryProposalResponse) XXX_Unmarshal(b []byte) error {
+func (m *RoleDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RoleDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryProposalResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RoleDetails.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -748,50 +778,47 @@ func (m *QueryProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryProposalResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryProposalResponse.Merge(m, src)
+func (m *RoleDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleDetails.Merge(m, src)
 }
-func (m *QueryProposalResponse) XXX_Size() int {
+func (m *RoleDetails) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryProposalResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryProposalResponse.DiscardUnknown(m)
+func (m *RoleDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleDetails.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryProposalResponse proto.InternalMessageInfo
+var xxx_messageInfo_RoleDetails proto.InternalMessageInfo
 
-func (m *QueryProposalResponse) GetProposal() Proposal {
+func (m *RoleDetails) GetInfo() RoleInfo {
 	if m != nil {
-		return m.Proposal
+		return m.Info
 	}
-	return Proposal{}
+	return RoleInfo{}
 }
 
-func (m *QueryProposalResponse) GetVotes() []Vote {
+func (m *RoleDetails) GetPermissions() Permissions {
 	if m != nil {
-		return m.Votes
+		return m.Permissions
 	}
-	return nil
+	return Permissions{}
 }
 
-// QueryProposalsRequest is the request type for the Query/Proposals RPC method.
-type QueryProposalsRequest struct {
-	// voter defines the voter address for the proposals.
-	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+type AllRolesRequest struct {
 }
 
-func (m *QueryProposalsRequest) Reset()         { *m = QueryProposalsRequest{} }
-func (m *QueryProposalsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryProposalsRequest) ProtoMessage()    {}
-func (*QueryProposalsRequest) Descriptor() ([]byte, []int) {
+func (m *AllRolesRequest) Reset()         { *m = AllRolesRequest{} }
+func (m *AllRolesRequest) String() string { return proto.CompactTextString(m) }
+func (*AllRolesRequest) ProtoMessage()    {}
+func (*AllRolesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{17}
 }
-func (m *QueryProposalsRequest) XXX_Unmarshal(b []byte) error {
+func (m *AllRolesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AllRolesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryProposalsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AllRolesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -801,36 +828,34 @@ func (m *QueryProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryProposalsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryProposalsRequest.Merge(m, src)
+func (m *AllRolesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllRolesRequest.Merge(m, src)
 }
-func (m *QueryProposalsRequest) XXX_Size() int {
+func (m *AllRolesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryProposalsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryProposalsRequest.DiscardUnknown(m)
+func (m *AllRolesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllRolesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryProposalsRequest proto.InternalMessageInfo
+var xxx_messageInfo_AllRolesRequest proto.InternalMessageInfo
 
-// QueryProposalsResponse is the response type for the Query/Proposals RPC
-// method.
-type QueryProposalsResponse struct {
-	Proposals []Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
+type AllRolesResponse struct {
+	Roles []RoleDetails `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles"`
 }
 
-func (m *QueryProposalsResponse) Reset()         { *m = QueryProposalsResponse{} }
-func (m *QueryProposalsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryProposalsResponse) ProtoMessage()    {}
-func (*QueryProposalsResponse) Descriptor() ([]byte, []int) {
+func (m *AllRolesResponse) Reset()         { *m = AllRolesResponse{} }
+func (m *AllRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*AllRolesResponse) ProtoMessage()    {}
+func (*AllRolesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{18}
 }
-func (m *QueryProposalsResponse) XXX_Unmarshal(b []byte) error {
+func (m *AllRolesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AllRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryProposalsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AllRolesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -840,43 +865,42 @@ func (m *QueryProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryProposalsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryProposalsResponse.Merge(m, src)
+func (m *AllRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllRolesResponse.Merge(m, src)
 }
-func (m *QueryProposalsResponse) XXX_Size() int {
+func (m *AllRolesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryProposalsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryProposalsResponse.DiscardUnknown(m)
+func (m *AllRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllRolesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryProposalsResponse proto.InternalMessageInfo
+var xxx_messageInfo_AllRolesResponse proto.InternalMessageInfo
 
-func (m *QueryProposalsResponse) GetProposals() []Proposal {
+func (m *AllRolesResponse) GetRoles() []RoleDetails {
 	if m != nil {
-		return m.Proposals
+		return m.Roles
 	}
 	return nil
 }
 
-// QueryWhitelistedProposalVotersRequest is the request type for Query/WhitelistedProposalVoters RPC method.
-type QueryWhitelistedProposalVotersRequest struct {
-	// proposal_id defines the unique id of the proposal.
-	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+type RoleRequest struct {
+	// identifier is either the role number or its sid.
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
 }
 
-func (m *QueryWhitelistedProposalVotersRequest) Reset()         { *m = QueryWhitelistedProposalVotersRequest{} }
-func (m *QueryWhitelistedProposalVotersRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryWhitelistedProposalVotersRequest) ProtoMessage()    {}
-func (*QueryWhitelistedProposalVotersRequest) Descriptor() ([]byte, []int) {
+func (m *RoleRequest) Reset()         { *m = RoleRequest{} }
+func (m *RoleRequest) String() string { return proto.CompactTextString(m) }
+func (*RoleRequest) ProtoMessage()    {}
+func (*RoleRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{19}
 }
-func (m *QueryWhitelistedProposalVotersRequest) XXX_Unmarshal(b []byte) error {
+func (m *RoleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryWhitelistedProposalVotersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryWhitelistedProposalVotersRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RoleRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -886,44 +910,41 @@ func (m *QueryWhitelistedProposalVotersRequest) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryWhitelistedProposalVotersRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryWhitelistedProposalVotersRequest.Merge(m, src)
+func (m *RoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleRequest.Merge(m, src)
 }
-func (m *QueryWhitelistedProposalVotersRequest) XXX_Size() int {
+func (m *RoleRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryWhitelistedProposalVotersRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryWhitelistedProposalVotersRequest.DiscardUnknown(m)
+func (m *RoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryWhitelistedProposalVotersRequest proto.InternalMessageInfo
+var xxx_messageInfo_RoleRequest proto.InternalMessageInfo
 
-func (m *QueryWhitelistedProposalVotersRequest) GetProposalId() uint64 {
+func (m *RoleRequest) GetIdentifier() string {
 	if m != nil {
-		return m.ProposalId
+		return m.Identifier
 	}
-	return 0
+	return ""
 }
 
-// QueryWhitelistedProposalVotersResponse is the response type for Query/WhitelistedProposalVoters RPC method.
-type QueryWhitelistedProposalVotersResponse struct {
-	Voters []NetworkActor `protobuf:"bytes,1,rep,name=voters,proto3" json:"voters"`
+type RoleResponse struct {
+	Role RoleDetails `protobuf:"bytes,1,opt,name=role,proto3" json:"role"`
 }
 
-func (m *QueryWhitelistedProposalVotersResponse) Reset() {
-	*m = QueryWhitelistedProposalVotersResponse{}
-}
-func (m *QueryWhitelistedProposalVotersResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryWhitelistedProposalVotersResponse) ProtoMessage()    {}
-func (*QueryWhitelistedProposalVotersResponse) Descriptor() ([]byte, []int) {
+func (m *RoleResponse) Reset()         { *m = RoleResponse{} }
+func (m *RoleResponse) String() string { return proto.CompactTextString(m) }
+func (*RoleResponse) ProtoMessage()    {}
+func (*RoleResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{20}
 }
-func (m *QueryWhitelistedProposalVotersResponse) XXX_Unmarshal(b []byte) error {
+func (m *RoleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryWhitelistedProposalVotersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryWhitelistedProposalVotersResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RoleResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -933,45 +954,42 @@ func (m *QueryWhitelistedProposalVotersResponse) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *QueryWhitelistedProposalVotersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryWhitelistedProposalVotersResponse.Merge(m, src)
+func (m *RoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleResponse.Merge(m, src)
 }
-func (m *QueryWhitelistedProposalVotersResponse) XXX_Size() int {
+func (m *RoleResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryWhitelistedProposalVotersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryWhitelistedProposalVotersResponse.DiscardUnknown(m)
+func (m *RoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryWhitelistedProposalVotersResponse proto.InternalMessageInfo
+var xxx_messageInfo_RoleResponse proto.InternalMessageInfo
 
-func (m *QueryWhitelistedProposalVotersResponse) GetVoters() []NetworkActor {
+func (m *RoleResponse) GetRole() RoleDetails {
 	if m != nil {
-		return m.Voters
+		return m.Role
 	}
-	return nil
+	return RoleDetails{}
 }
 
-// QueryVoteRequest is the request type for the Query/Vote RPC method.
-type QueryVoteRequest struct {
-	// proposal_id defines the unique id of the proposal.
-	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	// voter defines the oter address for the proposals.
-	Voter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
+type RoleActorsRequest struct {
+	// identifier is either the role number or its sid.
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
 }
 
-func (m *QueryVoteRequest) Reset()         { *m = QueryVoteRequest{} }
-func (m *QueryVoteRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryVoteRequest) ProtoMessage()    {}
-func (*QueryVoteRequest) Descriptor() ([]byte, []int) {
+func (m *RoleActorsRequest) Reset()         { *m = RoleActorsRequest{} }
+func (m *RoleActorsRequest) String() string { return proto.CompactTextString(m) }
+func (*RoleActorsRequest) ProtoMessage()    {}
+func (*RoleActorsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{21}
 }
-func (m *QueryVoteRequest) XXX_Unmarshal(b []byte) error {
+func (m *RoleActorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RoleActorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryVoteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RoleActorsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -981,36 +999,41 @@ func (m *QueryVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *QueryVoteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryVoteRequest.Merge(m, src)
+func (m *RoleActorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleActorsRequest.Merge(m, src)
 }
-func (m *QueryVoteRequest) XXX_Size() int {
+func (m *RoleActorsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryVoteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryVoteRequest.DiscardUnknown(m)
+func (m *RoleActorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleActorsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryVoteRequest proto.InternalMessageInfo
+var xxx_messageInfo_RoleActorsRequest proto.InternalMessageInfo
 
-// QueryVoteResponse is the response type for the Query/Vote RPC method.
-type QueryVoteResponse struct {
-	// vote defined the queried vote.
-	Vote Vote `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote"`
+func (m *RoleActorsRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
 }
 
-func (m *QueryVoteResponse) Reset()         { *m = QueryVoteResponse{} }
-func (m *QueryVoteResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryVoteResponse) ProtoMessage()    {}
-func (*QueryVoteResponse) Descriptor() ([]byte, []int) {
+type RoleActorsResponse struct {
+	Actors []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,rep,name=actors,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"actors,omitempty"`
+}
+
+func (m *RoleActorsResponse) Reset()         { *m = RoleActorsResponse{} }
+func (m *RoleActorsResponse) String() string { return proto.CompactTextString(m) }
+func (*RoleActorsResponse) ProtoMessage()    {}
+func (*RoleActorsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{22}
 }
-func (m *QueryVoteResponse) XXX_Unmarshal(b []byte) error {
+func (m *RoleActorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RoleActorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryVoteResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RoleActorsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1020,43 +1043,41 @@ func (m *QueryVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *QueryVoteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryVoteResponse.Merge(m, src)
+func (m *RoleActorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleActorsResponse.Merge(m, src)
 }
-func (m *QueryVoteResponse) XXX_Size() int {
+func (m *RoleActorsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryVoteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryVoteResponse.DiscardUnknown(m)
+func (m *RoleActorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleActorsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryVoteResponse proto.InternalMessageInfo
+var xxx_messageInfo_RoleActorsResponse proto.InternalMessageInfo
 
-func (m *QueryVoteResponse) GetVote() Vote {
+func (m *RoleActorsResponse) GetActors() []github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.Vote
+		return m.Actors
 	}
-	return Vote{}
+	return nil
 }
 
-// QueryVotesRequest is the request type for the Query/Votes RPC method.
-type QueryVotesRequest struct {
-	// proposal_id defines the unique id of the proposal.
-	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+type ExecutionFeeRequest struct {
+	TransactionType string `protobuf:"bytes,1,opt,name=transaction_type,json=transactionType,proto3" json:"transaction_type,omitempty"`
 }
 
-func (m *QueryVotesRequest) Reset()         { *m = QueryVotesRequest{} }
-func (m *QueryVotesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryVotesRequest) ProtoMessage()    {}
-func (*QueryVotesRequest) Descriptor() ([]byte, []int) {
+func (m *ExecutionFeeRequest) Reset()         { *m = ExecutionFeeRequest{} }
+func (m *ExecutionFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*ExecutionFeeRequest) ProtoMessage()    {}
+func (*ExecutionFeeRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{23}
 }
-func (m *QueryVotesRequest) XXX_Unmarshal(b []byte) error {
+func (m *ExecutionFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExecutionFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryVotesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExecutionFeeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1066,43 +1087,41 @@ func (m *QueryVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *QueryVotesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryVotesRequest.Merge(m, src)
+func (m *ExecutionFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionFeeRequest.Merge(m, src)
 }
-func (m *QueryVotesRequest) XXX_Size() int {
+func (m *ExecutionFeeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryVotesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryVotesRequest.DiscardUnknown(m)
+func (m *ExecutionFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionFeeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryVotesRequest proto.InternalMessageInfo
+var xxx_messageInfo_ExecutionFeeRequest proto.InternalMessageInfo
 
-func (m *QueryVotesRequest) GetProposalId() uint64 {
+func (m *ExecutionFeeRequest) GetTransactionType() string {
 	if m != nil {
-		return m.ProposalId
+		return m.TransactionType
 	}
-	return 0
+	return ""
 }
 
-// QueryVotesResponse is the response type for the Query/Votes RPC method.
-type QueryVotesResponse struct {
-	// votes defined the queried votes.
-	Votes []Vote `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes"`
+type ExecutionFeeResponse struct {
+	Fee *ExecutionFee `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee,omitempty"`
 }
 
-func (m *QueryVotesResponse) Reset()         { *m = QueryVotesResponse{} }
-func (m *QueryVotesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryVotesResponse) ProtoMessage()    {}
-func (*QueryVotesResponse) Descriptor() ([]byte, []int) {
+func (m *ExecutionFeeResponse) Reset()         { *m = ExecutionFeeResponse{} }
+func (m *ExecutionFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*ExecutionFeeResponse) ProtoMessage()    {}
+func (*ExecutionFeeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{24}
 }
-func (m *QueryVotesResponse) XXX_Unmarshal(b []byte) error {
+func (m *ExecutionFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExecutionFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryVotesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExecutionFeeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1112,42 +1131,40 @@ func (m *QueryVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryVotesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryVotesResponse.Merge(m, src)
+func (m *ExecutionFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionFeeResponse.Merge(m, src)
 }
-func (m *QueryVotesResponse) XXX_Size() int {
+func (m *ExecutionFeeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryVotesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryVotesResponse.DiscardUnknown(m)
+func (m *ExecutionFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionFeeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryVotesResponse proto.InternalMessageInfo
+var xxx_messageInfo_ExecutionFeeResponse proto.InternalMessageInfo
 
-func (m *QueryVotesResponse) GetVotes() []Vote {
+func (m *ExecutionFeeResponse) GetFee() *ExecutionFee {
 	if m != nil {
-		return m.Votes
+		return m.Fee
 	}
 	return nil
 }
 
-// QueryDataReferenceKeysRequest is the request type for data reference keys query.
-type QueryDataReferenceKeysRequest struct {
-	Pagination *github_com_cosmos_cosmos_sdk_types_query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3,casttype=github.com/cosmos/cosmos-sdk/types/query.PageRequest" json:"pagination,omitempty"`
+type PoorNetworkMessagesRequest struct {
 }
 
-func (m *QueryDataReferenceKeysRequest) Reset()         { *m = QueryDataReferenceKeysRequest{} }
-func (m *QueryDataReferenceKeysRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDataReferenceKeysRequest) ProtoMessage()    {}
-func (*QueryDataReferenceKeysRequest) Descriptor() ([]byte, []int) {
+func (m *PoorNetworkMessagesRequest) Reset()         { *m = PoorNetworkMessagesRequest{} }
+func (m *PoorNetworkMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*PoorNetworkMessagesRequest) ProtoMessage()    {}
+func (*PoorNetworkMessagesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{25}
 }
-func (m *QueryDataReferenceKeysRequest) XXX_Unmarshal(b []byte) error {
+func (m *PoorNetworkMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDataReferenceKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PoorNetworkMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDataReferenceKeysRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PoorNetworkMessagesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1157,43 +1174,34 @@ func (m *QueryDataReferenceKeysRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryDataReferenceKeysRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDataReferenceKeysRequest.Merge(m, src)
+func (m *PoorNetworkMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoorNetworkMessagesRequest.Merge(m, src)
 }
-func (m *QueryDataReferenceKeysRequest) XXX_Size() int {
+func (m *PoorNetworkMessagesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDataReferenceKeysRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDataReferenceKeysRequest.DiscardUnknown(m)
+func (m *PoorNetworkMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoorNetworkMessagesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDataReferenceKeysRequest proto.InternalMessageInfo
-
-func (m *QueryDataReferenceKeysRequest) GetPagination() *github_com_cosmos_cosmos_sdk_types_query.PageRequest {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
+var xxx_messageInfo_PoorNetworkMessagesRequest proto.InternalMessageInfo
 
-// QueryDataReferenceKeysResponse is the response type for data reference keys query.
-type QueryDataReferenceKeysResponse struct {
-	Keys       []string                                               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
-	Pagination *github_com_cosmos_cosmos_sdk_types_query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3,casttype=github.com/cosmos/cosmos-sdk/types/query.PageResponse" json:"pagination,omitempty"`
+type PoorNetworkMessagesResponse struct {
+	Messages []string `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
-func (m *QueryDataReferenceKeysResponse) Reset()         { *m = QueryDataReferenceKeysResponse{} }
-func (m *QueryDataReferenceKeysResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDataReferenceKeysResponse) ProtoMessage()    {}
-func (*QueryDataReferenceKeysResponse) Descriptor() ([]byte, []int) {
+func (m *PoorNetworkMessagesResponse) Reset()         { *m = PoorNetworkMessagesResponse{} }
+func (m *PoorNetworkMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*PoorNetworkMessagesResponse) ProtoMessage()    {}
+func (*PoorNetworkMessagesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{26}
 }
-func (m *QueryDataReferenceKeysResponse) XXX_Unmarshal(b []byte) error {
+func (m *PoorNetworkMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDataReferenceKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PoorNetworkMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDataReferenceKeysResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PoorNetworkMessagesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1203,49 +1211,41 @@ func (m *QueryDataReferenceKeysResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryDataReferenceKeysResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDataReferenceKeysResponse.Merge(m, src)
+func (m *PoorNetworkMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoorNetworkMessagesResponse.Merge(m, src)
 }
-func (m *QueryDataReferenceKeysResponse) XXX_Size() int {
+func (m *PoorNetworkMessagesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDataReferenceKeysResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDataReferenceKeysResponse.DiscardUnknown(m)
+func (m *PoorNetworkMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoorNetworkMessagesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDataReferenceKeysResponse proto.InternalMessageInfo
-
-func (m *QueryDataReferenceKeysResponse) GetKeys() []string {
-	if m != nil {
-		return m.Keys
-	}
-	return nil
-}
+var xxx_messageInfo_PoorNetworkMessagesResponse proto.InternalMessageInfo
 
-func (m *QueryDataReferenceKeysResponse) GetPagination() *github_com_cosmos_cosmos_sdk_types_query.PageResponse {
+func (m *PoorNetworkMessagesResponse) GetMessages() []string {
 	if m != nil {
-		return m.Pagination
+		return m.Messages
 	}
 	return nil
 }
 
-// QueryDataReferenceRequest is the response type for data reference query.
-type QueryDataReferenceRequest struct {
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+type CouncilorByAddressRequest struct {
+	ValAddr github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=val_addr,json=valAddr,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"val_addr,omitempty" yaml:"val_addr"`
 }
 
-func (m *QueryDataReferenceRequest) Reset()         { *m = QueryDataReferenceRequest{} }
-func (m *QueryDataReferenceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDataReferenceRequest) ProtoMessage()    {}
-func (*QueryDataReferenceRequest) Descriptor() ([]byte, []int) {
+func (m *CouncilorByAddressRequest) Reset()         { *m = CouncilorByAddressRequest{} }
+func (m *CouncilorByAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*CouncilorByAddressRequest) ProtoMessage()    {}
+func (*CouncilorByAddressRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{27}
 }
-func (m *QueryDataReferenceRequest) XXX_Unmarshal(b []byte) error {
+func (m *CouncilorByAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDataReferenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CouncilorByAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDataReferenceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CouncilorByAddressRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1255,42 +1255,41 @@ func (m *QueryDataReferenceRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryDataReferenceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDataReferenceRequest.Merge(m, src)
+func (m *CouncilorByAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CouncilorByAddressRequest.Merge(m, src)
 }
-func (m *QueryDataReferenceRequest) XXX_Size() int {
+func (m *CouncilorByAddressRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDataReferenceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDataReferenceRequest.DiscardUnknown(m)
+func (m *CouncilorByAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CouncilorByAddressRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDataReferenceRequest proto.InternalMessageInfo
+var xxx_messageInfo_CouncilorByAddressRequest proto.InternalMessageInfo
 
-func (m *QueryDataReferenceRequest) GetKey() string {
+func (m *CouncilorByAddressRequest) GetValAddr() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.Key
+		return m.ValAddr
 	}
-	return ""
+	return nil
 }
 
-// QueryDataReferenceResponse is the response type for data referencequery.
-type QueryDataReferenceResponse struct {
-	Data *DataRegistryEntry `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+type CouncilorByMonikerRequest struct {
+	Moniker string `protobuf:"bytes,1,opt,name=moniker,proto3" json:"moniker,omitempty"`
 }
 
-func (m *QueryDataReferenceResponse) Reset()         { *m = QueryDataReferenceResponse{} }
-func (m *QueryDataReferenceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDataReferenceResponse) ProtoMessage()    {}
-func (*QueryDataReferenceResponse) Descriptor() ([]byte, []int) {
+func (m *CouncilorByMonikerRequest) Reset()         { *m = CouncilorByMonikerRequest{} }
+func (m *CouncilorByMonikerRequest) String() string { return proto.CompactTextString(m) }
+func (*CouncilorByMonikerRequest) ProtoMessage()    {}
+func (*CouncilorByMonikerRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{28}
 }
-func (m *QueryDataReferenceResponse) XXX_Unmarshal(b []byte) error {
+func (m *CouncilorByMonikerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDataReferenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CouncilorByMonikerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDataReferenceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CouncilorByMonikerRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1300,2116 +1299,6663 @@ func (m *QueryDataReferenceResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryDataReferenceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDataReferenceResponse.Merge(m, src)
+func (m *CouncilorByMonikerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CouncilorByMonikerRequest.Merge(m, src)
 }
-func (m *QueryDataReferenceResponse) XXX_Size() int {
+func (m *CouncilorByMonikerRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDataReferenceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDataReferenceResponse.DiscardUnknown(m)
+func (m *CouncilorByMonikerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CouncilorByMonikerRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDataReferenceResponse proto.InternalMessageInfo
+var xxx_messageInfo_CouncilorByMonikerRequest proto.InternalMessageInfo
 
-func (m *QueryDataReferenceResponse) GetData() *DataRegistryEntry {
+func (m *CouncilorByMonikerRequest) GetMoniker() string {
 	if m != nil {
-		return m.Data
+		return m.Moniker
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*NetworkPropertiesRequest)(nil), "kira.gov.NetworkPropertiesRequest")
-	proto.RegisterType((*NetworkPropertiesResponse)(nil), "kira.gov.NetworkPropertiesResponse")
-	proto.RegisterType((*PermissionsByAddressRequest)(nil), "kira.gov.PermissionsByAddressRequest")
-	proto.RegisterType((*PermissionsResponse)(nil), "kira.gov.PermissionsResponse")
-	proto.RegisterType((*RolesByAddressRequest)(nil), "kira.gov.RolesByAddressRequest")
-	proto.RegisterType((*RolesByAddressResponse)(nil), "kira.gov.RolesByAddressResponse")
-	proto.RegisterType((*RolePermissionsRequest)(nil), "kira.gov.RolePermissionsRequest")
-	proto.RegisterType((*RolePermissionsResponse)(nil), "kira.gov.RolePermissionsResponse")
-	proto.RegisterType((*ExecutionFeeRequest)(nil), "kira.gov.ExecutionFeeRequest")
-	proto.RegisterType((*ExecutionFeeResponse)(nil), "kira.gov.ExecutionFeeResponse")
-	proto.RegisterType((*PoorNetworkMessagesRequest)(nil), "kira.gov.PoorNetworkMessagesRequest")
-	proto.RegisterType((*PoorNetworkMessagesResponse)(nil), "kira.gov.PoorNetworkMessagesResponse")
-	proto.RegisterType((*CouncilorByAddressRequest)(nil), "kira.gov.CouncilorByAddressRequest")
-	proto.RegisterType((*CouncilorByMonikerRequest)(nil), "kira.gov.CouncilorByMonikerRequest")
-	proto.RegisterType((*CouncilorResponse)(nil), "kira.gov.CouncilorResponse")
-	proto.RegisterType((*QueryProposalRequest)(nil), "kira.gov.QueryProposalRequest")
-	proto.RegisterType((*QueryProposalResponse)(nil), "kira.gov.QueryProposalResponse")
-	proto.RegisterType((*QueryProposalsRequest)(nil), "kira.gov.QueryProposalsRequest")
-	proto.RegisterType((*QueryProposalsResponse)(nil), "kira.gov.QueryProposalsResponse")
-	proto.RegisterType((*QueryWhitelistedProposalVotersRequest)(nil), "kira.gov.QueryWhitelistedProposalVotersRequest")
-	proto.RegisterType((*QueryWhitelistedProposalVotersResponse)(nil), "kira.gov.QueryWhitelistedProposalVotersResponse")
-	proto.RegisterType((*QueryVoteRequest)(nil), "kira.gov.QueryVoteRequest")
-	proto.RegisterType((*QueryVoteResponse)(nil), "kira.gov.QueryVoteResponse")
-	proto.RegisterType((*QueryVotesRequest)(nil), "kira.gov.QueryVotesRequest")
-	proto.RegisterType((*QueryVotesResponse)(nil), "kira.gov.QueryVotesResponse")
-	proto.RegisterType((*QueryDataReferenceKeysRequest)(nil), "kira.gov.QueryDataReferenceKeysRequest")
-	proto.RegisterType((*QueryDataReferenceKeysResponse)(nil), "kira.gov.QueryDataReferenceKeysResponse")
-	proto.RegisterType((*QueryDataReferenceRequest)(nil), "kira.gov.QueryDataReferenceRequest")
-	proto.RegisterType((*QueryDataReferenceResponse)(nil), "kira.gov.QueryDataReferenceResponse")
+type CouncilorResponse struct {
+	Councilor Councilor `protobuf:"bytes,1,opt,name=councilor,proto3" json:"councilor"`
 }
 
-func init() { proto.RegisterFile("query.proto", fileDescriptor_5c6ac9b241082464) }
-
-var fileDescriptor_5c6ac9b241082464 = []byte{
-	// 1307 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4f, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xf6, 0x26, 0x4e, 0xeb, 0xbc, 0xae, 0x9a, 0x74, 0xe2, 0xb8, 0xe9, 0x26, 0xb1, 0xd3, 0x69,
-	0xd3, 0x5f, 0xfa, 0x13, 0xf5, 0x42, 0x69, 0x09, 0x2d, 0x42, 0x24, 0x6e, 0x8b, 0x41, 0x21, 0x55,
-	0x58, 0xf1, 0xa7, 0xe2, 0x50, 0x6b, 0x6b, 0x4f, 0xb6, 0x2b, 0xff, 0x19, 0x67, 0x77, 0x62, 0xb2,
-	0x2a, 0x45, 0x88, 0x03, 0x82, 0x5b, 0x25, 0xbe, 0x40, 0x6f, 0x88, 0xaf, 0xc0, 0x27, 0xe8, 0xb1,
-	0x12, 0x17, 0x4e, 0x11, 0x4a, 0x38, 0x70, 0xe6, 0x98, 0x13, 0xda, 0xd9, 0x99, 0xdd, 0xf5, 0x7a,
-	0x37, 0x4e, 0x85, 0x04, 0xa7, 0xec, 0xce, 0x3c, 0xef, 0xf3, 0x3e, 0xf3, 0xee, 0x9b, 0x79, 0x5e,
-	0x43, 0x7e, 0x67, 0x97, 0xd8, 0x6e, 0xa5, 0x67, 0x53, 0x46, 0x51, 0xae, 0x65, 0xd9, 0x46, 0xc5,
-	0xa4, 0x7d, 0x35, 0x6f, 0x34, 0x18, 0xb5, 0xfd, 0x65, 0x75, 0xaa, 0x41, 0x77, 0xbb, 0x0d, 0xab,
-	0x1d, 0x2c, 0xcc, 0x34, 0x0d, 0x66, 0xd4, 0x6d, 0x62, 0x5a, 0x0e, 0x93, 0xc1, 0xea, 0x74, 0xcf,
-	0x30, 0xad, 0xae, 0xc1, 0x2c, 0xda, 0x15, 0x2b, 0x60, 0xd3, 0x36, 0x91, 0x21, 0x64, 0x8f, 0x34,
-	0x76, 0xbd, 0xcd, 0xfa, 0x36, 0x91, 0x8b, 0x73, 0x5d, 0xc2, 0xbe, 0xa4, 0x76, 0xab, 0xde, 0xb3,
-	0x69, 0x8f, 0xd8, 0xcc, 0x22, 0x8e, 0xd8, 0x39, 0xeb, 0xad, 0x50, 0xc7, 0x68, 0x8b, 0xf7, 0x82,
-	0x49, 0x4d, 0xca, 0x1f, 0x35, 0xef, 0x49, 0xac, 0x2e, 0x98, 0x94, 0x9a, 0x6d, 0xa2, 0x19, 0x3d,
-	0x4b, 0x33, 0xba, 0x5d, 0xca, 0x78, 0x76, 0xc1, 0x81, 0x55, 0x98, 0xbb, 0xef, 0xf3, 0x6f, 0x05,
-	0xf4, 0x3a, 0xd9, 0xd9, 0x25, 0x0e, 0xc3, 0x0f, 0xe0, 0x42, 0xc2, 0x9e, 0xd3, 0xa3, 0x5d, 0x87,
-	0xa0, 0x77, 0x00, 0x42, 0x41, 0x73, 0xca, 0x92, 0xb2, 0x92, 0xbf, 0x3e, 0x5f, 0x91, 0xb5, 0xa9,
-	0x0c, 0x07, 0x46, 0xe0, 0xf8, 0x6b, 0x98, 0xdf, 0x22, 0x76, 0xc7, 0x72, 0x1c, 0x4f, 0x4a, 0xd5,
-	0x5d, 0x6f, 0x36, 0x6d, 0xe2, 0xc8, 0xc4, 0xa8, 0x0e, 0xb9, 0xbe, 0xd1, 0xae, 0x1b, 0xcd, 0xa6,
-	0xcd, 0x99, 0xcf, 0x54, 0xef, 0xfe, 0xb5, 0x5f, 0x9e, 0x72, 0x8d, 0x4e, 0xfb, 0x36, 0x96, 0x3b,
-	0xf8, 0x68, 0xbf, 0x7c, 0xcd, 0xb4, 0xd8, 0xe3, 0xdd, 0x47, 0x95, 0x06, 0xed, 0x68, 0x0d, 0xea,
-	0x74, 0xa8, 0x23, 0xfe, 0x5c, 0x73, 0x9a, 0x2d, 0x8d, 0xb9, 0x3d, 0xe2, 0x54, 0xd6, 0x1b, 0x0d,
-	0x49, 0x7f, 0xba, 0x6f, 0xb4, 0xbd, 0x67, 0x7c, 0x1f, 0x66, 0x22, 0xf9, 0x83, 0x33, 0xad, 0x42,
-	0xbe, 0x17, 0x2e, 0x8b, 0x43, 0xcd, 0x86, 0x87, 0x8a, 0xc6, 0x44, 0x91, 0x78, 0x0f, 0x66, 0x75,
-	0xda, 0x26, 0xff, 0xc1, 0x49, 0x2a, 0x50, 0x8c, 0x67, 0x16, 0x87, 0x29, 0xc0, 0x84, 0xd7, 0x5a,
-	0xde, 0x31, 0xc6, 0x57, 0xb2, 0xba, 0xff, 0x82, 0x5f, 0xf3, 0xf1, 0x03, 0xa7, 0xf7, 0xa5, 0x22,
-	0xc8, 0x7a, 0x10, 0x2e, 0x33, 0xab, 0xf3, 0x67, 0xac, 0xc3, 0xf9, 0x21, 0xf4, 0x3f, 0xad, 0xd5,
-	0x1a, 0xcc, 0xdc, 0x93, 0x6d, 0xfe, 0x3e, 0x21, 0x32, 0xfd, 0x55, 0x98, 0x66, 0xb6, 0xd1, 0x75,
-	0x8c, 0x06, 0xef, 0x7f, 0xef, 0xc4, 0x9c, 0x74, 0x52, 0x9f, 0x8a, 0xac, 0x7f, 0xe2, 0xf6, 0x08,
-	0x5e, 0x83, 0xc2, 0x20, 0x83, 0x90, 0xb4, 0x02, 0xe3, 0xdb, 0x84, 0x08, 0x29, 0xc5, 0x50, 0xca,
-	0x00, 0xd8, 0x83, 0xe0, 0x05, 0x50, 0xb7, 0x28, 0xb5, 0x45, 0x93, 0x6e, 0x12, 0xc7, 0x31, 0xcc,
-	0xb0, 0xef, 0x6f, 0xc1, 0x7c, 0xe2, 0xae, 0x48, 0xa3, 0x42, 0xae, 0x23, 0xd6, 0x78, 0x6d, 0x27,
-	0xf5, 0xe0, 0x1d, 0x7f, 0x05, 0x17, 0xee, 0xc8, 0x7b, 0xe0, 0xdf, 0x6f, 0x86, 0x9b, 0x03, 0xd9,
-	0x37, 0x69, 0xd7, 0x6a, 0x11, 0x5b, 0x66, 0x9f, 0x83, 0xd3, 0x1d, 0x7f, 0x45, 0xd4, 0x55, 0xbe,
-	0xe2, 0x8f, 0xe0, 0x5c, 0x10, 0x16, 0xf9, 0xbe, 0x93, 0xc1, 0x8d, 0x26, 0x4a, 0x3a, 0x13, 0x96,
-	0x34, 0x4c, 0x93, 0x7d, 0xb1, 0x5f, 0xce, 0xe8, 0x21, 0x16, 0xaf, 0x42, 0xe1, 0x63, 0xef, 0xba,
-	0xdc, 0x12, 0x97, 0x93, 0xcc, 0x5f, 0x86, 0xbc, 0xbc, 0xaf, 0xea, 0x56, 0x53, 0xb4, 0x19, 0xc8,
-	0xa5, 0x0f, 0x9b, 0xd8, 0x85, 0xd9, 0x58, 0xa0, 0x90, 0x72, 0x03, 0x72, 0x12, 0x26, 0x94, 0xa0,
-	0x48, 0x9f, 0x89, 0x1d, 0x21, 0x24, 0x40, 0xa2, 0xff, 0xc3, 0x44, 0x9f, 0x32, 0xe2, 0xcc, 0x8d,
-	0x2d, 0x8d, 0xaf, 0xe4, 0xaf, 0x9f, 0x0d, 0x43, 0x3e, 0xa3, 0x8c, 0x08, 0xb8, 0x0f, 0xc1, 0xab,
-	0xb1, 0xd4, 0xc1, 0x27, 0x2b, 0xf8, 0x24, 0xb2, 0x64, 0xfe, 0xcb, 0xed, 0xdc, 0xf7, 0xcf, 0xcb,
-	0x99, 0x3f, 0x9f, 0x97, 0x33, 0x78, 0x0b, 0x8a, 0xf1, 0x40, 0x21, 0xfa, 0x2d, 0x98, 0x94, 0x52,
-	0xfc, 0x36, 0x39, 0x4e, 0x75, 0x08, 0xc5, 0x1f, 0xc0, 0x32, 0x67, 0xfc, 0xfc, 0xb1, 0xc5, 0x48,
-	0xdb, 0x72, 0x18, 0x69, 0x4a, 0xb0, 0xa7, 0xdb, 0x76, 0x4e, 0x5c, 0xcf, 0x87, 0x70, 0x65, 0x14,
-	0x53, 0x50, 0xe0, 0x53, 0xfc, 0x60, 0x52, 0x68, 0x71, 0xe8, 0x1e, 0x5f, 0xf7, 0x9c, 0x4e, 0x88,
-	0x15, 0x58, 0xfc, 0x9d, 0x02, 0xd3, 0x3c, 0x81, 0xc7, 0x76, 0x52, 0x55, 0xa8, 0x26, 0x2b, 0x3a,
-	0xc6, 0xff, 0x03, 0xde, 0x78, 0xf5, 0x76, 0x1f, 0xfa, 0x08, 0xef, 0xc2, 0xb9, 0x88, 0x8e, 0xe0,
-	0x32, 0xc8, 0x7a, 0x38, 0xd1, 0x30, 0xc9, 0x5f, 0x9f, 0x23, 0xf0, 0x8d, 0x48, 0xf8, 0xc9, 0xab,
-	0xbb, 0x06, 0x28, 0x1a, 0x25, 0xb2, 0x06, 0x4d, 0xa7, 0x8c, 0x6e, 0xba, 0x67, 0x0a, 0x2c, 0x72,
-	0x8a, 0xbb, 0x06, 0x33, 0x74, 0xb2, 0x4d, 0x6c, 0xd2, 0x6d, 0x90, 0x0d, 0xe2, 0x06, 0x22, 0x28,
-	0x40, 0x38, 0x2f, 0x24, 0x5c, 0xb1, 0x86, 0x29, 0xeb, 0x5e, 0x7d, 0xfb, 0x68, 0xbf, 0x7c, 0x63,
-	0x74, 0x1d, 0x35, 0x7f, 0x92, 0x89, 0x44, 0xea, 0x91, 0x14, 0xf8, 0x27, 0x05, 0x4a, 0x69, 0x92,
-	0xc4, 0x09, 0x11, 0x64, 0x5b, 0xc4, 0x95, 0x37, 0x1f, 0x7f, 0x46, 0x3b, 0x03, 0x3a, 0xc7, 0xe2,
-	0xf7, 0xaf, 0x9f, 0xcd, 0x8f, 0xaf, 0xde, 0x3a, 0xda, 0x2f, 0xdf, 0x7c, 0x45, 0xa1, 0x7e, 0xe8,
-	0x80, 0xd2, 0x6b, 0x70, 0x61, 0x58, 0xa8, 0xac, 0xdb, 0x34, 0x8c, 0xb7, 0x88, 0x2b, 0xfe, 0x67,
-	0xbd, 0x47, 0xbc, 0x09, 0x6a, 0x12, 0x5c, 0x9c, 0x49, 0x83, 0xac, 0x37, 0xac, 0x0d, 0x4f, 0x31,
-	0x3e, 0xdc, 0x9f, 0xe0, 0xee, 0x75, 0x99, 0xed, 0xea, 0x1c, 0x78, 0xfd, 0x97, 0x33, 0x30, 0xc1,
-	0xf9, 0xd0, 0x43, 0x28, 0x24, 0x4d, 0x32, 0x68, 0x39, 0xd1, 0x09, 0xe3, 0x96, 0xa0, 0x2e, 0x26,
-	0x1b, 0xa6, 0x10, 0x86, 0x33, 0xe8, 0x53, 0x38, 0x3b, 0xe8, 0xef, 0xa8, 0x1c, 0x86, 0x24, 0xce,
-	0x1c, 0xea, 0x52, 0x3a, 0x20, 0xa0, 0x7d, 0x00, 0x53, 0x31, 0x63, 0x47, 0xb1, 0xb0, 0xe1, 0x09,
-	0x41, 0xbd, 0x78, 0x0c, 0x22, 0xc2, 0x8c, 0x86, 0x1d, 0x10, 0x5d, 0x4a, 0xb2, 0x8e, 0xb8, 0xf0,
-	0xf9, 0x04, 0x50, 0x2a, 0xb3, 0x70, 0xb7, 0x14, 0xe6, 0x41, 0xef, 0x1b, 0xc5, 0x6c, 0x40, 0xa1,
-	0x46, 0xd8, 0xd0, 0xc8, 0x8a, 0xf0, 0x71, 0xf3, 0xac, 0xa0, 0xbe, 0x74, 0x2c, 0x26, 0x48, 0xa1,
-	0xc3, 0x54, 0x8d, 0xb0, 0xe8, 0x24, 0x82, 0x16, 0x53, 0x26, 0x14, 0x41, 0x5c, 0x4a, 0xdb, 0x0e,
-	0x38, 0x4d, 0x28, 0xd6, 0x08, 0x4b, 0x18, 0x55, 0xd0, 0xe5, 0x48, 0x5b, 0xa5, 0xce, 0x39, 0xea,
-	0xf2, 0x08, 0x54, 0x90, 0xa8, 0x0f, 0x39, 0xe9, 0x1c, 0x28, 0x22, 0x2b, 0xc9, 0xe6, 0xd5, 0x72,
-	0xea, 0xbe, 0xa0, 0xbb, 0xfa, 0xed, 0xaf, 0x7f, 0xfc, 0x38, 0x76, 0x09, 0x5d, 0xd4, 0x3c, 0xa0,
-	0x66, 0xd2, 0xbe, 0x16, 0xb8, 0x9f, 0xf6, 0x24, 0x72, 0xe9, 0x3e, 0x45, 0x2d, 0x98, 0x0c, 0x8c,
-	0x15, 0xa5, 0x11, 0x27, 0xf5, 0x7d, 0xb2, 0x27, 0xe3, 0x79, 0x9e, 0x7a, 0x16, 0xcd, 0x24, 0xa4,
-	0x46, 0x3f, 0x2b, 0xb0, 0x50, 0x23, 0x2c, 0xd5, 0x2d, 0x91, 0x16, 0xe3, 0x1f, 0xe5, 0xd0, 0xea,
-	0xeb, 0x27, 0x0f, 0x10, 0x02, 0xaf, 0x70, 0x81, 0x4b, 0xa8, 0x14, 0x0a, 0xf4, 0xcd, 0x36, 0x56,
-	0x98, 0x75, 0xc8, 0x7a, 0x91, 0x48, 0x8d, 0x65, 0x88, 0x38, 0x71, 0xb4, 0xe7, 0x87, 0xdc, 0x11,
-	0x67, 0x90, 0x05, 0x13, 0xdc, 0xba, 0x50, 0x12, 0x2e, 0x38, 0xc2, 0x42, 0xf2, 0xa6, 0x60, 0x59,
-	0xe6, 0x72, 0xcb, 0x68, 0x71, 0x50, 0x6e, 0x5c, 0xed, 0x0f, 0x0a, 0x9c, 0xaf, 0x11, 0xb6, 0xde,
-	0x6e, 0x0f, 0xd9, 0x0a, 0xfa, 0x5f, 0x2c, 0x41, 0x9a, 0x17, 0xaa, 0x2b, 0xa3, 0x81, 0xe9, 0x5f,
-	0x99, 0xff, 0x14, 0xe7, 0x56, 0xf5, 0x8d, 0x02, 0xb3, 0x35, 0xc2, 0x06, 0xa2, 0xab, 0xee, 0x06,
-	0x71, 0xa3, 0x17, 0x49, 0xaa, 0xb3, 0xa8, 0x97, 0x8f, 0x07, 0x09, 0x05, 0x0b, 0x5c, 0x41, 0x11,
-	0x15, 0x06, 0x15, 0x68, 0x4f, 0x5a, 0xc4, 0x7d, 0x5a, 0x7d, 0xef, 0xc5, 0x41, 0x49, 0x79, 0x79,
-	0x50, 0x52, 0x7e, 0x3f, 0x28, 0x29, 0xcf, 0x0e, 0x4b, 0x99, 0x97, 0x87, 0xa5, 0xcc, 0x6f, 0x87,
-	0xa5, 0xcc, 0x17, 0xcb, 0x11, 0x5f, 0xdc, 0xb0, 0x6c, 0xe3, 0x0e, 0xb5, 0x89, 0xe6, 0x90, 0x96,
-	0x61, 0x69, 0x7b, 0x9c, 0x85, 0x5b, 0xe3, 0xa3, 0x53, 0xfc, 0xa7, 0xfb, 0x9b, 0x7f, 0x07, 0x00,
-	0x00, 0xff, 0xff, 0x5c, 0x9b, 0xc2, 0x65, 0x97, 0x10, 0x00, 0x00,
+func (m *CouncilorResponse) Reset()         { *m = CouncilorResponse{} }
+func (m *CouncilorResponse) String() string { return proto.CompactTextString(m) }
+func (*CouncilorResponse) ProtoMessage()    {}
+func (*CouncilorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{29}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Returns the permissions an actor has by address.
-	PermissionsByAddress(ctx context.Context, in *PermissionsByAddressRequest, opts ...grpc.CallOption) (*PermissionsResponse, error)
-	// Returns the roles that are assigned to an address.
-	RolesByAddress(ctx context.Context, in *RolesByAddressRequest, opts ...grpc.CallOption) (*RolesByAddressResponse, error)
-	// RolePermissions returns the permissions of the roles available in the registry.
-	RolePermissions(ctx context.Context, in *RolePermissionsRequest, opts ...grpc.CallOption) (*RolePermissionsResponse, error)
-	// CouncilorByAddress returns the councilor object from its address
-	CouncilorByAddress(ctx context.Context, in *CouncilorByAddressRequest, opts ...grpc.CallOption) (*CouncilorResponse, error)
-	// CouncilorByMoniker returns the councilor object from its moniker
-	CouncilorByMoniker(ctx context.Context, in *CouncilorByMonikerRequest, opts ...grpc.CallOption) (*CouncilorResponse, error)
-	// GetNetworkProperties returns network properties
-	GetNetworkProperties(ctx context.Context, in *NetworkPropertiesRequest, opts ...grpc.CallOption) (*NetworkPropertiesResponse, error)
-	// GetExecutionFee returns execution fee from msg type
-	GetExecutionFee(ctx context.Context, in *ExecutionFeeRequest, opts ...grpc.CallOption) (*ExecutionFeeResponse, error)
-	// GetPoorNetworkMessages returns poor network messages
-	GetPoorNetworkMessages(ctx context.Context, in *PoorNetworkMessagesRequest, opts ...grpc.CallOption) (*PoorNetworkMessagesResponse, error)
-	// Proposal queries proposal details based on ProposalID.
-	Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error)
-	// Proposals queries all proposals based on given status.
-	Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error)
-	// GetWhitelistedProposalVoters returns whitelisted voters for a proposal for tracking
-	GetWhitelistedProposalVoters(ctx context.Context, in *QueryWhitelistedProposalVotersRequest, opts ...grpc.CallOption) (*QueryWhitelistedProposalVotersResponse, error)
-	// Vote queries voted information based on proposalID, voterAddr.
-	Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error)
-	// Votes queries votes of a given proposal.
-	Votes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error)
-	// Query all data reference keys with pagination.
-	GetAllDataReferenceKeys(ctx context.Context, in *QueryDataReferenceKeysRequest, opts ...grpc.CallOption) (*QueryDataReferenceKeysResponse, error)
-	// Query data reference by key.
-	GetDataReferenceByKey(ctx context.Context, in *QueryDataReferenceRequest, opts ...grpc.CallOption) (*QueryDataReferenceResponse, error)
+func (m *CouncilorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *CouncilorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CouncilorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *CouncilorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CouncilorResponse.Merge(m, src)
 }
-
-func (c *queryClient) PermissionsByAddress(ctx context.Context, in *PermissionsByAddressRequest, opts ...grpc.CallOption) (*PermissionsResponse, error) {
-	out := new(PermissionsResponse)
-	err := c.cc.Invoke(ctx, "/kira.gov.Query/PermissionsByAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *CouncilorResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) RolesByAddress(ctx context.Context, in *RolesByAddressRequest, opts ...grpc.CallOption) (*RolesByAddressResponse, error) {
-	out := new(RolesByAddressResponse)
-	err := c.cc.Invoke(ctx, "/kira.gov.Query/RolesByAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *CouncilorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CouncilorResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) RolePermissions(ctx context.Context, in *RolePermissionsRequest, opts ...grpc.CallOption) (*RolePermissionsResponse, error) {
-	out := new(RolePermissionsResponse)
-	err := c.cc.Invoke(ctx, "/kira.gov.Query/RolePermissions", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_CouncilorResponse proto.InternalMessageInfo
 
-func (c *queryClient) CouncilorByAddress(ctx context.Context, in *CouncilorByAddressRequest, opts ...grpc.CallOption) (*CouncilorResponse, error) {
-	out := new(CouncilorResponse)
-	err := c.cc.Invoke(ctx, "/kira.gov.Query/CouncilorByAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CouncilorResponse) GetCouncilor() Councilor {
+	if m != nil {
+		return m.Councilor
 	}
-	return out, nil
+	return Councilor{}
 }
 
-func (c *queryClient) CouncilorByMoniker(ctx context.Context, in *CouncilorByMonikerRequest, opts ...grpc.CallOption) (*CouncilorResponse, error) {
-	out := new(CouncilorResponse)
-	err := c.cc.Invoke(ctx, "/kira.gov.Query/CouncilorByMoniker", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type CouncilorsRequest struct {
+	// status filters the councilors by status (COUNCILOR_ACTIVE or COUNCILOR_SUSPENDED), all the councilors are returned when empty.
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (c *queryClient) GetNetworkProperties(ctx context.Context, in *NetworkPropertiesRequest, opts ...grpc.CallOption) (*NetworkPropertiesResponse, error) {
-	out := new(NetworkPropertiesResponse)
-	err := c.cc.Invoke(ctx, "/kira.gov.Query/GetNetworkProperties", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CouncilorsRequest) Reset()         { *m = CouncilorsRequest{} }
+func (m *CouncilorsRequest) String() string { return proto.CompactTextString(m) }
+func (*CouncilorsRequest) ProtoMessage()    {}
+func (*CouncilorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{30}
+}
+func (m *CouncilorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CouncilorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CouncilorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *CouncilorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CouncilorsRequest.Merge(m, src)
+}
+func (m *CouncilorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CouncilorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CouncilorsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetExecutionFee(ctx context.Context, in *ExecutionFeeRequest, opts ...grpc.CallOption) (*ExecutionFeeResponse, error) {
-	out := new(ExecutionFeeResponse)
-	err := c.cc.Invoke(ctx, "/kira.gov.Query/GetExecutionFee", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CouncilorsRequest proto.InternalMessageInfo
+
+func (m *CouncilorsRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetPoorNetworkMessages(ctx context.Context, in *PoorNetworkMessagesRequest, opts ...grpc.CallOption) (*PoorNetworkMessagesResponse, error) {
-	out := new(PoorNetworkMessagesResponse)
-	err := c.cc.Invoke(ctx, "/kira.gov.Query/GetPoorNetworkMessages", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type CouncilorsResponse struct {
+	Councilors []Councilor `protobuf:"bytes,1,rep,name=councilors,proto3" json:"councilors"`
 }
 
-func (c *queryClient) Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error) {
-	out := new(QueryProposalResponse)
-	err := c.cc.Invoke(ctx, "/kira.gov.Query/Proposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CouncilorsResponse) Reset()         { *m = CouncilorsResponse{} }
+func (m *CouncilorsResponse) String() string { return proto.CompactTextString(m) }
+func (*CouncilorsResponse) ProtoMessage()    {}
+func (*CouncilorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{31}
+}
+func (m *CouncilorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CouncilorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CouncilorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *CouncilorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CouncilorsResponse.Merge(m, src)
+}
+func (m *CouncilorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CouncilorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CouncilorsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error) {
-	out := new(QueryProposalsResponse)
-	err := c.cc.Invoke(ctx, "/kira.gov.Query/Proposals", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CouncilorsResponse proto.InternalMessageInfo
+
+func (m *CouncilorsResponse) GetCouncilors() []Councilor {
+	if m != nil {
+		return m.Councilors
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetWhitelistedProposalVoters(ctx context.Context, in *QueryWhitelistedProposalVotersRequest, opts ...grpc.CallOption) (*QueryWhitelistedProposalVotersResponse, error) {
-	out := new(QueryWhitelistedProposalVotersResponse)
-	err := c.cc.Invoke(ctx, "/kira.gov.Query/GetWhitelistedProposalVoters", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryProposalRequest is the request type for the Query/Proposal RPC method.
+type QueryProposalRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func (c *queryClient) Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error) {
-	out := new(QueryVoteResponse)
-	err := c.cc.Invoke(ctx, "/kira.gov.Query/Vote", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryProposalRequest) Reset()         { *m = QueryProposalRequest{} }
+func (m *QueryProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalRequest) ProtoMessage()    {}
+func (*QueryProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{32}
+}
+func (m *QueryProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalRequest.Merge(m, src)
+}
+func (m *QueryProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Votes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error) {
-	out := new(QueryVotesResponse)
-	err := c.cc.Invoke(ctx, "/kira.gov.Query/Votes", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProposalRequest proto.InternalMessageInfo
+
+func (m *QueryProposalRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetAllDataReferenceKeys(ctx context.Context, in *QueryDataReferenceKeysRequest, opts ...grpc.CallOption) (*QueryDataReferenceKeysResponse, error) {
-	out := new(QueryDataReferenceKeysResponse)
-	err := c.cc.Invoke(ctx, "/kira.gov.Query/GetAllDataReferenceKeys", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryProposalResponse is the response type for the Query/Proposal RPC method.
+type QueryProposalResponse struct {
+	Proposal Proposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal"`
+	Votes    []Vote   `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes"`
 }
 
-func (c *queryClient) GetDataReferenceByKey(ctx context.Context, in *QueryDataReferenceRequest, opts ...grpc.CallOption) (*QueryDataReferenceResponse, error) {
-	out := new(QueryDataReferenceResponse)
-	err := c.cc.Invoke(ctx, "/kira.gov.Query/GetDataReferenceByKey", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryProposalResponse) Reset()         { *m = QueryProposalResponse{} }
+func (m *QueryProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalResponse) ProtoMessage()    {}
+func (*QueryProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{33}
+}
+func (m *QueryProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Returns the permissions an actor has by address.
-	PermissionsByAddress(context.Context, *PermissionsByAddressRequest) (*PermissionsResponse, error)
-	// Returns the roles that are assigned to an address.
-	RolesByAddress(context.Context, *RolesByAddressRequest) (*RolesByAddressResponse, error)
-	// RolePermissions returns the permissions of the roles available in the registry.
-	RolePermissions(context.Context, *RolePermissionsRequest) (*RolePermissionsResponse, error)
-	// CouncilorByAddress returns the councilor object from its address
-	CouncilorByAddress(context.Context, *CouncilorByAddressRequest) (*CouncilorResponse, error)
-	// CouncilorByMoniker returns the councilor object from its moniker
-	CouncilorByMoniker(context.Context, *CouncilorByMonikerRequest) (*CouncilorResponse, error)
-	// GetNetworkProperties returns network properties
-	GetNetworkProperties(context.Context, *NetworkPropertiesRequest) (*NetworkPropertiesResponse, error)
-	// GetExecutionFee returns execution fee from msg type
-	GetExecutionFee(context.Context, *ExecutionFeeRequest) (*ExecutionFeeResponse, error)
-	// GetPoorNetworkMessages returns poor network messages
-	GetPoorNetworkMessages(context.Context, *PoorNetworkMessagesRequest) (*PoorNetworkMessagesResponse, error)
-	// Proposal queries proposal details based on ProposalID.
-	Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error)
-	// Proposals queries all proposals based on given status.
-	Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error)
-	// GetWhitelistedProposalVoters returns whitelisted voters for a proposal for tracking
-	GetWhitelistedProposalVoters(context.Context, *QueryWhitelistedProposalVotersRequest) (*QueryWhitelistedProposalVotersResponse, error)
-	// Vote queries voted information based on proposalID, voterAddr.
-	Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error)
-	// Votes queries votes of a given proposal.
-	Votes(context.Context, *QueryVotesRequest) (*QueryVotesResponse, error)
-	// Query all data reference keys with pagination.
-	GetAllDataReferenceKeys(context.Context, *QueryDataReferenceKeysRequest) (*QueryDataReferenceKeysResponse, error)
-	// Query data reference by key.
-	GetDataReferenceByKey(context.Context, *QueryDataReferenceRequest) (*QueryDataReferenceResponse, error)
+func (m *QueryProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalResponse.Merge(m, src)
+}
+func (m *QueryProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalResponse.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryProposalResponse proto.InternalMessageInfo
+
+func (m *QueryProposalResponse) GetProposal() Proposal {
+	if m != nil {
+		return m.Proposal
+	}
+	return Proposal{}
 }
 
-func (*UnimplementedQueryServer) PermissionsByAddress(ctx context.Context, req *PermissionsByAddressRequest) (*PermissionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PermissionsByAddress not implemented")
+func (m *QueryProposalResponse) GetVotes() []Vote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) RolesByAddress(ctx context.Context, req *RolesByAddressRequest) (*RolesByAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RolesByAddress not implemented")
+
+// QueryProposalsRequest is the request type for the Query/Proposals RPC method.
+type QueryProposalsRequest struct {
+	// voter defines the voter address for the proposals.
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
 }
-func (*UnimplementedQueryServer) RolePermissions(ctx context.Context, req *RolePermissionsRequest) (*RolePermissionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RolePermissions not implemented")
+
+func (m *QueryProposalsRequest) Reset()         { *m = QueryProposalsRequest{} }
+func (m *QueryProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsRequest) ProtoMessage()    {}
+func (*QueryProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{34}
 }
-func (*UnimplementedQueryServer) CouncilorByAddress(ctx context.Context, req *CouncilorByAddressRequest) (*CouncilorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CouncilorByAddress not implemented")
+func (m *QueryProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) CouncilorByMoniker(ctx context.Context, req *CouncilorByMonikerRequest) (*CouncilorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CouncilorByMoniker not implemented")
+func (m *QueryProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetNetworkProperties(ctx context.Context, req *NetworkPropertiesRequest) (*NetworkPropertiesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkProperties not implemented")
+func (m *QueryProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetExecutionFee(ctx context.Context, req *ExecutionFeeRequest) (*ExecutionFeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExecutionFee not implemented")
+func (m *QueryProposalsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetPoorNetworkMessages(ctx context.Context, req *PoorNetworkMessagesRequest) (*PoorNetworkMessagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPoorNetworkMessages not implemented")
+func (m *QueryProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Proposal(ctx context.Context, req *QueryProposalRequest) (*QueryProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Proposal not implemented")
+
+var xxx_messageInfo_QueryProposalsRequest proto.InternalMessageInfo
+
+// QueryProposalsResponse is the response type for the Query/Proposals RPC
+// method.
+type QueryProposalsResponse struct {
+	Proposals []Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
 }
-func (*UnimplementedQueryServer) Proposals(ctx context.Context, req *QueryProposalsRequest) (*QueryProposalsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Proposals not implemented")
+
+func (m *QueryProposalsResponse) Reset()         { *m = QueryProposalsResponse{} }
+func (m *QueryProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsResponse) ProtoMessage()    {}
+func (*QueryProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{35}
 }
-func (*UnimplementedQueryServer) GetWhitelistedProposalVoters(ctx context.Context, req *QueryWhitelistedProposalVotersRequest) (*QueryWhitelistedProposalVotersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWhitelistedProposalVoters not implemented")
+func (m *QueryProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Vote(ctx context.Context, req *QueryVoteRequest) (*QueryVoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
+func (m *QueryProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Votes(ctx context.Context, req *QueryVotesRequest) (*QueryVotesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Votes not implemented")
+func (m *QueryProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetAllDataReferenceKeys(ctx context.Context, req *QueryDataReferenceKeysRequest) (*QueryDataReferenceKeysResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllDataReferenceKeys not implemented")
+func (m *QueryProposalsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetDataReferenceByKey(ctx context.Context, req *QueryDataReferenceRequest) (*QueryDataReferenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDataReferenceByKey not implemented")
+func (m *QueryProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryProposalsResponse proto.InternalMessageInfo
 
-func _Query_PermissionsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PermissionsByAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PermissionsByAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kira.gov.Query/PermissionsByAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PermissionsByAddress(ctx, req.(*PermissionsByAddressRequest))
+func (m *QueryProposalsResponse) GetProposals() []Proposal {
+	if m != nil {
+		return m.Proposals
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_RolesByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RolesByAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RolesByAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kira.gov.Query/RolesByAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RolesByAddress(ctx, req.(*RolesByAddressRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryWhitelistedProposalVotersRequest is the request type for Query/WhitelistedProposalVoters RPC method.
+type QueryWhitelistedProposalVotersRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func _Query_RolePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RolePermissionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RolePermissions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kira.gov.Query/RolePermissions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RolePermissions(ctx, req.(*RolePermissionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryWhitelistedProposalVotersRequest) Reset()         { *m = QueryWhitelistedProposalVotersRequest{} }
+func (m *QueryWhitelistedProposalVotersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWhitelistedProposalVotersRequest) ProtoMessage()    {}
+func (*QueryWhitelistedProposalVotersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{36}
 }
-
-func _Query_CouncilorByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CouncilorByAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CouncilorByAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kira.gov.Query/CouncilorByAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CouncilorByAddress(ctx, req.(*CouncilorByAddressRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryWhitelistedProposalVotersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_CouncilorByMoniker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CouncilorByMonikerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CouncilorByMoniker(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kira.gov.Query/CouncilorByMoniker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CouncilorByMoniker(ctx, req.(*CouncilorByMonikerRequest))
+func (m *QueryWhitelistedProposalVotersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWhitelistedProposalVotersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryWhitelistedProposalVotersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWhitelistedProposalVotersRequest.Merge(m, src)
+}
+func (m *QueryWhitelistedProposalVotersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWhitelistedProposalVotersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWhitelistedProposalVotersRequest.DiscardUnknown(m)
 }
 
-func _Query_GetNetworkProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NetworkPropertiesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetNetworkProperties(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kira.gov.Query/GetNetworkProperties",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetNetworkProperties(ctx, req.(*NetworkPropertiesRequest))
+var xxx_messageInfo_QueryWhitelistedProposalVotersRequest proto.InternalMessageInfo
+
+func (m *QueryWhitelistedProposalVotersRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetExecutionFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExecutionFeeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetExecutionFee(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kira.gov.Query/GetExecutionFee",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetExecutionFee(ctx, req.(*ExecutionFeeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryWhitelistedProposalVotersResponse is the response type for Query/WhitelistedProposalVoters RPC method.
+type QueryWhitelistedProposalVotersResponse struct {
+	Voters []NetworkActor `protobuf:"bytes,1,rep,name=voters,proto3" json:"voters"`
 }
 
-func _Query_GetPoorNetworkMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PoorNetworkMessagesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPoorNetworkMessages(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kira.gov.Query/GetPoorNetworkMessages",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPoorNetworkMessages(ctx, req.(*PoorNetworkMessagesRequest))
+func (m *QueryWhitelistedProposalVotersResponse) Reset() {
+	*m = QueryWhitelistedProposalVotersResponse{}
+}
+func (m *QueryWhitelistedProposalVotersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWhitelistedProposalVotersResponse) ProtoMessage()    {}
+func (*QueryWhitelistedProposalVotersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{37}
+}
+func (m *QueryWhitelistedProposalVotersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWhitelistedProposalVotersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWhitelistedProposalVotersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryWhitelistedProposalVotersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWhitelistedProposalVotersResponse.Merge(m, src)
+}
+func (m *QueryWhitelistedProposalVotersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWhitelistedProposalVotersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWhitelistedProposalVotersResponse.DiscardUnknown(m)
 }
 
-func _Query_Proposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Proposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kira.gov.Query/Proposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Proposal(ctx, req.(*QueryProposalRequest))
+var xxx_messageInfo_QueryWhitelistedProposalVotersResponse proto.InternalMessageInfo
+
+func (m *QueryWhitelistedProposalVotersResponse) GetVoters() []NetworkActor {
+	if m != nil {
+		return m.Voters
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Proposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProposalsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Proposals(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kira.gov.Query/Proposals",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Proposals(ctx, req.(*QueryProposalsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryVoteRequest is the request type for the Query/Vote RPC method.
+type QueryVoteRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter defines the oter address for the proposals.
+	Voter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
 }
 
-func _Query_GetWhitelistedProposalVoters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWhitelistedProposalVotersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetWhitelistedProposalVoters(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kira.gov.Query/GetWhitelistedProposalVoters",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetWhitelistedProposalVoters(ctx, req.(*QueryWhitelistedProposalVotersRequest))
+func (m *QueryVoteRequest) Reset()         { *m = QueryVoteRequest{} }
+func (m *QueryVoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteRequest) ProtoMessage()    {}
+func (*QueryVoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{38}
+}
+func (m *QueryVoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryVoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteRequest.Merge(m, src)
+}
+func (m *QueryVoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteRequest.DiscardUnknown(m)
 }
 
-func _Query_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVoteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Vote(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kira.gov.Query/Vote",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Vote(ctx, req.(*QueryVoteRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryVoteRequest proto.InternalMessageInfo
 
-func _Query_Votes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVotesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Votes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kira.gov.Query/Votes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Votes(ctx, req.(*QueryVotesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryVoteResponse is the response type for the Query/Vote RPC method.
+type QueryVoteResponse struct {
+	// vote defined the queried vote.
+	Vote Vote `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote"`
 }
 
-func _Query_GetAllDataReferenceKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDataReferenceKeysRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAllDataReferenceKeys(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kira.gov.Query/GetAllDataReferenceKeys",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAllDataReferenceKeys(ctx, req.(*QueryDataReferenceKeysRequest))
+func (m *QueryVoteResponse) Reset()         { *m = QueryVoteResponse{} }
+func (m *QueryVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteResponse) ProtoMessage()    {}
+func (*QueryVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{39}
+}
+func (m *QueryVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteResponse.Merge(m, src)
+}
+func (m *QueryVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteResponse.DiscardUnknown(m)
 }
 
-func _Query_GetDataReferenceByKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDataReferenceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetDataReferenceByKey(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kira.gov.Query/GetDataReferenceByKey",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetDataReferenceByKey(ctx, req.(*QueryDataReferenceRequest))
+var xxx_messageInfo_QueryVoteResponse proto.InternalMessageInfo
+
+func (m *QueryVoteResponse) GetVote() Vote {
+	if m != nil {
+		return m.Vote
 	}
-	return interceptor(ctx, in, info, handler)
+	return Vote{}
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kira.gov.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "PermissionsByAddress",
-			Handler:    _Query_PermissionsByAddress_Handler,
-		},
-		{
-			MethodName: "RolesByAddress",
-			Handler:    _Query_RolesByAddress_Handler,
-		},
-		{
-			MethodName: "RolePermissions",
-			Handler:    _Query_RolePermissions_Handler,
-		},
-		{
-			MethodName: "CouncilorByAddress",
-			Handler:    _Query_CouncilorByAddress_Handler,
-		},
-		{
-			MethodName: "CouncilorByMoniker",
-			Handler:    _Query_CouncilorByMoniker_Handler,
-		},
-		{
-			MethodName: "GetNetworkProperties",
-			Handler:    _Query_GetNetworkProperties_Handler,
-		},
-		{
-			MethodName: "GetExecutionFee",
-			Handler:    _Query_GetExecutionFee_Handler,
-		},
-		{
-			MethodName: "GetPoorNetworkMessages",
-			Handler:    _Query_GetPoorNetworkMessages_Handler,
-		},
-		{
-			MethodName: "Proposal",
-			Handler:    _Query_Proposal_Handler,
-		},
-		{
-			MethodName: "Proposals",
-			Handler:    _Query_Proposals_Handler,
-		},
-		{
-			MethodName: "GetWhitelistedProposalVoters",
-			Handler:    _Query_GetWhitelistedProposalVoters_Handler,
-		},
-		{
-			MethodName: "Vote",
-			Handler:    _Query_Vote_Handler,
-		},
-		{
-			MethodName: "Votes",
-			Handler:    _Query_Votes_Handler,
-		},
-		{
-			MethodName: "GetAllDataReferenceKeys",
-			Handler:    _Query_GetAllDataReferenceKeys_Handler,
-		},
-		{
-			MethodName: "GetDataReferenceByKey",
-			Handler:    _Query_GetDataReferenceByKey_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "query.proto",
+// QueryVoteHistoryRequest is the request type for the Query/VoteHistory RPC method.
+type QueryVoteHistoryRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter defines the voter address for the proposal.
+	Voter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
 }
 
-func (m *NetworkPropertiesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVoteHistoryRequest) Reset()         { *m = QueryVoteHistoryRequest{} }
+func (m *QueryVoteHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteHistoryRequest) ProtoMessage()    {}
+func (*QueryVoteHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{40}
+}
+func (m *QueryVoteHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *NetworkPropertiesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVoteHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteHistoryRequest.Merge(m, src)
+}
+func (m *QueryVoteHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *NetworkPropertiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryVoteHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryVoteHistoryRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
 }
 
-func (m *NetworkPropertiesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVoteHistoryRequest) GetVoter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Voter
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *NetworkPropertiesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVoteHistoryResponse is the response type for the Query/VoteHistory RPC method.
+type QueryVoteHistoryResponse struct {
+	// votes defined the votes cast by the voter, oldest first.
+	Votes []Vote `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes"`
 }
 
-func (m *NetworkPropertiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Properties != nil {
-		{
-			size, err := m.Properties.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryVoteHistoryResponse) Reset()         { *m = QueryVoteHistoryResponse{} }
+func (m *QueryVoteHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteHistoryResponse) ProtoMessage()    {}
+func (*QueryVoteHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{41}
+}
+func (m *QueryVoteHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *PermissionsByAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryVoteHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteHistoryResponse.Merge(m, src)
+}
+func (m *QueryVoteHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *PermissionsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_QueryVoteHistoryResponse proto.InternalMessageInfo
 
-func (m *PermissionsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValAddr) > 0 {
-		i -= len(m.ValAddr)
-		copy(dAtA[i:], m.ValAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryVoteHistoryResponse) GetVotes() []Vote {
+	if m != nil {
+		return m.Votes
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *PermissionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryVotesRequest is the request type for the Query/Votes RPC method.
+type QueryVotesRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func (m *PermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVotesRequest) Reset()         { *m = QueryVotesRequest{} }
+func (m *QueryVotesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVotesRequest) ProtoMessage()    {}
+func (*QueryVotesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{42}
 }
-
-func (m *PermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Permissions != nil {
-		{
-			size, err := m.Permissions.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryVotesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryVotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotesRequest.Merge(m, src)
+}
+func (m *QueryVotesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotesRequest.DiscardUnknown(m)
 }
 
-func (m *RolesByAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVotesRequest proto.InternalMessageInfo
+
+func (m *QueryVotesRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RolesByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVotesResponse is the response type for the Query/Votes RPC method.
+type QueryVotesResponse struct {
+	// votes defined the queried votes.
+	Votes []Vote `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes"`
 }
 
-func (m *RolesByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValAddr) > 0 {
-		i -= len(m.ValAddr)
-		copy(dAtA[i:], m.ValAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryVotesResponse) Reset()         { *m = QueryVotesResponse{} }
+func (m *QueryVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVotesResponse) ProtoMessage()    {}
+func (*QueryVotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{43}
+}
+func (m *QueryVotesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotesResponse.Merge(m, src)
+}
+func (m *QueryVotesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotesResponse.DiscardUnknown(m)
 }
 
-func (m *RolesByAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVotesResponse proto.InternalMessageInfo
+
+func (m *QueryVotesResponse) GetVotes() []Vote {
+	if m != nil {
+		return m.Votes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RolesByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTallyRequest is the request type for the Query/Tally RPC method.
+type QueryTallyRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func (m *RolesByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Roles) > 0 {
-		dAtA4 := make([]byte, len(m.Roles)*10)
-		var j3 int
-		for _, num := range m.Roles {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
+func (m *QueryTallyRequest) Reset()         { *m = QueryTallyRequest{} }
+func (m *QueryTallyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTallyRequest) ProtoMessage()    {}
+func (*QueryTallyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{44}
+}
+func (m *QueryTallyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTallyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTallyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintQuery(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTallyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTallyRequest.Merge(m, src)
+}
+func (m *QueryTallyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTallyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTallyRequest.DiscardUnknown(m)
 }
 
-func (m *RolePermissionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTallyRequest proto.InternalMessageInfo
+
+func (m *QueryTallyRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RolePermissionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTallyResponse is the response type for the Query/Tally RPC method.
+type QueryTallyResponse struct {
+	TallyResult   TallyResult   `protobuf:"bytes,1,opt,name=tally_result,json=tallyResult,proto3" json:"tally_result"`
+	WeightedTally WeightedTally `protobuf:"bytes,2,opt,name=weighted_tally,json=weightedTally,proto3" json:"weighted_tally"`
 }
 
-func (m *RolePermissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Role != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Role))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryTallyResponse) Reset()         { *m = QueryTallyResponse{} }
+func (m *QueryTallyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTallyResponse) ProtoMessage()    {}
+func (*QueryTallyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{45}
+}
+func (m *QueryTallyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTallyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTallyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTallyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTallyResponse.Merge(m, src)
+}
+func (m *QueryTallyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTallyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTallyResponse.DiscardUnknown(m)
 }
 
-func (m *RolePermissionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTallyResponse proto.InternalMessageInfo
+
+func (m *QueryTallyResponse) GetTallyResult() TallyResult {
+	if m != nil {
+		return m.TallyResult
 	}
-	return dAtA[:n], nil
+	return TallyResult{}
 }
 
-func (m *RolePermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTallyResponse) GetWeightedTally() WeightedTally {
+	if m != nil {
+		return m.WeightedTally
+	}
+	return WeightedTally{}
 }
 
-func (m *RolePermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Permissions != nil {
-		{
-			size, err := m.Permissions.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryDepositRequest is the request type for the Query/Deposit RPC method.
+type QueryDepositRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func (m *ExecutionFeeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDepositRequest) Reset()         { *m = QueryDepositRequest{} }
+func (m *QueryDepositRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositRequest) ProtoMessage()    {}
+func (*QueryDepositRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{46}
 }
-
-func (m *ExecutionFeeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDepositRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *ExecutionFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TransactionType) > 0 {
-		i -= len(m.TransactionType)
-		copy(dAtA[i:], m.TransactionType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TransactionType)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryDepositRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDepositRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositRequest.Merge(m, src)
+}
+func (m *QueryDepositRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositRequest.DiscardUnknown(m)
 }
 
-func (m *ExecutionFeeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDepositRequest proto.InternalMessageInfo
+
+func (m *QueryDepositRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ExecutionFeeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDepositResponse is the response type for the Query/Deposit RPC method.
+type QueryDepositResponse struct {
+	Deposit Deposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit"`
 }
 
-func (m *ExecutionFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Fee != nil {
-		{
-			size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDepositResponse) Reset()         { *m = QueryDepositResponse{} }
+func (m *QueryDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositResponse) ProtoMessage()    {}
+func (*QueryDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{47}
+}
+func (m *QueryDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *PoorNetworkMessagesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositResponse.Merge(m, src)
 }
-
-func (m *PoorNetworkMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDepositResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *PoorNetworkMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositResponse.DiscardUnknown(m)
 }
 
-func (m *PoorNetworkMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDepositResponse proto.InternalMessageInfo
+
+func (m *QueryDepositResponse) GetDeposit() Deposit {
+	if m != nil {
+		return m.Deposit
 	}
-	return dAtA[:n], nil
+	return Deposit{}
 }
 
-func (m *PoorNetworkMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDataReferenceKeysRequest is the request type for data reference keys query.
+type QueryDataReferenceKeysRequest struct {
+	Pagination *github_com_cosmos_cosmos_sdk_types_query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3,casttype=github.com/cosmos/cosmos-sdk/types/query.PageRequest" json:"pagination,omitempty"`
 }
 
-func (m *PoorNetworkMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Messages[iNdEx])
-			copy(dAtA[i:], m.Messages[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Messages[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDataReferenceKeysRequest) Reset()         { *m = QueryDataReferenceKeysRequest{} }
+func (m *QueryDataReferenceKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDataReferenceKeysRequest) ProtoMessage()    {}
+func (*QueryDataReferenceKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{48}
+}
+func (m *QueryDataReferenceKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataReferenceKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataReferenceKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDataReferenceKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataReferenceKeysRequest.Merge(m, src)
+}
+func (m *QueryDataReferenceKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataReferenceKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataReferenceKeysRequest.DiscardUnknown(m)
 }
 
-func (m *CouncilorByAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDataReferenceKeysRequest proto.InternalMessageInfo
+
+func (m *QueryDataReferenceKeysRequest) GetPagination() *github_com_cosmos_cosmos_sdk_types_query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CouncilorByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDataReferenceKeysResponse is the response type for data reference keys query.
+type QueryDataReferenceKeysResponse struct {
+	Keys       []string                                               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Pagination *github_com_cosmos_cosmos_sdk_types_query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3,casttype=github.com/cosmos/cosmos-sdk/types/query.PageResponse" json:"pagination,omitempty"`
 }
 
-func (m *CouncilorByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValAddr) > 0 {
-		i -= len(m.ValAddr)
-		copy(dAtA[i:], m.ValAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryDataReferenceKeysResponse) Reset()         { *m = QueryDataReferenceKeysResponse{} }
+func (m *QueryDataReferenceKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDataReferenceKeysResponse) ProtoMessage()    {}
+func (*QueryDataReferenceKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{49}
+}
+func (m *QueryDataReferenceKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataReferenceKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataReferenceKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDataReferenceKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataReferenceKeysResponse.Merge(m, src)
+}
+func (m *QueryDataReferenceKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataReferenceKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataReferenceKeysResponse.DiscardUnknown(m)
 }
 
-func (m *CouncilorByMonikerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDataReferenceKeysResponse proto.InternalMessageInfo
+
+func (m *QueryDataReferenceKeysResponse) GetKeys() []string {
+	if m != nil {
+		return m.Keys
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CouncilorByMonikerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDataReferenceKeysResponse) GetPagination() *github_com_cosmos_cosmos_sdk_types_query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *CouncilorByMonikerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Moniker) > 0 {
-		i -= len(m.Moniker)
-		copy(dAtA[i:], m.Moniker)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Moniker)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryDataReferenceRequest is the response type for data reference query.
+type QueryDataReferenceRequest struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (m *CouncilorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDataReferenceRequest) Reset()         { *m = QueryDataReferenceRequest{} }
+func (m *QueryDataReferenceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDataReferenceRequest) ProtoMessage()    {}
+func (*QueryDataReferenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{50}
+}
+func (m *QueryDataReferenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataReferenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataReferenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryDataReferenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataReferenceRequest.Merge(m, src)
+}
+func (m *QueryDataReferenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataReferenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataReferenceRequest.DiscardUnknown(m)
 }
 
-func (m *CouncilorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryDataReferenceRequest proto.InternalMessageInfo
+
+func (m *QueryDataReferenceRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
 }
 
-func (m *CouncilorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Councilor.MarshalToSizedBuffer(dAtA[:i])
+// QueryDataReferenceResponse is the response type for data referencequery.
+type QueryDataReferenceResponse struct {
+	Data *DataRegistryEntry `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryDataReferenceResponse) Reset()         { *m = QueryDataReferenceResponse{} }
+func (m *QueryDataReferenceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDataReferenceResponse) ProtoMessage()    {}
+func (*QueryDataReferenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{51}
+}
+func (m *QueryDataReferenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataReferenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataReferenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryDataReferenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataReferenceResponse.Merge(m, src)
+}
+func (m *QueryDataReferenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataReferenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataReferenceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryProposalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDataReferenceResponse proto.InternalMessageInfo
+
+func (m *QueryDataReferenceResponse) GetData() *DataRegistryEntry {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryProposalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*NetworkPropertiesRequest)(nil), "kira.gov.NetworkPropertiesRequest")
+	proto.RegisterType((*NetworkPropertiesResponse)(nil), "kira.gov.NetworkPropertiesResponse")
+	proto.RegisterType((*PermissionsByAddressRequest)(nil), "kira.gov.PermissionsByAddressRequest")
+	proto.RegisterType((*PermissionsResponse)(nil), "kira.gov.PermissionsResponse")
+	proto.RegisterType((*RolesByAddressRequest)(nil), "kira.gov.RolesByAddressRequest")
+	proto.RegisterType((*RolesByAddressResponse)(nil), "kira.gov.RolesByAddressResponse")
+	proto.RegisterType((*RolePermissionsRequest)(nil), "kira.gov.RolePermissionsRequest")
+	proto.RegisterType((*RolePermissionsResponse)(nil), "kira.gov.RolePermissionsResponse")
+	proto.RegisterType((*PermissionGrantsRequest)(nil), "kira.gov.PermissionGrantsRequest")
+	proto.RegisterType((*PermissionGrantsResponse)(nil), "kira.gov.PermissionGrantsResponse")
+	proto.RegisterType((*AuthorizationsRequest)(nil), "kira.gov.AuthorizationsRequest")
+	proto.RegisterType((*AuthorizationsResponse)(nil), "kira.gov.AuthorizationsResponse")
+	proto.RegisterType((*GroupRequest)(nil), "kira.gov.GroupRequest")
+	proto.RegisterType((*GroupResponse)(nil), "kira.gov.GroupResponse")
+	proto.RegisterType((*GroupProposalsRequest)(nil), "kira.gov.GroupProposalsRequest")
+	proto.RegisterType((*GroupProposalsResponse)(nil), "kira.gov.GroupProposalsResponse")
+	proto.RegisterType((*RoleDetails)(nil), "kira.gov.RoleDetails")
+	proto.RegisterType((*AllRolesRequest)(nil), "kira.gov.AllRolesRequest")
+	proto.RegisterType((*AllRolesResponse)(nil), "kira.gov.AllRolesResponse")
+	proto.RegisterType((*RoleRequest)(nil), "kira.gov.RoleRequest")
+	proto.RegisterType((*RoleResponse)(nil), "kira.gov.RoleResponse")
+	proto.RegisterType((*RoleActorsRequest)(nil), "kira.gov.RoleActorsRequest")
+	proto.RegisterType((*RoleActorsResponse)(nil), "kira.gov.RoleActorsResponse")
+	proto.RegisterType((*ExecutionFeeRequest)(nil), "kira.gov.ExecutionFeeRequest")
+	proto.RegisterType((*ExecutionFeeResponse)(nil), "kira.gov.ExecutionFeeResponse")
+	proto.RegisterType((*PoorNetworkMessagesRequest)(nil), "kira.gov.PoorNetworkMessagesRequest")
+	proto.RegisterType((*PoorNetworkMessagesResponse)(nil), "kira.gov.PoorNetworkMessagesResponse")
+	proto.RegisterType((*CouncilorByAddressRequest)(nil), "kira.gov.CouncilorByAddressRequest")
+	proto.RegisterType((*CouncilorByMonikerRequest)(nil), "kira.gov.CouncilorByMonikerRequest")
+	proto.RegisterType((*CouncilorResponse)(nil), "kira.gov.CouncilorResponse")
+	proto.RegisterType((*CouncilorsRequest)(nil), "kira.gov.CouncilorsRequest")
+	proto.RegisterType((*CouncilorsResponse)(nil), "kira.gov.CouncilorsResponse")
+	proto.RegisterType((*QueryProposalRequest)(nil), "kira.gov.QueryProposalRequest")
+	proto.RegisterType((*QueryProposalResponse)(nil), "kira.gov.QueryProposalResponse")
+	proto.RegisterType((*QueryProposalsRequest)(nil), "kira.gov.QueryProposalsRequest")
+	proto.RegisterType((*QueryProposalsResponse)(nil), "kira.gov.QueryProposalsResponse")
+	proto.RegisterType((*QueryWhitelistedProposalVotersRequest)(nil), "kira.gov.QueryWhitelistedProposalVotersRequest")
+	proto.RegisterType((*QueryWhitelistedProposalVotersResponse)(nil), "kira.gov.QueryWhitelistedProposalVotersResponse")
+	proto.RegisterType((*QueryVoteRequest)(nil), "kira.gov.QueryVoteRequest")
+	proto.RegisterType((*QueryVoteResponse)(nil), "kira.gov.QueryVoteResponse")
+	proto.RegisterType((*QueryVoteHistoryRequest)(nil), "kira.gov.QueryVoteHistoryRequest")
+	proto.RegisterType((*QueryVoteHistoryResponse)(nil), "kira.gov.QueryVoteHistoryResponse")
+	proto.RegisterType((*QueryVotesRequest)(nil), "kira.gov.QueryVotesRequest")
+	proto.RegisterType((*QueryVotesResponse)(nil), "kira.gov.QueryVotesResponse")
+	proto.RegisterType((*QueryTallyRequest)(nil), "kira.gov.QueryTallyRequest")
+	proto.RegisterType((*QueryTallyResponse)(nil), "kira.gov.QueryTallyResponse")
+	proto.RegisterType((*QueryDepositRequest)(nil), "kira.gov.QueryDepositRequest")
+	proto.RegisterType((*QueryDepositResponse)(nil), "kira.gov.QueryDepositResponse")
+	proto.RegisterType((*QueryDataReferenceKeysRequest)(nil), "kira.gov.QueryDataReferenceKeysRequest")
+	proto.RegisterType((*QueryDataReferenceKeysResponse)(nil), "kira.gov.QueryDataReferenceKeysResponse")
+	proto.RegisterType((*QueryDataReferenceRequest)(nil), "kira.gov.QueryDataReferenceRequest")
+	proto.RegisterType((*QueryDataReferenceResponse)(nil), "kira.gov.QueryDataReferenceResponse")
 }
 
-func (m *QueryProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("query.proto", fileDescriptor_5c6ac9b241082464) }
+
+var fileDescriptor_5c6ac9b241082464 = []byte{
+	// 1997 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0xca, 0x94, 0x44, 0x3d, 0x2a, 0xfa, 0x18, 0x51, 0x94, 0xb4, 0x92, 0x48, 0x79, 0x1c,
+	0xa5, 0x4a, 0x13, 0x8b, 0x8d, 0xe3, 0xc4, 0xb5, 0x9b, 0x34, 0x16, 0x6d, 0x85, 0x11, 0x5c, 0xa7,
+	0x2a, 0x91, 0x2f, 0xb4, 0x68, 0x88, 0x0d, 0x39, 0xa2, 0x17, 0x5c, 0x71, 0xe8, 0xdd, 0xa1, 0x6c,
+	0x26, 0x4d, 0x51, 0xb4, 0x40, 0x91, 0xde, 0x52, 0xf4, 0xd6, 0x53, 0x6e, 0x45, 0xff, 0x93, 0x1c,
+	0x7a, 0x08, 0x50, 0x14, 0xe8, 0x49, 0x28, 0xec, 0x1e, 0x7a, 0xce, 0xd1, 0xa7, 0x62, 0x67, 0x67,
+	0x66, 0x67, 0xbf, 0x48, 0xc5, 0x41, 0xd3, 0x93, 0xb8, 0xef, 0xfd, 0xde, 0xef, 0x7d, 0xec, 0xee,
+	0xdb, 0xf7, 0x46, 0x50, 0xb8, 0x3f, 0x20, 0xee, 0x70, 0xaf, 0xef, 0x52, 0x46, 0x51, 0xbe, 0x6b,
+	0xbb, 0xd6, 0x5e, 0x87, 0x9e, 0x9a, 0x05, 0xab, 0xc5, 0xa8, 0x1b, 0x88, 0xcd, 0x65, 0x6b, 0xc0,
+	0xee, 0x51, 0xd7, 0xfe, 0xd8, 0x62, 0x36, 0xed, 0x09, 0xe1, 0x42, 0x8b, 0x0e, 0x7a, 0x2d, 0xdb,
+	0x51, 0xa8, 0x42, 0xc7, 0xa5, 0x83, 0xbe, 0x34, 0x69, 0x5b, 0xcc, 0x6a, 0xba, 0xa4, 0x63, 0x7b,
+	0x4c, 0xd2, 0x9b, 0x8b, 0x7d, 0xab, 0x63, 0xf7, 0x74, 0x12, 0x70, 0xa9, 0x43, 0xa4, 0x09, 0x79,
+	0x48, 0x5a, 0x03, 0x5f, 0xd9, 0x3c, 0x26, 0x52, 0xb8, 0xd6, 0x23, 0xec, 0x01, 0x75, 0xbb, 0xcd,
+	0xbe, 0x4b, 0xfb, 0xc4, 0x65, 0x36, 0xf1, 0x84, 0x66, 0xde, 0x97, 0x50, 0xcf, 0x72, 0xc4, 0x75,
+	0xb1, 0x43, 0x3b, 0x94, 0xff, 0xac, 0xfa, 0xbf, 0x84, 0x74, 0xb3, 0x43, 0x69, 0xc7, 0x21, 0x55,
+	0xab, 0x6f, 0x57, 0xad, 0x5e, 0x8f, 0x32, 0xee, 0x5d, 0x70, 0x60, 0x13, 0xd6, 0xde, 0x0e, 0xf8,
+	0x8f, 0x14, 0x7d, 0x83, 0xdc, 0x1f, 0x10, 0x8f, 0xe1, 0x0f, 0x60, 0x3d, 0x45, 0xe7, 0xf5, 0x69,
+	0xcf, 0x23, 0xe8, 0x47, 0x00, 0x61, 0x40, 0x6b, 0xc6, 0xb6, 0xb1, 0x5b, 0xb8, 0xb2, 0xb1, 0x27,
+	0xab, 0xb7, 0x97, 0x34, 0xd4, 0xe0, 0xf8, 0xd7, 0xb0, 0x71, 0x44, 0xdc, 0x13, 0xdb, 0xf3, 0xfc,
+	0x50, 0x6a, 0xc3, 0xfd, 0x76, 0xdb, 0x25, 0x9e, 0x74, 0x8c, 0x9a, 0x90, 0x3f, 0xb5, 0x9c, 0xa6,
+	0xd5, 0x6e, 0xbb, 0x9c, 0x79, 0xae, 0x76, 0xfb, 0xeb, 0xb3, 0xca, 0xc2, 0xd0, 0x3a, 0x71, 0x6e,
+	0x60, 0xa9, 0xc1, 0x4f, 0xce, 0x2a, 0x97, 0x3b, 0x36, 0xbb, 0x37, 0xf8, 0x68, 0xaf, 0x45, 0x4f,
+	0xaa, 0x2d, 0xea, 0x9d, 0x50, 0x4f, 0xfc, 0xb9, 0xec, 0xb5, 0xbb, 0x55, 0x36, 0xec, 0x13, 0x6f,
+	0x6f, 0xbf, 0xd5, 0x92, 0xf4, 0x33, 0xa7, 0x96, 0xe3, 0xff, 0xc6, 0x6f, 0xc3, 0xb2, 0xe6, 0x5f,
+	0xe5, 0x74, 0x0d, 0x0a, 0xfd, 0x50, 0x2c, 0x92, 0x5a, 0x09, 0x93, 0xd2, 0x6d, 0x74, 0x24, 0x7e,
+	0x08, 0x2b, 0x0d, 0xea, 0x90, 0xff, 0x43, 0x26, 0x7b, 0x50, 0x8a, 0x7b, 0x16, 0xc9, 0x14, 0x61,
+	0xca, 0x7f, 0xb4, 0xfc, 0x34, 0x2e, 0xec, 0xe6, 0x1a, 0xc1, 0x05, 0x7e, 0x31, 0xc0, 0x47, 0xb2,
+	0x0f, 0x42, 0x45, 0x90, 0xf3, 0x21, 0x3c, 0xcc, 0x5c, 0x83, 0xff, 0xc6, 0x0d, 0x58, 0x4d, 0xa0,
+	0xbf, 0x7d, 0xad, 0x56, 0x43, 0x5d, 0xdd, 0xb5, 0x7a, 0x4c, 0x85, 0xf0, 0x4b, 0x98, 0xb1, 0x82,
+	0x2c, 0x44, 0xb1, 0x6e, 0x7d, 0x7d, 0x56, 0x99, 0x0f, 0x8a, 0x25, 0x14, 0x4f, 0x53, 0x2b, 0x69,
+	0xfa, 0x47, 0x03, 0xd6, 0x92, 0xae, 0x55, 0x3e, 0xd3, 0x1d, 0x2e, 0xe1, 0xf5, 0x2a, 0x5c, 0x59,
+	0x4f, 0x4b, 0x85, 0xdb, 0xd4, 0x72, 0x5f, 0x9e, 0x55, 0x26, 0x1a, 0x02, 0x8e, 0x5e, 0x83, 0x3c,
+	0x79, 0xd8, 0xb7, 0x5d, 0xff, 0x35, 0x98, 0xe4, 0xa6, 0x66, 0x9a, 0xe9, 0x81, 0x8f, 0x19, 0x0a,
+	0x5b, 0x65, 0x81, 0xff, 0x61, 0xc0, 0xca, 0xbe, 0xde, 0x5b, 0xf4, 0x62, 0x70, 0x0f, 0xc4, 0x4d,
+	0x16, 0x43, 0x28, 0x9e, 0xa6, 0x18, 0xc2, 0x34, 0xa4, 0x27, 0x6b, 0x93, 0xe9, 0xf4, 0xe4, 0xe9,
+	0xe9, 0x09, 0x6e, 0x42, 0x29, 0x9e, 0x96, 0x28, 0xf4, 0x01, 0xcc, 0x47, 0x9a, 0xa9, 0x2c, 0xf8,
+	0x6a, 0x58, 0xb5, 0x88, 0xa5, 0x28, 0x59, 0xcc, 0x08, 0x7f, 0x66, 0xc0, 0x5c, 0xdd, 0x6f, 0xb7,
+	0xb2, 0x5e, 0xeb, 0x90, 0xe7, 0xed, 0xb7, 0x69, 0xb7, 0xc5, 0x33, 0x3c, 0xc3, 0xaf, 0x0f, 0xdb,
+	0xfa, 0x73, 0x35, 0xf9, 0x3f, 0x78, 0xae, 0x5e, 0x83, 0x67, 0x44, 0x24, 0x22, 0xc5, 0x17, 0x60,
+	0x8a, 0xbb, 0x16, 0x6f, 0xc5, 0x42, 0x98, 0x19, 0xc7, 0x89, 0x8c, 0x02, 0x0c, 0xbe, 0x02, 0x2b,
+	0x5c, 0x7a, 0x24, 0x9a, 0xb9, 0x37, 0x3e, 0x21, 0xfc, 0x2e, 0x94, 0xe2, 0x36, 0xaa, 0x2d, 0xcf,
+	0xca, 0xaf, 0x42, 0x4a, 0x61, 0x23, 0x46, 0x22, 0x8c, 0x10, 0x8f, 0x3f, 0x86, 0x82, 0xff, 0xba,
+	0xdf, 0x26, 0xcc, 0xb2, 0x1d, 0x0f, 0xbd, 0x08, 0x39, 0xbb, 0x77, 0x4c, 0x45, 0x16, 0x28, 0xa4,
+	0xf1, 0x41, 0x87, 0xbd, 0x63, 0x2a, 0x18, 0x38, 0x0a, 0xbd, 0x1e, 0x6d, 0x08, 0x93, 0x23, 0x1a,
+	0x82, 0xb0, 0x8b, 0xb4, 0x85, 0x25, 0x58, 0xd8, 0x77, 0x1c, 0x9f, 0x59, 0x7d, 0x7f, 0x0e, 0x60,
+	0x31, 0x14, 0x89, 0xfc, 0x5e, 0xd2, 0xbb, 0x5a, 0x84, 0x5f, 0x8b, 0x5c, 0x16, 0x38, 0x68, 0x79,
+	0x97, 0x83, 0xac, 0x64, 0x59, 0xcb, 0x00, 0x76, 0x9b, 0xf4, 0x98, 0x7d, 0x6c, 0x8b, 0x57, 0x6b,
+	0xb6, 0xa1, 0x49, 0xf0, 0x1b, 0x30, 0x17, 0xc0, 0x85, 0xc7, 0xaa, 0xd6, 0x17, 0xc7, 0x38, 0x0c,
+	0x9a, 0xe6, 0xcb, 0xb0, 0xe4, 0xab, 0xf6, 0xfd, 0xf1, 0xc1, 0x3b, 0xaf, 0xd7, 0x26, 0x20, 0xdd,
+	0x48, 0xf8, 0x3e, 0x84, 0x69, 0x3e, 0x85, 0x04, 0xe9, 0xce, 0xd5, 0x5e, 0xfa, 0xe6, 0x4f, 0xa9,
+	0x20, 0xc0, 0x37, 0x61, 0xf9, 0x40, 0x4e, 0x17, 0x6f, 0x12, 0x55, 0x8d, 0xe7, 0x61, 0x91, 0xb9,
+	0x56, 0xcf, 0xb3, 0x5a, 0x7c, 0xec, 0xf0, 0xcd, 0x45, 0x74, 0x0b, 0x9a, 0xfc, 0x9d, 0x61, 0x9f,
+	0xe0, 0x9b, 0x50, 0x8c, 0x32, 0x88, 0x20, 0x77, 0xe1, 0xc2, 0x31, 0x91, 0xf5, 0x29, 0x85, 0xf5,
+	0x89, 0x80, 0x7d, 0x08, 0xde, 0x04, 0xf3, 0x88, 0x52, 0x57, 0xcc, 0x06, 0x77, 0x89, 0xe7, 0x59,
+	0x9d, 0xf0, 0x76, 0x5f, 0x87, 0x8d, 0x54, 0xad, 0x70, 0x63, 0x42, 0xfe, 0x44, 0xc8, 0x78, 0x35,
+	0x66, 0x1b, 0xea, 0x1a, 0xff, 0x0a, 0xd6, 0x6f, 0xc9, 0x59, 0xec, 0xbb, 0xff, 0x06, 0xbf, 0x12,
+	0xf1, 0x7e, 0x97, 0xf6, 0xec, 0x2e, 0x71, 0xa5, 0xf7, 0x35, 0x98, 0x39, 0x09, 0x24, 0xa2, 0xae,
+	0xf2, 0x12, 0xff, 0x04, 0x96, 0x94, 0x99, 0xf6, 0x19, 0x9a, 0x55, 0x53, 0xa5, 0x28, 0xe9, 0x72,
+	0x58, 0xd2, 0xd0, 0x8d, 0x78, 0x77, 0x15, 0x16, 0xbf, 0xa0, 0xb1, 0xa9, 0xd4, 0x4b, 0x30, 0xed,
+	0x31, 0x8b, 0x0d, 0x3c, 0xe1, 0x5b, 0x5c, 0xe1, 0x9f, 0x02, 0xd2, 0xc1, 0xc2, 0xf7, 0x75, 0x00,
+	0xc5, 0x27, 0x5f, 0xb0, 0x11, 0xce, 0x35, 0x30, 0xbe, 0x06, 0xc5, 0x9f, 0xf9, 0x53, 0xb4, 0xec,
+	0x2d, 0x32, 0x80, 0x0a, 0x14, 0x64, 0x7b, 0x09, 0xdb, 0x18, 0x48, 0xd1, 0x61, 0x1b, 0x0f, 0x61,
+	0x25, 0x66, 0x28, 0x82, 0xb9, 0x0a, 0x79, 0x09, 0x4b, 0x36, 0xa0, 0x58, 0x0b, 0x53, 0x48, 0xf4,
+	0x7d, 0x98, 0x3a, 0xa5, 0x4c, 0x7d, 0x89, 0xe7, 0x43, 0x93, 0xf7, 0x28, 0x23, 0xb2, 0x2f, 0x70,
+	0x08, 0xbe, 0x16, 0x73, 0xad, 0xaa, 0x56, 0x0c, 0x48, 0xe4, 0x0d, 0x0b, 0x2e, 0x6e, 0xe4, 0x3f,
+	0xfb, 0xa2, 0x32, 0xf1, 0x9f, 0x2f, 0x2a, 0x13, 0xf8, 0x08, 0x4a, 0x71, 0x43, 0x11, 0xf4, 0xab,
+	0xc9, 0xee, 0x9b, 0x1d, 0x75, 0x08, 0xc5, 0x6f, 0xc1, 0x0e, 0x67, 0x7c, 0xff, 0x9e, 0xcd, 0x88,
+	0x63, 0x7b, 0x8c, 0xb4, 0x25, 0xd8, 0x8f, 0xdb, 0xf5, 0xce, 0x5d, 0xcf, 0x0f, 0xe1, 0xb9, 0x71,
+	0x4c, 0xaa, 0xc0, 0xd3, 0x3c, 0x31, 0x19, 0x68, 0x29, 0x31, 0xbc, 0xf3, 0x66, 0x24, 0xa7, 0x9d,
+	0x00, 0x8b, 0x7f, 0x6f, 0xc0, 0x22, 0x77, 0xe0, 0xb3, 0x9d, 0x37, 0x2a, 0x54, 0x97, 0x15, 0x0d,
+	0x3e, 0xbf, 0x4f, 0xd1, 0xc6, 0x12, 0x37, 0xe1, 0x75, 0x58, 0xd2, 0xe2, 0x50, 0xad, 0x28, 0xe7,
+	0xe3, 0xc4, 0x03, 0x93, 0x7e, 0xf7, 0x39, 0x02, 0xff, 0xce, 0x80, 0x55, 0x65, 0xff, 0x96, 0xed,
+	0x31, 0xea, 0x0e, 0xbf, 0xf3, 0x74, 0xf0, 0x9b, 0xb0, 0x96, 0x0c, 0x42, 0xe4, 0xa2, 0x1e, 0x65,
+	0x63, 0xfc, 0xa3, 0x7c, 0x55, 0x2b, 0xc6, 0xf9, 0x9f, 0x95, 0x9b, 0x80, 0x74, 0xab, 0x6f, 0xe1,
+	0xf7, 0x1d, 0xcb, 0x71, 0xce, 0x5d, 0x3e, 0xfc, 0x67, 0x03, 0x90, 0x6e, 0x26, 0x1c, 0xff, 0x18,
+	0xe6, 0x98, 0x2f, 0x68, 0xba, 0xc4, 0x1b, 0x38, 0x2c, 0xf9, 0xc1, 0x95, 0xf0, 0x81, 0x23, 0x67,
+	0xf0, 0x02, 0x0b, 0x45, 0xe8, 0x36, 0xcc, 0x3f, 0x20, 0x76, 0xe7, 0x1e, 0x23, 0xed, 0x26, 0x97,
+	0x8b, 0x19, 0x44, 0x9b, 0x7f, 0xde, 0x17, 0x7a, 0xce, 0x24, 0x38, 0x9e, 0x79, 0xa0, 0x0b, 0xf1,
+	0xab, 0xb0, 0xcc, 0x63, 0xbb, 0x4d, 0xfa, 0xd4, 0xb3, 0xd9, 0xb9, 0x93, 0x3a, 0x84, 0x62, 0xd4,
+	0x4e, 0x0d, 0x2c, 0x33, 0xed, 0x40, 0x24, 0x12, 0x5a, 0x0a, 0xc3, 0x11, 0x58, 0x11, 0x88, 0xc4,
+	0xe1, 0xcf, 0x0d, 0xd8, 0x0a, 0xb8, 0x2c, 0x66, 0x35, 0xc8, 0x31, 0x71, 0x49, 0xaf, 0x45, 0xee,
+	0x90, 0xa1, 0xba, 0xb5, 0x14, 0x20, 0x3c, 0x48, 0x48, 0xd9, 0xbd, 0xac, 0x8e, 0x7c, 0x37, 0x6b,
+	0x3f, 0x7c, 0x72, 0x56, 0xb9, 0x3a, 0xfe, 0xe1, 0xac, 0x06, 0x87, 0x20, 0x9a, 0x65, 0x43, 0x73,
+	0x81, 0xff, 0x62, 0x40, 0x39, 0x2b, 0x24, 0x91, 0x28, 0x82, 0x5c, 0x97, 0x0c, 0xe5, 0xb7, 0x99,
+	0xff, 0x46, 0xf7, 0x23, 0x71, 0x4e, 0xc6, 0x27, 0x84, 0xc0, 0x5b, 0x60, 0x5f, 0xbb, 0xfe, 0xe4,
+	0xac, 0xf2, 0xca, 0x37, 0x0c, 0x34, 0x30, 0x8d, 0x44, 0x7a, 0x19, 0xd6, 0x93, 0x81, 0xca, 0xba,
+	0x2d, 0xc2, 0x85, 0x2e, 0x19, 0x8a, 0xbe, 0xee, 0xff, 0xc4, 0x77, 0xc1, 0x4c, 0x83, 0x87, 0xb3,
+	0x9f, 0x7f, 0x8a, 0x93, 0x3c, 0xde, 0x08, 0xe0, 0xc1, 0xd1, 0xce, 0x41, 0x8f, 0xb9, 0xc3, 0x06,
+	0x07, 0x5e, 0xf9, 0xdb, 0x32, 0x4c, 0x71, 0x3e, 0xf4, 0x21, 0x14, 0xd3, 0x8e, 0x38, 0xd0, 0x4e,
+	0xfa, 0x44, 0x1c, 0x1b, 0x5a, 0xcc, 0xad, 0x54, 0x98, 0x0c, 0x0c, 0x4f, 0xa0, 0x5f, 0xc0, 0x62,
+	0x7c, 0x97, 0x45, 0x17, 0x33, 0x77, 0x56, 0xc5, 0x8b, 0x47, 0x41, 0x14, 0xf9, 0xbb, 0x30, 0x1f,
+	0xdd, 0xde, 0x50, 0x25, 0x63, 0x3b, 0x53, 0xc4, 0xdb, 0xd9, 0x00, 0x45, 0x7b, 0x03, 0xa6, 0xf8,
+	0x06, 0x82, 0x4a, 0xb1, 0x95, 0x44, 0x92, 0xac, 0x26, 0xe4, 0x7a, 0x48, 0xd1, 0x95, 0x47, 0x0f,
+	0x29, 0x75, 0x81, 0x32, 0xb7, 0xb3, 0x01, 0x3a, 0x6d, 0xf4, 0xfc, 0x44, 0xa7, 0x4d, 0x3d, 0xd3,
+	0x31, 0xb7, 0xb3, 0x01, 0x8a, 0xf6, 0x03, 0x58, 0x88, 0x1d, 0x9c, 0xa0, 0x98, 0x59, 0xf2, 0x04,
+	0xc6, 0xbc, 0x38, 0x02, 0xa1, 0x98, 0x6f, 0x41, 0x5e, 0x2e, 0x45, 0x48, 0x3b, 0xa3, 0x88, 0xed,
+	0x4e, 0xa6, 0x99, 0xa6, 0x52, 0x24, 0xd7, 0x20, 0xe7, 0x8b, 0x50, 0x6c, 0x9b, 0x91, 0xc6, 0xa5,
+	0xb8, 0x58, 0x19, 0x1e, 0x02, 0x84, 0x6b, 0x0a, 0xda, 0x88, 0xe2, 0x22, 0x1b, 0x8f, 0xb9, 0x99,
+	0xae, 0xd4, 0x4a, 0x84, 0x92, 0x33, 0x3b, 0xba, 0x94, 0x36, 0x6f, 0xc6, 0xef, 0xc0, 0x46, 0x0a,
+	0x28, 0x93, 0x59, 0xcc, 0xe3, 0x19, 0xcc, 0xd1, 0x69, 0x7d, 0x1c, 0xf3, 0x21, 0x80, 0x12, 0x47,
+	0xd2, 0x4f, 0x8c, 0xde, 0xe6, 0x66, 0xba, 0x52, 0x51, 0x59, 0x50, 0xac, 0x13, 0x96, 0x38, 0x26,
+	0x45, 0x78, 0xd4, 0x19, 0xaa, 0xe0, 0xbe, 0x34, 0x12, 0xa3, 0x5c, 0x34, 0x60, 0xa1, 0x4e, 0x98,
+	0xbe, 0x86, 0xa1, 0xad, 0x8c, 0xf5, 0x4c, 0x10, 0x97, 0xb3, 0xd4, 0x8a, 0xb3, 0x03, 0xa5, 0x3a,
+	0x61, 0x29, 0x7b, 0x1a, 0x7a, 0x56, 0xeb, 0x2c, 0x99, 0x4b, 0x9e, 0xb9, 0x33, 0x06, 0xa5, 0x1c,
+	0x9d, 0x42, 0x5e, 0xbe, 0xaf, 0x48, 0x0b, 0x2b, 0x6d, 0xcb, 0x30, 0x2b, 0x99, 0x7a, 0x41, 0xf7,
+	0xfc, 0x6f, 0xff, 0xfe, 0xef, 0x3f, 0x4d, 0x5e, 0x42, 0x17, 0xab, 0x3e, 0xb0, 0xda, 0xa1, 0xa7,
+	0x55, 0x35, 0x7c, 0x57, 0x3f, 0xd1, 0x3e, 0xec, 0x9f, 0xa2, 0x2e, 0xcc, 0xa6, 0xb6, 0x98, 0xd4,
+	0x55, 0xc1, 0xdc, 0xce, 0x06, 0x08, 0xd7, 0x1b, 0xdc, 0xf5, 0x0a, 0x5a, 0x4e, 0x71, 0x8d, 0xfe,
+	0x6a, 0xc0, 0x66, 0x9d, 0xb0, 0xcc, 0x61, 0x1d, 0x55, 0x63, 0xfc, 0xe3, 0x16, 0x04, 0xf3, 0x07,
+	0xe7, 0x37, 0x10, 0x01, 0x3e, 0xc7, 0x03, 0xdc, 0x46, 0xe5, 0x30, 0xc0, 0x60, 0xd6, 0x8f, 0x15,
+	0x66, 0x1f, 0x72, 0xbe, 0x25, 0x32, 0x63, 0x1e, 0xb4, 0x45, 0xc0, 0xdc, 0x48, 0xd5, 0xa9, 0x7b,
+	0xfa, 0x1e, 0x14, 0xb4, 0x49, 0x17, 0x5d, 0x4c, 0x41, 0x47, 0x47, 0x71, 0x13, 0x8f, 0x82, 0x28,
+	0x5e, 0x1b, 0xa6, 0x7c, 0x45, 0xe4, 0x8d, 0x4c, 0xcc, 0xc3, 0xe6, 0x66, 0xba, 0x52, 0xb0, 0xec,
+	0xf0, 0x32, 0x54, 0xd0, 0x56, 0xb4, 0x0c, 0xf1, 0x2a, 0xd8, 0x30, 0xc5, 0xe7, 0xc4, 0x84, 0x2b,
+	0x7d, 0x04, 0x36, 0x37, 0xd3, 0x95, 0xd9, 0xae, 0xf8, 0xbc, 0x1a, 0x73, 0xe5, 0xc2, 0x8c, 0x18,
+	0x10, 0xd1, 0x56, 0x8c, 0x2f, 0x3a, 0x9c, 0x9a, 0xe5, 0x2c, 0xb5, 0x70, 0xb8, 0xcb, 0x1d, 0x62,
+	0xb4, 0x1d, 0x3a, 0x14, 0xb3, 0x66, 0x3c, 0xbd, 0x3f, 0x18, 0xb0, 0x5a, 0x27, 0x6c, 0xdf, 0x71,
+	0x12, 0x83, 0x1e, 0xfa, 0x5e, 0xdc, 0x4b, 0xc6, 0x74, 0x6a, 0xee, 0x8e, 0x07, 0x66, 0xbf, 0x1c,
+	0xfc, 0xbf, 0x66, 0x7c, 0x78, 0xfc, 0x8d, 0x01, 0x2b, 0x75, 0xc2, 0x22, 0xd6, 0xb5, 0xe1, 0x1d,
+	0x32, 0x44, 0x97, 0x46, 0x39, 0x90, 0x51, 0x3c, 0x3b, 0x1a, 0x24, 0x22, 0xd8, 0xe4, 0x11, 0x94,
+	0x50, 0x31, 0x1a, 0x41, 0xf5, 0x93, 0x2e, 0x19, 0x7e, 0x5a, 0x7b, 0xe3, 0xcb, 0x47, 0x65, 0xe3,
+	0xab, 0x47, 0x65, 0xe3, 0x5f, 0x8f, 0xca, 0xc6, 0xe7, 0x8f, 0xcb, 0x13, 0x5f, 0x3d, 0x2e, 0x4f,
+	0xfc, 0xf3, 0x71, 0x79, 0xe2, 0xe7, 0x3b, 0xda, 0xa4, 0x7a, 0xc7, 0x76, 0xad, 0x5b, 0xd4, 0x25,
+	0x55, 0x8f, 0x74, 0x2d, 0xbb, 0xfa, 0x30, 0xb8, 0xa3, 0xfe, 0xb0, 0xfa, 0xd1, 0x34, 0xff, 0x2f,
+	0xdb, 0xcb, 0xff, 0x1d, 0x00, 0x1e, 0x91, 0xaf, 0xf7, 0x64, 0x1c, 0x00, 0x00,
 }
 
-func (m *QueryProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Returns the permissions an actor has by address.
+	PermissionsByAddress(ctx context.Context, in *PermissionsByAddressRequest, opts ...grpc.CallOption) (*PermissionsResponse, error)
+	// PermissionGrants returns the permissions delegated to an address and the expiries of its whitelisted permissions.
+	PermissionGrants(ctx context.Context, in *PermissionGrantsRequest, opts ...grpc.CallOption) (*PermissionGrantsResponse, error)
+	// Authorizations returns the authorizations given by a granter, to a grantee when it is set.
+	Authorizations(ctx context.Context, in *AuthorizationsRequest, opts ...grpc.CallOption) (*AuthorizationsResponse, error)
+	// Group returns a group from its id or its address.
+	Group(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GroupResponse, error)
+	// GroupProposals returns the proposals of a group.
+	GroupProposals(ctx context.Context, in *GroupProposalsRequest, opts ...grpc.CallOption) (*GroupProposalsResponse, error)
+	// Returns the roles that are assigned to an address.
+	RolesByAddress(ctx context.Context, in *RolesByAddressRequest, opts ...grpc.CallOption) (*RolesByAddressResponse, error)
+	// RolePermissions returns the permissions of the roles available in the registry.
+	RolePermissions(ctx context.Context, in *RolePermissionsRequest, opts ...grpc.CallOption) (*RolePermissionsResponse, error)
+	// AllRoles returns every role of the registry with its permissions.
+	AllRoles(ctx context.Context, in *AllRolesRequest, opts ...grpc.CallOption) (*AllRolesResponse, error)
+	// Role returns a role of the registry from its number or its sid.
+	Role(ctx context.Context, in *RoleRequest, opts ...grpc.CallOption) (*RoleResponse, error)
+	// RoleActors returns the addresses of the actors holding a role.
+	RoleActors(ctx context.Context, in *RoleActorsRequest, opts ...grpc.CallOption) (*RoleActorsResponse, error)
+	// CouncilorByAddress returns the councilor object from its address
+	CouncilorByAddress(ctx context.Context, in *CouncilorByAddressRequest, opts ...grpc.CallOption) (*CouncilorResponse, error)
+	// CouncilorByMoniker returns the councilor object from its moniker
+	CouncilorByMoniker(ctx context.Context, in *CouncilorByMonikerRequest, opts ...grpc.CallOption) (*CouncilorResponse, error)
+	// Councilors returns the councilors of the council registry, optionally filtered by status
+	Councilors(ctx context.Context, in *CouncilorsRequest, opts ...grpc.CallOption) (*CouncilorsResponse, error)
+	// GetNetworkProperties returns network properties
+	GetNetworkProperties(ctx context.Context, in *NetworkPropertiesRequest, opts ...grpc.CallOption) (*NetworkPropertiesResponse, error)
+	// GetExecutionFee returns execution fee from msg type
+	GetExecutionFee(ctx context.Context, in *ExecutionFeeRequest, opts ...grpc.CallOption) (*ExecutionFeeResponse, error)
+	// GetPoorNetworkMessages returns poor network messages
+	GetPoorNetworkMessages(ctx context.Context, in *PoorNetworkMessagesRequest, opts ...grpc.CallOption) (*PoorNetworkMessagesResponse, error)
+	// Proposal queries proposal details based on ProposalID.
+	Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error)
+	// Proposals queries all proposals based on given status.
+	Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error)
+	// GetWhitelistedProposalVoters returns whitelisted voters for a proposal for tracking
+	GetWhitelistedProposalVoters(ctx context.Context, in *QueryWhitelistedProposalVotersRequest, opts ...grpc.CallOption) (*QueryWhitelistedProposalVotersResponse, error)
+	// Vote queries voted information based on proposalID, voterAddr.
+	Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error)
+	// VoteHistory queries the votes cast by a voter on a proposal, including the ones it changed.
+	VoteHistory(ctx context.Context, in *QueryVoteHistoryRequest, opts ...grpc.CallOption) (*QueryVoteHistoryResponse, error)
+	// Votes queries votes of a given proposal.
+	Votes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error)
+	// Tally queries the tally of a given proposal, it is computed live while the proposal is in voting.
+	Tally(ctx context.Context, in *QueryTallyRequest, opts ...grpc.CallOption) (*QueryTallyResponse, error)
+	// Deposit queries the deposit escrowed for a given proposal.
+	Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error)
+	// Query all data reference keys with pagination.
+	GetAllDataReferenceKeys(ctx context.Context, in *QueryDataReferenceKeysRequest, opts ...grpc.CallOption) (*QueryDataReferenceKeysResponse, error)
+	// Query data reference by key.
+	GetDataReferenceByKey(ctx context.Context, in *QueryDataReferenceRequest, opts ...grpc.CallOption) (*QueryDataReferenceResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) PermissionsByAddress(ctx context.Context, in *PermissionsByAddressRequest, opts ...grpc.CallOption) (*PermissionsResponse, error) {
+	out := new(PermissionsResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/PermissionsByAddress", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PermissionGrants(ctx context.Context, in *PermissionGrantsRequest, opts ...grpc.CallOption) (*PermissionGrantsResponse, error) {
+	out := new(PermissionGrantsResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/PermissionGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Votes) > 0 {
-		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func (c *queryClient) Authorizations(ctx context.Context, in *AuthorizationsRequest, opts ...grpc.CallOption) (*AuthorizationsResponse, error) {
+	out := new(AuthorizationsResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/Authorizations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	{
-		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	return out, nil
+}
+
+func (c *queryClient) Group(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GroupResponse, error) {
+	out := new(GroupResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/Group", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryProposalsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GroupProposals(ctx context.Context, in *GroupProposalsRequest, opts ...grpc.CallOption) (*GroupProposalsResponse, error) {
+	out := new(GroupProposalsResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/GroupProposals", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RolesByAddress(ctx context.Context, in *RolesByAddressRequest, opts ...grpc.CallOption) (*RolesByAddressResponse, error) {
+	out := new(RolesByAddressResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/RolesByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) RolePermissions(ctx context.Context, in *RolePermissionsRequest, opts ...grpc.CallOption) (*RolePermissionsResponse, error) {
+	out := new(RolePermissionsResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/RolePermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryProposalsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AllRoles(ctx context.Context, in *AllRolesRequest, opts ...grpc.CallOption) (*AllRolesResponse, error) {
+	out := new(AllRolesResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/AllRoles", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Role(ctx context.Context, in *RoleRequest, opts ...grpc.CallOption) (*RoleResponse, error) {
+	out := new(RoleResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/Role", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Proposals) > 0 {
-		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) RoleActors(ctx context.Context, in *RoleActorsRequest, opts ...grpc.CallOption) (*RoleActorsResponse, error) {
+	out := new(RoleActorsResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/RoleActors", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryWhitelistedProposalVotersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CouncilorByAddress(ctx context.Context, in *CouncilorByAddressRequest, opts ...grpc.CallOption) (*CouncilorResponse, error) {
+	out := new(CouncilorResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/CouncilorByAddress", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryWhitelistedProposalVotersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CouncilorByMoniker(ctx context.Context, in *CouncilorByMonikerRequest, opts ...grpc.CallOption) (*CouncilorResponse, error) {
+	out := new(CouncilorResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/CouncilorByMoniker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryWhitelistedProposalVotersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) Councilors(ctx context.Context, in *CouncilorsRequest, opts ...grpc.CallOption) (*CouncilorsResponse, error) {
+	out := new(CouncilorsResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/Councilors", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryWhitelistedProposalVotersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetNetworkProperties(ctx context.Context, in *NetworkPropertiesRequest, opts ...grpc.CallOption) (*NetworkPropertiesResponse, error) {
+	out := new(NetworkPropertiesResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/GetNetworkProperties", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryWhitelistedProposalVotersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetExecutionFee(ctx context.Context, in *ExecutionFeeRequest, opts ...grpc.CallOption) (*ExecutionFeeResponse, error) {
+	out := new(ExecutionFeeResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/GetExecutionFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryWhitelistedProposalVotersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Voters) > 0 {
-		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Voters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GetPoorNetworkMessages(ctx context.Context, in *PoorNetworkMessagesRequest, opts ...grpc.CallOption) (*PoorNetworkMessagesResponse, error) {
+	out := new(PoorNetworkMessagesResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/GetPoorNetworkMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryVoteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error) {
+	out := new(QueryProposalResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/Proposal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryVoteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error) {
+	out := new(QueryProposalsResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/Proposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ProposalId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) GetWhitelistedProposalVoters(ctx context.Context, in *QueryWhitelistedProposalVotersRequest, opts ...grpc.CallOption) (*QueryWhitelistedProposalVotersResponse, error) {
+	out := new(QueryWhitelistedProposalVotersResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/GetWhitelistedProposalVoters", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryVoteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error) {
+	out := new(QueryVoteResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/Vote", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryVoteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) VoteHistory(ctx context.Context, in *QueryVoteHistoryRequest, opts ...grpc.CallOption) (*QueryVoteHistoryResponse, error) {
+	out := new(QueryVoteHistoryResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/VoteHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Vote.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) Votes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error) {
+	out := new(QueryVotesResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/Votes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryVotesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Tally(ctx context.Context, in *QueryTallyRequest, opts ...grpc.CallOption) (*QueryTallyResponse, error) {
+	out := new(QueryTallyResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/Tally", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryVotesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error) {
+	out := new(QueryDepositResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/Deposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) GetAllDataReferenceKeys(ctx context.Context, in *QueryDataReferenceKeysRequest, opts ...grpc.CallOption) (*QueryDataReferenceKeysResponse, error) {
+	out := new(QueryDataReferenceKeysResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/GetAllDataReferenceKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryVotesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetDataReferenceByKey(ctx context.Context, in *QueryDataReferenceRequest, opts ...grpc.CallOption) (*QueryDataReferenceResponse, error) {
+	out := new(QueryDataReferenceResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Query/GetDataReferenceByKey", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryVotesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Returns the permissions an actor has by address.
+	PermissionsByAddress(context.Context, *PermissionsByAddressRequest) (*PermissionsResponse, error)
+	// PermissionGrants returns the permissions delegated to an address and the expiries of its whitelisted permissions.
+	PermissionGrants(context.Context, *PermissionGrantsRequest) (*PermissionGrantsResponse, error)
+	// Authorizations returns the authorizations given by a granter, to a grantee when it is set.
+	Authorizations(context.Context, *AuthorizationsRequest) (*AuthorizationsResponse, error)
+	// Group returns a group from its id or its address.
+	Group(context.Context, *GroupRequest) (*GroupResponse, error)
+	// GroupProposals returns the proposals of a group.
+	GroupProposals(context.Context, *GroupProposalsRequest) (*GroupProposalsResponse, error)
+	// Returns the roles that are assigned to an address.
+	RolesByAddress(context.Context, *RolesByAddressRequest) (*RolesByAddressResponse, error)
+	// RolePermissions returns the permissions of the roles available in the registry.
+	RolePermissions(context.Context, *RolePermissionsRequest) (*RolePermissionsResponse, error)
+	// AllRoles returns every role of the registry with its permissions.
+	AllRoles(context.Context, *AllRolesRequest) (*AllRolesResponse, error)
+	// Role returns a role of the registry from its number or its sid.
+	Role(context.Context, *RoleRequest) (*RoleResponse, error)
+	// RoleActors returns the addresses of the actors holding a role.
+	RoleActors(context.Context, *RoleActorsRequest) (*RoleActorsResponse, error)
+	// CouncilorByAddress returns the councilor object from its address
+	CouncilorByAddress(context.Context, *CouncilorByAddressRequest) (*CouncilorResponse, error)
+	// CouncilorByMoniker returns the councilor object from its moniker
+	CouncilorByMoniker(context.Context, *CouncilorByMonikerRequest) (*CouncilorResponse, error)
+	// Councilors returns the councilors of the council registry, optionally filtered by status
+	Councilors(context.Context, *CouncilorsRequest) (*CouncilorsResponse, error)
+	// GetNetworkProperties returns network properties
+	GetNetworkProperties(context.Context, *NetworkPropertiesRequest) (*NetworkPropertiesResponse, error)
+	// GetExecutionFee returns execution fee from msg type
+	GetExecutionFee(context.Context, *ExecutionFeeRequest) (*ExecutionFeeResponse, error)
+	// GetPoorNetworkMessages returns poor network messages
+	GetPoorNetworkMessages(context.Context, *PoorNetworkMessagesRequest) (*PoorNetworkMessagesResponse, error)
+	// Proposal queries proposal details based on ProposalID.
+	Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error)
+	// Proposals queries all proposals based on given status.
+	Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error)
+	// GetWhitelistedProposalVoters returns whitelisted voters for a proposal for tracking
+	GetWhitelistedProposalVoters(context.Context, *QueryWhitelistedProposalVotersRequest) (*QueryWhitelistedProposalVotersResponse, error)
+	// Vote queries voted information based on proposalID, voterAddr.
+	Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error)
+	// VoteHistory queries the votes cast by a voter on a proposal, including the ones it changed.
+	VoteHistory(context.Context, *QueryVoteHistoryRequest) (*QueryVoteHistoryResponse, error)
+	// Votes queries votes of a given proposal.
+	Votes(context.Context, *QueryVotesRequest) (*QueryVotesResponse, error)
+	// Tally queries the tally of a given proposal, it is computed live while the proposal is in voting.
+	Tally(context.Context, *QueryTallyRequest) (*QueryTallyResponse, error)
+	// Deposit queries the deposit escrowed for a given proposal.
+	Deposit(context.Context, *QueryDepositRequest) (*QueryDepositResponse, error)
+	// Query all data reference keys with pagination.
+	GetAllDataReferenceKeys(context.Context, *QueryDataReferenceKeysRequest) (*QueryDataReferenceKeysResponse, error)
+	// Query data reference by key.
+	GetDataReferenceByKey(context.Context, *QueryDataReferenceRequest) (*QueryDataReferenceResponse, error)
 }
 
-func (m *QueryVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Votes) > 0 {
-		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryDataReferenceKeysRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) PermissionsByAddress(ctx context.Context, req *PermissionsByAddressRequest) (*PermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PermissionsByAddress not implemented")
+}
+func (*UnimplementedQueryServer) PermissionGrants(ctx context.Context, req *PermissionGrantsRequest) (*PermissionGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PermissionGrants not implemented")
+}
+func (*UnimplementedQueryServer) Authorizations(ctx context.Context, req *AuthorizationsRequest) (*AuthorizationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authorizations not implemented")
+}
+func (*UnimplementedQueryServer) Group(ctx context.Context, req *GroupRequest) (*GroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Group not implemented")
+}
+func (*UnimplementedQueryServer) GroupProposals(ctx context.Context, req *GroupProposalsRequest) (*GroupProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupProposals not implemented")
+}
+func (*UnimplementedQueryServer) RolesByAddress(ctx context.Context, req *RolesByAddressRequest) (*RolesByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RolesByAddress not implemented")
+}
+func (*UnimplementedQueryServer) RolePermissions(ctx context.Context, req *RolePermissionsRequest) (*RolePermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RolePermissions not implemented")
+}
+func (*UnimplementedQueryServer) AllRoles(ctx context.Context, req *AllRolesRequest) (*AllRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllRoles not implemented")
+}
+func (*UnimplementedQueryServer) Role(ctx context.Context, req *RoleRequest) (*RoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Role not implemented")
+}
+func (*UnimplementedQueryServer) RoleActors(ctx context.Context, req *RoleActorsRequest) (*RoleActorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleActors not implemented")
+}
+func (*UnimplementedQueryServer) CouncilorByAddress(ctx context.Context, req *CouncilorByAddressRequest) (*CouncilorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CouncilorByAddress not implemented")
+}
+func (*UnimplementedQueryServer) CouncilorByMoniker(ctx context.Context, req *CouncilorByMonikerRequest) (*CouncilorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CouncilorByMoniker not implemented")
+}
+func (*UnimplementedQueryServer) Councilors(ctx context.Context, req *CouncilorsRequest) (*CouncilorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Councilors not implemented")
+}
+func (*UnimplementedQueryServer) GetNetworkProperties(ctx context.Context, req *NetworkPropertiesRequest) (*NetworkPropertiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkProperties not implemented")
+}
+func (*UnimplementedQueryServer) GetExecutionFee(ctx context.Context, req *ExecutionFeeRequest) (*ExecutionFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExecutionFee not implemented")
+}
+func (*UnimplementedQueryServer) GetPoorNetworkMessages(ctx context.Context, req *PoorNetworkMessagesRequest) (*PoorNetworkMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoorNetworkMessages not implemented")
+}
+func (*UnimplementedQueryServer) Proposal(ctx context.Context, req *QueryProposalRequest) (*QueryProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proposal not implemented")
+}
+func (*UnimplementedQueryServer) Proposals(ctx context.Context, req *QueryProposalsRequest) (*QueryProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proposals not implemented")
+}
+func (*UnimplementedQueryServer) GetWhitelistedProposalVoters(ctx context.Context, req *QueryWhitelistedProposalVotersRequest) (*QueryWhitelistedProposalVotersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWhitelistedProposalVoters not implemented")
+}
+func (*UnimplementedQueryServer) Vote(ctx context.Context, req *QueryVoteRequest) (*QueryVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
+}
+func (*UnimplementedQueryServer) VoteHistory(ctx context.Context, req *QueryVoteHistoryRequest) (*QueryVoteHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteHistory not implemented")
+}
+func (*UnimplementedQueryServer) Votes(ctx context.Context, req *QueryVotesRequest) (*QueryVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Votes not implemented")
+}
+func (*UnimplementedQueryServer) Tally(ctx context.Context, req *QueryTallyRequest) (*QueryTallyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tally not implemented")
+}
+func (*UnimplementedQueryServer) Deposit(ctx context.Context, req *QueryDepositRequest) (*QueryDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (*UnimplementedQueryServer) GetAllDataReferenceKeys(ctx context.Context, req *QueryDataReferenceKeysRequest) (*QueryDataReferenceKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllDataReferenceKeys not implemented")
+}
+func (*UnimplementedQueryServer) GetDataReferenceByKey(ctx context.Context, req *QueryDataReferenceRequest) (*QueryDataReferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataReferenceByKey not implemented")
 }
 
-func (m *QueryDataReferenceKeysRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryDataReferenceKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_PermissionsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermissionsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).PermissionsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/PermissionsByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PermissionsByAddress(ctx, req.(*PermissionsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDataReferenceKeysResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PermissionGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermissionGrantsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).PermissionGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/PermissionGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PermissionGrants(ctx, req.(*PermissionGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDataReferenceKeysResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Authorizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Authorizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/Authorizations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Authorizations(ctx, req.(*AuthorizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDataReferenceKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_Group_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Keys) > 0 {
-		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Keys[iNdEx])
-			copy(dAtA[i:], m.Keys[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Keys[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Group(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/Group",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Group(ctx, req.(*GroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDataReferenceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GroupProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupProposalsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GroupProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/GroupProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupProposals(ctx, req.(*GroupProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDataReferenceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_RolesByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolesByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RolesByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/RolesByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RolesByAddress(ctx, req.(*RolesByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDataReferenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+func _Query_RolePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).RolePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/RolePermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RolePermissions(ctx, req.(*RolePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDataReferenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AllRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllRolesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDataReferenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDataReferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Data != nil {
-		{
-			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).AllRoles(ctx, in)
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/AllRoles",
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *NetworkPropertiesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllRoles(ctx, req.(*AllRolesRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *NetworkPropertiesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Role_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Properties != nil {
-		l = m.Properties.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Role(ctx, in)
 	}
-	return n
-}
-
-func (m *PermissionsByAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/Role",
 	}
-	var l int
-	_ = l
-	l = len(m.ValAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Role(ctx, req.(*RoleRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PermissionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_RoleActors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleActorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Permissions != nil {
-		l = m.Permissions.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).RoleActors(ctx, in)
 	}
-	return n
-}
-
-func (m *RolesByAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/RoleActors",
 	}
-	var l int
-	_ = l
-	l = len(m.ValAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RoleActors(ctx, req.(*RoleActorsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RolesByAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_CouncilorByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CouncilorByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Roles) > 0 {
-		l = 0
-		for _, e := range m.Roles {
-			l += sovQuery(uint64(e))
-		}
-		n += 1 + sovQuery(uint64(l)) + l
+	if interceptor == nil {
+		return srv.(QueryServer).CouncilorByAddress(ctx, in)
 	}
-	return n
-}
-
-func (m *RolePermissionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/CouncilorByAddress",
 	}
-	var l int
-	_ = l
-	if m.Role != 0 {
-		n += 1 + sovQuery(uint64(m.Role))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CouncilorByAddress(ctx, req.(*CouncilorByAddressRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RolePermissionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_CouncilorByMoniker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CouncilorByMonikerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Permissions != nil {
-		l = m.Permissions.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).CouncilorByMoniker(ctx, in)
 	}
-	return n
-}
-
-func (m *ExecutionFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/CouncilorByMoniker",
 	}
-	var l int
-	_ = l
-	l = len(m.TransactionType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CouncilorByMoniker(ctx, req.(*CouncilorByMonikerRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ExecutionFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Councilors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CouncilorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Fee != nil {
-		l = m.Fee.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Councilors(ctx, in)
 	}
-	return n
-}
-
-func (m *PoorNetworkMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/Councilors",
 	}
-	var l int
-	_ = l
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Councilors(ctx, req.(*CouncilorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PoorNetworkMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetNetworkProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkPropertiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for _, s := range m.Messages {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetNetworkProperties(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/GetNetworkProperties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetNetworkProperties(ctx, req.(*NetworkPropertiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CouncilorByAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetExecutionFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutionFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ValAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetExecutionFee(ctx, in)
 	}
-	return n
-}
-
-func (m *CouncilorByMonikerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/GetExecutionFee",
 	}
-	var l int
-	_ = l
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetExecutionFee(ctx, req.(*ExecutionFeeRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CouncilorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetPoorNetworkMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoorNetworkMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Councilor.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).GetPoorNetworkMessages(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/GetPoorNetworkMessages",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPoorNetworkMessages(ctx, req.(*PoorNetworkMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Proposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Proposal.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if len(m.Votes) > 0 {
-		for _, e := range m.Votes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Proposal(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryProposalsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/Proposal",
 	}
-	var l int
-	_ = l
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Proposal(ctx, req.(*QueryProposalRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryProposalsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Proposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Proposals) > 0 {
-		for _, e := range m.Proposals {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Proposals(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryWhitelistedProposalVotersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/Proposals",
 	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Proposals(ctx, req.(*QueryProposalsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWhitelistedProposalVotersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetWhitelistedProposalVoters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWhitelistedProposalVotersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Voters) > 0 {
-		for _, e := range m.Voters {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetWhitelistedProposalVoters(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/GetWhitelistedProposalVoters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetWhitelistedProposalVoters(ctx, req.(*QueryWhitelistedProposalVotersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVoteRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
+	if interceptor == nil {
+		return srv.(QueryServer).Vote(ctx, in)
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/Vote",
 	}
-	return n
-}
-
-func (m *QueryVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Vote(ctx, req.(*QueryVoteRequest))
 	}
-	var l int
-	_ = l
-	l = m.Vote.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVotesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_VoteHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
+	if interceptor == nil {
+		return srv.(QueryServer).VoteHistory(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryVotesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/VoteHistory",
 	}
-	var l int
-	_ = l
-	if len(m.Votes) > 0 {
-		for _, e := range m.Votes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteHistory(ctx, req.(*QueryVoteHistoryRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDataReferenceKeysRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Votes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Votes(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/Votes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Votes(ctx, req.(*QueryVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDataReferenceKeysResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Tally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTallyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Keys) > 0 {
-		for _, s := range m.Keys {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Tally(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/Tally",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Tally(ctx, req.(*QueryTallyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDataReferenceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/Deposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Deposit(ctx, req.(*QueryDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAllDataReferenceKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataReferenceKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllDataReferenceKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/GetAllDataReferenceKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllDataReferenceKeys(ctx, req.(*QueryDataReferenceKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetDataReferenceByKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataReferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDataReferenceByKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Query/GetDataReferenceByKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDataReferenceByKey(ctx, req.(*QueryDataReferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kira.gov.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PermissionsByAddress",
+			Handler:    _Query_PermissionsByAddress_Handler,
+		},
+		{
+			MethodName: "PermissionGrants",
+			Handler:    _Query_PermissionGrants_Handler,
+		},
+		{
+			MethodName: "Authorizations",
+			Handler:    _Query_Authorizations_Handler,
+		},
+		{
+			MethodName: "Group",
+			Handler:    _Query_Group_Handler,
+		},
+		{
+			MethodName: "GroupProposals",
+			Handler:    _Query_GroupProposals_Handler,
+		},
+		{
+			MethodName: "RolesByAddress",
+			Handler:    _Query_RolesByAddress_Handler,
+		},
+		{
+			MethodName: "RolePermissions",
+			Handler:    _Query_RolePermissions_Handler,
+		},
+		{
+			MethodName: "AllRoles",
+			Handler:    _Query_AllRoles_Handler,
+		},
+		{
+			MethodName: "Role",
+			Handler:    _Query_Role_Handler,
+		},
+		{
+			MethodName: "RoleActors",
+			Handler:    _Query_RoleActors_Handler,
+		},
+		{
+			MethodName: "CouncilorByAddress",
+			Handler:    _Query_CouncilorByAddress_Handler,
+		},
+		{
+			MethodName: "CouncilorByMoniker",
+			Handler:    _Query_CouncilorByMoniker_Handler,
+		},
+		{
+			MethodName: "Councilors",
+			Handler:    _Query_Councilors_Handler,
+		},
+		{
+			MethodName: "GetNetworkProperties",
+			Handler:    _Query_GetNetworkProperties_Handler,
+		},
+		{
+			MethodName: "GetExecutionFee",
+			Handler:    _Query_GetExecutionFee_Handler,
+		},
+		{
+			MethodName: "GetPoorNetworkMessages",
+			Handler:    _Query_GetPoorNetworkMessages_Handler,
+		},
+		{
+			MethodName: "Proposal",
+			Handler:    _Query_Proposal_Handler,
+		},
+		{
+			MethodName: "Proposals",
+			Handler:    _Query_Proposals_Handler,
+		},
+		{
+			MethodName: "GetWhitelistedProposalVoters",
+			Handler:    _Query_GetWhitelistedProposalVoters_Handler,
+		},
+		{
+			MethodName: "Vote",
+			Handler:    _Query_Vote_Handler,
+		},
+		{
+			MethodName: "VoteHistory",
+			Handler:    _Query_VoteHistory_Handler,
+		},
+		{
+			MethodName: "Votes",
+			Handler:    _Query_Votes_Handler,
+		},
+		{
+			MethodName: "Tally",
+			Handler:    _Query_Tally_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _Query_Deposit_Handler,
+		},
+		{
+			MethodName: "GetAllDataReferenceKeys",
+			Handler:    _Query_GetAllDataReferenceKeys_Handler,
+		},
+		{
+			MethodName: "GetDataReferenceByKey",
+			Handler:    _Query_GetDataReferenceByKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "query.proto",
+}
+
+func (m *NetworkPropertiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkPropertiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetworkPropertiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *NetworkPropertiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDataReferenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NetworkPropertiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetworkPropertiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Properties != nil {
+		{
+			size, err := m.Properties.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PermissionsByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PermissionsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ValAddr) > 0 {
+		i -= len(m.ValAddr)
+		copy(dAtA[i:], m.ValAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *PermissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *PermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Permissions != nil {
+		{
+			size, err := m.Permissions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RolesByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolesByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolesByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValAddr) > 0 {
+		i -= len(m.ValAddr)
+		copy(dAtA[i:], m.ValAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RolesByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolesByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolesByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		dAtA4 := make([]byte, len(m.Roles)*10)
+		var j3 int
+		for _, num := range m.Roles {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintQuery(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RolePermissionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolePermissionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolePermissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Role != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RolePermissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolePermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolePermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Permissions != nil {
+		{
+			size, err := m.Permissions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PermissionGrantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PermissionGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PermissionGrantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PermissionGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Expiries) > 0 {
+		for iNdEx := len(m.Expiries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Expiries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthorizationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorizationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthorizationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthorizationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorizationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthorizationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authorizations) > 0 {
+		for iNdEx := len(m.Authorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Group.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoleDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Permissions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AllRolesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllRolesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllRolesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AllRolesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllRolesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllRolesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Role.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RoleActorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleActorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleActorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoleActorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleActorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleActorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actors) > 0 {
+		for iNdEx := len(m.Actors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Actors[iNdEx])
+			copy(dAtA[i:], m.Actors[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Actors[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TransactionType) > 0 {
+		i -= len(m.TransactionType)
+		copy(dAtA[i:], m.TransactionType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TransactionType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Fee != nil {
+		{
+			size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoorNetworkMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoorNetworkMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoorNetworkMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PoorNetworkMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoorNetworkMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoorNetworkMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Messages[iNdEx])
+			copy(dAtA[i:], m.Messages[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Messages[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CouncilorByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CouncilorByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CouncilorByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValAddr) > 0 {
+		i -= len(m.ValAddr)
+		copy(dAtA[i:], m.ValAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CouncilorByMonikerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CouncilorByMonikerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CouncilorByMonikerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CouncilorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CouncilorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CouncilorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Councilor.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CouncilorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CouncilorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CouncilorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CouncilorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CouncilorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CouncilorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Councilors) > 0 {
+		for iNdEx := len(m.Councilors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Councilors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWhitelistedProposalVotersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWhitelistedProposalVotersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWhitelistedProposalVotersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWhitelistedProposalVotersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWhitelistedProposalVotersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWhitelistedProposalVotersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voters) > 0 {
+		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Voters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Vote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTallyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTallyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTallyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTallyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.WeightedTally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.TallyResult.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataReferenceKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataReferenceKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataReferenceKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataReferenceKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataReferenceKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataReferenceKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataReferenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataReferenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataReferenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataReferenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataReferenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataReferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *NetworkPropertiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *NetworkPropertiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Properties != nil {
+		l = m.Properties.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PermissionsByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PermissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Permissions != nil {
+		l = m.Permissions.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RolesByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RolesByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		l = 0
+		for _, e := range m.Roles {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *RolePermissionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovQuery(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *RolePermissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Permissions != nil {
+		l = m.Permissions.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PermissionGrantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PermissionGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Expiries) > 0 {
+		for _, e := range m.Expiries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AuthorizationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AuthorizationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Authorizations) > 0 {
+		for _, e := range m.Authorizations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Group.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GroupProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *GroupProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RoleDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Info.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Permissions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *AllRolesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AllRolesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RoleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Role.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RoleActorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RoleActorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Actors) > 0 {
+		for _, b := range m.Actors {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecutionFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TransactionType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutionFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fee != nil {
+		l = m.Fee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PoorNetworkMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PoorNetworkMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, s := range m.Messages {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CouncilorByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CouncilorByMonikerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CouncilorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Councilor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *CouncilorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CouncilorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Councilors) > 0 {
+		for _, e := range m.Councilors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryWhitelistedProposalVotersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryWhitelistedProposalVotersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Voters) > 0 {
+		for _, e := range m.Voters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Vote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVoteHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTallyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryTallyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TallyResult.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.WeightedTally.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Deposit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDataReferenceKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataReferenceKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataReferenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataReferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *NetworkPropertiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkPropertiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkPropertiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkPropertiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkPropertiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkPropertiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Properties == nil {
+				m.Properties = &NetworkProperties{}
+			}
+			if err := m.Properties.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PermissionsByAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PermissionsByAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PermissionsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddr = append(m.ValAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValAddr == nil {
+				m.ValAddr = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PermissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PermissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Permissions == nil {
+				m.Permissions = &Permissions{}
+			}
+			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolesByAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolesByAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolesByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddr = append(m.ValAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValAddr == nil {
+				m.ValAddr = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolesByAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolesByAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolesByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Roles = append(m.Roles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Roles) == 0 {
+					m.Roles = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Roles = append(m.Roles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolePermissionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolePermissionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolePermissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolePermissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolePermissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolePermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Permissions == nil {
+				m.Permissions = &Permissions{}
+			}
+			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PermissionGrantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PermissionGrantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PermissionGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PermissionGrantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PermissionGrantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PermissionGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, PermissionGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expiries = append(m.Expiries, PermissionExpiry{})
+			if err := m.Expiries[len(m.Expiries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthorizationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthorizationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthorizationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = append(m.Granter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Granter == nil {
+				m.Granter = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = append(m.Grantee[:0], dAtA[iNdEx:postIndex]...)
+			if m.Grantee == nil {
+				m.Grantee = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthorizationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthorizationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthorizationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authorizations = append(m.Authorizations, Authorization{})
+			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Group.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, GroupProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllRolesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllRolesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllRolesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllRolesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllRolesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllRolesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, RoleDetails{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Role.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *NetworkPropertiesRequest) Unmarshal(dAtA []byte) error {
+func (m *RoleActorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3432,12 +7978,44 @@ func (m *NetworkPropertiesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkPropertiesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RoleActorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkPropertiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RoleActorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3462,7 +8040,7 @@ func (m *NetworkPropertiesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetworkPropertiesResponse) Unmarshal(dAtA []byte) error {
+func (m *RoleActorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3485,17 +8063,17 @@ func (m *NetworkPropertiesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkPropertiesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RoleActorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkPropertiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RoleActorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actors", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3505,27 +8083,23 @@ func (m *NetworkPropertiesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Properties == nil {
-				m.Properties = &NetworkProperties{}
-			}
-			if err := m.Properties.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Actors = append(m.Actors, make([]byte, postIndex-iNdEx))
+			copy(m.Actors[len(m.Actors)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3551,7 +8125,7 @@ func (m *NetworkPropertiesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PermissionsByAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *ExecutionFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3574,17 +8148,17 @@ func (m *PermissionsByAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PermissionsByAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutionFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PermissionsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutionFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionType", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3594,25 +8168,23 @@ func (m *PermissionsByAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValAddr = append(m.ValAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValAddr == nil {
-				m.ValAddr = []byte{}
-			}
+			m.TransactionType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3638,7 +8210,7 @@ func (m *PermissionsByAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PermissionsResponse) Unmarshal(dAtA []byte) error {
+func (m *ExecutionFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3661,15 +8233,15 @@ func (m *PermissionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PermissionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutionFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutionFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3696,10 +8268,10 @@ func (m *PermissionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Permissions == nil {
-				m.Permissions = &Permissions{}
+			if m.Fee == nil {
+				m.Fee = &ExecutionFee{}
 			}
-			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3727,7 +8299,7 @@ func (m *PermissionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolesByAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *PoorNetworkMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3750,17 +8322,70 @@ func (m *RolesByAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolesByAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoorNetworkMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolesByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoorNetworkMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoorNetworkMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoorNetworkMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoorNetworkMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3770,25 +8395,23 @@ func (m *RolesByAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValAddr = append(m.ValAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValAddr == nil {
-				m.ValAddr = []byte{}
-			}
+			m.Messages = append(m.Messages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3814,7 +8437,7 @@ func (m *RolesByAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolesByAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *CouncilorByAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3837,88 +8460,46 @@ func (m *RolesByAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolesByAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CouncilorByAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolesByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CouncilorByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Roles = append(m.Roles, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Roles) == 0 {
-					m.Roles = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Roles = append(m.Roles, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddr = append(m.ValAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValAddr == nil {
+				m.ValAddr = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3943,7 +8524,7 @@ func (m *RolesByAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolePermissionsRequest) Unmarshal(dAtA []byte) error {
+func (m *CouncilorByMonikerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3966,17 +8547,17 @@ func (m *RolePermissionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolePermissionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CouncilorByMonikerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolePermissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CouncilorByMonikerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
 			}
-			m.Role = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3986,11 +8567,24 @@ func (m *RolePermissionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4015,7 +8609,7 @@ func (m *RolePermissionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolePermissionsResponse) Unmarshal(dAtA []byte) error {
+func (m *CouncilorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4038,15 +8632,15 @@ func (m *RolePermissionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolePermissionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CouncilorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolePermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CouncilorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Councilor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4073,10 +8667,7 @@ func (m *RolePermissionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Permissions == nil {
-				m.Permissions = &Permissions{}
-			}
-			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Councilor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4104,7 +8695,7 @@ func (m *RolePermissionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecutionFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *CouncilorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4127,15 +8718,15 @@ func (m *ExecutionFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutionFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CouncilorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutionFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CouncilorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransactionType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4163,7 +8754,7 @@ func (m *ExecutionFeeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransactionType = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4189,7 +8780,7 @@ func (m *ExecutionFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecutionFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *CouncilorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4212,15 +8803,15 @@ func (m *ExecutionFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutionFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CouncilorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutionFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CouncilorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Councilors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4247,10 +8838,8 @@ func (m *ExecutionFeeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fee == nil {
-				m.Fee = &ExecutionFee{}
-			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Councilors = append(m.Councilors, Councilor{})
+			if err := m.Councilors[len(m.Councilors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4278,7 +8867,7 @@ func (m *ExecutionFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoorNetworkMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4301,12 +8890,31 @@ func (m *PoorNetworkMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoorNetworkMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoorNetworkMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4331,7 +8939,7 @@ func (m *PoorNetworkMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoorNetworkMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4354,15 +8962,135 @@ func (m *PoorNetworkMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoorNetworkMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoorNetworkMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4390,7 +9118,7 @@ func (m *PoorNetworkMessagesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, string(dAtA[iNdEx:postIndex]))
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4416,7 +9144,7 @@ func (m *PoorNetworkMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CouncilorByAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4439,17 +9167,17 @@ func (m *CouncilorByAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CouncilorByAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CouncilorByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4459,24 +9187,24 @@ func (m *CouncilorByAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValAddr = append(m.ValAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValAddr == nil {
-				m.ValAddr = []byte{}
+			m.Proposals = append(m.Proposals, Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -4503,7 +9231,7 @@ func (m *CouncilorByAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CouncilorByMonikerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWhitelistedProposalVotersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4526,17 +9254,17 @@ func (m *CouncilorByMonikerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CouncilorByMonikerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWhitelistedProposalVotersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CouncilorByMonikerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWhitelistedProposalVotersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4546,24 +9274,11 @@ func (m *CouncilorByMonikerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4588,7 +9303,7 @@ func (m *CouncilorByMonikerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CouncilorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWhitelistedProposalVotersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4611,15 +9326,15 @@ func (m *CouncilorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CouncilorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWhitelistedProposalVotersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CouncilorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWhitelistedProposalVotersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Councilor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4646,7 +9361,8 @@ func (m *CouncilorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Councilor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Voters = append(m.Voters, NetworkActor{})
+			if err := m.Voters[len(m.Voters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4674,7 +9390,7 @@ func (m *CouncilorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4697,10 +9413,10 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4722,6 +9438,40 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4746,7 +9496,7 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4769,48 +9519,15 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4837,8 +9554,7 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Votes = append(m.Votes, Vote{})
-			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4866,7 +9582,7 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4889,17 +9605,36 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4909,23 +9644,25 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4951,7 +9688,7 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4974,15 +9711,15 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5009,8 +9746,8 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposals = append(m.Proposals, Proposal{})
-			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Votes = append(m.Votes, Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5038,7 +9775,7 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistedProposalVotersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5061,10 +9798,10 @@ func (m *QueryWhitelistedProposalVotersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistedProposalVotersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistedProposalVotersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5110,7 +9847,7 @@ func (m *QueryWhitelistedProposalVotersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistedProposalVotersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5133,15 +9870,15 @@ func (m *QueryWhitelistedProposalVotersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistedProposalVotersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistedProposalVotersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5168,8 +9905,8 @@ func (m *QueryWhitelistedProposalVotersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voters = append(m.Voters, NetworkActor{})
-			if err := m.Voters[len(m.Voters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Votes = append(m.Votes, Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5197,7 +9934,7 @@ func (m *QueryWhitelistedProposalVotersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTallyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5220,10 +9957,10 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTallyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTallyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5245,40 +9982,6 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Voter == nil {
-				m.Voter = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5303,7 +10006,7 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTallyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5326,15 +10029,15 @@ func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTallyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTallyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyResult", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5361,7 +10064,40 @@ func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedTally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightedTally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5389,7 +10125,7 @@ func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5412,10 +10148,10 @@ func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5461,7 +10197,7 @@ func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5484,15 +10220,15 @@ func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5519,8 +10255,7 @@ func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Votes = append(m.Votes, Vote{})
-			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func IsQuorum(percentage, votes, totalVoters uint64) (bool, error) {
@@ -17,3 +19,19 @@ func IsQuorum(percentage, votes, totalVoters uint64) (bool, error) {
 	necessaryApproval := uint64(math.Ceil(float64(totalVoters*percentage) / 100.0))
 	return votes >= necessaryApproval, nil
 }
+
+// IsWeightedQuorum returns if the weight of the votes reaches the percentage of the weight of all the
+// actors allowed to vote, the votes and the voters being weighted the same way as in the tally. There is
+// no quorum when no voter has weight.
+func IsWeightedQuorum(percentage uint64, votesWeight, totalVotersWeight sdk.Dec) (bool, error) {
+	if percentage > 100 {
+		return false, fmt.Errorf("quorum cannot be bigger than 100")
+	}
+
+	if !totalVotersWeight.IsPositive() {
+		return false, nil
+	}
+
+	necessaryWeight := totalVotersWeight.MulInt64(int64(percentage)).QuoInt64(100)
+	return votesWeight.GTE(necessaryWeight), nil
+}
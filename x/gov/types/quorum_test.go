@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -69,3 +70,53 @@ func TestIsQuorum(t *testing.T) {
 		})
 	}
 }
+
+func TestIsWeightedQuorum(t *testing.T) {
+	tests := []struct {
+		name                     string
+		percentage               uint64
+		votesWeight, totalWeight sdk.Dec
+		reached                  bool
+	}{
+		{
+			name:        "quorum not reached",
+			percentage:  33,
+			votesWeight: sdk.NewDec(4),
+			totalWeight: sdk.NewDec(29),
+			reached:     false,
+		},
+		{
+			name:        "quorum reached",
+			percentage:  33,
+			votesWeight: sdk.NewDecWithPrec(957, 2),
+			totalWeight: sdk.NewDec(29),
+			reached:     true,
+		},
+		{
+			name:        "no voter weight",
+			percentage:  33,
+			votesWeight: sdk.ZeroDec(),
+			totalWeight: sdk.ZeroDec(),
+			reached:     false,
+		},
+		{
+			name:        "no voter weight with 0 quorum",
+			percentage:  0,
+			votesWeight: sdk.ZeroDec(),
+			totalWeight: sdk.ZeroDec(),
+			reached:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			quorum, err := IsWeightedQuorum(tt.percentage, tt.votesWeight, tt.totalWeight)
+			require.NoError(t, err)
+			require.Equal(t, tt.reached, quorum)
+		})
+	}
+
+	_, err := IsWeightedQuorum(101, sdk.NewDec(7), sdk.NewDec(10))
+	require.EqualError(t, err, "quorum cannot be bigger than 100")
+}
@@ -25,8 +25,10 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type MsgCreateRole struct {
-	Proposer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty"`
-	Role     uint32                                        `protobuf:"varint,2,opt,name=role,proto3" json:"role,omitempty"`
+	Proposer    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty"`
+	Role        uint32                                        `protobuf:"varint,2,opt,name=role,proto3" json:"role,omitempty"`
+	Sid         string                                        `protobuf:"bytes,3,opt,name=sid,proto3" json:"sid,omitempty"`
+	Description string                                        `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 }
 
 func (m *MsgCreateRole) Reset()         { *m = MsgCreateRole{} }
@@ -76,6 +78,90 @@ func (m *MsgCreateRole) GetRole() uint32 {
 	return 0
 }
 
+func (m *MsgCreateRole) GetSid() string {
+	if m != nil {
+		return m.Sid
+	}
+	return ""
+}
+
+func (m *MsgCreateRole) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+// RoleInfo describes a role registered in the network. The sid is a unique
+// string identifier that can be used in place of the role number.
+type RoleInfo struct {
+	Id          uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sid         string `protobuf:"bytes,2,opt,name=sid,proto3" json:"sid,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Immutable   bool   `protobuf:"varint,4,opt,name=immutable,proto3" json:"immutable,omitempty"`
+}
+
+func (m *RoleInfo) Reset()         { *m = RoleInfo{} }
+func (m *RoleInfo) String() string { return proto.CompactTextString(m) }
+func (*RoleInfo) ProtoMessage()    {}
+func (*RoleInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48a3ff9f7c9032f8, []int{1}
+}
+func (m *RoleInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleInfo.Merge(m, src)
+}
+func (m *RoleInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleInfo proto.InternalMessageInfo
+
+func (m *RoleInfo) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RoleInfo) GetSid() string {
+	if m != nil {
+		return m.Sid
+	}
+	return ""
+}
+
+func (m *RoleInfo) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *RoleInfo) GetImmutable() bool {
+	if m != nil {
+		return m.Immutable
+	}
+	return false
+}
+
 type MsgAssignRole struct {
 	Proposer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty"`
 	Address  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty" yaml:"address"`
@@ -86,7 +172,7 @@ func (m *MsgAssignRole) Reset()         { *m = MsgAssignRole{} }
 func (m *MsgAssignRole) String() string { return proto.CompactTextString(m) }
 func (*MsgAssignRole) ProtoMessage()    {}
 func (*MsgAssignRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48a3ff9f7c9032f8, []int{1}
+	return fileDescriptor_48a3ff9f7c9032f8, []int{2}
 }
 func (m *MsgAssignRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -146,7 +232,7 @@ func (m *MsgRemoveRole) Reset()         { *m = MsgRemoveRole{} }
 func (m *MsgRemoveRole) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveRole) ProtoMessage()    {}
 func (*MsgRemoveRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48a3ff9f7c9032f8, []int{2}
+	return fileDescriptor_48a3ff9f7c9032f8, []int{3}
 }
 func (m *MsgRemoveRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -206,7 +292,7 @@ func (m *MsgWhitelistRolePermission) Reset()         { *m = MsgWhitelistRolePerm
 func (m *MsgWhitelistRolePermission) String() string { return proto.CompactTextString(m) }
 func (*MsgWhitelistRolePermission) ProtoMessage()    {}
 func (*MsgWhitelistRolePermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48a3ff9f7c9032f8, []int{3}
+	return fileDescriptor_48a3ff9f7c9032f8, []int{4}
 }
 func (m *MsgWhitelistRolePermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -266,7 +352,7 @@ func (m *MsgBlacklistRolePermission) Reset()         { *m = MsgBlacklistRolePerm
 func (m *MsgBlacklistRolePermission) String() string { return proto.CompactTextString(m) }
 func (*MsgBlacklistRolePermission) ProtoMessage()    {}
 func (*MsgBlacklistRolePermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48a3ff9f7c9032f8, []int{4}
+	return fileDescriptor_48a3ff9f7c9032f8, []int{5}
 }
 func (m *MsgBlacklistRolePermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -326,7 +412,7 @@ func (m *MsgRemoveWhitelistRolePermission) Reset()         { *m = MsgRemoveWhite
 func (m *MsgRemoveWhitelistRolePermission) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveWhitelistRolePermission) ProtoMessage()    {}
 func (*MsgRemoveWhitelistRolePermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48a3ff9f7c9032f8, []int{5}
+	return fileDescriptor_48a3ff9f7c9032f8, []int{6}
 }
 func (m *MsgRemoveWhitelistRolePermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +472,7 @@ func (m *MsgRemoveBlacklistRolePermission) Reset()         { *m = MsgRemoveBlack
 func (m *MsgRemoveBlacklistRolePermission) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveBlacklistRolePermission) ProtoMessage()    {}
 func (*MsgRemoveBlacklistRolePermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48a3ff9f7c9032f8, []int{6}
+	return fileDescriptor_48a3ff9f7c9032f8, []int{7}
 }
 func (m *MsgRemoveBlacklistRolePermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -438,6 +524,7 @@ func (m *MsgRemoveBlacklistRolePermission) GetPermission() uint32 {
 
 func init() {
 	proto.RegisterType((*MsgCreateRole)(nil), "kira.gov.MsgCreateRole")
+	proto.RegisterType((*RoleInfo)(nil), "kira.gov.RoleInfo")
 	proto.RegisterType((*MsgAssignRole)(nil), "kira.gov.MsgAssignRole")
 	proto.RegisterType((*MsgRemoveRole)(nil), "kira.gov.MsgRemoveRole")
 	proto.RegisterType((*MsgWhitelistRolePermission)(nil), "kira.gov.MsgWhitelistRolePermission")
@@ -449,29 +536,34 @@ func init() {
 func init() { proto.RegisterFile("role.proto", fileDescriptor_48a3ff9f7c9032f8) }
 
 var fileDescriptor_48a3ff9f7c9032f8 = []byte{
-	// 342 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x94, 0xb1, 0x4a, 0x3b, 0x41,
-	0x10, 0xc6, 0xb3, 0xff, 0xbf, 0x68, 0x58, 0x12, 0x8b, 0xc3, 0x22, 0xa4, 0xd8, 0x84, 0x03, 0x21,
-	0x4d, 0xee, 0x10, 0x3b, 0x1b, 0xc9, 0xa5, 0x94, 0x80, 0x5c, 0x23, 0x08, 0x16, 0x9b, 0xcb, 0xb0,
-	0x59, 0xee, 0x2e, 0x73, 0xec, 0xac, 0xc1, 0xbc, 0x85, 0x6f, 0x60, 0xe5, 0xbb, 0x58, 0xc6, 0xce,
-	0x2a, 0x48, 0xf2, 0x06, 0x96, 0x56, 0x92, 0x4b, 0x72, 0x44, 0xac, 0x2c, 0x94, 0x60, 0xb5, 0xc3,
-	0x0c, 0x7c, 0xdf, 0xef, 0x1b, 0x86, 0xe5, 0xdc, 0x60, 0x02, 0x5e, 0x66, 0xd0, 0xa2, 0x53, 0x8e,
-	0xb5, 0x91, 0x9e, 0xc2, 0x71, 0xfd, 0x48, 0xa1, 0xc2, 0xbc, 0xe9, 0x2f, 0xab, 0xd5, 0xdc, 0x35,
-	0xbc, 0xda, 0x23, 0xd5, 0x35, 0x20, 0x2d, 0x84, 0x98, 0x80, 0xd3, 0xe3, 0xe5, 0xcc, 0x60, 0x86,
-	0x04, 0xa6, 0xc6, 0x9a, 0xac, 0x55, 0x09, 0x4e, 0xde, 0x67, 0x8d, 0xb6, 0xd2, 0x76, 0x78, 0xdb,
-	0xf7, 0x22, 0x4c, 0xfd, 0x08, 0x29, 0x45, 0x5a, 0x3f, 0x6d, 0x1a, 0xc4, 0xbe, 0x9d, 0x64, 0x40,
-	0x5e, 0x27, 0x8a, 0x3a, 0x83, 0x81, 0x01, 0xa2, 0xb0, 0x90, 0x70, 0x1c, 0xbe, 0xb7, 0xa4, 0xa9,
-	0xfd, 0x6b, 0xb2, 0x56, 0x35, 0xcc, 0x6b, 0xf7, 0x99, 0xe5, 0xa6, 0x1d, 0x22, 0xad, 0x46, 0x3f,
-	0x61, 0x7a, 0xc3, 0x0f, 0xe4, 0xaa, 0x99, 0xfb, 0x56, 0x82, 0xee, 0xdb, 0xac, 0x71, 0x38, 0x91,
-	0x69, 0x72, 0xe6, 0xae, 0x07, 0xee, 0xf7, 0xf5, 0x37, 0x9a, 0x45, 0xa6, 0xff, 0x5f, 0x33, 0x85,
-	0x90, 0xe2, 0x18, 0xfe, 0x48, 0xa6, 0x07, 0xc6, 0xeb, 0x3d, 0x52, 0x57, 0x43, 0x6d, 0x21, 0xd1,
-	0x64, 0x97, 0xb1, 0x2e, 0xc1, 0xa4, 0x9a, 0x48, 0xe3, 0xe8, 0x17, 0x2e, 0xc5, 0x11, 0x9c, 0x67,
-	0x85, 0xe1, 0x9a, 0x6d, 0xab, 0xb3, 0x21, 0x0c, 0x12, 0x19, 0xc5, 0xbb, 0x49, 0xf8, 0xc8, 0x78,
-	0xb3, 0xb8, 0x8b, 0x1d, 0xde, 0xe4, 0x27, 0xce, 0xdd, 0xdd, 0x67, 0x70, 0xfe, 0x34, 0x17, 0x6c,
-	0x3a, 0x17, 0xec, 0x75, 0x2e, 0xd8, 0xfd, 0x42, 0x94, 0xa6, 0x0b, 0x51, 0x7a, 0x59, 0x88, 0xd2,
-	0xf5, 0xf1, 0x16, 0xc6, 0x85, 0x36, 0xb2, 0x8b, 0x06, 0x7c, 0x82, 0x58, 0x6a, 0xff, 0xce, 0x57,
-	0x38, 0x5e, 0x91, 0xf4, 0xf7, 0xf3, 0x7f, 0xef, 0xf4, 0x23, 0x00, 0x00, 0xff, 0xff, 0xef, 0x43,
-	0x66, 0x92, 0x25, 0x05, 0x00, 0x00,
+	// 418 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x94, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x3b, 0xc9, 0xa2, 0xd9, 0x71, 0xbb, 0xc8, 0xe0, 0x21, 0x2c, 0x92, 0x0d, 0x01, 0xa1,
+	0x97, 0x4d, 0x10, 0x6f, 0x5e, 0xa4, 0xe9, 0x49, 0xa4, 0x20, 0xb9, 0x08, 0x82, 0x87, 0x69, 0x32,
+	0xce, 0x0e, 0x49, 0xfa, 0xc2, 0xbc, 0xd9, 0x62, 0xbf, 0x85, 0xdf, 0xc0, 0x93, 0x7e, 0x16, 0x8f,
+	0xf5, 0xe6, 0xa9, 0x48, 0xfb, 0x0d, 0x3c, 0x7a, 0x92, 0x24, 0x4d, 0x5a, 0x11, 0x0f, 0x1e, 0x94,
+	0xb2, 0xa7, 0x3c, 0xfe, 0x0f, 0xfe, 0xef, 0xf7, 0xfe, 0x79, 0x0c, 0xa5, 0x1a, 0x0a, 0x11, 0x56,
+	0x1a, 0x0c, 0x30, 0x27, 0x57, 0x9a, 0x87, 0x12, 0x16, 0x17, 0x0f, 0x24, 0x48, 0x68, 0xc4, 0xa8,
+	0xae, 0xda, 0x7e, 0xf0, 0x89, 0xd0, 0xe1, 0x14, 0xe5, 0x44, 0x0b, 0x6e, 0x44, 0x02, 0x85, 0x60,
+	0x53, 0xea, 0x54, 0x1a, 0x2a, 0x40, 0xa1, 0x5d, 0xe2, 0x93, 0xd1, 0x59, 0xfc, 0xf8, 0xc7, 0xfa,
+	0xf2, 0x4a, 0x2a, 0x73, 0x7d, 0x33, 0x0b, 0x53, 0x28, 0xa3, 0x14, 0xb0, 0x04, 0xdc, 0x7d, 0xae,
+	0x30, 0xcb, 0x23, 0xb3, 0xac, 0x04, 0x86, 0xe3, 0x34, 0x1d, 0x67, 0x99, 0x16, 0x88, 0x49, 0x6f,
+	0xc1, 0x18, 0x3d, 0xa9, 0x71, 0x5c, 0xcb, 0x27, 0xa3, 0x61, 0xd2, 0xd4, 0xec, 0x3e, 0xb5, 0x51,
+	0x65, 0xae, 0xed, 0x93, 0xd1, 0x69, 0x52, 0x97, 0xcc, 0xa7, 0xf7, 0x32, 0x81, 0xa9, 0x56, 0x95,
+	0x51, 0x30, 0x77, 0x4f, 0x9a, 0xce, 0xa1, 0x14, 0x14, 0xd4, 0xa9, 0xf1, 0x9e, 0xcf, 0xdf, 0x02,
+	0x3b, 0xa7, 0x96, 0xca, 0x1a, 0xb8, 0x61, 0x62, 0xa9, 0xac, 0xf3, 0xb3, 0xfe, 0xe8, 0x67, 0xff,
+	0xe6, 0xc7, 0x1e, 0xd2, 0x53, 0x55, 0x96, 0x37, 0x86, 0xcf, 0x0a, 0xd1, 0xcc, 0x73, 0x92, 0xbd,
+	0x10, 0x7c, 0x69, 0x63, 0x19, 0x23, 0x2a, 0x39, 0xff, 0x17, 0xb1, 0xbc, 0xa1, 0x77, 0x79, 0x2b,
+	0x36, 0xd8, 0x67, 0xf1, 0xe4, 0xfb, 0xfa, 0xf2, 0x7c, 0xc9, 0xcb, 0xe2, 0x69, 0xb0, 0x6b, 0x04,
+	0x7f, 0xef, 0xdf, 0x79, 0xf6, 0xa9, 0xdb, 0xfb, 0xd4, 0xbb, 0x9d, 0x12, 0x51, 0xc2, 0x42, 0xdc,
+	0x92, 0x9d, 0x3e, 0x10, 0x7a, 0x31, 0x45, 0xf9, 0xea, 0x5a, 0x19, 0x51, 0x28, 0x34, 0xf5, 0x5a,
+	0x2f, 0x85, 0x2e, 0x15, 0x62, 0xfd, 0x93, 0xff, 0xc3, 0x2d, 0x7b, 0x94, 0x56, 0xfd, 0xc0, 0x1d,
+	0xdb, 0x81, 0xd2, 0x11, 0xc6, 0x05, 0x4f, 0xf3, 0xe3, 0x24, 0xfc, 0x48, 0xa8, 0xdf, 0xdf, 0xc5,
+	0x11, 0x27, 0xf9, 0x0b, 0xe7, 0xf1, 0xe6, 0x19, 0x3f, 0xfb, 0xbc, 0xf1, 0xc8, 0x6a, 0xe3, 0x91,
+	0x6f, 0x1b, 0x8f, 0xbc, 0xdf, 0x7a, 0x83, 0xd5, 0xd6, 0x1b, 0x7c, 0xdd, 0x7a, 0x83, 0xd7, 0x8f,
+	0x0e, 0x30, 0x5e, 0x28, 0xcd, 0x27, 0xa0, 0x45, 0x84, 0x22, 0xe7, 0x2a, 0x7a, 0x17, 0x49, 0x58,
+	0xb4, 0x24, 0xb3, 0x3b, 0xcd, 0xd3, 0xfc, 0xe4, 0xe7, 0x00, 0xa7, 0x01, 0x9a, 0xc1, 0xc8, 0x05,
+	0x00, 0x00,
 }
 
 func (m *MsgCreateRole) Marshal() (dAtA []byte, err error) {
@@ -494,6 +586,20 @@ func (m *MsgCreateRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintRole(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sid) > 0 {
+		i -= len(m.Sid)
+		copy(dAtA[i:], m.Sid)
+		i = encodeVarintRole(dAtA, i, uint64(len(m.Sid)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Role != 0 {
 		i = encodeVarintRole(dAtA, i, uint64(m.Role))
 		i--
@@ -509,6 +615,58 @@ func (m *MsgCreateRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RoleInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Immutable {
+		i--
+		if m.Immutable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintRole(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sid) > 0 {
+		i -= len(m.Sid)
+		copy(dAtA[i:], m.Sid)
+		i = encodeVarintRole(dAtA, i, uint64(len(m.Sid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintRole(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgAssignRole) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -777,6 +935,37 @@ func (m *MsgCreateRole) Size() (n int) {
 	if m.Role != 0 {
 		n += 1 + sovRole(uint64(m.Role))
 	}
+	l = len(m.Sid)
+	if l > 0 {
+		n += 1 + l + sovRole(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovRole(uint64(l))
+	}
+	return n
+}
+
+func (m *RoleInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRole(uint64(m.Id))
+	}
+	l = len(m.Sid)
+	if l > 0 {
+		n += 1 + l + sovRole(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovRole(uint64(l))
+	}
+	if m.Immutable {
+		n += 2
+	}
 	return n
 }
 
@@ -984,6 +1173,226 @@ func (m *MsgCreateRole) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRole
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRole
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRole(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRole
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRole
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRole
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRole
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRole
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Immutable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Immutable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRole(dAtA[iNdEx:])
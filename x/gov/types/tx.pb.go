@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -100,6 +101,78 @@ func (m *MsgBlacklistPermissionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgBlacklistPermissionsResponse proto.InternalMessageInfo
 
+type MsgDelegatePermissionResponse struct {
+}
+
+func (m *MsgDelegatePermissionResponse) Reset()         { *m = MsgDelegatePermissionResponse{} }
+func (m *MsgDelegatePermissionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegatePermissionResponse) ProtoMessage()    {}
+func (*MsgDelegatePermissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{2}
+}
+func (m *MsgDelegatePermissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegatePermissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegatePermissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegatePermissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegatePermissionResponse.Merge(m, src)
+}
+func (m *MsgDelegatePermissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegatePermissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegatePermissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegatePermissionResponse proto.InternalMessageInfo
+
+type MsgRevokePermissionResponse struct {
+}
+
+func (m *MsgRevokePermissionResponse) Reset()         { *m = MsgRevokePermissionResponse{} }
+func (m *MsgRevokePermissionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokePermissionResponse) ProtoMessage()    {}
+func (*MsgRevokePermissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{3}
+}
+func (m *MsgRevokePermissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokePermissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokePermissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokePermissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokePermissionResponse.Merge(m, src)
+}
+func (m *MsgRevokePermissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokePermissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokePermissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokePermissionResponse proto.InternalMessageInfo
+
 type MsgClaimCouncilorResponse struct {
 }
 
@@ -107,7 +180,7 @@ func (m *MsgClaimCouncilorResponse) Reset()         { *m = MsgClaimCouncilorResp
 func (m *MsgClaimCouncilorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimCouncilorResponse) ProtoMessage()    {}
 func (*MsgClaimCouncilorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{2}
+	return fileDescriptor_0fd2153dc07d3b5c, []int{4}
 }
 func (m *MsgClaimCouncilorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -143,7 +216,7 @@ func (m *MsgVoteProposalResponse) Reset()         { *m = MsgVoteProposalResponse
 func (m *MsgVoteProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteProposalResponse) ProtoMessage()    {}
 func (*MsgVoteProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{3}
+	return fileDescriptor_0fd2153dc07d3b5c, []int{5}
 }
 func (m *MsgVoteProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -172,6 +245,42 @@ func (m *MsgVoteProposalResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVoteProposalResponse proto.InternalMessageInfo
 
+type MsgCancelProposalResponse struct {
+}
+
+func (m *MsgCancelProposalResponse) Reset()         { *m = MsgCancelProposalResponse{} }
+func (m *MsgCancelProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelProposalResponse) ProtoMessage()    {}
+func (*MsgCancelProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{6}
+}
+func (m *MsgCancelProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelProposalResponse.Merge(m, src)
+}
+func (m *MsgCancelProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelProposalResponse proto.InternalMessageInfo
+
 type MsgProposalAssignPermissionResponse struct {
 	ProposalID uint64 `protobuf:"varint,1,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
 }
@@ -180,7 +289,7 @@ func (m *MsgProposalAssignPermissionResponse) Reset()         { *m = MsgProposal
 func (m *MsgProposalAssignPermissionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgProposalAssignPermissionResponse) ProtoMessage()    {}
 func (*MsgProposalAssignPermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{4}
+	return fileDescriptor_0fd2153dc07d3b5c, []int{7}
 }
 func (m *MsgProposalAssignPermissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,7 +333,7 @@ func (m *MsgProposalUpsertDataRegistryResponse) Reset()         { *m = MsgPropos
 func (m *MsgProposalUpsertDataRegistryResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgProposalUpsertDataRegistryResponse) ProtoMessage()    {}
 func (*MsgProposalUpsertDataRegistryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{5}
+	return fileDescriptor_0fd2153dc07d3b5c, []int{8}
 }
 func (m *MsgProposalUpsertDataRegistryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -268,7 +377,7 @@ func (m *MsgProposalSetNetworkPropertyResponse) Reset()         { *m = MsgPropos
 func (m *MsgProposalSetNetworkPropertyResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgProposalSetNetworkPropertyResponse) ProtoMessage()    {}
 func (*MsgProposalSetNetworkPropertyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{6}
+	return fileDescriptor_0fd2153dc07d3b5c, []int{9}
 }
 func (m *MsgProposalSetNetworkPropertyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -316,7 +425,7 @@ func (m *MsgProposalSetPoorNetworkMessagesResponse) String() string {
 }
 func (*MsgProposalSetPoorNetworkMessagesResponse) ProtoMessage() {}
 func (*MsgProposalSetPoorNetworkMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{7}
+	return fileDescriptor_0fd2153dc07d3b5c, []int{10}
 }
 func (m *MsgProposalSetPoorNetworkMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -360,7 +469,7 @@ func (m *MsgProposalCreateRoleResponse) Reset()         { *m = MsgProposalCreate
 func (m *MsgProposalCreateRoleResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgProposalCreateRoleResponse) ProtoMessage()    {}
 func (*MsgProposalCreateRoleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{8}
+	return fileDescriptor_0fd2153dc07d3b5c, []int{11}
 }
 func (m *MsgProposalCreateRoleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -396,21 +505,22 @@ func (m *MsgProposalCreateRoleResponse) GetProposalID() uint64 {
 	return 0
 }
 
-type MsgCreateRoleResponse struct {
+type MsgProposalSetRoleVoteWeightResponse struct {
+	ProposalID uint64 `protobuf:"varint,1,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
 }
 
-func (m *MsgCreateRoleResponse) Reset()         { *m = MsgCreateRoleResponse{} }
-func (m *MsgCreateRoleResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateRoleResponse) ProtoMessage()    {}
-func (*MsgCreateRoleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{9}
+func (m *MsgProposalSetRoleVoteWeightResponse) Reset()         { *m = MsgProposalSetRoleVoteWeightResponse{} }
+func (m *MsgProposalSetRoleVoteWeightResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposalSetRoleVoteWeightResponse) ProtoMessage()    {}
+func (*MsgProposalSetRoleVoteWeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{12}
 }
-func (m *MsgCreateRoleResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgProposalSetRoleVoteWeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgProposalSetRoleVoteWeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateRoleResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgProposalSetRoleVoteWeightResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -420,33 +530,41 @@ func (m *MsgCreateRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateRoleResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateRoleResponse.Merge(m, src)
+func (m *MsgProposalSetRoleVoteWeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposalSetRoleVoteWeightResponse.Merge(m, src)
 }
-func (m *MsgCreateRoleResponse) XXX_Size() int {
+func (m *MsgProposalSetRoleVoteWeightResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateRoleResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateRoleResponse.DiscardUnknown(m)
+func (m *MsgProposalSetRoleVoteWeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposalSetRoleVoteWeightResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateRoleResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgProposalSetRoleVoteWeightResponse proto.InternalMessageInfo
 
-type MsgAssignRoleResponse struct {
+func (m *MsgProposalSetRoleVoteWeightResponse) GetProposalID() uint64 {
+	if m != nil {
+		return m.ProposalID
+	}
+	return 0
 }
 
-func (m *MsgAssignRoleResponse) Reset()         { *m = MsgAssignRoleResponse{} }
-func (m *MsgAssignRoleResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAssignRoleResponse) ProtoMessage()    {}
-func (*MsgAssignRoleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{10}
+type MsgProposalParameterChangeResponse struct {
+	ProposalID uint64 `protobuf:"varint,1,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
 }
-func (m *MsgAssignRoleResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgProposalParameterChangeResponse) Reset()         { *m = MsgProposalParameterChangeResponse{} }
+func (m *MsgProposalParameterChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposalParameterChangeResponse) ProtoMessage()    {}
+func (*MsgProposalParameterChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{13}
+}
+func (m *MsgProposalParameterChangeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAssignRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgProposalParameterChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAssignRoleResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgProposalParameterChangeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -456,33 +574,41 @@ func (m *MsgAssignRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgAssignRoleResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAssignRoleResponse.Merge(m, src)
+func (m *MsgProposalParameterChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposalParameterChangeResponse.Merge(m, src)
 }
-func (m *MsgAssignRoleResponse) XXX_Size() int {
+func (m *MsgProposalParameterChangeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAssignRoleResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAssignRoleResponse.DiscardUnknown(m)
+func (m *MsgProposalParameterChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposalParameterChangeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAssignRoleResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgProposalParameterChangeResponse proto.InternalMessageInfo
 
-type MsgRemoveRoleResponse struct {
+func (m *MsgProposalParameterChangeResponse) GetProposalID() uint64 {
+	if m != nil {
+		return m.ProposalID
+	}
+	return 0
 }
 
-func (m *MsgRemoveRoleResponse) Reset()         { *m = MsgRemoveRoleResponse{} }
-func (m *MsgRemoveRoleResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveRoleResponse) ProtoMessage()    {}
-func (*MsgRemoveRoleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{11}
+type MsgProposalMultiContentResponse struct {
+	ProposalID uint64 `protobuf:"varint,1,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
 }
-func (m *MsgRemoveRoleResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgProposalMultiContentResponse) Reset()         { *m = MsgProposalMultiContentResponse{} }
+func (m *MsgProposalMultiContentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposalMultiContentResponse) ProtoMessage()    {}
+func (*MsgProposalMultiContentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{14}
+}
+func (m *MsgProposalMultiContentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgProposalMultiContentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveRoleResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgProposalMultiContentResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -492,33 +618,41 @@ func (m *MsgRemoveRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveRoleResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveRoleResponse.Merge(m, src)
+func (m *MsgProposalMultiContentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposalMultiContentResponse.Merge(m, src)
 }
-func (m *MsgRemoveRoleResponse) XXX_Size() int {
+func (m *MsgProposalMultiContentResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveRoleResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveRoleResponse.DiscardUnknown(m)
+func (m *MsgProposalMultiContentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposalMultiContentResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveRoleResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgProposalMultiContentResponse proto.InternalMessageInfo
 
-type MsgSetNetworkPropertiesResponse struct {
+func (m *MsgProposalMultiContentResponse) GetProposalID() uint64 {
+	if m != nil {
+		return m.ProposalID
+	}
+	return 0
 }
 
-func (m *MsgSetNetworkPropertiesResponse) Reset()         { *m = MsgSetNetworkPropertiesResponse{} }
-func (m *MsgSetNetworkPropertiesResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSetNetworkPropertiesResponse) ProtoMessage()    {}
-func (*MsgSetNetworkPropertiesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{12}
+type MsgProposalSoftwareUpgradeResponse struct {
+	ProposalID uint64 `protobuf:"varint,1,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
 }
-func (m *MsgSetNetworkPropertiesResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgProposalSoftwareUpgradeResponse) Reset()         { *m = MsgProposalSoftwareUpgradeResponse{} }
+func (m *MsgProposalSoftwareUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposalSoftwareUpgradeResponse) ProtoMessage()    {}
+func (*MsgProposalSoftwareUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{15}
+}
+func (m *MsgProposalSoftwareUpgradeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetNetworkPropertiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgProposalSoftwareUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetNetworkPropertiesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgProposalSoftwareUpgradeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -528,33 +662,43 @@ func (m *MsgSetNetworkPropertiesResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *MsgSetNetworkPropertiesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetNetworkPropertiesResponse.Merge(m, src)
+func (m *MsgProposalSoftwareUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposalSoftwareUpgradeResponse.Merge(m, src)
 }
-func (m *MsgSetNetworkPropertiesResponse) XXX_Size() int {
+func (m *MsgProposalSoftwareUpgradeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetNetworkPropertiesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetNetworkPropertiesResponse.DiscardUnknown(m)
+func (m *MsgProposalSoftwareUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposalSoftwareUpgradeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetNetworkPropertiesResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgProposalSoftwareUpgradeResponse proto.InternalMessageInfo
 
-type MsgSetExecutionFeeResponse struct {
+func (m *MsgProposalSoftwareUpgradeResponse) GetProposalID() uint64 {
+	if m != nil {
+		return m.ProposalID
+	}
+	return 0
 }
 
-func (m *MsgSetExecutionFeeResponse) Reset()         { *m = MsgSetExecutionFeeResponse{} }
-func (m *MsgSetExecutionFeeResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSetExecutionFeeResponse) ProtoMessage()    {}
-func (*MsgSetExecutionFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{13}
+type MsgProposalCancelSoftwareUpgradeResponse struct {
+	ProposalID uint64 `protobuf:"varint,1,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
 }
-func (m *MsgSetExecutionFeeResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgProposalCancelSoftwareUpgradeResponse) Reset() {
+	*m = MsgProposalCancelSoftwareUpgradeResponse{}
+}
+func (m *MsgProposalCancelSoftwareUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposalCancelSoftwareUpgradeResponse) ProtoMessage()    {}
+func (*MsgProposalCancelSoftwareUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{16}
+}
+func (m *MsgProposalCancelSoftwareUpgradeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetExecutionFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgProposalCancelSoftwareUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetExecutionFeeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgProposalCancelSoftwareUpgradeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -564,33 +708,41 @@ func (m *MsgSetExecutionFeeResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgSetExecutionFeeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetExecutionFeeResponse.Merge(m, src)
+func (m *MsgProposalCancelSoftwareUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposalCancelSoftwareUpgradeResponse.Merge(m, src)
 }
-func (m *MsgSetExecutionFeeResponse) XXX_Size() int {
+func (m *MsgProposalCancelSoftwareUpgradeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetExecutionFeeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetExecutionFeeResponse.DiscardUnknown(m)
+func (m *MsgProposalCancelSoftwareUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposalCancelSoftwareUpgradeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetExecutionFeeResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgProposalCancelSoftwareUpgradeResponse proto.InternalMessageInfo
 
-type MsgWhitelistRolePermissionResponse struct {
+func (m *MsgProposalCancelSoftwareUpgradeResponse) GetProposalID() uint64 {
+	if m != nil {
+		return m.ProposalID
+	}
+	return 0
 }
 
-func (m *MsgWhitelistRolePermissionResponse) Reset()         { *m = MsgWhitelistRolePermissionResponse{} }
-func (m *MsgWhitelistRolePermissionResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWhitelistRolePermissionResponse) ProtoMessage()    {}
-func (*MsgWhitelistRolePermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{14}
+type MsgProposalRemoveCouncilorResponse struct {
+	ProposalID uint64 `protobuf:"varint,1,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
 }
-func (m *MsgWhitelistRolePermissionResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgProposalRemoveCouncilorResponse) Reset()         { *m = MsgProposalRemoveCouncilorResponse{} }
+func (m *MsgProposalRemoveCouncilorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposalRemoveCouncilorResponse) ProtoMessage()    {}
+func (*MsgProposalRemoveCouncilorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{17}
+}
+func (m *MsgProposalRemoveCouncilorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWhitelistRolePermissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgProposalRemoveCouncilorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWhitelistRolePermissionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgProposalRemoveCouncilorResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -600,33 +752,40 @@ func (m *MsgWhitelistRolePermissionResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgWhitelistRolePermissionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWhitelistRolePermissionResponse.Merge(m, src)
+func (m *MsgProposalRemoveCouncilorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposalRemoveCouncilorResponse.Merge(m, src)
 }
-func (m *MsgWhitelistRolePermissionResponse) XXX_Size() int {
+func (m *MsgProposalRemoveCouncilorResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWhitelistRolePermissionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWhitelistRolePermissionResponse.DiscardUnknown(m)
+func (m *MsgProposalRemoveCouncilorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposalRemoveCouncilorResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWhitelistRolePermissionResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgProposalRemoveCouncilorResponse proto.InternalMessageInfo
 
-type MsgBlacklistRolePermissionResponse struct {
+func (m *MsgProposalRemoveCouncilorResponse) GetProposalID() uint64 {
+	if m != nil {
+		return m.ProposalID
+	}
+	return 0
 }
 
-func (m *MsgBlacklistRolePermissionResponse) Reset()         { *m = MsgBlacklistRolePermissionResponse{} }
-func (m *MsgBlacklistRolePermissionResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgBlacklistRolePermissionResponse) ProtoMessage()    {}
-func (*MsgBlacklistRolePermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{15}
+type MsgCreateRoleResponse struct {
 }
-func (m *MsgBlacklistRolePermissionResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgCreateRoleResponse) Reset()         { *m = MsgCreateRoleResponse{} }
+func (m *MsgCreateRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateRoleResponse) ProtoMessage()    {}
+func (*MsgCreateRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{18}
+}
+func (m *MsgCreateRoleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBlacklistRolePermissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBlacklistRolePermissionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateRoleResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -636,28 +795,244 @@ func (m *MsgBlacklistRolePermissionResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgBlacklistRolePermissionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBlacklistRolePermissionResponse.Merge(m, src)
+func (m *MsgCreateRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateRoleResponse.Merge(m, src)
 }
-func (m *MsgBlacklistRolePermissionResponse) XXX_Size() int {
+func (m *MsgCreateRoleResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBlacklistRolePermissionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBlacklistRolePermissionResponse.DiscardUnknown(m)
+func (m *MsgCreateRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateRoleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBlacklistRolePermissionResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateRoleResponse proto.InternalMessageInfo
 
-type MsgRemoveWhitelistRolePermissionResponse struct {
+type MsgAssignRoleResponse struct {
 }
 
-func (m *MsgRemoveWhitelistRolePermissionResponse) Reset() {
-	*m = MsgRemoveWhitelistRolePermissionResponse{}
+func (m *MsgAssignRoleResponse) Reset()         { *m = MsgAssignRoleResponse{} }
+func (m *MsgAssignRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAssignRoleResponse) ProtoMessage()    {}
+func (*MsgAssignRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{19}
+}
+func (m *MsgAssignRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAssignRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAssignRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAssignRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAssignRoleResponse.Merge(m, src)
+}
+func (m *MsgAssignRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAssignRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAssignRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAssignRoleResponse proto.InternalMessageInfo
+
+type MsgRemoveRoleResponse struct {
+}
+
+func (m *MsgRemoveRoleResponse) Reset()         { *m = MsgRemoveRoleResponse{} }
+func (m *MsgRemoveRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRoleResponse) ProtoMessage()    {}
+func (*MsgRemoveRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{20}
+}
+func (m *MsgRemoveRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRoleResponse.Merge(m, src)
+}
+func (m *MsgRemoveRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRoleResponse proto.InternalMessageInfo
+
+type MsgSetNetworkPropertiesResponse struct {
+}
+
+func (m *MsgSetNetworkPropertiesResponse) Reset()         { *m = MsgSetNetworkPropertiesResponse{} }
+func (m *MsgSetNetworkPropertiesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetNetworkPropertiesResponse) ProtoMessage()    {}
+func (*MsgSetNetworkPropertiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{21}
+}
+func (m *MsgSetNetworkPropertiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetNetworkPropertiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetNetworkPropertiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetNetworkPropertiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetNetworkPropertiesResponse.Merge(m, src)
+}
+func (m *MsgSetNetworkPropertiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetNetworkPropertiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetNetworkPropertiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetNetworkPropertiesResponse proto.InternalMessageInfo
+
+type MsgSetExecutionFeeResponse struct {
+}
+
+func (m *MsgSetExecutionFeeResponse) Reset()         { *m = MsgSetExecutionFeeResponse{} }
+func (m *MsgSetExecutionFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetExecutionFeeResponse) ProtoMessage()    {}
+func (*MsgSetExecutionFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{22}
+}
+func (m *MsgSetExecutionFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetExecutionFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetExecutionFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetExecutionFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetExecutionFeeResponse.Merge(m, src)
+}
+func (m *MsgSetExecutionFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetExecutionFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetExecutionFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetExecutionFeeResponse proto.InternalMessageInfo
+
+type MsgWhitelistRolePermissionResponse struct {
+}
+
+func (m *MsgWhitelistRolePermissionResponse) Reset()         { *m = MsgWhitelistRolePermissionResponse{} }
+func (m *MsgWhitelistRolePermissionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWhitelistRolePermissionResponse) ProtoMessage()    {}
+func (*MsgWhitelistRolePermissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{23}
+}
+func (m *MsgWhitelistRolePermissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWhitelistRolePermissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWhitelistRolePermissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWhitelistRolePermissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWhitelistRolePermissionResponse.Merge(m, src)
+}
+func (m *MsgWhitelistRolePermissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWhitelistRolePermissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWhitelistRolePermissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWhitelistRolePermissionResponse proto.InternalMessageInfo
+
+type MsgBlacklistRolePermissionResponse struct {
+}
+
+func (m *MsgBlacklistRolePermissionResponse) Reset()         { *m = MsgBlacklistRolePermissionResponse{} }
+func (m *MsgBlacklistRolePermissionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBlacklistRolePermissionResponse) ProtoMessage()    {}
+func (*MsgBlacklistRolePermissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{24}
+}
+func (m *MsgBlacklistRolePermissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBlacklistRolePermissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBlacklistRolePermissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBlacklistRolePermissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBlacklistRolePermissionResponse.Merge(m, src)
+}
+func (m *MsgBlacklistRolePermissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBlacklistRolePermissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBlacklistRolePermissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBlacklistRolePermissionResponse proto.InternalMessageInfo
+
+type MsgRemoveWhitelistRolePermissionResponse struct {
+}
+
+func (m *MsgRemoveWhitelistRolePermissionResponse) Reset() {
+	*m = MsgRemoveWhitelistRolePermissionResponse{}
 }
 func (m *MsgRemoveWhitelistRolePermissionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveWhitelistRolePermissionResponse) ProtoMessage()    {}
 func (*MsgRemoveWhitelistRolePermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{16}
+	return fileDescriptor_0fd2153dc07d3b5c, []int{25}
 }
 func (m *MsgRemoveWhitelistRolePermissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -695,7 +1070,7 @@ func (m *MsgRemoveBlacklistRolePermissionResponse) Reset() {
 func (m *MsgRemoveBlacklistRolePermissionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveBlacklistRolePermissionResponse) ProtoMessage()    {}
 func (*MsgRemoveBlacklistRolePermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{17}
+	return fileDescriptor_0fd2153dc07d3b5c, []int{26}
 }
 func (m *MsgRemoveBlacklistRolePermissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -724,88 +1099,421 @@ func (m *MsgRemoveBlacklistRolePermissionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveBlacklistRolePermissionResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgWhitelistPermissionsResponse)(nil), "kira.gov.MsgWhitelistPermissionsResponse")
-	proto.RegisterType((*MsgBlacklistPermissionsResponse)(nil), "kira.gov.MsgBlacklistPermissionsResponse")
-	proto.RegisterType((*MsgClaimCouncilorResponse)(nil), "kira.gov.MsgClaimCouncilorResponse")
-	proto.RegisterType((*MsgVoteProposalResponse)(nil), "kira.gov.MsgVoteProposalResponse")
-	proto.RegisterType((*MsgProposalAssignPermissionResponse)(nil), "kira.gov.MsgProposalAssignPermissionResponse")
-	proto.RegisterType((*MsgProposalUpsertDataRegistryResponse)(nil), "kira.gov.MsgProposalUpsertDataRegistryResponse")
-	proto.RegisterType((*MsgProposalSetNetworkPropertyResponse)(nil), "kira.gov.MsgProposalSetNetworkPropertyResponse")
-	proto.RegisterType((*MsgProposalSetPoorNetworkMessagesResponse)(nil), "kira.gov.MsgProposalSetPoorNetworkMessagesResponse")
-	proto.RegisterType((*MsgProposalCreateRoleResponse)(nil), "kira.gov.MsgProposalCreateRoleResponse")
-	proto.RegisterType((*MsgCreateRoleResponse)(nil), "kira.gov.MsgCreateRoleResponse")
-	proto.RegisterType((*MsgAssignRoleResponse)(nil), "kira.gov.MsgAssignRoleResponse")
-	proto.RegisterType((*MsgRemoveRoleResponse)(nil), "kira.gov.MsgRemoveRoleResponse")
-	proto.RegisterType((*MsgSetNetworkPropertiesResponse)(nil), "kira.gov.MsgSetNetworkPropertiesResponse")
-	proto.RegisterType((*MsgSetExecutionFeeResponse)(nil), "kira.gov.MsgSetExecutionFeeResponse")
-	proto.RegisterType((*MsgWhitelistRolePermissionResponse)(nil), "kira.gov.MsgWhitelistRolePermissionResponse")
-	proto.RegisterType((*MsgBlacklistRolePermissionResponse)(nil), "kira.gov.MsgBlacklistRolePermissionResponse")
-	proto.RegisterType((*MsgRemoveWhitelistRolePermissionResponse)(nil), "kira.gov.MsgRemoveWhitelistRolePermissionResponse")
-	proto.RegisterType((*MsgRemoveBlacklistRolePermissionResponse)(nil), "kira.gov.MsgRemoveBlacklistRolePermissionResponse")
+type MsgGrantAuthorizationResponse struct {
 }
 
-func init() { proto.RegisterFile("tx.proto", fileDescriptor_0fd2153dc07d3b5c) }
-
-var fileDescriptor_0fd2153dc07d3b5c = []byte{
-	// 729 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xdd, 0x4e, 0x13, 0x4f,
-	0x18, 0xc6, 0x69, 0xfe, 0xff, 0x18, 0xf2, 0x6a, 0x20, 0x19, 0xd1, 0xc2, 0x00, 0x45, 0x0a, 0x84,
-	0x0f, 0xb5, 0x9b, 0xc0, 0x05, 0x10, 0x29, 0x60, 0x0c, 0xd6, 0x34, 0x35, 0x68, 0xe2, 0x81, 0x64,
-	0x29, 0xaf, 0xc3, 0xa4, 0xdb, 0xce, 0x66, 0x66, 0x28, 0x70, 0xa2, 0xb7, 0xe0, 0x75, 0x78, 0x25,
-	0x1e, 0x72, 0xe8, 0xa1, 0x81, 0x1b, 0x31, 0xa5, 0xfb, 0xbd, 0x33, 0xed, 0xea, 0x59, 0x3b, 0xcf,
-	0x33, 0xbf, 0x77, 0xbe, 0xde, 0xa7, 0x85, 0x49, 0x7d, 0x55, 0xf3, 0xa5, 0xd0, 0x82, 0x4c, 0x76,
-	0xb8, 0x74, 0x6b, 0x4c, 0xf4, 0xe9, 0x0c, 0x13, 0x4c, 0xdc, 0x0f, 0x3a, 0x83, 0x4f, 0x43, 0x9d,
-	0x3e, 0x74, 0xdb, 0x5a, 0xc8, 0xe0, 0xcb, 0x74, 0x5b, 0x5c, 0xf4, 0xda, 0xdc, 0x8b, 0x06, 0xa6,
-	0x7c, 0x29, 0x7c, 0xa1, 0x5c, 0x2f, 0xf8, 0x0e, 0x52, 0x78, 0x18, 0x7c, 0x7e, 0x8c, 0x57, 0xd8,
-	0xbe, 0xd0, 0x5c, 0xf4, 0x4e, 0xbe, 0x60, 0x38, 0x38, 0xdb, 0x43, 0x7d, 0x29, 0x64, 0xe7, 0x64,
-	0x30, 0x11, 0xa5, 0xe6, 0xa8, 0x02, 0xe5, 0xa9, 0xeb, 0x79, 0xe2, 0x12, 0xcf, 0x4e, 0xba, 0xa8,
-	0x94, 0xcb, 0xc2, 0xf1, 0xea, 0x32, 0x2c, 0x35, 0x14, 0xfb, 0x78, 0xce, 0x35, 0x7a, 0x5c, 0xe9,
-	0x26, 0xca, 0x2e, 0x57, 0x8a, 0x8b, 0x9e, 0x6a, 0xa1, 0xf2, 0x45, 0x4f, 0x61, 0x60, 0xd9, 0xf3,
-	0xdc, 0x76, 0xc7, 0x66, 0x99, 0x87, 0xb9, 0x86, 0x62, 0x75, 0xcf, 0xe5, 0xdd, 0x7a, 0xb8, 0x87,
-	0x48, 0x9c, 0x83, 0x72, 0x43, 0xb1, 0x0f, 0x42, 0x63, 0x33, 0xd8, 0x4e, 0x24, 0x1d, 0xc0, 0x4a,
-	0x43, 0xb1, 0x70, 0xf8, 0x95, 0x52, 0x9c, 0xf5, 0x62, 0x7e, 0x68, 0x23, 0x15, 0x80, 0xf0, 0x24,
-	0xde, 0xec, 0xcf, 0x96, 0x9e, 0x95, 0x36, 0xfe, 0x6f, 0x25, 0x46, 0xaa, 0xaf, 0x61, 0x2d, 0x81,
-	0x39, 0xf6, 0x15, 0x4a, 0xbd, 0xef, 0x6a, 0xb7, 0x85, 0x8c, 0x2b, 0x2d, 0xaf, 0xff, 0x11, 0xf4,
-	0x1e, 0xf5, 0xbb, 0xe1, 0x79, 0x36, 0x87, 0xc7, 0x59, 0x1c, 0x74, 0x04, 0x9b, 0x69, 0x50, 0x53,
-	0x08, 0x19, 0xc0, 0x1a, 0xc1, 0x0d, 0x14, 0x86, 0xed, 0xc2, 0x62, 0x02, 0x56, 0x97, 0xe8, 0x6a,
-	0x6c, 0x09, 0x0f, 0x0b, 0x03, 0xca, 0xf0, 0x64, 0x70, 0x3d, 0xb9, 0x89, 0x81, 0x30, 0x3c, 0x77,
-	0x83, 0xd0, 0xc2, 0xae, 0xe8, 0xa7, 0x67, 0x0c, 0x1f, 0x43, 0xee, 0x64, 0x78, 0xbc, 0x9d, 0xea,
-	0x02, 0xd0, 0xa1, 0xe5, 0x20, 0x7c, 0xa1, 0x87, 0x18, 0x03, 0x56, 0xa1, 0x9a, 0x7c, 0x70, 0x03,
-	0x78, 0xfe, 0xc6, 0x03, 0x57, 0xf4, 0xe6, 0x2c, 0xae, 0x2d, 0xd8, 0x88, 0x56, 0x39, 0x8e, 0x98,
-	0xf4, 0x8e, 0xe1, 0x6e, 0xff, 0x98, 0x82, 0xff, 0x1a, 0x8a, 0x91, 0x33, 0x98, 0x31, 0x75, 0x06,
-	0x59, 0xae, 0x85, 0x6d, 0x5d, 0xb3, 0x34, 0x0f, 0xdd, 0x1c, 0x6b, 0x89, 0x6e, 0xef, 0x0c, 0x66,
-	0x4c, 0xcd, 0x95, 0xa9, 0x62, 0xb2, 0x64, 0xaa, 0x8c, 0x6a, 0x51, 0xd2, 0x82, 0xa9, 0x74, 0x7f,
-	0x92, 0xf9, 0xd4, 0xe4, 0xb4, 0x48, 0x57, 0x46, 0x88, 0x11, 0xf3, 0x2d, 0x3c, 0x4a, 0xb6, 0x35,
-	0x99, 0x4b, 0x4d, 0x4a, 0x4a, 0x74, 0xd9, 0x2a, 0x45, 0x34, 0x1f, 0x66, 0x6d, 0x49, 0x40, 0xd6,
-	0x52, 0xd3, 0x6d, 0x36, 0xfa, 0xb2, 0x90, 0x2d, 0xaa, 0xd8, 0x07, 0x6a, 0x0f, 0x0d, 0xb2, 0x6e,
-	0x84, 0xe5, 0x8d, 0xd4, 0x29, 0x68, 0x34, 0xd5, 0xcd, 0x67, 0x8c, 0xa5, 0x6e, 0xde, 0x68, 0xa9,
-	0x3b, 0x22, 0xb5, 0xbe, 0xa6, 0xea, 0x26, 0x23, 0x49, 0x31, 0x45, 0x9e, 0xdb, 0x70, 0x86, 0xec,
-	0xa2, 0x3b, 0x7f, 0x61, 0x8e, 0xea, 0x7f, 0x06, 0x92, 0x4f, 0x31, 0xb2, 0x64, 0x44, 0xc5, 0x06,
-	0xba, 0x3e, 0xc6, 0x10, 0xf1, 0x0f, 0x01, 0x12, 0xdc, 0x72, 0xfa, 0x09, 0xc7, 0xbc, 0x25, 0x8b,
-	0x90, 0xe4, 0xc4, 0x99, 0x98, 0xe1, 0xc4, 0x42, 0x86, 0x93, 0x4f, 0xd1, 0x01, 0x27, 0x8e, 0xd0,
-	0x0c, 0x27, 0x16, 0x32, 0x9c, 0x7c, 0xe8, 0x0e, 0x12, 0xc2, 0x94, 0xb8, 0x99, 0x84, 0x30, 0x59,
-	0x32, 0x09, 0x31, 0x2a, 0xb7, 0xc9, 0x31, 0x4c, 0x67, 0x42, 0x9b, 0x2c, 0x64, 0x67, 0x27, 0x55,
-	0xba, 0x3a, 0x4a, 0x8d, 0xb0, 0x5d, 0x28, 0x5b, 0xb2, 0x99, 0xac, 0x9a, 0x43, 0x32, 0xed, 0xa2,
-	0x2f, 0x8a, 0xb8, 0x92, 0xe5, 0x2c, 0xf1, 0x9e, 0x29, 0x67, 0x71, 0x65, 0xca, 0x8d, 0xf9, 0xa9,
-	0x20, 0xdf, 0x60, 0x71, 0xe4, 0xef, 0x0f, 0xd9, 0x32, 0x5c, 0xae, 0x6d, 0xa7, 0xdb, 0xc5, 0xbd,
-	0xf9, 0x05, 0xd8, 0x76, 0x6d, 0x5a, 0x80, 0x6d, 0xef, 0xdb, 0xc5, 0xbd, 0xe1, 0x02, 0xf6, 0x76,
-	0x7f, 0xde, 0x56, 0x4a, 0x37, 0xb7, 0x95, 0xd2, 0xef, 0xdb, 0x4a, 0xe9, 0xfb, 0x5d, 0x65, 0xe2,
-	0xe6, 0xae, 0x32, 0xf1, 0xeb, 0xae, 0x32, 0xf1, 0x69, 0x8d, 0x71, 0x7d, 0x7e, 0x71, 0x5a, 0x6b,
-	0x8b, 0xae, 0x73, 0xc4, 0xa5, 0x5b, 0x17, 0x12, 0x1d, 0x85, 0x1d, 0x97, 0x3b, 0x57, 0x0e, 0x13,
-	0x7d, 0x47, 0x5f, 0xfb, 0xa8, 0x4e, 0x1f, 0xdc, 0xff, 0x13, 0xdd, 0xf9, 0x13, 0x00, 0x00, 0xff,
-	0xff, 0x5d, 0x43, 0x8a, 0x59, 0x36, 0x0b, 0x00, 0x00,
+func (m *MsgGrantAuthorizationResponse) Reset()         { *m = MsgGrantAuthorizationResponse{} }
+func (m *MsgGrantAuthorizationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantAuthorizationResponse) ProtoMessage()    {}
+func (*MsgGrantAuthorizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{27}
+}
+func (m *MsgGrantAuthorizationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantAuthorizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantAuthorizationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantAuthorizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantAuthorizationResponse.Merge(m, src)
+}
+func (m *MsgGrantAuthorizationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantAuthorizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantAuthorizationResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgGrantAuthorizationResponse proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+type MsgRevokeAuthorizationResponse struct {
+}
 
-// MsgClient is the client API for Msg service.
+func (m *MsgRevokeAuthorizationResponse) Reset()         { *m = MsgRevokeAuthorizationResponse{} }
+func (m *MsgRevokeAuthorizationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAuthorizationResponse) ProtoMessage()    {}
+func (*MsgRevokeAuthorizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{28}
+}
+func (m *MsgRevokeAuthorizationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAuthorizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAuthorizationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAuthorizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAuthorizationResponse.Merge(m, src)
+}
+func (m *MsgRevokeAuthorizationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAuthorizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAuthorizationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAuthorizationResponse proto.InternalMessageInfo
+
+type MsgExecResponse struct {
+	// results are the data returned by the executed messages.
+	Results [][]byte `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *MsgExecResponse) Reset()         { *m = MsgExecResponse{} }
+func (m *MsgExecResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecResponse) ProtoMessage()    {}
+func (*MsgExecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{29}
+}
+func (m *MsgExecResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecResponse.Merge(m, src)
+}
+func (m *MsgExecResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecResponse proto.InternalMessageInfo
+
+func (m *MsgExecResponse) GetResults() [][]byte {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type MsgCreateGroupResponse struct {
+	GroupId uint64                                        `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Address github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+}
+
+func (m *MsgCreateGroupResponse) Reset()         { *m = MsgCreateGroupResponse{} }
+func (m *MsgCreateGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateGroupResponse) ProtoMessage()    {}
+func (*MsgCreateGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{30}
+}
+func (m *MsgCreateGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateGroupResponse.Merge(m, src)
+}
+func (m *MsgCreateGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateGroupResponse proto.InternalMessageInfo
+
+func (m *MsgCreateGroupResponse) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *MsgCreateGroupResponse) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+type MsgUpdateGroupResponse struct {
+}
+
+func (m *MsgUpdateGroupResponse) Reset()         { *m = MsgUpdateGroupResponse{} }
+func (m *MsgUpdateGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGroupResponse) ProtoMessage()    {}
+func (*MsgUpdateGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{31}
+}
+func (m *MsgUpdateGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupResponse.Merge(m, src)
+}
+func (m *MsgUpdateGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGroupResponse proto.InternalMessageInfo
+
+type MsgSubmitGroupProposalResponse struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgSubmitGroupProposalResponse) Reset()         { *m = MsgSubmitGroupProposalResponse{} }
+func (m *MsgSubmitGroupProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitGroupProposalResponse) ProtoMessage()    {}
+func (*MsgSubmitGroupProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{32}
+}
+func (m *MsgSubmitGroupProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitGroupProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitGroupProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitGroupProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitGroupProposalResponse.Merge(m, src)
+}
+func (m *MsgSubmitGroupProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitGroupProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitGroupProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitGroupProposalResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitGroupProposalResponse) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+type MsgApproveGroupProposalResponse struct {
+}
+
+func (m *MsgApproveGroupProposalResponse) Reset()         { *m = MsgApproveGroupProposalResponse{} }
+func (m *MsgApproveGroupProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveGroupProposalResponse) ProtoMessage()    {}
+func (*MsgApproveGroupProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{33}
+}
+func (m *MsgApproveGroupProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveGroupProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveGroupProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveGroupProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveGroupProposalResponse.Merge(m, src)
+}
+func (m *MsgApproveGroupProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveGroupProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveGroupProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveGroupProposalResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgWhitelistPermissionsResponse)(nil), "kira.gov.MsgWhitelistPermissionsResponse")
+	proto.RegisterType((*MsgBlacklistPermissionsResponse)(nil), "kira.gov.MsgBlacklistPermissionsResponse")
+	proto.RegisterType((*MsgDelegatePermissionResponse)(nil), "kira.gov.MsgDelegatePermissionResponse")
+	proto.RegisterType((*MsgRevokePermissionResponse)(nil), "kira.gov.MsgRevokePermissionResponse")
+	proto.RegisterType((*MsgClaimCouncilorResponse)(nil), "kira.gov.MsgClaimCouncilorResponse")
+	proto.RegisterType((*MsgVoteProposalResponse)(nil), "kira.gov.MsgVoteProposalResponse")
+	proto.RegisterType((*MsgCancelProposalResponse)(nil), "kira.gov.MsgCancelProposalResponse")
+	proto.RegisterType((*MsgProposalAssignPermissionResponse)(nil), "kira.gov.MsgProposalAssignPermissionResponse")
+	proto.RegisterType((*MsgProposalUpsertDataRegistryResponse)(nil), "kira.gov.MsgProposalUpsertDataRegistryResponse")
+	proto.RegisterType((*MsgProposalSetNetworkPropertyResponse)(nil), "kira.gov.MsgProposalSetNetworkPropertyResponse")
+	proto.RegisterType((*MsgProposalSetPoorNetworkMessagesResponse)(nil), "kira.gov.MsgProposalSetPoorNetworkMessagesResponse")
+	proto.RegisterType((*MsgProposalCreateRoleResponse)(nil), "kira.gov.MsgProposalCreateRoleResponse")
+	proto.RegisterType((*MsgProposalSetRoleVoteWeightResponse)(nil), "kira.gov.MsgProposalSetRoleVoteWeightResponse")
+	proto.RegisterType((*MsgProposalParameterChangeResponse)(nil), "kira.gov.MsgProposalParameterChangeResponse")
+	proto.RegisterType((*MsgProposalMultiContentResponse)(nil), "kira.gov.MsgProposalMultiContentResponse")
+	proto.RegisterType((*MsgProposalSoftwareUpgradeResponse)(nil), "kira.gov.MsgProposalSoftwareUpgradeResponse")
+	proto.RegisterType((*MsgProposalCancelSoftwareUpgradeResponse)(nil), "kira.gov.MsgProposalCancelSoftwareUpgradeResponse")
+	proto.RegisterType((*MsgProposalRemoveCouncilorResponse)(nil), "kira.gov.MsgProposalRemoveCouncilorResponse")
+	proto.RegisterType((*MsgCreateRoleResponse)(nil), "kira.gov.MsgCreateRoleResponse")
+	proto.RegisterType((*MsgAssignRoleResponse)(nil), "kira.gov.MsgAssignRoleResponse")
+	proto.RegisterType((*MsgRemoveRoleResponse)(nil), "kira.gov.MsgRemoveRoleResponse")
+	proto.RegisterType((*MsgSetNetworkPropertiesResponse)(nil), "kira.gov.MsgSetNetworkPropertiesResponse")
+	proto.RegisterType((*MsgSetExecutionFeeResponse)(nil), "kira.gov.MsgSetExecutionFeeResponse")
+	proto.RegisterType((*MsgWhitelistRolePermissionResponse)(nil), "kira.gov.MsgWhitelistRolePermissionResponse")
+	proto.RegisterType((*MsgBlacklistRolePermissionResponse)(nil), "kira.gov.MsgBlacklistRolePermissionResponse")
+	proto.RegisterType((*MsgRemoveWhitelistRolePermissionResponse)(nil), "kira.gov.MsgRemoveWhitelistRolePermissionResponse")
+	proto.RegisterType((*MsgRemoveBlacklistRolePermissionResponse)(nil), "kira.gov.MsgRemoveBlacklistRolePermissionResponse")
+	proto.RegisterType((*MsgGrantAuthorizationResponse)(nil), "kira.gov.MsgGrantAuthorizationResponse")
+	proto.RegisterType((*MsgRevokeAuthorizationResponse)(nil), "kira.gov.MsgRevokeAuthorizationResponse")
+	proto.RegisterType((*MsgExecResponse)(nil), "kira.gov.MsgExecResponse")
+	proto.RegisterType((*MsgCreateGroupResponse)(nil), "kira.gov.MsgCreateGroupResponse")
+	proto.RegisterType((*MsgUpdateGroupResponse)(nil), "kira.gov.MsgUpdateGroupResponse")
+	proto.RegisterType((*MsgSubmitGroupProposalResponse)(nil), "kira.gov.MsgSubmitGroupProposalResponse")
+	proto.RegisterType((*MsgApproveGroupProposalResponse)(nil), "kira.gov.MsgApproveGroupProposalResponse")
+}
+
+func init() { proto.RegisterFile("tx.proto", fileDescriptor_0fd2153dc07d3b5c) }
+
+var fileDescriptor_0fd2153dc07d3b5c = []byte{
+	// 1240 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x41, 0x53, 0x1b, 0x37,
+	0x14, 0x8e, 0x9b, 0x4c, 0x93, 0x79, 0x64, 0x92, 0x76, 0x93, 0x06, 0x5b, 0x09, 0xb6, 0x31, 0x50,
+	0xec, 0x04, 0xec, 0x29, 0xe9, 0x3d, 0x63, 0x4c, 0x60, 0x28, 0x75, 0x87, 0x31, 0x43, 0xd3, 0xe9,
+	0xa1, 0x8c, 0xb0, 0x95, 0x65, 0xc7, 0x6b, 0x6b, 0x47, 0x92, 0x0d, 0xe9, 0xa1, 0xed, 0x4f, 0xe8,
+	0xdf, 0xe9, 0x3f, 0xe8, 0x31, 0xc7, 0x9e, 0x3a, 0x1d, 0xf8, 0x17, 0x3d, 0x75, 0x6c, 0xef, 0xca,
+	0x5a, 0xad, 0xe4, 0xdd, 0x72, 0x82, 0xd5, 0xf7, 0xe9, 0x7b, 0x4f, 0xd2, 0xd3, 0x7b, 0x4f, 0x86,
+	0x07, 0xe2, 0xaa, 0x1e, 0x30, 0x2a, 0xa8, 0xf3, 0xa0, 0xef, 0x31, 0x5c, 0x77, 0xe9, 0x18, 0x3d,
+	0x75, 0xa9, 0x4b, 0xa7, 0x83, 0x8d, 0xc9, 0x7f, 0x33, 0x1c, 0x2d, 0xe1, 0xae, 0xa0, 0x2c, 0xfc,
+	0x78, 0x82, 0x47, 0xe2, 0x82, 0x32, 0xef, 0x67, 0x2c, 0x3c, 0x3a, 0x0c, 0x07, 0x1f, 0x77, 0xe9,
+	0x68, 0xd8, 0xf5, 0x7c, 0xc9, 0x5a, 0x72, 0x19, 0x1d, 0x05, 0xe1, 0xc7, 0xa3, 0x80, 0xd1, 0x80,
+	0x72, 0xec, 0x87, 0xdf, 0xc0, 0xa8, 0x4f, 0x22, 0x39, 0x72, 0x45, 0xba, 0xa3, 0x89, 0xd4, 0xd9,
+	0x7b, 0x12, 0x0d, 0xe6, 0x87, 0x44, 0x5c, 0x52, 0xd6, 0x3f, 0x9b, 0x4c, 0x24, 0x4c, 0x78, 0x84,
+	0x87, 0xc8, 0x33, 0xec, 0xfb, 0xf4, 0x92, 0xf4, 0xce, 0x06, 0x84, 0x73, 0xec, 0x46, 0xe3, 0x95,
+	0x55, 0x28, 0xb5, 0xb9, 0xfb, 0xee, 0xc2, 0x13, 0xc4, 0xf7, 0xb8, 0x38, 0x26, 0x6c, 0xe0, 0x71,
+	0xee, 0xd1, 0x21, 0xef, 0x10, 0x1e, 0xd0, 0x21, 0x27, 0x21, 0x65, 0xd7, 0xc7, 0xdd, 0xbe, 0x8d,
+	0x52, 0x82, 0x95, 0x36, 0x77, 0xf7, 0x88, 0x4f, 0x5c, 0x2c, 0xc8, 0x9c, 0x21, 0x09, 0x2b, 0xf0,
+	0xbc, 0xcd, 0xdd, 0x0e, 0x19, 0xd3, 0xbe, 0x09, 0x7e, 0x0e, 0x85, 0x36, 0x77, 0x5b, 0x3e, 0xf6,
+	0x06, 0xad, 0x68, 0x43, 0x24, 0x58, 0x80, 0xe5, 0x36, 0x77, 0xbf, 0xa7, 0x82, 0x1c, 0x87, 0xdb,
+	0xa1, 0xcf, 0xc3, 0xc3, 0x2e, 0xf1, 0x13, 0xe0, 0x5b, 0x58, 0x6b, 0x73, 0x37, 0x1a, 0x6e, 0x72,
+	0xee, 0xb9, 0xc3, 0xa4, 0x6d, 0xa7, 0x08, 0x10, 0x6d, 0xf3, 0xe1, 0x5e, 0x3e, 0x57, 0xce, 0x55,
+	0xef, 0x75, 0x94, 0x91, 0xca, 0x01, 0x6c, 0x28, 0x32, 0xa7, 0x01, 0x27, 0x4c, 0xec, 0x61, 0x81,
+	0x3b, 0xc4, 0xf5, 0xb8, 0x60, 0x1f, 0x6e, 0x29, 0x74, 0x42, 0xc4, 0x77, 0xb3, 0xc3, 0x3a, 0x9e,
+	0x9d, 0x55, 0x76, 0xa1, 0x23, 0xa8, 0xc5, 0x85, 0x8e, 0x29, 0x65, 0xa1, 0x58, 0x3b, 0x3c, 0xde,
+	0xcc, 0x62, 0x6f, 0x60, 0x45, 0x11, 0x6b, 0x31, 0x82, 0x05, 0xe9, 0x50, 0x9f, 0x64, 0x16, 0xd8,
+	0x87, 0xf5, 0xb8, 0x37, 0x93, 0xd9, 0x93, 0xd3, 0x7a, 0x47, 0x3c, 0xf7, 0x42, 0x64, 0xd6, 0xd9,
+	0x83, 0x8a, 0xa2, 0x73, 0x8c, 0x19, 0x1e, 0x10, 0x41, 0x58, 0xeb, 0x02, 0x0f, 0xdd, 0xec, 0xde,
+	0x34, 0xa1, 0xa4, 0xa8, 0xb4, 0x47, 0xbe, 0xf0, 0x5a, 0x74, 0x28, 0xc8, 0xf0, 0xb6, 0x8e, 0x9c,
+	0xd0, 0xf7, 0xe2, 0x12, 0x33, 0x72, 0x1a, 0xb8, 0x0c, 0xf7, 0xb2, 0x3b, 0xf2, 0x0d, 0x54, 0xd5,
+	0x7d, 0x9d, 0x86, 0xe8, 0x6d, 0xb5, 0xe2, 0x1e, 0x75, 0xc8, 0x80, 0x8e, 0x49, 0xe2, 0x9e, 0xa4,
+	0xaa, 0x2c, 0xc3, 0x17, 0x93, 0xcb, 0x92, 0x38, 0xe1, 0x10, 0x98, 0x5d, 0x10, 0x03, 0x30, 0xb3,
+	0x17, 0x03, 0x66, 0x29, 0x21, 0x11, 0xc2, 0xde, 0x3c, 0xee, 0x2a, 0x2f, 0x00, 0xcd, 0x28, 0x6f,
+	0xa3, 0x3c, 0xb5, 0x4f, 0xe6, 0x02, 0xeb, 0x50, 0x51, 0xd3, 0xce, 0x44, 0xdc, 0x90, 0x16, 0x66,
+	0x2c, 0x99, 0x79, 0x2c, 0xac, 0x97, 0x50, 0x95, 0x5e, 0xa6, 0x29, 0xaa, 0xdc, 0x34, 0xdd, 0x59,
+	0x52, 0x3b, 0x60, 0x78, 0x28, 0x9a, 0x6a, 0xea, 0x96, 0x84, 0x32, 0x14, 0x65, 0x52, 0x33, 0x33,
+	0x5e, 0xc1, 0xe3, 0x36, 0x77, 0x27, 0x3b, 0x20, 0x4f, 0x29, 0x0f, 0xf7, 0x19, 0xe1, 0x23, 0x5f,
+	0xf0, 0x7c, 0xae, 0x7c, 0xb7, 0xfa, 0xb0, 0x13, 0x7d, 0x56, 0x7e, 0xcb, 0xc1, 0x33, 0x79, 0x40,
+	0x07, 0x93, 0x32, 0x20, 0x27, 0x15, 0xe0, 0xc1, 0xb4, 0x2e, 0x9c, 0x79, 0xbd, 0xf0, 0x60, 0xef,
+	0x4f, 0xbf, 0x0f, 0x7b, 0xce, 0x11, 0xdc, 0xc7, 0xbd, 0x1e, 0x23, 0x9c, 0xe7, 0x3f, 0x29, 0xe7,
+	0xaa, 0x0f, 0x77, 0xbf, 0xfa, 0xf7, 0xef, 0xd2, 0xb6, 0xeb, 0x89, 0x8b, 0xd1, 0x79, 0xbd, 0x4b,
+	0x07, 0x8d, 0x2e, 0xe5, 0x03, 0xca, 0xc3, 0x3f, 0xdb, 0xbc, 0xd7, 0x6f, 0x88, 0x0f, 0x01, 0xe1,
+	0xf5, 0x66, 0xb7, 0xdb, 0x9c, 0x4d, 0xec, 0x44, 0x0a, 0x95, 0xfc, 0xd4, 0x83, 0xd3, 0xa0, 0xa7,
+	0x7b, 0x50, 0x69, 0x4e, 0xd7, 0x7a, 0x32, 0x3a, 0x1f, 0x78, 0x62, 0x8a, 0xe8, 0xe9, 0xd6, 0x29,
+	0xc1, 0x52, 0x14, 0x6c, 0x73, 0x37, 0xe7, 0xf1, 0xd7, 0x0b, 0x83, 0xa6, 0x19, 0x04, 0x8c, 0x8e,
+	0x89, 0x51, 0x63, 0xe7, 0x0f, 0x04, 0x77, 0xdb, 0xdc, 0x75, 0x7a, 0xf0, 0xd4, 0x54, 0x92, 0x9c,
+	0xd5, 0x7a, 0x54, 0x71, 0xeb, 0x96, 0xaa, 0x85, 0x6a, 0xa9, 0x14, 0xe9, 0x71, 0x0f, 0x9e, 0x9a,
+	0xaa, 0x9a, 0x66, 0xc5, 0x44, 0x41, 0xb5, 0x54, 0x8a, 0xb4, 0xf2, 0x13, 0x38, 0xc9, 0xc2, 0xe8,
+	0x94, 0x62, 0x02, 0x49, 0x02, 0xda, 0x4c, 0x21, 0x48, 0xfd, 0x1f, 0xe0, 0x33, 0xbd, 0xae, 0x3a,
+	0x2b, 0xb1, 0xc9, 0x3a, 0x8c, 0x36, 0x16, 0xc2, 0x52, 0xb9, 0x03, 0x8f, 0xe2, 0x25, 0xd9, 0x79,
+	0x1e, 0x9b, 0x18, 0x07, 0xd1, 0xda, 0x02, 0x50, 0x6a, 0x7e, 0x0b, 0x0f, 0xd5, 0x4a, 0xee, 0x14,
+	0x62, 0x93, 0x54, 0x08, 0xad, 0x5a, 0xa1, 0x98, 0x87, 0xb1, 0xe2, 0xaf, 0x7b, 0x18, 0x03, 0xd1,
+	0xda, 0x02, 0x50, 0x6a, 0x06, 0x90, 0xb7, 0xf5, 0x0c, 0x4e, 0x7c, 0xe3, 0x6c, 0x34, 0xb4, 0x9d,
+	0x89, 0x26, 0x2d, 0x8e, 0x01, 0xd9, 0xdb, 0x0b, 0x67, 0xd3, 0x28, 0x96, 0x24, 0xa2, 0x46, 0x46,
+	0xa2, 0xc9, 0x6e, 0xb2, 0x1b, 0xb1, 0xd8, 0x4d, 0x12, 0x51, 0x23, 0x23, 0x51, 0xda, 0xfd, 0x05,
+	0x90, 0xa5, 0x79, 0xe1, 0x2e, 0x77, 0x5e, 0xd9, 0xe4, 0x0c, 0x5d, 0x0e, 0x7a, 0xfd, 0x3f, 0xc8,
+	0xea, 0x8d, 0x4c, 0xf6, 0x3b, 0xda, 0x8d, 0x4c, 0x12, 0xd0, 0x66, 0x0a, 0x41, 0xea, 0x73, 0x28,
+	0x58, 0xdb, 0x21, 0xe7, 0x4b, 0x9b, 0xc7, 0x71, 0x1e, 0xaa, 0x67, 0xe3, 0x49, 0xa3, 0x03, 0x58,
+	0xb6, 0xf4, 0x4e, 0xce, 0xba, 0x51, 0x4a, 0x63, 0xa1, 0xad, 0x2c, 0x2c, 0x35, 0x77, 0x9a, 0x9a,
+	0x2c, 0x2d, 0x77, 0x9a, 0x28, 0xa8, 0x96, 0x4a, 0x31, 0x2d, 0x4a, 0xeb, 0x9d, 0x2c, 0x8b, 0xd2,
+	0x58, 0x68, 0x2b, 0x0b, 0x4b, 0x9a, 0xfb, 0x15, 0x56, 0x16, 0x36, 0x6c, 0xce, 0x4b, 0x73, 0x08,
+	0x98, 0xb8, 0x68, 0x27, 0x3b, 0xd7, 0xb4, 0x5e, 0xad, 0xcb, 0xb3, 0xac, 0x57, 0x63, 0xa1, 0xad,
+	0x2c, 0x2c, 0x69, 0x6e, 0x1f, 0x40, 0xb9, 0x00, 0xcb, 0xf1, 0xec, 0x38, 0x0f, 0xfc, 0x92, 0x05,
+	0x50, 0x75, 0xe6, 0xdd, 0xa3, 0xa6, 0x33, 0x07, 0x50, 0xc9, 0x02, 0xa8, 0x3a, 0xf3, 0x66, 0x53,
+	0xd3, 0x99, 0x03, 0xa8, 0x64, 0x01, 0xd4, 0xe0, 0x34, 0xf5, 0xa6, 0x5a, 0x70, 0x9a, 0x28, 0xa8,
+	0x96, 0x4a, 0x91, 0x56, 0x4e, 0xe1, 0xb1, 0xd6, 0xde, 0x3a, 0x2f, 0xf4, 0xd9, 0x2a, 0x8a, 0xd6,
+	0x17, 0xa1, 0x6a, 0x0c, 0x58, 0xba, 0x58, 0x2d, 0x06, 0x2c, 0x2c, 0xb4, 0x95, 0x85, 0xa5, 0x9a,
+	0xb3, 0x34, 0xc2, 0x9a, 0x39, 0x0b, 0x0b, 0x6d, 0x65, 0x61, 0xa9, 0x57, 0x6c, 0x61, 0xa7, 0xae,
+	0x5d, 0xb1, 0x85, 0x5c, 0xb4, 0x93, 0x9d, 0x9b, 0x74, 0xc0, 0xb6, 0x6a, 0x93, 0x03, 0xb6, 0xb5,
+	0xef, 0x64, 0xe7, 0xaa, 0xd5, 0x27, 0xf9, 0xa6, 0xd0, 0xaa, 0x4f, 0x92, 0x80, 0x36, 0x53, 0x08,
+	0x52, 0x1f, 0xc3, 0x13, 0xc3, 0x93, 0xc4, 0x29, 0x1b, 0x7a, 0xbe, 0xb8, 0x85, 0x6a, 0x1a, 0x43,
+	0x9a, 0xf8, 0x1a, 0xee, 0x4d, 0x42, 0xd7, 0xf9, 0x3c, 0x36, 0x63, 0x32, 0x84, 0x0a, 0x89, 0x21,
+	0x39, 0xeb, 0x10, 0x96, 0x94, 0xb7, 0x8d, 0x93, 0x37, 0x64, 0x95, 0x29, 0x82, 0xca, 0x36, 0x44,
+	0x95, 0x52, 0x1e, 0x29, 0x9a, 0x94, 0x82, 0xa0, 0xb2, 0x0d, 0x51, 0xb7, 0xcb, 0xf0, 0xaa, 0xd1,
+	0xb6, 0xcb, 0xc0, 0x40, 0xd5, 0x34, 0x86, 0x9a, 0x8e, 0x4c, 0xaf, 0x1e, 0x2d, 0x1d, 0x99, 0x28,
+	0xa8, 0x96, 0x4a, 0x89, 0xac, 0xec, 0xbe, 0xf9, 0xf3, 0xba, 0x98, 0xfb, 0x78, 0x5d, 0xcc, 0xfd,
+	0x73, 0x5d, 0xcc, 0xfd, 0x7e, 0x53, 0xbc, 0xf3, 0xf1, 0xa6, 0x78, 0xe7, 0xaf, 0x9b, 0xe2, 0x9d,
+	0x1f, 0x37, 0x94, 0xd7, 0xe0, 0x91, 0xc7, 0x70, 0x8b, 0x32, 0xd2, 0xe0, 0xa4, 0x8f, 0xbd, 0xc6,
+	0x55, 0xc3, 0xa5, 0xe3, 0xd9, 0x83, 0xf0, 0xfc, 0xd3, 0xe9, 0x2f, 0x82, 0xaf, 0xff, 0x1b, 0x00,
+	0x8b, 0x9f, 0xb8, 0x4c, 0xe0, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
@@ -813,10 +1521,16 @@ type MsgClient interface {
 	WhitelistPermissions(ctx context.Context, in *MsgWhitelistPermissions, opts ...grpc.CallOption) (*MsgWhitelistPermissionsResponse, error)
 	// BlacklistPermissions defines a method for blacklisting a permission for an address.
 	BlacklistPermissions(ctx context.Context, in *MsgBlacklistPermissions, opts ...grpc.CallOption) (*MsgBlacklistPermissionsResponse, error)
+	// DelegatePermission defines a method for delegating a permission held by the delegator to another address.
+	DelegatePermission(ctx context.Context, in *MsgDelegatePermission, opts ...grpc.CallOption) (*MsgDelegatePermissionResponse, error)
+	// RevokePermission defines a method for revoking a permission delegated to another address.
+	RevokePermission(ctx context.Context, in *MsgRevokePermission, opts ...grpc.CallOption) (*MsgRevokePermissionResponse, error)
 	// ClaimCouncilor defines a method for claiming a councilor
 	ClaimCouncilor(ctx context.Context, in *MsgClaimCouncilor, opts ...grpc.CallOption) (*MsgClaimCouncilorResponse, error)
 	// VoteProposal defines a method for voting a proposal
 	VoteProposal(ctx context.Context, in *MsgVoteProposal, opts ...grpc.CallOption) (*MsgVoteProposalResponse, error)
+	// CancelProposal defines a method for cancelling a proposal
+	CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error)
 	// ProposalAssignPermission defines a method for assigning a permission proposal
 	ProposalAssignPermission(ctx context.Context, in *MsgProposalAssignPermission, opts ...grpc.CallOption) (*MsgProposalAssignPermissionResponse, error)
 	// ProposalUpsertDataRegistry defines a method for upserting data registry proposal
@@ -827,6 +1541,18 @@ type MsgClient interface {
 	ProposalSetPoorNetworkMsgs(ctx context.Context, in *MsgProposalSetPoorNetworkMessages, opts ...grpc.CallOption) (*MsgProposalSetPoorNetworkMessagesResponse, error)
 	// ProposalCreateRole defines a method for creating a role proposal
 	ProposalCreateRole(ctx context.Context, in *MsgProposalCreateRole, opts ...grpc.CallOption) (*MsgProposalCreateRoleResponse, error)
+	// ProposalSetRoleVoteWeight defines a method for setting the vote weight of a role proposal
+	ProposalSetRoleVoteWeight(ctx context.Context, in *MsgProposalSetRoleVoteWeight, opts ...grpc.CallOption) (*MsgProposalSetRoleVoteWeightResponse, error)
+	// ProposalParameterChange defines a method for changing the params of module param subspaces proposal
+	ProposalParameterChange(ctx context.Context, in *MsgProposalParameterChange, opts ...grpc.CallOption) (*MsgProposalParameterChangeResponse, error)
+	// ProposalMultiContent defines a method for batching several contents in a single proposal
+	ProposalMultiContent(ctx context.Context, in *MsgProposalMultiContent, opts ...grpc.CallOption) (*MsgProposalMultiContentResponse, error)
+	// ProposalSoftwareUpgrade defines a method for scheduling a software upgrade proposal
+	ProposalSoftwareUpgrade(ctx context.Context, in *MsgProposalSoftwareUpgrade, opts ...grpc.CallOption) (*MsgProposalSoftwareUpgradeResponse, error)
+	// ProposalCancelSoftwareUpgrade defines a method for cancelling the scheduled software upgrade proposal
+	ProposalCancelSoftwareUpgrade(ctx context.Context, in *MsgProposalCancelSoftwareUpgrade, opts ...grpc.CallOption) (*MsgProposalCancelSoftwareUpgradeResponse, error)
+	// ProposalRemoveCouncilor defines a method for removing a councilor proposal
+	ProposalRemoveCouncilor(ctx context.Context, in *MsgProposalRemoveCouncilor, opts ...grpc.CallOption) (*MsgProposalRemoveCouncilorResponse, error)
 	// CreateRole defines a method for creating a role
 	CreateRole(ctx context.Context, in *MsgCreateRole, opts ...grpc.CallOption) (*MsgCreateRoleResponse, error)
 	// AssignRole defines a method for assigning a role to an address
@@ -845,6 +1571,20 @@ type MsgClient interface {
 	RemoveWhitelistRolePermission(ctx context.Context, in *MsgRemoveWhitelistRolePermission, opts ...grpc.CallOption) (*MsgRemoveWhitelistRolePermissionResponse, error)
 	// RemoveBlacklistRolePermission defines a method for removing blacklisted permission from a role
 	RemoveBlacklistRolePermission(ctx context.Context, in *MsgRemoveBlacklistRolePermission, opts ...grpc.CallOption) (*MsgRemoveBlacklistRolePermissionResponse, error)
+	// GrantAuthorization defines a method for authorizing a grantee to execute messages on behalf of the granter
+	GrantAuthorization(ctx context.Context, in *MsgGrantAuthorization, opts ...grpc.CallOption) (*MsgGrantAuthorizationResponse, error)
+	// RevokeAuthorization defines a method for revoking an authorization given to a grantee
+	RevokeAuthorization(ctx context.Context, in *MsgRevokeAuthorization, opts ...grpc.CallOption) (*MsgRevokeAuthorizationResponse, error)
+	// Exec defines a method for executing messages on behalf of their granters
+	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
+	// CreateGroup defines a method for creating a group account governed by its members
+	CreateGroup(ctx context.Context, in *MsgCreateGroup, opts ...grpc.CallOption) (*MsgCreateGroupResponse, error)
+	// UpdateGroup defines a method for changing the members and the threshold of a group
+	UpdateGroup(ctx context.Context, in *MsgUpdateGroup, opts ...grpc.CallOption) (*MsgUpdateGroupResponse, error)
+	// SubmitGroupProposal defines a method for proposing messages to be executed by a group
+	SubmitGroupProposal(ctx context.Context, in *MsgSubmitGroupProposal, opts ...grpc.CallOption) (*MsgSubmitGroupProposalResponse, error)
+	// ApproveGroupProposal defines a method for approving a group proposal as a member
+	ApproveGroupProposal(ctx context.Context, in *MsgApproveGroupProposal, opts ...grpc.CallOption) (*MsgApproveGroupProposalResponse, error)
 }
 
 type msgClient struct {
@@ -873,6 +1613,24 @@ func (c *msgClient) BlacklistPermissions(ctx context.Context, in *MsgBlacklistPe
 	return out, nil
 }
 
+func (c *msgClient) DelegatePermission(ctx context.Context, in *MsgDelegatePermission, opts ...grpc.CallOption) (*MsgDelegatePermissionResponse, error) {
+	out := new(MsgDelegatePermissionResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Msg/DelegatePermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokePermission(ctx context.Context, in *MsgRevokePermission, opts ...grpc.CallOption) (*MsgRevokePermissionResponse, error) {
+	out := new(MsgRevokePermissionResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Msg/RevokePermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) ClaimCouncilor(ctx context.Context, in *MsgClaimCouncilor, opts ...grpc.CallOption) (*MsgClaimCouncilorResponse, error) {
 	out := new(MsgClaimCouncilorResponse)
 	err := c.cc.Invoke(ctx, "/kira.gov.Msg/ClaimCouncilor", in, out, opts...)
@@ -891,6 +1649,15 @@ func (c *msgClient) VoteProposal(ctx context.Context, in *MsgVoteProposal, opts
 	return out, nil
 }
 
+func (c *msgClient) CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error) {
+	out := new(MsgCancelProposalResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Msg/CancelProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) ProposalAssignPermission(ctx context.Context, in *MsgProposalAssignPermission, opts ...grpc.CallOption) (*MsgProposalAssignPermissionResponse, error) {
 	out := new(MsgProposalAssignPermissionResponse)
 	err := c.cc.Invoke(ctx, "/kira.gov.Msg/ProposalAssignPermission", in, out, opts...)
@@ -936,6 +1703,60 @@ func (c *msgClient) ProposalCreateRole(ctx context.Context, in *MsgProposalCreat
 	return out, nil
 }
 
+func (c *msgClient) ProposalSetRoleVoteWeight(ctx context.Context, in *MsgProposalSetRoleVoteWeight, opts ...grpc.CallOption) (*MsgProposalSetRoleVoteWeightResponse, error) {
+	out := new(MsgProposalSetRoleVoteWeightResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Msg/ProposalSetRoleVoteWeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposalParameterChange(ctx context.Context, in *MsgProposalParameterChange, opts ...grpc.CallOption) (*MsgProposalParameterChangeResponse, error) {
+	out := new(MsgProposalParameterChangeResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Msg/ProposalParameterChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposalMultiContent(ctx context.Context, in *MsgProposalMultiContent, opts ...grpc.CallOption) (*MsgProposalMultiContentResponse, error) {
+	out := new(MsgProposalMultiContentResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Msg/ProposalMultiContent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposalSoftwareUpgrade(ctx context.Context, in *MsgProposalSoftwareUpgrade, opts ...grpc.CallOption) (*MsgProposalSoftwareUpgradeResponse, error) {
+	out := new(MsgProposalSoftwareUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Msg/ProposalSoftwareUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposalCancelSoftwareUpgrade(ctx context.Context, in *MsgProposalCancelSoftwareUpgrade, opts ...grpc.CallOption) (*MsgProposalCancelSoftwareUpgradeResponse, error) {
+	out := new(MsgProposalCancelSoftwareUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Msg/ProposalCancelSoftwareUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposalRemoveCouncilor(ctx context.Context, in *MsgProposalRemoveCouncilor, opts ...grpc.CallOption) (*MsgProposalRemoveCouncilorResponse, error) {
+	out := new(MsgProposalRemoveCouncilorResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Msg/ProposalRemoveCouncilor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CreateRole(ctx context.Context, in *MsgCreateRole, opts ...grpc.CallOption) (*MsgCreateRoleResponse, error) {
 	out := new(MsgCreateRoleResponse)
 	err := c.cc.Invoke(ctx, "/kira.gov.Msg/CreateRole", in, out, opts...)
@@ -1017,29 +1838,110 @@ func (c *msgClient) RemoveBlacklistRolePermission(ctx context.Context, in *MsgRe
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// WhitelistPermissions defines a method for whitelisting a permission for an address.
-	WhitelistPermissions(context.Context, *MsgWhitelistPermissions) (*MsgWhitelistPermissionsResponse, error)
-	// BlacklistPermissions defines a method for blacklisting a permission for an address.
-	BlacklistPermissions(context.Context, *MsgBlacklistPermissions) (*MsgBlacklistPermissionsResponse, error)
-	// ClaimCouncilor defines a method for claiming a councilor
-	ClaimCouncilor(context.Context, *MsgClaimCouncilor) (*MsgClaimCouncilorResponse, error)
-	// VoteProposal defines a method for voting a proposal
-	VoteProposal(context.Context, *MsgVoteProposal) (*MsgVoteProposalResponse, error)
-	// ProposalAssignPermission defines a method for assigning a permission proposal
-	ProposalAssignPermission(context.Context, *MsgProposalAssignPermission) (*MsgProposalAssignPermissionResponse, error)
-	// ProposalUpsertDataRegistry defines a method for upserting data registry proposal
-	ProposalUpsertDataRegistry(context.Context, *MsgProposalUpsertDataRegistry) (*MsgProposalUpsertDataRegistryResponse, error)
-	// ProposalSetNetworkProperty defines a method for setting a network property proposal
-	ProposalSetNetworkProperty(context.Context, *MsgProposalSetNetworkProperty) (*MsgProposalSetNetworkPropertyResponse, error)
-	// ProposalSetPoorNetworkMsgs defines a method for setting poor network allowed messages
-	ProposalSetPoorNetworkMsgs(context.Context, *MsgProposalSetPoorNetworkMessages) (*MsgProposalSetPoorNetworkMessagesResponse, error)
-	// ProposalCreateRole defines a method for creating a role proposal
-	ProposalCreateRole(context.Context, *MsgProposalCreateRole) (*MsgProposalCreateRoleResponse, error)
-	// CreateRole defines a method for creating a role
-	CreateRole(context.Context, *MsgCreateRole) (*MsgCreateRoleResponse, error)
-	// AssignRole defines a method for assigning a role to an address
+func (c *msgClient) GrantAuthorization(ctx context.Context, in *MsgGrantAuthorization, opts ...grpc.CallOption) (*MsgGrantAuthorizationResponse, error) {
+	out := new(MsgGrantAuthorizationResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Msg/GrantAuthorization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeAuthorization(ctx context.Context, in *MsgRevokeAuthorization, opts ...grpc.CallOption) (*MsgRevokeAuthorizationResponse, error) {
+	out := new(MsgRevokeAuthorizationResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Msg/RevokeAuthorization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error) {
+	out := new(MsgExecResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Msg/Exec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateGroup(ctx context.Context, in *MsgCreateGroup, opts ...grpc.CallOption) (*MsgCreateGroupResponse, error) {
+	out := new(MsgCreateGroupResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Msg/CreateGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateGroup(ctx context.Context, in *MsgUpdateGroup, opts ...grpc.CallOption) (*MsgUpdateGroupResponse, error) {
+	out := new(MsgUpdateGroupResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Msg/UpdateGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitGroupProposal(ctx context.Context, in *MsgSubmitGroupProposal, opts ...grpc.CallOption) (*MsgSubmitGroupProposalResponse, error) {
+	out := new(MsgSubmitGroupProposalResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Msg/SubmitGroupProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveGroupProposal(ctx context.Context, in *MsgApproveGroupProposal, opts ...grpc.CallOption) (*MsgApproveGroupProposalResponse, error) {
+	out := new(MsgApproveGroupProposalResponse)
+	err := c.cc.Invoke(ctx, "/kira.gov.Msg/ApproveGroupProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// WhitelistPermissions defines a method for whitelisting a permission for an address.
+	WhitelistPermissions(context.Context, *MsgWhitelistPermissions) (*MsgWhitelistPermissionsResponse, error)
+	// BlacklistPermissions defines a method for blacklisting a permission for an address.
+	BlacklistPermissions(context.Context, *MsgBlacklistPermissions) (*MsgBlacklistPermissionsResponse, error)
+	// DelegatePermission defines a method for delegating a permission held by the delegator to another address.
+	DelegatePermission(context.Context, *MsgDelegatePermission) (*MsgDelegatePermissionResponse, error)
+	// RevokePermission defines a method for revoking a permission delegated to another address.
+	RevokePermission(context.Context, *MsgRevokePermission) (*MsgRevokePermissionResponse, error)
+	// ClaimCouncilor defines a method for claiming a councilor
+	ClaimCouncilor(context.Context, *MsgClaimCouncilor) (*MsgClaimCouncilorResponse, error)
+	// VoteProposal defines a method for voting a proposal
+	VoteProposal(context.Context, *MsgVoteProposal) (*MsgVoteProposalResponse, error)
+	// CancelProposal defines a method for cancelling a proposal
+	CancelProposal(context.Context, *MsgCancelProposal) (*MsgCancelProposalResponse, error)
+	// ProposalAssignPermission defines a method for assigning a permission proposal
+	ProposalAssignPermission(context.Context, *MsgProposalAssignPermission) (*MsgProposalAssignPermissionResponse, error)
+	// ProposalUpsertDataRegistry defines a method for upserting data registry proposal
+	ProposalUpsertDataRegistry(context.Context, *MsgProposalUpsertDataRegistry) (*MsgProposalUpsertDataRegistryResponse, error)
+	// ProposalSetNetworkProperty defines a method for setting a network property proposal
+	ProposalSetNetworkProperty(context.Context, *MsgProposalSetNetworkProperty) (*MsgProposalSetNetworkPropertyResponse, error)
+	// ProposalSetPoorNetworkMsgs defines a method for setting poor network allowed messages
+	ProposalSetPoorNetworkMsgs(context.Context, *MsgProposalSetPoorNetworkMessages) (*MsgProposalSetPoorNetworkMessagesResponse, error)
+	// ProposalCreateRole defines a method for creating a role proposal
+	ProposalCreateRole(context.Context, *MsgProposalCreateRole) (*MsgProposalCreateRoleResponse, error)
+	// ProposalSetRoleVoteWeight defines a method for setting the vote weight of a role proposal
+	ProposalSetRoleVoteWeight(context.Context, *MsgProposalSetRoleVoteWeight) (*MsgProposalSetRoleVoteWeightResponse, error)
+	// ProposalParameterChange defines a method for changing the params of module param subspaces proposal
+	ProposalParameterChange(context.Context, *MsgProposalParameterChange) (*MsgProposalParameterChangeResponse, error)
+	// ProposalMultiContent defines a method for batching several contents in a single proposal
+	ProposalMultiContent(context.Context, *MsgProposalMultiContent) (*MsgProposalMultiContentResponse, error)
+	// ProposalSoftwareUpgrade defines a method for scheduling a software upgrade proposal
+	ProposalSoftwareUpgrade(context.Context, *MsgProposalSoftwareUpgrade) (*MsgProposalSoftwareUpgradeResponse, error)
+	// ProposalCancelSoftwareUpgrade defines a method for cancelling the scheduled software upgrade proposal
+	ProposalCancelSoftwareUpgrade(context.Context, *MsgProposalCancelSoftwareUpgrade) (*MsgProposalCancelSoftwareUpgradeResponse, error)
+	// ProposalRemoveCouncilor defines a method for removing a councilor proposal
+	ProposalRemoveCouncilor(context.Context, *MsgProposalRemoveCouncilor) (*MsgProposalRemoveCouncilorResponse, error)
+	// CreateRole defines a method for creating a role
+	CreateRole(context.Context, *MsgCreateRole) (*MsgCreateRoleResponse, error)
+	// AssignRole defines a method for assigning a role to an address
 	AssignRole(context.Context, *MsgAssignRole) (*MsgAssignRoleResponse, error)
 	// RemoveRole defines a method for removing a role from an address
 	RemoveRole(context.Context, *MsgRemoveRole) (*MsgRemoveRoleResponse, error)
@@ -1055,6 +1957,20 @@ type MsgServer interface {
 	RemoveWhitelistRolePermission(context.Context, *MsgRemoveWhitelistRolePermission) (*MsgRemoveWhitelistRolePermissionResponse, error)
 	// RemoveBlacklistRolePermission defines a method for removing blacklisted permission from a role
 	RemoveBlacklistRolePermission(context.Context, *MsgRemoveBlacklistRolePermission) (*MsgRemoveBlacklistRolePermissionResponse, error)
+	// GrantAuthorization defines a method for authorizing a grantee to execute messages on behalf of the granter
+	GrantAuthorization(context.Context, *MsgGrantAuthorization) (*MsgGrantAuthorizationResponse, error)
+	// RevokeAuthorization defines a method for revoking an authorization given to a grantee
+	RevokeAuthorization(context.Context, *MsgRevokeAuthorization) (*MsgRevokeAuthorizationResponse, error)
+	// Exec defines a method for executing messages on behalf of their granters
+	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
+	// CreateGroup defines a method for creating a group account governed by its members
+	CreateGroup(context.Context, *MsgCreateGroup) (*MsgCreateGroupResponse, error)
+	// UpdateGroup defines a method for changing the members and the threshold of a group
+	UpdateGroup(context.Context, *MsgUpdateGroup) (*MsgUpdateGroupResponse, error)
+	// SubmitGroupProposal defines a method for proposing messages to be executed by a group
+	SubmitGroupProposal(context.Context, *MsgSubmitGroupProposal) (*MsgSubmitGroupProposalResponse, error)
+	// ApproveGroupProposal defines a method for approving a group proposal as a member
+	ApproveGroupProposal(context.Context, *MsgApproveGroupProposal) (*MsgApproveGroupProposalResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1067,12 +1983,21 @@ func (*UnimplementedMsgServer) WhitelistPermissions(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) BlacklistPermissions(ctx context.Context, req *MsgBlacklistPermissions) (*MsgBlacklistPermissionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlacklistPermissions not implemented")
 }
+func (*UnimplementedMsgServer) DelegatePermission(ctx context.Context, req *MsgDelegatePermission) (*MsgDelegatePermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatePermission not implemented")
+}
+func (*UnimplementedMsgServer) RevokePermission(ctx context.Context, req *MsgRevokePermission) (*MsgRevokePermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePermission not implemented")
+}
 func (*UnimplementedMsgServer) ClaimCouncilor(ctx context.Context, req *MsgClaimCouncilor) (*MsgClaimCouncilorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimCouncilor not implemented")
 }
 func (*UnimplementedMsgServer) VoteProposal(ctx context.Context, req *MsgVoteProposal) (*MsgVoteProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VoteProposal not implemented")
 }
+func (*UnimplementedMsgServer) CancelProposal(ctx context.Context, req *MsgCancelProposal) (*MsgCancelProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelProposal not implemented")
+}
 func (*UnimplementedMsgServer) ProposalAssignPermission(ctx context.Context, req *MsgProposalAssignPermission) (*MsgProposalAssignPermissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProposalAssignPermission not implemented")
 }
@@ -1088,6 +2013,24 @@ func (*UnimplementedMsgServer) ProposalSetPoorNetworkMsgs(ctx context.Context, r
 func (*UnimplementedMsgServer) ProposalCreateRole(ctx context.Context, req *MsgProposalCreateRole) (*MsgProposalCreateRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProposalCreateRole not implemented")
 }
+func (*UnimplementedMsgServer) ProposalSetRoleVoteWeight(ctx context.Context, req *MsgProposalSetRoleVoteWeight) (*MsgProposalSetRoleVoteWeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalSetRoleVoteWeight not implemented")
+}
+func (*UnimplementedMsgServer) ProposalParameterChange(ctx context.Context, req *MsgProposalParameterChange) (*MsgProposalParameterChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalParameterChange not implemented")
+}
+func (*UnimplementedMsgServer) ProposalMultiContent(ctx context.Context, req *MsgProposalMultiContent) (*MsgProposalMultiContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalMultiContent not implemented")
+}
+func (*UnimplementedMsgServer) ProposalSoftwareUpgrade(ctx context.Context, req *MsgProposalSoftwareUpgrade) (*MsgProposalSoftwareUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalSoftwareUpgrade not implemented")
+}
+func (*UnimplementedMsgServer) ProposalCancelSoftwareUpgrade(ctx context.Context, req *MsgProposalCancelSoftwareUpgrade) (*MsgProposalCancelSoftwareUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalCancelSoftwareUpgrade not implemented")
+}
+func (*UnimplementedMsgServer) ProposalRemoveCouncilor(ctx context.Context, req *MsgProposalRemoveCouncilor) (*MsgProposalRemoveCouncilorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalRemoveCouncilor not implemented")
+}
 func (*UnimplementedMsgServer) CreateRole(ctx context.Context, req *MsgCreateRole) (*MsgCreateRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
 }
@@ -1115,6 +2058,27 @@ func (*UnimplementedMsgServer) RemoveWhitelistRolePermission(ctx context.Context
 func (*UnimplementedMsgServer) RemoveBlacklistRolePermission(ctx context.Context, req *MsgRemoveBlacklistRolePermission) (*MsgRemoveBlacklistRolePermissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveBlacklistRolePermission not implemented")
 }
+func (*UnimplementedMsgServer) GrantAuthorization(ctx context.Context, req *MsgGrantAuthorization) (*MsgGrantAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantAuthorization not implemented")
+}
+func (*UnimplementedMsgServer) RevokeAuthorization(ctx context.Context, req *MsgRevokeAuthorization) (*MsgRevokeAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAuthorization not implemented")
+}
+func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
+}
+func (*UnimplementedMsgServer) CreateGroup(ctx context.Context, req *MsgCreateGroup) (*MsgCreateGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (*UnimplementedMsgServer) UpdateGroup(ctx context.Context, req *MsgUpdateGroup) (*MsgUpdateGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroup not implemented")
+}
+func (*UnimplementedMsgServer) SubmitGroupProposal(ctx context.Context, req *MsgSubmitGroupProposal) (*MsgSubmitGroupProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitGroupProposal not implemented")
+}
+func (*UnimplementedMsgServer) ApproveGroupProposal(ctx context.Context, req *MsgApproveGroupProposal) (*MsgApproveGroupProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveGroupProposal not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1156,6 +2120,42 @@ func _Msg_BlacklistPermissions_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegatePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegatePermission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegatePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Msg/DelegatePermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegatePermission(ctx, req.(*MsgDelegatePermission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokePermission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Msg/RevokePermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokePermission(ctx, req.(*MsgRevokePermission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_ClaimCouncilor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgClaimCouncilor)
 	if err := dec(in); err != nil {
@@ -1192,6 +2192,24 @@ func _Msg_VoteProposal_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Msg/CancelProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelProposal(ctx, req.(*MsgCancelProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_ProposalAssignPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgProposalAssignPermission)
 	if err := dec(in); err != nil {
@@ -1282,6 +2300,114 @@ func _Msg_ProposalCreateRole_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ProposalSetRoleVoteWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposalSetRoleVoteWeight)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposalSetRoleVoteWeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Msg/ProposalSetRoleVoteWeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposalSetRoleVoteWeight(ctx, req.(*MsgProposalSetRoleVoteWeight))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposalParameterChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposalParameterChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposalParameterChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Msg/ProposalParameterChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposalParameterChange(ctx, req.(*MsgProposalParameterChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposalMultiContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposalMultiContent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposalMultiContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Msg/ProposalMultiContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposalMultiContent(ctx, req.(*MsgProposalMultiContent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposalSoftwareUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposalSoftwareUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposalSoftwareUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Msg/ProposalSoftwareUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposalSoftwareUpgrade(ctx, req.(*MsgProposalSoftwareUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposalCancelSoftwareUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposalCancelSoftwareUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposalCancelSoftwareUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Msg/ProposalCancelSoftwareUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposalCancelSoftwareUpgrade(ctx, req.(*MsgProposalCancelSoftwareUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposalRemoveCouncilor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposalRemoveCouncilor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposalRemoveCouncilor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Msg/ProposalRemoveCouncilor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposalRemoveCouncilor(ctx, req.(*MsgProposalRemoveCouncilor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreateRole)
 	if err := dec(in); err != nil {
@@ -1444,64 +2570,226 @@ func _Msg_RemoveBlacklistRolePermission_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kira.gov.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "WhitelistPermissions",
-			Handler:    _Msg_WhitelistPermissions_Handler,
-		},
-		{
-			MethodName: "BlacklistPermissions",
-			Handler:    _Msg_BlacklistPermissions_Handler,
-		},
-		{
-			MethodName: "ClaimCouncilor",
-			Handler:    _Msg_ClaimCouncilor_Handler,
-		},
-		{
-			MethodName: "VoteProposal",
-			Handler:    _Msg_VoteProposal_Handler,
-		},
-		{
-			MethodName: "ProposalAssignPermission",
-			Handler:    _Msg_ProposalAssignPermission_Handler,
-		},
-		{
-			MethodName: "ProposalUpsertDataRegistry",
-			Handler:    _Msg_ProposalUpsertDataRegistry_Handler,
-		},
-		{
-			MethodName: "ProposalSetNetworkProperty",
-			Handler:    _Msg_ProposalSetNetworkProperty_Handler,
-		},
-		{
-			MethodName: "ProposalSetPoorNetworkMsgs",
-			Handler:    _Msg_ProposalSetPoorNetworkMsgs_Handler,
-		},
-		{
-			MethodName: "ProposalCreateRole",
-			Handler:    _Msg_ProposalCreateRole_Handler,
-		},
-		{
-			MethodName: "CreateRole",
-			Handler:    _Msg_CreateRole_Handler,
-		},
-		{
-			MethodName: "AssignRole",
-			Handler:    _Msg_AssignRole_Handler,
-		},
-		{
-			MethodName: "RemoveRole",
-			Handler:    _Msg_RemoveRole_Handler,
-		},
-		{
-			MethodName: "SetNetworkProperties",
-			Handler:    _Msg_SetNetworkProperties_Handler,
-		},
-		{
-			MethodName: "SetExecutionFee",
+func _Msg_GrantAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantAuthorization)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Msg/GrantAuthorization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantAuthorization(ctx, req.(*MsgGrantAuthorization))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAuthorization)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Msg/RevokeAuthorization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAuthorization(ctx, req.(*MsgRevokeAuthorization))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Exec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Msg/Exec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Exec(ctx, req.(*MsgExec))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Msg/CreateGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateGroup(ctx, req.(*MsgCreateGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Msg/UpdateGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroup(ctx, req.(*MsgUpdateGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitGroupProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitGroupProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitGroupProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Msg/SubmitGroupProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitGroupProposal(ctx, req.(*MsgSubmitGroupProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveGroupProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveGroupProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveGroupProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.gov.Msg/ApproveGroupProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveGroupProposal(ctx, req.(*MsgApproveGroupProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kira.gov.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WhitelistPermissions",
+			Handler:    _Msg_WhitelistPermissions_Handler,
+		},
+		{
+			MethodName: "BlacklistPermissions",
+			Handler:    _Msg_BlacklistPermissions_Handler,
+		},
+		{
+			MethodName: "DelegatePermission",
+			Handler:    _Msg_DelegatePermission_Handler,
+		},
+		{
+			MethodName: "RevokePermission",
+			Handler:    _Msg_RevokePermission_Handler,
+		},
+		{
+			MethodName: "ClaimCouncilor",
+			Handler:    _Msg_ClaimCouncilor_Handler,
+		},
+		{
+			MethodName: "VoteProposal",
+			Handler:    _Msg_VoteProposal_Handler,
+		},
+		{
+			MethodName: "CancelProposal",
+			Handler:    _Msg_CancelProposal_Handler,
+		},
+		{
+			MethodName: "ProposalAssignPermission",
+			Handler:    _Msg_ProposalAssignPermission_Handler,
+		},
+		{
+			MethodName: "ProposalUpsertDataRegistry",
+			Handler:    _Msg_ProposalUpsertDataRegistry_Handler,
+		},
+		{
+			MethodName: "ProposalSetNetworkProperty",
+			Handler:    _Msg_ProposalSetNetworkProperty_Handler,
+		},
+		{
+			MethodName: "ProposalSetPoorNetworkMsgs",
+			Handler:    _Msg_ProposalSetPoorNetworkMsgs_Handler,
+		},
+		{
+			MethodName: "ProposalCreateRole",
+			Handler:    _Msg_ProposalCreateRole_Handler,
+		},
+		{
+			MethodName: "ProposalSetRoleVoteWeight",
+			Handler:    _Msg_ProposalSetRoleVoteWeight_Handler,
+		},
+		{
+			MethodName: "ProposalParameterChange",
+			Handler:    _Msg_ProposalParameterChange_Handler,
+		},
+		{
+			MethodName: "ProposalMultiContent",
+			Handler:    _Msg_ProposalMultiContent_Handler,
+		},
+		{
+			MethodName: "ProposalSoftwareUpgrade",
+			Handler:    _Msg_ProposalSoftwareUpgrade_Handler,
+		},
+		{
+			MethodName: "ProposalCancelSoftwareUpgrade",
+			Handler:    _Msg_ProposalCancelSoftwareUpgrade_Handler,
+		},
+		{
+			MethodName: "ProposalRemoveCouncilor",
+			Handler:    _Msg_ProposalRemoveCouncilor_Handler,
+		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _Msg_CreateRole_Handler,
+		},
+		{
+			MethodName: "AssignRole",
+			Handler:    _Msg_AssignRole_Handler,
+		},
+		{
+			MethodName: "RemoveRole",
+			Handler:    _Msg_RemoveRole_Handler,
+		},
+		{
+			MethodName: "SetNetworkProperties",
+			Handler:    _Msg_SetNetworkProperties_Handler,
+		},
+		{
+			MethodName: "SetExecutionFee",
 			Handler:    _Msg_SetExecutionFee_Handler,
 		},
 		{
@@ -1520,6 +2808,34 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveBlacklistRolePermission",
 			Handler:    _Msg_RemoveBlacklistRolePermission_Handler,
 		},
+		{
+			MethodName: "GrantAuthorization",
+			Handler:    _Msg_GrantAuthorization_Handler,
+		},
+		{
+			MethodName: "RevokeAuthorization",
+			Handler:    _Msg_RevokeAuthorization_Handler,
+		},
+		{
+			MethodName: "Exec",
+			Handler:    _Msg_Exec_Handler,
+		},
+		{
+			MethodName: "CreateGroup",
+			Handler:    _Msg_CreateGroup_Handler,
+		},
+		{
+			MethodName: "UpdateGroup",
+			Handler:    _Msg_UpdateGroup_Handler,
+		},
+		{
+			MethodName: "SubmitGroupProposal",
+			Handler:    _Msg_SubmitGroupProposal_Handler,
+		},
+		{
+			MethodName: "ApproveGroupProposal",
+			Handler:    _Msg_ApproveGroupProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "tx.proto",
@@ -1571,6 +2887,52 @@ func (m *MsgBlacklistPermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgDelegatePermissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegatePermissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegatePermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokePermissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokePermissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokePermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgClaimCouncilorResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1617,6 +2979,29 @@ func (m *MsgVoteProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgProposalAssignPermissionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1757,7 +3142,7 @@ func (m *MsgProposalCreateRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateRoleResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgProposalSetRoleVoteWeightResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1767,20 +3152,25 @@ func (m *MsgCreateRoleResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgProposalSetRoleVoteWeightResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgProposalSetRoleVoteWeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.ProposalID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAssignRoleResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgProposalParameterChangeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1790,20 +3180,183 @@ func (m *MsgAssignRoleResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAssignRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgProposalParameterChangeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAssignRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgProposalParameterChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRemoveRoleResponse) Marshal() (dAtA []byte, err error) {
+	if m.ProposalID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposalMultiContentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposalMultiContentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposalMultiContentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposalSoftwareUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposalSoftwareUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposalSoftwareUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposalCancelSoftwareUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposalCancelSoftwareUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposalCancelSoftwareUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposalRemoveCouncilorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposalRemoveCouncilorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposalRemoveCouncilorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAssignRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAssignRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAssignRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRoleResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1964,97 +3517,311 @@ func (m *MsgRemoveBlacklistRolePermissionResponse) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgGrantAuthorizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgWhitelistPermissionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *MsgGrantAuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBlacklistPermissionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgGrantAuthorizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgClaimCouncilorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRevokeAuthorizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgVoteProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgRevokeAuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgProposalAssignPermissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRevokeAuthorizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ProposalID != 0 {
-		n += 1 + sovTx(uint64(m.ProposalID))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgProposalUpsertDataRegistryResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProposalID != 0 {
-		n += 1 + sovTx(uint64(m.ProposalID))
+func (m *MsgExecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgProposalSetNetworkPropertyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProposalID != 0 {
-		n += 1 + sovTx(uint64(m.ProposalID))
-	}
-	return n
+func (m *MsgExecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgProposalSetPoorNetworkMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ProposalID != 0 {
-		n += 1 + sovTx(uint64(m.ProposalID))
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Results[iNdEx])
+			copy(dAtA[i:], m.Results[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Results[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitGroupProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitGroupProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitGroupProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveGroupProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveGroupProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveGroupProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgWhitelistPermissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBlacklistPermissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegatePermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokePermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimCouncilorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVoteProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProposalAssignPermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovTx(uint64(m.ProposalID))
+	}
+	return n
+}
+
+func (m *MsgProposalUpsertDataRegistryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovTx(uint64(m.ProposalID))
+	}
+	return n
+}
+
+func (m *MsgProposalSetNetworkPropertyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovTx(uint64(m.ProposalID))
+	}
+	return n
+}
+
+func (m *MsgProposalSetPoorNetworkMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovTx(uint64(m.ProposalID))
 	}
 	return n
 }
@@ -2071,94 +3838,1264 @@ func (m *MsgProposalCreateRoleResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgCreateRoleResponse) Size() (n int) {
+func (m *MsgProposalSetRoleVoteWeightResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovTx(uint64(m.ProposalID))
+	}
 	return n
 }
 
-func (m *MsgAssignRoleResponse) Size() (n int) {
+func (m *MsgProposalParameterChangeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovTx(uint64(m.ProposalID))
+	}
 	return n
 }
 
-func (m *MsgRemoveRoleResponse) Size() (n int) {
+func (m *MsgProposalMultiContentResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovTx(uint64(m.ProposalID))
+	}
 	return n
 }
 
-func (m *MsgSetNetworkPropertiesResponse) Size() (n int) {
+func (m *MsgProposalSoftwareUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovTx(uint64(m.ProposalID))
+	}
+	return n
+}
+
+func (m *MsgProposalCancelSoftwareUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovTx(uint64(m.ProposalID))
+	}
+	return n
+}
+
+func (m *MsgProposalRemoveCouncilorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovTx(uint64(m.ProposalID))
+	}
+	return n
+}
+
+func (m *MsgCreateRoleResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAssignRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetNetworkPropertiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetExecutionFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWhitelistRolePermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBlacklistRolePermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveWhitelistRolePermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveBlacklistRolePermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantAuthorizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeAuthorizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, b := range m.Results {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitGroupProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgApproveGroupProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgWhitelistPermissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWhitelistPermissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWhitelistPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBlacklistPermissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBlacklistPermissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBlacklistPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegatePermissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegatePermissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegatePermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokePermissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokePermissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokePermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimCouncilorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimCouncilorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimCouncilorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposalAssignPermissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposalAssignPermissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposalAssignPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposalUpsertDataRegistryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposalUpsertDataRegistryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposalUpsertDataRegistryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposalSetNetworkPropertyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposalSetNetworkPropertyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposalSetNetworkPropertyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposalSetPoorNetworkMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposalSetPoorNetworkMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposalSetPoorNetworkMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposalCreateRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposalCreateRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposalCreateRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposalSetRoleVoteWeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposalSetRoleVoteWeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposalSetRoleVoteWeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposalParameterChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposalParameterChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposalParameterChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgSetExecutionFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgWhitelistRolePermissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProposalMultiContentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposalMultiContentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposalMultiContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *MsgBlacklistRolePermissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgRemoveWhitelistRolePermissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProposalSoftwareUpgradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposalSoftwareUpgradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposalSoftwareUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *MsgRemoveBlacklistRolePermissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgWhitelistPermissionsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProposalCancelSoftwareUpgradeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2181,12 +5118,31 @@ func (m *MsgWhitelistPermissionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWhitelistPermissionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposalCancelSoftwareUpgradeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWhitelistPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposalCancelSoftwareUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2211,7 +5167,7 @@ func (m *MsgWhitelistPermissionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBlacklistPermissionsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProposalRemoveCouncilorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2230,16 +5186,35 @@ func (m *MsgBlacklistPermissionsResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBlacklistPermissionsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBlacklistPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposalRemoveCouncilorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposalRemoveCouncilorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2264,7 +5239,7 @@ func (m *MsgBlacklistPermissionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimCouncilorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateRoleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2287,10 +5262,10 @@ func (m *MsgClaimCouncilorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimCouncilorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateRoleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimCouncilorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2317,7 +5292,7 @@ func (m *MsgClaimCouncilorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAssignRoleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2340,10 +5315,10 @@ func (m *MsgVoteProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAssignRoleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAssignRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2370,7 +5345,7 @@ func (m *MsgVoteProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProposalAssignPermissionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveRoleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2393,31 +5368,12 @@ func (m *MsgProposalAssignPermissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposalAssignPermissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveRoleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposalAssignPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
-			}
-			m.ProposalID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2442,7 +5398,7 @@ func (m *MsgProposalAssignPermissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProposalUpsertDataRegistryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetNetworkPropertiesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2465,31 +5421,12 @@ func (m *MsgProposalUpsertDataRegistryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposalUpsertDataRegistryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetNetworkPropertiesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposalUpsertDataRegistryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetNetworkPropertiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
-			}
-			m.ProposalID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2514,7 +5451,7 @@ func (m *MsgProposalUpsertDataRegistryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProposalSetNetworkPropertyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetExecutionFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2537,31 +5474,12 @@ func (m *MsgProposalSetNetworkPropertyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposalSetNetworkPropertyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetExecutionFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposalSetNetworkPropertyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetExecutionFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
-			}
-			m.ProposalID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2586,7 +5504,7 @@ func (m *MsgProposalSetNetworkPropertyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProposalSetPoorNetworkMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWhitelistRolePermissionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2609,31 +5527,12 @@ func (m *MsgProposalSetPoorNetworkMessagesResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposalSetPoorNetworkMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWhitelistRolePermissionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposalSetPoorNetworkMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWhitelistRolePermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
-			}
-			m.ProposalID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2658,7 +5557,7 @@ func (m *MsgProposalSetPoorNetworkMessagesResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *MsgProposalCreateRoleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBlacklistRolePermissionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2681,31 +5580,12 @@ func (m *MsgProposalCreateRoleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposalCreateRoleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBlacklistRolePermissionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposalCreateRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBlacklistRolePermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
-			}
-			m.ProposalID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2730,7 +5610,7 @@ func (m *MsgProposalCreateRoleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateRoleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveWhitelistRolePermissionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2753,10 +5633,10 @@ func (m *MsgCreateRoleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRoleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveWhitelistRolePermissionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveWhitelistRolePermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2783,7 +5663,7 @@ func (m *MsgCreateRoleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAssignRoleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveBlacklistRolePermissionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2806,10 +5686,10 @@ func (m *MsgAssignRoleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAssignRoleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveBlacklistRolePermissionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAssignRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveBlacklistRolePermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2836,7 +5716,7 @@ func (m *MsgAssignRoleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveRoleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantAuthorizationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2859,10 +5739,10 @@ func (m *MsgRemoveRoleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveRoleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantAuthorizationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantAuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2889,7 +5769,7 @@ func (m *MsgRemoveRoleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetNetworkPropertiesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeAuthorizationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2912,10 +5792,10 @@ func (m *MsgSetNetworkPropertiesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetNetworkPropertiesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeAuthorizationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetNetworkPropertiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeAuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2942,7 +5822,7 @@ func (m *MsgSetNetworkPropertiesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetExecutionFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2965,12 +5845,44 @@ func (m *MsgSetExecutionFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetExecutionFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetExecutionFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, make([]byte, postIndex-iNdEx))
+			copy(m.Results[len(m.Results)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2995,7 +5907,7 @@ func (m *MsgSetExecutionFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWhitelistRolePermissionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3018,12 +5930,65 @@ func (m *MsgWhitelistRolePermissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWhitelistRolePermissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWhitelistRolePermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3048,7 +6013,7 @@ func (m *MsgWhitelistRolePermissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBlacklistRolePermissionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3071,10 +6036,10 @@ func (m *MsgBlacklistRolePermissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBlacklistRolePermissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBlacklistRolePermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3101,7 +6066,7 @@ func (m *MsgBlacklistRolePermissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveWhitelistRolePermissionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitGroupProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3124,12 +6089,31 @@ func (m *MsgRemoveWhitelistRolePermissionResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveWhitelistRolePermissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitGroupProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveWhitelistRolePermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitGroupProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3154,7 +6138,7 @@ func (m *MsgRemoveWhitelistRolePermissionResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *MsgRemoveBlacklistRolePermissionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveGroupProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3177,10 +6161,10 @@ func (m *MsgRemoveBlacklistRolePermissionResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveBlacklistRolePermissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveGroupProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveBlacklistRolePermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveGroupProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2,8 +2,13 @@ package types
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gogo/protobuf/proto"
 )
 
 // NewPermissions generates a new permissions struct.
@@ -46,12 +51,16 @@ func (p *Permissions) IsWhitelisted(perm PermValue) bool {
 	return false
 }
 
-// AddToWhitelist adds permission to whitelist.
+// AddToWhitelist adds permission to whitelist, adding a whitelisted permission again does nothing.
 func (p *Permissions) AddToWhitelist(perm PermValue) error {
 	if p.IsBlacklisted(perm) {
 		return fmt.Errorf("permission is already blacklisted")
 	}
 
+	if p.IsWhitelisted(perm) {
+		return nil
+	}
+
 	p.Whitelist = append(p.Whitelist, uint32(perm))
 	return nil
 }
@@ -62,6 +71,10 @@ func (p *Permissions) AddToBlacklist(perm PermValue) error {
 		return fmt.Errorf("permission is already whitelisted")
 	}
 
+	if p.IsBlacklisted(perm) {
+		return nil
+	}
+
 	p.Blacklist = append(p.Blacklist, uint32(perm))
 	return nil
 }
@@ -98,18 +111,189 @@ func (m *Permissions) RemoveFromBlacklist(perm PermValue) error {
 	return nil
 }
 
+// Validate checks every permission is known and that no permission is both whitelisted and blacklisted.
+func (p *Permissions) Validate() error {
+	for _, perm := range p.Whitelist {
+		if _, ok := PermValue_name[int32(perm)]; !ok {
+			return fmt.Errorf("unknown permission %d", perm)
+		}
+
+		if p.IsBlacklisted(PermValue(perm)) {
+			return fmt.Errorf("permission %d is both whitelisted and blacklisted", perm)
+		}
+	}
+
+	for _, perm := range p.Blacklist {
+		if _, ok := PermValue_name[int32(perm)]; !ok {
+			return fmt.Errorf("unknown permission %d", perm)
+		}
+	}
+
+	return nil
+}
+
+// IsDelegablePermission returns false for the permissions an actor can not delegate: setting permissions,
+// claiming a seat and voting proposals, which would outlive the grant or give the delegatee a voice.
+func IsDelegablePermission(perm PermValue) bool {
+	switch perm {
+	case PermZero, PermSetPermissions, PermClaimValidator, PermClaimCouncilor:
+		return false
+	}
+
+	if _, ok := PermValue_name[int32(perm)]; !ok {
+		return false
+	}
+
+	return !strings.HasPrefix(perm.String(), "PERMISSION_VOTE_")
+}
+
+// isExpired returns true once the block height or the block time reaches the expiry, a zero height
+// and an unset time never expire.
+func isExpired(expiryHeight uint64, expiryTime *time.Time, height int64, now time.Time) bool {
+	if expiryHeight != 0 && uint64(height) >= expiryHeight {
+		return true
+	}
+
+	return expiryTime != nil && !now.Before(*expiryTime)
+}
+
+func NewPermissionExpiry(address types.AccAddress, perm PermValue, expiryHeight uint64, expiryTime *time.Time) PermissionExpiry {
+	return PermissionExpiry{
+		Address:      address,
+		Permission:   uint32(perm),
+		ExpiryHeight: expiryHeight,
+		ExpiryTime:   expiryTime,
+	}
+}
+
+// IsExpired returns true if the permission has to be removed at the height and time.
+func (e PermissionExpiry) IsExpired(height int64, now time.Time) bool {
+	return isExpired(e.ExpiryHeight, e.ExpiryTime, height, now)
+}
+
+func NewPermissionGrant(delegator, delegatee types.AccAddress, perm PermValue, expiryHeight uint64, expiryTime *time.Time) PermissionGrant {
+	return PermissionGrant{
+		Delegator:    delegator,
+		Delegatee:    delegatee,
+		Permission:   uint32(perm),
+		ExpiryHeight: expiryHeight,
+		ExpiryTime:   expiryTime,
+	}
+}
+
+// IsExpired returns true if the grant has to be removed at the height and time.
+func (g PermissionGrant) IsExpired(height int64, now time.Time) bool {
+	return isExpired(g.ExpiryHeight, g.ExpiryTime, height, now)
+}
+
+// MsgTypeURL returns the type url of a message, the one the authorizations are given for.
+func MsgTypeURL(msg proto.Message) string {
+	return "/" + proto.MessageName(msg)
+}
+
+func NewAuthorization(
+	granter, grantee types.AccAddress,
+	msgTypeURL string,
+	spendLimit types.Coins,
+	maxUses uint64,
+	expiryHeight uint64,
+	expiryTime *time.Time,
+) Authorization {
+	return Authorization{
+		Granter:      granter,
+		Grantee:      grantee,
+		MsgTypeUrl:   msgTypeURL,
+		SpendLimit:   spendLimit,
+		MaxUses:      maxUses,
+		ExpiryHeight: expiryHeight,
+		ExpiryTime:   expiryTime,
+	}
+}
+
+// IsExpired returns true if the authorization can not be used anymore at the height and time.
+func (a Authorization) IsExpired(height int64, now time.Time) bool {
+	return isExpired(a.ExpiryHeight, a.ExpiryTime, height, now)
+}
+
+// ValidateAuthorization checks the message type url of an authorization and its spend limit, which
+// only applies to bank sends.
+func ValidateAuthorization(msgTypeURL string, spendLimit types.Coins) error {
+	if len(msgTypeURL) < 2 || !strings.HasPrefix(msgTypeURL, "/") {
+		return fmt.Errorf("invalid message type url %q", msgTypeURL)
+	}
+
+	if spendLimit.Empty() {
+		return nil
+	}
+
+	if msgTypeURL != MsgTypeURL(&banktypes.MsgSend{}) {
+		return fmt.Errorf("spend limit is only supported for %s", MsgTypeURL(&banktypes.MsgSend{}))
+	}
+
+	if !spendLimit.IsValid() {
+		return fmt.Errorf("invalid spend limit %s", spendLimit)
+	}
+
+	return nil
+}
+
 func NewCouncilor(
 	moniker string,
 	website string,
 	social string,
 	identity string,
 	address types.AccAddress,
+	status CouncilorStatus,
+	termStart time.Time,
 ) Councilor {
 	return Councilor{
-		Moniker:  moniker,
-		Website:  website,
-		Social:   social,
-		Identity: identity,
-		Address:  address,
+		Moniker:   moniker,
+		Website:   website,
+		Social:    social,
+		Identity:  identity,
+		Address:   address,
+		Status:    status,
+		TermStart: termStart,
+	}
+}
+
+// IsActive returns true if the councilor holds the councilor rights.
+func (c Councilor) IsActive() bool {
+	return c.Status == CouncilorActive
+}
+
+// ParseCouncilorStatus parses a councilor status from its name, like COUNCILOR_SUSPENDED or suspended.
+func ParseCouncilorStatus(status string) (CouncilorStatus, error) {
+	status = strings.ToUpper(status)
+	if !strings.HasPrefix(status, "COUNCILOR_") {
+		status = "COUNCILOR_" + status
+	}
+
+	value, ok := CouncilorStatus_value[status]
+	if !ok {
+		return CouncilorActive, fmt.Errorf("unknown councilor status %s", status)
+	}
+
+	return CouncilorStatus(value), nil
+}
+
+var roleSidRegex = regexp.MustCompile(`^[a-z][a-z0-9_]{0,31}$`)
+
+func NewRoleInfo(role Role, sid string, description string, immutable bool) RoleInfo {
+	return RoleInfo{
+		Id:          uint32(role),
+		Sid:         sid,
+		Description: description,
+		Immutable:   immutable,
 	}
 }
+
+// ValidateRoleSid checks the sid is lowercase alphanumeric with underscores, starts with a letter
+// and is at most 32 characters long, so it can never be mistaken for a role number.
+func ValidateRoleSid(sid string) error {
+	if !roleSidRegex.MatchString(sid) {
+		return fmt.Errorf("%w: %q", ErrInvalidRoleSid, sid)
+	}
+
+	return nil
+}
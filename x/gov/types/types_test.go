@@ -1,10 +1,13 @@
 package types_test
 
 import (
+	"errors"
 	"os"
 	"testing"
+	"time"
 
 	customgovtypes "github.com/KiraCore/sekai/x/gov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/KiraCore/sekai/app"
 
@@ -42,6 +45,11 @@ func TestPermissions_AddWhitelist(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, perms.IsWhitelisted(customgovtypes.PermSetPermissions))
 
+	// Adding it again does not duplicate it
+	err = perms.AddToWhitelist(customgovtypes.PermSetPermissions)
+	require.NoError(t, err)
+	require.Len(t, perms.Whitelist, 1)
+
 	// Add to whitelist value blacklisted gives error
 	err = perms.AddToBlacklist(customgovtypes.PermClaimValidator)
 	require.NoError(t, err)
@@ -58,6 +66,11 @@ func TestPermissions_AddBlacklist(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, perms.IsBlacklisted(customgovtypes.PermSetPermissions))
 
+	// Adding it again does not duplicate it
+	err = perms.AddToBlacklist(customgovtypes.PermSetPermissions)
+	require.NoError(t, err)
+	require.Len(t, perms.Blacklist, 1)
+
 	// Add to blacklist when is whitelisted gives error
 	err = perms.AddToWhitelist(customgovtypes.PermClaimValidator)
 	require.NoError(t, err)
@@ -97,3 +110,69 @@ func TestPermissions_RemoveFromBlacklist(t *testing.T) {
 
 	require.False(t, perms.IsBlacklisted(customgovtypes.PermSetPermissions))
 }
+
+func TestParseCouncilorStatus(t *testing.T) {
+	status, err := customgovtypes.ParseCouncilorStatus("COUNCILOR_SUSPENDED")
+	require.NoError(t, err)
+	require.Equal(t, customgovtypes.CouncilorSuspended, status)
+
+	status, err = customgovtypes.ParseCouncilorStatus("active")
+	require.NoError(t, err)
+	require.Equal(t, customgovtypes.CouncilorActive, status)
+
+	_, err = customgovtypes.ParseCouncilorStatus("jailed")
+	require.Error(t, err)
+}
+
+func TestValidateRoleSid(t *testing.T) {
+	require.NoError(t, customgovtypes.ValidateRoleSid("sudo"))
+	require.NoError(t, customgovtypes.ValidateRoleSid("role_1234"))
+
+	for _, sid := range []string{"", "1234", "Sudo", "my-role", "_role", "a_very_long_role_sid_over_32_chars"} {
+		require.True(t, errors.Is(customgovtypes.ValidateRoleSid(sid), customgovtypes.ErrInvalidRoleSid), sid)
+	}
+}
+
+func TestIsDelegablePermission(t *testing.T) {
+	require.True(t, customgovtypes.IsDelegablePermission(customgovtypes.PermUpsertTokenRate))
+	require.True(t, customgovtypes.IsDelegablePermission(customgovtypes.PermCreateSetNetworkPropertyProposal))
+
+	require.False(t, customgovtypes.IsDelegablePermission(customgovtypes.PermZero))
+	require.False(t, customgovtypes.IsDelegablePermission(customgovtypes.PermSetPermissions))
+	require.False(t, customgovtypes.IsDelegablePermission(customgovtypes.PermClaimValidator))
+	require.False(t, customgovtypes.IsDelegablePermission(customgovtypes.PermClaimCouncilor))
+	require.False(t, customgovtypes.IsDelegablePermission(customgovtypes.PermVoteSetNetworkPropertyProposal))
+	require.False(t, customgovtypes.IsDelegablePermission(customgovtypes.PermValue(9999)))
+}
+
+func TestPermissionExpiry_IsExpired(t *testing.T) {
+	now := time.Unix(1600000000, 0).UTC()
+	later := now.Add(time.Hour)
+
+	require.False(t, customgovtypes.NewPermissionExpiry(nil, customgovtypes.PermUpsertTokenRate, 0, nil).IsExpired(100, later))
+
+	byHeight := customgovtypes.NewPermissionExpiry(nil, customgovtypes.PermUpsertTokenRate, 10, nil)
+	require.False(t, byHeight.IsExpired(9, now))
+	require.True(t, byHeight.IsExpired(10, now))
+
+	byTime := customgovtypes.NewPermissionGrant(nil, nil, customgovtypes.PermUpsertTokenRate, 0, &later)
+	require.False(t, byTime.IsExpired(100, now))
+	require.True(t, byTime.IsExpired(100, later))
+}
+
+func TestGroup_ApprovalWeight(t *testing.T) {
+	member1 := sdk.AccAddress("member1_____________")
+	member2 := sdk.AccAddress("member2_____________")
+	outsider := sdk.AccAddress("outsider____________")
+
+	group := customgovtypes.NewGroup(1, []customgovtypes.GroupMember{
+		customgovtypes.NewGroupMember(member1, 2),
+		customgovtypes.NewGroupMember(member2, 1),
+	}, 3, "")
+	require.Equal(t, customgovtypes.GroupAddress(1), group.Address)
+	require.NotEqual(t, customgovtypes.GroupAddress(1), customgovtypes.GroupAddress(2))
+
+	// the approvals of addresses which are no longer members do not count
+	require.Equal(t, uint64(2), group.ApprovalWeight([]sdk.AccAddress{member1, outsider}))
+	require.Equal(t, uint64(3), group.ApprovalWeight([]sdk.AccAddress{member1, member2}))
+}
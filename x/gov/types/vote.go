@@ -1,33 +1,141 @@
 package types
 
-import "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxVoteReasonLength is the maximum length of the justification of a vote.
+const MaxVoteReasonLength = 256
 
 type Votes []Vote
 
 func NewVote(proposalID uint64, addr types.AccAddress, option VoteOption) Vote {
+	return NewWeightedVote(proposalID, addr, NewNonSplitVoteOption(option), "")
+}
+
+// NewWeightedVote returns a vote split across the options, its option is the one with the highest weight.
+func NewWeightedVote(proposalID uint64, addr types.AccAddress, options WeightedVoteOptions, reason string) Vote {
 	return Vote{
 		ProposalId: proposalID,
 		Voter:      addr,
-		Option:     option,
+		Option:     options.MainOption(),
+		Options:    options,
+		Reason:     reason,
+	}
+}
+
+// WeightedOptions returns the options of the vote, votes cast before split votes existed weight 1 on their option.
+func (v Vote) WeightedOptions() WeightedVoteOptions {
+	if len(v.Options) == 0 {
+		return NewNonSplitVoteOption(v.Option)
+	}
+	return v.Options
+}
+
+type WeightedVoteOptions []WeightedVoteOption
+
+// NewNonSplitVoteOption returns the options of a vote cast entirely on one option.
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{{Option: option, Weight: types.OneDec()}}
+}
+
+// MainOption returns the option with the highest weight, the first one listed on a tie.
+func (o WeightedVoteOptions) MainOption() VoteOption {
+	main := OptionEmpty
+	weight := types.ZeroDec()
+	for _, option := range o {
+		if option.Weight.GT(weight) {
+			main = option.Option
+			weight = option.Weight
+		}
+	}
+	return main
+}
+
+// ValidateBasic checks that the options are valid, not repeated and that their weights add up to 1.
+func (o WeightedVoteOptions) ValidateBasic() error {
+	if len(o) == 0 {
+		return fmt.Errorf("no vote option")
+	}
+
+	used := make(map[VoteOption]bool)
+	total := types.ZeroDec()
+	for _, option := range o {
+		if option.Option == OptionEmpty || VoteOption_name[int32(option.Option)] == "" {
+			return fmt.Errorf("invalid vote option %d", option.Option)
+		}
+		if used[option.Option] {
+			return fmt.Errorf("duplicated vote option %s", option.Option)
+		}
+		if option.Weight.IsNil() || !option.Weight.IsPositive() || option.Weight.GT(types.OneDec()) {
+			return fmt.Errorf("invalid weight %s for vote option %s", option.Weight, option.Option)
+		}
+
+		used[option.Option] = true
+		total = total.Add(option.Weight)
+	}
+
+	if !total.Equal(types.OneDec()) {
+		return fmt.Errorf("vote option weights add up to %s instead of 1", total)
+	}
+
+	return nil
+}
+
+// NewWeightedTally returns a tally without any vote.
+func NewWeightedTally() WeightedTally {
+	return WeightedTally{
+		Yes:        types.ZeroDec(),
+		Abstain:    types.ZeroDec(),
+		No:         types.ZeroDec(),
+		NoWithVeto: types.ZeroDec(),
 	}
 }
 
 type CalculatedVotes struct {
 	votes          map[VoteOption]uint64
-	actorsWithVeto uint64
+	weights        map[VoteOption]types.Dec
+	actorsWithVeto types.Dec
 	total          uint64
+	totalWeight    types.Dec
 }
 
+// CalculateVotes counts the votes giving the same weight to every voter.
 func CalculateVotes(votes Votes, actorsWithVeto uint64) CalculatedVotes {
+	return CalculateWeightedVotes(votes, func(types.AccAddress) types.Dec {
+		return types.OneDec()
+	}, types.NewDec(int64(actorsWithVeto)))
+}
+
+// CalculateWeightedVotes counts the votes weighting each of them by the weight of the voter,
+// vetoWeight is the total weight of the actors allowed to veto the proposal.
+// The weight of a split vote is shared between its options, its count goes to its main option.
+func CalculateWeightedVotes(votes Votes, weightOf func(voter types.AccAddress) types.Dec, vetoWeight types.Dec) CalculatedVotes {
 	votesMap := make(map[VoteOption]uint64)
+	weightsMap := make(map[VoteOption]types.Dec)
+	totalWeight := types.ZeroDec()
 	for _, vote := range votes {
+		weight := weightOf(vote.Voter)
+
 		votesMap[vote.Option]++
+		for _, option := range vote.WeightedOptions() {
+			if _, ok := weightsMap[option.Option]; !ok {
+				weightsMap[option.Option] = types.ZeroDec()
+			}
+
+			weightsMap[option.Option] = weightsMap[option.Option].Add(weight.Mul(option.Weight))
+		}
+		totalWeight = totalWeight.Add(weight)
 	}
 
 	return CalculatedVotes{
 		total:          uint64(len(votes)),
-		actorsWithVeto: actorsWithVeto,
+		totalWeight:    totalWeight,
+		actorsWithVeto: vetoWeight,
 		votes:          votesMap,
+		weights:        weightsMap,
 	}
 }
 
@@ -51,23 +159,61 @@ func (c CalculatedVotes) VetoVotes() uint64 {
 	return c.votes[OptionNoWithVeto]
 }
 
+// TotalWeight returns the weight of all the votes.
+func (c CalculatedVotes) TotalWeight() types.Dec {
+	return c.totalWeight
+}
+
+// WeightedTally returns the weight of the votes cast on each option.
+func (c CalculatedVotes) WeightedTally() WeightedTally {
+	return WeightedTally{
+		Yes:        c.weight(OptionYes),
+		Abstain:    c.weight(OptionAbstain),
+		No:         c.weight(OptionNo),
+		NoWithVeto: c.weight(OptionNoWithVeto),
+	}
+}
+
+// TallyResult returns the number of votes cast on each option, totalVoters is the number of actors
+// allowed to vote on the proposal and quorum the percentage of them required to vote.
+func (c CalculatedVotes) TallyResult(totalVoters uint64, quorum uint64) TallyResult {
+	return TallyResult{
+		Yes:         c.YesVotes(),
+		Abstain:     c.AbstainVotes(),
+		No:          c.NoVotes(),
+		NoWithVeto:  c.VetoVotes(),
+		TotalVoters: totalVoters,
+		Quorum:      quorum,
+	}
+}
+
+func (c CalculatedVotes) weight(option VoteOption) types.Dec {
+	weight, ok := c.weights[option]
+	if !ok {
+		return types.ZeroDec()
+	}
+	return weight
+}
+
 func (c CalculatedVotes) ProcessResult() VoteResult {
-	if c.actorsWithVeto != 0 {
-		percentageActorsWithVeto := (float32(c.votes[OptionNoWithVeto]) / float32(c.actorsWithVeto)) * 100
-		if percentageActorsWithVeto >= 50 {
+	half := types.NewDecWithPrec(5, 1)
+
+	if c.actorsWithVeto.IsPositive() {
+		if c.weight(OptionNoWithVeto).Quo(c.actorsWithVeto).GTE(half) {
 			return RejectedWithVeto
 		}
 	}
 
-	yesPercentage := (float32(c.votes[OptionYes]) / float32(c.total)) * 100
-	if yesPercentage > 50.00 {
-		return Passed
+	if !c.totalWeight.IsPositive() {
+		return Unknown
 	}
 
-	sumOtherThanYes := c.votes[OptionNo] + c.votes[OptionAbstain] + c.votes[OptionNoWithVeto]
-	sumPercentage := (float32(sumOtherThanYes) / float32(c.total)) * 100
+	if c.weight(OptionYes).Quo(c.totalWeight).GT(half) {
+		return Passed
+	}
 
-	if sumPercentage >= 50 {
+	sumOtherThanYes := c.weight(OptionNo).Add(c.weight(OptionAbstain)).Add(c.weight(OptionNoWithVeto))
+	if sumOtherThanYes.Quo(c.totalWeight).GTE(half) {
 		return Rejected
 	}
 
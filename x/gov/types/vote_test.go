@@ -104,3 +104,144 @@ func TestCalculatedVotes_ProcessResult(t *testing.T) {
 		})
 	}
 }
+
+func TestCalculateWeightedVotes(t *testing.T) {
+	proposalID := uint64(12345)
+	whale := types.AccAddress("whale")
+	addr1 := types.AccAddress("addr1")
+	addr2 := types.AccAddress("addr2")
+
+	votes := Votes{
+		NewVote(proposalID, whale, OptionNo),
+		NewVote(proposalID, addr1, OptionYes),
+		NewVote(proposalID, addr2, OptionYes),
+	}
+
+	weights := map[string]types.Dec{
+		whale.String(): types.NewDec(10),
+		addr1.String(): types.NewDecWithPrec(25, 1),
+		addr2.String(): types.NewDecWithPrec(25, 1),
+	}
+	weightOf := func(voter types.AccAddress) types.Dec {
+		return weights[voter.String()]
+	}
+
+	calculatedVotes := CalculateWeightedVotes(votes, weightOf, types.ZeroDec())
+	require.Equal(t, uint64(2), calculatedVotes.YesVotes())
+	require.Equal(t, uint64(1), calculatedVotes.NoVotes())
+	require.Equal(t, types.NewDec(15), calculatedVotes.TotalWeight())
+	require.Equal(t, WeightedTally{
+		Yes:        types.NewDec(5),
+		Abstain:    types.ZeroDec(),
+		No:         types.NewDec(10),
+		NoWithVeto: types.ZeroDec(),
+	}, calculatedVotes.WeightedTally())
+	require.Equal(t, Rejected, calculatedVotes.ProcessResult())
+
+	// the same votes pass when every voter has the same weight
+	require.Equal(t, Passed, CalculateVotes(votes, 0).ProcessResult())
+
+	// no weight at all
+	noWeight := CalculateWeightedVotes(votes, func(types.AccAddress) types.Dec { return types.ZeroDec() }, types.ZeroDec())
+	require.Equal(t, Unknown, noWeight.ProcessResult())
+}
+
+func TestCalculateWeightedVotes_SplitVotes(t *testing.T) {
+	proposalID := uint64(12345)
+	whale := types.AccAddress("whale")
+	addr1 := types.AccAddress("addr1")
+
+	votes := Votes{
+		NewWeightedVote(proposalID, whale, WeightedVoteOptions{
+			{Option: OptionNo, Weight: types.NewDecWithPrec(6, 1)},
+			{Option: OptionYes, Weight: types.NewDecWithPrec(4, 1)},
+		}, "mostly against"),
+		NewVote(proposalID, addr1, OptionYes),
+	}
+
+	weights := map[string]types.Dec{
+		whale.String(): types.NewDec(10),
+		addr1.String(): types.NewDec(1),
+	}
+	weightOf := func(voter types.AccAddress) types.Dec {
+		return weights[voter.String()]
+	}
+
+	calculatedVotes := CalculateWeightedVotes(votes, weightOf, types.ZeroDec())
+	// the split vote is counted on its main option
+	require.Equal(t, uint64(1), calculatedVotes.YesVotes())
+	require.Equal(t, uint64(1), calculatedVotes.NoVotes())
+	require.Equal(t, types.NewDec(11), calculatedVotes.TotalWeight())
+
+	tally := calculatedVotes.WeightedTally()
+	require.True(t, types.NewDec(5).Equal(tally.Yes))
+	require.True(t, types.NewDec(6).Equal(tally.No))
+	require.Equal(t, Rejected, calculatedVotes.ProcessResult())
+}
+
+func TestWeightedVoteOptions_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name    string
+		options WeightedVoteOptions
+		valid   bool
+	}{
+		{
+			name:    "non split vote",
+			options: NewNonSplitVoteOption(OptionYes),
+			valid:   true,
+		},
+		{
+			name: "split vote",
+			options: WeightedVoteOptions{
+				{Option: OptionYes, Weight: types.NewDecWithPrec(25, 2)},
+				{Option: OptionAbstain, Weight: types.NewDecWithPrec(75, 2)},
+			},
+			valid: true,
+		},
+		{
+			name:    "no option",
+			options: WeightedVoteOptions{},
+		},
+		{
+			name:    "invalid option",
+			options: NewNonSplitVoteOption(VoteOption(10)),
+		},
+		{
+			name:    "empty option",
+			options: NewNonSplitVoteOption(OptionEmpty),
+		},
+		{
+			name: "duplicated option",
+			options: WeightedVoteOptions{
+				{Option: OptionYes, Weight: types.NewDecWithPrec(5, 1)},
+				{Option: OptionYes, Weight: types.NewDecWithPrec(5, 1)},
+			},
+		},
+		{
+			name: "weights do not add up to 1",
+			options: WeightedVoteOptions{
+				{Option: OptionYes, Weight: types.NewDecWithPrec(5, 1)},
+				{Option: OptionNo, Weight: types.NewDecWithPrec(4, 1)},
+			},
+		},
+		{
+			name: "negative weight",
+			options: WeightedVoteOptions{
+				{Option: OptionYes, Weight: types.NewDec(2)},
+				{Option: OptionNo, Weight: types.NewDec(-1)},
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			err := test.options.ValidateBasic()
+			if test.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -42,9 +42,41 @@ func (k Keeper) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) e
 	return nil
 }
 
-// AfterValidatorRemoved deletes the address-pubkey relation when a validator is removed,
+// AfterValidatorRemoved deletes the address-pubkey relation, the signing info and the missed blocks
+// of a consensus address when its validator is removed.
 func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, address sdk.ConsAddress) {
 	k.deleteAddrPubkeyRelation(ctx, crypto.Address(address))
+	k.deleteValidatorSigningInfo(ctx, address)
+	k.clearValidatorMissedBlockBitArray(ctx, address)
+}
+
+// AfterConsensusKeyRotated adds the address-pubkey relation of the new consensus key and carries
+// the signing info and the missed blocks of the old consensus address over to the new one.
+func (k Keeper) AfterConsensusKeyRotated(ctx sdk.Context, oldAddress, newAddress sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	validator, err := k.sk.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	consPk, err := validator.ConsPubKey()
+	if err != nil {
+		return err
+	}
+	k.AddPubkey(ctx, consPk)
+
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, oldAddress)
+	if !found {
+		return nil
+	}
+
+	signingInfo.Address = newAddress.String()
+	k.SetValidatorSigningInfo(ctx, newAddress, signingInfo)
+	k.IterateValidatorMissedBlockBitArray(ctx, oldAddress, func(index int64, missed bool) (stop bool) {
+		k.SetValidatorMissedBlockBitArray(ctx, newAddress, index, missed)
+		return false
+	})
+
+	return nil
 }
 
 //_________________________________________________________________________________________
@@ -76,4 +108,16 @@ func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {
 	h.k.AfterValidatorCreated(ctx, valAddr)
 }
 
+// Implements sdk.ValidatorHooks
+func (h Hooks) AfterConsensusKeyRotated(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	if err := h.k.AfterConsensusKeyRotated(ctx, oldConsAddr, newConsAddr, valAddr); err != nil {
+		h.k.Logger(ctx).Error("failed to carry the signing info over to the rotated consensus key",
+			"validator", valAddr.String(), "old", oldConsAddr.String(), "new", newConsAddr.String(), "err", err)
+	}
+}
+
 func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) {}
+
+func (h Hooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {}
+
+func (h Hooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {}
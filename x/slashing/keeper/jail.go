@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/KiraCore/sekai/x/slashing/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -20,3 +22,24 @@ func (k Keeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
 		k.sk.Jail(ctx, validator.ValKey)
 	}
 }
+
+// Slash attempts to slash a validator. The slash is delegated to the staking
+// module to burn the tokens at stake.
+func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64) {
+	validator, err := k.sk.GetValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return
+	}
+
+	burned := k.sk.Slash(ctx, validator.ValKey, distributionHeight, fraction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlash,
+			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
+			sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueDoubleSign),
+			sdk.NewAttribute(types.AttributeKeyBurnedCoins, burned.String()),
+		),
+	)
+}
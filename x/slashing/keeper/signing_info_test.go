@@ -9,6 +9,8 @@ import (
 
 	"github.com/KiraCore/sekai/simapp"
 	"github.com/KiraCore/sekai/x/slashing/types"
+	stakingtypes "github.com/KiraCore/sekai/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -94,3 +96,76 @@ func TestJailUntil(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, time.Unix(253402300799, 0).UTC(), info.InactiveUntil)
 }
+
+func TestSigningInfoCarriedOverOnConsensusKeyRotation(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200))
+
+	validator, err := stakingtypes.NewValidator("validator 1", "some-web.com", "A Social", "My Identity", sdk.NewDecWithPrec(10, 2), sdk.ValAddress(addrDels[0]), ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	app.CustomStakingKeeper.AddValidator(ctx, validator)
+	app.CustomStakingKeeper.SetValidatorPower(ctx, validator.ValKey, 1)
+
+	oldConsAddr := validator.GetConsAddr()
+	app.CustomSlashingKeeper.SetValidatorSigningInfo(ctx, oldConsAddr, types.NewValidatorSigningInfo(oldConsAddr, int64(4), int64(3), time.Unix(0, 0), false, int64(1)))
+	app.CustomSlashingKeeper.SetValidatorMissedBlockBitArray(ctx, oldConsAddr, 2, true)
+
+	newPubKey := ed25519.GenPrivKey().PubKey()
+	newConsAddr := sdk.ConsAddress(newPubKey.Address())
+	require.NoError(t, app.CustomStakingKeeper.RotateConsensusKey(ctx, validator.ValKey, newPubKey))
+	_, err = app.CustomStakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+
+	info, found := app.CustomSlashingKeeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	require.True(t, found)
+	require.Equal(t, newConsAddr.String(), info.Address)
+	require.Equal(t, int64(4), info.StartHeight)
+	require.Equal(t, int64(3), info.IndexOffset)
+	require.Equal(t, int64(1), info.MissedBlocksCounter)
+	require.True(t, app.CustomSlashingKeeper.GetValidatorMissedBlockBitArray(ctx, newConsAddr, 2))
+
+	pubKey, err := app.CustomSlashingKeeper.GetPubkey(ctx, newPubKey.Address())
+	require.NoError(t, err)
+	require.Equal(t, newPubKey, pubKey)
+}
+
+func TestSigningInfoDeletedOnValidatorRemoval(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200))
+
+	validator, err := stakingtypes.NewValidator("validator 1", "some-web.com", "A Social", "My Identity", sdk.NewDecWithPrec(10, 2), sdk.ValAddress(addrDels[0]), ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	app.CustomStakingKeeper.AddValidator(ctx, validator)
+	app.CustomStakingKeeper.SetValidatorPower(ctx, validator.ValKey, 1)
+
+	consAddr := validator.GetConsAddr()
+	app.CustomSlashingKeeper.AddPubkey(ctx, validator.GetConsPubKey())
+	app.CustomSlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, types.NewValidatorSigningInfo(consAddr, int64(1), int64(3), time.Unix(0, 0), false, int64(1)))
+	app.CustomSlashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 2, true)
+
+	properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	properties.ExitNoticePeriod = 0
+	app.CustomGovKeeper.SetNetworkProperties(ctx, properties)
+
+	_, err = app.CustomStakingKeeper.ExitValidator(ctx, validator.ValKey)
+	require.NoError(t, err)
+	require.NoError(t, app.CustomStakingKeeper.ProcessValidatorExits(ctx))
+
+	// the signing info is kept until the validator is deleted
+	require.True(t, app.CustomSlashingKeeper.HasValidatorSigningInfo(ctx, consAddr))
+
+	// and until the unbonding time is over
+	ctx = ctx.WithBlockHeight(3)
+	require.NoError(t, app.CustomStakingKeeper.ProcessValidatorExits(ctx))
+	require.True(t, app.CustomSlashingKeeper.HasValidatorSigningInfo(ctx, consAddr))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(properties.UnbondingTime) * time.Second))
+	require.NoError(t, app.CustomStakingKeeper.ProcessValidatorExits(ctx))
+
+	require.False(t, app.CustomSlashingKeeper.HasValidatorSigningInfo(ctx, consAddr))
+	require.False(t, app.CustomSlashingKeeper.GetValidatorMissedBlockBitArray(ctx, consAddr, 2))
+	_, err = app.CustomSlashingKeeper.GetPubkey(ctx, consAddr.Bytes())
+	require.Error(t, err)
+}
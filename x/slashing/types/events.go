@@ -3,6 +3,7 @@ package types
 
 // Slashing module event types
 const (
+	EventTypeSlash      = "slash"
 	EventTypeInactivate = "inactivate"
 	EventTypeLiveness   = "liveness"
 
@@ -12,6 +13,7 @@ const (
 	AttributeKeyReason       = "reason"
 	AttributeKeyInactivated  = "inactivated"
 	AttributeKeyMissedBlocks = "missed_blocks"
+	AttributeKeyBurnedCoins  = "burned_coins"
 
 	AttributeValueDoubleSign       = "double_sign"
 	AttributeValueMissingSignature = "missing_signature"
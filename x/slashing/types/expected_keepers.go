@@ -54,13 +54,18 @@ type StakingKeeper interface {
 
 	HandleValidatorSignature(sdk.Context, sdk.ValAddress, bool) error
 
+	// burn the fraction of the tokens bonded to the validator and of its unbonding delegations
+	// started at or after the infraction height, returns the burned amount
+	Slash(sdk.Context, sdk.ValAddress, int64, sdk.Dec) sdk.Int
+
 	// MaxValidators returns the maximum amount of joined validators
 	MaxValidators(sdk.Context) uint32
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
 type StakingHooks interface {
-	AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress)                           // Must be called when a validator is created
-	AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) // Must be called when a validator is deleted
-	AfterValidatorJoined(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress)  // Must be called when a validator is joined
+	AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress)                                              // Must be called when a validator is created
+	AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress)                    // Must be called when a validator is deleted
+	AfterValidatorJoined(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress)                     // Must be called when a validator is joined
+	AfterConsensusKeyRotated(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) // Must be called when a validator's consensus key is replaced
 }
@@ -38,11 +38,26 @@ func DefaultGenesisState() *GenesisState {
 
 // ValidateGenesis validates the slashing genesis parameters
 func ValidateGenesis(data GenesisState) error {
+	return data.Validate()
+}
+
+// Validate checks the params and that the signing infos and missed blocks are set once per valid consensus address
+func (data GenesisState) Validate() error {
 	minSign := data.Params.MinSignedPerWindow
 	if minSign.IsNegative() || minSign.GT(sdk.OneDec()) {
 		return fmt.Errorf("min signed per window should be less than or equal to one and greater than zero, is %s", minSign.String())
 	}
 
+	slashFractionDoubleSign := data.Params.SlashFractionDoubleSign
+	if slashFractionDoubleSign.IsNegative() || slashFractionDoubleSign.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash fraction double sign should be less than or equal to one and greater than zero, is %s", slashFractionDoubleSign.String())
+	}
+
+	slashFractionDowntime := data.Params.SlashFractionDowntime
+	if slashFractionDowntime.IsNegative() || slashFractionDowntime.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash fraction downtime should be less than or equal to one and greater than zero, is %s", slashFractionDowntime.String())
+	}
+
 	downtimeInactive := data.Params.DowntimeInactiveDuration
 	if downtimeInactive < 1*time.Minute {
 		return fmt.Errorf("downtime unblond duration must be at least 1 minute, is %s", downtimeInactive.String())
@@ -53,5 +68,35 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	signingInfos := make(map[string]bool)
+	for _, info := range data.SigningInfos {
+		if _, err := sdk.ConsAddressFromBech32(info.Address); err != nil {
+			return fmt.Errorf("invalid signing info address %s: %w", info.Address, err)
+		}
+
+		if signingInfos[info.Address] {
+			return fmt.Errorf("duplicate signing info of %s", info.Address)
+		}
+		signingInfos[info.Address] = true
+	}
+
+	missedBlocks := make(map[string]bool)
+	for _, array := range data.MissedBlocks {
+		if _, err := sdk.ConsAddressFromBech32(array.Address); err != nil {
+			return fmt.Errorf("invalid missed blocks address %s: %w", array.Address, err)
+		}
+
+		if missedBlocks[array.Address] {
+			return fmt.Errorf("duplicate missed blocks of %s", array.Address)
+		}
+		missedBlocks[array.Address] = true
+
+		for _, missed := range array.MissedBlocks {
+			if missed.Index < 0 || missed.Index >= signedWindow {
+				return fmt.Errorf("missed block index %d of %s is out of the signed blocks window", missed.Index, array.Address)
+			}
+		}
+	}
+
 	return nil
 }
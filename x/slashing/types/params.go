@@ -15,7 +15,9 @@ const (
 )
 
 var (
-	DefaultMinSignedPerWindow = sdk.NewDecWithPrec(5, 1)
+	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
+	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
+	DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(100))
 )
 
 // Parameter store keys
@@ -23,6 +25,8 @@ var (
 	KeySignedBlocksWindow       = []byte("SignedBlocksWindow")
 	KeyMinSignedPerWindow       = []byte("MinSignedPerWindow")
 	KeyDowntimeInactiveDuration = []byte("DowntimeInactiveDuration")
+	KeySlashFractionDoubleSign  = []byte("SlashFractionDoubleSign")
+	KeySlashFractionDowntime    = []byte("SlashFractionDowntime")
 )
 
 // ParamKeyTable for slashing module
@@ -33,12 +37,15 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, DowntimeInactiveDuration time.Duration,
+	slashFractionDoubleSign, slashFractionDowntime sdk.Dec,
 ) Params {
 
 	return Params{
 		SignedBlocksWindow:       signedBlocksWindow,
 		MinSignedPerWindow:       minSignedPerWindow,
 		DowntimeInactiveDuration: DowntimeInactiveDuration,
+		SlashFractionDoubleSign:  slashFractionDoubleSign,
+		SlashFractionDowntime:    slashFractionDowntime,
 	}
 }
 
@@ -48,6 +55,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeySignedBlocksWindow, &p.SignedBlocksWindow, validateSignedBlocksWindow),
 		paramtypes.NewParamSetPair(KeyMinSignedPerWindow, &p.MinSignedPerWindow, validateMinSignedPerWindow),
 		paramtypes.NewParamSetPair(KeyDowntimeInactiveDuration, &p.DowntimeInactiveDuration, validateDowntimeInactiveDuration),
+		paramtypes.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFractionDoubleSign),
+		paramtypes.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFractionDowntime),
 	}
 }
 
@@ -55,6 +64,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 func DefaultParams() Params {
 	return NewParams(
 		DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeInactiveDuration,
+		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime,
 	)
 }
 
@@ -99,3 +109,35 @@ func validateDowntimeInactiveDuration(i interface{}) error {
 
 	return nil
 }
+
+func validateSlashFractionDoubleSign(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("double sign slash fraction cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("double sign slash fraction too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateSlashFractionDowntime(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("downtime slash fraction cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("downtime slash fraction too large: %s", v)
+	}
+
+	return nil
+}
@@ -125,6 +125,8 @@ type Params struct {
 	SignedBlocksWindow       int64                                  `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty" yaml:"signed_blocks_window"`
 	MinSignedPerWindow       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_signed_per_window" yaml:"min_signed_per_window"`
 	DowntimeInactiveDuration time.Duration                          `protobuf:"bytes,3,opt,name=downtime_inactive_duration,json=downtimeInactiveDuration,proto3,stdduration" json:"downtime_inactive_duration" yaml:"downtime_inactive_duration"`
+	SlashFractionDoubleSign  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
+	SlashFractionDowntime    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -182,43 +184,47 @@ func init() {
 func init() { proto.RegisterFile("slashing.proto", fileDescriptor_31f622956ca78100) }
 
 var fileDescriptor_31f622956ca78100 = []byte{
-	// 565 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x18, 0xcd, 0x35, 0x50, 0x8a, 0xd3, 0x76, 0x70, 0x5b, 0x61, 0x02, 0xf2, 0xa5, 0x1e, 0x50, 0x84,
-	0x54, 0x5b, 0x2a, 0x5b, 0x47, 0xb7, 0x43, 0x2b, 0x24, 0x28, 0x6e, 0x01, 0x89, 0xc5, 0xba, 0xf8,
-	0x2e, 0xce, 0x29, 0xf6, 0x5d, 0x74, 0x77, 0x21, 0xed, 0xc8, 0xc2, 0x88, 0x3a, 0x76, 0xec, 0xc8,
-	0x4f, 0xe9, 0xd8, 0x11, 0x31, 0x18, 0x94, 0x2c, 0x9d, 0xf3, 0x0b, 0x90, 0xcf, 0x76, 0xb1, 0x4a,
-	0x98, 0x92, 0xef, 0xbd, 0xf7, 0xbd, 0xbb, 0xef, 0x7b, 0x67, 0x63, 0x5d, 0x26, 0x48, 0x0e, 0x28,
-	0x8b, 0xdd, 0x91, 0xe0, 0x8a, 0x9b, 0x6b, 0x43, 0x2a, 0x90, 0x5b, 0x81, 0xed, 0xcd, 0x98, 0xc7,
-	0x5c, 0x33, 0x5e, 0xfe, 0xaf, 0x10, 0xb5, 0xed, 0x98, 0xf3, 0x38, 0x21, 0x9e, 0xae, 0x7a, 0xe3,
-	0xbe, 0x87, 0xc7, 0x02, 0x29, 0xca, 0x59, 0xc9, 0xc3, 0xfb, 0xbc, 0xa2, 0x29, 0x91, 0x0a, 0xa5,
-	0xa3, 0x42, 0xe0, 0x7c, 0x6b, 0x1a, 0x9b, 0x1f, 0x50, 0x42, 0x31, 0x52, 0x5c, 0x9c, 0xd0, 0x98,
-	0x51, 0x16, 0x1f, 0xb1, 0x3e, 0x37, 0x2d, 0xe3, 0x11, 0xc2, 0x58, 0x10, 0x29, 0x2d, 0xd0, 0x01,
-	0xdd, 0xc7, 0x41, 0x55, 0x9a, 0x7b, 0xc6, 0xaa, 0x54, 0x48, 0xa8, 0x70, 0x40, 0x68, 0x3c, 0x50,
-	0xd6, 0x52, 0x07, 0x74, 0x9b, 0xfe, 0x93, 0x79, 0x06, 0x37, 0xce, 0x51, 0x9a, 0xec, 0x39, 0x75,
-	0xd6, 0x09, 0x5a, 0xba, 0x3c, 0xd4, 0x55, 0xde, 0x4b, 0x19, 0x26, 0x67, 0x21, 0xef, 0xf7, 0x25,
-	0x51, 0x56, 0xf3, 0x7e, 0x6f, 0x9d, 0x75, 0x82, 0x96, 0x2e, 0xdf, 0xea, 0xca, 0xc4, 0xc6, 0x3a,
-	0x65, 0x28, 0x52, 0xf4, 0x33, 0x09, 0xc7, 0x4c, 0xd1, 0xc4, 0x7a, 0xd0, 0x01, 0xdd, 0xd6, 0x6e,
-	0xdb, 0x2d, 0x86, 0x74, 0xab, 0x21, 0xdd, 0xd3, 0x6a, 0x48, 0x7f, 0xfb, 0x3a, 0x83, 0x8d, 0x79,
-	0x06, 0xb7, 0x2a, 0xf7, 0x7a, 0xbf, 0x73, 0xf1, 0x0b, 0x82, 0x60, 0xad, 0x02, 0xdf, 0xe7, 0x98,
-	0x69, 0x1b, 0x86, 0xe2, 0x69, 0x4f, 0x2a, 0xce, 0x08, 0xb6, 0x1e, 0x76, 0x40, 0x77, 0x25, 0xa8,
-	0x21, 0xe6, 0xa9, 0xb1, 0x95, 0x52, 0x29, 0x09, 0x0e, 0x7b, 0x09, 0x8f, 0x86, 0x32, 0x8c, 0xf8,
-	0x98, 0x29, 0x22, 0xac, 0x65, 0x3d, 0x4a, 0x67, 0x9e, 0xc1, 0xe7, 0xc5, 0x61, 0x0b, 0x65, 0x4e,
-	0xb0, 0x51, 0xe0, 0xbe, 0x86, 0xf7, 0x0b, 0x74, 0x6f, 0xe5, 0xf2, 0x0a, 0x36, 0x6e, 0xaf, 0x20,
-	0x70, 0x6e, 0x97, 0x8c, 0xe5, 0x63, 0x24, 0x50, 0x2a, 0xcd, 0x77, 0xc6, 0xa6, 0xa4, 0x31, 0xfb,
-	0xeb, 0x31, 0xa1, 0x0c, 0xf3, 0x89, 0xce, 0xa3, 0xe9, 0xc3, 0x79, 0x06, 0x9f, 0x95, 0x0b, 0x5f,
-	0xa0, 0x72, 0x02, 0xb3, 0x80, 0x8b, 0x83, 0x3e, 0x6a, 0xd0, 0xfc, 0x02, 0xf2, 0xeb, 0xb3, 0xb0,
-	0xec, 0x18, 0x11, 0x51, 0x99, 0xe6, 0x29, 0xae, 0xfa, 0x6f, 0xf2, 0x7d, 0xfd, 0xcc, 0xe0, 0x8b,
-	0x98, 0xaa, 0xc1, 0xb8, 0xe7, 0x46, 0x3c, 0xf5, 0x22, 0x2e, 0x53, 0x2e, 0xcb, 0x9f, 0x1d, 0x89,
-	0x87, 0x9e, 0x3a, 0x1f, 0x11, 0xe9, 0x1e, 0x90, 0xa8, 0x3e, 0xec, 0x02, 0x53, 0x27, 0x30, 0x53,
-	0xca, 0x4e, 0x34, 0x7c, 0x4c, 0x44, 0x79, 0x87, 0xaf, 0xc0, 0x68, 0x63, 0x3e, 0x61, 0xf9, 0x53,
-	0x0c, 0xef, 0x12, 0xa9, 0x1e, 0xae, 0x7e, 0x12, 0xad, 0xdd, 0xa7, 0xff, 0x84, 0x7a, 0x50, 0x0a,
-	0xfc, 0x9d, 0x32, 0xd3, 0xed, 0xe2, 0xe4, 0xff, 0x5b, 0x39, 0x97, 0x79, 0xbe, 0x56, 0x25, 0x38,
-	0x2a, 0xf9, 0x3b, 0xa3, 0xc3, 0xef, 0x53, 0x1b, 0x5c, 0x4f, 0x6d, 0x70, 0x33, 0xb5, 0xc1, 0xef,
-	0xa9, 0x0d, 0x2e, 0x66, 0x76, 0xe3, 0x66, 0x66, 0x37, 0x7e, 0xcc, 0xec, 0xc6, 0xa7, 0x97, 0xb5,
-	0x15, 0xbc, 0xa6, 0x02, 0xed, 0x73, 0x41, 0x3c, 0x49, 0x86, 0x88, 0x7a, 0x67, 0x5e, 0xf5, 0x59,
-	0x16, 0xab, 0xe8, 0x2d, 0xeb, 0x5b, 0xbe, 0xfa, 0x13, 0x00, 0x00, 0xff, 0xff, 0x74, 0x9c, 0x6c,
-	0x07, 0xc4, 0x03, 0x00, 0x00,
+	// 634 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbf, 0x4f, 0xdb, 0x40,
+	0x14, 0xce, 0x35, 0x85, 0xd2, 0x4b, 0x60, 0x30, 0x20, 0xdc, 0xb4, 0xb2, 0x83, 0x87, 0x2a, 0xaa,
+	0x84, 0x2d, 0xd1, 0x8d, 0xd1, 0xa0, 0x0a, 0x54, 0xa9, 0xa5, 0x86, 0xb6, 0x52, 0x17, 0xeb, 0x92,
+	0xbb, 0x38, 0xa7, 0xd8, 0x77, 0xd1, 0xdd, 0xa5, 0xc0, 0xd8, 0x05, 0x75, 0xaa, 0x18, 0x19, 0x19,
+	0xfb, 0xa7, 0x30, 0x32, 0x56, 0x1d, 0xd2, 0x2a, 0x2c, 0x9d, 0xf3, 0x17, 0x54, 0xbe, 0xb3, 0xdb,
+	0x08, 0xc8, 0xc0, 0x94, 0xbc, 0xef, 0x7b, 0xef, 0xbb, 0xef, 0xfd, 0x48, 0xe0, 0x92, 0x4c, 0x91,
+	0xec, 0x51, 0x96, 0xf8, 0x03, 0xc1, 0x15, 0xb7, 0x16, 0xfb, 0x54, 0x20, 0xbf, 0x04, 0x1b, 0x2b,
+	0x09, 0x4f, 0xb8, 0x66, 0x82, 0xfc, 0x9b, 0x49, 0x6a, 0x38, 0x09, 0xe7, 0x49, 0x4a, 0x02, 0x1d,
+	0xb5, 0x87, 0xdd, 0x00, 0x0f, 0x05, 0x52, 0x94, 0xb3, 0x82, 0x77, 0x6f, 0xf2, 0x8a, 0x66, 0x44,
+	0x2a, 0x94, 0x0d, 0x4c, 0x82, 0xf7, 0xad, 0x0a, 0x57, 0x3e, 0xa0, 0x94, 0x62, 0xa4, 0xb8, 0x38,
+	0xa0, 0x09, 0xa3, 0x2c, 0xd9, 0x63, 0x5d, 0x6e, 0xd9, 0xf0, 0x11, 0xc2, 0x58, 0x10, 0x29, 0x6d,
+	0xd0, 0x04, 0xad, 0xc7, 0x51, 0x19, 0x5a, 0x5b, 0xb0, 0x2e, 0x15, 0x12, 0x2a, 0xee, 0x11, 0x9a,
+	0xf4, 0x94, 0xfd, 0xa0, 0x09, 0x5a, 0xd5, 0x70, 0x6d, 0x32, 0x72, 0x97, 0x4f, 0x50, 0x96, 0x6e,
+	0x79, 0xd3, 0xac, 0x17, 0xd5, 0x74, 0xb8, 0xab, 0xa3, 0xbc, 0x96, 0x32, 0x4c, 0x8e, 0x63, 0xde,
+	0xed, 0x4a, 0xa2, 0xec, 0xea, 0xcd, 0xda, 0x69, 0xd6, 0x8b, 0x6a, 0x3a, 0x7c, 0xab, 0x23, 0x0b,
+	0xc3, 0x25, 0xca, 0x50, 0x47, 0xd1, 0xcf, 0x24, 0x1e, 0x32, 0x45, 0x53, 0xfb, 0x61, 0x13, 0xb4,
+	0x6a, 0x9b, 0x0d, 0xdf, 0x34, 0xe9, 0x97, 0x4d, 0xfa, 0x87, 0x65, 0x93, 0xe1, 0xfa, 0xe5, 0xc8,
+	0xad, 0x4c, 0x46, 0xee, 0x6a, 0xa9, 0x3e, 0x5d, 0xef, 0x9d, 0xfd, 0x72, 0x41, 0xb4, 0x58, 0x82,
+	0xef, 0x73, 0xcc, 0x72, 0x20, 0x54, 0x3c, 0x6b, 0x4b, 0xc5, 0x19, 0xc1, 0xf6, 0x5c, 0x13, 0xb4,
+	0x16, 0xa2, 0x29, 0xc4, 0x3a, 0x84, 0xab, 0x19, 0x95, 0x92, 0xe0, 0xb8, 0x9d, 0xf2, 0x4e, 0x5f,
+	0xc6, 0x1d, 0x3e, 0x64, 0x8a, 0x08, 0x7b, 0x5e, 0xb7, 0xd2, 0x9c, 0x8c, 0xdc, 0x67, 0xe6, 0xb1,
+	0x3b, 0xd3, 0xbc, 0x68, 0xd9, 0xe0, 0xa1, 0x86, 0xb7, 0x0d, 0xba, 0xb5, 0x70, 0x7e, 0xe1, 0x56,
+	0xfe, 0x5c, 0xb8, 0xc0, 0x3b, 0x9d, 0x83, 0xf3, 0xfb, 0x48, 0xa0, 0x4c, 0x5a, 0xef, 0xe0, 0x8a,
+	0xa4, 0x09, 0xfb, 0xaf, 0x71, 0x44, 0x19, 0xe6, 0x47, 0x7a, 0x1f, 0xd5, 0xd0, 0x9d, 0x8c, 0xdc,
+	0xa7, 0xc5, 0xc0, 0xef, 0xc8, 0xf2, 0x22, 0xcb, 0xc0, 0xe6, 0xa1, 0x8f, 0x1a, 0xb4, 0xbe, 0x80,
+	0xdc, 0x3e, 0x8b, 0x8b, 0x8a, 0x01, 0x11, 0xa5, 0x68, 0xbe, 0xc5, 0x7a, 0xf8, 0x26, 0x9f, 0xd7,
+	0xcf, 0x91, 0xfb, 0x3c, 0xa1, 0xaa, 0x37, 0x6c, 0xfb, 0x1d, 0x9e, 0x05, 0x1d, 0x2e, 0x33, 0x2e,
+	0x8b, 0x8f, 0x0d, 0x89, 0xfb, 0x81, 0x3a, 0x19, 0x10, 0xe9, 0xef, 0x90, 0xce, 0x74, 0xb3, 0x77,
+	0x88, 0x7a, 0x91, 0x95, 0x51, 0x76, 0xa0, 0xe1, 0x7d, 0x22, 0x0a, 0x0f, 0xa7, 0x00, 0x36, 0x30,
+	0x3f, 0x62, 0xf9, 0x29, 0xc6, 0xff, 0x36, 0x52, 0x1e, 0xae, 0x3e, 0x89, 0xda, 0xe6, 0x93, 0x5b,
+	0x4b, 0xdd, 0x29, 0x12, 0xc2, 0x8d, 0x62, 0xa7, 0xeb, 0xe6, 0xe5, 0xd9, 0x52, 0xde, 0x79, 0xbe,
+	0x5f, 0xbb, 0x4c, 0xd8, 0x2b, 0xf8, 0x52, 0xc8, 0x3a, 0x03, 0xb0, 0xa1, 0x7f, 0x5f, 0x71, 0x57,
+	0xe4, 0x14, 0x67, 0x31, 0xe6, 0xc3, 0x76, 0x4a, 0x74, 0x27, 0xfa, 0xba, 0xea, 0xe1, 0xc1, 0xbd,
+	0x27, 0x52, 0xf8, 0x9a, 0xad, 0xec, 0x45, 0x6b, 0x9a, 0x7c, 0x55, 0x70, 0x3b, 0x9a, 0xca, 0xc7,
+	0x64, 0x7d, 0x05, 0x70, 0xed, 0x56, 0xa1, 0xb1, 0xaf, 0x6f, 0xb1, 0x1e, 0xee, 0xdf, 0xdb, 0x8f,
+	0x33, 0xc3, 0x8f, 0x91, 0xf5, 0xa2, 0xd5, 0x1b, 0x66, 0x0c, 0x1e, 0xee, 0x7e, 0x1f, 0x3b, 0xe0,
+	0x72, 0xec, 0x80, 0xab, 0xb1, 0x03, 0x7e, 0x8f, 0x1d, 0x70, 0x76, 0xed, 0x54, 0xae, 0xae, 0x9d,
+	0xca, 0x8f, 0x6b, 0xa7, 0xf2, 0xe9, 0xc5, 0xd4, 0xf3, 0xaf, 0xa9, 0x40, 0xdb, 0x5c, 0x90, 0x40,
+	0x92, 0x3e, 0xa2, 0xc1, 0x71, 0x50, 0xfe, 0x69, 0x19, 0x1b, 0xed, 0x79, 0xbd, 0xc3, 0x97, 0x7f,
+	0x07, 0x00, 0xba, 0x33, 0x28, 0xbf, 0xe2, 0x04, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -288,6 +294,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.DowntimeInactiveDuration != that1.DowntimeInactiveDuration {
 		return false
 	}
+	if !this.SlashFractionDoubleSign.Equal(that1.SlashFractionDoubleSign) {
+		return false
+	}
+	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -373,6 +385,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.SlashFractionDowntime.Size()
+		i -= size
+		if _, err := m.SlashFractionDowntime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SlashFractionDoubleSign.Size()
+		i -= size
+		if _, err := m.SlashFractionDoubleSign.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeInactiveDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeInactiveDuration):])
 	if err2 != nil {
 		return 0, err2
@@ -450,6 +482,10 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeInactiveDuration)
 	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDoubleSign.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDowntime.Size()
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -768,6 +804,72 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -6,7 +6,24 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
-// EndBlocker called every block, update validator set
+// EndBlocker called every block, release mature unbondings and redelegations, remove the validators jailed for too long
+// or whose exit notice period is over, end the uptime epoch and update validator set
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
+	if err := k.RemoveExpiredJailedValidators(ctx); err != nil {
+		panic(err)
+	}
+
+	if err := k.ProcessValidatorExits(ctx); err != nil {
+		panic(err)
+	}
+
+	if err := k.CompleteUnbondings(ctx); err != nil {
+		panic(err)
+	}
+
+	k.CompleteRedelegations(ctx)
+
+	k.ProcessUptimeEpoch(ctx)
+
 	return k.BlockValidatorUpdates(ctx)
 }
@@ -2,6 +2,7 @@ package staking_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/KiraCore/sekai/x/staking"
 
@@ -10,6 +11,7 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/KiraCore/sekai/simapp"
+	customgovtypes "github.com/KiraCore/sekai/x/gov/types"
 	customstakingtypes "github.com/KiraCore/sekai/x/staking/types"
 )
 
@@ -224,3 +226,117 @@ func TestItIncludesItBackToValidatorSetOnceReactivatingIt(t *testing.T) {
 		})
 	}
 }
+
+func TestItUpdatesTheValidatorPowerFollowingThePowerMode(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, types.TokensFromConsensusPower(10))
+	pubKeys := simapp.CreateTestPubKeys(2)
+
+	var validators []customstakingtypes.Validator
+	for i, addr := range addrs {
+		validator, err := customstakingtypes.NewValidator(
+			"validator",
+			"some-web.com",
+			"A Social",
+			"My Identity",
+			types.NewDec(1234),
+			types.ValAddress(addr),
+			pubKeys[i],
+		)
+		require.NoError(t, err)
+		app.CustomStakingKeeper.AddPendingValidator(ctx, validator)
+		validators = append(validators, validator)
+	}
+
+	// joining validators have the flat power
+	updates := staking.EndBlocker(ctx, app.CustomStakingKeeper)
+	require.Len(t, updates, 2)
+	for _, update := range updates {
+		require.Equal(t, int64(1), update.Power)
+	}
+
+	// nothing changes while the power stays the same
+	updates = staking.EndBlocker(ctx, app.CustomStakingKeeper)
+	require.Len(t, updates, 0)
+
+	// power follows the rank once the mode is changed
+	networkProperties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	networkProperties.ValidatorPowerMode = customgovtypes.PowerModeRank
+	app.CustomGovKeeper.SetNetworkProperties(ctx, networkProperties)
+
+	for i := 0; i < 9; i++ {
+		err := app.CustomStakingKeeper.HandleValidatorSignature(ctx, validators[0].ValKey, false)
+		require.NoError(t, err)
+	}
+
+	updates = staking.EndBlocker(ctx, app.CustomStakingKeeper)
+	require.Len(t, updates, 1)
+	require.Equal(t, int64(5), updates[0].Power)
+
+	power, found := app.CustomStakingKeeper.GetValidatorPower(ctx, validators[0].ValKey)
+	require.True(t, found)
+	require.Equal(t, int64(5), power)
+
+	// the power grows with the logarithm of the rank, it is unchanged until the rank reaches 16
+	err := app.CustomStakingKeeper.HandleValidatorSignature(ctx, validators[0].ValKey, false)
+	require.NoError(t, err)
+
+	updates = staking.EndBlocker(ctx, app.CustomStakingKeeper)
+	require.Len(t, updates, 0)
+
+	// the power of a single validator is capped to a share of the capped total power
+	networkProperties.MaxValidatorPowerPercent = 50
+	app.CustomGovKeeper.SetNetworkProperties(ctx, networkProperties)
+
+	updates = staking.EndBlocker(ctx, app.CustomStakingKeeper)
+	require.Len(t, updates, 1)
+	require.Equal(t, int64(1), updates[0].Power)
+
+	// removed validators do not keep their power
+	err = app.CustomStakingKeeper.Pause(ctx, validators[0].ValKey)
+	require.NoError(t, err)
+
+	updates = staking.EndBlocker(ctx, app.CustomStakingKeeper)
+	require.Len(t, updates, 1)
+	require.Equal(t, int64(0), updates[0].Power)
+
+	_, found = app.CustomStakingKeeper.GetValidatorPower(ctx, validators[0].ValKey)
+	require.False(t, found)
+}
+
+func TestItRemovesTheValidatorsJailedForTheJailMaxTime(t *testing.T) {
+	blockTime := time.Now()
+
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Time: blockTime})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, types.TokensFromConsensusPower(10))
+	valAddr1 := types.ValAddress(addrs[0])
+
+	pubKey, err := types.GetPubKeyFromBech32(types.Bech32PubKeyTypeConsPub, "kiravalconspub1zcjduepqylc5k8r40azmw0xt7hjugr4mr5w2am7jw77ux5w6s8hpjxyrjjsq4xg7em")
+	require.NoError(t, err)
+
+	validator1, err := customstakingtypes.NewValidator("validator 1", "some-web.com", "A Social", "My Identity", types.NewDec(1234), valAddr1, pubKey)
+	require.NoError(t, err)
+	app.CustomStakingKeeper.AddValidator(ctx, validator1)
+
+	err = app.CustomStakingKeeper.Jail(ctx, validator1.ValKey)
+	require.NoError(t, err)
+
+	updates := staking.EndBlocker(ctx, app.CustomStakingKeeper)
+	require.Len(t, updates, 1)
+
+	properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	properties.JailMaxTime = 10
+	app.CustomGovKeeper.SetNetworkProperties(ctx, properties)
+	ctx = ctx.WithBlockTime(blockTime.Add(time.Minute * time.Duration(properties.JailMaxTime)))
+	updates = staking.EndBlocker(ctx, app.CustomStakingKeeper)
+	require.Len(t, updates, 0)
+
+	validator, err := app.CustomStakingKeeper.GetValidator(ctx, validator1.ValKey)
+	require.NoError(t, err)
+	require.True(t, validator.IsRemoved())
+	require.Len(t, app.CustomStakingKeeper.GetJailedValidators(ctx), 0)
+}
@@ -110,3 +110,155 @@ func validateQueryValidatorFlags(flagSet *pflag.FlagSet) error {
 
 	return nil
 }
+
+// GetCmdQueryDelegations the query delegations of a delegator command.
+func GetCmdQueryDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegations [delegator-addr]",
+		Short: "Query the delegations of a delegator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &customstakingtypes.DelegationsRequest{Delegator: args[0], Pagination: pageReq}
+
+			queryClient := customstakingtypes.NewQueryClient(clientCtx)
+			res, err := queryClient.Delegations(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delegations")
+
+	return cmd
+}
+
+// GetCmdQueryValidatorDelegations the query delegations made to a validator command.
+func GetCmdQueryValidatorDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-delegations [validator-addr]",
+		Short: "Query the delegations made to a validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &customstakingtypes.ValidatorDelegationsRequest{ValAddr: args[0], Pagination: pageReq}
+
+			queryClient := customstakingtypes.NewQueryClient(clientCtx)
+			res, err := queryClient.ValidatorDelegations(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator delegations")
+
+	return cmd
+}
+
+// GetCmdQueryUnbondingDelegations the query unbonding delegations of a delegator command.
+func GetCmdQueryUnbondingDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-delegations [delegator-addr]",
+		Short: "Query the unbonding delegations of a delegator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &customstakingtypes.UnbondingDelegationsRequest{Delegator: args[0], Pagination: pageReq}
+
+			queryClient := customstakingtypes.NewQueryClient(clientCtx)
+			res, err := queryClient.UnbondingDelegations(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unbonding delegations")
+
+	return cmd
+}
+
+// GetCmdQueryStandbyValidators the query standby validators command.
+func GetCmdQueryStandbyValidators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "standby-validators",
+		Short: "Query the active validators waiting for a seat in the validator set",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := customstakingtypes.NewQueryClient(clientCtx)
+			res, err := queryClient.StandbyValidators(context.Background(), &customstakingtypes.StandbyValidatorsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorHistory the query uptime and rank history of a validator command.
+func GetCmdQueryValidatorHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-history [validator-addr]",
+		Short: "Query the blocks signed and missed, the rank and the status changes of a validator by epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &customstakingtypes.ValidatorHistoryRequest{ValAddr: args[0], Pagination: pageReq}
+
+			queryClient := customstakingtypes.NewQueryClient(clientCtx)
+			res, err := queryClient.ValidatorHistory(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator history")
+
+	return cmd
+}
@@ -110,3 +110,234 @@ func GetTxProposalUnjailValidatorCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetTxDelegateCmd implement cli command for MsgDelegate
+func GetTxDelegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate [validator-addr] [amount]",
+		Short: "Delegate ukex to a validator",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := types.ValAddressFromBech32(args[0])
+			if err != nil {
+				return errors.Wrap(err, "invalid validator address")
+			}
+
+			amount, ok := types.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[1])
+			}
+
+			msg := customstakingtypes.NewMsgDelegate(clientCtx.FromAddress, valAddr, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+// GetTxUndelegateCmd implement cli command for MsgUndelegate
+func GetTxUndelegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegate [validator-addr] [amount]",
+		Short: "Undelegate ukex from a validator, the tokens are returned after the unbonding time",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := types.ValAddressFromBech32(args[0])
+			if err != nil {
+				return errors.Wrap(err, "invalid validator address")
+			}
+
+			amount, ok := types.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[1])
+			}
+
+			msg := customstakingtypes.NewMsgUndelegate(clientCtx.FromAddress, valAddr, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+// GetTxRedelegateCmd implement cli command for MsgRedelegate
+func GetTxRedelegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redelegate [src-validator-addr] [dst-validator-addr] [amount]",
+		Short: "Move delegated ukex from a validator to another",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			srcValAddr, err := types.ValAddressFromBech32(args[0])
+			if err != nil {
+				return errors.Wrap(err, "invalid source validator address")
+			}
+
+			dstValAddr, err := types.ValAddressFromBech32(args[1])
+			if err != nil {
+				return errors.Wrap(err, "invalid destination validator address")
+			}
+
+			amount, ok := types.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[2])
+			}
+
+			msg := customstakingtypes.NewMsgRedelegate(clientCtx.FromAddress, srcValAddr, dstValAddr, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+// GetTxUnjailCmd implement cli command for MsgUnjail
+func GetTxUnjailCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unjail",
+		Short: "Unjail a validator once it has been jailed for the minimum jail time (the from address is the validator)",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := customstakingtypes.NewMsgUnjail(types.ValAddress(clientCtx.FromAddress))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+// GetTxEditValidatorCmd implement cli command for MsgEditValidator
+func GetTxEditValidatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit-validator",
+		Short: "Edit the profile and the commission of a validator, the flags not set are left unchanged (the from address is the validator)",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			moniker, _ := cmd.Flags().GetString(FlagMoniker)
+			website, _ := cmd.Flags().GetString(FlagWebsite)
+			social, _ := cmd.Flags().GetString(FlagSocial)
+			identity, _ := cmd.Flags().GetString(FlagIdentity)
+			comission, _ := cmd.Flags().GetString(FlagComission)
+
+			var comm *types.Dec
+			if comission != "" {
+				dec, err := types.NewDecFromStr(comission)
+				if err != nil {
+					return errors.Wrap(err, "invalid commission")
+				}
+				comm = &dec
+			}
+
+			msg := customstakingtypes.NewMsgEditValidator(types.ValAddress(clientCtx.FromAddress), moniker, website, social, identity, comm)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMoniker, "", "the new Moniker")
+	cmd.Flags().String(FlagWebsite, "", "the new Website")
+	cmd.Flags().String(FlagSocial, "", "the new social")
+	cmd.Flags().String(FlagIdentity, "", "the new Identity")
+	cmd.Flags().String(FlagComission, "", "the new commission")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+// GetTxRotateConsensusKeyCmd implement cli command for MsgRotateConsensusKey
+func GetTxRotateConsensusKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-consensus-key [pubkey]",
+		Short: "Replace the consensus key of a validator in the next block with a bech32 consensus public key (the from address is the validator)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valPubKey, err := types.GetPubKeyFromBech32(types.Bech32PubKeyTypeConsPub, args[0])
+			if err != nil {
+				return errors.Wrap(err, "failed to get consensus node public key")
+			}
+
+			msg, err := customstakingtypes.NewMsgRotateConsensusKey(types.ValAddress(clientCtx.FromAddress), valPubKey)
+			if err != nil {
+				return fmt.Errorf("error creating tx: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+// GetTxExitValidatorCmd implement cli command for MsgExitValidator
+func GetTxExitValidatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exit-validator",
+		Short: "Leave the validator set for good once the exit notice period is over, the delegations to the validator are unbonded (the from address is the validator)",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := customstakingtypes.NewMsgExitValidator(types.ValAddress(clientCtx.FromAddress))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
@@ -0,0 +1,180 @@
+package staking
+
+import (
+	"github.com/KiraCore/sekai/x/staking/keeper"
+	"github.com/KiraCore/sekai/x/staking/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// InitGenesis sets the validators and their delegations and returns the power of the active validators
+// holding a seat in the set, the last powers sent to tendermint and the standby queue are not part of the
+// genesis as they are derived from the validators.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genesisState types.GenesisState) []abci.ValidatorUpdate {
+	for _, val := range genesisState.Validators {
+		k.AddValidator(ctx, val)
+		k.AfterValidatorJoined(ctx, val.GetConsAddr(), val.ValKey)
+	}
+
+	for _, val := range genesisState.PendingValidators {
+		k.AddPendingValidator(ctx, val)
+	}
+
+	for _, valAddress := range genesisState.RemovingValidators {
+		k.AddRemovingValidator(ctx, mustGetValidator(ctx, k, valAddress))
+	}
+
+	for _, valAddress := range genesisState.ReactivatingValidators {
+		k.AddReactivatingValidator(ctx, mustGetValidator(ctx, k, valAddress))
+	}
+
+	for _, jail := range genesisState.JailedValidators {
+		k.SetValidatorJailInfo(ctx, jail.ValKey, jail.Info)
+	}
+
+	for _, bond := range genesisState.Bonds {
+		k.SetValidatorBond(ctx, bond)
+	}
+
+	for _, delegation := range genesisState.Delegations {
+		k.SetDelegation(ctx, delegation)
+	}
+
+	for _, unbonding := range genesisState.UnbondingDelegations {
+		k.AddUnbondingDelegation(ctx, unbonding)
+	}
+
+	if genesisState.NextUnbondingDelegationId != 0 {
+		k.SetNextUnbondingDelegationID(ctx, genesisState.NextUnbondingDelegationId)
+	}
+
+	for _, redelegation := range genesisState.Redelegations {
+		k.AddRedelegation(ctx, redelegation)
+	}
+
+	if genesisState.NextRedelegationId != 0 {
+		k.SetNextRedelegationID(ctx, genesisState.NextRedelegationId)
+	}
+
+	for _, rotation := range genesisState.ConsensusKeyRotations {
+		k.SetConsensusKeyRotation(ctx, rotation)
+	}
+
+	for _, exit := range genesisState.ValidatorExits {
+		k.SetValidatorExit(ctx, exit)
+	}
+
+	if genesisState.CurrentEpoch.Number != 0 {
+		k.SetCurrentUptimeEpoch(ctx, genesisState.CurrentEpoch)
+	}
+
+	for _, record := range genesisState.ValidatorEpochs {
+		k.SetValidatorEpoch(ctx, record)
+	}
+
+	activeVals, standbyVals := k.SplitValidatorSet(ctx)
+	for _, val := range standbyVals {
+		k.AddStandbyValidator(ctx, val.ValKey)
+	}
+
+	powers := k.ConsensusPowers(ctx, activeVals)
+
+	valUpdate := make([]abci.ValidatorUpdate, len(activeVals))
+	for i, val := range activeVals {
+		consPk, err := val.TmConsPubKey()
+		if err != nil {
+			panic(err)
+		}
+
+		valUpdate[i] = abci.ValidatorUpdate{
+			Power:  powers[i],
+			PubKey: consPk,
+		}
+		k.SetValidatorPower(ctx, val.ValKey, powers[i])
+	}
+
+	return valUpdate
+}
+
+// ExportGenesis returns the validators, the validator queues, the delegations and the uptime history
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	validators := k.GetValidatorSet(ctx)
+	if validators == nil {
+		validators = []types.Validator{}
+	}
+
+	pendingValidators := k.GetPendingValidatorSet(ctx)
+	if pendingValidators == nil {
+		pendingValidators = []types.Validator{}
+	}
+
+	currentEpoch, _ := k.GetCurrentUptimeEpoch(ctx)
+
+	return &types.GenesisState{
+		Validators:                validators,
+		PendingValidators:         pendingValidators,
+		RemovingValidators:        toValAddresses(k.GetRemovingValidatorSet(ctx)),
+		ReactivatingValidators:    toValAddresses(k.GetReactivatingValidatorSet(ctx)),
+		JailedValidators:          k.GetJailedValidators(ctx),
+		Bonds:                     k.GetValidatorBonds(ctx),
+		Delegations:               k.GetAllDelegations(ctx),
+		UnbondingDelegations:      k.GetUnbondingDelegations(ctx),
+		NextUnbondingDelegationId: k.GetNextUnbondingDelegationID(ctx),
+		ConsensusKeyRotations:     k.GetConsensusKeyRotations(ctx),
+		ValidatorExits:            k.GetValidatorExits(ctx),
+		CurrentEpoch:              currentEpoch,
+		ValidatorEpochs:           k.GetAllValidatorEpochs(ctx),
+		Redelegations:             k.GetRedelegations(ctx),
+		NextRedelegationId:        k.GetNextRedelegationID(ctx),
+	}
+}
+
+// WriteValidators returns the active validators with the last power sent to tendermint
+func WriteValidators(ctx sdk.Context, k keeper.Keeper) ([]tmtypes.GenesisValidator, error) {
+	var vals []tmtypes.GenesisValidator
+	for _, validator := range k.GetActiveValidatorSet(ctx) {
+		power, found := k.GetValidatorPower(ctx, validator.ValKey)
+		if !found {
+			continue
+		}
+
+		pk, err := validator.ConsPubKey()
+		if err != nil {
+			return nil, err
+		}
+
+		tmPk, err := cryptocodec.ToTmPubKeyInterface(pk)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, tmtypes.GenesisValidator{
+			Address: sdk.ConsAddress(tmPk.Address()).Bytes(),
+			PubKey:  tmPk,
+			Power:   power,
+			Name:    validator.Moniker,
+		})
+	}
+
+	return vals, nil
+}
+
+func mustGetValidator(ctx sdk.Context, k keeper.Keeper, valAddress sdk.ValAddress) types.Validator {
+	validator, err := k.GetValidator(ctx, valAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return validator
+}
+
+func toValAddresses(keys [][]byte) []sdk.ValAddress {
+	addresses := []sdk.ValAddress{}
+	for _, key := range keys {
+		addresses = append(addresses, key)
+	}
+
+	return addresses
+}
@@ -0,0 +1,104 @@
+package staking_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/staking"
+	customstakingtypes "github.com/KiraCore/sekai/x/staking/types"
+)
+
+func TestExportInitGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 1, Time: time.Unix(1600000000, 0).UTC()})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, types.TokensFromConsensusPower(10))
+
+	var validators []customstakingtypes.Validator
+	for i, addr := range addrs {
+		validator, err := customstakingtypes.NewValidator(
+			fmt.Sprintf("validator %d", i),
+			"some-web.com",
+			"A Social",
+			"My Identity",
+			types.NewDec(1234),
+			types.ValAddress(addr),
+			ed25519.GenPrivKey().PubKey(),
+		)
+		require.NoError(t, err)
+		validators = append(validators, validator)
+	}
+
+	staking.InitGenesis(ctx, app.CustomStakingKeeper, customstakingtypes.GenesisState{
+		Validators: validators[:2],
+	})
+	app.CustomStakingKeeper.AddPendingValidator(ctx, validators[2])
+	require.NoError(t, app.CustomStakingKeeper.Jail(ctx, validators[1].ValKey))
+
+	require.NoError(t, app.CustomStakingKeeper.Delegate(ctx, addrs[2], validators[0].ValKey, types.NewInt(1000)))
+	_, err := app.CustomStakingKeeper.Undelegate(ctx, addrs[2], validators[0].ValKey, types.NewInt(400))
+	require.NoError(t, err)
+
+	cdc := app.AppCodec()
+	exported := cdc.MustMarshalJSON(staking.ExportGenesis(ctx, app.CustomStakingKeeper))
+
+	var genesisState customstakingtypes.GenesisState
+	cdc.MustUnmarshalJSON(exported, &genesisState)
+	require.Len(t, genesisState.Validators, 2)
+	require.Len(t, genesisState.PendingValidators, 1)
+	require.Equal(t, []types.ValAddress{validators[1].ValKey}, genesisState.RemovingValidators)
+	require.Len(t, genesisState.JailedValidators, 1)
+	require.Len(t, genesisState.Bonds, 1)
+	require.Len(t, genesisState.Delegations, 1)
+	require.Len(t, genesisState.UnbondingDelegations, 1)
+	require.Equal(t, uint64(2), genesisState.NextUnbondingDelegationId)
+
+	// a fresh chain started from the exported genesis exports the same genesis
+	newApp := simapp.Setup(false)
+	newCtx := newApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Unix(1600000000, 0).UTC()})
+	updates := staking.InitGenesis(newCtx, newApp.CustomStakingKeeper, genesisState)
+	require.Len(t, updates, 1)
+
+	reexported := cdc.MustMarshalJSON(staking.ExportGenesis(newCtx, newApp.CustomStakingKeeper))
+	require.Equal(t, string(exported), string(reexported))
+}
+
+func TestInitGenesis_MaxValidators(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 1, Time: time.Unix(1600000000, 0).UTC()})
+
+	properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	properties.MaxValidators = 2
+	app.CustomGovKeeper.SetNetworkProperties(ctx, properties)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, types.TokensFromConsensusPower(10))
+
+	var validators []customstakingtypes.Validator
+	for i, addr := range addrs {
+		validator, err := customstakingtypes.NewValidator(fmt.Sprintf("validator %d", i), "some-web.com", "A Social", "My Identity", types.NewDec(1234), types.ValAddress(addr), ed25519.GenPrivKey().PubKey())
+		require.NoError(t, err)
+		validator.Rank = int64(i)
+		validators = append(validators, validator)
+	}
+
+	// only the highest ranked validators get a seat, the other one waits in the standby queue
+	updates := staking.InitGenesis(ctx, app.CustomStakingKeeper, customstakingtypes.GenesisState{
+		Validators: validators,
+	})
+	require.Len(t, updates, 2)
+
+	standbyVals := app.CustomStakingKeeper.GetStandbyValidators(ctx)
+	require.Len(t, standbyVals, 1)
+	require.Equal(t, validators[0].ValKey, standbyVals[0].ValKey)
+
+	genesisVals, err := staking.WriteValidators(ctx, app.CustomStakingKeeper)
+	require.NoError(t, err)
+	require.Len(t, genesisVals, 2)
+}
@@ -255,6 +255,10 @@ func TestHandler_ProposalUnjailValidator(t *testing.T) {
 	err = app.CustomStakingKeeper.Jail(ctx, val.ValKey)
 	require.NoError(t, err)
 
+	// the validator can be unjailed at any time when JAIL_MAX_TIME is 0
+	require.Equal(t, uint64(0), properties.JailMaxTime)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour * 24 * 30))
+
 	handler := staking.NewHandler(app.CustomStakingKeeper, app.CustomGovKeeper)
 	_, err = handler(
 		ctx,
@@ -281,6 +285,7 @@ func TestHandler_ProposalUnjailValidator(t *testing.T) {
 		ctx.BlockTime().Add(time.Minute*time.Duration(properties.ProposalEnactmentTime)),
 	)
 	require.NoError(t, err)
+	expectedSavedProposal.Proposer = proposerAddr
 	require.Equal(t, expectedSavedProposal, savedProposal)
 
 	// Next proposal ID is increased.
@@ -298,3 +303,94 @@ func TestHandler_ProposalUnjailValidator(t *testing.T) {
 }
 
 // TODO: should add more tests for various types of cases by network properties status
+
+func TestHandler_Unjail(t *testing.T) {
+	valAddr, err := types.ValAddressFromBech32("kiravaloper15ky9du8a2wlstz6fpx3p4mqpjyrm5cgq38f2fp")
+	require.NoError(t, err)
+
+	pubKey, err := types.GetPubKeyFromBech32(types.Bech32PubKeyTypeConsPub, "kiravalconspub1zcjduepqylc5k8r40azmw0xt7hjugr4mr5w2am7jw77ux5w6s8hpjxyrjjsq4xg7em")
+	require.NoError(t, err)
+
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{
+		Time: time.Now(),
+	})
+
+	val, err := customstakingtypes.NewValidator("Moniker", "Website", "Social", "identity", types.NewDec(123), valAddr, pubKey)
+	require.NoError(t, err)
+	app.CustomStakingKeeper.AddValidator(ctx, val)
+
+	err = app.CustomStakingKeeper.Jail(ctx, val.ValKey)
+	require.NoError(t, err)
+
+	handler := staking.NewHandler(app.CustomStakingKeeper, app.CustomGovKeeper)
+	_, err = handler(ctx, customstakingtypes.NewMsgUnjail(valAddr))
+	require.True(t, customstakingtypes.ErrMinJailTimeNotPassed.Is(err))
+
+	// After the minimum jail time
+	properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute * time.Duration(properties.MinJailTime)))
+	res, err := handler(ctx, customstakingtypes.NewMsgUnjail(valAddr))
+	require.NoError(t, err)
+	require.Equal(t, customstakingtypes.EventTypeUnjail, res.Events[0].Type)
+
+	validator, err := app.CustomStakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.True(t, validator.IsActive())
+}
+
+func TestHandler_EditValidator(t *testing.T) {
+	valAddr, err := types.ValAddressFromBech32("kiravaloper15ky9du8a2wlstz6fpx3p4mqpjyrm5cgq38f2fp")
+	require.NoError(t, err)
+
+	pubKey, err := types.GetPubKeyFromBech32(types.Bech32PubKeyTypeConsPub, "kiravalconspub1zcjduepqylc5k8r40azmw0xt7hjugr4mr5w2am7jw77ux5w6s8hpjxyrjjsq4xg7em")
+	require.NoError(t, err)
+
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{
+		Time: time.Now(),
+	})
+
+	val, err := customstakingtypes.NewValidator("Moniker", "Website", "Social", "identity", types.NewDecWithPrec(10, 2), valAddr, pubKey)
+	require.NoError(t, err)
+	app.CustomStakingKeeper.AddValidator(ctx, val)
+
+	commission := types.NewDecWithPrec(12, 2)
+	handler := staking.NewHandler(app.CustomStakingKeeper, app.CustomGovKeeper)
+	res, err := handler(ctx, customstakingtypes.NewMsgEditValidator(valAddr, "New Moniker", "", "", "", &commission))
+	require.NoError(t, err)
+	require.Equal(t, customstakingtypes.EventTypeEditValidator, res.Events[0].Type)
+
+	validator, err := app.CustomStakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, "New Moniker", validator.Moniker)
+	require.Equal(t, commission, validator.Commission)
+}
+
+func TestHandler_ExitValidator(t *testing.T) {
+	valAddr, err := types.ValAddressFromBech32("kiravaloper15ky9du8a2wlstz6fpx3p4mqpjyrm5cgq38f2fp")
+	require.NoError(t, err)
+
+	pubKey, err := types.GetPubKeyFromBech32(types.Bech32PubKeyTypeConsPub, "kiravalconspub1zcjduepqylc5k8r40azmw0xt7hjugr4mr5w2am7jw77ux5w6s8hpjxyrjjsq4xg7em")
+	require.NoError(t, err)
+
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{
+		Time: time.Now(),
+	})
+
+	val, err := customstakingtypes.NewValidator("Moniker", "Website", "Social", "identity", types.NewDecWithPrec(10, 2), valAddr, pubKey)
+	require.NoError(t, err)
+	app.CustomStakingKeeper.AddValidator(ctx, val)
+
+	handler := staking.NewHandler(app.CustomStakingKeeper, app.CustomGovKeeper)
+	res, err := handler(ctx, customstakingtypes.NewMsgExitValidator(valAddr))
+	require.NoError(t, err)
+	require.Equal(t, customstakingtypes.EventTypeExitValidator, res.Events[0].Type)
+
+	_, found := app.CustomStakingKeeper.GetValidatorExit(ctx, valAddr)
+	require.True(t, found)
+
+	_, err = handler(ctx, customstakingtypes.NewMsgExitValidator(valAddr))
+	require.Error(t, err)
+}
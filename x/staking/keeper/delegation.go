@@ -0,0 +1,326 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/KiraCore/sekai/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetValidatorBond returns the tokens bonded to the validator, an empty bond is returned
+// for validators without delegations.
+func (k Keeper) GetValidatorBond(ctx sdk.Context, valAddress sdk.ValAddress) types.ValidatorBond {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(GetValidatorBondKey(valAddress))
+	if bz == nil {
+		return types.NewValidatorBond(valAddress)
+	}
+
+	var bond types.ValidatorBond
+	k.cdc.MustUnmarshalBinaryBare(bz, &bond)
+
+	return bond
+}
+
+// SetValidatorBond stores the tokens bonded to the validator.
+func (k Keeper) SetValidatorBond(ctx sdk.Context, bond types.ValidatorBond) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetValidatorBondKey(bond.ValKey), k.cdc.MustMarshalBinaryBare(&bond))
+}
+
+// GetDelegation returns the delegation of the delegator to the validator.
+func (k Keeper) GetDelegation(ctx sdk.Context, delegator sdk.AccAddress, valAddress sdk.ValAddress) (types.Delegation, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(GetDelegationKey(delegator, valAddress))
+	if bz == nil {
+		return types.Delegation{}, false
+	}
+
+	var delegation types.Delegation
+	k.cdc.MustUnmarshalBinaryBare(bz, &delegation)
+
+	return delegation, true
+}
+
+// SetDelegation stores the delegation and indexes it by validator.
+func (k Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetDelegationKey(delegation.Delegator, delegation.ValKey), k.cdc.MustMarshalBinaryBare(&delegation))
+	store.Set(GetDelegationByValidatorIndexKey(delegation.ValKey, delegation.Delegator), delegation.Delegator)
+}
+
+func (k Keeper) removeDelegation(ctx sdk.Context, delegation types.Delegation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetDelegationKey(delegation.Delegator, delegation.ValKey))
+	store.Delete(GetDelegationByValidatorIndexKey(delegation.ValKey, delegation.Delegator))
+}
+
+// GetValidatorBonds returns the bonds of all the validators with delegations.
+func (k Keeper) GetValidatorBonds(ctx sdk.Context) []types.ValidatorBond {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, ValidatorBondKey)
+	defer iter.Close()
+
+	bonds := []types.ValidatorBond{}
+	for ; iter.Valid(); iter.Next() {
+		var bond types.ValidatorBond
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &bond)
+		bonds = append(bonds, bond)
+	}
+
+	return bonds
+}
+
+// GetAllDelegations returns the delegations of all the delegators.
+func (k Keeper) GetAllDelegations(ctx sdk.Context) []types.Delegation {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, DelegationKey)
+	defer iter.Close()
+
+	delegations := []types.Delegation{}
+	for ; iter.Valid(); iter.Next() {
+		var delegation types.Delegation
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &delegation)
+		delegations = append(delegations, delegation)
+	}
+
+	return delegations
+}
+
+// GetDelegatorDelegations returns all the delegations of a delegator.
+func (k Keeper) GetDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress) []types.Delegation {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, GetDelegationsKey(delegator))
+	defer iter.Close()
+
+	var delegations []types.Delegation
+	for ; iter.Valid(); iter.Next() {
+		var delegation types.Delegation
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &delegation)
+		delegations = append(delegations, delegation)
+	}
+
+	return delegations
+}
+
+// GetValidatorDelegations returns all the delegations made to a validator.
+func (k Keeper) GetValidatorDelegations(ctx sdk.Context, valAddress sdk.ValAddress) []types.Delegation {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, GetValidatorDelegationsKey(valAddress))
+	defer iter.Close()
+
+	var delegations []types.Delegation
+	for ; iter.Valid(); iter.Next() {
+		delegation, found := k.GetDelegation(ctx, iter.Value(), valAddress)
+		if found {
+			delegations = append(delegations, delegation)
+		}
+	}
+
+	return delegations
+}
+
+// GetDelegationBalance returns the amount of tokens the delegation shares are worth.
+func (k Keeper) GetDelegationBalance(ctx sdk.Context, delegation types.Delegation) sdk.Int {
+	return k.GetValidatorBond(ctx, delegation.ValKey).TokensFromShares(delegation.Shares).TruncateInt()
+}
+
+// GetDelegatorBondedTokens returns the amount of tokens all the delegations of a delegator are worth.
+func (k Keeper) GetDelegatorBondedTokens(ctx sdk.Context, delegator sdk.AccAddress) sdk.Int {
+	tokens := sdk.ZeroInt()
+	for _, delegation := range k.GetDelegatorDelegations(ctx, delegator) {
+		tokens = tokens.Add(k.GetDelegationBalance(ctx, delegation))
+	}
+
+	return tokens
+}
+
+// Delegate escrows the tokens of the delegator in the bonded pool and issues validator shares for them.
+func (k Keeper) Delegate(ctx sdk.Context, delegator sdk.AccAddress, valAddress sdk.ValAddress, amount sdk.Int) error {
+	validator, err := k.GetValidator(ctx, valAddress)
+	if err != nil {
+		return err
+	}
+
+	if validator.IsRemoved() {
+		return types.ErrValidatorRemoved
+	}
+
+	if _, found := k.GetValidatorExit(ctx, valAddress); found {
+		return types.ErrValidatorExiting
+	}
+
+	if k.GetValidatorBond(ctx, valAddress).IsDepleted() {
+		return types.ErrValidatorBondDepleted
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), amount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delegator, types.BondedPoolName, coins); err != nil {
+		return err
+	}
+
+	k.bond(ctx, delegator, valAddress, amount)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDelegate,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// Undelegate removes the delegation shares worth the amount and moves the tokens to the not bonded pool,
+// the tokens are returned to the delegator once the unbonding time is over.
+func (k Keeper) Undelegate(ctx sdk.Context, delegator sdk.AccAddress, valAddress sdk.ValAddress, amount sdk.Int) (time.Time, error) {
+	if err := k.unbond(ctx, delegator, valAddress, amount); err != nil {
+		return time.Time{}, err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), amount))
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.BondedPoolName, types.NotBondedPoolName, coins); err != nil {
+		return time.Time{}, err
+	}
+
+	properties := k.govkeeper.GetNetworkProperties(ctx)
+	completionTime := ctx.BlockTime().Add(time.Duration(properties.UnbondingTime) * time.Second)
+
+	unbonding := types.NewUnbondingDelegation(k.getNextUnbondingDelegationID(ctx), delegator, valAddress, ctx.BlockHeight(), completionTime, amount)
+	k.AddUnbondingDelegation(ctx, unbonding)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnbond,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	)
+
+	return completionTime, nil
+}
+
+// Redelegate moves bonded tokens of the delegator from a validator to another, the tokens stay in
+// the bonded pool so the move is immediate. The move is recorded until the unbonding time is over so
+// that the tokens are still slashed for the infractions the source validator committed before it.
+func (k Keeper) Redelegate(ctx sdk.Context, delegator sdk.AccAddress, srcValAddress, dstValAddress sdk.ValAddress, amount sdk.Int) error {
+	if srcValAddress.Equals(dstValAddress) {
+		return types.ErrSelfRedelegation
+	}
+
+	dstValidator, err := k.GetValidator(ctx, dstValAddress)
+	if err != nil {
+		return err
+	}
+
+	if dstValidator.IsRemoved() {
+		return types.ErrValidatorRemoved
+	}
+
+	if _, found := k.GetValidatorExit(ctx, dstValAddress); found {
+		return types.ErrValidatorExiting
+	}
+
+	if k.GetValidatorBond(ctx, dstValAddress).IsDepleted() {
+		return types.ErrValidatorBondDepleted
+	}
+
+	// tokens redelegated to the source validator can not move again before their record completes
+	if k.hasReceivingRedelegation(ctx, delegator, srcValAddress) {
+		return types.ErrTransitiveRedelegation
+	}
+
+	if err := k.unbond(ctx, delegator, srcValAddress, amount); err != nil {
+		return err
+	}
+
+	k.bond(ctx, delegator, dstValAddress, amount)
+
+	properties := k.govkeeper.GetNetworkProperties(ctx)
+	completionTime := ctx.BlockTime().Add(time.Duration(properties.UnbondingTime) * time.Second)
+
+	redelegation := types.NewRedelegation(k.getNextRedelegationID(ctx), delegator, srcValAddress, dstValAddress, ctx.BlockHeight(), completionTime, amount)
+	k.AddRedelegation(ctx, redelegation)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRedelegate,
+			sdk.NewAttribute(types.AttributeKeySrcValidator, srcValAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyDstValidator, dstValAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	)
+
+	return nil
+}
+
+// bond adds the tokens to the validator bond and the issued shares to the delegation.
+func (k Keeper) bond(ctx sdk.Context, delegator sdk.AccAddress, valAddress sdk.ValAddress, amount sdk.Int) {
+	k.BeforeDelegationSharesModified(ctx, delegator, valAddress)
+
+	bond := k.GetValidatorBond(ctx, valAddress)
+	shares := bond.SharesFromTokens(amount)
+	bond.Tokens = bond.Tokens.Add(amount)
+	bond.DelegatorShares = bond.DelegatorShares.Add(shares)
+	k.SetValidatorBond(ctx, bond)
+
+	delegation, found := k.GetDelegation(ctx, delegator, valAddress)
+	if !found {
+		delegation = types.NewDelegation(delegator, valAddress)
+	}
+
+	delegation.Shares = delegation.Shares.Add(shares)
+	k.SetDelegation(ctx, delegation)
+	k.AfterDelegationModified(ctx, delegator, valAddress)
+}
+
+// unbond removes the shares worth the amount from the delegation and the tokens from the validator bond.
+func (k Keeper) unbond(ctx sdk.Context, delegator sdk.AccAddress, valAddress sdk.ValAddress, amount sdk.Int) error {
+	delegation, found := k.GetDelegation(ctx, delegator, valAddress)
+	if !found {
+		return types.ErrDelegationNotFound
+	}
+
+	bond := k.GetValidatorBond(ctx, valAddress)
+	if bond.IsDepleted() {
+		return types.ErrValidatorBondDepleted
+	}
+
+	balance := bond.TokensFromShares(delegation.Shares).TruncateInt()
+	if amount.GT(balance) {
+		return types.ErrInsufficientDelegation
+	}
+
+	shares := bond.SharesFromTokens(amount)
+	if amount.Equal(balance) || shares.GT(delegation.Shares) {
+		shares = delegation.Shares
+	}
+
+	k.BeforeDelegationSharesModified(ctx, delegator, valAddress)
+
+	bond.Tokens = bond.Tokens.Sub(amount)
+	bond.DelegatorShares = bond.DelegatorShares.Sub(shares)
+	k.SetValidatorBond(ctx, bond)
+
+	delegation.Shares = delegation.Shares.Sub(shares)
+	if delegation.Shares.IsZero() {
+		k.removeDelegation(ctx, delegation)
+	} else {
+		k.SetDelegation(ctx, delegation)
+	}
+	k.AfterDelegationModified(ctx, delegator, valAddress)
+
+	return nil
+}
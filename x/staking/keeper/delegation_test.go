@@ -0,0 +1,254 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/staking"
+	"github.com/KiraCore/sekai/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestDelegateAndUndelegate(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+
+	validators := createValidators(t, app, ctx, 1)
+	validator := validators[0]
+	app.CustomStakingKeeper.AddValidator(ctx, validator)
+
+	// the validator self-bonds from its own account
+	delegator := sdk.AccAddress(validator.ValKey)
+	initialBalance := app.BankKeeper.GetBalance(ctx, delegator, "ukex").Amount
+
+	err := app.CustomStakingKeeper.Delegate(ctx, delegator, validator.ValKey, sdk.NewInt(1000))
+	require.NoError(t, err)
+
+	delegation, found := app.CustomStakingKeeper.GetDelegation(ctx, delegator, validator.ValKey)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(1000), app.CustomStakingKeeper.GetDelegationBalance(ctx, delegation))
+	require.Equal(t, sdk.NewInt(1000), app.CustomStakingKeeper.GetValidatorBond(ctx, validator.ValKey).Tokens)
+	require.Equal(t, initialBalance.SubRaw(1000), app.BankKeeper.GetBalance(ctx, delegator, "ukex").Amount)
+	require.Equal(t, sdk.NewInt(1000), app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(types.BondedPoolName), "ukex").Amount)
+	require.Len(t, app.CustomStakingKeeper.GetValidatorDelegations(ctx, validator.ValKey), 1)
+
+	completionTime, err := app.CustomStakingKeeper.Undelegate(ctx, delegator, validator.ValKey, sdk.NewInt(400))
+	require.NoError(t, err)
+
+	properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	require.Equal(t, ctx.BlockTime().Add(time.Duration(properties.UnbondingTime)*time.Second), completionTime)
+
+	delegation, found = app.CustomStakingKeeper.GetDelegation(ctx, delegator, validator.ValKey)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(600), app.CustomStakingKeeper.GetDelegationBalance(ctx, delegation))
+	require.Equal(t, sdk.NewInt(400), app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(types.NotBondedPoolName), "ukex").Amount)
+
+	unbondings := app.CustomStakingKeeper.GetDelegatorUnbondingDelegations(ctx, delegator)
+	require.Len(t, unbondings, 1)
+	require.Equal(t, sdk.NewInt(400), unbondings[0].Balance)
+
+	// tokens are still locked before the unbonding time is over
+	staking.EndBlocker(ctx, app.CustomStakingKeeper)
+	require.Len(t, app.CustomStakingKeeper.GetDelegatorUnbondingDelegations(ctx, delegator), 1)
+	require.Equal(t, initialBalance.SubRaw(1000), app.BankKeeper.GetBalance(ctx, delegator, "ukex").Amount)
+
+	ctx = ctx.WithBlockTime(completionTime)
+	staking.EndBlocker(ctx, app.CustomStakingKeeper)
+	require.Len(t, app.CustomStakingKeeper.GetDelegatorUnbondingDelegations(ctx, delegator), 0)
+	require.Equal(t, initialBalance.SubRaw(600), app.BankKeeper.GetBalance(ctx, delegator, "ukex").Amount)
+
+	// undelegating the whole balance removes the delegation
+	_, err = app.CustomStakingKeeper.Undelegate(ctx, delegator, validator.ValKey, sdk.NewInt(600))
+	require.NoError(t, err)
+
+	_, found = app.CustomStakingKeeper.GetDelegation(ctx, delegator, validator.ValKey)
+	require.False(t, found)
+	require.Len(t, app.CustomStakingKeeper.GetValidatorDelegations(ctx, validator.ValKey), 0)
+}
+
+func TestDelegation_Errors(t *testing.T) {
+	tests := []struct {
+		name          string
+		expectedError error
+		execute       func(app *simapp.SimApp, ctx sdk.Context, delegator sdk.AccAddress, validator types.Validator) error
+	}{
+		{
+			name:          "delegate to an unknown validator",
+			expectedError: fmt.Errorf("validator not found"),
+			execute: func(app *simapp.SimApp, ctx sdk.Context, delegator sdk.AccAddress, validator types.Validator) error {
+				return app.CustomStakingKeeper.Delegate(ctx, delegator, sdk.ValAddress("unknown"), sdk.NewInt(100))
+			},
+		},
+		{
+			name:          "undelegate without delegation",
+			expectedError: types.ErrDelegationNotFound,
+			execute: func(app *simapp.SimApp, ctx sdk.Context, delegator sdk.AccAddress, validator types.Validator) error {
+				_, err := app.CustomStakingKeeper.Undelegate(ctx, delegator, validator.ValKey, sdk.NewInt(100))
+				return err
+			},
+		},
+		{
+			name:          "undelegate more than delegated",
+			expectedError: types.ErrInsufficientDelegation,
+			execute: func(app *simapp.SimApp, ctx sdk.Context, delegator sdk.AccAddress, validator types.Validator) error {
+				err := app.CustomStakingKeeper.Delegate(ctx, delegator, validator.ValKey, sdk.NewInt(100))
+				if err != nil {
+					return err
+				}
+
+				_, err = app.CustomStakingKeeper.Undelegate(ctx, delegator, validator.ValKey, sdk.NewInt(101))
+				return err
+			},
+		},
+		{
+			name:          "redelegate to the same validator",
+			expectedError: types.ErrSelfRedelegation,
+			execute: func(app *simapp.SimApp, ctx sdk.Context, delegator sdk.AccAddress, validator types.Validator) error {
+				return app.CustomStakingKeeper.Redelegate(ctx, delegator, validator.ValKey, validator.ValKey, sdk.NewInt(100))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			ctx := app.NewContext(false, tmproto.Header{})
+
+			validators := createValidators(t, app, ctx, 1)
+			app.CustomStakingKeeper.AddValidator(ctx, validators[0])
+
+			err := tt.execute(app, ctx, sdk.AccAddress(validators[0].ValKey), validators[0])
+			require.EqualError(t, err, tt.expectedError.Error())
+		})
+	}
+}
+
+func TestRedelegate(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	validators := createValidators(t, app, ctx, 2)
+	app.CustomStakingKeeper.AddValidator(ctx, validators[0])
+	app.CustomStakingKeeper.AddValidator(ctx, validators[1])
+
+	delegator := sdk.AccAddress(validators[0].ValKey)
+	err := app.CustomStakingKeeper.Delegate(ctx, delegator, validators[0].ValKey, sdk.NewInt(1000))
+	require.NoError(t, err)
+
+	err = app.CustomStakingKeeper.Redelegate(ctx, delegator, validators[0].ValKey, validators[1].ValKey, sdk.NewInt(300))
+	require.NoError(t, err)
+
+	require.Equal(t, sdk.NewInt(700), app.CustomStakingKeeper.GetValidatorBond(ctx, validators[0].ValKey).Tokens)
+	require.Equal(t, sdk.NewInt(300), app.CustomStakingKeeper.GetValidatorBond(ctx, validators[1].ValKey).Tokens)
+	require.Len(t, app.CustomStakingKeeper.GetDelegatorDelegations(ctx, delegator), 2)
+
+	// the tokens stay bonded
+	require.Equal(t, sdk.NewInt(1000), app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(types.BondedPoolName), "ukex").Amount)
+
+	// the move is recorded until the unbonding time is over
+	redelegations := app.CustomStakingKeeper.GetDelegatorRedelegations(ctx, delegator)
+	require.Len(t, redelegations, 1)
+	require.Equal(t, validators[0].ValKey, redelegations[0].SrcValKey)
+	require.Equal(t, validators[1].ValKey, redelegations[0].DstValKey)
+	require.Equal(t, sdk.NewInt(300), redelegations[0].InitialBalance)
+
+	// redelegated tokens can not move again before the record completes
+	err = app.CustomStakingKeeper.Redelegate(ctx, delegator, validators[1].ValKey, validators[0].ValKey, sdk.NewInt(100))
+	require.True(t, types.ErrTransitiveRedelegation.Is(err))
+
+	ctx = ctx.WithBlockTime(redelegations[0].CompletionTime)
+	staking.EndBlocker(ctx, app.CustomStakingKeeper)
+	require.Len(t, app.CustomStakingKeeper.GetRedelegations(ctx), 0)
+
+	err = app.CustomStakingKeeper.Redelegate(ctx, delegator, validators[1].ValKey, validators[0].ValKey, sdk.NewInt(100))
+	require.NoError(t, err)
+}
+
+func TestSlashRedelegation(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 10, Time: time.Now().UTC()})
+
+	validators := createValidators(t, app, ctx, 2)
+	app.CustomStakingKeeper.AddValidator(ctx, validators[0])
+	app.CustomStakingKeeper.AddValidator(ctx, validators[1])
+
+	delegator := sdk.AccAddress(validators[1].ValKey)
+	require.NoError(t, app.CustomStakingKeeper.Delegate(ctx, delegator, validators[0].ValKey, sdk.NewInt(1000)))
+
+	// redelegated after the infraction, the tokens are slashed at the destination validator
+	err := app.CustomStakingKeeper.Redelegate(ctx, delegator, validators[0].ValKey, validators[1].ValKey, sdk.NewInt(400))
+	require.NoError(t, err)
+
+	supplyBefore := app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf("ukex")
+
+	burned := app.CustomStakingKeeper.Slash(ctx, validators[0].ValKey, 5, sdk.NewDecWithPrec(1, 1))
+	require.Equal(t, sdk.NewInt(100), burned)
+	require.Equal(t, supplyBefore.Sub(burned), app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf("ukex"))
+
+	require.Equal(t, sdk.NewInt(540), app.CustomStakingKeeper.GetValidatorBond(ctx, validators[0].ValKey).Tokens)
+	require.Equal(t, sdk.NewInt(360), app.CustomStakingKeeper.GetValidatorBond(ctx, validators[1].ValKey).Tokens)
+
+	delegation, _ := app.CustomStakingKeeper.GetDelegation(ctx, delegator, validators[1].ValKey)
+	require.Equal(t, sdk.NewInt(360), app.CustomStakingKeeper.GetDelegationBalance(ctx, delegation))
+
+	// redelegations made before the infraction are not slashed
+	burned = app.CustomStakingKeeper.Slash(ctx, validators[0].ValKey, 11, sdk.NewDecWithPrec(1, 1))
+	require.Equal(t, sdk.NewInt(54), burned)
+	require.Equal(t, sdk.NewInt(360), app.CustomStakingKeeper.GetValidatorBond(ctx, validators[1].ValKey).Tokens)
+
+	// completed redelegations are not slashed
+	ctx = ctx.WithBlockTime(app.CustomStakingKeeper.GetRedelegations(ctx)[0].CompletionTime)
+	staking.EndBlocker(ctx, app.CustomStakingKeeper)
+
+	burned = app.CustomStakingKeeper.Slash(ctx, validators[0].ValKey, 5, sdk.NewDecWithPrec(1, 1))
+	require.Equal(t, sdk.NewInt(48), burned)
+	require.Equal(t, sdk.NewInt(360), app.CustomStakingKeeper.GetValidatorBond(ctx, validators[1].ValKey).Tokens)
+}
+
+func TestSlashValidatorBond(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 10})
+
+	validators := createValidators(t, app, ctx, 2)
+	validator := validators[0]
+	app.CustomStakingKeeper.AddValidator(ctx, validator)
+
+	selfDelegator := sdk.AccAddress(validator.ValKey)
+	delegator := sdk.AccAddress(validators[1].ValKey)
+	require.NoError(t, app.CustomStakingKeeper.Delegate(ctx, selfDelegator, validator.ValKey, sdk.NewInt(1000)))
+	require.NoError(t, app.CustomStakingKeeper.Delegate(ctx, delegator, validator.ValKey, sdk.NewInt(1000)))
+
+	// started unbonding after the infraction, it is still slashed
+	_, err := app.CustomStakingKeeper.Undelegate(ctx, delegator, validator.ValKey, sdk.NewInt(200))
+	require.NoError(t, err)
+
+	supplyBefore := app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf("ukex")
+
+	burned := app.CustomStakingKeeper.Slash(ctx, validator.ValKey, 5, sdk.NewDecWithPrec(1, 1))
+	require.Equal(t, sdk.NewInt(200), burned)
+	require.Equal(t, supplyBefore.Sub(burned), app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf("ukex"))
+
+	require.Equal(t, sdk.NewInt(1620), app.CustomStakingKeeper.GetValidatorBond(ctx, validator.ValKey).Tokens)
+
+	selfDelegation, _ := app.CustomStakingKeeper.GetDelegation(ctx, selfDelegator, validator.ValKey)
+	require.Equal(t, sdk.NewInt(900), app.CustomStakingKeeper.GetDelegationBalance(ctx, selfDelegation))
+
+	delegation, _ := app.CustomStakingKeeper.GetDelegation(ctx, delegator, validator.ValKey)
+	require.Equal(t, sdk.NewInt(720), app.CustomStakingKeeper.GetDelegationBalance(ctx, delegation))
+
+	unbondings := app.CustomStakingKeeper.GetDelegatorUnbondingDelegations(ctx, delegator)
+	require.Len(t, unbondings, 1)
+	require.Equal(t, sdk.NewInt(180), unbondings[0].Balance)
+
+	// unbondings started before the infraction are not slashed
+	burned = app.CustomStakingKeeper.Slash(ctx, validator.ValKey, 11, sdk.NewDecWithPrec(1, 1))
+	require.Equal(t, sdk.NewInt(162), burned)
+
+	unbondings = app.CustomStakingKeeper.GetDelegatorUnbondingDelegations(ctx, delegator)
+	require.Equal(t, sdk.NewInt(180), unbondings[0].Balance)
+}
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/KiraCore/sekai/x/staking/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	tmstrings "github.com/tendermint/tendermint/libs/strings"
+)
+
+// EditValidator updates the profile of a validator, the empty fields and a nil commission are left unchanged.
+// The moniker must not be used by another validator and the commission can only be changed once every
+// COMMISSION_CHANGE_INTERVAL seconds by at most MAX_COMMISSION_CHANGE percentage points.
+func (k Keeper) EditValidator(ctx sdk.Context, valAddress sdk.ValAddress, moniker, website, social, identity string, commission *sdk.Dec) error {
+	validator, err := k.GetValidator(ctx, valAddress)
+	if err != nil {
+		return err
+	}
+
+	if validator.IsRemoved() {
+		return types.ErrValidatorRemoved
+	}
+
+	oldMoniker := validator.Moniker
+	if moniker != "" && moniker != oldMoniker {
+		other, err := k.GetValidatorByMoniker(ctx, moniker)
+		if err == nil && !other.ValKey.Equals(valAddress) {
+			return errors.Wrap(types.ErrMonikerAlreadyUsed, moniker)
+		}
+
+		validator.Moniker = moniker
+	}
+
+	if website != "" {
+		validator.Website = website
+	}
+
+	if social != "" {
+		validator.Social = social
+	}
+
+	if identity != "" {
+		validator.Identity = identity
+	}
+
+	if commission != nil && !commission.Equal(validator.Commission) {
+		properties := k.govkeeper.GetNetworkProperties(ctx)
+
+		nextChangeTime := validator.CommissionUpdateTime.Add(time.Duration(properties.CommissionChangeInterval) * time.Second)
+		if ctx.BlockTime().Before(nextChangeTime) {
+			return errors.Wrapf(types.ErrCommissionChange, "commission can not be changed before %s", nextChangeTime.Format(time.RFC3339))
+		}
+
+		maxChange := sdk.NewDecWithPrec(int64(properties.MaxCommissionChange), 2)
+		if properties.MaxCommissionChange != 0 && commission.Sub(validator.Commission).Abs().GT(maxChange) {
+			return errors.Wrapf(types.ErrCommissionChange, "commission can not change by more than %s", maxChange)
+		}
+
+		validator.Commission = *commission
+		validator.CommissionUpdateTime = ctx.BlockTime()
+	}
+
+	if err := validator.Validate(); err != nil {
+		return err
+	}
+
+	// the old moniker is released only when it still points to the validator
+	store := ctx.KVStore(k.storeKey)
+	if validator.Moniker != oldMoniker && bytes.Equal(store.Get(GetValidatorByMonikerKey(oldMoniker)), GetValidatorKey(valAddress)) {
+		store.Delete(GetValidatorByMonikerKey(oldMoniker))
+	}
+
+	k.AddValidator(ctx, validator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEditValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyMoniker, validator.Moniker),
+			sdk.NewAttribute(types.AttributeKeyCommission, validator.Commission.String()),
+		),
+	)
+
+	return nil
+}
+
+// RotateConsensusKey queues the replacement of the consensus key of a validator, the key is swapped in
+// the validator set updates of the next end blocker. The old consensus address stays bound to the
+// validator so the votes and the evidences of the blocks signed with it are still handled.
+func (k Keeper) RotateConsensusKey(ctx sdk.Context, valAddress sdk.ValAddress, pubKey cryptotypes.PubKey) error {
+	validator, err := k.GetValidator(ctx, valAddress)
+	if err != nil {
+		return err
+	}
+
+	if validator.IsRemoved() {
+		return types.ErrValidatorRemoved
+	}
+
+	if _, found := k.GetConsensusKeyRotation(ctx, valAddress); found {
+		return errors.Wrap(types.ErrKeyRotationPending, valAddress.String())
+	}
+
+	cp := ctx.ConsensusParams()
+	if cp != nil && cp.Validator != nil && !tmstrings.StringInSlice(pubKey.Type(), cp.Validator.PubKeyTypes) {
+		return errors.Wrapf(errors.ErrInvalidPubKey, "consensus key type %s is not supported", pubKey.Type())
+	}
+
+	if k.isConsensusKeyUsed(ctx, sdk.ConsAddress(pubKey.Address())) {
+		return errors.Wrap(types.ErrConsensusKeyUsed, sdk.ConsAddress(pubKey.Address()).String())
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return err
+	}
+
+	k.SetConsensusKeyRotation(ctx, types.ConsensusKeyRotation{
+		ValKey: valAddress,
+		PubKey: pkAny,
+	})
+
+	return nil
+}
+
+// isConsensusKeyUsed returns if the consensus address is used by a validator, a pending validator or a
+// pending consensus key rotation.
+func (k Keeper) isConsensusKeyUsed(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
+	if _, err := k.GetValidatorByConsAddr(ctx, consAddr); err == nil {
+		return true
+	}
+
+	for _, validator := range k.GetPendingValidatorSet(ctx) {
+		if validator.GetConsAddr().Equals(consAddr) {
+			return true
+		}
+	}
+
+	for _, rotation := range k.GetConsensusKeyRotations(ctx) {
+		if rotation.GetConsAddr().Equals(consAddr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SetConsensusKeyRotation saves the pending consensus key rotation of a validator.
+func (k Keeper) SetConsensusKeyRotation(ctx sdk.Context, rotation types.ConsensusKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetConsensusKeyRotationKey(rotation.ValKey), k.cdc.MustMarshalBinaryBare(&rotation))
+}
+
+// GetConsensusKeyRotation returns the pending consensus key rotation of a validator.
+func (k Keeper) GetConsensusKeyRotation(ctx sdk.Context, valAddress sdk.ValAddress) (types.ConsensusKeyRotation, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(GetConsensusKeyRotationKey(valAddress))
+	if bz == nil {
+		return types.ConsensusKeyRotation{}, false
+	}
+
+	var rotation types.ConsensusKeyRotation
+	k.cdc.MustUnmarshalBinaryBare(bz, &rotation)
+
+	return rotation, true
+}
+
+// DeleteConsensusKeyRotation removes the pending consensus key rotation of a validator.
+func (k Keeper) DeleteConsensusKeyRotation(ctx sdk.Context, valAddress sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetConsensusKeyRotationKey(valAddress))
+}
+
+// GetConsensusKeyRotations returns the pending consensus key rotations ordered by validator address.
+func (k Keeper) GetConsensusKeyRotations(ctx sdk.Context) []types.ConsensusKeyRotation {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), ConsensusKeyRotationKey)
+	defer iterator.Close()
+
+	rotations := []types.ConsensusKeyRotation{}
+	for ; iterator.Valid(); iterator.Next() {
+		var rotation types.ConsensusKeyRotation
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &rotation)
+		rotations = append(rotations, rotation)
+	}
+
+	return rotations
+}
@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestEditValidator(t *testing.T) {
+	blockTime := time.Now()
+
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{
+		Time: blockTime,
+	})
+
+	properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	properties.CommissionChangeInterval = 3600
+	properties.MaxCommissionChange = 5
+	app.CustomGovKeeper.SetNetworkProperties(ctx, properties)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.TokensFromConsensusPower(10))
+	validator1, err := types.NewValidator("validator 1", "some-web.com", "A Social", "My Identity", sdk.NewDecWithPrec(10, 2), sdk.ValAddress(addrs[0]), ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	app.CustomStakingKeeper.AddValidator(ctx, validator1)
+	validator2, err := types.NewValidator("validator 2", "some-web.com", "A Social", "My Identity", sdk.NewDecWithPrec(10, 2), sdk.ValAddress(addrs[1]), ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	app.CustomStakingKeeper.AddValidator(ctx, validator2)
+
+	// The moniker is used by another validator.
+	err = app.CustomStakingKeeper.EditValidator(ctx, validator1.ValKey, "validator 2", "", "", "", nil)
+	require.True(t, types.ErrMonikerAlreadyUsed.Is(err))
+
+	// The commission can not change by more than 5 percentage points.
+	commission := sdk.NewDecWithPrec(16, 2)
+	err = app.CustomStakingKeeper.EditValidator(ctx, validator1.ValKey, "", "", "", "", &commission)
+	require.True(t, types.ErrCommissionChange.Is(err))
+
+	commission = sdk.NewDecWithPrec(15, 2)
+	err = app.CustomStakingKeeper.EditValidator(ctx, validator1.ValKey, "new moniker", "new-web.com", "", "", &commission)
+	require.NoError(t, err)
+
+	validator, err := app.CustomStakingKeeper.GetValidator(ctx, validator1.ValKey)
+	require.NoError(t, err)
+	require.Equal(t, "new moniker", validator.Moniker)
+	require.Equal(t, "new-web.com", validator.Website)
+	require.Equal(t, "A Social", validator.Social)
+	require.Equal(t, commission, validator.Commission)
+	require.Equal(t, blockTime.UTC(), validator.CommissionUpdateTime.UTC())
+
+	validator, err = app.CustomStakingKeeper.GetValidatorByMoniker(ctx, "new moniker")
+	require.NoError(t, err)
+	require.Equal(t, validator1.ValKey, validator.ValKey)
+	_, err = app.CustomStakingKeeper.GetValidatorByMoniker(ctx, "validator 1")
+	require.Error(t, err)
+
+	// The commission changed less than the commission change interval ago.
+	commission = sdk.NewDecWithPrec(12, 2)
+	ctx = ctx.WithBlockTime(blockTime.Add(59 * time.Minute))
+	err = app.CustomStakingKeeper.EditValidator(ctx, validator1.ValKey, "", "", "", "", &commission)
+	require.True(t, types.ErrCommissionChange.Is(err))
+
+	ctx = ctx.WithBlockTime(blockTime.Add(time.Hour))
+	err = app.CustomStakingKeeper.EditValidator(ctx, validator1.ValKey, "", "", "", "", &commission)
+	require.NoError(t, err)
+
+	// The released moniker can be taken by another validator.
+	err = app.CustomStakingKeeper.EditValidator(ctx, validator2.ValKey, "validator 1", "", "", "", nil)
+	require.NoError(t, err)
+}
+
+func TestRotateConsensusKey(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.TokensFromConsensusPower(10))
+	validator1, err := types.NewValidator("validator 1", "some-web.com", "A Social", "My Identity", sdk.NewDecWithPrec(10, 2), sdk.ValAddress(addrs[0]), ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	app.CustomStakingKeeper.AddValidator(ctx, validator1)
+	app.CustomStakingKeeper.SetValidatorPower(ctx, validator1.ValKey, 1)
+	validator2, err := types.NewValidator("validator 2", "some-web.com", "A Social", "My Identity", sdk.NewDecWithPrec(10, 2), sdk.ValAddress(addrs[1]), ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	app.CustomStakingKeeper.AddValidator(ctx, validator2)
+	app.CustomStakingKeeper.SetValidatorPower(ctx, validator2.ValKey, 1)
+
+	// The key is used by another validator.
+	err = app.CustomStakingKeeper.RotateConsensusKey(ctx, validator1.ValKey, validator2.GetConsPubKey())
+	require.True(t, types.ErrConsensusKeyUsed.Is(err))
+
+	newPubKey := ed25519.GenPrivKey().PubKey()
+	err = app.CustomStakingKeeper.RotateConsensusKey(ctx, validator1.ValKey, newPubKey)
+	require.NoError(t, err)
+
+	err = app.CustomStakingKeeper.RotateConsensusKey(ctx, validator1.ValKey, ed25519.GenPrivKey().PubKey())
+	require.True(t, types.ErrKeyRotationPending.Is(err))
+
+	// The key is used by a pending rotation.
+	err = app.CustomStakingKeeper.RotateConsensusKey(ctx, validator2.ValKey, newPubKey)
+	require.True(t, types.ErrConsensusKeyUsed.Is(err))
+
+	oldTmPubKey, err := validator1.TmConsPubKey()
+	require.NoError(t, err)
+
+	updates, err := app.CustomStakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+	require.Equal(t, oldTmPubKey, updates[0].PubKey)
+	require.Equal(t, int64(0), updates[0].Power)
+	require.Equal(t, int64(1), updates[1].Power)
+
+	validator, err := app.CustomStakingKeeper.GetValidator(ctx, validator1.ValKey)
+	require.NoError(t, err)
+	require.Equal(t, newPubKey, validator.GetConsPubKey())
+	newTmPubKey, err := validator.TmConsPubKey()
+	require.NoError(t, err)
+	require.Equal(t, newTmPubKey, updates[1].PubKey)
+
+	// Both consensus addresses are bound to the validator.
+	validator, err = app.CustomStakingKeeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(newPubKey.Address()))
+	require.NoError(t, err)
+	require.Equal(t, validator1.ValKey, validator.ValKey)
+	validator, err = app.CustomStakingKeeper.GetValidatorByConsAddr(ctx, validator1.GetConsAddr())
+	require.NoError(t, err)
+	require.Equal(t, validator1.ValKey, validator.ValKey)
+
+	_, found := app.CustomStakingKeeper.GetConsensusKeyRotation(ctx, validator1.ValKey)
+	require.False(t, found)
+
+	updates, err = app.CustomStakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Len(t, updates, 0)
+}
+
+func TestRotateConsensusKey_ValidatorOutOfTheSet(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	validators := createValidators(t, app, ctx, 1)
+	validator1 := validators[0]
+	validator1.Status = types.Inactive
+	app.CustomStakingKeeper.AddValidator(ctx, validator1)
+
+	newPubKey := ed25519.GenPrivKey().PubKey()
+	err := app.CustomStakingKeeper.RotateConsensusKey(ctx, validator1.ValKey, newPubKey)
+	require.NoError(t, err)
+
+	// The inactive validator is not in the tendermint set, only its key is swapped.
+	updates, err := app.CustomStakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Len(t, updates, 0)
+
+	validator, err := app.CustomStakingKeeper.GetValidator(ctx, validator1.ValKey)
+	require.NoError(t, err)
+	require.Equal(t, newPubKey, validator.GetConsPubKey())
+}
@@ -0,0 +1,261 @@
+package keeper
+
+import (
+	"bytes"
+	"time"
+
+	customgovtypes "github.com/KiraCore/sekai/x/gov/types"
+	"github.com/KiraCore/sekai/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ExitValidator schedules the removal of a validator once the EXIT_NOTICE_PERIOD network property is
+// over, the validator keeps validating until then.
+func (k Keeper) ExitValidator(ctx sdk.Context, valAddress sdk.ValAddress) (time.Time, error) {
+	validator, err := k.GetValidator(ctx, valAddress)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if validator.IsRemoved() {
+		return time.Time{}, types.ErrValidatorRemoved
+	}
+
+	if _, found := k.GetValidatorExit(ctx, valAddress); found {
+		return time.Time{}, types.ErrValidatorExiting
+	}
+
+	properties := k.govkeeper.GetNetworkProperties(ctx)
+	exitTime := ctx.BlockTime().Add(time.Duration(properties.ExitNoticePeriod) * time.Second)
+
+	k.SetValidatorExit(ctx, types.ValidatorExit{
+		ValKey: valAddress,
+		Time:   exitTime,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExitValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyExitTime, exitTime.Format(time.RFC3339)),
+		),
+	)
+
+	return exitTime, nil
+}
+
+// ProcessValidatorExits removes from the validator set the validators whose exit notice period is over.
+// They are deleted once the unbondings started when they left the set are over, so that the infractions
+// committed before leaving can still be slashed, and tendermint no longer reports their votes.
+func (k Keeper) ProcessValidatorExits(ctx sdk.Context) error {
+	for _, exit := range k.GetValidatorExits(ctx) {
+		if exit.RemovalHeight != 0 || ctx.BlockTime().Before(exit.Time) {
+			continue
+		}
+
+		validator, err := k.GetValidator(ctx, exit.ValKey)
+		if err != nil {
+			return err
+		}
+
+		if err := k.leaveValidatorSet(ctx, validator, exit); err != nil {
+			return err
+		}
+	}
+
+	for _, exit := range k.getRemovableValidatorExits(ctx) {
+		if ctx.BlockHeight() < exit.RemovalHeight+2 {
+			continue
+		}
+
+		validator, err := k.GetValidator(ctx, exit.ValKey)
+		if err != nil {
+			return err
+		}
+
+		k.removeValidator(ctx, validator)
+	}
+
+	return nil
+}
+
+// leaveValidatorSet sets the validator status to removed, drops it from the tendermint set through the
+// removing queue and unbonds its delegations.
+func (k Keeper) leaveValidatorSet(ctx sdk.Context, validator types.Validator, exit types.ValidatorExit) error {
+	inSet := k.inValidatorSet(ctx, validator)
+
+	k.setStatusToValidator(ctx, validator, types.Removed)
+	k.RemoveReactivatingValidator(ctx, validator)
+	k.RemoveStandbyValidator(ctx, validator.ValKey)
+	if inSet {
+		k.AddRemovingValidator(ctx, validator)
+	}
+
+	k.removeJailValidatorInfo(ctx, validator)
+	k.DeleteConsensusKeyRotation(ctx, validator.ValKey)
+	k.setActorStatus(ctx, validator, customgovtypes.Removed)
+
+	if err := k.unbondValidatorDelegations(ctx, validator.ValKey); err != nil {
+		return err
+	}
+
+	unbondingTime := time.Duration(k.govkeeper.GetNetworkProperties(ctx).UnbondingTime) * time.Second
+	exit.RemovalHeight = ctx.BlockHeight()
+	exit.RemovalTime = ctx.BlockTime().Add(unbondingTime)
+	k.SetValidatorExit(ctx, exit)
+
+	return nil
+}
+
+// inValidatorSet returns if tendermint has the validator in its set, validators that joined before powers
+// were recorded are in the set while active and out of the standby queue.
+func (k Keeper) inValidatorSet(ctx sdk.Context, validator types.Validator) bool {
+	if _, found := k.GetValidatorPower(ctx, validator.ValKey); found {
+		return true
+	}
+
+	reactivating := ctx.KVStore(k.storeKey).Has(GetReactivatingValidatorKey(validator.ValKey))
+	return validator.IsActive() && !reactivating && !k.IsStandbyValidator(ctx, validator.ValKey)
+}
+
+// removeValidator deletes the validator with its moniker and consensus address indexes and its uptime
+// history, the hooks are called for every consensus address the validator used.
+func (k Keeper) removeValidator(ctx sdk.Context, validator types.Validator) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetValidatorKey(validator.ValKey))
+
+	// the moniker is released only when it still points to the validator
+	if bytes.Equal(store.Get(GetValidatorByMonikerKey(validator.Moniker)), GetValidatorKey(validator.ValKey)) {
+		store.Delete(GetValidatorByMonikerKey(validator.Moniker))
+	}
+
+	k.removeValidatorPower(ctx, validator.ValKey)
+	k.DeleteValidatorExit(ctx, validator.ValKey)
+	k.deleteValidatorEpochs(ctx, validator.ValKey)
+
+	// the consensus addresses of the keys rotated away are still bound to the validator
+	for _, consAddr := range k.getValidatorConsAddrs(ctx, validator.ValKey) {
+		store.Delete(GetValidatorByConsAddrKey(consAddr))
+		k.AfterValidatorRemoved(ctx, consAddr, validator.ValKey)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.ValKey.String()),
+		),
+	)
+}
+
+// getValidatorConsAddrs returns all the consensus addresses bound to a validator.
+func (k Keeper) getValidatorConsAddrs(ctx sdk.Context, valAddress sdk.ValAddress) []sdk.ConsAddress {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), ValidatorsByConsAddressKey)
+	defer iterator.Close()
+
+	consAddrs := []sdk.ConsAddress{}
+	for ; iterator.Valid(); iterator.Next() {
+		if bytes.Equal(iterator.Value(), valAddress) {
+			consAddrs = append(consAddrs, sdk.ConsAddress(iterator.Key()[len(ValidatorsByConsAddressKey):]))
+		}
+	}
+
+	return consAddrs
+}
+
+// unbondValidatorDelegations starts the unbonding of all the delegations made to a validator, the shares
+// worth less than a token and the tokens left in the bond by the rounding of the shares are burned.
+func (k Keeper) unbondValidatorDelegations(ctx sdk.Context, valAddress sdk.ValAddress) error {
+	for _, delegation := range k.GetValidatorDelegations(ctx, valAddress) {
+		if balance := k.GetDelegationBalance(ctx, delegation); balance.IsPositive() {
+			if _, err := k.Undelegate(ctx, delegation.Delegator, valAddress, balance); err != nil {
+				return err
+			}
+		}
+
+		// the shares worth less than a token are removed without unbonding
+		if _, found := k.GetDelegation(ctx, delegation.Delegator, valAddress); found {
+			k.BeforeDelegationSharesModified(ctx, delegation.Delegator, valAddress)
+			k.removeDelegation(ctx, delegation)
+			k.AfterDelegationModified(ctx, delegation.Delegator, valAddress)
+		}
+	}
+
+	if err := k.burnTokens(ctx, types.BondedPoolName, k.GetValidatorBond(ctx, valAddress).Tokens); err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Delete(GetValidatorBondKey(valAddress))
+
+	return nil
+}
+
+// SetValidatorExit saves the exit of a validator, a validator that left the validator set is queued by
+// removal time.
+func (k Keeper) SetValidatorExit(ctx sdk.Context, exit types.ValidatorExit) {
+	k.DeleteValidatorExit(ctx, exit.ValKey)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetValidatorExitKey(exit.ValKey), k.cdc.MustMarshalBinaryBare(&exit))
+	if exit.RemovalHeight != 0 {
+		store.Set(GetValidatorRemovalQueueKey(exit.RemovalTime, exit.ValKey), exit.ValKey)
+	}
+}
+
+// GetValidatorExit returns the exit of a validator, found is false if the validator is not exiting.
+func (k Keeper) GetValidatorExit(ctx sdk.Context, valAddress sdk.ValAddress) (types.ValidatorExit, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(GetValidatorExitKey(valAddress))
+	if bz == nil {
+		return types.ValidatorExit{}, false
+	}
+
+	var exit types.ValidatorExit
+	k.cdc.MustUnmarshalBinaryBare(bz, &exit)
+
+	return exit, true
+}
+
+// DeleteValidatorExit removes the exit of a validator.
+func (k Keeper) DeleteValidatorExit(ctx sdk.Context, valAddress sdk.ValAddress) {
+	exit, found := k.GetValidatorExit(ctx, valAddress)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetValidatorExitKey(valAddress))
+	if exit.RemovalHeight != 0 {
+		store.Delete(GetValidatorRemovalQueueKey(exit.RemovalTime, valAddress))
+	}
+}
+
+// GetValidatorExits returns the exits of all the exiting validators ordered by validator address.
+func (k Keeper) GetValidatorExits(ctx sdk.Context) []types.ValidatorExit {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), ValidatorExitKey)
+	defer iterator.Close()
+
+	exits := []types.ValidatorExit{}
+	for ; iterator.Valid(); iterator.Next() {
+		var exit types.ValidatorExit
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &exit)
+		exits = append(exits, exit)
+	}
+
+	return exits
+}
+
+// getRemovableValidatorExits returns the exits of the validators whose removal time is over.
+func (k Keeper) getRemovableValidatorExits(ctx sdk.Context) []types.ValidatorExit {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(ValidatorRemovalQueueKey, sdk.PrefixEndBytes(GetValidatorRemovalQueueTimeKey(ctx.BlockTime())))
+	defer iterator.Close()
+
+	exits := []types.ValidatorExit{}
+	for ; iterator.Valid(); iterator.Next() {
+		if exit, found := k.GetValidatorExit(ctx, sdk.ValAddress(iterator.Value())); found {
+			exits = append(exits, exit)
+		}
+	}
+
+	return exits
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/KiraCore/sekai/simapp"
+	customgovtypes "github.com/KiraCore/sekai/x/gov/types"
+	"github.com/KiraCore/sekai/x/staking"
+	"github.com/KiraCore/sekai/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestExitValidator(t *testing.T) {
+	blockTime := time.Now().UTC()
+
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 1, Time: blockTime})
+
+	properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	properties.ExitNoticePeriod = 3600
+	app.CustomGovKeeper.SetNetworkProperties(ctx, properties)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(10))
+	validator1, err := types.NewValidator("validator 1", "some-web.com", "A Social", "My Identity", sdk.NewDecWithPrec(10, 2), sdk.ValAddress(addrs[0]), ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	app.CustomStakingKeeper.AddValidator(ctx, validator1)
+	app.CustomStakingKeeper.SetValidatorPower(ctx, validator1.ValKey, 1)
+	app.CustomGovKeeper.SaveNetworkActor(ctx, customgovtypes.NewDefaultActor(addrs[0]))
+
+	require.NoError(t, app.CustomStakingKeeper.Delegate(ctx, addrs[0], validator1.ValKey, sdk.NewInt(1000)))
+
+	exitTime, err := app.CustomStakingKeeper.ExitValidator(ctx, validator1.ValKey)
+	require.NoError(t, err)
+	require.Equal(t, blockTime.Add(time.Hour), exitTime)
+
+	_, err = app.CustomStakingKeeper.ExitValidator(ctx, validator1.ValKey)
+	require.True(t, types.ErrValidatorExiting.Is(err))
+
+	// The exiting validator does not accept new delegations.
+	err = app.CustomStakingKeeper.Delegate(ctx, addrs[0], validator1.ValKey, sdk.NewInt(1000))
+	require.True(t, types.ErrValidatorExiting.Is(err))
+
+	// The validator keeps validating during the notice period.
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(blockTime.Add(59 * time.Minute))
+	updates := staking.EndBlocker(ctx, app.CustomStakingKeeper)
+	require.Len(t, updates, 0)
+	validator, err := app.CustomStakingKeeper.GetValidator(ctx, validator1.ValKey)
+	require.NoError(t, err)
+	require.True(t, validator.IsActive())
+
+	// The validator leaves the set once the notice period is over.
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(exitTime)
+	updates = staking.EndBlocker(ctx, app.CustomStakingKeeper)
+	require.Len(t, updates, 1)
+	require.Equal(t, int64(0), updates[0].Power)
+
+	validator, err = app.CustomStakingKeeper.GetValidator(ctx, validator1.ValKey)
+	require.NoError(t, err)
+	require.True(t, validator.IsRemoved())
+
+	actor, found := app.CustomGovKeeper.GetNetworkActorByAddress(ctx, addrs[0])
+	require.True(t, found)
+	require.Equal(t, customgovtypes.Removed, actor.Status)
+
+	require.Len(t, app.CustomStakingKeeper.GetValidatorDelegations(ctx, validator1.ValKey), 0)
+	unbondings := app.CustomStakingKeeper.GetDelegatorUnbondingDelegations(ctx, addrs[0])
+	require.Len(t, unbondings, 1)
+	require.Equal(t, sdk.NewInt(1000), unbondings[0].Balance)
+
+	_, err = app.CustomStakingKeeper.ExitValidator(ctx, validator1.ValKey)
+	require.Equal(t, types.ErrValidatorRemoved, err)
+	err = app.CustomStakingKeeper.Jail(ctx, validator1.ValKey)
+	require.Equal(t, types.ErrValidatorRemoved, err)
+
+	// The validator is kept while the unbondings started when it left the set are running.
+	ctx = ctx.WithBlockHeight(5)
+	updates = staking.EndBlocker(ctx, app.CustomStakingKeeper)
+	require.Len(t, updates, 0)
+	_, err = app.CustomStakingKeeper.GetValidator(ctx, validator1.ValKey)
+	require.NoError(t, err)
+	_, err = app.CustomStakingKeeper.GetValidatorByConsAddr(ctx, validator1.GetConsAddr())
+	require.NoError(t, err)
+
+	exit, found := app.CustomStakingKeeper.GetValidatorExit(ctx, validator1.ValKey)
+	require.True(t, found)
+	require.Equal(t, unbondings[0].CompletionTime, exit.RemovalTime)
+
+	ctx = ctx.WithBlockHeight(6).WithBlockTime(exit.RemovalTime)
+	updates = staking.EndBlocker(ctx, app.CustomStakingKeeper)
+	require.Len(t, updates, 0)
+
+	_, err = app.CustomStakingKeeper.GetValidator(ctx, validator1.ValKey)
+	require.Error(t, err)
+	_, err = app.CustomStakingKeeper.GetValidatorByConsAddr(ctx, validator1.GetConsAddr())
+	require.Error(t, err)
+	_, err = app.CustomStakingKeeper.GetValidatorByMoniker(ctx, validator1.Moniker)
+	require.Error(t, err)
+	_, found = app.CustomStakingKeeper.GetValidatorExit(ctx, validator1.ValKey)
+	require.False(t, found)
+	require.Len(t, app.CustomStakingKeeper.GetValidatorEpochs(ctx, validator1.ValKey), 0)
+}
+
+func TestExitValidator_ValidatorOutOfTheSet(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+
+	properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	properties.ExitNoticePeriod = 0
+	app.CustomGovKeeper.SetNetworkProperties(ctx, properties)
+
+	validators := createValidators(t, app, ctx, 1)
+	validator1 := validators[0]
+	validator1.Status = types.Inactive
+	app.CustomStakingKeeper.AddValidator(ctx, validator1)
+
+	_, err := app.CustomStakingKeeper.ExitValidator(ctx, validator1.ValKey)
+	require.NoError(t, err)
+
+	// The inactive validator is not in the tendermint set, no update is sent for it.
+	updates := staking.EndBlocker(ctx, app.CustomStakingKeeper)
+	require.Len(t, updates, 0)
+
+	validator, err := app.CustomStakingKeeper.GetValidator(ctx, validator1.ValKey)
+	require.NoError(t, err)
+	require.True(t, validator.IsRemoved())
+}
@@ -144,3 +144,148 @@ func (q Querier) Validators(ctx context.Context, request *types.ValidatorsReques
 
 	return &response, nil
 }
+
+// Delegations implements the Query delegations of a delegator gRPC method
+func (q Querier) Delegations(ctx context.Context, request *types.DelegationsRequest) (*types.DelegationsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	delegator, err := sdk.AccAddressFromBech32(request.Delegator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	c := sdk.UnwrapSDKContext(ctx)
+	delegationStore := prefix.NewStore(c.KVStore(q.keeper.storeKey), GetDelegationsKey(delegator))
+
+	var delegations []types.DelegationResponse
+	pageRes, err := query.Paginate(delegationStore, request.Pagination, func(key []byte, value []byte) error {
+		var delegation types.Delegation
+		if err := q.keeper.cdc.UnmarshalBinaryBare(value, &delegation); err != nil {
+			return err
+		}
+
+		delegations = append(delegations, types.DelegationResponse{
+			Delegation: delegation,
+			Balance:    q.keeper.GetDelegationBalance(c, delegation),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.DelegationsResponse{Delegations: delegations, Pagination: pageRes}, nil
+}
+
+// ValidatorDelegations implements the Query delegations made to a validator gRPC method
+func (q Querier) ValidatorDelegations(ctx context.Context, request *types.ValidatorDelegationsRequest) (*types.DelegationsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(request.ValAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	c := sdk.UnwrapSDKContext(ctx)
+	indexStore := prefix.NewStore(c.KVStore(q.keeper.storeKey), GetValidatorDelegationsKey(valAddr))
+
+	var delegations []types.DelegationResponse
+	pageRes, err := query.Paginate(indexStore, request.Pagination, func(key []byte, value []byte) error {
+		delegation, found := q.keeper.GetDelegation(c, value, valAddr)
+		if !found {
+			return types.ErrDelegationNotFound
+		}
+
+		delegations = append(delegations, types.DelegationResponse{
+			Delegation: delegation,
+			Balance:    q.keeper.GetDelegationBalance(c, delegation),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.DelegationsResponse{Delegations: delegations, Pagination: pageRes}, nil
+}
+
+// UnbondingDelegations implements the Query unbonding delegations of a delegator gRPC method
+func (q Querier) UnbondingDelegations(ctx context.Context, request *types.UnbondingDelegationsRequest) (*types.UnbondingDelegationsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	delegator, err := sdk.AccAddressFromBech32(request.Delegator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	c := sdk.UnwrapSDKContext(ctx)
+	indexStore := prefix.NewStore(c.KVStore(q.keeper.storeKey), GetDelegatorUnbondingsKey(delegator))
+
+	var unbondings []types.UnbondingDelegation
+	pageRes, err := query.Paginate(indexStore, request.Pagination, func(key []byte, value []byte) error {
+		unbonding, found := q.keeper.GetUnbondingDelegation(c, sdk.BigEndianToUint64(value))
+		if !found {
+			return fmt.Errorf("unbonding delegation not found")
+		}
+
+		unbondings = append(unbondings, unbonding)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.UnbondingDelegationsResponse{UnbondingDelegations: unbondings, Pagination: pageRes}, nil
+}
+
+// StandbyValidators implements the Query standby validators gRPC method
+func (q Querier) StandbyValidators(ctx context.Context, request *types.StandbyValidatorsRequest) (*types.StandbyValidatorsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	c := sdk.UnwrapSDKContext(ctx)
+
+	return &types.StandbyValidatorsResponse{Validators: q.keeper.GetStandbyValidators(c)}, nil
+}
+
+// ValidatorHistory implements the Query uptime and rank history of a validator gRPC method
+func (q Querier) ValidatorHistory(ctx context.Context, request *types.ValidatorHistoryRequest) (*types.ValidatorHistoryResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(request.ValAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	c := sdk.UnwrapSDKContext(ctx)
+	if _, err := q.keeper.GetValidator(c, valAddr); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	historyStore := prefix.NewStore(c.KVStore(q.keeper.storeKey), GetValidatorEpochsKey(valAddr))
+
+	var epochs []types.ValidatorEpoch
+	pageRes, err := query.Paginate(historyStore, request.Pagination, func(key []byte, value []byte) error {
+		var record types.ValidatorEpoch
+		q.keeper.cdc.MustUnmarshalBinaryBare(value, &record)
+
+		epochs = append(epochs, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	currentEpoch, _ := q.keeper.GetCurrentUptimeEpoch(c)
+
+	return &types.ValidatorHistoryResponse{Epochs: epochs, CurrentEpoch: currentEpoch, Pagination: pageRes}, nil
+}
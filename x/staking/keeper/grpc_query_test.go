@@ -11,6 +11,7 @@ import (
 
 	"github.com/KiraCore/sekai/simapp"
 	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 )
 
@@ -35,3 +36,58 @@ func TestQuerier_ValidatorByAddress(t *testing.T) {
 
 	require.True(t, val.Equal(qValidatorResp.Validator))
 }
+
+func TestQuerier_StandbyValidators(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	validators := createValidators(t, app, ctx, 3)
+	for i, validator := range validators {
+		validator.Rank = int64(i)
+		app.CustomStakingKeeper.AddValidator(ctx, validator)
+		app.CustomStakingKeeper.AddStandbyValidator(ctx, validator.ValKey)
+	}
+
+	querier := stakingkeeper.NewQuerier(app.CustomStakingKeeper)
+
+	res, err := querier.StandbyValidators(types.WrapSDKContext(ctx), &stakingtypes.StandbyValidatorsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Validators, 3)
+
+	// the highest ranked validator is the first to take a seat
+	require.Equal(t, validators[2].ValKey, res.Validators[0].ValKey)
+	require.Equal(t, validators[1].ValKey, res.Validators[1].ValKey)
+	require.Equal(t, validators[0].ValKey, res.Validators[2].ValKey)
+}
+
+func TestQuerier_ValidatorHistory(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	validators := createValidators(t, app, ctx, 2)
+	app.CustomStakingKeeper.AddValidator(ctx, validators[0])
+	app.CustomStakingKeeper.SetCurrentUptimeEpoch(ctx, stakingtypes.UptimeEpoch{Number: 3, StartHeight: 21})
+	for epoch := uint64(1); epoch <= 3; epoch++ {
+		app.CustomStakingKeeper.SetValidatorEpoch(ctx, stakingtypes.ValidatorEpoch{
+			ValKey:       validators[0].ValKey,
+			Epoch:        epoch,
+			SignedBlocks: epoch,
+		})
+	}
+
+	querier := stakingkeeper.NewQuerier(app.CustomStakingKeeper)
+
+	res, err := querier.ValidatorHistory(types.WrapSDKContext(ctx), &stakingtypes.ValidatorHistoryRequest{
+		ValAddr:    validators[0].ValKey.String(),
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Epochs, 1)
+	require.Equal(t, uint64(2), res.Epochs[0].Epoch)
+	require.Equal(t, uint64(2), res.Epochs[0].SignedBlocks)
+	require.Equal(t, stakingtypes.UptimeEpoch{Number: 3, StartHeight: 21}, res.CurrentEpoch)
+
+	// the validator is not registered
+	_, err = querier.ValidatorHistory(types.WrapSDKContext(ctx), &stakingtypes.ValidatorHistoryRequest{ValAddr: validators[1].ValKey.String()})
+	require.Error(t, err)
+}
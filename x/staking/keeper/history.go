@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KiraCore/sekai/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProcessUptimeEpoch ends the epoch in progress once it lasted for the UPTIME_EPOCH_LENGTH network property,
+// the rank, streak and status of every validator are recorded at the end of the epoch and the epochs older
+// than the UPTIME_EPOCH_RETENTION network property are pruned.
+func (k Keeper) ProcessUptimeEpoch(ctx sdk.Context) {
+	epoch, found := k.currentUptimeEpoch(ctx)
+	if !found {
+		return
+	}
+
+	properties := k.govkeeper.GetNetworkProperties(ctx)
+	if ctx.BlockHeight()-epoch.StartHeight+1 < int64(properties.UptimeEpochLength) {
+		return
+	}
+
+	for _, validator := range k.GetValidatorSet(ctx) {
+		record := k.getOrNewValidatorEpoch(ctx, validator.ValKey, epoch)
+		record.EndHeight = ctx.BlockHeight()
+		record.Rank = validator.Rank
+		record.Streak = validator.Streak
+		record.Status = validator.Status
+		k.SetValidatorEpoch(ctx, record)
+	}
+
+	if epoch.Number > properties.UptimeEpochRetention {
+		k.pruneValidatorEpochs(ctx, epoch.Number-properties.UptimeEpochRetention)
+	}
+
+	k.SetCurrentUptimeEpoch(ctx, types.UptimeEpoch{
+		Number:      epoch.Number + 1,
+		StartHeight: ctx.BlockHeight() + 1,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEndUptimeEpoch,
+			sdk.NewAttribute(types.AttributeKeyEpoch, fmt.Sprintf("%d", epoch.Number)),
+		),
+	)
+}
+
+// currentUptimeEpoch returns the epoch in progress, the first epoch starts at the first block the history
+// is enabled in. found is false while the UPTIME_EPOCH_LENGTH network property is 0.
+func (k Keeper) currentUptimeEpoch(ctx sdk.Context) (types.UptimeEpoch, bool) {
+	if k.govkeeper.GetNetworkProperties(ctx).UptimeEpochLength == 0 {
+		return types.UptimeEpoch{}, false
+	}
+
+	epoch, found := k.GetCurrentUptimeEpoch(ctx)
+	if !found {
+		epoch = types.UptimeEpoch{
+			Number:      1,
+			StartHeight: ctx.BlockHeight(),
+		}
+		k.SetCurrentUptimeEpoch(ctx, epoch)
+	}
+
+	return epoch, true
+}
+
+// recordValidatorSignature counts the block signed or missed by the validator in the epoch in progress.
+func (k Keeper) recordValidatorSignature(ctx sdk.Context, validator types.Validator, missed bool) {
+	epoch, found := k.currentUptimeEpoch(ctx)
+	if !found {
+		return
+	}
+
+	record := k.getOrNewValidatorEpoch(ctx, validator.ValKey, epoch)
+	if missed {
+		record.MissedBlocks++
+	} else {
+		record.SignedBlocks++
+	}
+
+	record.Rank = validator.Rank
+	record.Streak = validator.Streak
+	record.Status = validator.Status
+	k.SetValidatorEpoch(ctx, record)
+}
+
+// recordValidatorStatusChange records the status of the validator in the epoch in progress when it differs
+// from the previous status.
+func (k Keeper) recordValidatorStatusChange(ctx sdk.Context, validator types.Validator, previous types.ValidatorStatus) {
+	if validator.Status == previous {
+		return
+	}
+
+	epoch, found := k.currentUptimeEpoch(ctx)
+	if !found {
+		return
+	}
+
+	record := k.getOrNewValidatorEpoch(ctx, validator.ValKey, epoch)
+	record.StatusChanges = append(record.StatusChanges, types.ValidatorStatusChange{
+		Height: ctx.BlockHeight(),
+		Time:   ctx.BlockTime(),
+		Status: validator.Status,
+	})
+
+	record.Rank = validator.Rank
+	record.Streak = validator.Streak
+	record.Status = validator.Status
+	k.SetValidatorEpoch(ctx, record)
+}
+
+func (k Keeper) getOrNewValidatorEpoch(ctx sdk.Context, valAddress sdk.ValAddress, epoch types.UptimeEpoch) types.ValidatorEpoch {
+	record, found := k.GetValidatorEpoch(ctx, valAddress, epoch.Number)
+	if !found {
+		record = types.ValidatorEpoch{
+			ValKey:      valAddress,
+			Epoch:       epoch.Number,
+			StartHeight: epoch.StartHeight,
+		}
+	}
+
+	return record
+}
+
+// pruneValidatorEpochs deletes the records of all the validators up to the given epoch.
+func (k Keeper) pruneValidatorEpochs(ctx sdk.Context, lastEpoch uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(ValidatorEpochByEpochKey, GetValidatorEpochByEpochPrefix(lastEpoch+1))
+	defer iterator.Close()
+
+	var keys, recordKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		epoch := sdk.BigEndianToUint64(key[len(ValidatorEpochByEpochKey) : len(ValidatorEpochByEpochKey)+8])
+		keys = append(keys, key)
+		recordKeys = append(recordKeys, GetValidatorEpochKey(sdk.ValAddress(iterator.Value()), epoch))
+	}
+
+	for i, key := range keys {
+		store.Delete(key)
+		store.Delete(recordKeys[i])
+	}
+}
+
+// deleteValidatorEpochs deletes the history of a validator.
+func (k Keeper) deleteValidatorEpochs(ctx sdk.Context, valAddress sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, GetValidatorEpochsKey(valAddress))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+		store.Delete(GetValidatorEpochByEpochKey(sdk.BigEndianToUint64(key[len(key)-8:]), valAddress))
+	}
+}
+
+// SetCurrentUptimeEpoch saves the epoch of the history in progress.
+func (k Keeper) SetCurrentUptimeEpoch(ctx sdk.Context, epoch types.UptimeEpoch) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(CurrentUptimeEpochKey, k.cdc.MustMarshalBinaryBare(&epoch))
+}
+
+// GetCurrentUptimeEpoch returns the epoch of the history in progress, found is false until the history starts.
+func (k Keeper) GetCurrentUptimeEpoch(ctx sdk.Context) (types.UptimeEpoch, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(CurrentUptimeEpochKey)
+	if bz == nil {
+		return types.UptimeEpoch{}, false
+	}
+
+	var epoch types.UptimeEpoch
+	k.cdc.MustUnmarshalBinaryBare(bz, &epoch)
+
+	return epoch, true
+}
+
+// SetValidatorEpoch saves the uptime and rank of a validator over an epoch, indexed by epoch for pruning.
+func (k Keeper) SetValidatorEpoch(ctx sdk.Context, record types.ValidatorEpoch) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetValidatorEpochKey(record.ValKey, record.Epoch), k.cdc.MustMarshalBinaryBare(&record))
+	store.Set(GetValidatorEpochByEpochKey(record.Epoch, record.ValKey), record.ValKey)
+}
+
+// GetValidatorEpoch returns the uptime and rank of a validator over an epoch, found is false if the epoch
+// was pruned or the validator has no record for it.
+func (k Keeper) GetValidatorEpoch(ctx sdk.Context, valAddress sdk.ValAddress, epoch uint64) (types.ValidatorEpoch, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(GetValidatorEpochKey(valAddress, epoch))
+	if bz == nil {
+		return types.ValidatorEpoch{}, false
+	}
+
+	var record types.ValidatorEpoch
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+
+	return record, true
+}
+
+// GetValidatorEpochs returns the history of a validator ordered from the oldest epoch.
+func (k Keeper) GetValidatorEpochs(ctx sdk.Context, valAddress sdk.ValAddress) []types.ValidatorEpoch {
+	return k.getValidatorEpochsByPrefix(ctx, GetValidatorEpochsKey(valAddress))
+}
+
+// GetAllValidatorEpochs returns the history of all the validators ordered by validator address and epoch.
+func (k Keeper) GetAllValidatorEpochs(ctx sdk.Context) []types.ValidatorEpoch {
+	return k.getValidatorEpochsByPrefix(ctx, ValidatorEpochKey)
+}
+
+func (k Keeper) getValidatorEpochsByPrefix(ctx sdk.Context, prefix []byte) []types.ValidatorEpoch {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	records := []types.ValidatorEpoch{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ValidatorEpoch
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/KiraCore/sekai/simapp"
+	stakingkeeper "github.com/KiraCore/sekai/x/staking/keeper"
+	"github.com/KiraCore/sekai/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestProcessUptimeEpoch(t *testing.T) {
+	blockTime := time.Now().UTC()
+
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 1, Time: blockTime})
+
+	properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	properties.UptimeEpochLength = 3
+	properties.UptimeEpochRetention = 2
+	app.CustomGovKeeper.SetNetworkProperties(ctx, properties)
+
+	validators := createValidators(t, app, ctx, 1)
+	validator1 := validators[0]
+	app.CustomStakingKeeper.AddValidator(ctx, validator1)
+
+	// The first epoch starts with the first block the validator signs.
+	for height, missed := range []bool{false, true, false} {
+		ctx = ctx.WithBlockHeight(int64(height + 1))
+		require.NoError(t, app.CustomStakingKeeper.HandleValidatorSignature(ctx, validator1.ValKey, missed))
+		app.CustomStakingKeeper.ProcessUptimeEpoch(ctx)
+	}
+
+	epoch, found := app.CustomStakingKeeper.GetCurrentUptimeEpoch(ctx)
+	require.True(t, found)
+	require.Equal(t, types.UptimeEpoch{Number: 2, StartHeight: 4}, epoch)
+
+	record, found := app.CustomStakingKeeper.GetValidatorEpoch(ctx, validator1.ValKey, 1)
+	require.True(t, found)
+	require.Equal(t, int64(1), record.StartHeight)
+	require.Equal(t, int64(3), record.EndHeight)
+	require.Equal(t, uint64(2), record.SignedBlocks)
+	require.Equal(t, uint64(1), record.MissedBlocks)
+	require.Equal(t, int64(1), record.Rank)
+	require.Equal(t, int64(1), record.Streak)
+	require.Equal(t, types.Active, record.Status)
+	require.Len(t, record.StatusChanges, 0)
+
+	// The status changes are recorded in the epoch in progress.
+	ctx = ctx.WithBlockHeight(4).WithBlockTime(blockTime.Add(time.Minute))
+	require.NoError(t, app.CustomStakingKeeper.Pause(ctx, validator1.ValKey))
+
+	record, found = app.CustomStakingKeeper.GetValidatorEpoch(ctx, validator1.ValKey, 2)
+	require.True(t, found)
+	require.Equal(t, int64(0), record.EndHeight)
+	require.Equal(t, types.Paused, record.Status)
+	require.Equal(t, []types.ValidatorStatusChange{
+		{Height: 4, Time: blockTime.Add(time.Minute), Status: types.Paused},
+	}, record.StatusChanges)
+
+	// The paused validator does not sign, its epochs are still recorded until they are pruned.
+	for height := int64(4); height <= 9; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		app.CustomStakingKeeper.ProcessUptimeEpoch(ctx)
+	}
+
+	records := app.CustomStakingKeeper.GetValidatorEpochs(ctx, validator1.ValKey)
+	require.Len(t, records, 2)
+	require.Equal(t, uint64(2), records[0].Epoch)
+	require.Equal(t, int64(6), records[0].EndHeight)
+	require.Equal(t, uint64(3), records[1].Epoch)
+	require.Equal(t, int64(7), records[1].StartHeight)
+	require.Equal(t, int64(9), records[1].EndHeight)
+	require.Equal(t, uint64(0), records[1].SignedBlocks)
+	require.Equal(t, types.Paused, records[1].Status)
+
+	// The epoch index of the pruned records is deleted with them.
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(app.GetKey(types.ModuleName)), stakingkeeper.ValidatorEpochByEpochKey)
+	defer iterator.Close()
+	require.Equal(t, stakingkeeper.GetValidatorEpochByEpochKey(2, validator1.ValKey), iterator.Key())
+}
+
+func TestProcessUptimeEpoch_Disabled(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 1})
+
+	properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	properties.UptimeEpochLength = 0
+	app.CustomGovKeeper.SetNetworkProperties(ctx, properties)
+
+	validators := createValidators(t, app, ctx, 1)
+	app.CustomStakingKeeper.AddValidator(ctx, validators[0])
+
+	require.NoError(t, app.CustomStakingKeeper.HandleValidatorSignature(ctx, validators[0].ValKey, false))
+	require.NoError(t, app.CustomStakingKeeper.Pause(ctx, validators[0].ValKey))
+	app.CustomStakingKeeper.ProcessUptimeEpoch(ctx)
+
+	_, found := app.CustomStakingKeeper.GetCurrentUptimeEpoch(ctx)
+	require.False(t, found)
+	require.Len(t, app.CustomStakingKeeper.GetValidatorEpochs(ctx, validators[0].ValKey), 0)
+}
@@ -35,3 +35,24 @@ func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress,
 		k.hooks.AfterValidatorRemoved(ctx, consAddr, valAddr)
 	}
 }
+
+// AfterConsensusKeyRotated - call hook if registered
+func (k Keeper) AfterConsensusKeyRotated(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	if k.hooks != nil {
+		k.hooks.AfterConsensusKeyRotated(ctx, oldConsAddr, newConsAddr, valAddr)
+	}
+}
+
+// BeforeDelegationSharesModified - call hook if registered
+func (k Keeper) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	if k.hooks != nil {
+		k.hooks.BeforeDelegationSharesModified(ctx, delAddr, valAddr)
+	}
+}
+
+// AfterDelegationModified - call hook if registered
+func (k Keeper) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	if k.hooks != nil {
+		k.hooks.AfterDelegationModified(ctx, delAddr, valAddr)
+	}
+}
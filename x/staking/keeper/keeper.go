@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/KiraCore/sekai/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -10,20 +11,21 @@ import (
 
 // Keeper represents the keeper that maintains the Validator Registry.
 type Keeper struct {
-	storeKey  sdk.StoreKey
-	cdc       *codec.LegacyAmino
-	hooks     types.StakingHooks
-	govkeeper types.GovKeeper
+	storeKey   sdk.StoreKey
+	cdc        *codec.LegacyAmino
+	hooks      types.StakingHooks
+	bankKeeper types.BankKeeper
+	govkeeper  types.GovKeeper
 }
 
 // NewKeeper returns new keeper.
-func NewKeeper(storeKey sdk.StoreKey, cdc *codec.LegacyAmino, govkeeper types.GovKeeper) Keeper {
-	return Keeper{storeKey: storeKey, cdc: cdc, govkeeper: govkeeper}
+func NewKeeper(storeKey sdk.StoreKey, cdc *codec.LegacyAmino, bankKeeper types.BankKeeper, govkeeper types.GovKeeper) Keeper {
+	return Keeper{storeKey: storeKey, cdc: cdc, bankKeeper: bankKeeper, govkeeper: govkeeper}
 }
 
 // BondDenom returns the denom that is basically used for fee payment
 func (k Keeper) BondDenom(ctx sdk.Context) string {
-	return "ukex"
+	return types.BondDenom
 }
 
 // Set the validator hooks
@@ -115,6 +117,18 @@ func (k Keeper) GetValidatorSet(ctx sdk.Context) []types.Validator {
 	return validators
 }
 
+// GetActiveValidatorSet returns the validators that are active, ordered by address
+func (k Keeper) GetActiveValidatorSet(ctx sdk.Context) []types.Validator {
+	var validators []types.Validator
+	for _, validator := range k.GetValidatorSet(ctx) {
+		if validator.IsActive() {
+			validators = append(validators, validator)
+		}
+	}
+
+	return validators
+}
+
 func (k Keeper) GetPendingValidatorSet(ctx sdk.Context) []types.Validator {
 	store := ctx.KVStore(k.storeKey)
 
@@ -158,14 +172,20 @@ func (k Keeper) GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress
 	return validator, nil
 }
 
-// MaxValidators returns the maximum amount of joined validators
-func (k Keeper) MaxValidators(sdk.Context) uint32 {
-	// TODO: don't do anything for now, implement this
-	return 10
+// MaxValidators returns the maximum amount of validators in the validator set, set by the MAX_VALIDATORS
+// network property. The set is not limited when the property is 0.
+func (k Keeper) MaxValidators(ctx sdk.Context) uint32 {
+	maxValidators := k.govkeeper.GetNetworkProperties(ctx).MaxValidators
+	if maxValidators == 0 || maxValidators > math.MaxUint32 {
+		return math.MaxUint32
+	}
+
+	return uint32(maxValidators)
 }
 
-// IsNetworkActive returns true if network has more than the validators required in network property
+// IsNetworkActive returns true if the validator set holds at least the validators required in network property,
+// the validators that are not active or wait in the standby queue are not counted
 func (k Keeper) IsNetworkActive(ctx sdk.Context) bool {
-	vals := k.GetValidatorSet(ctx)
-	return len(vals) >= int(k.govkeeper.GetNetworkProperties(ctx).MinValidators)
+	setVals, _ := k.SplitValidatorSet(ctx)
+	return len(setVals) >= int(k.govkeeper.GetNetworkProperties(ctx).MinValidators)
 }
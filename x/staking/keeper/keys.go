@@ -1,6 +1,10 @@
 package keeper
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // Keys for Staking store.
 // 0x00<ValAddress> : The validator
@@ -10,6 +14,27 @@ import sdk "github.com/cosmos/cosmos-sdk/types"
 // 0x04<ValAddress> : The Validator Address
 // 0x05<ValAddress> : The Validator Address
 // 0x06<ValAddress> : Validator Jail Info
+// 0x07<ValAddress> : Validator consensus power
+// 0x08<ValAddress> : Validator bond
+// 0x09<AccAddress><ValAddress> : Delegation
+// 0x0A<ValAddress><AccAddress> : Delegation index by validator
+// 0x0B<ID> : Unbonding delegation
+// 0x0C<Time><ID> : Unbonding delegation queue
+// 0x0D<AccAddress><ID> : Unbonding delegation index by delegator
+// 0x0E<ValAddress><ID> : Unbonding delegation index by validator
+// 0x0F : Next unbonding delegation ID
+// 0x10<ValAddress> : Pending consensus key rotation
+// 0x11<ValAddress> : Validator exit
+// 0x12<ValAddress> : Standby validator
+// 0x13 : Uptime epoch in progress
+// 0x14<ValAddress><Epoch> : Validator uptime and rank over an epoch
+// 0x15<ID> : Redelegation
+// 0x16<Time><ID> : Redelegation queue
+// 0x17<AccAddress><ID> : Redelegation index by delegator
+// 0x18<ValAddress><ID> : Redelegation index by source validator
+// 0x19 : Next redelegation ID
+// 0x1A<Time><ValAddress> : Validator removal queue
+// 0x1B<Epoch><ValAddress> : Validator uptime and rank index by epoch
 var (
 	ValidatorsKey              = []byte{0x00} // Validators key prefix.
 	ValidatorsByMonikerKey     = []byte{0x01} // Validators by moniker prefix.
@@ -18,6 +43,28 @@ var (
 	RemovingValidatorQueue     = []byte{0x04} // Validators that are pending to be removed from the validator set.
 	ReactivatingValidatorQueue = []byte{0x05} // Validators that are pending to be reactivated in the set.
 	ValidatorJailInfo          = []byte{0x06} // Validator Jail Info (JailTime, etc)
+	ValidatorPowerKey          = []byte{0x07} // Last consensus power sent to tendermint for the validator.
+
+	ValidatorBondKey              = []byte{0x08} // Tokens bonded to the validator.
+	DelegationKey                 = []byte{0x09} // Delegations by delegator and validator.
+	DelegationByValidatorIndexKey = []byte{0x0A} // Delegations by validator and delegator.
+	UnbondingDelegationKey        = []byte{0x0B} // Unbonding delegations by ID.
+	UnbondingQueueKey             = []byte{0x0C} // Unbonding delegations by completion time.
+	UnbondingByDelegatorIndexKey  = []byte{0x0D} // Unbonding delegations by delegator.
+	UnbondingByValidatorIndexKey  = []byte{0x0E} // Unbonding delegations by validator.
+	NextUnbondingDelegationIDKey  = []byte{0x0F} // ID assigned to the next unbonding delegation.
+	ConsensusKeyRotationKey       = []byte{0x10} // Consensus keys replacing the keys of validators in the next end blocker.
+	ValidatorExitKey              = []byte{0x11} // Validators leaving the validator set.
+	StandbyValidatorQueue         = []byte{0x12} // Active validators that do not fit in the validator set.
+	CurrentUptimeEpochKey         = []byte{0x13} // Epoch of the validator uptime and rank history in progress.
+	ValidatorEpochKey             = []byte{0x14} // Uptime and rank of the validators by validator and epoch.
+	RedelegationKey               = []byte{0x15} // Redelegations by ID.
+	RedelegationQueueKey          = []byte{0x16} // Redelegations by completion time.
+	RedelegationByDelegatorKey    = []byte{0x17} // Redelegations by delegator.
+	RedelegationBySrcValidatorKey = []byte{0x18} // Redelegations by source validator.
+	NextRedelegationIDKey         = []byte{0x19} // ID assigned to the next redelegation.
+	ValidatorRemovalQueueKey      = []byte{0x1A} // Validators that left the validator set by removal time.
+	ValidatorEpochByEpochKey      = []byte{0x1B} // Uptime and rank of the validators by epoch and validator.
 )
 
 // GetValidatorKey gets the key for the validator with address
@@ -52,3 +99,119 @@ func GetReactivatingValidatorKey(operatorAddress sdk.ValAddress) []byte {
 func GetValidatorJailInfoKey(operatorAddress sdk.ValAddress) []byte {
 	return append(ValidatorJailInfo, operatorAddress.Bytes()...)
 }
+
+func GetValidatorPowerKey(operatorAddress sdk.ValAddress) []byte {
+	return append(ValidatorPowerKey, operatorAddress.Bytes()...)
+}
+
+func GetValidatorBondKey(operatorAddress sdk.ValAddress) []byte {
+	return append(ValidatorBondKey, operatorAddress.Bytes()...)
+}
+
+func GetDelegationsKey(delegator sdk.AccAddress) []byte {
+	return append(DelegationKey, delegator.Bytes()...)
+}
+
+func GetDelegationKey(delegator sdk.AccAddress, operatorAddress sdk.ValAddress) []byte {
+	return append(GetDelegationsKey(delegator), operatorAddress.Bytes()...)
+}
+
+func GetValidatorDelegationsKey(operatorAddress sdk.ValAddress) []byte {
+	return append(DelegationByValidatorIndexKey, operatorAddress.Bytes()...)
+}
+
+func GetDelegationByValidatorIndexKey(operatorAddress sdk.ValAddress, delegator sdk.AccAddress) []byte {
+	return append(GetValidatorDelegationsKey(operatorAddress), delegator.Bytes()...)
+}
+
+func GetUnbondingDelegationKey(id uint64) []byte {
+	return append(UnbondingDelegationKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetUnbondingQueueTimeKey(completionTime time.Time) []byte {
+	return append(UnbondingQueueKey, sdk.FormatTimeBytes(completionTime)...)
+}
+
+func GetUnbondingQueueKey(completionTime time.Time, id uint64) []byte {
+	return append(GetUnbondingQueueTimeKey(completionTime), sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetDelegatorUnbondingsKey(delegator sdk.AccAddress) []byte {
+	return append(UnbondingByDelegatorIndexKey, delegator.Bytes()...)
+}
+
+func GetUnbondingByDelegatorIndexKey(delegator sdk.AccAddress, id uint64) []byte {
+	return append(GetDelegatorUnbondingsKey(delegator), sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetValidatorUnbondingsKey(operatorAddress sdk.ValAddress) []byte {
+	return append(UnbondingByValidatorIndexKey, operatorAddress.Bytes()...)
+}
+
+func GetUnbondingByValidatorIndexKey(operatorAddress sdk.ValAddress, id uint64) []byte {
+	return append(GetValidatorUnbondingsKey(operatorAddress), sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetRedelegationKey(id uint64) []byte {
+	return append(RedelegationKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetRedelegationQueueTimeKey(completionTime time.Time) []byte {
+	return append(RedelegationQueueKey, sdk.FormatTimeBytes(completionTime)...)
+}
+
+func GetRedelegationQueueKey(completionTime time.Time, id uint64) []byte {
+	return append(GetRedelegationQueueTimeKey(completionTime), sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetDelegatorRedelegationsKey(delegator sdk.AccAddress) []byte {
+	return append(RedelegationByDelegatorKey, delegator.Bytes()...)
+}
+
+func GetRedelegationByDelegatorIndexKey(delegator sdk.AccAddress, id uint64) []byte {
+	return append(GetDelegatorRedelegationsKey(delegator), sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetSrcValidatorRedelegationsKey(operatorAddress sdk.ValAddress) []byte {
+	return append(RedelegationBySrcValidatorKey, operatorAddress.Bytes()...)
+}
+
+func GetRedelegationBySrcValidatorIndexKey(operatorAddress sdk.ValAddress, id uint64) []byte {
+	return append(GetSrcValidatorRedelegationsKey(operatorAddress), sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetConsensusKeyRotationKey(operatorAddress sdk.ValAddress) []byte {
+	return append(ConsensusKeyRotationKey, operatorAddress.Bytes()...)
+}
+
+func GetValidatorExitKey(operatorAddress sdk.ValAddress) []byte {
+	return append(ValidatorExitKey, operatorAddress.Bytes()...)
+}
+
+func GetValidatorRemovalQueueTimeKey(removalTime time.Time) []byte {
+	return append(ValidatorRemovalQueueKey, sdk.FormatTimeBytes(removalTime)...)
+}
+
+func GetValidatorRemovalQueueKey(removalTime time.Time, operatorAddress sdk.ValAddress) []byte {
+	return append(GetValidatorRemovalQueueTimeKey(removalTime), operatorAddress.Bytes()...)
+}
+
+func GetStandbyValidatorKey(operatorAddress sdk.ValAddress) []byte {
+	return append(StandbyValidatorQueue, operatorAddress.Bytes()...)
+}
+
+func GetValidatorEpochsKey(operatorAddress sdk.ValAddress) []byte {
+	return append(ValidatorEpochKey, operatorAddress.Bytes()...)
+}
+
+func GetValidatorEpochKey(operatorAddress sdk.ValAddress, epoch uint64) []byte {
+	return append(GetValidatorEpochsKey(operatorAddress), sdk.Uint64ToBigEndian(epoch)...)
+}
+
+func GetValidatorEpochByEpochPrefix(epoch uint64) []byte {
+	return append(ValidatorEpochByEpochKey, sdk.Uint64ToBigEndian(epoch)...)
+}
+
+func GetValidatorEpochByEpochKey(epoch uint64, operatorAddress sdk.ValAddress) []byte {
+	return append(GetValidatorEpochByEpochPrefix(epoch), operatorAddress.Bytes()...)
+}
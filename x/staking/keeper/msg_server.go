@@ -79,11 +79,12 @@ func (k msgServer) ProposalUnjailValidator(goCtx context.Context, msg *types.Msg
 		return nil, fmt.Errorf("validator jailing info not found")
 	}
 
-	if info.Time.Add(time.Duration(maxUnjailingTime) * time.Minute).Before(ctx.BlockTime()) {
+	// a JAIL_MAX_TIME of 0 lets the validators be unjailed at any time
+	if maxUnjailingTime != 0 && info.Time.Add(time.Duration(maxUnjailingTime)*time.Minute).Before(ctx.BlockTime()) {
 		return nil, fmt.Errorf("time to unjail passed")
 	}
 
-	proposalID, err := k.CreateAndSaveProposalWithContent(ctx, types.NewProposalUnjailValidator(
+	proposalID, err := k.CreateAndSaveProposalWithContent(ctx, msg.Proposer, types.NewProposalUnjailValidator(
 		msg.Proposer,
 		msg.Hash,
 		msg.Reference,
@@ -97,7 +98,93 @@ func (k msgServer) ProposalUnjailValidator(goCtx context.Context, msg *types.Msg
 	}, nil
 }
 
-func (k msgServer) CreateAndSaveProposalWithContent(ctx sdk.Context, content customgovtypes.Content) (uint64, error) {
+func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*types.MsgDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.keeper.Delegate(ctx, msg.Delegator, msg.ValKey, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDelegateResponse{}, nil
+}
+
+func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (*types.MsgUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	completionTime, err := k.keeper.Undelegate(ctx, msg.Delegator, msg.ValKey, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUndelegateResponse{
+		CompletionTime: completionTime,
+	}, nil
+}
+
+func (k msgServer) Redelegate(goCtx context.Context, msg *types.MsgRedelegate) (*types.MsgRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.keeper.Redelegate(ctx, msg.Delegator, msg.SrcValKey, msg.DstValKey, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRedelegateResponse{}, nil
+}
+
+func (k msgServer) Unjail(goCtx context.Context, msg *types.MsgUnjail) (*types.MsgUnjailResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.keeper.SelfUnjail(ctx, msg.ValKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnjailResponse{}, nil
+}
+
+func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValidator) (*types.MsgEditValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.keeper.EditValidator(ctx, msg.ValKey, msg.Moniker, msg.Website, msg.Social, msg.Identity, msg.Commission)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgEditValidatorResponse{}, nil
+}
+
+func (k msgServer) RotateConsensusKey(goCtx context.Context, msg *types.MsgRotateConsensusKey) (*types.MsgRotateConsensusKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pk, ok := msg.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrInvalidPubKey, "Expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	err := k.keeper.RotateConsensusKey(ctx, msg.ValKey, pk)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRotateConsensusKeyResponse{}, nil
+}
+
+func (k msgServer) ExitValidator(goCtx context.Context, msg *types.MsgExitValidator) (*types.MsgExitValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	exitTime, err := k.keeper.ExitValidator(ctx, msg.ValKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExitValidatorResponse{
+		ExitTime: exitTime,
+	}, nil
+}
+
+func (k msgServer) CreateAndSaveProposalWithContent(ctx sdk.Context, proposer sdk.AccAddress, content customgovtypes.Content) (uint64, error) {
 	blockTime := ctx.BlockTime()
 	proposalID, err := k.govKeeper.GetNextProposalID(ctx)
 	if err != nil {
@@ -113,6 +200,15 @@ func (k msgServer) CreateAndSaveProposalWithContent(ctx sdk.Context, content cus
 		blockTime.Add(time.Minute*time.Duration(properties.ProposalEndTime)),
 		blockTime.Add(time.Minute*time.Duration(properties.ProposalEnactmentTime)),
 	)
+	if err != nil {
+		return 0, err
+	}
+	proposal.Proposer = proposer
+
+	err = k.govKeeper.CollectProposalDeposit(ctx, proposalID, proposer, content)
+	if err != nil {
+		return 0, err
+	}
 
 	k.govKeeper.SaveProposal(ctx, proposal)
 	k.govKeeper.AddToActiveProposals(ctx, proposal)
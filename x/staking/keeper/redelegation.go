@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"github.com/KiraCore/sekai/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetRedelegation returns the redelegation by its id.
+func (k Keeper) GetRedelegation(ctx sdk.Context, id uint64) (types.Redelegation, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(GetRedelegationKey(id))
+	if bz == nil {
+		return types.Redelegation{}, false
+	}
+
+	var redelegation types.Redelegation
+	k.cdc.MustUnmarshalBinaryBare(bz, &redelegation)
+
+	return redelegation, true
+}
+
+// AddRedelegation stores the redelegation and queues it by completion time.
+func (k Keeper) AddRedelegation(ctx sdk.Context, redelegation types.Redelegation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetRedelegationKey(redelegation.Id), k.cdc.MustMarshalBinaryBare(&redelegation))
+	store.Set(GetRedelegationQueueKey(redelegation.CompletionTime, redelegation.Id), sdk.Uint64ToBigEndian(redelegation.Id))
+	store.Set(GetRedelegationByDelegatorIndexKey(redelegation.Delegator, redelegation.Id), sdk.Uint64ToBigEndian(redelegation.Id))
+	store.Set(GetRedelegationBySrcValidatorIndexKey(redelegation.SrcValKey, redelegation.Id), sdk.Uint64ToBigEndian(redelegation.Id))
+}
+
+func (k Keeper) removeRedelegation(ctx sdk.Context, redelegation types.Redelegation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetRedelegationKey(redelegation.Id))
+	store.Delete(GetRedelegationQueueKey(redelegation.CompletionTime, redelegation.Id))
+	store.Delete(GetRedelegationByDelegatorIndexKey(redelegation.Delegator, redelegation.Id))
+	store.Delete(GetRedelegationBySrcValidatorIndexKey(redelegation.SrcValKey, redelegation.Id))
+}
+
+func (k Keeper) getNextRedelegationID(ctx sdk.Context) uint64 {
+	id := k.GetNextRedelegationID(ctx)
+	k.SetNextRedelegationID(ctx, id+1)
+
+	return id
+}
+
+// GetNextRedelegationID returns the ID assigned to the next redelegation.
+func (k Keeper) GetNextRedelegationID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(NextRedelegationIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextRedelegationID sets the ID assigned to the next redelegation.
+func (k Keeper) SetNextRedelegationID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(NextRedelegationIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetRedelegations returns all the redelegations, by ID.
+func (k Keeper) GetRedelegations(ctx sdk.Context) []types.Redelegation {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, RedelegationKey)
+	defer iter.Close()
+
+	redelegations := []types.Redelegation{}
+	for ; iter.Valid(); iter.Next() {
+		var redelegation types.Redelegation
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &redelegation)
+		redelegations = append(redelegations, redelegation)
+	}
+
+	return redelegations
+}
+
+// GetDelegatorRedelegations returns the redelegations of a delegator.
+func (k Keeper) GetDelegatorRedelegations(ctx sdk.Context, delegator sdk.AccAddress) []types.Redelegation {
+	return k.getRedelegationsByIndex(ctx, GetDelegatorRedelegationsKey(delegator))
+}
+
+// GetSrcValidatorRedelegations returns the redelegations moving tokens out of a validator.
+func (k Keeper) GetSrcValidatorRedelegations(ctx sdk.Context, valAddress sdk.ValAddress) []types.Redelegation {
+	return k.getRedelegationsByIndex(ctx, GetSrcValidatorRedelegationsKey(valAddress))
+}
+
+func (k Keeper) getRedelegationsByIndex(ctx sdk.Context, prefix []byte) []types.Redelegation {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	var redelegations []types.Redelegation
+	for ; iter.Valid(); iter.Next() {
+		redelegation, found := k.GetRedelegation(ctx, sdk.BigEndianToUint64(iter.Value()))
+		if found {
+			redelegations = append(redelegations, redelegation)
+		}
+	}
+
+	return redelegations
+}
+
+// hasReceivingRedelegation returns true when tokens of the delegator redelegated to the validator are
+// still in their unbonding time.
+func (k Keeper) hasReceivingRedelegation(ctx sdk.Context, delegator sdk.AccAddress, valAddress sdk.ValAddress) bool {
+	for _, redelegation := range k.GetDelegatorRedelegations(ctx, delegator) {
+		if redelegation.DstValKey.Equals(valAddress) && !redelegation.IsMature(ctx.BlockTime()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CompleteRedelegations removes the redelegations whose unbonding time is over, their tokens are no
+// longer slashed for the infractions of the source validator.
+// Called in each EndBlock
+func (k Keeper) CompleteRedelegations(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := store.Iterator(RedelegationQueueKey, sdk.PrefixEndBytes(GetRedelegationQueueTimeKey(ctx.BlockTime())))
+	defer iter.Close()
+
+	var redelegations []types.Redelegation
+	for ; iter.Valid(); iter.Next() {
+		redelegation, found := k.GetRedelegation(ctx, sdk.BigEndianToUint64(iter.Value()))
+		if found {
+			redelegations = append(redelegations, redelegation)
+		}
+	}
+
+	for _, redelegation := range redelegations {
+		k.removeRedelegation(ctx, redelegation)
+	}
+}
+
+// slashRedelegations burns the share of the tokens redelegated out of the validator after the infraction
+// height, from the delegations to the destination validators. Returns the amount burned.
+func (k Keeper) slashRedelegations(ctx sdk.Context, valAddress sdk.ValAddress, infractionHeight int64, fraction sdk.Dec) sdk.Int {
+	burned := sdk.ZeroInt()
+	for _, redelegation := range k.GetSrcValidatorRedelegations(ctx, valAddress) {
+		if redelegation.CreationHeight < infractionHeight || redelegation.IsMature(ctx.BlockTime()) {
+			continue
+		}
+
+		delegation, found := k.GetDelegation(ctx, redelegation.Delegator, redelegation.DstValKey)
+		if !found {
+			continue
+		}
+
+		amount := sdk.MinInt(redelegation.InitialBalance.ToDec().Mul(fraction).TruncateInt(), k.GetDelegationBalance(ctx, delegation))
+		if !amount.IsPositive() {
+			continue
+		}
+
+		if err := k.unbond(ctx, redelegation.Delegator, redelegation.DstValKey, amount); err != nil {
+			continue
+		}
+		burned = burned.Add(amount)
+	}
+
+	return burned
+}
@@ -1,9 +1,14 @@
 package keeper
 
 import (
+	"fmt"
+	"time"
+
+	customgovtypes "github.com/KiraCore/sekai/x/gov/types"
 	"github.com/KiraCore/sekai/x/staking/types"
 	customstakingtypes "github.com/KiraCore/sekai/x/staking/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // Activate a validator
@@ -13,12 +18,16 @@ func (k Keeper) Activate(ctx sdk.Context, valAddress sdk.ValAddress) error {
 		return err
 	}
 
+	if validator.IsRemoved() {
+		return customstakingtypes.ErrValidatorRemoved
+	}
+
 	if validator.IsPaused() {
 		return customstakingtypes.ErrValidatorPaused
 	}
 
 	k.setStatusToValidator(ctx, validator, customstakingtypes.Active)
-	k.addReactivatingValidator(ctx, validator)
+	k.AddReactivatingValidator(ctx, validator)
 
 	return nil
 }
@@ -30,21 +39,94 @@ func (k Keeper) Inactivate(ctx sdk.Context, valAddress sdk.ValAddress) error { /
 		return err
 	}
 
+	if validator.IsRemoved() {
+		return customstakingtypes.ErrValidatorRemoved
+	}
+
 	if validator.IsPaused() {
 		return customstakingtypes.ErrValidatorPaused
 	}
 
 	networkProperties := k.govkeeper.GetNetworkProperties(ctx)
+	previous := validator.Status
 	validator.Status = customstakingtypes.Inactive
 	validator.Rank = validator.Rank * int64(100-networkProperties.InactiveRankDecreasePercent) / 100
 
 	k.AddValidator(ctx, validator)
-	k.addRemovingValidator(ctx, validator)
+	k.AddRemovingValidator(ctx, validator)
+	k.recordValidatorStatusChange(ctx, validator, previous)
 
 	return nil
 }
 
-// HandleValidatorSignature manage rank and streak by block miss / sign result
+// Slash burns the fraction of the tokens bonded to the validator and of the tokens that started
+// unbonding or were redelegated from it at or after the infraction height. It returns the amount
+// of burned tokens.
+func (k Keeper) Slash(ctx sdk.Context, valAddress sdk.ValAddress, infractionHeight int64, fraction sdk.Dec) sdk.Int {
+	if !fraction.IsPositive() {
+		return sdk.ZeroInt()
+	}
+
+	if fraction.GT(sdk.OneDec()) {
+		fraction = sdk.OneDec()
+	}
+
+	// tokens that started unbonding after the infraction were still at stake when it was committed
+	unbondingBurned := sdk.ZeroInt()
+	for _, unbonding := range k.GetValidatorUnbondingDelegations(ctx, valAddress) {
+		if unbonding.CreationHeight < infractionHeight || unbonding.IsMature(ctx.BlockTime()) {
+			continue
+		}
+
+		amount := sdk.MinInt(unbonding.InitialBalance.ToDec().Mul(fraction).TruncateInt(), unbonding.Balance)
+		unbonding.Balance = unbonding.Balance.Sub(amount)
+		k.setUnbondingDelegation(ctx, unbonding)
+		unbondingBurned = unbondingBurned.Add(amount)
+	}
+
+	// tokens redelegated after the infraction are burned from the destination validators
+	redelegationBurned := k.slashRedelegations(ctx, valAddress, infractionHeight, fraction)
+
+	bond := k.GetValidatorBond(ctx, valAddress)
+	bondedBurned := bond.Tokens.ToDec().Mul(fraction).TruncateInt()
+	bond.Tokens = bond.Tokens.Sub(bondedBurned)
+	if bondedBurned.IsPositive() {
+		k.SetValidatorBond(ctx, bond)
+	}
+	bondedBurned = bondedBurned.Add(redelegationBurned)
+
+	if err := k.burnTokens(ctx, types.NotBondedPoolName, unbondingBurned); err != nil {
+		panic(err)
+	}
+
+	if err := k.burnTokens(ctx, types.BondedPoolName, bondedBurned); err != nil {
+		panic(err)
+	}
+
+	burned := unbondingBurned.Add(bondedBurned)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashValidatorBond,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyInfractionHeight, fmt.Sprintf("%d", infractionHeight)),
+			sdk.NewAttribute(types.AttributeKeyBurnedAmount, burned.String()),
+		),
+	)
+
+	return burned
+}
+
+func (k Keeper) burnTokens(ctx sdk.Context, pool string, amount sdk.Int) error {
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, pool, sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), amount)))
+}
+
+// HandleValidatorSignature manage rank and streak by block miss / sign result, the block is counted in the
+// uptime and rank history of the validator
 func (k Keeper) HandleValidatorSignature(ctx sdk.Context, valAddress sdk.ValAddress, missed bool) error {
 	validator, err := k.GetValidator(ctx, valAddress)
 	if err != nil {
@@ -66,6 +148,7 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, valAddress sdk.ValAddr
 		}
 	}
 	k.AddValidator(ctx, validator)
+	k.recordValidatorSignature(ctx, validator, missed)
 	return nil
 }
 
@@ -76,12 +159,16 @@ func (k Keeper) Pause(ctx sdk.Context, valAddress sdk.ValAddress) error {
 		return err
 	}
 
+	if validator.IsRemoved() {
+		return customstakingtypes.ErrValidatorRemoved
+	}
+
 	if validator.IsInactivated() {
 		return customstakingtypes.ErrValidatorInactive
 	}
 
 	k.setStatusToValidator(ctx, validator, customstakingtypes.Paused)
-	k.addRemovingValidator(ctx, validator)
+	k.AddRemovingValidator(ctx, validator)
 
 	return nil
 }
@@ -93,12 +180,16 @@ func (k Keeper) Unpause(ctx sdk.Context, valAddress sdk.ValAddress) error { // i
 		return err
 	}
 
+	if validator.IsRemoved() {
+		return customstakingtypes.ErrValidatorRemoved
+	}
+
 	if validator.IsInactivated() {
 		return customstakingtypes.ErrValidatorInactive
 	}
 
 	k.setStatusToValidator(ctx, validator, customstakingtypes.Active)
-	k.addReactivatingValidator(ctx, validator)
+	k.AddReactivatingValidator(ctx, validator)
 
 	return nil
 }
@@ -110,9 +201,14 @@ func (k Keeper) Jail(ctx sdk.Context, valAddress sdk.ValAddress) error {
 		return err
 	}
 
+	if validator.IsRemoved() {
+		return customstakingtypes.ErrValidatorRemoved
+	}
+
 	k.setStatusToValidator(ctx, validator, customstakingtypes.Jailed)
-	k.addRemovingValidator(ctx, validator)
+	k.AddRemovingValidator(ctx, validator)
 	k.setJailValidatorInfo(ctx, validator)
+	k.setActorStatus(ctx, validator, customgovtypes.Jailed)
 
 	return nil
 }
@@ -140,16 +236,114 @@ func (k Keeper) Unjail(ctx sdk.Context, valAddress sdk.ValAddress) error {
 		return err
 	}
 
+	if !validator.IsJailed() {
+		return customstakingtypes.ErrValidatorNotJailed
+	}
+
 	k.setStatusToValidator(ctx, validator, customstakingtypes.Active)
-	k.addReactivatingValidator(ctx, validator)
+	k.AddReactivatingValidator(ctx, validator)
 	k.removeJailValidatorInfo(ctx, validator)
+	k.setActorStatus(ctx, validator, customgovtypes.Active)
+
+	return nil
+}
+
+// SelfUnjail unjails a validator on its own request, it must have been jailed for at least the
+// MIN_JAIL_TIME network property.
+func (k Keeper) SelfUnjail(ctx sdk.Context, valAddress sdk.ValAddress) error {
+	validator, err := k.GetValidator(ctx, valAddress)
+	if err != nil {
+		return err
+	}
+
+	if !validator.IsJailed() {
+		return customstakingtypes.ErrValidatorNotJailed
+	}
+
+	info, found := k.GetValidatorJailInfo(ctx, valAddress)
+	if !found {
+		return fmt.Errorf("validator jailing info not found")
+	}
+
+	minJailTime := time.Duration(k.govkeeper.GetNetworkProperties(ctx).MinJailTime) * time.Minute
+	if unjailTime := info.Time.Add(minJailTime); ctx.BlockTime().Before(unjailTime) {
+		return errors.Wrapf(customstakingtypes.ErrMinJailTimeNotPassed, "validator can be unjailed from %s", unjailTime.Format(time.RFC3339))
+	}
+
+	if err := k.Unjail(ctx, valAddress); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			customstakingtypes.EventTypeUnjail,
+			sdk.NewAttribute(customstakingtypes.AttributeKeyValidator, valAddress.String()),
+			sdk.NewAttribute(customstakingtypes.AttributeKeyJailTime, info.Time.Format(time.RFC3339)),
+		),
+	)
+
+	return nil
+}
+
+// RemoveExpiredJailedValidators permanently removes the validators jailed for the JAIL_MAX_TIME network
+// property or longer, a JAIL_MAX_TIME of 0 keeps the validators jailed until they are unjailed. They leave
+// like exiting validators, their delegations are unbonded and they are deleted once the unbonding time is over.
+func (k Keeper) RemoveExpiredJailedValidators(ctx sdk.Context) error {
+	jailMaxTime := time.Duration(k.govkeeper.GetNetworkProperties(ctx).JailMaxTime) * time.Minute
+	if jailMaxTime == 0 {
+		return nil
+	}
+
+	for _, jail := range k.GetJailedValidators(ctx) {
+		if ctx.BlockTime().Before(jail.Info.Time.Add(jailMaxTime)) {
+			continue
+		}
+
+		validator, err := k.GetValidator(ctx, jail.ValKey)
+		if err != nil {
+			return err
+		}
+
+		exit, found := k.GetValidatorExit(ctx, validator.ValKey)
+		if !found {
+			exit = customstakingtypes.ValidatorExit{
+				ValKey: validator.ValKey,
+				Time:   ctx.BlockTime(),
+			}
+		}
+
+		if err := k.leaveValidatorSet(ctx, validator, exit); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				customstakingtypes.EventTypeRemoveJailedValidator,
+				sdk.NewAttribute(customstakingtypes.AttributeKeyValidator, validator.ValKey.String()),
+				sdk.NewAttribute(customstakingtypes.AttributeKeyJailTime, jail.Info.Time.Format(time.RFC3339)),
+			),
+		)
+	}
 
 	return nil
 }
 
+// setActorStatus sets the status of the network actor of the validator, if any.
+func (k Keeper) setActorStatus(ctx sdk.Context, validator customstakingtypes.Validator, status customgovtypes.ActorStatus) {
+	actor, found := k.govkeeper.GetNetworkActorByAddress(ctx, sdk.AccAddress(validator.ValKey))
+	if !found {
+		return
+	}
+
+	actor.Status = status
+	k.govkeeper.SaveNetworkActor(ctx, actor)
+}
+
 func (k Keeper) setStatusToValidator(ctx sdk.Context, validator customstakingtypes.Validator, status customstakingtypes.ValidatorStatus) {
+	previous := validator.Status
 	validator.Status = status
 	k.AddValidator(ctx, validator)
+	k.recordValidatorStatusChange(ctx, validator, previous)
 }
 
 func (k Keeper) setJailValidatorInfo(ctx sdk.Context, validator customstakingtypes.Validator) {
@@ -157,10 +351,35 @@ func (k Keeper) setJailValidatorInfo(ctx sdk.Context, validator customstakingtyp
 		Time: ctx.BlockTime(),
 	}
 
-	bz := k.cdc.MustMarshalBinaryBare(jailInfo)
+	k.SetValidatorJailInfo(ctx, validator.ValKey, jailInfo)
+}
+
+// SetValidatorJailInfo stores the information about the jail of the validator.
+func (k Keeper) SetValidatorJailInfo(ctx sdk.Context, valAddress sdk.ValAddress, info customstakingtypes.ValidatorJailInfo) {
+	bz := k.cdc.MustMarshalBinaryBare(info)
 
 	store := ctx.KVStore(k.storeKey)
-	store.Set(GetValidatorJailInfoKey(validator.ValKey), bz)
+	store.Set(GetValidatorJailInfoKey(valAddress), bz)
+}
+
+// GetJailedValidators returns the jail info of all the jailed validators.
+func (k Keeper) GetJailedValidators(ctx sdk.Context) []customstakingtypes.ValidatorJail {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, ValidatorJailInfo)
+	defer iter.Close()
+
+	jails := []customstakingtypes.ValidatorJail{}
+	for ; iter.Valid(); iter.Next() {
+		var info customstakingtypes.ValidatorJailInfo
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &info)
+		jails = append(jails, customstakingtypes.ValidatorJail{
+			ValKey: iter.Key()[len(ValidatorJailInfo):],
+			Info:   info,
+		})
+	}
+
+	return jails
 }
 
 func (k Keeper) removeJailValidatorInfo(ctx sdk.Context, validator customstakingtypes.Validator) {
@@ -201,7 +420,8 @@ func (k Keeper) GetReactivatingValidatorSet(ctx sdk.Context) [][]byte {
 	return validatorKeys
 }
 
-func (k Keeper) addRemovingValidator(ctx sdk.Context, validator types.Validator) {
+// AddRemovingValidator queues the validator to be removed from the set in the next end blocker.
+func (k Keeper) AddRemovingValidator(ctx sdk.Context, validator types.Validator) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(GetRemovingValidatorKey(validator.ValKey), validator.ValKey)
 }
@@ -211,7 +431,8 @@ func (k Keeper) RemoveRemovingValidator(ctx sdk.Context, validator types.Validat
 	store.Delete(GetRemovingValidatorKey(validator.ValKey))
 }
 
-func (k Keeper) addReactivatingValidator(ctx sdk.Context, validator types.Validator) {
+// AddReactivatingValidator queues the validator to join back the set in the next end blocker.
+func (k Keeper) AddReactivatingValidator(ctx sdk.Context, validator types.Validator) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(GetReactivatingValidatorKey(validator.ValKey), validator.ValKey)
 }
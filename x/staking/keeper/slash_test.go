@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	customgovtypes "github.com/KiraCore/sekai/x/gov/types"
 	"github.com/KiraCore/sekai/x/staking/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -447,3 +448,118 @@ func TestUnjailValidator(t *testing.T) {
 	_, found = app.CustomStakingKeeper.GetValidatorJailInfo(ctx, validator1.ValKey)
 	require.False(t, found)
 }
+
+func TestSelfUnjailValidator(t *testing.T) {
+	blockTime := time.Now()
+
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{
+		Time: blockTime,
+	})
+
+	properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	properties.MinJailTime = 5
+	properties.JailMaxTime = 10
+	app.CustomGovKeeper.SetNetworkProperties(ctx, properties)
+
+	validators := createValidators(t, app, ctx, 1)
+	validator1 := validators[0]
+	app.CustomStakingKeeper.AddValidator(ctx, validator1)
+	app.CustomGovKeeper.SaveNetworkActor(ctx, customgovtypes.NewDefaultActor(sdk.AccAddress(validator1.ValKey)))
+
+	err := app.CustomStakingKeeper.SelfUnjail(ctx, validator1.ValKey)
+	require.Equal(t, types.ErrValidatorNotJailed, err)
+
+	err = app.CustomStakingKeeper.Jail(ctx, validator1.ValKey)
+	require.NoError(t, err)
+	actor, found := app.CustomGovKeeper.GetNetworkActorByAddress(ctx, sdk.AccAddress(validator1.ValKey))
+	require.True(t, found)
+	require.Equal(t, customgovtypes.Jailed, actor.Status)
+
+	// The minimum jail time is not over.
+	ctx = ctx.WithBlockTime(blockTime.Add(4 * time.Minute))
+	err = app.CustomStakingKeeper.SelfUnjail(ctx, validator1.ValKey)
+	require.True(t, types.ErrMinJailTimeNotPassed.Is(err))
+
+	ctx = ctx.WithBlockTime(blockTime.Add(5 * time.Minute)).WithEventManager(sdk.NewEventManager())
+	err = app.CustomStakingKeeper.SelfUnjail(ctx, validator1.ValKey)
+	require.NoError(t, err)
+
+	validator, err := app.CustomStakingKeeper.GetValidator(ctx, validator1.ValKey)
+	require.NoError(t, err)
+	require.True(t, validator.IsActive())
+	_, found = app.CustomStakingKeeper.GetValidatorJailInfo(ctx, validator1.ValKey)
+	require.False(t, found)
+
+	actor, found = app.CustomGovKeeper.GetNetworkActorByAddress(ctx, sdk.AccAddress(validator1.ValKey))
+	require.True(t, found)
+	require.Equal(t, customgovtypes.Active, actor.Status)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeUnjail, events[0].Type)
+}
+
+func TestRemoveExpiredJailedValidators(t *testing.T) {
+	blockTime := time.Now()
+
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{
+		Time: blockTime,
+	})
+
+	properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	properties.JailMaxTime = 10
+	app.CustomGovKeeper.SetNetworkProperties(ctx, properties)
+
+	validators := createValidators(t, app, ctx, 2)
+	for _, validator := range validators {
+		app.CustomStakingKeeper.AddValidator(ctx, validator)
+		app.CustomGovKeeper.SaveNetworkActor(ctx, customgovtypes.NewDefaultActor(sdk.AccAddress(validator.ValKey)))
+	}
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(10))
+	require.NoError(t, app.CustomStakingKeeper.Delegate(ctx, addrs[0], validators[0].ValKey, sdk.NewInt(1000)))
+
+	err := app.CustomStakingKeeper.Jail(ctx, validators[0].ValKey)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(blockTime.Add(time.Minute))
+	err = app.CustomStakingKeeper.Jail(ctx, validators[1].ValKey)
+	require.NoError(t, err)
+
+	// Only the first validator is jailed for the jail max time.
+	ctx = ctx.WithBlockTime(blockTime.Add(10 * time.Minute)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, app.CustomStakingKeeper.RemoveExpiredJailedValidators(ctx))
+
+	removed, err := app.CustomStakingKeeper.GetValidator(ctx, validators[0].ValKey)
+	require.NoError(t, err)
+	require.True(t, removed.IsRemoved())
+	_, found := app.CustomStakingKeeper.GetValidatorJailInfo(ctx, validators[0].ValKey)
+	require.False(t, found)
+
+	actor, found := app.CustomGovKeeper.GetNetworkActorByAddress(ctx, sdk.AccAddress(validators[0].ValKey))
+	require.True(t, found)
+	require.Equal(t, customgovtypes.Removed, actor.Status)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventTypeRemoveJailedValidator, events[len(events)-1].Type)
+
+	// The removed validator leaves like an exiting validator.
+	require.Len(t, app.CustomStakingKeeper.GetValidatorDelegations(ctx, validators[0].ValKey), 0)
+	unbondings := app.CustomStakingKeeper.GetDelegatorUnbondingDelegations(ctx, addrs[0])
+	require.Len(t, unbondings, 1)
+	require.Equal(t, sdk.NewInt(1000), unbondings[0].Balance)
+	exit, found := app.CustomStakingKeeper.GetValidatorExit(ctx, validators[0].ValKey)
+	require.True(t, found)
+	require.Equal(t, unbondings[0].CompletionTime, exit.RemovalTime)
+
+	jailed, err := app.CustomStakingKeeper.GetValidator(ctx, validators[1].ValKey)
+	require.NoError(t, err)
+	require.True(t, jailed.IsJailed())
+
+	// A removed validator can not come back.
+	require.Equal(t, types.ErrValidatorNotJailed, app.CustomStakingKeeper.Unjail(ctx, validators[0].ValKey))
+	require.Equal(t, types.ErrValidatorRemoved, app.CustomStakingKeeper.Activate(ctx, validators[0].ValKey))
+	require.Equal(t, types.ErrValidatorRemoved, app.CustomStakingKeeper.Delegate(ctx, sdk.AccAddress(validators[1].ValKey), validators[0].ValKey, sdk.NewInt(10)))
+}
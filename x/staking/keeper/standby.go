@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/KiraCore/sekai/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// sortValidatorsByRank orders the validators by rank, the highest streak first on equal rank and the
+// lowest address first on equal streak.
+func sortValidatorsByRank(validators []types.Validator) {
+	sort.SliceStable(validators, func(i, j int) bool {
+		if validators[i].Rank != validators[j].Rank {
+			return validators[i].Rank > validators[j].Rank
+		}
+
+		if validators[i].Streak != validators[j].Streak {
+			return validators[i].Streak > validators[j].Streak
+		}
+
+		return bytes.Compare(validators[i].ValKey, validators[j].ValKey) < 0
+	})
+}
+
+// SplitValidatorSet returns the active validators holding a seat in the validator set ordered by address,
+// and the active validators that do not fit in the MAX_VALIDATORS network property ordered by rank.
+func (k Keeper) SplitValidatorSet(ctx sdk.Context) (setVals, standbyVals []types.Validator) {
+	activeVals := k.GetActiveValidatorSet(ctx)
+
+	maxValidators := int(k.MaxValidators(ctx))
+	if len(activeVals) <= maxValidators {
+		return activeVals, nil
+	}
+
+	ranked := append([]types.Validator{}, activeVals...)
+	sortValidatorsByRank(ranked)
+
+	seats := make(map[string]bool)
+	for _, validator := range ranked[:maxValidators] {
+		seats[validator.ValKey.String()] = true
+	}
+
+	for _, validator := range activeVals {
+		if seats[validator.ValKey.String()] {
+			setVals = append(setVals, validator)
+		}
+	}
+
+	return setVals, ranked[maxValidators:]
+}
+
+// GetStandbyValidators returns the validators waiting for a seat in the validator set, ordered by the
+// rank that decides which of them joins the set first.
+func (k Keeper) GetStandbyValidators(ctx sdk.Context) []types.Validator {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), StandbyValidatorQueue)
+	defer iterator.Close()
+
+	validators := []types.Validator{}
+	for ; iterator.Valid(); iterator.Next() {
+		validator, err := k.GetValidator(ctx, iterator.Value())
+		if err != nil {
+			panic(err)
+		}
+
+		validators = append(validators, validator)
+	}
+
+	sortValidatorsByRank(validators)
+
+	return validators
+}
+
+// IsStandbyValidator returns if the validator is active but out of the tendermint set.
+func (k Keeper) IsStandbyValidator(ctx sdk.Context, valAddress sdk.ValAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(GetStandbyValidatorKey(valAddress))
+}
+
+func (k Keeper) AddStandbyValidator(ctx sdk.Context, valAddress sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetStandbyValidatorKey(valAddress), valAddress)
+}
+
+func (k Keeper) RemoveStandbyValidator(ctx sdk.Context, valAddress sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetStandbyValidatorKey(valAddress))
+}
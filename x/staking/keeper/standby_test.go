@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestApplyAndReturnValidatorSetUpdates_MaxValidators(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	properties.MaxValidators = 2
+	app.CustomGovKeeper.SetNetworkProperties(ctx, properties)
+	require.Equal(t, uint32(2), app.CustomStakingKeeper.MaxValidators(ctx))
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.TokensFromConsensusPower(10))
+	var validators []types.Validator
+	for i, addr := range addrs {
+		validator, err := types.NewValidator(fmt.Sprintf("validator %d", i+1), "some-web.com", "A Social", "My Identity", sdk.NewDecWithPrec(10, 2), sdk.ValAddress(addr), ed25519.GenPrivKey().PubKey())
+		require.NoError(t, err)
+		validator.Rank = int64(10 - i)
+		app.CustomStakingKeeper.AddPendingValidator(ctx, validator)
+		validators = append(validators, validator)
+	}
+
+	// The lowest ranked validator waits in the standby queue.
+	updates, err := app.CustomStakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+	requireUpdate(t, validators[0], 1, updates[0])
+	requireUpdate(t, validators[1], 1, updates[1])
+
+	standbyVals := app.CustomStakingKeeper.GetStandbyValidators(ctx)
+	require.Len(t, standbyVals, 1)
+	require.Equal(t, validators[2].ValKey, standbyVals[0].ValKey)
+
+	// On equal rank the highest streak takes the seat.
+	validator, err := app.CustomStakingKeeper.GetValidator(ctx, validators[2].ValKey)
+	require.NoError(t, err)
+	validator.Rank = 9
+	validator.Streak = 1
+	app.CustomStakingKeeper.AddValidator(ctx, validator)
+
+	updates, err = app.CustomStakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+	requireUpdate(t, validators[1], 0, updates[0])
+	requireUpdate(t, validators[2], 1, updates[1])
+
+	standbyVals = app.CustomStakingKeeper.GetStandbyValidators(ctx)
+	require.Len(t, standbyVals, 1)
+	require.Equal(t, validators[1].ValKey, standbyVals[0].ValKey)
+
+	updates, err = app.CustomStakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Len(t, updates, 0)
+
+	// The standby validator takes the seat released by a paused validator.
+	require.NoError(t, app.CustomStakingKeeper.Pause(ctx, validators[0].ValKey))
+	updates, err = app.CustomStakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+	requireUpdate(t, validators[0], 0, updates[0])
+	requireUpdate(t, validators[1], 1, updates[1])
+	require.Len(t, app.CustomStakingKeeper.GetStandbyValidators(ctx), 0)
+}
+
+func TestApplyAndReturnValidatorSetUpdates_StandbyValidatorLeaves(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	properties.MaxValidators = 1
+	app.CustomGovKeeper.SetNetworkProperties(ctx, properties)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.TokensFromConsensusPower(10))
+	var validators []types.Validator
+	for i, addr := range addrs {
+		validator, err := types.NewValidator(fmt.Sprintf("validator %d", i+1), "some-web.com", "A Social", "My Identity", sdk.NewDecWithPrec(10, 2), sdk.ValAddress(addr), ed25519.GenPrivKey().PubKey())
+		require.NoError(t, err)
+		validator.Rank = int64(10 - i)
+		app.CustomStakingKeeper.AddPendingValidator(ctx, validator)
+		validators = append(validators, validator)
+	}
+
+	updates, err := app.CustomStakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+	require.True(t, app.CustomStakingKeeper.IsStandbyValidator(ctx, validators[1].ValKey))
+
+	// The standby validator is not in the tendermint set, no update is sent when it is jailed.
+	require.NoError(t, app.CustomStakingKeeper.Jail(ctx, validators[1].ValKey))
+	updates, err = app.CustomStakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Len(t, updates, 0)
+	require.False(t, app.CustomStakingKeeper.IsStandbyValidator(ctx, validators[1].ValKey))
+}
+
+func TestIsNetworkActive(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	properties.MinValidators = 2
+	properties.MaxValidators = 2
+	app.CustomGovKeeper.SetNetworkProperties(ctx, properties)
+
+	validators := createValidators(t, app, ctx, 3)
+	app.CustomStakingKeeper.AddValidator(ctx, validators[0])
+	validators[1].Status = types.Paused
+	app.CustomStakingKeeper.AddValidator(ctx, validators[1])
+	require.False(t, app.CustomStakingKeeper.IsNetworkActive(ctx))
+
+	app.CustomStakingKeeper.AddValidator(ctx, validators[2])
+	require.True(t, app.CustomStakingKeeper.IsNetworkActive(ctx))
+}
+
+func requireUpdate(t *testing.T, validator types.Validator, power int64, update abcitypes.ValidatorUpdate) {
+	consPk, err := validator.TmConsPubKey()
+	require.NoError(t, err)
+	require.Equal(t, consPk, update.PubKey)
+	require.Equal(t, power, update.Power)
+}
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"github.com/KiraCore/sekai/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetUnbondingDelegation returns the unbonding delegation by its id.
+func (k Keeper) GetUnbondingDelegation(ctx sdk.Context, id uint64) (types.UnbondingDelegation, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(GetUnbondingDelegationKey(id))
+	if bz == nil {
+		return types.UnbondingDelegation{}, false
+	}
+
+	var unbonding types.UnbondingDelegation
+	k.cdc.MustUnmarshalBinaryBare(bz, &unbonding)
+
+	return unbonding, true
+}
+
+func (k Keeper) setUnbondingDelegation(ctx sdk.Context, unbonding types.UnbondingDelegation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetUnbondingDelegationKey(unbonding.Id), k.cdc.MustMarshalBinaryBare(&unbonding))
+}
+
+// AddUnbondingDelegation stores the unbonding delegation and queues it by completion time.
+func (k Keeper) AddUnbondingDelegation(ctx sdk.Context, unbonding types.UnbondingDelegation) {
+	k.setUnbondingDelegation(ctx, unbonding)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetUnbondingQueueKey(unbonding.CompletionTime, unbonding.Id), sdk.Uint64ToBigEndian(unbonding.Id))
+	store.Set(GetUnbondingByDelegatorIndexKey(unbonding.Delegator, unbonding.Id), sdk.Uint64ToBigEndian(unbonding.Id))
+	store.Set(GetUnbondingByValidatorIndexKey(unbonding.ValKey, unbonding.Id), sdk.Uint64ToBigEndian(unbonding.Id))
+}
+
+func (k Keeper) removeUnbondingDelegation(ctx sdk.Context, unbonding types.UnbondingDelegation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetUnbondingDelegationKey(unbonding.Id))
+	store.Delete(GetUnbondingQueueKey(unbonding.CompletionTime, unbonding.Id))
+	store.Delete(GetUnbondingByDelegatorIndexKey(unbonding.Delegator, unbonding.Id))
+	store.Delete(GetUnbondingByValidatorIndexKey(unbonding.ValKey, unbonding.Id))
+}
+
+func (k Keeper) getNextUnbondingDelegationID(ctx sdk.Context) uint64 {
+	id := k.GetNextUnbondingDelegationID(ctx)
+	k.SetNextUnbondingDelegationID(ctx, id+1)
+
+	return id
+}
+
+// GetNextUnbondingDelegationID returns the ID assigned to the next unbonding delegation.
+func (k Keeper) GetNextUnbondingDelegationID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(NextUnbondingDelegationIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextUnbondingDelegationID sets the ID assigned to the next unbonding delegation.
+func (k Keeper) SetNextUnbondingDelegationID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(NextUnbondingDelegationIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetUnbondingDelegations returns all the unbonding delegations, by ID.
+func (k Keeper) GetUnbondingDelegations(ctx sdk.Context) []types.UnbondingDelegation {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, UnbondingDelegationKey)
+	defer iter.Close()
+
+	unbondings := []types.UnbondingDelegation{}
+	for ; iter.Valid(); iter.Next() {
+		var unbonding types.UnbondingDelegation
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &unbonding)
+		unbondings = append(unbondings, unbonding)
+	}
+
+	return unbondings
+}
+
+// GetDelegatorUnbondingDelegations returns the unbonding delegations of a delegator.
+func (k Keeper) GetDelegatorUnbondingDelegations(ctx sdk.Context, delegator sdk.AccAddress) []types.UnbondingDelegation {
+	return k.getUnbondingDelegationsByIndex(ctx, GetDelegatorUnbondingsKey(delegator))
+}
+
+// GetValidatorUnbondingDelegations returns the unbonding delegations from a validator.
+func (k Keeper) GetValidatorUnbondingDelegations(ctx sdk.Context, valAddress sdk.ValAddress) []types.UnbondingDelegation {
+	return k.getUnbondingDelegationsByIndex(ctx, GetValidatorUnbondingsKey(valAddress))
+}
+
+func (k Keeper) getUnbondingDelegationsByIndex(ctx sdk.Context, prefix []byte) []types.UnbondingDelegation {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	var unbondings []types.UnbondingDelegation
+	for ; iter.Valid(); iter.Next() {
+		unbonding, found := k.GetUnbondingDelegation(ctx, sdk.BigEndianToUint64(iter.Value()))
+		if found {
+			unbondings = append(unbondings, unbonding)
+		}
+	}
+
+	return unbondings
+}
+
+// GetMatureUnbondingDelegations returns the unbonding delegations whose unbonding time is over.
+func (k Keeper) GetMatureUnbondingDelegations(ctx sdk.Context) []types.UnbondingDelegation {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := store.Iterator(UnbondingQueueKey, sdk.PrefixEndBytes(GetUnbondingQueueTimeKey(ctx.BlockTime())))
+	defer iter.Close()
+
+	var unbondings []types.UnbondingDelegation
+	for ; iter.Valid(); iter.Next() {
+		unbonding, found := k.GetUnbondingDelegation(ctx, sdk.BigEndianToUint64(iter.Value()))
+		if found {
+			unbondings = append(unbondings, unbonding)
+		}
+	}
+
+	return unbondings
+}
+
+// CompleteUnbondings returns the tokens of the mature unbonding delegations to their delegators.
+// Called in each EndBlock
+func (k Keeper) CompleteUnbondings(ctx sdk.Context) error {
+	for _, unbonding := range k.GetMatureUnbondingDelegations(ctx) {
+		if unbonding.Balance.IsPositive() {
+			coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), unbonding.Balance))
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, unbonding.Delegator, coins); err != nil {
+				return err
+			}
+		}
+
+		k.removeUnbondingDelegation(ctx, unbonding)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteUnbonding,
+				sdk.NewAttribute(types.AttributeKeyValidator, unbonding.ValKey.String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, unbonding.Delegator.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, unbonding.Balance.String()),
+			),
+		)
+	}
+
+	return nil
+}
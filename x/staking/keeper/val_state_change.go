@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"errors"
+	"sort"
 
+	customgovtypes "github.com/KiraCore/sekai/x/gov/types"
 	"github.com/KiraCore/sekai/x/staking/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -22,6 +24,9 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []abci.ValidatorUpdate, err error) {
 	var valUpdate []abci.ValidatorUpdate
 
+	// Validators that need to be sent to tendermint even if their power did not change.
+	joiningVals := map[string]bool{}
+
 	valSet := k.GetPendingValidatorSet(ctx)
 	for _, val := range valSet {
 		k.AddValidator(ctx, val)
@@ -32,16 +37,7 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 			return nil, err
 		}
 
-		consPk, err := val.TmConsPubKey()
-		if err != nil {
-			return nil, err
-		}
-
-		valUpdate = append(valUpdate, abci.ValidatorUpdate{
-			Power:  1,
-			PubKey: consPk,
-		})
-
+		joiningVals[val.ValKey.String()] = true
 		k.RemovePendingValidator(ctx, val)
 	}
 
@@ -53,6 +49,19 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 			return nil, errors.New("validator not found")
 		}
 
+		k.RemoveRemovingValidator(ctx, validator)
+
+		// The validator was reactivated in the same block, it stays in the set.
+		if validator.IsActive() {
+			continue
+		}
+
+		// The validators in the standby queue are not in the tendermint set.
+		if k.IsStandbyValidator(ctx, validator.ValKey) {
+			k.RemoveStandbyValidator(ctx, validator.ValKey)
+			continue
+		}
+
 		consPk, err := validator.TmConsPubKey()
 		if err != nil {
 			return nil, err
@@ -62,10 +71,10 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 			Power:  0,
 			PubKey: consPk,
 		})
-		k.RemoveRemovingValidator(ctx, validator)
+		k.removeValidatorPower(ctx, validator.ValKey)
 	}
 
-	// Remove validators from the set, paused or inactivated.
+	// Include back in the set the validators that have been unpaused, activated or unjailed.
 	reactivateVals := k.GetReactivatingValidatorSet(ctx)
 	for _, val := range reactivateVals {
 		validator, err := k.GetValidator(ctx, val)
@@ -73,21 +82,224 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 			return nil, errors.New("validator not found")
 		}
 
+		joiningVals[validator.ValKey.String()] = true
+		k.RemoveReactivatingValidator(ctx, validator)
+	}
+
+	// Swap the consensus keys of the validators that rotated them, the validators in the set leave it
+	// with the old key and join it back with the new one.
+	for _, rotation := range k.GetConsensusKeyRotations(ctx) {
+		validator, err := k.GetValidator(ctx, rotation.ValKey)
+		if err != nil {
+			return nil, errors.New("validator not found")
+		}
+
+		k.DeleteConsensusKeyRotation(ctx, validator.ValKey)
+
+		oldConsAddr := validator.GetConsAddr()
+		oldConsPk, err := validator.TmConsPubKey()
+		if err != nil {
+			return nil, err
+		}
+
+		// validators that joined before powers were recorded are in the set with the flat power
+		_, found := k.GetValidatorPower(ctx, validator.ValKey)
+		standby := k.IsStandbyValidator(ctx, validator.ValKey)
+		if found || (validator.IsActive() && !joiningVals[validator.ValKey.String()] && !standby) {
+			valUpdate = append(valUpdate, abci.ValidatorUpdate{
+				Power:  0,
+				PubKey: oldConsPk,
+			})
+			k.removeValidatorPower(ctx, validator.ValKey)
+			joiningVals[validator.ValKey.String()] = true
+		}
+
+		validator.PubKey = rotation.PubKey
+		k.AddValidator(ctx, validator)
+		k.AfterConsensusKeyRotated(ctx, oldConsAddr, validator.GetConsAddr(), validator.ValKey)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRotateConsensusKey,
+				sdk.NewAttribute(types.AttributeKeyValidator, validator.ValKey.String()),
+				sdk.NewAttribute(types.AttributeKeyOldConsAddress, oldConsAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyNewConsAddress, validator.GetConsAddr().String()),
+			),
+		)
+	}
+
+	// The active validators that lost their seat to higher ranked validators leave the tendermint set and
+	// wait in the standby queue.
+	setVals, standbyVals := k.SplitValidatorSet(ctx)
+	for _, validator := range standbyVals {
+		if k.IsStandbyValidator(ctx, validator.ValKey) {
+			continue
+		}
+
+		k.AddStandbyValidator(ctx, validator.ValKey)
+
+		// the joining validators are not in the tendermint set yet
+		_, found := k.GetValidatorPower(ctx, validator.ValKey)
+		if !found && joiningVals[validator.ValKey.String()] {
+			continue
+		}
+
 		consPk, err := validator.TmConsPubKey()
 		if err != nil {
 			return nil, err
 		}
 
 		valUpdate = append(valUpdate, abci.ValidatorUpdate{
-			Power:  1,
+			Power:  0,
 			PubKey: consPk,
 		})
-		k.RemoveRemovingValidator(ctx, validator)
+		k.removeValidatorPower(ctx, validator.ValKey)
+	}
+
+	// Send the power of the joining validators, of those taking a seat from the standby queue and of
+	// those whose power changed.
+	powers := k.ConsensusPowers(ctx, setVals)
+	for i, validator := range setVals {
+		lastPower, found := k.GetValidatorPower(ctx, validator.ValKey)
+		if !found {
+			// validators that joined before powers were recorded have the flat power
+			lastPower = 1
+		}
+
+		if k.IsStandbyValidator(ctx, validator.ValKey) {
+			k.RemoveStandbyValidator(ctx, validator.ValKey)
+			joiningVals[validator.ValKey.String()] = true
+		}
+
+		if !joiningVals[validator.ValKey.String()] && lastPower == powers[i] {
+			continue
+		}
+
+		consPk, err := validator.TmConsPubKey()
+		if err != nil {
+			return nil, err
+		}
+
+		valUpdate = append(valUpdate, abci.ValidatorUpdate{
+			Power:  powers[i],
+			PubKey: consPk,
+		})
+		k.SetValidatorPower(ctx, validator.ValKey, powers[i])
 	}
 
 	return valUpdate, nil
 }
 
+// ConsensusPowers returns the consensus power of each of the validators following the validator
+// power mode network property. When the max validator power percent property is set, the power
+// of the largest validators is capped so that none of them holds more than that share of the
+// resulting total power.
+func (k Keeper) ConsensusPowers(ctx sdk.Context, validators []types.Validator) []int64 {
+	properties := k.govkeeper.GetNetworkProperties(ctx)
+
+	powers := make([]int64, len(validators))
+	for i, validator := range validators {
+		if properties.ValidatorPowerMode == customgovtypes.PowerModeBondedTokens && validator.IsActive() {
+			powers[i] = k.bondedTokensPower(ctx, validator)
+			continue
+		}
+		powers[i] = validator.ConsensusPower(properties.ValidatorPowerMode)
+	}
+
+	if properties.MaxValidatorPowerPercent == 0 || properties.MaxValidatorPowerPercent >= 100 {
+		return powers
+	}
+
+	capPowers(powers, int64(properties.MaxValidatorPowerPercent))
+	return powers
+}
+
+// bondedTokensPower returns the consensus power of the tokens bonded to the validator, an active
+// validator keeps a power of at least 1 so that it is not dropped from the tendermint set.
+func (k Keeper) bondedTokensPower(ctx sdk.Context, validator types.Validator) int64 {
+	power := sdk.TokensToConsensusPower(k.GetValidatorBond(ctx, validator.ValKey).Tokens)
+	if power < 1 {
+		return 1
+	}
+
+	return power
+}
+
+// capPowers lowers the powers of the largest validators to the highest common cap c for which
+// c <= percent * (c * capped + sum of the uncapped powers) / 100, so that the capped power is
+// redistributed to the rest of the set instead of being counted in the total it is compared to.
+// When the set is too small for any validator to stay under the share, the powers are equalized.
+func capPowers(powers []int64, percent int64) {
+	order := make([]int, len(powers))
+	totalPower := int64(0)
+	for i := range powers {
+		order[i] = i
+		totalPower += powers[i]
+	}
+	if totalPower == 0 {
+		return
+	}
+
+	sort.SliceStable(order, func(a, b int) bool {
+		return powers[order[a]] > powers[order[b]]
+	})
+
+	if powers[order[0]]*100 <= percent*totalPower {
+		return
+	}
+
+	restPower := totalPower
+	for capped := 1; capped < len(order); capped++ {
+		restPower -= powers[order[capped-1]]
+		if int64(capped)*percent >= 100 {
+			break
+		}
+
+		maxPower := percent * restPower / (100 - int64(capped)*percent)
+		if maxPower >= powers[order[capped]] && maxPower > 0 {
+			for _, i := range order[:capped] {
+				powers[i] = maxPower
+			}
+			return
+		}
+	}
+
+	minPower := int64(0)
+	for _, i := range order {
+		if powers[i] > 0 {
+			minPower = powers[i]
+		}
+	}
+	for _, i := range order {
+		if powers[i] > minPower {
+			powers[i] = minPower
+		}
+	}
+}
+
+// GetValidatorPower returns the last consensus power sent to tendermint for the validator.
+func (k Keeper) GetValidatorPower(ctx sdk.Context, valAddress sdk.ValAddress) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(GetValidatorPowerKey(valAddress))
+	if bz == nil {
+		return 0, false
+	}
+
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// SetValidatorPower records the consensus power sent to tendermint for the validator.
+func (k Keeper) SetValidatorPower(ctx sdk.Context, valAddress sdk.ValAddress, power int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetValidatorPowerKey(valAddress), sdk.Uint64ToBigEndian(uint64(power)))
+}
+
+func (k Keeper) removeValidatorPower(ctx sdk.Context, valAddress sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetValidatorPowerKey(valAddress))
+}
+
 // perform all the store operations for when a validator status becomes joined
 func (k Keeper) joinValidator(ctx sdk.Context, validator types.Validator) (types.Validator, error) {
 
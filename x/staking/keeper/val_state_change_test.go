@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/KiraCore/sekai/simapp"
+	customgovtypes "github.com/KiraCore/sekai/x/gov/types"
+	"github.com/KiraCore/sekai/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestKeeper_ConsensusPowers(t *testing.T) {
+	tests := []struct {
+		name           string
+		ranks          []int64
+		percent        uint64
+		expectedPowers []int64
+	}{
+		// the power of a rank is 1 plus its number of bits: 256 has a power of 10
+		{
+			name:           "no cap",
+			ranks:          []int64{256, 0, 0},
+			percent:        0,
+			expectedPowers: []int64{10, 1, 1},
+		},
+		{
+			name:           "largest validator under the cap",
+			ranks:          []int64{2, 1, 1},
+			percent:        50,
+			expectedPowers: []int64{3, 2, 2},
+		},
+		{
+			name:           "capped power is not counted in the total",
+			ranks:          []int64{9, 0, 0, 0, 0, 0},
+			percent:        33,
+			expectedPowers: []int64{2, 1, 1, 1, 1, 1},
+		},
+		{
+			name:           "several validators capped",
+			ranks:          []int64{1 << 38, 1 << 33, 256, 256, 256, 256},
+			percent:        30,
+			expectedPowers: []int64{30, 30, 10, 10, 10, 10},
+		},
+		{
+			name:           "set too small to respect the cap",
+			ranks:          []int64{256, 8},
+			percent:        30,
+			expectedPowers: []int64{5, 5},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			ctx := app.NewContext(false, tmproto.Header{})
+
+			properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+			properties.ValidatorPowerMode = customgovtypes.PowerModeRank
+			properties.MaxValidatorPowerPercent = tt.percent
+			app.CustomGovKeeper.SetNetworkProperties(ctx, properties)
+
+			validators := createValidators(t, app, ctx, len(tt.ranks))
+			for i := range validators {
+				validators[i].Rank = tt.ranks[i]
+			}
+
+			powers := app.CustomStakingKeeper.ConsensusPowers(ctx, validators)
+			require.Equal(t, tt.expectedPowers, powers)
+
+			if tt.percent == 0 || uint64(len(powers))*tt.percent < 100 {
+				return
+			}
+
+			totalPower := int64(0)
+			for _, power := range powers {
+				totalPower += power
+			}
+			for _, power := range powers {
+				require.LessOrEqual(t, power*100, int64(tt.percent)*totalPower)
+			}
+		})
+	}
+}
+
+func TestKeeper_ConsensusPowersFromBondedTokens(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+
+	properties := app.CustomGovKeeper.GetNetworkProperties(ctx)
+	properties.ValidatorPowerMode = customgovtypes.PowerModeBondedTokens
+	app.CustomGovKeeper.SetNetworkProperties(ctx, properties)
+
+	validators := createValidators(t, app, ctx, 3)
+	for _, validator := range validators {
+		app.CustomStakingKeeper.AddValidator(ctx, validator)
+	}
+
+	err := app.CustomStakingKeeper.Delegate(ctx, sdk.AccAddress(validators[0].ValKey), validators[0].ValKey, sdk.TokensFromConsensusPower(5))
+	require.NoError(t, err)
+	err = app.CustomStakingKeeper.Delegate(ctx, sdk.AccAddress(validators[1].ValKey), validators[1].ValKey, sdk.TokensFromConsensusPower(2))
+	require.NoError(t, err)
+
+	// validators without bonded tokens keep the minimum power
+	powers := app.CustomStakingKeeper.ConsensusPowers(ctx, validators)
+	require.Equal(t, []int64{5, 2, 1}, powers)
+
+	// inactive validators have no power
+	validators[1].Status = types.Paused
+	powers = app.CustomStakingKeeper.ConsensusPowers(ctx, validators)
+	require.Equal(t, []int64{5, 0, 1}, powers)
+}
@@ -3,6 +3,7 @@ package staking
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
@@ -44,11 +45,16 @@ func (b AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry
 }
 
 func (b AppModuleBasic) DefaultGenesis(marshaler codec.JSONMarshaler) json.RawMessage {
-	return nil
+	return marshaler.MustMarshalJSON(customstakingtypes.DefaultGenesis())
 }
 
 func (b AppModuleBasic) ValidateGenesis(marshaler codec.JSONMarshaler, config client.TxEncodingConfig, message json.RawMessage) error {
-	return nil
+	var genesisState customstakingtypes.GenesisState
+	if err := marshaler.UnmarshalJSON(message, &genesisState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", customstakingtypes.ModuleName, err)
+	}
+
+	return genesisState.Validate()
 }
 
 func (b AppModuleBasic) RegisterGRPCRoutes(clientCtx client.Context, serveMux *runtime.ServeMux) {
@@ -76,7 +82,17 @@ func (b AppModuleBasic) GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	txCommand.AddCommand(cli.GetTxClaimValidatorCmd(), proposalCmd)
+	txCommand.AddCommand(
+		cli.GetTxClaimValidatorCmd(),
+		cli.GetTxDelegateCmd(),
+		cli.GetTxUndelegateCmd(),
+		cli.GetTxRedelegateCmd(),
+		cli.GetTxUnjailCmd(),
+		cli.GetTxEditValidatorCmd(),
+		cli.GetTxRotateConsensusKeyCmd(),
+		cli.GetTxExitValidatorCmd(),
+		proposalCmd,
+	)
 
 	return txCommand
 }
@@ -116,28 +132,12 @@ func (am AppModule) InitGenesis(
 	var genesisState types.GenesisState
 	cdc.MustUnmarshalJSON(data, &genesisState)
 
-	valUpdate := make([]abci.ValidatorUpdate, len(genesisState.Validators))
-
-	for i, val := range genesisState.Validators {
-		am.customStakingKeeper.AddValidator(ctx, val)
-		am.customStakingKeeper.AfterValidatorJoined(ctx, val.GetConsAddr(), val.ValKey)
-
-		consPk, err := val.TmConsPubKey()
-		if err != nil {
-			panic(err)
-		}
-
-		valUpdate[i] = abci.ValidatorUpdate{
-			Power:  1,
-			PubKey: consPk,
-		}
-	}
-
-	return valUpdate
+	return InitGenesis(ctx, am.customStakingKeeper, genesisState)
 }
 
-func (am AppModule) ExportGenesis(context sdk.Context, marshaler codec.JSONMarshaler) json.RawMessage {
-	return nil
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) json.RawMessage {
+	gs := ExportGenesis(ctx, am.customStakingKeeper)
+	return cdc.MustMarshalJSON(gs)
 }
 
 func (am AppModule) RegisterInvariants(registry sdk.InvariantRegistry) {}
@@ -46,6 +46,135 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 			}
 		}
 	}`)
+
+	cdc.RegisterConcrete(&MsgDelegate{}, "kiraHub/MsgDelegate", nil)
+	functionmeta.AddNewFunction((&MsgDelegate{}).Type(), `{
+		"description": "MsgDelegate defines a message for bonding tokens to a validator.",
+		"parameters": {
+			"delegator": {
+				"type":        "address",
+				"description": "delegator account address"
+			},
+			"val_key": {
+				"type":        "val_address",
+				"description": "validator operator address"
+			},
+			"amount": {
+				"type":        "string",
+				"description": "amount of ukex to bond"
+			}
+		}
+	}`)
+
+	cdc.RegisterConcrete(&MsgUndelegate{}, "kiraHub/MsgUndelegate", nil)
+	functionmeta.AddNewFunction((&MsgUndelegate{}).Type(), `{
+		"description": "MsgUndelegate defines a message for unbonding tokens from a validator, the tokens are returned once the unbonding time is over.",
+		"parameters": {
+			"delegator": {
+				"type":        "address",
+				"description": "delegator account address"
+			},
+			"val_key": {
+				"type":        "val_address",
+				"description": "validator operator address"
+			},
+			"amount": {
+				"type":        "string",
+				"description": "amount of ukex to unbond"
+			}
+		}
+	}`)
+
+	cdc.RegisterConcrete(&MsgRedelegate{}, "kiraHub/MsgRedelegate", nil)
+	functionmeta.AddNewFunction((&MsgRedelegate{}).Type(), `{
+		"description": "MsgRedelegate defines a message for moving bonded tokens from a validator to another.",
+		"parameters": {
+			"delegator": {
+				"type":        "address",
+				"description": "delegator account address"
+			},
+			"src_val_key": {
+				"type":        "val_address",
+				"description": "operator address of the validator the tokens are moved from"
+			},
+			"dst_val_key": {
+				"type":        "val_address",
+				"description": "operator address of the validator the tokens are moved to"
+			},
+			"amount": {
+				"type":        "string",
+				"description": "amount of ukex to move"
+			}
+		}
+	}`)
+
+	cdc.RegisterConcrete(&MsgUnjail{}, "kiraHub/MsgUnjail", nil)
+	functionmeta.AddNewFunction((&MsgUnjail{}).Type(), `{
+		"description": "MsgUnjail defines a message for a jailed validator to unjail itself once jailed for the minimum jail time.",
+		"parameters": {
+			"val_key": {
+				"type":        "val_address",
+				"description": "validator operator address"
+			}
+		}
+	}`)
+
+	cdc.RegisterConcrete(&MsgEditValidator{}, "kiraHub/MsgEditValidator", nil)
+	functionmeta.AddNewFunction((&MsgEditValidator{}).Type(), `{
+		"description": "MsgEditValidator defines a message for editing the profile and the commission of a validator, the empty fields are left unchanged.",
+		"parameters": {
+			"val_key": {
+				"type":        "val_address",
+				"description": "validator operator address"
+			},
+			"moniker": {
+				"type":        "string",
+				"description": "validator's name or nickname, unique among the validators."
+			},
+			"website": {
+				"type":        "string",
+				"description": "validator's website."
+			},
+			"social": {
+				"type":        "string",
+				"description": "validator's social link."
+			},
+			"identity": {
+				"type":        "string",
+				"description": "validator's identity information."
+			},
+			"commission": {
+				"type":        "float",
+				"description": "commision rate for this validator, limited by the COMMISSION_CHANGE_INTERVAL and MAX_COMMISSION_CHANGE network properties"
+			}
+		}
+	}`)
+
+	cdc.RegisterConcrete(&MsgRotateConsensusKey{}, "kiraHub/MsgRotateConsensusKey", nil)
+	functionmeta.AddNewFunction((&MsgRotateConsensusKey{}).Type(), `{
+		"description": "MsgRotateConsensusKey defines a message for replacing the consensus key of a validator in the next end blocker.",
+		"parameters": {
+			"val_key": {
+				"type":        "val_address",
+				"description": "validator operator address"
+			},
+			"pub_key": {
+				"type":        "string",
+				"description": "new validator bech32 public key"
+			}
+		}
+	}`)
+
+	cdc.RegisterConcrete(&MsgExitValidator{}, "kiraHub/MsgExitValidator", nil)
+	functionmeta.AddNewFunction((&MsgExitValidator{}).Type(), `{
+		"description": "MsgExitValidator defines a message for a validator to leave the validator set for good once the exit notice period is over, its delegations are unbonded.",
+		"parameters": {
+			"val_key": {
+				"type":        "val_address",
+				"description": "validator operator address"
+			}
+		}
+	}`)
 }
 
 // RegisterInterfaces register Msg and structs
@@ -53,6 +182,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgClaimValidator{},
 		&MsgProposalUnjailValidator{},
+		&MsgDelegate{},
+		&MsgUndelegate{},
+		&MsgRedelegate{},
+		&MsgUnjail{},
+		&MsgEditValidator{},
+		&MsgRotateConsensusKey{},
+		&MsgExitValidator{},
 	)
 
 	registry.RegisterInterface(
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewValidatorBond returns an empty bond for the validator.
+func NewValidatorBond(valKey sdk.ValAddress) ValidatorBond {
+	return ValidatorBond{
+		ValKey:          valKey,
+		Tokens:          sdk.ZeroInt(),
+		DelegatorShares: sdk.ZeroDec(),
+	}
+}
+
+// IsDepleted returns true when the bond has delegator shares but no tokens backing them,
+// this happens when the validator has been fully slashed.
+func (b ValidatorBond) IsDepleted() bool {
+	return b.Tokens.IsZero() && b.DelegatorShares.IsPositive()
+}
+
+// SharesFromTokens returns the amount of shares that the given tokens are worth.
+func (b ValidatorBond) SharesFromTokens(amount sdk.Int) sdk.Dec {
+	if b.DelegatorShares.IsZero() {
+		return amount.ToDec()
+	}
+
+	return b.DelegatorShares.MulInt(amount).QuoInt(b.Tokens)
+}
+
+// TokensFromShares returns the amount of tokens that the given shares are worth.
+func (b ValidatorBond) TokensFromShares(shares sdk.Dec) sdk.Dec {
+	if b.DelegatorShares.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	return shares.MulInt(b.Tokens).Quo(b.DelegatorShares)
+}
+
+// NewDelegation returns a delegation without shares.
+func NewDelegation(delegator sdk.AccAddress, valKey sdk.ValAddress) Delegation {
+	return Delegation{
+		Delegator: delegator,
+		ValKey:    valKey,
+		Shares:    sdk.ZeroDec(),
+	}
+}
+
+// NewUnbondingDelegation returns a new unbonding delegation.
+func NewUnbondingDelegation(id uint64, delegator sdk.AccAddress, valKey sdk.ValAddress, creationHeight int64, completionTime time.Time, balance sdk.Int) UnbondingDelegation {
+	return UnbondingDelegation{
+		Id:             id,
+		Delegator:      delegator,
+		ValKey:         valKey,
+		CreationHeight: creationHeight,
+		CompletionTime: completionTime,
+		InitialBalance: balance,
+		Balance:        balance,
+	}
+}
+
+// IsMature returns true when the unbonding time is over.
+func (u UnbondingDelegation) IsMature(currentTime time.Time) bool {
+	return !u.CompletionTime.After(currentTime)
+}
+
+// NewRedelegation returns a new redelegation.
+func NewRedelegation(id uint64, delegator sdk.AccAddress, srcValKey, dstValKey sdk.ValAddress, creationHeight int64, completionTime time.Time, balance sdk.Int) Redelegation {
+	return Redelegation{
+		Id:             id,
+		Delegator:      delegator,
+		SrcValKey:      srcValKey,
+		DstValKey:      dstValKey,
+		CreationHeight: creationHeight,
+		CompletionTime: completionTime,
+		InitialBalance: balance,
+	}
+}
+
+// IsMature returns true when the unbonding time is over.
+func (r Redelegation) IsMature(currentTime time.Time) bool {
+	return !r.CompletionTime.After(currentTime)
+}
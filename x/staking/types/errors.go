@@ -12,8 +12,22 @@ var ErrInvalidSocialLength = fmt.Errorf("invalid social length (max 64 bytes)")
 var ErrInvalidIdentityLength = fmt.Errorf("invalid identity length (max 64 bytes)")
 var ErrValidatorInactive = fmt.Errorf("validator is inactive")
 var ErrValidatorPaused = fmt.Errorf("validator is paused")
+var ErrDelegationNotFound = fmt.Errorf("delegation not found")
+var ErrInsufficientDelegation = fmt.Errorf("not enough delegation shares")
+var ErrValidatorBondDepleted = fmt.Errorf("validator has no tokens left to back its delegator shares")
+var ErrSelfRedelegation = fmt.Errorf("cannot redelegate to the same validator")
+var ErrValidatorNotJailed = fmt.Errorf("validator is not jailed")
+var ErrValidatorRemoved = fmt.Errorf("validator is removed")
+var ErrInvalidCommission = fmt.Errorf("commission must be between 0 and 1")
 
 var (
-	ErrNetworkActorNotFound = errors.Register(ModuleName, 2, "network actor not found")
-	ErrNotEnoughPermissions = errors.Register(ModuleName, 3, "not enough permissions")
+	ErrNetworkActorNotFound   = errors.Register(ModuleName, 2, "network actor not found")
+	ErrNotEnoughPermissions   = errors.Register(ModuleName, 3, "not enough permissions")
+	ErrMinJailTimeNotPassed   = errors.Register(ModuleName, 4, "minimum jail time not passed")
+	ErrMonikerAlreadyUsed     = errors.Register(ModuleName, 5, "moniker already used by another validator")
+	ErrCommissionChange       = errors.Register(ModuleName, 6, "commission change not allowed")
+	ErrConsensusKeyUsed       = errors.Register(ModuleName, 7, "consensus key already used")
+	ErrKeyRotationPending     = errors.Register(ModuleName, 8, "consensus key rotation already pending")
+	ErrValidatorExiting       = errors.Register(ModuleName, 9, "validator is exiting")
+	ErrTransitiveRedelegation = errors.Register(ModuleName, 10, "redelegation to the source validator is still in its unbonding time")
 )
@@ -0,0 +1,33 @@
+package types
+
+// staking module event types
+const (
+	EventTypeDelegate              = "delegate"
+	EventTypeUnbond                = "unbond"
+	EventTypeRedelegate            = "redelegate"
+	EventTypeCompleteUnbonding     = "complete_unbonding"
+	EventTypeSlashValidatorBond    = "slash_validator_bond"
+	EventTypeUnjail                = "unjail"
+	EventTypeRemoveJailedValidator = "remove_jailed_validator"
+	EventTypeEditValidator         = "edit_validator"
+	EventTypeRotateConsensusKey    = "rotate_consensus_key"
+	EventTypeExitValidator         = "exit_validator"
+	EventTypeRemoveValidator       = "remove_validator"
+	EventTypeEndUptimeEpoch        = "end_uptime_epoch"
+
+	AttributeKeyValidator        = "validator"
+	AttributeKeySrcValidator     = "source_validator"
+	AttributeKeyDstValidator     = "destination_validator"
+	AttributeKeyDelegator        = "delegator"
+	AttributeKeyAmount           = "amount"
+	AttributeKeyCompletionTime   = "completion_time"
+	AttributeKeyBurnedAmount     = "burned_amount"
+	AttributeKeyInfractionHeight = "infraction_height"
+	AttributeKeyJailTime         = "jail_time"
+	AttributeKeyMoniker          = "moniker"
+	AttributeKeyCommission       = "commission"
+	AttributeKeyOldConsAddress   = "old_consensus_address"
+	AttributeKeyNewConsAddress   = "new_consensus_address"
+	AttributeKeyExitTime         = "exit_time"
+	AttributeKeyEpoch            = "epoch"
+)
@@ -7,10 +7,13 @@ import (
 
 // StakingHooks event hooks for staking validator object (noalias)
 type StakingHooks interface {
-	AfterValidatorJoined(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress)  // Must be called when a validator is joined
-	AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress)                           // Must be called when a validator is created
-	BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress)                         // Must be called when a validator's state changes
-	AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) // Must be called when a validator is deleted
+	AfterValidatorJoined(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress)                     // Must be called when a validator is joined
+	AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress)                                              // Must be called when a validator is created
+	BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress)                                            // Must be called when a validator's state changes
+	AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress)                    // Must be called when a validator is deleted
+	AfterConsensusKeyRotated(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) // Must be called when a validator's consensus key is replaced
+	BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress)             // Must be called before the shares of a delegation change
+	AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress)                    // Must be called when a delegation is created, modified or removed
 }
 
 // GovKeeper expected governance keeper
@@ -20,4 +23,16 @@ type GovKeeper interface {
 	// GetNetworkActorsByAbsoluteWhitelistPermission returns all actors that have a specific whitelist permission,
 	// it does not matter if it is by role or by individual permission.
 	GetNetworkActorsByAbsoluteWhitelistPermission(ctx sdk.Context, perm customgovtypes.PermValue) []customgovtypes.NetworkActor
+	// GetNetworkActorByAddress returns the network actor of an address, found is false if there is none.
+	GetNetworkActorByAddress(ctx sdk.Context, address sdk.AccAddress) (customgovtypes.NetworkActor, bool)
+	// SaveNetworkActor stores a network actor.
+	SaveNetworkActor(ctx sdk.Context, actor customgovtypes.NetworkActor)
+}
+
+// BankKeeper defines the expected bank keeper used to escrow the delegated tokens
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 }
@@ -1,9 +1,18 @@
 package types
 
-import "github.com/cosmos/cosmos-sdk/codec/types"
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
 
 var _ types.UnpackInterfacesMessage = GenesisState{}
 
+// DefaultGenesis returns the default customstaking genesis state, validators join it through gentxs.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{}
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (data GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	for _, p := range data.Validators {
@@ -12,5 +21,205 @@ func (data GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, p := range data.PendingValidators {
+		err := p.UnpackInterfaces(unpacker)
+		if err != nil {
+			return err
+		}
+	}
+	for _, r := range data.ConsensusKeyRotations {
+		err := r.UnpackInterfaces(unpacker)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the genesis state is consistent, it is run by the validate-genesis command before the chain starts.
+func (data GenesisState) Validate() error {
+	validators := make(map[string]bool)
+	monikers := make(map[string]bool)
+	consAddresses := make(map[string]bool)
+	for _, val := range append(append([]Validator{}, data.Validators...), data.PendingValidators...) {
+		if val.ValKey.Empty() {
+			return fmt.Errorf("validator address not set")
+		}
+
+		if validators[val.ValKey.String()] {
+			return fmt.Errorf("duplicate validator %s", val.ValKey)
+		}
+		validators[val.ValKey.String()] = true
+
+		if err := val.Validate(); err != nil {
+			return fmt.Errorf("invalid validator %s: %w", val.ValKey, err)
+		}
+
+		if monikers[val.Moniker] {
+			return fmt.Errorf("duplicate validator moniker %s", val.Moniker)
+		}
+		monikers[val.Moniker] = true
+
+		if val.PubKey == nil {
+			return fmt.Errorf("consensus key of validator %s not set", val.ValKey)
+		}
+
+		pk, err := val.ConsPubKey()
+		if err != nil {
+			return fmt.Errorf("invalid consensus key of validator %s: %w", val.ValKey, err)
+		}
+
+		consAddress := string(pk.Address())
+		if consAddresses[consAddress] {
+			return fmt.Errorf("duplicate consensus key of validator %s", val.ValKey)
+		}
+		consAddresses[consAddress] = true
+	}
+
+	for _, valKey := range data.RemovingValidators {
+		if !validators[valKey.String()] {
+			return fmt.Errorf("removing validator %s does not exist", valKey)
+		}
+	}
+
+	for _, valKey := range data.ReactivatingValidators {
+		if !validators[valKey.String()] {
+			return fmt.Errorf("reactivating validator %s does not exist", valKey)
+		}
+	}
+
+	for _, jail := range data.JailedValidators {
+		if !validators[jail.ValKey.String()] {
+			return fmt.Errorf("jailed validator %s does not exist", jail.ValKey)
+		}
+	}
+
+	rotations := make(map[string]bool)
+	for _, rotation := range data.ConsensusKeyRotations {
+		if !validators[rotation.ValKey.String()] {
+			return fmt.Errorf("consensus key rotation of validator %s which does not exist", rotation.ValKey)
+		}
+
+		if rotations[rotation.ValKey.String()] {
+			return fmt.Errorf("duplicate consensus key rotation of validator %s", rotation.ValKey)
+		}
+		rotations[rotation.ValKey.String()] = true
+
+		if rotation.PubKey == nil {
+			return fmt.Errorf("consensus key rotation of validator %s has no key", rotation.ValKey)
+		}
+
+		pk, err := rotation.ConsPubKey()
+		if err != nil {
+			return fmt.Errorf("invalid consensus key rotation of validator %s: %w", rotation.ValKey, err)
+		}
+
+		consAddress := string(pk.Address())
+		if consAddresses[consAddress] {
+			return fmt.Errorf("consensus key rotation of validator %s to a key already used", rotation.ValKey)
+		}
+		consAddresses[consAddress] = true
+	}
+
+	exits := make(map[string]bool)
+	for _, exit := range data.ValidatorExits {
+		if !validators[exit.ValKey.String()] {
+			return fmt.Errorf("exit of validator %s which does not exist", exit.ValKey)
+		}
+
+		if exits[exit.ValKey.String()] {
+			return fmt.Errorf("duplicate exit of validator %s", exit.ValKey)
+		}
+		exits[exit.ValKey.String()] = true
+	}
+
+	epochs := make(map[string]bool)
+	for _, record := range data.ValidatorEpochs {
+		if !validators[record.ValKey.String()] {
+			return fmt.Errorf("epoch %d of validator %s which does not exist", record.Epoch, record.ValKey)
+		}
+
+		if record.Epoch == 0 || record.Epoch > data.CurrentEpoch.Number {
+			return fmt.Errorf("epoch %d of validator %s is not between 1 and the current epoch %d", record.Epoch, record.ValKey, data.CurrentEpoch.Number)
+		}
+
+		key := fmt.Sprintf("%s/%d", record.ValKey, record.Epoch)
+		if epochs[key] {
+			return fmt.Errorf("duplicate epoch %d of validator %s", record.Epoch, record.ValKey)
+		}
+		epochs[key] = true
+	}
+
+	bonds := make(map[string]bool)
+	for _, bond := range data.Bonds {
+		if !validators[bond.ValKey.String()] {
+			return fmt.Errorf("bond of validator %s which does not exist", bond.ValKey)
+		}
+
+		if bonds[bond.ValKey.String()] {
+			return fmt.Errorf("duplicate bond of validator %s", bond.ValKey)
+		}
+		bonds[bond.ValKey.String()] = true
+
+		if bond.Tokens.IsNil() || bond.Tokens.IsNegative() || bond.DelegatorShares.IsNil() || bond.DelegatorShares.IsNegative() {
+			return fmt.Errorf("invalid bond of validator %s", bond.ValKey)
+		}
+	}
+
+	for _, delegation := range data.Delegations {
+		if !bonds[delegation.ValKey.String()] {
+			return fmt.Errorf("delegation of %s to validator %s which has no bond", delegation.Delegator, delegation.ValKey)
+		}
+
+		if delegation.Delegator.Empty() || delegation.Shares.IsNil() || !delegation.Shares.IsPositive() {
+			return fmt.Errorf("invalid delegation of %s to validator %s", delegation.Delegator, delegation.ValKey)
+		}
+	}
+
+	// the unbonding delegation ids start at 1 when no next id is set
+	nextUnbondingID := data.NextUnbondingDelegationId
+	if nextUnbondingID == 0 {
+		nextUnbondingID = 1
+	}
+
+	unbondings := make(map[uint64]bool)
+	for _, unbonding := range data.UnbondingDelegations {
+		if unbondings[unbonding.Id] {
+			return fmt.Errorf("duplicate unbonding delegation %d", unbonding.Id)
+		}
+		unbondings[unbonding.Id] = true
+
+		if unbonding.Id >= nextUnbondingID {
+			return fmt.Errorf("unbonding delegation %d is not lower than the next unbonding delegation id %d", unbonding.Id, nextUnbondingID)
+		}
+
+		if unbonding.Delegator.Empty() || unbonding.Balance.IsNil() || unbonding.Balance.IsNegative() {
+			return fmt.Errorf("invalid unbonding delegation %d", unbonding.Id)
+		}
+	}
+
+	// the redelegation ids start at 1 when no next id is set
+	nextRedelegationID := data.NextRedelegationId
+	if nextRedelegationID == 0 {
+		nextRedelegationID = 1
+	}
+
+	redelegations := make(map[uint64]bool)
+	for _, redelegation := range data.Redelegations {
+		if redelegations[redelegation.Id] {
+			return fmt.Errorf("duplicate redelegation %d", redelegation.Id)
+		}
+		redelegations[redelegation.Id] = true
+
+		if redelegation.Id >= nextRedelegationID {
+			return fmt.Errorf("redelegation %d is not lower than the next redelegation id %d", redelegation.Id, nextRedelegationID)
+		}
+
+		if redelegation.Delegator.Empty() || redelegation.SrcValKey.Empty() || redelegation.DstValKey.Empty() ||
+			redelegation.InitialBalance.IsNil() || redelegation.InitialBalance.IsNegative() {
+			return fmt.Errorf("invalid redelegation %d", redelegation.Id)
+		}
+	}
+
 	return nil
 }
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -25,6 +26,31 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
 	Validators []Validator `protobuf:"bytes,1,rep,name=validators,proto3,casttype=Validator" json:"validators"`
+	// pending_validators are the claimed validators joining the set in the next end blocker.
+	PendingValidators []Validator `protobuf:"bytes,2,rep,name=pending_validators,json=pendingValidators,proto3,casttype=Validator" json:"pending_validators"`
+	// removing_validators are the validators leaving the set in the next end blocker.
+	RemovingValidators []github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,3,rep,name=removing_validators,json=removingValidators,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"removing_validators,omitempty"`
+	// reactivating_validators are the validators joining back the set in the next end blocker.
+	ReactivatingValidators []github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,4,rep,name=reactivating_validators,json=reactivatingValidators,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"reactivating_validators,omitempty"`
+	JailedValidators       []ValidatorJail                                 `protobuf:"bytes,5,rep,name=jailed_validators,json=jailedValidators,proto3" json:"jailed_validators"`
+	Bonds                  []ValidatorBond                                 `protobuf:"bytes,6,rep,name=bonds,proto3" json:"bonds"`
+	Delegations            []Delegation                                    `protobuf:"bytes,7,rep,name=delegations,proto3" json:"delegations"`
+	UnbondingDelegations   []UnbondingDelegation                           `protobuf:"bytes,8,rep,name=unbonding_delegations,json=unbondingDelegations,proto3" json:"unbonding_delegations"`
+	// next_unbonding_delegation_id is the ID assigned to the next unbonding delegation.
+	NextUnbondingDelegationId uint64 `protobuf:"varint,9,opt,name=next_unbonding_delegation_id,json=nextUnbondingDelegationId,proto3" json:"next_unbonding_delegation_id,omitempty"`
+	// consensus_key_rotations are the consensus keys replacing the keys of validators in the next end blocker.
+	ConsensusKeyRotations []ConsensusKeyRotation `protobuf:"bytes,10,rep,name=consensus_key_rotations,json=consensusKeyRotations,proto3" json:"consensus_key_rotations"`
+	// validator_exits are the validators leaving the validator set.
+	ValidatorExits []ValidatorExit `protobuf:"bytes,11,rep,name=validator_exits,json=validatorExits,proto3" json:"validator_exits"`
+	// current_epoch is the epoch of the validator uptime and rank history in progress, its number is 0 until
+	// the history starts.
+	CurrentEpoch UptimeEpoch `protobuf:"bytes,12,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch"`
+	// validator_epochs are the uptime and rank of the validators over the epochs kept in the history.
+	ValidatorEpochs []ValidatorEpoch `protobuf:"bytes,13,rep,name=validator_epochs,json=validatorEpochs,proto3" json:"validator_epochs"`
+	// redelegations are the redelegations whose unbonding time is not over.
+	Redelegations []Redelegation `protobuf:"bytes,14,rep,name=redelegations,proto3" json:"redelegations"`
+	// next_redelegation_id is the ID assigned to the next redelegation.
+	NextRedelegationId uint64 `protobuf:"varint,15,opt,name=next_redelegation_id,json=nextRedelegationId,proto3" json:"next_redelegation_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -67,27 +93,207 @@ func (m *GenesisState) GetValidators() []Validator {
 	return nil
 }
 
+func (m *GenesisState) GetPendingValidators() []Validator {
+	if m != nil {
+		return m.PendingValidators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRemovingValidators() []github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.RemovingValidators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReactivatingValidators() []github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ReactivatingValidators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetJailedValidators() []ValidatorJail {
+	if m != nil {
+		return m.JailedValidators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBonds() []ValidatorBond {
+	if m != nil {
+		return m.Bonds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegations() []Delegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnbondingDelegations() []UnbondingDelegation {
+	if m != nil {
+		return m.UnbondingDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextUnbondingDelegationId() uint64 {
+	if m != nil {
+		return m.NextUnbondingDelegationId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetConsensusKeyRotations() []ConsensusKeyRotation {
+	if m != nil {
+		return m.ConsensusKeyRotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorExits() []ValidatorExit {
+	if m != nil {
+		return m.ValidatorExits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCurrentEpoch() UptimeEpoch {
+	if m != nil {
+		return m.CurrentEpoch
+	}
+	return UptimeEpoch{}
+}
+
+func (m *GenesisState) GetValidatorEpochs() []ValidatorEpoch {
+	if m != nil {
+		return m.ValidatorEpochs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedelegations() []Redelegation {
+	if m != nil {
+		return m.Redelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextRedelegationId() uint64 {
+	if m != nil {
+		return m.NextRedelegationId
+	}
+	return 0
+}
+
+// ValidatorJail holds the jail info of a jailed validator.
+type ValidatorJail struct {
+	ValKey github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=val_key,json=valKey,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"val_key,omitempty" yaml:"val_key"`
+	Info   ValidatorJailInfo                             `protobuf:"bytes,2,opt,name=info,proto3" json:"info"`
+}
+
+func (m *ValidatorJail) Reset()         { *m = ValidatorJail{} }
+func (m *ValidatorJail) String() string { return proto.CompactTextString(m) }
+func (*ValidatorJail) ProtoMessage()    {}
+func (*ValidatorJail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14205810582f3203, []int{1}
+}
+func (m *ValidatorJail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorJail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorJail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorJail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorJail.Merge(m, src)
+}
+func (m *ValidatorJail) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorJail) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorJail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorJail proto.InternalMessageInfo
+
+func (m *ValidatorJail) GetValKey() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValKey
+	}
+	return nil
+}
+
+func (m *ValidatorJail) GetInfo() ValidatorJailInfo {
+	if m != nil {
+		return m.Info
+	}
+	return ValidatorJailInfo{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kira.staking.GenesisState")
+	proto.RegisterType((*ValidatorJail)(nil), "kira.staking.ValidatorJail")
 }
 
 func init() { proto.RegisterFile("genesis.proto", fileDescriptor_14205810582f3203) }
 
 var fileDescriptor_14205810582f3203 = []byte{
-	// 199 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4d, 0x4f, 0xcd, 0x4b,
-	0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0xc9, 0xce, 0x2c, 0x4a, 0xd4,
-	0x2b, 0x2e, 0x49, 0xcc, 0xce, 0xcc, 0x4b, 0x97, 0xe2, 0x85, 0x32, 0x20, 0x92, 0x52, 0x22, 0xe9,
-	0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11, 0x55, 0x8a, 0xe2, 0xe2, 0x71, 0x87, 0x98,
-	0x11, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xe4, 0xc5, 0xc5, 0x55, 0x96, 0x98, 0x93, 0x99, 0x92, 0x58,
-	0x92, 0x5f, 0x54, 0x2c, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x6d, 0x24, 0xae, 0x87, 0x6c, 0xae, 0x5e,
-	0x18, 0x4c, 0xde, 0x49, 0xf0, 0xc4, 0x3d, 0x79, 0x86, 0x5f, 0xf7, 0xe4, 0x39, 0xe1, 0x42, 0x41,
-	0x48, 0xba, 0x9d, 0x9c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
-	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x33,
-	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xdf, 0x3b, 0xb3, 0x28, 0xd1, 0x39,
-	0xbf, 0x28, 0x55, 0xbf, 0x38, 0x35, 0x3b, 0x31, 0x53, 0xbf, 0x42, 0x1f, 0x6a, 0x8f, 0x7e, 0x49,
-	0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x9d, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x87,
-	0x24, 0x1e, 0x7a, 0xeb, 0x00, 0x00, 0x00,
+	// 654 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0xbb, 0x50, 0x40, 0xa6, 0x2d, 0x3f, 0x46, 0x90, 0x05, 0x49, 0x5b, 0x7b, 0xaa, 0x07,
+	0x5a, 0xc5, 0x83, 0xd1, 0x8b, 0xda, 0x82, 0x06, 0x88, 0x1e, 0x6a, 0x24, 0xd1, 0x90, 0xac, 0xd3,
+	0x9d, 0x61, 0x19, 0xba, 0x9d, 0x69, 0x66, 0xa6, 0x9b, 0xf6, 0xbf, 0xf0, 0xcf, 0xf0, 0xe0, 0x1f,
+	0xc2, 0x91, 0xa3, 0xa7, 0xc6, 0xc0, 0x7f, 0xe0, 0x91, 0x93, 0xd9, 0xe9, 0x14, 0x66, 0xf9, 0xd1,
+	0x04, 0x4f, 0x3b, 0x79, 0xef, 0x7d, 0x3f, 0xef, 0xbb, 0xef, 0xed, 0x2c, 0xc8, 0x05, 0x84, 0x11,
+	0x49, 0x65, 0xa5, 0x23, 0xb8, 0xe2, 0x30, 0xdb, 0xa2, 0x02, 0x55, 0xa4, 0x42, 0x2d, 0xca, 0x82,
+	0xb5, 0x9c, 0x39, 0x0c, 0x93, 0x6b, 0x0b, 0x98, 0x84, 0x24, 0x40, 0x8a, 0x72, 0x66, 0x22, 0x4b,
+	0x01, 0x0f, 0xb8, 0x3e, 0x56, 0xe3, 0xd3, 0x30, 0x5a, 0xfa, 0x35, 0x0b, 0xb2, 0x1f, 0x86, 0xd8,
+	0xcf, 0x0a, 0x29, 0x02, 0x77, 0x01, 0x88, 0x50, 0x48, 0x31, 0x52, 0x5c, 0x48, 0xd7, 0x29, 0x4e,
+	0x96, 0x33, 0x9b, 0x2b, 0x15, 0xbb, 0x55, 0x65, 0x7f, 0x94, 0xaf, 0x2d, 0x9e, 0x0c, 0x0a, 0xa9,
+	0x8b, 0x41, 0x61, 0xf6, 0x32, 0xd4, 0xb0, 0xd4, 0xf0, 0x2b, 0x80, 0x1d, 0xc2, 0x30, 0x65, 0x81,
+	0x67, 0x31, 0x27, 0xee, 0xcd, 0x5c, 0x34, 0x94, 0xfd, 0x2b, 0x74, 0x13, 0x3c, 0x14, 0xa4, 0xcd,
+	0xa3, 0x6b, 0xec, 0xc9, 0xe2, 0x64, 0x39, 0x5b, 0x7b, 0x7e, 0x31, 0x28, 0x6c, 0x04, 0x54, 0x1d,
+	0x75, 0x9b, 0x15, 0x9f, 0xb7, 0xab, 0x3e, 0x97, 0x6d, 0x2e, 0xcd, 0x63, 0x43, 0xe2, 0x56, 0x55,
+	0xf5, 0x3b, 0x44, 0xc6, 0x4d, 0xdf, 0x61, 0x2c, 0x88, 0x94, 0x0d, 0x38, 0xa2, 0x59, 0x3d, 0x8e,
+	0xc1, 0x8a, 0x20, 0xc8, 0x57, 0x34, 0x42, 0xea, 0x5a, 0x9f, 0xf4, 0xff, 0xf6, 0x79, 0x64, 0x13,
+	0xad, 0x5e, 0x9f, 0xc0, 0xe2, 0x31, 0xa2, 0x21, 0xc1, 0x76, 0x97, 0x29, 0x3d, 0xa9, 0xc7, 0x77,
+	0x4c, 0x6a, 0x17, 0xd1, 0xb0, 0x96, 0x8e, 0xa7, 0xd5, 0x58, 0x18, 0x6a, 0x2d, 0xde, 0x4b, 0x30,
+	0xd5, 0xe4, 0x0c, 0x4b, 0x77, 0x7a, 0x2c, 0xa3, 0xc6, 0x19, 0x36, 0x8c, 0x61, 0x3d, 0x7c, 0x0b,
+	0x32, 0x57, 0x9f, 0x8e, 0x74, 0x67, 0xb4, 0xdc, 0x4d, 0xca, 0xb7, 0x2e, 0x0b, 0x8c, 0xd6, 0x96,
+	0xc0, 0x03, 0xb0, 0xdc, 0x65, 0x31, 0x2c, 0x9e, 0x99, 0xcd, 0x7a, 0xa0, 0x59, 0x4f, 0x92, 0xac,
+	0x2f, 0xa3, 0xd2, 0x1b, 0xd0, 0xa5, 0xee, 0xcd, 0x94, 0x84, 0x6f, 0xc0, 0x3a, 0x23, 0x3d, 0xe5,
+	0xdd, 0xd6, 0xc2, 0xa3, 0xd8, 0x9d, 0x2d, 0x3a, 0xe5, 0x74, 0x63, 0x35, 0xae, 0xb9, 0x05, 0xbd,
+	0x83, 0xe1, 0x77, 0xb0, 0xe2, 0x73, 0x26, 0x09, 0x93, 0x5d, 0xe9, 0xb5, 0x48, 0xdf, 0x13, 0x5c,
+	0x19, 0x83, 0x40, 0x1b, 0x2c, 0x25, 0x0d, 0xd6, 0x47, 0xc5, 0x7b, 0xa4, 0xdf, 0xe0, 0xca, 0x76,
+	0xb8, 0xec, 0xdf, 0x92, 0x93, 0x70, 0x17, 0xcc, 0x5f, 0x2e, 0xd1, 0x23, 0x3d, 0xaa, 0xa4, 0x9b,
+	0x19, 0xbb, 0x85, 0xed, 0x1e, 0x55, 0x06, 0x39, 0x17, 0xd9, 0x41, 0x09, 0xb7, 0x40, 0xce, 0xef,
+	0x0a, 0x41, 0x98, 0xf2, 0x48, 0x87, 0xfb, 0x47, 0x6e, 0xb6, 0xe8, 0x94, 0x33, 0x9b, 0xab, 0xd7,
+	0x86, 0xd8, 0x51, 0xb4, 0x4d, 0xb6, 0xe3, 0x02, 0xc3, 0xc9, 0x1a, 0x95, 0x8e, 0xc1, 0x8f, 0x60,
+	0xc1, 0x72, 0x14, 0x87, 0xa4, 0x9b, 0xd3, 0x96, 0xd6, 0xef, 0xb2, 0x64, 0xb1, 0xe6, 0xa3, 0x44,
+	0x54, 0xc2, 0xf7, 0x20, 0x27, 0x88, 0xbd, 0xd9, 0x39, 0xcd, 0x5a, 0x4b, 0xb2, 0x1a, 0x56, 0x89,
+	0x21, 0x25, 0x65, 0xf0, 0x19, 0x58, 0xd2, 0xbb, 0x14, 0x24, 0xb9, 0xc3, 0x79, 0xbd, 0x43, 0x18,
+	0xe7, 0x6c, 0xc8, 0x0e, 0x2e, 0xfd, 0x74, 0x40, 0x2e, 0x71, 0x01, 0xe0, 0x01, 0x98, 0x89, 0x50,
+	0x18, 0x2f, 0xd2, 0x75, 0x8a, 0x4e, 0x39, 0x5b, 0xab, 0xff, 0x1d, 0x14, 0xe6, 0xfa, 0xa8, 0x1d,
+	0xbe, 0x2e, 0x99, 0x44, 0xe9, 0xfe, 0xd7, 0x74, 0x3a, 0x42, 0xe1, 0x1e, 0xe9, 0xc3, 0x57, 0x20,
+	0x4d, 0xd9, 0x21, 0x77, 0x27, 0xf4, 0xd4, 0x0b, 0x63, 0x6e, 0xe2, 0x0e, 0x3b, 0xe4, 0xe6, 0x2d,
+	0xb5, 0xa4, 0x56, 0x3f, 0x39, 0xcb, 0x3b, 0xa7, 0x67, 0x79, 0xe7, 0xcf, 0x59, 0xde, 0xf9, 0x71,
+	0x9e, 0x4f, 0x9d, 0x9e, 0xe7, 0x53, 0xbf, 0xcf, 0xf3, 0xa9, 0x6f, 0x4f, 0x2d, 0x2f, 0x7b, 0x54,
+	0xa0, 0x3a, 0x17, 0xa4, 0x2a, 0x49, 0x0b, 0xd1, 0x6a, 0xaf, 0x6a, 0xe0, 0x43, 0x4b, 0xcd, 0x69,
+	0xfd, 0x97, 0x7e, 0xf1, 0x6f, 0x00, 0xbb, 0x7e, 0x4f, 0x4e, 0xfb, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -110,6 +316,170 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextRedelegationId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextRedelegationId))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ValidatorEpochs) > 0 {
+		for iNdEx := len(m.ValidatorEpochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorEpochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	{
+		size, err := m.CurrentEpoch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.ValidatorExits) > 0 {
+		for iNdEx := len(m.ValidatorExits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorExits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ConsensusKeyRotations) > 0 {
+		for iNdEx := len(m.ConsensusKeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsensusKeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.NextUnbondingDelegationId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextUnbondingDelegationId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.UnbondingDelegations) > 0 {
+		for iNdEx := len(m.UnbondingDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Bonds) > 0 {
+		for iNdEx := len(m.Bonds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bonds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.JailedValidators) > 0 {
+		for iNdEx := len(m.JailedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JailedValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ReactivatingValidators) > 0 {
+		for iNdEx := len(m.ReactivatingValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReactivatingValidators[iNdEx])
+			copy(dAtA[i:], m.ReactivatingValidators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ReactivatingValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RemovingValidators) > 0 {
+		for iNdEx := len(m.RemovingValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovingValidators[iNdEx])
+			copy(dAtA[i:], m.RemovingValidators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RemovingValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PendingValidators) > 0 {
+		for iNdEx := len(m.PendingValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Validators) > 0 {
 		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -127,6 +497,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorJail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorJail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorJail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValKey) > 0 {
+		i -= len(m.ValKey)
+		copy(dAtA[i:], m.ValKey)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -150,39 +560,128 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+	if len(m.PendingValidators) > 0 {
+		for _, e := range m.PendingValidators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+	}
+	if len(m.RemovingValidators) > 0 {
+		for _, b := range m.RemovingValidators {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReactivatingValidators) > 0 {
+		for _, b := range m.ReactivatingValidators {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.JailedValidators) > 0 {
+		for _, e := range m.JailedValidators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Bonds) > 0 {
+		for _, e := range m.Bonds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbondingDelegations) > 0 {
+		for _, e := range m.UnbondingDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextUnbondingDelegationId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextUnbondingDelegationId))
+	}
+	if len(m.ConsensusKeyRotations) > 0 {
+		for _, e := range m.ConsensusKeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorExits) > 0 {
+		for _, e := range m.ValidatorExits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.CurrentEpoch.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ValidatorEpochs) > 0 {
+		for _, e := range m.ValidatorEpochs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextRedelegationId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextRedelegationId))
+	}
+	return n
+}
+
+func (m *ValidatorJail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValKey)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Info.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
@@ -222,6 +721,567 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingValidators = append(m.PendingValidators, Validator{})
+			if err := m.PendingValidators[len(m.PendingValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovingValidators", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovingValidators = append(m.RemovingValidators, make([]byte, postIndex-iNdEx))
+			copy(m.RemovingValidators[len(m.RemovingValidators)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReactivatingValidators", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReactivatingValidators = append(m.ReactivatingValidators, make([]byte, postIndex-iNdEx))
+			copy(m.ReactivatingValidators[len(m.ReactivatingValidators)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JailedValidators = append(m.JailedValidators, ValidatorJail{})
+			if err := m.JailedValidators[len(m.JailedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bonds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bonds = append(m.Bonds, ValidatorBond{})
+			if err := m.Bonds[len(m.Bonds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, Delegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingDelegations = append(m.UnbondingDelegations, UnbondingDelegation{})
+			if err := m.UnbondingDelegations[len(m.UnbondingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUnbondingDelegationId", wireType)
+			}
+			m.NextUnbondingDelegationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextUnbondingDelegationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusKeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusKeyRotations = append(m.ConsensusKeyRotations, ConsensusKeyRotation{})
+			if err := m.ConsensusKeyRotations[len(m.ConsensusKeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorExits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorExits = append(m.ValidatorExits, ValidatorExit{})
+			if err := m.ValidatorExits[len(m.ValidatorExits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorEpochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorEpochs = append(m.ValidatorEpochs, ValidatorEpoch{})
+			if err := m.ValidatorEpochs[len(m.ValidatorEpochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redelegations = append(m.Redelegations, Redelegation{})
+			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRedelegationId", wireType)
+			}
+			m.NextRedelegationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRedelegationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorJail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorJail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorJail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValKey = append(m.ValKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValKey == nil {
+				m.ValKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,227 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	customstakingtypes "github.com/KiraCore/sekai/x/staking/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	valAddr1 := types.ValAddress("val1________________")
+	valAddr2 := types.ValAddress("val2________________")
+	delegator := types.AccAddress("delegator___________")
+
+	newValidator := func(moniker string, valAddr types.ValAddress) customstakingtypes.Validator {
+		validator, err := customstakingtypes.NewValidator(moniker, "some-web.com", "A Social", "My Identity", types.NewDec(1234), valAddr, ed25519.GenPrivKey().PubKey())
+		require.NoError(t, err)
+		return validator
+	}
+
+	validator1 := newValidator("validator 1", valAddr1)
+	validator2 := newValidator("validator 2", valAddr2)
+
+	newRotation := func(valAddr types.ValAddress, pubKey cryptotypes.PubKey) customstakingtypes.ConsensusKeyRotation {
+		pkAny, err := codectypes.NewAnyWithValue(pubKey)
+		require.NoError(t, err)
+		return customstakingtypes.ConsensusKeyRotation{ValKey: valAddr, PubKey: pkAny}
+	}
+
+	tests := []struct {
+		name      string
+		genesis   customstakingtypes.GenesisState
+		expectErr bool
+	}{
+		{
+			name:      "default genesis",
+			genesis:   *customstakingtypes.DefaultGenesis(),
+			expectErr: false,
+		},
+		{
+			name: "valid validators and delegations",
+			genesis: customstakingtypes.GenesisState{
+				Validators:         []customstakingtypes.Validator{validator1},
+				PendingValidators:  []customstakingtypes.Validator{validator2},
+				RemovingValidators: []types.ValAddress{valAddr1},
+				Bonds: []customstakingtypes.ValidatorBond{
+					{ValKey: valAddr1, Tokens: types.NewInt(100), DelegatorShares: types.NewDec(100)},
+				},
+				Delegations: []customstakingtypes.Delegation{
+					{Delegator: delegator, ValKey: valAddr1, Shares: types.NewDec(100)},
+				},
+				UnbondingDelegations: []customstakingtypes.UnbondingDelegation{
+					{Id: 1, Delegator: delegator, ValKey: valAddr1, InitialBalance: types.NewInt(10), Balance: types.NewInt(10)},
+				},
+				NextUnbondingDelegationId: 2,
+				Redelegations: []customstakingtypes.Redelegation{
+					{Id: 1, Delegator: delegator, SrcValKey: valAddr2, DstValKey: valAddr1, InitialBalance: types.NewInt(10)},
+				},
+				NextRedelegationId: 2,
+			},
+			expectErr: false,
+		},
+		{
+			name: "duplicate validator",
+			genesis: customstakingtypes.GenesisState{
+				Validators:        []customstakingtypes.Validator{validator1},
+				PendingValidators: []customstakingtypes.Validator{newValidator("other moniker", valAddr1)},
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicate moniker",
+			genesis: customstakingtypes.GenesisState{
+				Validators: []customstakingtypes.Validator{validator1, newValidator("validator 1", valAddr2)},
+			},
+			expectErr: true,
+		},
+		{
+			name: "valid consensus key rotation",
+			genesis: customstakingtypes.GenesisState{
+				Validators:            []customstakingtypes.Validator{validator1},
+				ConsensusKeyRotations: []customstakingtypes.ConsensusKeyRotation{newRotation(valAddr1, ed25519.GenPrivKey().PubKey())},
+			},
+			expectErr: false,
+		},
+		{
+			name: "consensus key rotation to a key already used",
+			genesis: customstakingtypes.GenesisState{
+				Validators:            []customstakingtypes.Validator{validator1, validator2},
+				ConsensusKeyRotations: []customstakingtypes.ConsensusKeyRotation{newRotation(valAddr1, validator2.GetConsPubKey())},
+			},
+			expectErr: true,
+		},
+		{
+			name: "consensus key rotation of a validator that does not exist",
+			genesis: customstakingtypes.GenesisState{
+				Validators:            []customstakingtypes.Validator{validator1},
+				ConsensusKeyRotations: []customstakingtypes.ConsensusKeyRotation{newRotation(valAddr2, ed25519.GenPrivKey().PubKey())},
+			},
+			expectErr: true,
+		},
+		{
+			name: "valid validator exit",
+			genesis: customstakingtypes.GenesisState{
+				Validators:     []customstakingtypes.Validator{validator1},
+				ValidatorExits: []customstakingtypes.ValidatorExit{{ValKey: valAddr1, Time: time.Now()}},
+			},
+			expectErr: false,
+		},
+		{
+			name: "exit of a validator that does not exist",
+			genesis: customstakingtypes.GenesisState{
+				Validators:     []customstakingtypes.Validator{validator1},
+				ValidatorExits: []customstakingtypes.ValidatorExit{{ValKey: valAddr2, Time: time.Now()}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicate validator exit",
+			genesis: customstakingtypes.GenesisState{
+				Validators: []customstakingtypes.Validator{validator1},
+				ValidatorExits: []customstakingtypes.ValidatorExit{
+					{ValKey: valAddr1, Time: time.Now()},
+					{ValKey: valAddr1, Time: time.Now()},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "valid validator epochs",
+			genesis: customstakingtypes.GenesisState{
+				Validators:   []customstakingtypes.Validator{validator1},
+				CurrentEpoch: customstakingtypes.UptimeEpoch{Number: 2, StartHeight: 11},
+				ValidatorEpochs: []customstakingtypes.ValidatorEpoch{
+					{ValKey: valAddr1, Epoch: 1, StartHeight: 1, EndHeight: 10, SignedBlocks: 10},
+					{ValKey: valAddr1, Epoch: 2, StartHeight: 11, SignedBlocks: 3},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "epoch of a validator that does not exist",
+			genesis: customstakingtypes.GenesisState{
+				Validators:      []customstakingtypes.Validator{validator1},
+				CurrentEpoch:    customstakingtypes.UptimeEpoch{Number: 1, StartHeight: 1},
+				ValidatorEpochs: []customstakingtypes.ValidatorEpoch{{ValKey: valAddr2, Epoch: 1, StartHeight: 1}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "validator epoch after the current epoch",
+			genesis: customstakingtypes.GenesisState{
+				Validators:      []customstakingtypes.Validator{validator1},
+				CurrentEpoch:    customstakingtypes.UptimeEpoch{Number: 1, StartHeight: 1},
+				ValidatorEpochs: []customstakingtypes.ValidatorEpoch{{ValKey: valAddr1, Epoch: 2, StartHeight: 11}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicate validator epoch",
+			genesis: customstakingtypes.GenesisState{
+				Validators:   []customstakingtypes.Validator{validator1},
+				CurrentEpoch: customstakingtypes.UptimeEpoch{Number: 1, StartHeight: 1},
+				ValidatorEpochs: []customstakingtypes.ValidatorEpoch{
+					{ValKey: valAddr1, Epoch: 1, StartHeight: 1},
+					{ValKey: valAddr1, Epoch: 1, StartHeight: 1},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "removing validator does not exist",
+			genesis: customstakingtypes.GenesisState{
+				Validators:         []customstakingtypes.Validator{validator1},
+				RemovingValidators: []types.ValAddress{valAddr2},
+			},
+			expectErr: true,
+		},
+		{
+			name: "delegation to a validator without bond",
+			genesis: customstakingtypes.GenesisState{
+				Validators: []customstakingtypes.Validator{validator1},
+				Delegations: []customstakingtypes.Delegation{
+					{Delegator: delegator, ValKey: valAddr1, Shares: types.NewDec(100)},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "unbonding delegation id not lower than the next id",
+			genesis: customstakingtypes.GenesisState{
+				Validators: []customstakingtypes.Validator{validator1},
+				UnbondingDelegations: []customstakingtypes.UnbondingDelegation{
+					{Id: 1, Delegator: delegator, ValKey: valAddr1, InitialBalance: types.NewInt(10), Balance: types.NewInt(10)},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "redelegation id not lower than the next id",
+			genesis: customstakingtypes.GenesisState{
+				Redelegations: []customstakingtypes.Redelegation{
+					{Id: 1, Delegator: delegator, SrcValKey: valAddr2, DstValKey: valAddr1, InitialBalance: types.NewInt(10)},
+				},
+			},
+			expectErr: true,
+		},
+	}
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			err := test.genesis.Validate()
+			if test.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -31,3 +31,18 @@ func (h MultiStakingHooks) AfterValidatorJoined(ctx sdk.Context, consAddr sdk.Co
 		h[i].AfterValidatorJoined(ctx, consAddr, valAddr)
 	}
 }
+func (h MultiStakingHooks) AfterConsensusKeyRotated(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	for i := range h {
+		h[i].AfterConsensusKeyRotated(ctx, oldConsAddr, newConsAddr, valAddr)
+	}
+}
+func (h MultiStakingHooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	for i := range h {
+		h[i].BeforeDelegationSharesModified(ctx, delAddr, valAddr)
+	}
+}
+func (h MultiStakingHooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	for i := range h {
+		h[i].AfterDelegationModified(ctx, delAddr, valAddr)
+	}
+}
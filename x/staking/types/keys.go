@@ -6,5 +6,14 @@ const (
 	
 	// QuerierRoute is the querier route for the staking module
 	QuerierRoute = ModuleName
+
+	// BondedPoolName is the module account holding the tokens delegated to validators
+	BondedPoolName = "bonded_tokens_pool"
+
+	// NotBondedPoolName is the module account holding the tokens that are unbonding
+	NotBondedPoolName = "not_bonded_tokens_pool"
+
+	// BondDenom is the denom bonded by the delegators and the base denom of the fees
+	BondDenom = "ukex"
 )
 
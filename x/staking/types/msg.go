@@ -14,6 +14,13 @@ import (
 var (
 	_ sdk.Msg = &MsgClaimValidator{}
 	_ sdk.Msg = &MsgProposalUnjailValidator{}
+	_ sdk.Msg = &MsgDelegate{}
+	_ sdk.Msg = &MsgUndelegate{}
+	_ sdk.Msg = &MsgRedelegate{}
+	_ sdk.Msg = &MsgUnjail{}
+	_ sdk.Msg = &MsgEditValidator{}
+	_ sdk.Msg = &MsgRotateConsensusKey{}
+	_ sdk.Msg = &MsgExitValidator{}
 )
 
 func NewMsgClaimValidator(
@@ -115,3 +122,296 @@ func (m *MsgProposalUnjailValidator) GetSignBytes() []byte {
 func (m *MsgProposalUnjailValidator) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Proposer}
 }
+
+func NewMsgDelegate(delegator sdk.AccAddress, valKey sdk.ValAddress, amount sdk.Int) *MsgDelegate {
+	return &MsgDelegate{
+		Delegator: delegator,
+		ValKey:    valKey,
+		Amount:    amount,
+	}
+}
+
+func (m *MsgDelegate) Route() string {
+	return ModuleName
+}
+
+func (m *MsgDelegate) Type() string {
+	return types.MsgTypeDelegate
+}
+
+func (m *MsgDelegate) ValidateBasic() error {
+	if m.Delegator.Empty() {
+		return fmt.Errorf("delegator not set")
+	}
+
+	if m.ValKey.Empty() {
+		return fmt.Errorf("validator not set")
+	}
+
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return fmt.Errorf("amount should be positive")
+	}
+
+	return nil
+}
+
+func (m *MsgDelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgDelegate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Delegator}
+}
+
+func NewMsgUndelegate(delegator sdk.AccAddress, valKey sdk.ValAddress, amount sdk.Int) *MsgUndelegate {
+	return &MsgUndelegate{
+		Delegator: delegator,
+		ValKey:    valKey,
+		Amount:    amount,
+	}
+}
+
+func (m *MsgUndelegate) Route() string {
+	return ModuleName
+}
+
+func (m *MsgUndelegate) Type() string {
+	return types.MsgTypeUndelegate
+}
+
+func (m *MsgUndelegate) ValidateBasic() error {
+	if m.Delegator.Empty() {
+		return fmt.Errorf("delegator not set")
+	}
+
+	if m.ValKey.Empty() {
+		return fmt.Errorf("validator not set")
+	}
+
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return fmt.Errorf("amount should be positive")
+	}
+
+	return nil
+}
+
+func (m *MsgUndelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgUndelegate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Delegator}
+}
+
+func NewMsgRedelegate(delegator sdk.AccAddress, srcValKey, dstValKey sdk.ValAddress, amount sdk.Int) *MsgRedelegate {
+	return &MsgRedelegate{
+		Delegator: delegator,
+		SrcValKey: srcValKey,
+		DstValKey: dstValKey,
+		Amount:    amount,
+	}
+}
+
+func (m *MsgRedelegate) Route() string {
+	return ModuleName
+}
+
+func (m *MsgRedelegate) Type() string {
+	return types.MsgTypeRedelegate
+}
+
+func (m *MsgRedelegate) ValidateBasic() error {
+	if m.Delegator.Empty() {
+		return fmt.Errorf("delegator not set")
+	}
+
+	if m.SrcValKey.Empty() || m.DstValKey.Empty() {
+		return fmt.Errorf("validator not set")
+	}
+
+	if m.SrcValKey.Equals(m.DstValKey) {
+		return ErrSelfRedelegation
+	}
+
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return fmt.Errorf("amount should be positive")
+	}
+
+	return nil
+}
+
+func (m *MsgRedelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgRedelegate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Delegator}
+}
+
+func NewMsgUnjail(valKey sdk.ValAddress) *MsgUnjail {
+	return &MsgUnjail{
+		ValKey: valKey,
+	}
+}
+
+func (m *MsgUnjail) Route() string {
+	return ModuleName
+}
+
+func (m *MsgUnjail) Type() string {
+	return types.MsgTypeUnjail
+}
+
+func (m *MsgUnjail) ValidateBasic() error {
+	if m.ValKey.Empty() {
+		return fmt.Errorf("validator not set")
+	}
+
+	return nil
+}
+
+func (m *MsgUnjail) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgUnjail) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.AccAddress(m.ValKey),
+	}
+}
+
+func NewMsgEditValidator(valKey sdk.ValAddress, moniker, website, social, identity string, commission *sdk.Dec) *MsgEditValidator {
+	return &MsgEditValidator{
+		ValKey:     valKey,
+		Moniker:    moniker,
+		Website:    website,
+		Social:     social,
+		Identity:   identity,
+		Commission: commission,
+	}
+}
+
+func (m *MsgEditValidator) Route() string {
+	return ModuleName
+}
+
+func (m *MsgEditValidator) Type() string {
+	return types.MsgTypeEditValidator
+}
+
+func (m *MsgEditValidator) ValidateBasic() error {
+	if m.ValKey.Empty() {
+		return fmt.Errorf("validator not set")
+	}
+
+	if m.Moniker == "" && m.Website == "" && m.Social == "" && m.Identity == "" && m.Commission == nil {
+		return fmt.Errorf("nothing to edit")
+	}
+
+	if m.Commission != nil && (m.Commission.IsNegative() || m.Commission.GT(sdk.OneDec())) {
+		return ErrInvalidCommission
+	}
+
+	return Validator{Moniker: m.Moniker, Website: m.Website, Social: m.Social, Identity: m.Identity}.Validate()
+}
+
+func (m *MsgEditValidator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgEditValidator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.AccAddress(m.ValKey),
+	}
+}
+
+func NewMsgRotateConsensusKey(valKey sdk.ValAddress, pubKey cryptotypes.PubKey) (*MsgRotateConsensusKey, error) {
+	if pubKey == nil {
+		return nil, fmt.Errorf("public key not set")
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgRotateConsensusKey{
+		ValKey: valKey,
+		PubKey: pkAny,
+	}, nil
+}
+
+func (m *MsgRotateConsensusKey) Route() string {
+	return ModuleName
+}
+
+func (m *MsgRotateConsensusKey) Type() string {
+	return types.MsgTypeRotateConsensusKey
+}
+
+func (m *MsgRotateConsensusKey) ValidateBasic() error {
+	if m.ValKey.Empty() {
+		return fmt.Errorf("validator not set")
+	}
+
+	if m.PubKey == nil {
+		return fmt.Errorf("public key not set")
+	}
+
+	return nil
+}
+
+func (m *MsgRotateConsensusKey) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgRotateConsensusKey) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.AccAddress(m.ValKey),
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *MsgRotateConsensusKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(m.PubKey, &pubKey)
+}
+
+func NewMsgExitValidator(valKey sdk.ValAddress) *MsgExitValidator {
+	return &MsgExitValidator{
+		ValKey: valKey,
+	}
+}
+
+func (m *MsgExitValidator) Route() string {
+	return ModuleName
+}
+
+func (m *MsgExitValidator) Type() string {
+	return types.MsgTypeExitValidator
+}
+
+func (m *MsgExitValidator) ValidateBasic() error {
+	if m.ValKey.Empty() {
+		return fmt.Errorf("validator not set")
+	}
+
+	return nil
+}
+
+func (m *MsgExitValidator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgExitValidator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.AccAddress(m.ValKey),
+	}
+}
@@ -1,12 +1,15 @@
 package types_test
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/KiraCore/sekai/app"
 
 	customstakingtypes "github.com/KiraCore/sekai/x/staking/types"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
@@ -44,3 +47,122 @@ func TestMsgClaimValidator_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgRedelegate_ValidateBasic(t *testing.T) {
+	delegator := types.AccAddress("delegator")
+	valAddr1 := types.ValAddress("validator1")
+	valAddr2 := types.ValAddress("validator2")
+
+	tests := []struct {
+		name        string
+		msg         *customstakingtypes.MsgRedelegate
+		expectedErr error
+	}{
+		{
+			name:        "valid message",
+			msg:         customstakingtypes.NewMsgRedelegate(delegator, valAddr1, valAddr2, types.NewInt(100)),
+			expectedErr: nil,
+		},
+		{
+			name:        "empty delegator",
+			msg:         customstakingtypes.NewMsgRedelegate(nil, valAddr1, valAddr2, types.NewInt(100)),
+			expectedErr: fmt.Errorf("delegator not set"),
+		},
+		{
+			name:        "same validator",
+			msg:         customstakingtypes.NewMsgRedelegate(delegator, valAddr1, valAddr1, types.NewInt(100)),
+			expectedErr: customstakingtypes.ErrSelfRedelegation,
+		},
+		{
+			name:        "zero amount",
+			msg:         customstakingtypes.NewMsgRedelegate(delegator, valAddr1, valAddr2, types.ZeroInt()),
+			expectedErr: fmt.Errorf("amount should be positive"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.expectedErr.Error())
+			}
+		})
+	}
+}
+
+func TestMsgUnjail_ValidateBasic(t *testing.T) {
+	require.NoError(t, customstakingtypes.NewMsgUnjail(types.ValAddress("validator1")).ValidateBasic())
+	require.EqualError(t, customstakingtypes.NewMsgUnjail(nil).ValidateBasic(), "validator not set")
+}
+
+func TestMsgEditValidator_ValidateBasic(t *testing.T) {
+	valAddr := types.ValAddress("validator1")
+	commission := types.NewDecWithPrec(5, 2)
+	overOne := types.NewDecWithPrec(11, 1)
+
+	tests := []struct {
+		name        string
+		msg         *customstakingtypes.MsgEditValidator
+		expectedErr error
+	}{
+		{
+			name:        "valid message",
+			msg:         customstakingtypes.NewMsgEditValidator(valAddr, "moniker", "", "", "", &commission),
+			expectedErr: nil,
+		},
+		{
+			name:        "empty validator",
+			msg:         customstakingtypes.NewMsgEditValidator(nil, "moniker", "", "", "", nil),
+			expectedErr: fmt.Errorf("validator not set"),
+		},
+		{
+			name:        "nothing to edit",
+			msg:         customstakingtypes.NewMsgEditValidator(valAddr, "", "", "", "", nil),
+			expectedErr: fmt.Errorf("nothing to edit"),
+		},
+		{
+			name:        "commission over 1",
+			msg:         customstakingtypes.NewMsgEditValidator(valAddr, "", "", "", "", &overOne),
+			expectedErr: customstakingtypes.ErrInvalidCommission,
+		},
+		{
+			name:        "moniker too long",
+			msg:         customstakingtypes.NewMsgEditValidator(valAddr, strings.Repeat("a", 65), "", "", "", nil),
+			expectedErr: customstakingtypes.ErrInvalidMonikerLength,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.expectedErr.Error())
+			}
+		})
+	}
+}
+
+func TestMsgRotateConsensusKey_ValidateBasic(t *testing.T) {
+	pubKey := ed25519.GenPrivKey().PubKey()
+
+	msg, err := customstakingtypes.NewMsgRotateConsensusKey(types.ValAddress("validator1"), pubKey)
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+
+	msg, err = customstakingtypes.NewMsgRotateConsensusKey(nil, pubKey)
+	require.NoError(t, err)
+	require.EqualError(t, msg.ValidateBasic(), "validator not set")
+
+	_, err = customstakingtypes.NewMsgRotateConsensusKey(types.ValAddress("validator1"), nil)
+	require.Error(t, err)
+}
+
+func TestMsgExitValidator_ValidateBasic(t *testing.T) {
+	require.NoError(t, customstakingtypes.NewMsgExitValidator(types.ValAddress("validator1")).ValidateBasic())
+	require.EqualError(t, customstakingtypes.NewMsgExitValidator(nil).ValidateBasic(), "validator not set")
+}
@@ -22,3 +22,11 @@ func (m *ProposalUnjailValidator) ProposalType() string {
 func (m *ProposalUnjailValidator) VotePermission() types.PermValue {
 	panic("implement me")
 }
+
+func (m *ProposalUnjailValidator) CreatePermission() types.PermValue {
+	return types.PermCreateUnjailValidatorProposal
+}
+
+func (m *ProposalUnjailValidator) ValidateBasic() error {
+	return nil
+}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"math/bits"
+
+	customgovtypes "github.com/KiraCore/sekai/x/gov/types"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -89,6 +92,11 @@ func (v Validator) IsJailed() bool {
 	return v.Status == Jailed
 }
 
+// IsRemoved returns if validator is removed
+func (v Validator) IsRemoved() bool {
+	return v.Status == Removed
+}
+
 // GetConsPubKey returns the validator PubKey as a cryptotypes.PubKey.
 func (v Validator) GetConsPubKey() cryptotypes.PubKey {
 	pk, ok := v.PubKey.GetCachedValue().(cryptotypes.PubKey)
@@ -124,12 +132,57 @@ func (v Validator) TmConsPubKey() (tmprotocrypto.PublicKey, error) {
 	return tmPk, nil
 }
 
-// ConsensusPower gets the consensus-engine power. Aa reduction of 10^6 from
-// validator tokens is applied
-func (v Validator) ConsensusPower() int64 {
-	if v.IsActive() {
+// ConsensusPower gets the consensus-engine power of the validator following the
+// validator power mode, inactive validators have no power. The rank and streak modes
+// grow the power with the logarithm of the rank or streak, see logPower.
+func (v Validator) ConsensusPower(powerMode uint64) int64 {
+	if !v.IsActive() {
+		return 0
+	}
+
+	switch powerMode {
+	case customgovtypes.PowerModeRank:
+		return logPower(v.Rank)
+	case customgovtypes.PowerModeStreak:
+		return logPower(v.Streak)
+	default:
 		return 1
 	}
+}
+
+// logPower returns 1 plus the number of bits of n: 1 for 0, 2 for 1, 3 for 2 to 3, 4 for 4 to 7...
+// Rank and streak change on every block, the power only changes when they cross a power of two so
+// that the validator set is not sent to tendermint as updates on every block.
+func logPower(n int64) int64 {
+	if n <= 0 {
+		return 1
+	}
+
+	return int64(bits.Len64(uint64(n))) + 1
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r ConsensusKeyRotation) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubkey cryptotypes.PubKey
+	return unpacker.UnpackAny(r.PubKey, &pubkey)
+}
+
+// ConsPubKey returns the new consensus key of the rotation as a cryptotypes.PubKey.
+func (r ConsensusKeyRotation) ConsPubKey() (cryptotypes.PubKey, error) {
+	pk, ok := r.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	return pk, nil
+}
+
+// GetConsAddr extracts the consensus address of the new consensus key of the rotation
+func (r ConsensusKeyRotation) GetConsAddr() sdk.ConsAddress {
+	pk, err := r.ConsPubKey()
+	if err != nil {
+		panic(err)
+	}
 
-	return 0
+	return sdk.ConsAddress(pk.Address())
 }
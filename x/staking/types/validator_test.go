@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	customgovtypes "github.com/KiraCore/sekai/x/gov/types"
 	customstakingtypes "github.com/KiraCore/sekai/x/staking/types"
 
 	"github.com/stretchr/testify/require"
@@ -131,3 +132,30 @@ func TestNewValidator_IsActiveByDefault(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, validator.IsActive())
 }
+
+func TestValidator_ConsensusPower(t *testing.T) {
+	tests := []struct {
+		rankOrStreak int64
+		power        int64
+	}{
+		{-1, 1},
+		{0, 1},
+		{1, 2},
+		{2, 3},
+		{3, 3},
+		{4, 4},
+		{7, 4},
+		{8, 5},
+		{1000, 11},
+	}
+
+	for _, tt := range tests {
+		validator := customstakingtypes.Validator{Status: customstakingtypes.Active, Rank: tt.rankOrStreak, Streak: tt.rankOrStreak}
+		require.Equal(t, tt.power, validator.ConsensusPower(customgovtypes.PowerModeRank), tt.rankOrStreak)
+		require.Equal(t, tt.power, validator.ConsensusPower(customgovtypes.PowerModeStreak), tt.rankOrStreak)
+		require.Equal(t, int64(1), validator.ConsensusPower(customgovtypes.PowerModeFlat))
+	}
+
+	inactive := customstakingtypes.Validator{Status: customstakingtypes.Paused, Rank: 8}
+	require.Equal(t, int64(0), inactive.ConsensusPower(customgovtypes.PowerModeRank))
+}
@@ -0,0 +1,39 @@
+package tokens
+
+import (
+	"github.com/KiraCore/sekai/x/tokens/keeper"
+	"github.com/KiraCore/sekai/x/tokens/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis sets the token aliases, the token rates and the tokens white and black lists
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genesisState types.GenesisState) {
+	for _, alias := range genesisState.Aliases {
+		k.UpsertTokenAlias(ctx, *alias)
+	}
+
+	for _, rate := range genesisState.Rates {
+		k.UpsertTokenRate(ctx, *rate)
+	}
+
+	k.SetTokenBlackWhites(ctx, genesisState.TokenBlackWhites)
+}
+
+// ExportGenesis returns the token aliases, the token rates and the tokens white and black lists
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	aliases := make(map[string]*types.TokenAlias)
+	for _, alias := range k.ListTokenAlias(ctx) {
+		aliases[alias.Symbol] = alias
+	}
+
+	rates := make(map[string]*types.TokenRate)
+	for _, rate := range k.ListTokenRate(ctx) {
+		rates[rate.Denom] = rate
+	}
+
+	return &types.GenesisState{
+		Aliases:          aliases,
+		Rates:            rates,
+		TokenBlackWhites: k.GetTokenBlackWhites(ctx),
+	}
+}
@@ -0,0 +1,40 @@
+package tokens_test
+
+import (
+	"testing"
+
+	"github.com/KiraCore/sekai/simapp"
+	tokens "github.com/KiraCore/sekai/x/tokens"
+	tokenstypes "github.com/KiraCore/sekai/x/tokens/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestExportInitGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	require.NoError(t, app.TokensKeeper.UpsertTokenAlias(ctx, *tokenstypes.NewTokenAlias("ETH", "Ethereum", "icon", 6, []string{"weth"})))
+	require.NoError(t, app.TokensKeeper.UpsertTokenRate(ctx, *tokenstypes.NewTokenRate("weth", sdk.NewDecWithPrec(5, 2), false)))
+	app.TokensKeeper.AddTokensToWhitelist(ctx, []string{"weth"})
+	app.TokensKeeper.AddTokensToBlacklist(ctx, []string{"scam"})
+
+	cdc := app.AppCodec()
+	exported := cdc.MustMarshalJSON(tokens.ExportGenesis(ctx, app.TokensKeeper))
+
+	var genesisState tokenstypes.GenesisState
+	cdc.MustUnmarshalJSON(exported, &genesisState)
+	require.Contains(t, genesisState.Aliases, "ETH")
+	require.Contains(t, genesisState.Rates, "weth")
+	require.Contains(t, genesisState.TokenBlackWhites.Whitelisted, "weth")
+	require.Contains(t, genesisState.TokenBlackWhites.Blacklisted, "scam")
+
+	// a fresh chain started from the exported genesis exports the same genesis
+	newApp := simapp.Setup(false)
+	newCtx := newApp.NewContext(false, tmproto.Header{})
+	tokens.InitGenesis(newCtx, newApp.TokensKeeper, genesisState)
+
+	reexported := cdc.MustMarshalJSON(tokens.ExportGenesis(newCtx, newApp.TokensKeeper))
+	require.Equal(t, string(exported), string(reexported))
+}
@@ -296,6 +296,7 @@ func TestHandler_CreateProposalUpsertTokenAliases(t *testing.T) {
 		ctx.BlockTime().Add(time.Minute*time.Duration(properties.ProposalEnactmentTime)),
 	)
 	require.NoError(t, err)
+	expectedSavedProposal.Proposer = proposerAddr
 	require.Equal(t, expectedSavedProposal, savedProposal)
 
 	// Next proposal ID is increased.
@@ -397,6 +398,7 @@ func TestHandler_CreateProposalUpsertTokenRates(t *testing.T) {
 		ctx.BlockTime().Add(time.Minute*time.Duration(properties.ProposalEnactmentTime)),
 	)
 	require.NoError(t, err)
+	expectedSavedProposal.Proposer = proposerAddr
 	require.Equal(t, expectedSavedProposal, savedProposal)
 
 	// Next proposal ID is increased.
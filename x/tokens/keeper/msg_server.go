@@ -36,7 +36,7 @@ func (k msgServer) ProposalUpsertTokenRates(goCtx context.Context, msg *types.Ms
 		return nil, errors.Wrap(customgovtypes.ErrNotEnoughPermissions, customgovtypes.PermCreateUpsertTokenRateProposal.String())
 	}
 
-	proposalID, err := k.CreateAndSaveProposalWithContent(ctx, types.NewProposalUpsertTokenRates(
+	proposalID, err := k.CreateAndSaveProposalWithContent(ctx, msg.Proposer, types.NewProposalUpsertTokenRates(
 		msg.Denom,
 		msg.Rate,
 		msg.FeePayments,
@@ -54,7 +54,7 @@ func (k msgServer) ProposalUpsertTokenAlias(goCtx context.Context, msg *types.Ms
 		return nil, errors.Wrap(customgovtypes.ErrNotEnoughPermissions, customgovtypes.PermCreateUpsertTokenAliasProposal.String())
 	}
 
-	proposalID, err := k.CreateAndSaveProposalWithContent(ctx, types.NewProposalUpsertTokenAlias(
+	proposalID, err := k.CreateAndSaveProposalWithContent(ctx, msg.Proposer, types.NewProposalUpsertTokenAlias(
 		msg.Symbol,
 		msg.Name,
 		msg.Icon,
@@ -74,7 +74,7 @@ func (k msgServer) ProposalTokensWhiteBlackChange(goCtx context.Context, msg *ty
 		return nil, errors.Wrap(customgovtypes.ErrNotEnoughPermissions, customgovtypes.PermCreateTokensWhiteBlackChangeProposal.String())
 	}
 
-	proposalID, err := k.CreateAndSaveProposalWithContent(ctx, types.NewProposalTokensWhiteBlackChange(
+	proposalID, err := k.CreateAndSaveProposalWithContent(ctx, msg.Proposer, types.NewProposalTokensWhiteBlackChange(
 		msg.IsBlacklist,
 		msg.IsAdd,
 		msg.Tokens,
@@ -130,7 +130,7 @@ func (k msgServer) UpsertTokenRate(goCtx context.Context, msg *types.MsgUpsertTo
 	return &types.MsgUpsertTokenRateResponse{}, nil
 }
 
-func (k msgServer) CreateAndSaveProposalWithContent(ctx sdk.Context, content customgovtypes.Content) (uint64, error) {
+func (k msgServer) CreateAndSaveProposalWithContent(ctx sdk.Context, proposer sdk.AccAddress, content customgovtypes.Content) (uint64, error) {
 	blockTime := ctx.BlockTime()
 	proposalID, err := k.cgk.GetNextProposalID(ctx)
 	if err != nil {
@@ -146,6 +146,15 @@ func (k msgServer) CreateAndSaveProposalWithContent(ctx sdk.Context, content cus
 		blockTime.Add(time.Minute*time.Duration(properties.ProposalEndTime)),
 		blockTime.Add(time.Minute*time.Duration(properties.ProposalEnactmentTime)),
 	)
+	if err != nil {
+		return 0, err
+	}
+	proposal.Proposer = proposer
+
+	err = k.cgk.CollectProposalDeposit(ctx, proposalID, proposer, content)
+	if err != nil {
+		return 0, err
+	}
 
 	k.cgk.SaveProposal(ctx, proposal)
 	k.cgk.AddToActiveProposals(ctx, proposal)
@@ -3,6 +3,7 @@ package tokens
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
@@ -46,7 +47,12 @@ func (b AppModuleBasic) DefaultGenesis(cdc codec.JSONMarshaler) json.RawMessage
 }
 
 func (b AppModuleBasic) ValidateGenesis(marshaler codec.JSONMarshaler, config client.TxEncodingConfig, message json.RawMessage) error {
-	return nil
+	var genesisState tokenstypes.GenesisState
+	if err := marshaler.UnmarshalJSON(message, &genesisState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", tokenstypes.ModuleName, err)
+	}
+
+	return genesisState.Validate()
 }
 
 func (b AppModuleBasic) RegisterRESTRoutes(clientCtx client.Context, router *mux.Router) {
@@ -96,21 +102,14 @@ func (am AppModule) InitGenesis(
 	var genesisState tokenstypes.GenesisState
 	cdc.MustUnmarshalJSON(data, &genesisState)
 
-	for _, alias := range genesisState.Aliases {
-		am.tokensKeeper.UpsertTokenAlias(ctx, *alias)
-	}
-
-	for _, rate := range genesisState.Rates {
-		am.tokensKeeper.UpsertTokenRate(ctx, *rate)
-	}
-
-	am.tokensKeeper.SetTokenBlackWhites(ctx, genesisState.TokenBlackWhites)
+	InitGenesis(ctx, am.tokensKeeper, genesisState)
 
 	return nil
 }
 
-func (am AppModule) ExportGenesis(clientCtx sdk.Context, marshaler codec.JSONMarshaler) json.RawMessage {
-	return nil
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) json.RawMessage {
+	gs := ExportGenesis(ctx, am.tokensKeeper)
+	return cdc.MustMarshalJSON(gs)
 }
 
 func (am AppModule) RegisterInvariants(registry sdk.InvariantRegistry) {}
@@ -12,4 +12,5 @@ type CustomGovKeeper interface {
 	GetNetworkProperties(ctx sdk.Context) *customgovtypes.NetworkProperties
 	SaveProposal(ctx sdk.Context, proposal customgovtypes.Proposal)
 	AddToActiveProposals(ctx sdk.Context, proposal customgovtypes.Proposal)
+	CollectProposalDeposit(ctx sdk.Context, proposalID uint64, proposer sdk.AccAddress, content customgovtypes.Content) error
 }
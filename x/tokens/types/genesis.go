@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -21,3 +23,60 @@ func DefaultGenesis() *GenesisState {
 		},
 	}
 }
+
+// Validate checks the genesis state is consistent, it is run by the validate-genesis command before the chain starts.
+func (data GenesisState) Validate() error {
+	aliasedDenoms := make(map[string]string)
+	for symbol, alias := range data.Aliases {
+		if alias == nil || alias.Symbol != symbol {
+			return fmt.Errorf("token alias %s is not stored under its symbol", symbol)
+		}
+
+		for _, denom := range alias.Denoms {
+			if err := sdk.ValidateDenom(denom); err != nil {
+				return fmt.Errorf("invalid denom of token alias %s: %w", symbol, err)
+			}
+
+			if other, ok := aliasedDenoms[denom]; ok {
+				return fmt.Errorf("denom %s is aliased by both %s and %s", denom, other, symbol)
+			}
+			aliasedDenoms[denom] = symbol
+		}
+	}
+
+	for denom, rate := range data.Rates {
+		if rate == nil || rate.Denom != denom {
+			return fmt.Errorf("token rate %s is not stored under its denom", denom)
+		}
+
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid denom of token rate: %w", err)
+		}
+
+		if rate.Rate.IsNil() || !rate.Rate.IsPositive() {
+			return fmt.Errorf("rate of %s must be positive, is %s", denom, rate.Rate)
+		}
+	}
+
+	if data.TokenBlackWhites != nil {
+		whitelisted := make(map[string]bool)
+		for _, denom := range data.TokenBlackWhites.Whitelisted {
+			if err := sdk.ValidateDenom(denom); err != nil {
+				return fmt.Errorf("invalid whitelisted denom: %w", err)
+			}
+			whitelisted[denom] = true
+		}
+
+		for _, denom := range data.TokenBlackWhites.Blacklisted {
+			if err := sdk.ValidateDenom(denom); err != nil {
+				return fmt.Errorf("invalid blacklisted denom: %w", err)
+			}
+
+			if whitelisted[denom] {
+				return fmt.Errorf("denom %s is both whitelisted and blacklisted", denom)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		malleate  func(data *GenesisState)
+		expectErr bool
+	}{
+		{
+			name:      "default genesis",
+			malleate:  func(data *GenesisState) {},
+			expectErr: false,
+		},
+		{
+			name: "alias not stored under its symbol",
+			malleate: func(data *GenesisState) {
+				data.Aliases["BTC"] = NewTokenAlias("XBT", "Bitcoin", "", 8, []string{"ubtc"})
+			},
+			expectErr: true,
+		},
+		{
+			name: "denom aliased twice",
+			malleate: func(data *GenesisState) {
+				data.Aliases["MKEX"] = NewTokenAlias("MKEX", "Kira", "", 3, []string{"mkex"})
+			},
+			expectErr: true,
+		},
+		{
+			name: "rate not stored under its denom",
+			malleate: func(data *GenesisState) {
+				data.Rates["ubtc"] = NewTokenRate("xeth", sdk.NewDec(1), true)
+			},
+			expectErr: true,
+		},
+		{
+			name: "rate not positive",
+			malleate: func(data *GenesisState) {
+				data.Rates["ubtc"] = NewTokenRate("ubtc", sdk.ZeroDec(), true)
+			},
+			expectErr: true,
+		},
+		{
+			name: "denom whitelisted and blacklisted",
+			malleate: func(data *GenesisState) {
+				data.TokenBlackWhites.Blacklisted = append(data.TokenBlackWhites.Blacklisted, "ukex")
+			},
+			expectErr: true,
+		},
+	}
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			data := DefaultGenesis()
+			test.malleate(data)
+
+			err := data.Validate()
+			if test.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -40,6 +40,14 @@ func (m *ProposalUpsertTokenAlias) VotePermission() types.PermValue {
 	return types.PermVoteUpsertTokenAliasProposal
 }
 
+func (m *ProposalUpsertTokenAlias) CreatePermission() types.PermValue {
+	return types.PermCreateUpsertTokenAliasProposal
+}
+
+func (m *ProposalUpsertTokenAlias) ValidateBasic() error {
+	return nil
+}
+
 func NewProposalUpsertTokenRates(denom string, rate sdk.Dec, feePayments bool) *ProposalUpsertTokenRates {
 	return &ProposalUpsertTokenRates{Denom: denom, Rate: rate, FeePayments: feePayments}
 }
@@ -52,6 +60,14 @@ func (m *ProposalUpsertTokenRates) VotePermission() types.PermValue {
 	return types.PermVoteUpsertTokenRateProposal
 }
 
+func (m *ProposalUpsertTokenRates) CreatePermission() types.PermValue {
+	return types.PermCreateUpsertTokenRateProposal
+}
+
+func (m *ProposalUpsertTokenRates) ValidateBasic() error {
+	return nil
+}
+
 func NewProposalTokensWhiteBlackChange(isBlacklist, isAdd bool, tokens []string) *ProposalTokensWhiteBlackChange {
 	return &ProposalTokensWhiteBlackChange{isBlacklist, isAdd, tokens}
 }
@@ -63,3 +79,11 @@ func (m *ProposalTokensWhiteBlackChange) ProposalType() string {
 func (m *ProposalTokensWhiteBlackChange) VotePermission() types.PermValue {
 	return types.PermVoteTokensWhiteBlackChangeProposal
 }
+
+func (m *ProposalTokensWhiteBlackChange) CreatePermission() types.PermValue {
+	return types.PermCreateTokensWhiteBlackChangeProposal
+}
+
+func (m *ProposalTokensWhiteBlackChange) ValidateBasic() error {
+	return nil
+}